@@ -2,16 +2,20 @@ package main
 
 import (
 	"log/slog"
+	"os"
 
 	"github.com/InWamos/trinity-proto/config"
 	_ "github.com/InWamos/trinity-proto/docs"
 	"github.com/InWamos/trinity-proto/logger"
 	"github.com/InWamos/trinity-proto/middleware"
 	"github.com/InWamos/trinity-proto/setup"
+	"github.com/InWamos/trinity-proto/setup/audit"
 	"github.com/InWamos/trinity-proto/setup/auth"
+	"github.com/InWamos/trinity-proto/setup/event"
 	"github.com/InWamos/trinity-proto/setup/record"
 	"github.com/InWamos/trinity-proto/setup/shared"
 	"github.com/InWamos/trinity-proto/setup/user"
+	"github.com/InWamos/trinity-proto/setup/webhook"
 	"go.uber.org/fx"
 	"go.uber.org/fx/fxevent"
 )
@@ -35,27 +39,69 @@ import (
 //	@description				Session cookie for authenticated requests. Roles: Admin, User
 
 func main() {
+	// Subcommands share the whole dependency graph but don't start the HTTP servers
+	if len(os.Args) > 1 && os.Args[1] == setup.ImportTelegramExportCommandName {
+		fx.New(
+			newCoreOptions(),
+			fx.Invoke(setup.NewImportTelegramExportCommand(os.Args[2:])),
+		).Run()
+		return
+	}
+	if len(os.Args) > 1 && (os.Args[1] == setup.BackfillTelegramRecordAnalysisCommandName ||
+		os.Args[1] == setup.BackfillTelegramIndicatorsCommandName) {
+		fx.New(
+			newCoreOptions(),
+			fx.Invoke(setup.NewBackfillTelegramRecordAnalysisCommand(os.Args[2:])),
+		).Run()
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == setup.VerifyTelegramChainCommandName {
+		fx.New(
+			newCoreOptions(),
+			fx.Invoke(setup.NewVerifyTelegramChainCommand(os.Args[2:])),
+		).Run()
+		return
+	}
+
 	fx.New(
+		newCoreOptions(),
+		fx.Provide(setup.NewMainHTTPServer),
+		fx.Provide(setup.NewProfilerHTTPServer),
+		fx.Provide(setup.NewHTTPServers),
+		fx.Invoke(setup.CreateAdminAccountIfNotExists),
+		fx.Invoke(setup.StartWebhookDispatcher),
+		fx.Invoke(setup.StartEventDispatcher),
+		fx.Invoke(setup.StartTelegramChainCheckpointer),
+		fx.Invoke(setup.StartTelegramExportWorker),
+		fx.Invoke(func(servers setup.HTTPServers) {}), //nolint:revive //False positive on Fx syntax
+	).Run()
+}
+
+func newCoreOptions() fx.Option {
+	return fx.Options(
 		fx.Provide(config.NewDatabaseConfig, config.NewLoggingConfig,
-			config.NewServerConfig, config.NewRedisConfig),
+			config.NewServerConfig, config.NewRedisConfig, config.NewStorageConfig, config.NewIngestConfig, config.NewBotConfig,
+			config.NewWebhookConfig, config.NewEventConfig, config.NewChainConfig, config.NewExportConfig,
+			config.NewVisibilityConfig),
 		fx.Provide(logger.GetLogger),
 		fx.Provide(
 			middleware.NewGlobalCORSMiddleware,
 			middleware.NewTrustedProxyMiddleware,
+			middleware.NewRequestMetadataMiddleware,
 			middleware.NewLoggingMiddleware,
 			middleware.NewAuthenticationMiddleware,
+			middleware.NewBotAuthenticationMiddleware,
 		),
 		user.NewUserModuleContainer(),
 		auth.NewAuthModuleContainer(),
 		record.NewRecordModuleContainer(),
+		webhook.NewWebhookModuleContainer(),
+		event.NewEventModuleContainer(),
+		audit.NewAuditModuleContainer(),
 		shared.NewSharedModuleContainer(),
-		fx.Provide(setup.NewMainHTTPServer),
-		fx.Provide(setup.NewProfilerHTTPServer),
-		fx.Provide(setup.NewHTTPServers),
 		fx.WithLogger(func(logger *slog.Logger) fxevent.Logger {
 			return &fxevent.SlogLogger{Logger: logger}
 		}),
-		fx.Invoke(setup.CreateAdminAccountIfNotExists),
-		fx.Invoke(func(servers setup.HTTPServers) {}), //nolint:revive //False positive on Fx syntax
-	).Run()
+	)
 }
@@ -0,0 +1,38 @@
+package config
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+)
+
+var ErrInvalidBotServiceUser = errors.New("BOT_SERVICE_USER_ID must be a UUID when BOT_WEBHOOK_SECRET is set")
+
+// BotConfig configures the Telegram Bot API webhook.
+// The webhook is disabled unless WebhookSecret is set, the updates are attributed to ServiceUserID.
+type BotConfig struct {
+	WebhookSecret string    `mapstructure:"BOT_WEBHOOK_SECRET"`
+	ServiceUserID uuid.UUID `mapstructure:"-"`
+}
+
+func NewBotConfig() (*BotConfig, error) {
+	viper.AutomaticEnv()
+
+	_ = viper.BindEnv("BOT_WEBHOOK_SECRET")
+	_ = viper.BindEnv("BOT_SERVICE_USER_ID")
+
+	var botConfig BotConfig
+	if err := viper.Unmarshal(&botConfig); err != nil {
+		return nil, err
+	}
+	if botConfig.WebhookSecret == "" {
+		return &botConfig, nil
+	}
+	serviceUserID, err := uuid.Parse(viper.GetString("BOT_SERVICE_USER_ID"))
+	if err != nil {
+		return nil, errors.Join(ErrInvalidBotServiceUser, err)
+	}
+	botConfig.ServiceUserID = serviceUserID
+	return &botConfig, nil
+}
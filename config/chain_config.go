@@ -0,0 +1,66 @@
+package config
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// DefaultChainCheckpointInterval is used when CHAIN_CHECKPOINT_INTERVAL is not set.
+const DefaultChainCheckpointInterval = time.Hour
+
+var ErrInvalidChainSigningKey = errors.New(
+	"CHAIN_SIGNING_KEY must be a base64 encoded Ed25519 seed of 32 bytes or private key of 64 bytes",
+)
+
+// ChainConfig configures the hash chain over the archived telegram data.
+// The heads of the chains are signed with SigningKey every CheckpointInterval. Without CHAIN_SIGNING_KEY
+// the chains are still kept, but no checkpoint is signed and the signatures of the existing ones can't be verified.
+type ChainConfig struct {
+	SigningKey         ed25519.PrivateKey `mapstructure:"-"`
+	CheckpointInterval time.Duration      `mapstructure:"CHAIN_CHECKPOINT_INTERVAL"`
+}
+
+func NewChainConfig() (*ChainConfig, error) {
+	viper.AutomaticEnv()
+
+	_ = viper.BindEnv("CHAIN_SIGNING_KEY")
+	_ = viper.BindEnv("CHAIN_CHECKPOINT_INTERVAL")
+
+	var chainConfig ChainConfig
+	if err := viper.Unmarshal(&chainConfig); err != nil {
+		return nil, err
+	}
+	if chainConfig.CheckpointInterval <= 0 {
+		chainConfig.CheckpointInterval = DefaultChainCheckpointInterval
+	}
+	encodedKey := viper.GetString("CHAIN_SIGNING_KEY")
+	if encodedKey == "" {
+		return &chainConfig, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidChainSigningKey, err)
+	}
+	switch len(key) {
+	case ed25519.SeedSize:
+		chainConfig.SigningKey = ed25519.NewKeyFromSeed(key)
+	case ed25519.PrivateKeySize:
+		chainConfig.SigningKey = ed25519.PrivateKey(key)
+	default:
+		return nil, ErrInvalidChainSigningKey
+	}
+	return &chainConfig, nil
+}
+
+// PublicKey returns the key verifying the checkpoints, nil without a signing key.
+func (chainConfig *ChainConfig) PublicKey() ed25519.PublicKey {
+	if chainConfig.SigningKey == nil {
+		return nil
+	}
+	publicKey, _ := chainConfig.SigningKey.Public().(ed25519.PublicKey)
+	return publicKey
+}
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// DefaultEventPollInterval is used when EVENT_POLL_INTERVAL is not set.
+	DefaultEventPollInterval = time.Second
+	// DefaultEventBatchSize is used when EVENT_BATCH_SIZE is not set.
+	DefaultEventBatchSize = 100
+	// DefaultEventStreamName is used when EVENT_STREAM_NAME is not set.
+	DefaultEventStreamName = "trinity:events"
+	// DefaultEventStreamMaxLength is used when EVENT_STREAM_MAX_LENGTH is not set.
+	DefaultEventStreamMaxLength = 100000
+	// DefaultEventRetention is used when EVENT_RETENTION is not set.
+	DefaultEventRetention = 7 * 24 * time.Hour
+)
+
+// EventConfig tunes the publishing of the domain events.
+// The outbox is polled every PollInterval for at most BatchSize due events, which are appended to the Redis
+// stream StreamName trimmed to about StreamMaxLength entries. The consumer groups of StreamGroups
+// (comma-separated) are created along with the stream, so that they don't miss the events published before
+// their consumers first connect. Published events are kept in the outbox for Retention.
+type EventConfig struct {
+	PollInterval    time.Duration `mapstructure:"EVENT_POLL_INTERVAL"`
+	BatchSize       int           `mapstructure:"EVENT_BATCH_SIZE"`
+	StreamName      string        `mapstructure:"EVENT_STREAM_NAME"`
+	StreamMaxLength int64         `mapstructure:"EVENT_STREAM_MAX_LENGTH"`
+	StreamGroups    string        `mapstructure:"EVENT_STREAM_GROUPS"`
+	Retention       time.Duration `mapstructure:"EVENT_RETENTION"`
+}
+
+func NewEventConfig() (*EventConfig, error) {
+	viper.AutomaticEnv()
+
+	_ = viper.BindEnv("EVENT_POLL_INTERVAL")
+	_ = viper.BindEnv("EVENT_BATCH_SIZE")
+	_ = viper.BindEnv("EVENT_STREAM_NAME")
+	_ = viper.BindEnv("EVENT_STREAM_MAX_LENGTH")
+	_ = viper.BindEnv("EVENT_STREAM_GROUPS")
+	_ = viper.BindEnv("EVENT_RETENTION")
+
+	var eventConfig EventConfig
+	if err := viper.Unmarshal(&eventConfig); err != nil {
+		return nil, err
+	}
+	if eventConfig.PollInterval <= 0 {
+		eventConfig.PollInterval = DefaultEventPollInterval
+	}
+	if eventConfig.BatchSize <= 0 {
+		eventConfig.BatchSize = DefaultEventBatchSize
+	}
+	if eventConfig.StreamName == "" {
+		eventConfig.StreamName = DefaultEventStreamName
+	}
+	if eventConfig.StreamMaxLength <= 0 {
+		eventConfig.StreamMaxLength = DefaultEventStreamMaxLength
+	}
+	if eventConfig.Retention <= 0 {
+		eventConfig.Retention = DefaultEventRetention
+	}
+	return &eventConfig, nil
+}
+
+// Groups returns the names of the consumer groups, without the blank ones.
+func (eventConfig *EventConfig) Groups() []string {
+	var groups []string
+	for group := range strings.SplitSeq(eventConfig.StreamGroups, ",") {
+		if group = strings.TrimSpace(group); group != "" {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
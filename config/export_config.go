@@ -0,0 +1,42 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// DefaultExportPollInterval is used when EXPORT_POLL_INTERVAL is not set.
+	DefaultExportPollInterval = 5 * time.Second
+	// DefaultExportMaxRecords is used when EXPORT_MAX_RECORDS is not set.
+	DefaultExportMaxRecords = 1000000
+)
+
+// ExportConfig tunes the building of the evidence exports.
+// The pending exports are polled every PollInterval and built one at a time. An export selecting more than
+// MaxRecords records fails, so that a single request can't fill the blob storage.
+// The bundles are signed with the CHAIN_SIGNING_KEY of ChainConfig.
+type ExportConfig struct {
+	PollInterval time.Duration `mapstructure:"EXPORT_POLL_INTERVAL"`
+	MaxRecords   int64         `mapstructure:"EXPORT_MAX_RECORDS"`
+}
+
+func NewExportConfig() (*ExportConfig, error) {
+	viper.AutomaticEnv()
+
+	_ = viper.BindEnv("EXPORT_POLL_INTERVAL")
+	_ = viper.BindEnv("EXPORT_MAX_RECORDS")
+
+	var exportConfig ExportConfig
+	if err := viper.Unmarshal(&exportConfig); err != nil {
+		return nil, err
+	}
+	if exportConfig.PollInterval <= 0 {
+		exportConfig.PollInterval = DefaultExportPollInterval
+	}
+	if exportConfig.MaxRecords <= 0 {
+		exportConfig.MaxRecords = DefaultExportMaxRecords
+	}
+	return &exportConfig, nil
+}
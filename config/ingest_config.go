@@ -0,0 +1,52 @@
+package config
+
+import "github.com/spf13/viper"
+
+const (
+	// DefaultIngestBatchSize is used when INGEST_BATCH_SIZE is not set.
+	DefaultIngestBatchSize = 500
+	// DefaultIngestMaxBatchBodySize is used when INGEST_MAX_BATCH_BODY_SIZE is not set (16 MiB).
+	DefaultIngestMaxBatchBodySize int64 = 16 << 20
+	// DefaultIngestChunkSize is used when INGEST_CHUNK_SIZE is not set.
+	DefaultIngestChunkSize = 500
+	// DefaultIngestMaxLineSize is used when INGEST_MAX_LINE_SIZE is not set (1 MiB).
+	DefaultIngestMaxLineSize = 1 << 20
+)
+
+// IngestConfig tunes the bulk and the streaming NDJSON ingestion.
+// A batch may hold up to BatchSize records and its body may not exceed MaxBatchBodySize bytes.
+// Every ChunkSize lines of a stream are committed in a transaction of their own,
+// a line may not exceed MaxLineSize bytes.
+type IngestConfig struct {
+	BatchSize        int   `mapstructure:"INGEST_BATCH_SIZE"`
+	MaxBatchBodySize int64 `mapstructure:"INGEST_MAX_BATCH_BODY_SIZE"`
+	ChunkSize        int   `mapstructure:"INGEST_CHUNK_SIZE"`
+	MaxLineSize      int   `mapstructure:"INGEST_MAX_LINE_SIZE"`
+}
+
+func NewIngestConfig() (*IngestConfig, error) {
+	viper.AutomaticEnv()
+
+	_ = viper.BindEnv("INGEST_BATCH_SIZE")
+	_ = viper.BindEnv("INGEST_MAX_BATCH_BODY_SIZE")
+	_ = viper.BindEnv("INGEST_CHUNK_SIZE")
+	_ = viper.BindEnv("INGEST_MAX_LINE_SIZE")
+
+	var ingestConfig IngestConfig
+	if err := viper.Unmarshal(&ingestConfig); err != nil {
+		return nil, err
+	}
+	if ingestConfig.BatchSize <= 0 {
+		ingestConfig.BatchSize = DefaultIngestBatchSize
+	}
+	if ingestConfig.MaxBatchBodySize <= 0 {
+		ingestConfig.MaxBatchBodySize = DefaultIngestMaxBatchBodySize
+	}
+	if ingestConfig.ChunkSize <= 0 {
+		ingestConfig.ChunkSize = DefaultIngestChunkSize
+	}
+	if ingestConfig.MaxLineSize <= 0 {
+		ingestConfig.MaxLineSize = DefaultIngestMaxLineSize
+	}
+	return &ingestConfig, nil
+}
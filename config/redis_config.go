@@ -3,10 +3,11 @@ package config
 import "github.com/spf13/viper"
 
 type RedisConfig struct {
-	Host         string `mapstructure:"REDIS_ADDRESS"`
-	Port         string `mapstructure:"REDIS_PORT"`
-	Password     string `mapstructure:"REDIS_PASSWORD"`
-	DBNumberAuth string `mapstructure:"REDIS_DB_AUTH"`
+	Host           string `mapstructure:"REDIS_ADDRESS"`
+	Port           string `mapstructure:"REDIS_PORT"`
+	Password       string `mapstructure:"REDIS_PASSWORD"`
+	DBNumberAuth   string `mapstructure:"REDIS_DB_AUTH"`
+	DBNumberEvents string `mapstructure:"REDIS_DB_EVENTS"`
 }
 
 func NewRedisConfig() (*RedisConfig, error) {
@@ -16,6 +17,7 @@ func NewRedisConfig() (*RedisConfig, error) {
 	_ = viper.BindEnv("REDIS_PORT")
 	_ = viper.BindEnv("REDIS_PASSWORD")
 	_ = viper.BindEnv("REDIS_DB_AUTH")
+	_ = viper.BindEnv("REDIS_DB_EVENTS")
 
 	var redisConfig RedisConfig
 	if err := viper.Unmarshal(&redisConfig); err != nil {
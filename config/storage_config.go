@@ -0,0 +1,61 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// DefaultMaxUploadSize is used when STORAGE_MAX_UPLOAD_SIZE is not set (50 MiB).
+	DefaultMaxUploadSize int64 = 50 << 20
+	// DefaultMaxImportSize is used when STORAGE_MAX_IMPORT_SIZE is not set (1 GiB).
+	DefaultMaxImportSize int64 = 1 << 30
+	// DefaultS3RequestTimeout is used when STORAGE_S3_REQUEST_TIMEOUT is not set.
+	DefaultS3RequestTimeout = 5 * time.Minute
+)
+
+// StorageConfig selects the blob storage backend. A request to the S3 storage, reading the body included,
+// may not take longer than S3RequestTimeout.
+type StorageConfig struct {
+	Backend          string        `mapstructure:"STORAGE_BACKEND"`
+	LocalPath        string        `mapstructure:"STORAGE_LOCAL_PATH"`
+	S3Endpoint       string        `mapstructure:"STORAGE_S3_ENDPOINT"`
+	S3Region         string        `mapstructure:"STORAGE_S3_REGION"`
+	S3Bucket         string        `mapstructure:"STORAGE_S3_BUCKET"`
+	S3AccessKey      string        `mapstructure:"STORAGE_S3_ACCESS_KEY"`
+	S3SecretKey      string        `mapstructure:"STORAGE_S3_SECRET_KEY"`
+	S3RequestTimeout time.Duration `mapstructure:"STORAGE_S3_REQUEST_TIMEOUT"`
+	MaxUploadSize    int64         `mapstructure:"STORAGE_MAX_UPLOAD_SIZE"`
+	MaxImportSize    int64         `mapstructure:"STORAGE_MAX_IMPORT_SIZE"`
+}
+
+func NewStorageConfig() (*StorageConfig, error) {
+	viper.AutomaticEnv()
+
+	_ = viper.BindEnv("STORAGE_BACKEND")
+	_ = viper.BindEnv("STORAGE_LOCAL_PATH")
+	_ = viper.BindEnv("STORAGE_S3_ENDPOINT")
+	_ = viper.BindEnv("STORAGE_S3_REGION")
+	_ = viper.BindEnv("STORAGE_S3_BUCKET")
+	_ = viper.BindEnv("STORAGE_S3_ACCESS_KEY")
+	_ = viper.BindEnv("STORAGE_S3_SECRET_KEY")
+	_ = viper.BindEnv("STORAGE_S3_REQUEST_TIMEOUT")
+	_ = viper.BindEnv("STORAGE_MAX_UPLOAD_SIZE")
+	_ = viper.BindEnv("STORAGE_MAX_IMPORT_SIZE")
+
+	var storageConfig StorageConfig
+	if err := viper.Unmarshal(&storageConfig); err != nil {
+		return nil, err
+	}
+	if storageConfig.MaxUploadSize <= 0 {
+		storageConfig.MaxUploadSize = DefaultMaxUploadSize
+	}
+	if storageConfig.MaxImportSize <= 0 {
+		storageConfig.MaxImportSize = DefaultMaxImportSize
+	}
+	if storageConfig.S3RequestTimeout <= 0 {
+		storageConfig.S3RequestTimeout = DefaultS3RequestTimeout
+	}
+	return &storageConfig, nil
+}
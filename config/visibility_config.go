@@ -0,0 +1,39 @@
+package config
+
+import (
+	"errors"
+
+	"github.com/spf13/viper"
+)
+
+// DefaultRecordVisibility is used when RECORD_VISIBILITY is not set.
+const DefaultRecordVisibility = "global"
+
+var ErrInvalidRecordVisibility = errors.New("RECORD_VISIBILITY must be one of private, team or global")
+
+// VisibilityConfig configures who sees the archived telegram users and records.
+// Every collector observing one of them is recorded along with the Visibility of its observation:
+// private to the collector, shared with its team or global. The team of a collector is the one
+// of its platform user, managed through the teams API.
+type VisibilityConfig struct {
+	Visibility string `mapstructure:"RECORD_VISIBILITY"`
+}
+
+func NewVisibilityConfig() (*VisibilityConfig, error) {
+	viper.AutomaticEnv()
+
+	_ = viper.BindEnv("RECORD_VISIBILITY")
+
+	var visibilityConfig VisibilityConfig
+	if err := viper.Unmarshal(&visibilityConfig); err != nil {
+		return nil, err
+	}
+	switch visibilityConfig.Visibility {
+	case "":
+		visibilityConfig.Visibility = DefaultRecordVisibility
+	case "private", "team", "global":
+	default:
+		return nil, ErrInvalidRecordVisibility
+	}
+	return &visibilityConfig, nil
+}
@@ -0,0 +1,55 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// DefaultWebhookPollInterval is used when WEBHOOK_POLL_INTERVAL is not set.
+	DefaultWebhookPollInterval = 5 * time.Second
+	// DefaultWebhookBatchSize is used when WEBHOOK_BATCH_SIZE is not set.
+	DefaultWebhookBatchSize = 20
+	// DefaultWebhookMaxAttempts is used when WEBHOOK_MAX_ATTEMPTS is not set.
+	DefaultWebhookMaxAttempts = 10
+	// DefaultWebhookRequestTimeout is used when WEBHOOK_REQUEST_TIMEOUT is not set.
+	DefaultWebhookRequestTimeout = 10 * time.Second
+)
+
+// WebhookConfig tunes the delivery of the outgoing webhooks.
+// The queue is polled every PollInterval for at most BatchSize due deliveries, a delivery is given up on
+// after MaxAttempts failed attempts, an attempt may not take longer than RequestTimeout.
+type WebhookConfig struct {
+	PollInterval   time.Duration `mapstructure:"WEBHOOK_POLL_INTERVAL"`
+	BatchSize      int           `mapstructure:"WEBHOOK_BATCH_SIZE"`
+	MaxAttempts    int           `mapstructure:"WEBHOOK_MAX_ATTEMPTS"`
+	RequestTimeout time.Duration `mapstructure:"WEBHOOK_REQUEST_TIMEOUT"`
+}
+
+func NewWebhookConfig() (*WebhookConfig, error) {
+	viper.AutomaticEnv()
+
+	_ = viper.BindEnv("WEBHOOK_POLL_INTERVAL")
+	_ = viper.BindEnv("WEBHOOK_BATCH_SIZE")
+	_ = viper.BindEnv("WEBHOOK_MAX_ATTEMPTS")
+	_ = viper.BindEnv("WEBHOOK_REQUEST_TIMEOUT")
+
+	var webhookConfig WebhookConfig
+	if err := viper.Unmarshal(&webhookConfig); err != nil {
+		return nil, err
+	}
+	if webhookConfig.PollInterval <= 0 {
+		webhookConfig.PollInterval = DefaultWebhookPollInterval
+	}
+	if webhookConfig.BatchSize <= 0 {
+		webhookConfig.BatchSize = DefaultWebhookBatchSize
+	}
+	if webhookConfig.MaxAttempts <= 0 {
+		webhookConfig.MaxAttempts = DefaultWebhookMaxAttempts
+	}
+	if webhookConfig.RequestTimeout <= 0 {
+		webhookConfig.RequestTimeout = DefaultWebhookRequestTimeout
+	}
+	return &webhookConfig, nil
+}
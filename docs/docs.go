@@ -22,96 +22,96 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/v1/auth/login": {
-            "post": {
-                "description": "Authenticate a user with username and password, returns session token",
-                "consumes": [
-                    "application/json"
-                ],
+        "/v1/alerts": {
+            "get": {
+                "description": "List the alerts raised by the watchlists of the current user, newest first,\nalong with the amount of unread alerts",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "alert"
                 ],
-                "summary": "User login",
+                "summary": "Get alerts",
                 "parameters": [
                     {
-                        "description": "Login credentials",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.loginForm"
-                        }
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Only list unread alerts",
+                        "name": "unread",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 200,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Amount of alerts",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Amount of alerts to skip",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Login successful",
+                        "description": "Alerts retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/handlers.LoginResponse"
+                            "$ref": "#/definitions/handlers.GetTelegramAlertsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request (validation failed)",
-                        "schema": {
-                            "$ref": "#/definitions/internal_auth_presentation_v1_handlers.ErrorResponse"
-                        }
+                        "description": "Invalid filter or pagination"
                     },
-                    "401": {
-                        "description": "Invalid credentials",
-                        "schema": {
-                            "$ref": "#/definitions/internal_auth_presentation_v1_handlers.ErrorResponse"
-                        }
+                    "403": {
+                        "description": "Insufficient privileges"
                     },
                     "500": {
-                        "description": "Server error",
-                        "schema": {
-                            "$ref": "#/definitions/internal_auth_presentation_v1_handlers.ErrorResponse"
-                        }
+                        "description": "Internal server error"
                     }
                 }
             }
         },
-        "/v1/record/telegram": {
-            "post": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "description": "Creates a new telegram record with the provided message details",
+        "/v1/alerts/{alert_id}": {
+            "patch": {
+                "description": "Changes the read state of an alert of the current user",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "record"
+                    "alert"
                 ],
-                "summary": "Add a new telegram record",
+                "summary": "Mark an alert as read or unread",
                 "parameters": [
                     {
-                        "description": "Record details",
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Alert ID",
+                        "name": "alert_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Read state",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.AddTelegramRecordRequest"
+                            "$ref": "#/definitions/handlers.MarkTelegramAlertReadRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.AddTelegramRecordResponse"
-                        }
+                    "204": {
+                        "description": "Read state changed"
                     },
                     "400": {
-                        "description": "Invalid request format",
+                        "description": "Invalid alert ID or request format",
                         "schema": {
                             "type": "string"
                         }
@@ -122,14 +122,8 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
-                    "409": {
-                        "description": "Record already exists or user not found",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "422": {
-                        "description": "Record contains unprocessable fields",
+                    "404": {
+                        "description": "Alert not found",
                         "schema": {
                             "type": "string"
                         }
@@ -143,168 +137,181 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/record/telegram/identity": {
-            "post": {
-                "description": "Add new telegram identity",
-                "consumes": [
-                    "application/json"
-                ],
+        "/v1/annotations/tags": {
+            "get": {
+                "description": "Suggest the tags in use starting with the prefix, the most used first, to autocomplete them.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "record"
+                    "annotation"
                 ],
-                "summary": "Add new telegram identity",
+                "summary": "Get the tag catalogue",
                 "parameters": [
                     {
-                        "description": "Identity creation request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.AddTelegramIdentityRequest"
-                        }
+                        "type": "string",
+                        "description": "Start of the tags",
+                        "name": "prefix",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Amount of tags",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Identity created successfully",
+                    "200": {
+                        "description": "Tags retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/handlers.AddTelegramIdentityResponse"
+                            "$ref": "#/definitions/handlers.GetTelegramTagCatalogueResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request format",
-                        "schema": {
-                            "type": "string"
-                        }
+                        "description": "Invalid prefix or limit"
                     },
                     "403": {
-                        "description": "Insufficient privileges",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "409": {
-                        "description": "You have already added this identity",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "422": {
-                        "description": "Invalid request body",
-                        "schema": {
-                            "type": "string"
-                        }
+                        "description": "Insufficient privileges"
                     },
                     "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "type": "string"
-                        }
+                        "description": "Internal server error"
                     }
                 }
             }
         },
-        "/v1/record/telegram/user": {
-            "post": {
-                "description": "Add a new Telegram user by Telegram ID. This creates a record linking a Telegram user to the system.",
-                "consumes": [
-                    "application/json"
-                ],
+        "/v1/annotations/tags/{tag}": {
+            "get": {
+                "description": "List the telegram users, identities and records holding the tag, the latest tagged first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "record"
+                    "annotation"
                 ],
-                "summary": "Add a new Telegram user",
+                "summary": "Get tagged targets",
                 "parameters": [
                     {
-                        "description": "Telegram user request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.AddTelegramUserRequest"
-                        }
+                        "type": "string",
+                        "description": "Tag",
+                        "name": "tag",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "telegram_user",
+                            "telegram_identity",
+                            "telegram_record"
+                        ],
+                        "type": "string",
+                        "description": "Only list targets of the type",
+                        "name": "target_type",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 200,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Amount of targets",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Amount of targets to skip",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Telegram user added successfully",
+                    "200": {
+                        "description": "Tagged targets retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/handlers.AddTelegramUserResponse"
+                            "$ref": "#/definitions/handlers.GetTelegramTaggedTargetsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request format"
+                        "description": "Invalid tag, target type or pagination"
                     },
                     "403": {
                         "description": "Insufficient privileges"
                     },
-                    "409": {
-                        "description": "You have already added this user"
-                    },
-                    "422": {
-                        "description": "This user contains unprocessable fields"
-                    },
                     "500": {
                         "description": "Internal server error"
                     }
                 }
             }
         },
-        "/v1/record/telegram/{telegram_id}/records": {
+        "/v1/annotations/{target_type}/{target_id}": {
             "get": {
-                "description": "Get the latest Telegram records for a specific Telegram user ID.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the tags and the notes of a telegram user, identity or record, the oldest first.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "record"
+                    "annotation"
                 ],
-                "summary": "Get latest Telegram records",
+                "summary": "Get annotations",
                 "parameters": [
                     {
-                        "description": "Telegram ID request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.GetLatestTelegramRecordsByTelegramIDRequest"
-                        }
+                        "enum": [
+                            "telegram_user",
+                            "telegram_identity",
+                            "telegram_record"
+                        ],
+                        "type": "string",
+                        "description": "Target type",
+                        "name": "target_type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Target ID",
+                        "name": "target_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Latest records retrieved successfully",
+                        "description": "Annotations retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/handlers.GetLatestTelegramRecordsByTelegramIDResponse"
+                            "$ref": "#/definitions/handlers.GetTelegramAnnotationsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid telegram ID format"
+                        "description": "Invalid target",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "403": {
-                        "description": "Insufficient privileges"
-                    },
-                    "404": {
-                        "description": "Telegram ID not found"
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "500": {
-                        "description": "Internal server error"
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
         },
-        "/v1/users/": {
+        "/v1/annotations/{target_type}/{target_id}/notes": {
             "post": {
-                "description": "Create a new user with username, display name, password and role",
+                "description": "Writes a markdown note about a telegram user, identity or record on behalf of the current user.",
                 "consumes": [
                     "application/json"
                 ],
@@ -312,310 +319,5997 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "annotation"
                 ],
-                "summary": "Create a new user",
+                "summary": "Add a note",
                 "parameters": [
                     {
-                        "description": "User creation request",
+                        "enum": [
+                            "telegram_user",
+                            "telegram_identity",
+                            "telegram_record"
+                        ],
+                        "type": "string",
+                        "description": "Target type",
+                        "name": "target_type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Target ID",
+                        "name": "target_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Note details",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.createUserForm"
+                            "$ref": "#/definitions/handlers.AddTelegramNoteRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "User created successfully",
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handlers.CreateUserResponse"
+                            "$ref": "#/definitions/handlers.TelegramNoteResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body",
+                        "description": "Invalid request format",
                         "schema": {
-                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Target not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Note contains unprocessable fields",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                            "type": "string"
                         }
                     }
                 }
             }
         },
-        "/v1/users/{id}": {
-            "get": {
-                "description": "Retrieve a user's information by their ID",
-                "produces": [
-                    "application/json"
-                ],
+        "/v1/annotations/{target_type}/{target_id}/notes/{note_id}": {
+            "delete": {
+                "description": "Removes a note about a telegram user, identity or record.\nOnly the user who has written the note and the admins remove it.",
                 "tags": [
-                    "users"
+                    "annotation"
                 ],
-                "summary": "Get user by ID",
+                "summary": "Remove a note",
                 "parameters": [
+                    {
+                        "enum": [
+                            "telegram_user",
+                            "telegram_identity",
+                            "telegram_record"
+                        ],
+                        "type": "string",
+                        "description": "Target type",
+                        "name": "target_type",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "User ID (UUID)",
-                        "name": "id",
+                        "description": "Target ID",
+                        "name": "target_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Note ID",
+                        "name": "note_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "User found",
+                    "204": {
+                        "description": "Note removed"
+                    },
+                    "400": {
+                        "description": "Invalid target or note ID",
                         "schema": {
-                            "$ref": "#/definitions/handlers.GetUserResponse"
+                            "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Invalid user ID format",
+                    "403": {
+                        "description": "Insufficient privileges",
                         "schema": {
-                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                            "type": "string"
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "Note not found",
                         "schema": {
-                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Permanently remove a user from the system",
+            }
+        },
+        "/v1/annotations/{target_type}/{target_id}/tags": {
+            "post": {
+                "description": "Tags a telegram user, identity or record on behalf of the current user.\nA tag is made of at most 64 letters, digits, dashes and underscores.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "annotation"
                 ],
-                "summary": "Delete a user",
+                "summary": "Add a tag",
                 "parameters": [
+                    {
+                        "enum": [
+                            "telegram_user",
+                            "telegram_identity",
+                            "telegram_record"
+                        ],
+                        "type": "string",
+                        "description": "Target type",
+                        "name": "target_type",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "User ID (UUID)",
-                        "name": "id",
+                        "description": "Target ID",
+                        "name": "target_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Tag details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddTelegramTagRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "User deleted successfully",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handlers.SuccessResponse"
+                            "$ref": "#/definitions/handlers.TelegramTagResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid user ID format",
+                        "description": "Invalid request format or tag",
                         "schema": {
-                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "Target not found",
                         "schema": {
-                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "The target already has this tag",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Tag contains unprocessable fields",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Server error",
+                        "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                            "type": "string"
                         }
                     }
                 }
             }
         },
-        "/v1/users/{id}/demote": {
-            "patch": {
-                "description": "Change a user's role from admin to user",
-                "produces": [
-                    "application/json"
-                ],
+        "/v1/annotations/{target_type}/{target_id}/tags/{tag}": {
+            "delete": {
+                "description": "Removes a tag from a telegram user, identity or record.\nOnly the user who has added the tag and the admins remove it.",
                 "tags": [
-                    "users"
+                    "annotation"
                 ],
-                "summary": "Demote user to regular user",
+                "summary": "Remove a tag",
                 "parameters": [
+                    {
+                        "enum": [
+                            "telegram_user",
+                            "telegram_identity",
+                            "telegram_record"
+                        ],
+                        "type": "string",
+                        "description": "Target type",
+                        "name": "target_type",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "User ID (UUID)",
-                        "name": "id",
+                        "description": "Target ID",
+                        "name": "target_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tag",
+                        "name": "tag",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "User demoted successfully",
+                    "204": {
+                        "description": "Tag removed"
+                    },
+                    "400": {
+                        "description": "Invalid target",
                         "schema": {
-                            "$ref": "#/definitions/handlers.SuccessResponse"
+                            "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Invalid user ID format",
+                    "403": {
+                        "description": "Insufficient privileges",
                         "schema": {
-                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                            "type": "string"
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "Tag not found",
                         "schema": {
-                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                            "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Server error",
+                        "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                            "type": "string"
                         }
                     }
                 }
             }
         },
-        "/v1/users/{id}/promote": {
-            "patch": {
-                "description": "Change a user's role from user to admin",
+        "/v1/audit": {
+            "get": {
+                "security": [
+                    {
+                        "SessionCookie": []
+                    }
+                ],
+                "description": "List the changes made through the API, newest first. Admin only.\nThe log is returned as CSV when format=csv is passed or text/csv is accepted.",
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv"
                 ],
                 "tags": [
-                    "users"
+                    "audit"
                 ],
-                "summary": "Promote user to admin",
+                "summary": "Get audit log",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "User ID (UUID)",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Only list the changes of the user",
+                        "name": "actor_user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "user.promoted",
+                        "description": "Only list the action",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "user",
+                        "description": "Only list the changes of the targets of the type",
+                        "name": "target_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only list the changes of the target",
+                        "name": "target_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Only list the changes made since the time",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Only list the changes made before the time",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "description": "Response format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 10000,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Amount of entries",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Amount of entries to skip",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User promoted successfully",
+                        "description": "Audit log retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/handlers.SuccessResponse"
+                            "$ref": "#/definitions/handlers.GetAuditEntriesResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid user ID format",
+                        "description": "Invalid filter or pagination"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/auth/login": {
+            "post": {
+                "description": "Authenticate a user with username and password, returns session token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "User login",
+                "parameters": [
+                    {
+                        "description": "Login credentials",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                            "$ref": "#/definitions/handlers.loginForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Login successful",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LoginResponse"
                         }
                     },
-                    "404": {
-                        "description": "User not found",
+                    "400": {
+                        "description": "Invalid request (validation failed)",
                         "schema": {
-                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                            "$ref": "#/definitions/internal_auth_presentation_v1_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid credentials",
+                        "schema": {
+                            "$ref": "#/definitions/internal_auth_presentation_v1_handlers.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                            "$ref": "#/definitions/internal_auth_presentation_v1_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/bot/telegram/webhook": {
+            "post": {
+                "description": "Webhook for the Telegram Bot API, register it with setWebhook and the same secret_token\nas BOT_WEBHOOK_SECRET. Stores message, edited_message and channel_post updates as records of the\nconfigured service user, along with their chats, senders and sender identities.\nUpdates that can't be stored are acknowledged anyway, so that Telegram doesn't redeliver them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "bot"
+                ],
+                "summary": "Receive a Telegram Bot API update",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook secret token",
+                        "name": "X-Telegram-Bot-Api-Secret-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.TelegramBotUpdate"
                         }
                     }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Update acknowledged"
+                    },
+                    "400": {
+                        "description": "Invalid request format"
+                    },
+                    "401": {
+                        "description": "Invalid secret token"
+                    },
+                    "404": {
+                        "description": "Webhook is not configured"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/exports": {
+            "get": {
+                "description": "List the exports requested by the current user with their progress, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "export"
+                ],
+                "summary": "Get exports",
+                "parameters": [
+                    {
+                        "maximum": 200,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Amount of exports",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Amount of exports to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Exports retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetTelegramExportsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid pagination"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            },
+            "post": {
+                "description": "Queues an export of the records posted by any of the users or in any of the chats, along with\nthe identities of their authors and their attachments. The export is built in the background:\npoll it until it's completed, then download a ZIP holding the data as JSON and CSV,\nthe attachments, a SHA-256 manifest and its detached Ed25519 signature.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "export"
+                ],
+                "summary": "Request an export",
+                "parameters": [
+                    {
+                        "description": "Selection",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RequestTelegramExportRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.TelegramExportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Selection contains unprocessable fields",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "503": {
+                        "description": "Exports are disabled",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/exports/{export_id}": {
+            "get": {
+                "description": "Get the status and the progress of an export. Admins may read the exports of any user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "export"
+                ],
+                "summary": "Get an export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Export ID",
+                        "name": "export_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Export retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.TelegramExportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid export ID format"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "404": {
+                        "description": "Export not found"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/exports/{export_id}/download": {
+            "get": {
+                "description": "Streams the ZIP of a completed export. The SHA-256 of the archive and the signature of its\nmanifest are also sent as the X-Export-SHA256 and X-Export-Signature headers.",
+                "produces": [
+                    "application/zip"
+                ],
+                "tags": [
+                    "export"
+                ],
+                "summary": "Download an export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Export ID",
+                        "name": "export_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Export bundle",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid export ID format"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "404": {
+                        "description": "Export not found"
+                    },
+                    "409": {
+                        "description": "Export has not been completed"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/investigations": {
+            "get": {
+                "description": "List the investigations the current user is a member of with their members, the newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "investigation"
+                ],
+                "summary": "Get investigations",
+                "responses": {
+                    "200": {
+                        "description": "Investigations retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetTelegramInvestigationsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            },
+            "post": {
+                "description": "Opens an investigation grouping telegram users, chats and records, the current user becomes\nits owner. An investigation is only visible to its members.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "investigation"
+                ],
+                "summary": "Open an investigation",
+                "parameters": [
+                    {
+                        "description": "Investigation details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddTelegramInvestigationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddTelegramInvestigationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Investigation contains unprocessable fields",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/investigations/{investigation_id}": {
+            "get": {
+                "description": "Get an investigation with its members and the annotated items attached to it.\nInvestigations the current user isn't a member of are not found.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "investigation"
+                ],
+                "summary": "Get an investigation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Investigation ID",
+                        "name": "investigation_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Investigation retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetTelegramInvestigationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid investigation ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Investigation not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes an investigation owned by the current user along with its members and items,\nthe telegram data it refers to is kept",
+                "tags": [
+                    "investigation"
+                ],
+                "summary": "Remove an investigation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Investigation ID",
+                        "name": "investigation_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Investigation removed"
+                    },
+                    "400": {
+                        "description": "Invalid investigation ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Investigation not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/investigations/{investigation_id}/items": {
+            "post": {
+                "description": "Attaches a telegram user, chat or record with a note to an investigation.\nOnly the owners and the editors of the investigation attach items.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "investigation"
+                ],
+                "summary": "Attach an investigation item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Investigation ID",
+                        "name": "investigation_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Item details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddTelegramInvestigationItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddTelegramInvestigationItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Investigation or target not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "The investigation already has this item",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Item contains unprocessable fields",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/investigations/{investigation_id}/items/{item_id}": {
+            "delete": {
+                "description": "Detaches an item from an investigation along with its note.\nOnly the owners and the editors of the investigation detach items.",
+                "tags": [
+                    "investigation"
+                ],
+                "summary": "Detach an investigation item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Investigation ID",
+                        "name": "investigation_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Item ID",
+                        "name": "item_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Item detached"
+                    },
+                    "400": {
+                        "description": "Invalid investigation or item ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Investigation or item not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/investigations/{investigation_id}/members/{user_id}": {
+            "put": {
+                "description": "Adds a platform user to an investigation or changes the role of a member. Owners manage\nthe members and remove the investigation, editors attach and detach items, viewers only read.\nOnly the owners of the investigation and the admins manage its members, the last owner\ncan't be demoted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "investigation"
+                ],
+                "summary": "Add or change an investigation member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Investigation ID",
+                        "name": "investigation_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Member role",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SaveTelegramInvestigationMemberRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role of the member changed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.TelegramInvestigationMemberResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Member added",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.TelegramInvestigationMemberResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Investigation not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "The investigation must keep an owner",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Member contains unprocessable fields",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes a user from an investigation. The owners and the admins remove any member,\nevery member may leave, but the last owner can't.",
+                "tags": [
+                    "investigation"
+                ],
+                "summary": "Remove an investigation member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Investigation ID",
+                        "name": "investigation_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Member removed"
+                    },
+                    "400": {
+                        "description": "Invalid investigation or user ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Investigation or member not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "The investigation must keep an owner",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/provenance/{target_type}/{target_id}": {
+            "get": {
+                "description": "Get the collectors who have archived a telegram user or record, the earliest first.\nOnly the observations visible to the caller are returned: the global ones, its own ones\nand the team ones of its teammates. Admins see all of them.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "provenance"
+                ],
+                "summary": "Get provenance",
+                "parameters": [
+                    {
+                        "enum": [
+                            "telegram_user",
+                            "telegram_record"
+                        ],
+                        "type": "string",
+                        "description": "Target type",
+                        "name": "target_type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Target ID",
+                        "name": "target_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Provenance retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetTelegramProvenanceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid target",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Target not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/provenance/{target_type}/{target_id}/visibility": {
+            "put": {
+                "description": "Changes the visibility of the caller's observation of a telegram user or record: private\nis only seen by the caller, team by its teammates and global by everyone.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "provenance"
+                ],
+                "summary": "Set observation visibility",
+                "parameters": [
+                    {
+                        "enum": [
+                            "telegram_user",
+                            "telegram_record"
+                        ],
+                        "type": "string",
+                        "description": "Target type",
+                        "name": "target_type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Target ID",
+                        "name": "target_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Visibility",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetTelegramObservationVisibilityRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Visibility changed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.TelegramObservationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "The caller hasn't observed the target",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Creates a new telegram record with the provided message details.\nA resubmission of a stored message with a different text adds a new version to its edit history\nand responds with 200 and \"revised\": true.\nAn unchanged message stored by another collector is only observed by the caller, with its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Add a new telegram record",
+                "parameters": [
+                    {
+                        "description": "Record details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddTelegramRecordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "New version of a stored record",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddTelegramRecordResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddTelegramRecordResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Record already exists unchanged or user not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Record contains unprocessable fields",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/attachment/{attachment_id}": {
+            "get": {
+                "description": "Streams the content of the attachment as a file download.",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Download an attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Attachment ID",
+                        "name": "attachment_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Attachment content",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid attachment ID format"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "404": {
+                        "description": "Attachment not found"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/chain/verification": {
+            "get": {
+                "description": "Walk the hash chains linking the archived records, identities and attachments of every collector\nand report where they break: a removed, inserted or altered entry, a subject altered or removed\nafter its latest entry, or a chain differing from its signed checkpoints. The subjects archived\nbefore the chains were introduced are counted as unchained. Admin only.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Verify the telegram chains",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Verify the chain of this user only",
+                        "name": "collector",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Chains verified, see intact",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.VerifyTelegramChainResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid collector ID"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "404": {
+                        "description": "No chain for this collector"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/chat": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Adds a telegram chat on the first submission and a new snapshot of its metadata on the following ones",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Add a telegram chat",
+                "parameters": [
+                    {
+                        "description": "Chat details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddTelegramChatRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddTelegramChatResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "You have already added this chat snapshot",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Chat contains unprocessable fields",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/chat/import": {
+            "post": {
+                "description": "Accepts either a bare result.json or a ZIP of the whole export folder, in which case\nthe media are imported as attachments. With \"Accept: application/x-ndjson\" the progress\nis streamed line by line and the last line is the summary.",
+                "consumes": [
+                    "application/json",
+                    "application/zip"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Import a Telegram Desktop chat export",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ImportTelegramExportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid export",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "Export is too large",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/chat/{chat_telegram_id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get a Telegram chat with all snapshots of its metadata.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Get Telegram chat",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Chat Telegram ID",
+                        "name": "chat_telegram_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Chat retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetTelegramChatResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid chat ID format"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "404": {
+                        "description": "Chat not found"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/chat/{chat_telegram_id}/activity": {
+            "get": {
+                "description": "Get the activity heatmap and statistics of a Telegram chat: message counts by day and by hour\nof the week, the most active senders, the average message length, first and last seen and the\nlongest gaps in activity. Days and hours are counted in the given IANA timezone.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Get the activity of a chat",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Chat Telegram ID",
+                        "name": "chat_telegram_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "UTC",
+                        "description": "IANA timezone",
+                        "name": "timezone",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Statistics retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetTelegramActivityStatisticsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid chat ID or timezone"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/chat/{chat_telegram_id}/forwards": {
+            "get": {
+                "description": "List the latest 100 records forwarded from a group or a channel,\noptionally narrowed down to a single message of it.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "List records forwarded from a chat",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Chat Telegram ID",
+                        "name": "chat_telegram_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Original message Telegram ID",
+                        "name": "message_telegram_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Records retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetTelegramRecordsForwardedFromResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid chat or message ID format"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/correlations": {
+            "get": {
+                "description": "List the clusters of different Telegram users whose identities share a phone number, a username\nor a bio, with the IDs of the identities the value was seen in as evidence. Phone numbers are\ncompared by their digits, usernames and bios ignoring case and, for bios, whitespace.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Get correlated users",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "phone",
+                                "username",
+                                "bio"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Correlation types, all by default",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Keep the clusters of the user",
+                        "name": "telegram_id",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Amount of clusters",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Amount of clusters to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Clusters retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetTelegramCorrelationClustersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid type, user ID or pagination"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/identity": {
+            "post": {
+                "description": "Add new telegram identity",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Add new telegram identity",
+                "parameters": [
+                    {
+                        "description": "Identity creation request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddTelegramIdentityRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Identity created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddTelegramIdentityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "You have already added this identity",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/indicators/records": {
+            "get": {
+                "description": "List the latest 100 records mentioning an indicator. The value may be written in any form,\ne.g. \"+1 (555) 123-4567\" or \"@Username\", it's normalised before the lookup.\nEvery matching record is streamed as CSV or NDJSON when format is csv or ndjson,\nor when text/csv or application/x-ndjson is accepted.",
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Find records by indicator",
+                "parameters": [
+                    {
+                        "enum": [
+                            "phone",
+                            "email",
+                            "url",
+                            "mention",
+                            "hashtag",
+                            "iban",
+                            "crypto_wallet"
+                        ],
+                        "type": "string",
+                        "description": "Indicator type",
+                        "name": "type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Indicator value",
+                        "name": "value",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Response format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only list records holding the tag",
+                        "name": "tag",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Records retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetTelegramRecordsByIndicatorResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid indicator, format or tag"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/ingest": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Accepts newline delimited JSON, each line is {\"type\": \"user\"|\"identity\"|\"record\", \"data\": {...}}.\nUser lines carry AddTelegramUserRequest, identity lines IngestTelegramIdentity\nand record lines IngestTelegramRecord.\nLines are committed in chunks and acknowledged with IngestTelegramStreamAck as soon as their chunk\nis committed, so the client may send the next lines while reading the acks.\nFailed lines are acknowledged with one of the error codes: invalid_line, validation_failed,\nuser_already_exists, identity_already_exists, record_already_exists, user_not_found, chat_not_found.",
+                "consumes": [
+                    "application/x-ndjson"
+                ],
+                "produces": [
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Ingest a stream of telegram entities",
+                "parameters": [
+                    {
+                        "description": "Stream of lines",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.IngestTelegramStreamLine"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.IngestTelegramStreamAck"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/profile_picture/{profile_picture_id}": {
+            "get": {
+                "description": "Streams the image of the profile picture.",
+                "produces": [
+                    "image/jpeg",
+                    "image/png",
+                    "image/webp"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Download a profile picture",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Profile picture ID",
+                        "name": "profile_picture_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Image content",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid profile picture ID format"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "404": {
+                        "description": "Profile picture not found"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/record/{record_id}/attachment": {
+            "post": {
+                "description": "Uploads a file and links it to the record. The MIME type is sniffed from the content.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Upload a record attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Record ID",
+                        "name": "record_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Telegram ID of the file",
+                        "name": "telegram_attachment_id",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Attachment content",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddTelegramAttachmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Attachment already exists or record not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "File is too large",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Attachment contains unprocessable fields",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/record/{record_id}/attachments": {
+            "get": {
+                "description": "Get metadata of all files attached to the record.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "List record attachments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Record ID",
+                        "name": "record_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Attachments retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetTelegramAttachmentsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid record ID format"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/record/{record_id}/deletion": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Marks the message as deleted in Telegram, the archived content is kept.\ndeleted_at defaults to now, a record that is already marked keeps the first deletion time.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Mark record deleted in Telegram",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Record ID",
+                        "name": "record_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Deletion details",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.MarkTelegramRecordDeletedRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.MarkTelegramRecordDeletedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Record not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/record/{record_id}/history": {
+            "get": {
+                "description": "Get all known versions of the message, the oldest first and the current one last.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Get record edit history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Record ID",
+                        "name": "record_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "History retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetTelegramRecordHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid record ID format"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "404": {
+                        "description": "Record not found"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/record/{record_id}/thread": {
+            "get": {
+                "description": "Get the reply thread the record belongs to: the message the chain of replies starts with\nand all the replies to it, in the order they have been posted. Up to 1000 records are returned.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Get record reply thread",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Record ID",
+                        "name": "record_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Thread retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetTelegramRecordThreadResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid record ID format"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "404": {
+                        "description": "Record not found"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/records:batch": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Adds up to INGEST_BATCH_SIZE records (500 by default) in one transaction.\nRecords that can't be added are reported per item\nwith one of the error codes: validation_failed, record_already_exists, user_not_found, chat_not_found.\nStored messages resubmitted with a different text are added as new versions and reported as revised.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Add telegram records in bulk",
+                "parameters": [
+                    {
+                        "description": "Records",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddTelegramRecordsBatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddTelegramRecordsBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "Too many records in the batch or the batch is too large",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/user": {
+            "post": {
+                "description": "Add a new Telegram user by Telegram ID. This creates a record linking a Telegram user to the system.\nA user already added by another collector is stored once, the caller observes it and gets its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Add a new Telegram user",
+                "parameters": [
+                    {
+                        "description": "Telegram user request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddTelegramUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Telegram user added successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddTelegramUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "409": {
+                        "description": "You have already added this user"
+                    },
+                    "422": {
+                        "description": "This user contains unprocessable fields"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/user/{telegram_id}/activity": {
+            "get": {
+                "description": "Get the activity heatmap and statistics of a Telegram user: message counts by day and by hour\nof the week, the most active chats, the average message length, first and last seen and the\nlongest gaps in activity. Days and hours are counted in the given IANA timezone.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Get the activity of a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Telegram ID",
+                        "name": "telegram_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "UTC",
+                        "description": "IANA timezone",
+                        "name": "timezone",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Statistics retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetTelegramActivityStatisticsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID or timezone"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/user/{telegram_id}/forwards": {
+            "get": {
+                "description": "List the latest 100 records forwarded from a Telegram user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "List records forwarded from a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Telegram ID",
+                        "name": "telegram_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Records retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetTelegramRecordsForwardedFromResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID format"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/user/{telegram_id}/identities": {
+            "get": {
+                "description": "Get every identity of the user as added by any collector, the oldest first. The identities are\nstreamed as they're read, as CSV or NDJSON when format is csv or ndjson, or when text/csv\nor application/x-ndjson is accepted.",
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Get identity history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Telegram user ID",
+                        "name": "telegram_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Response format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only list identities holding the tag",
+                        "name": "tag",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Identities retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetTelegramIdentityHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid telegram ID, format or tag"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/user/{telegram_id}/indicators": {
+            "get": {
+                "description": "List the phone numbers, emails, URLs, mentions, hashtags, IBANs and crypto wallets\nmentioned in the records sent by a Telegram user, the most mentioned first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "List indicators of a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Telegram ID",
+                        "name": "telegram_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Indicators retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetTelegramUserIndicatorsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID format"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/user/{telegram_id}/profile_picture": {
+            "post": {
+                "description": "Adds an avatar to the history of the telegram user. Only images are accepted.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Upload a profile picture",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Telegram ID",
+                        "name": "telegram_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "When the avatar has been set, RFC 3339",
+                        "name": "posted_at",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Image content",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddTelegramProfilePictureResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Profile picture already exists or user not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "File is too large",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Profile picture contains unprocessable fields",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/user/{telegram_id}/profile_pictures": {
+            "get": {
+                "description": "Get the avatar history of the telegram user, the newest first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "List profile pictures",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Telegram ID",
+                        "name": "telegram_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Profile pictures retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetTelegramProfilePicturesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid telegram ID format"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/{telegram_id}/graph": {
+            "get": {
+                "description": "Get the users a Telegram user has replied to, mentioned or shared a chat with, up to depth\ninteractions away, and the interactions between them. Edges are weighted by the amount\nof interactions. The graph is returned in the JSON Graph Format by default, GraphML and GEXF\nfor Gephi are selected with the format parameter or the Accept header.",
+                "produces": [
+                    "application/json",
+                    "application/graphml+xml",
+                    "application/gexf+xml"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Get the interaction graph of a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Telegram ID",
+                        "name": "telegram_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 3,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 2,
+                        "description": "Depth of the graph",
+                        "name": "depth",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "graphml",
+                            "gexf"
+                        ],
+                        "type": "string",
+                        "description": "Export format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Graph retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetTelegramInteractionGraphResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID, depth or format"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/record/telegram/{telegram_id}/records": {
+            "get": {
+                "description": "Get the latest Telegram records for a specific Telegram user ID.\nEvery record of the user, the latest first, is streamed as CSV or NDJSON when format is csv\nor ndjson, or when text/csv or application/x-ndjson is accepted. The user is then read from the path.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "record"
+                ],
+                "summary": "Get latest Telegram records",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Telegram user ID",
+                        "name": "telegram_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Response format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only list records holding the tag",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Telegram ID request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetLatestTelegramRecordsByTelegramIDRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Latest records retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetLatestTelegramRecordsByTelegramIDResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid telegram ID, format or tag"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "404": {
+                        "description": "Telegram ID not found"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/v1/teams/": {
+            "get": {
+                "description": "Retrieve every team sharing the deployment, ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "List teams",
+                "responses": {
+                    "200": {
+                        "description": "Teams",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handlers.GetTeamResponse"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a team sharing the deployment. The users that join it only see the data collected\nwithin the team.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Create a new team",
+                "parameters": [
+                    {
+                        "description": "Team creation request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.createTeamForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Team created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateTeamResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Team already exists",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/teams/{id}": {
+            "delete": {
+                "description": "Remove a team no user has ever joined, the removed users still belong to their team",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Delete a team",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Team ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Team deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid team ID format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Team still has users",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/": {
+            "post": {
+                "description": "Create a new user with username, display name, password and role.\nThe user may join a team, which can't be changed later: the users only see the data\ncollected within their team, the users without a team share the data collected before the teams.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Create a new user",
+                "parameters": [
+                    {
+                        "description": "User creation request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.createUserForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "User created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{id}": {
+            "get": {
+                "description": "Retrieve a user's information by their ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Permanently remove a user from the system",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Delete a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{id}/demote": {
+            "patch": {
+                "description": "Change a user's role from admin to user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Demote user to regular user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User demoted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{id}/promote": {
+            "patch": {
+                "description": "Change a user's role from user to admin",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Promote user to admin",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User promoted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_user_presentation_v1_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/watchlists": {
+            "get": {
+                "description": "List the watchlists of the current user with their entries",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "watchlist"
+                ],
+                "summary": "Get watchlists",
+                "responses": {
+                    "200": {
+                        "description": "Watchlists retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetTelegramWatchlistsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a watchlist of the current user. Its entries are matched against the records\nand identities added afterwards, raising alerts for the user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "watchlist"
+                ],
+                "summary": "Create a watchlist",
+                "parameters": [
+                    {
+                        "description": "Watchlist details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddTelegramWatchlistRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddTelegramWatchlistResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Watchlist contains unprocessable fields",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/watchlists/{watchlist_id}": {
+            "delete": {
+                "description": "Removes a watchlist of the current user along with its entries and their alerts",
+                "tags": [
+                    "watchlist"
+                ],
+                "summary": "Remove a watchlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Watchlist ID",
+                        "name": "watchlist_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Watchlist removed"
+                    },
+                    "400": {
+                        "description": "Invalid watchlist ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Watchlist not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/watchlists/{watchlist_id}/entries": {
+            "post": {
+                "description": "Adds a Telegram ID, a username, a phone number or a keyword to a watchlist of the current user.\nThe value may be written in any form, e.g. \"@Username\" or \"+1 (555) 123-4567\", it's normalised\nthe way records and identities are matched. Keywords are matched ignoring case and repeated whitespace.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "watchlist"
+                ],
+                "summary": "Add a watchlist entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Watchlist ID",
+                        "name": "watchlist_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Entry details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddTelegramWatchlistEntryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddTelegramWatchlistEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format or entry value",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Watchlist not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "The watchlist already has this entry",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/watchlists/{watchlist_id}/entries/{entry_id}": {
+            "delete": {
+                "description": "Removes an entry of a watchlist of the current user along with its alerts",
+                "tags": [
+                    "watchlist"
+                ],
+                "summary": "Remove a watchlist entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Watchlist ID",
+                        "name": "watchlist_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Entry ID",
+                        "name": "entry_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Entry removed"
+                    },
+                    "400": {
+                        "description": "Invalid watchlist or entry ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Watchlist or entry not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/webhooks": {
+            "get": {
+                "description": "List the webhook subscriptions, without their secrets",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Get webhook subscriptions",
+                "responses": {
+                    "200": {
+                        "description": "Subscriptions retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetWebhookSubscriptionsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            },
+            "post": {
+                "description": "Subscribes an endpoint to events. Each event is POSTed to it as JSON with the X-Trinity-Event,\nX-Trinity-Delivery and X-Trinity-Timestamp headers, and X-Trinity-Signature set to\n\"sha256=\" followed by the hex HMAC-SHA256 of \"\u003ctimestamp\u003e.\u003cbody\u003e\" keyed with the secret.\nDeliveries not answered with 2xx are retried with an exponential backoff.\nEvent types: telegram_record.created, telegram_identity.created, user.created, user.promoted,\nuser.demoted, user.removed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Add a webhook subscription",
+                "parameters": [
+                    {
+                        "description": "Subscription details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddWebhookSubscriptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddWebhookSubscriptionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format, URL, event types or secret",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/webhooks/deliveries/{delivery_id}/redeliver": {
+            "post": {
+                "description": "Queues the payload of a delivery again as a new delivery, which is sent right away.\nThe original delivery is kept in the log as it is.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Redeliver a webhook delivery",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Delivery ID",
+                        "name": "delivery_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RedeliverWebhookDeliveryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid delivery ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Delivery not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/webhooks/{subscription_id}": {
+            "delete": {
+                "description": "Removes a webhook subscription along with its deliveries, the pending ones are never sent",
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Remove a webhook subscription",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Subscription ID",
+                        "name": "subscription_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Subscription removed"
+                    },
+                    "400": {
+                        "description": "Invalid subscription ID format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient privileges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Subscription not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/webhooks/{subscription_id}/deliveries": {
+            "get": {
+                "description": "The delivery log of a webhook subscription, newest first, with the outcome of the last attempt\nof every delivery. The next attempt time is only set for the pending deliveries.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Get webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Subscription ID",
+                        "name": "subscription_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "succeeded",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "Delivery status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Amount of deliveries",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Amount of deliveries to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deliveries retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetWebhookDeliveriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid subscription ID, status or pagination"
+                    },
+                    "403": {
+                        "description": "Insufficient privileges"
+                    },
+                    "404": {
+                        "description": "Subscription not found"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "domain.TelegramForwardOrigin": {
+            "type": "object",
+            "properties": {
+                "fromChatTelegramID": {
+                    "type": "integer",
+                    "format": "int64"
+                },
+                "fromUserTelegramID": {
+                    "type": "integer"
+                },
+                "messageTelegramID": {
+                    "type": "integer"
+                },
+                "postedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.TelegramMessageEntity": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "customEmojiID": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "language": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "length": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "type": {
+                    "enum": [
+                        "mention",
+                        "hashtag",
+                        "cashtag",
+                        "bot_command",
+                        "url",
+                        "email",
+                        "phone_number",
+                        "bold",
+                        "italic",
+                        "underline",
+                        "strikethrough",
+                        "spoiler",
+                        "blockquote",
+                        "expandable_blockquote",
+                        "code",
+                        "pre",
+                        "text_link",
+                        "text_mention",
+                        "custom_emoji"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.TelegramMessageEntityType"
+                        }
+                    ]
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 2048
+                },
+                "userTelegramID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.TelegramMessageEntityType": {
+            "type": "string",
+            "enum": [
+                "mention",
+                "hashtag",
+                "cashtag",
+                "bot_command",
+                "url",
+                "email",
+                "phone_number",
+                "bold",
+                "italic",
+                "underline",
+                "strikethrough",
+                "spoiler",
+                "blockquote",
+                "expandable_blockquote",
+                "code",
+                "pre",
+                "text_link",
+                "text_mention",
+                "custom_emoji"
+            ],
+            "x-enum-varnames": [
+                "TelegramMessageEntityTypeMention",
+                "TelegramMessageEntityTypeHashtag",
+                "TelegramMessageEntityTypeCashtag",
+                "TelegramMessageEntityTypeBotCommand",
+                "TelegramMessageEntityTypeURL",
+                "TelegramMessageEntityTypeEmail",
+                "TelegramMessageEntityTypePhoneNumber",
+                "TelegramMessageEntityTypeBold",
+                "TelegramMessageEntityTypeItalic",
+                "TelegramMessageEntityTypeUnderline",
+                "TelegramMessageEntityTypeStrikethrough",
+                "TelegramMessageEntityTypeSpoiler",
+                "TelegramMessageEntityTypeBlockquote",
+                "TelegramMessageEntityTypeExpandableBlockquote",
+                "TelegramMessageEntityTypeCode",
+                "TelegramMessageEntityTypePre",
+                "TelegramMessageEntityTypeTextLink",
+                "TelegramMessageEntityTypeTextMention",
+                "TelegramMessageEntityTypeCustomEmoji"
+            ]
+        },
+        "domain.TelegramRecord": {
+            "type": "object",
+            "required": [
+                "addedAt",
+                "addedByUser",
+                "fromTelegramUserID",
+                "id",
+                "inTelegramChatID",
+                "messageTelegramID",
+                "postedAt"
+            ],
+            "properties": {
+                "addedAt": {
+                    "type": "string"
+                },
+                "addedByUser": {
+                    "type": "string"
+                },
+                "caption": {
+                    "type": "string",
+                    "maxLength": 1024
+                },
+                "captionEntities": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "items": {
+                        "$ref": "#/definitions/domain.TelegramMessageEntity"
+                    }
+                },
+                "deletedAt": {
+                    "type": "string"
+                },
+                "editedAt": {
+                    "type": "string"
+                },
+                "entities": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "items": {
+                        "$ref": "#/definitions/domain.TelegramMessageEntity"
+                    }
+                },
+                "forwardOrigin": {
+                    "$ref": "#/definitions/domain.TelegramForwardOrigin"
+                },
+                "fromTelegramUserID": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "inTelegramChatID": {
+                    "type": "integer"
+                },
+                "messageTelegramID": {
+                    "type": "integer"
+                },
+                "messageText": {
+                    "type": "string",
+                    "maxLength": 4096
+                },
+                "postedAt": {
+                    "type": "string"
+                },
+                "replyToMessageTelegramID": {
+                    "type": "integer"
+                },
+                "threadTelegramID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.AddTelegramAttachmentResponse": {
+            "type": "object",
+            "properties": {
+                "attachment_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "file_size": {
+                    "type": "integer",
+                    "example": 102400
+                },
+                "mime_type": {
+                    "type": "string",
+                    "example": "image/jpeg"
+                },
+                "sha256": {
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                }
+            }
+        },
+        "handlers.AddTelegramChatRequest": {
+            "type": "object",
+            "properties": {
+                "chat_telegram_id": {
+                    "type": "integer",
+                    "example": -1001234567890
+                },
+                "chat_type": {
+                    "type": "string",
+                    "enum": [
+                        "private",
+                        "group",
+                        "supergroup",
+                        "channel"
+                    ],
+                    "example": "supergroup"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Public Trinity chat"
+                },
+                "member_count": {
+                    "type": "integer",
+                    "example": 1337
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Trinity discussion"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "trinity_chat"
+                }
+            }
+        },
+        "handlers.AddTelegramChatResponse": {
+            "type": "object",
+            "properties": {
+                "chat_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "snapshot_id": {
+                    "type": "string",
+                    "example": "9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"
+                }
+            }
+        },
+        "handlers.AddTelegramIdentityRequest": {
+            "type": "object",
+            "properties": {
+                "telegram_bio": {
+                    "type": "string",
+                    "example": "Hi! I am using Whatsapp"
+                },
+                "telegram_first_name": {
+                    "type": "string",
+                    "example": "John"
+                },
+                "telegram_id": {
+                    "type": "string",
+                    "example": "20d8a06c-2fac-4643-ba78-7da267a7fe51"
+                },
+                "telegram_last_name": {
+                    "type": "string",
+                    "example": "Doe"
+                },
+                "telegram_phone_number": {
+                    "type": "string",
+                    "example": "+11234567890 (Use e164 format)"
+                },
+                "telegram_username": {
+                    "type": "string",
+                    "example": "user1235"
+                }
+            }
+        },
+        "handlers.AddTelegramIdentityResponse": {
+            "type": "object",
+            "properties": {
+                "record_id": {
+                    "type": "string",
+                    "example": "28736582143"
+                }
+            }
+        },
+        "handlers.AddTelegramInvestigationItemRequest": {
+            "type": "object",
+            "properties": {
+                "note": {
+                    "type": "string",
+                    "example": "Posts the wallet address of the giveaway"
+                },
+                "target_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "telegram_user",
+                        "telegram_chat",
+                        "telegram_record"
+                    ],
+                    "example": "telegram_record"
+                }
+            }
+        },
+        "handlers.AddTelegramInvestigationItemResponse": {
+            "type": "object",
+            "properties": {
+                "item_id": {
+                    "type": "string",
+                    "example": "9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"
+                }
+            }
+        },
+        "handlers.AddTelegramInvestigationRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Fake giveaway channels"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Crypto giveaway scam"
+                }
+            }
+        },
+        "handlers.AddTelegramInvestigationResponse": {
+            "type": "object",
+            "properties": {
+                "investigation_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "handlers.AddTelegramNoteRequest": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "example": "Runs the **fake giveaway** channels"
+                }
+            }
+        },
+        "handlers.AddTelegramProfilePictureResponse": {
+            "type": "object",
+            "properties": {
+                "mime_type": {
+                    "type": "string",
+                    "example": "image/jpeg"
+                },
+                "profile_picture_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "sha256": {
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b"
+                }
+            }
+        },
+        "handlers.AddTelegramRecordRequest": {
+            "type": "object",
+            "properties": {
+                "caption": {
+                    "type": "string",
+                    "example": "Look at this"
+                },
+                "caption_entities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramMessageEntity"
+                    }
+                },
+                "edited_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:35:00Z"
+                },
+                "entities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramMessageEntity"
+                    }
+                },
+                "forward_origin": {
+                    "$ref": "#/definitions/handlers.TelegramForwardOrigin"
+                },
+                "from_user_telegram_id": {
+                    "type": "string",
+                    "example": "cf6e273b-ac6e-43f1-abba-d8009ffc1b3f"
+                },
+                "in_telegram_chat_id": {
+                    "type": "integer",
+                    "example": 123456789
+                },
+                "message_telegram_id": {
+                    "type": "integer",
+                    "example": 28736582143
+                },
+                "message_text": {
+                    "type": "string",
+                    "example": "Hello world!"
+                },
+                "posted_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "reply_to_message_telegram_id": {
+                    "type": "integer",
+                    "example": 28736582140
+                },
+                "thread_telegram_id": {
+                    "type": "integer",
+                    "example": 28736582100
+                }
+            }
+        },
+        "handlers.AddTelegramRecordResponse": {
+            "type": "object",
+            "properties": {
+                "record_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "revised": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "handlers.AddTelegramRecordsBatchRequest": {
+            "type": "object",
+            "properties": {
+                "records": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.AddTelegramRecordRequest"
+                    }
+                }
+            }
+        },
+        "handlers.AddTelegramRecordsBatchResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.AddTelegramRecordsBatchResult"
+                    }
+                }
+            }
+        },
+        "handlers.AddTelegramRecordsBatchResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "record_already_exists"
+                },
+                "index": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "record_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "revised": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "handlers.AddTelegramTagRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "scammer"
+                }
+            }
+        },
+        "handlers.AddTelegramUserRequest": {
+            "type": "object",
+            "properties": {
+                "telegram_id": {
+                    "type": "integer",
+                    "example": 28736582143
+                }
+            }
+        },
+        "handlers.AddTelegramUserResponse": {
+            "type": "object",
+            "properties": {
+                "record_id": {
+                    "type": "string",
+                    "example": "28736582143"
+                }
+            }
+        },
+        "handlers.AddTelegramWatchlistEntryRequest": {
+            "type": "object",
+            "properties": {
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "telegram_id",
+                        "username",
+                        "phone",
+                        "keyword"
+                    ],
+                    "example": "phone"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "+1 (555) 123-4567"
+                }
+            }
+        },
+        "handlers.AddTelegramWatchlistEntryResponse": {
+            "type": "object",
+            "properties": {
+                "entry_id": {
+                    "type": "string",
+                    "example": "9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "+15551234567"
+                }
+            }
+        },
+        "handlers.AddTelegramWatchlistRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Fraud suspects"
+                }
+            }
+        },
+        "handlers.AddTelegramWatchlistResponse": {
+            "type": "object",
+            "properties": {
+                "watchlist_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "handlers.AddWebhookSubscriptionRequest": {
+            "type": "object",
+            "properties": {
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "telegram_record.created",
+                        "user.demoted"
+                    ]
+                },
+                "secret": {
+                    "type": "string",
+                    "example": "7d1f0e4c9a2b4f6e8c3d5a7b9e1f2c4d"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://enrichment.example.com/hooks/trinity"
+                }
+            }
+        },
+        "handlers.AddWebhookSubscriptionResponse": {
+            "type": "object",
+            "properties": {
+                "subscription_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "handlers.AuditEntryResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "user.promoted"
+                },
+                "actor_role": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "actor_user_id": {
+                    "type": "string",
+                    "example": "9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"
+                },
+                "after": {
+                    "type": "object"
+                },
+                "before": {
+                    "type": "object"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "ip_address": {
+                    "type": "string",
+                    "example": "203.0.113.7"
+                },
+                "occurred_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "request_id": {
+                    "type": "string",
+                    "example": "0b2d3e4f-5a6b-4c7e-8a21-3f5b4d6e9a1c"
+                },
+                "target_id": {
+                    "type": "string",
+                    "example": "4c7e8a21-3f5b-4d6e-9a1c-0b2d3e4f5a6b"
+                },
+                "target_type": {
+                    "type": "string",
+                    "example": "user"
+                }
+            }
+        },
+        "handlers.CreateTeamResponse": {
+            "description": "Team creation response with ID",
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "019b1a49-dbf6-74d6-97bf-2d7e57d30c76"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "The team has been created"
+                }
+            }
+        },
+        "handlers.CreateUserResponse": {
+            "description": "User creation response with ID",
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "019b1a49-dbf6-74d6-97bf-2d7e57d30c75"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "The user has been created. You can login now"
+                }
+            }
+        },
+        "handlers.GetAuditEntriesResponse": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.AuditEntryResponse"
+                    }
+                }
+            }
+        },
+        "handlers.GetLatestTelegramRecordsByTelegramIDRequest": {
+            "type": "object",
+            "properties": {
+                "telegram_id": {
+                    "type": "integer",
+                    "example": 28736582143
+                }
+            }
+        },
+        "handlers.GetLatestTelegramRecordsByTelegramIDResponse": {
+            "description": "information response",
+            "type": "object",
+            "properties": {
+                "records": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.TelegramRecord"
+                    }
+                },
+                "telegram_id": {
+                    "type": "integer",
+                    "example": 428736582143
+                }
+            }
+        },
+        "handlers.GetTeamResponse": {
+            "description": "Team information",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-12-14T00:36:46.545Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "019b1a49-dbf6-74d6-97bf-2d7e57d30c76"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Fraud department"
+                }
+            }
+        },
+        "handlers.GetTelegramActivityStatisticsResponse": {
+            "type": "object",
+            "properties": {
+                "average_message_length": {
+                    "type": "number",
+                    "example": 42.5
+                },
+                "breakdown": {
+                    "description": "Breakdown is by chat for a user and by sender for a chat, the most active first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramActivityBreakdownResponse"
+                    }
+                },
+                "daily": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramDailyActivityResponse"
+                    }
+                },
+                "first_seen_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "gaps": {
+                    "description": "Gaps are the longest periods without messages, the longest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramActivityGapResponse"
+                    }
+                },
+                "hour_of_week": {
+                    "description": "HourOfWeek holds the amount of messages by weekday, Monday first, and hour of the day",
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "last_seen_at": {
+                    "type": "string",
+                    "example": "2024-02-01T08:00:00Z"
+                },
+                "message_count": {
+                    "type": "integer",
+                    "example": 1250
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Europe/Berlin"
+                }
+            }
+        },
+        "handlers.GetTelegramAlertsResponse": {
+            "type": "object",
+            "properties": {
+                "alerts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramAlertResponse"
+                    }
+                },
+                "unread_count": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "handlers.GetTelegramAnnotationsResponse": {
+            "type": "object",
+            "properties": {
+                "notes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramNoteResponse"
+                    }
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramTagResponse"
+                    }
+                }
+            }
+        },
+        "handlers.GetTelegramAttachmentsResponse": {
+            "type": "object",
+            "properties": {
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramAttachmentResponse"
+                    }
+                }
+            }
+        },
+        "handlers.GetTelegramChatResponse": {
+            "description": "Telegram chat with its metadata history, a chat only known from the records posted in it is of the unknown type until its metadata is added",
+            "type": "object",
+            "properties": {
+                "added_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "chat_telegram_id": {
+                    "type": "integer",
+                    "example": -1001234567890
+                },
+                "chat_type": {
+                    "type": "string",
+                    "enum": [
+                        "private",
+                        "group",
+                        "supergroup",
+                        "channel",
+                        "unknown"
+                    ],
+                    "example": "supergroup"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "snapshots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramChatSnapshotResponse"
+                    }
+                }
+            }
+        },
+        "handlers.GetTelegramCorrelationClustersResponse": {
+            "type": "object",
+            "properties": {
+                "clusters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramCorrelationClusterResponse"
+                    }
+                }
+            }
+        },
+        "handlers.GetTelegramExportsResponse": {
+            "type": "object",
+            "properties": {
+                "exports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramExportResponse"
+                    }
+                }
+            }
+        },
+        "handlers.GetTelegramIdentityHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "identities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramIdentityResponse"
+                    }
+                }
+            }
+        },
+        "handlers.GetTelegramInteractionGraphResponse": {
+            "type": "object",
+            "properties": {
+                "graph": {
+                    "$ref": "#/definitions/handlers.TelegramInteractionGraphResponse"
+                }
+            }
+        },
+        "handlers.GetTelegramInvestigationResponse": {
+            "type": "object",
+            "properties": {
+                "investigation": {
+                    "$ref": "#/definitions/handlers.TelegramInvestigationResponse"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramInvestigationItemResponse"
+                    }
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "editor",
+                        "viewer"
+                    ],
+                    "example": "owner"
+                }
+            }
+        },
+        "handlers.GetTelegramInvestigationsResponse": {
+            "type": "object",
+            "properties": {
+                "investigations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramInvestigationResponse"
+                    }
+                }
+            }
+        },
+        "handlers.GetTelegramProfilePicturesResponse": {
+            "type": "object",
+            "properties": {
+                "profile_pictures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramProfilePictureResponse"
+                    }
+                }
+            }
+        },
+        "handlers.GetTelegramProvenanceResponse": {
+            "type": "object",
+            "properties": {
+                "observations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramObservationResponse"
+                    }
+                }
+            }
+        },
+        "handlers.GetTelegramRecordHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "deleted_at": {
+                    "type": "string",
+                    "example": "2024-01-16T08:00:00Z"
+                },
+                "message_telegram_id": {
+                    "type": "integer",
+                    "example": 28736582143
+                },
+                "posted_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "record_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "versions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramRecordVersionResponse"
+                    }
+                }
+            }
+        },
+        "handlers.GetTelegramRecordThreadResponse": {
+            "type": "object",
+            "properties": {
+                "record_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "records": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramRecordResponse"
+                    }
+                }
+            }
+        },
+        "handlers.GetTelegramRecordsByIndicatorResponse": {
+            "type": "object",
+            "properties": {
+                "records": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramRecordResponse"
+                    }
+                }
+            }
+        },
+        "handlers.GetTelegramRecordsForwardedFromResponse": {
+            "type": "object",
+            "properties": {
+                "records": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramRecordResponse"
+                    }
+                }
+            }
+        },
+        "handlers.GetTelegramTagCatalogueResponse": {
+            "type": "object",
+            "properties": {
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramTagCountResponse"
+                    }
+                }
+            }
+        },
+        "handlers.GetTelegramTaggedTargetsResponse": {
+            "type": "object",
+            "properties": {
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramTagResponse"
+                    }
+                }
+            }
+        },
+        "handlers.GetTelegramUserIndicatorsResponse": {
+            "type": "object",
+            "properties": {
+                "indicators": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramIndicatorResponse"
+                    }
+                }
+            }
+        },
+        "handlers.GetTelegramWatchlistsResponse": {
+            "type": "object",
+            "properties": {
+                "watchlists": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramWatchlistResponse"
+                    }
+                }
+            }
+        },
+        "handlers.GetUserResponse": {
+            "description": "User information response",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-12-14T00:36:46.545Z"
+                },
+                "display_name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "019b1a49-dbf6-74d6-97bf-2d7e57d30c75"
+                },
+                "team_id": {
+                    "type": "string",
+                    "example": "019b1a49-dbf6-74d6-97bf-2d7e57d30c76"
+                },
+                "user_role": {
+                    "type": "string",
+                    "enum": [
+                        "user",
+                        "admin"
+                    ],
+                    "example": "user"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "johndoe"
+                }
+            }
+        },
+        "handlers.GetWebhookDeliveriesResponse": {
+            "type": "object",
+            "properties": {
+                "deliveries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.WebhookDeliveryResponse"
+                    }
+                }
+            }
+        },
+        "handlers.GetWebhookSubscriptionsResponse": {
+            "type": "object",
+            "properties": {
+                "subscriptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.WebhookSubscriptionResponse"
+                    }
+                }
+            }
+        },
+        "handlers.ImportTelegramExportProgress": {
+            "type": "object",
+            "properties": {
+                "attachments_imported": {
+                    "type": "integer",
+                    "example": 140
+                },
+                "attachments_missing": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "duplicates_skipped": {
+                    "type": "integer",
+                    "example": 80
+                },
+                "messages_processed": {
+                    "type": "integer",
+                    "example": 1200
+                },
+                "messages_skipped": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "records_imported": {
+                    "type": "integer",
+                    "example": 1100
+                },
+                "users_created": {
+                    "type": "integer",
+                    "example": 35
+                }
+            }
+        },
+        "handlers.ImportTelegramExportResponse": {
+            "type": "object",
+            "properties": {
+                "chat_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "chat_telegram_id": {
+                    "type": "integer",
+                    "example": -1001234567890
+                },
+                "progress": {
+                    "$ref": "#/definitions/handlers.ImportTelegramExportProgress"
+                }
+            }
+        },
+        "handlers.IngestTelegramStreamAck": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "record_already_exists"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "line": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "handlers.IngestTelegramStreamLine": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "user",
+                        "identity",
+                        "record"
+                    ],
+                    "example": "record"
+                }
+            }
+        },
+        "handlers.LoginResponse": {
+            "description": "Login response with session token",
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Login successful"
+                },
+                "token": {
+                    "type": "string",
+                    "example": "dGVzdC10b2tlbi0xMjM0NTY3ODkw"
+                }
+            }
+        },
+        "handlers.MarkTelegramAlertReadRequest": {
+            "type": "object",
+            "properties": {
+                "read": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.MarkTelegramRecordDeletedRequest": {
+            "type": "object",
+            "properties": {
+                "deleted_at": {
+                    "type": "string",
+                    "example": "2024-01-16T08:00:00Z"
+                }
+            }
+        },
+        "handlers.MarkTelegramRecordDeletedResponse": {
+            "type": "object",
+            "properties": {
+                "deleted_at": {
+                    "type": "string",
+                    "example": "2024-01-16T08:00:00Z"
+                },
+                "record_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "handlers.RedeliverWebhookDeliveryResponse": {
+            "type": "object",
+            "properties": {
+                "delivery_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "handlers.RequestTelegramExportRequest": {
+            "type": "object",
+            "properties": {
+                "chat_telegram_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        -1001234567890
+                    ]
+                },
+                "user_telegram_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        123456789
+                    ]
+                }
+            }
+        },
+        "handlers.SaveTelegramInvestigationMemberRequest": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "editor",
+                        "viewer"
+                    ],
+                    "example": "editor"
+                }
+            }
+        },
+        "handlers.SetTelegramObservationVisibilityRequest": {
+            "type": "object",
+            "properties": {
+                "visibility": {
+                    "type": "string",
+                    "enum": [
+                        "private",
+                        "team",
+                        "global"
+                    ],
+                    "example": "team"
+                }
+            }
+        },
+        "handlers.SuccessResponse": {
+            "description": "Standard success response with message",
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "User promoted to admin successfully"
+                }
+            }
+        },
+        "handlers.TelegramActivityBreakdownResponse": {
+            "type": "object",
+            "properties": {
+                "first_seen_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "last_seen_at": {
+                    "type": "string",
+                    "example": "2024-02-01T08:00:00Z"
+                },
+                "message_count": {
+                    "type": "integer",
+                    "example": 320
+                },
+                "telegram_id": {
+                    "type": "integer",
+                    "example": -1001234567890
+                }
+            }
+        },
+        "handlers.TelegramActivityGapResponse": {
+            "type": "object",
+            "properties": {
+                "duration_seconds": {
+                    "type": "integer",
+                    "example": 560100
+                },
+                "ended_at": {
+                    "type": "string",
+                    "example": "2024-01-27T09:45:00Z"
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2024-01-20T22:10:00Z"
+                }
+            }
+        },
+        "handlers.TelegramAlertResponse": {
+            "type": "object",
+            "properties": {
+                "entry_id": {
+                    "type": "string",
+                    "example": "4c7e8a21-3f5b-4d6e-9a1c-0b2d3e4f5a6b"
+                },
+                "entry_type": {
+                    "type": "string",
+                    "enum": [
+                        "telegram_id",
+                        "username",
+                        "phone",
+                        "keyword"
+                    ],
+                    "example": "username"
+                },
+                "entry_value": {
+                    "type": "string",
+                    "example": "john_doe"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "identity_id": {
+                    "type": "string"
+                },
+                "read_at": {
+                    "type": "string",
+                    "example": "2024-01-15T11:00:00Z"
+                },
+                "record_id": {
+                    "type": "string"
+                },
+                "triggered_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "watchlist_id": {
+                    "type": "string",
+                    "example": "9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"
+                }
+            }
+        },
+        "handlers.TelegramAttachmentResponse": {
+            "type": "object",
+            "properties": {
+                "added_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "file_name": {
+                    "type": "string",
+                    "example": "photo.jpg"
+                },
+                "file_size": {
+                    "type": "integer",
+                    "example": 102400
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "mime_type": {
+                    "type": "string",
+                    "example": "image/jpeg"
+                },
+                "sha256": {
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b"
+                },
+                "telegram_attachment_id": {
+                    "type": "integer",
+                    "example": 5368371429374829
+                }
+            }
+        },
+        "handlers.TelegramBotChat": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": -1001234567890
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Trinity discussion"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "supergroup"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "trinity_chat"
+                }
+            }
+        },
+        "handlers.TelegramBotMessage": {
+            "type": "object",
+            "properties": {
+                "caption": {
+                    "type": "string",
+                    "example": "Look at this"
+                },
+                "caption_entities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramBotMessageEntity"
+                    }
+                },
+                "chat": {
+                    "$ref": "#/definitions/handlers.TelegramBotChat"
+                },
+                "date": {
+                    "type": "integer",
+                    "example": 1705314600
+                },
+                "edit_date": {
+                    "type": "integer",
+                    "example": 1705314900
+                },
+                "entities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramBotMessageEntity"
+                    }
+                },
+                "forward_origin": {
+                    "$ref": "#/definitions/handlers.TelegramBotMessageOrigin"
+                },
+                "from": {
+                    "$ref": "#/definitions/handlers.TelegramBotUser"
+                },
+                "message_id": {
+                    "type": "integer",
+                    "example": 1337
+                },
+                "message_thread_id": {
+                    "description": "MessageThreadID is the forum topic of supergroups or the comment thread of channel posts",
+                    "type": "integer",
+                    "example": 1300
+                },
+                "reply_to_message": {
+                    "$ref": "#/definitions/handlers.TelegramBotReplyMessage"
+                },
+                "sender_chat": {
+                    "$ref": "#/definitions/handlers.TelegramBotChat"
+                },
+                "text": {
+                    "type": "string",
+                    "example": "Hello world!"
+                }
+            }
+        },
+        "handlers.TelegramBotMessageEntity": {
+            "type": "object",
+            "properties": {
+                "custom_emoji_id": {
+                    "type": "string",
+                    "example": "5368324170671202286"
+                },
+                "language": {
+                    "type": "string",
+                    "example": "go"
+                },
+                "length": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "offset": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "type": {
+                    "type": "string",
+                    "example": "text_link"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com"
+                },
+                "user": {
+                    "$ref": "#/definitions/handlers.TelegramBotUser"
+                }
+            }
+        },
+        "handlers.TelegramBotMessageOrigin": {
+            "type": "object",
+            "properties": {
+                "chat": {
+                    "$ref": "#/definitions/handlers.TelegramBotChat"
+                },
+                "date": {
+                    "type": "integer",
+                    "example": 1705314000
+                },
+                "message_id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "sender_chat": {
+                    "$ref": "#/definitions/handlers.TelegramBotChat"
+                },
+                "sender_user": {
+                    "$ref": "#/definitions/handlers.TelegramBotUser"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "channel"
+                }
+            }
+        },
+        "handlers.TelegramBotReplyMessage": {
+            "type": "object",
+            "properties": {
+                "message_id": {
+                    "type": "integer",
+                    "example": 1336
+                }
+            }
+        },
+        "handlers.TelegramBotUpdate": {
+            "type": "object",
+            "properties": {
+                "channel_post": {
+                    "$ref": "#/definitions/handlers.TelegramBotMessage"
+                },
+                "edited_message": {
+                    "$ref": "#/definitions/handlers.TelegramBotMessage"
+                },
+                "message": {
+                    "$ref": "#/definitions/handlers.TelegramBotMessage"
+                },
+                "update_id": {
+                    "type": "integer",
+                    "example": 10000
+                }
+            }
+        },
+        "handlers.TelegramBotUser": {
+            "type": "object",
+            "properties": {
+                "first_name": {
+                    "type": "string",
+                    "example": "John"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 28736582
+                },
+                "last_name": {
+                    "type": "string",
+                    "example": "Doe"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "john_doe"
+                }
+            }
+        },
+        "handlers.TelegramChainBreakResponse": {
+            "type": "object",
+            "properties": {
+                "collector_user_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "content_mismatch"
+                },
+                "sequence": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "subject_id": {
+                    "type": "string"
+                },
+                "subject_type": {
+                    "type": "string",
+                    "example": "record"
+                }
+            }
+        },
+        "handlers.TelegramChatSnapshotResponse": {
+            "type": "object",
+            "properties": {
+                "added_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Public chat of the Trinity project"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"
+                },
+                "member_count": {
+                    "type": "integer",
+                    "example": 1337
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Trinity discussion"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "trinity_chat"
+                }
+            }
+        },
+        "handlers.TelegramCorrelationClusterResponse": {
+            "type": "object",
+            "properties": {
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramCorrelationMemberResponse"
+                    }
+                },
+                "type": {
+                    "type": "string",
+                    "example": "phone"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "+15551234567"
+                }
+            }
+        },
+        "handlers.TelegramCorrelationMemberResponse": {
+            "type": "object",
+            "properties": {
+                "first_seen_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "identity_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "last_seen_at": {
+                    "type": "string",
+                    "example": "2024-02-01T08:00:00Z"
+                },
+                "user_telegram_id": {
+                    "type": "integer",
+                    "example": 123456789
+                }
+            }
+        },
+        "handlers.TelegramDailyActivityResponse": {
+            "type": "object",
+            "properties": {
+                "day": {
+                    "type": "string",
+                    "example": "2024-01-15"
+                },
+                "message_count": {
+                    "type": "integer",
+                    "example": 17
+                }
+            }
+        },
+        "handlers.TelegramExportProgressResponse": {
+            "type": "object",
+            "properties": {
+                "attachments_exported": {
+                    "type": "integer",
+                    "example": 320
+                },
+                "identities_exported": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "records_exported": {
+                    "type": "integer",
+                    "example": 6000
+                },
+                "records_total": {
+                    "type": "integer",
+                    "example": 12500
+                }
+            }
+        },
+        "handlers.TelegramExportResponse": {
+            "type": "object",
+            "properties": {
+                "chat_telegram_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "completed_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:32:40Z"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "export selects too many records"
+                },
+                "file_size": {
+                    "type": "integer",
+                    "example": 1048576
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "progress": {
+                    "$ref": "#/definitions/handlers.TelegramExportProgressResponse"
+                },
+                "public_key": {
+                    "type": "string",
+                    "example": "MCowBQYDK2VwAyEA"
+                },
+                "requested_by": {
+                    "type": "string",
+                    "example": "9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"
+                },
+                "sha256": {
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                },
+                "signature": {
+                    "type": "string",
+                    "example": "dGhpcyBpcyBub3QgYSBzaWduYXR1cmU="
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:05Z"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "running",
+                        "completed",
+                        "failed"
+                    ],
+                    "example": "running"
+                },
+                "user_telegram_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "handlers.TelegramForwardOrigin": {
+            "type": "object",
+            "properties": {
+                "from_chat_telegram_id": {
+                    "type": "integer",
+                    "example": -1001234567890
+                },
+                "from_user_telegram_id": {
+                    "type": "integer",
+                    "example": 28736582
+                },
+                "message_telegram_id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "posted_at": {
+                    "type": "string",
+                    "example": "2024-01-14T08:00:00Z"
+                }
+            }
+        },
+        "handlers.TelegramIdentityResponse": {
+            "type": "object",
+            "properties": {
+                "added_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "added_by_user": {
+                    "type": "string",
+                    "example": "9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"
+                },
+                "bio": {
+                    "type": "string",
+                    "example": "Software developer"
+                },
+                "first_name": {
+                    "type": "string",
+                    "example": "John"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "last_name": {
+                    "type": "string",
+                    "example": "Doe"
+                },
+                "phone_number": {
+                    "type": "string",
+                    "example": "+15551234567"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "cf6e273b-ac6e-43f1-abba-d8009ffc1b3f"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "john_doe"
+                }
+            }
+        },
+        "handlers.TelegramIndicatorResponse": {
+            "type": "object",
+            "properties": {
+                "first_seen_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "last_seen_at": {
+                    "type": "string",
+                    "example": "2024-02-01T08:00:00Z"
+                },
+                "record_count": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "type": {
+                    "type": "string",
+                    "example": "phone"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "+15551234567"
+                }
+            }
+        },
+        "handlers.TelegramInteractionGraphEdgeMetadataResponse": {
+            "type": "object",
+            "properties": {
+                "first_interaction_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "last_interaction_at": {
+                    "type": "string",
+                    "example": "2024-02-01T08:00:00Z"
+                },
+                "weight": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "handlers.TelegramInteractionGraphEdgeResponse": {
+            "type": "object",
+            "properties": {
+                "directed": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "metadata": {
+                    "$ref": "#/definitions/handlers.TelegramInteractionGraphEdgeMetadataResponse"
+                },
+                "relation": {
+                    "type": "string",
+                    "example": "reply"
+                },
+                "source": {
+                    "type": "string",
+                    "example": "u123456789"
+                },
+                "target": {
+                    "type": "string",
+                    "example": "u987654321"
+                }
+            }
+        },
+        "handlers.TelegramInteractionGraphNodeMetadataResponse": {
+            "type": "object",
+            "properties": {
+                "depth": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "first_name": {
+                    "type": "string",
+                    "example": "John"
+                },
+                "last_name": {
+                    "type": "string",
+                    "example": "Doe"
+                },
+                "telegram_id": {
+                    "type": "integer",
+                    "example": 123456789
+                },
+                "username": {
+                    "type": "string",
+                    "example": "john_doe"
+                }
+            }
+        },
+        "handlers.TelegramInteractionGraphNodeResponse": {
+            "type": "object",
+            "properties": {
+                "label": {
+                    "type": "string",
+                    "example": "@john_doe"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/handlers.TelegramInteractionGraphNodeMetadataResponse"
+                }
+            }
+        },
+        "handlers.TelegramInteractionGraphResponse": {
+            "type": "object",
+            "properties": {
+                "directed": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "edges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramInteractionGraphEdgeResponse"
+                    }
+                },
+                "nodes": {
+                    "description": "Nodes are keyed by the IDs the edges refer to",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/handlers.TelegramInteractionGraphNodeResponse"
+                    }
+                }
+            }
+        },
+        "handlers.TelegramInvestigationItemResponse": {
+            "type": "object",
+            "properties": {
+                "added_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "added_by": {
+                    "type": "string",
+                    "example": "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Posts the wallet address of the giveaway"
+                },
+                "target_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "telegram_user",
+                        "telegram_chat",
+                        "telegram_record"
+                    ],
+                    "example": "telegram_record"
+                }
+            }
+        },
+        "handlers.TelegramInvestigationMemberResponse": {
+            "type": "object",
+            "properties": {
+                "added_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "added_by": {
+                    "type": "string",
+                    "example": "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "editor",
+                        "viewer"
+                    ],
+                    "example": "editor"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+                }
+            }
+        },
+        "handlers.TelegramInvestigationResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "created_by": {
+                    "type": "string",
+                    "example": "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Fake giveaway channels"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramInvestigationMemberResponse"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Crypto giveaway scam"
+                }
+            }
+        },
+        "handlers.TelegramMessageEntity": {
+            "type": "object",
+            "properties": {
+                "custom_emoji_id": {
+                    "type": "string",
+                    "example": "5368324170671202286"
+                },
+                "language": {
+                    "type": "string",
+                    "example": "go"
+                },
+                "length": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "offset": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "type": {
+                    "type": "string",
+                    "example": "text_link"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com"
+                },
+                "user_telegram_id": {
+                    "type": "integer",
+                    "example": 28736582
                 }
             }
-        }
-    },
-    "definitions": {
-        "domain.TelegramRecord": {
+        },
+        "handlers.TelegramNoteResponse": {
             "type": "object",
-            "required": [
-                "addedAt",
-                "addedByUser",
-                "fromTelegramUserID",
-                "id",
-                "inTelegramChatID",
-                "messageTelegramID",
-                "messageText",
-                "postedAt"
-            ],
             "properties": {
-                "addedAt": {
-                    "type": "string"
+                "added_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
                 },
-                "addedByUser": {
-                    "type": "string"
+                "added_by": {
+                    "type": "string",
+                    "example": "7c9e6679-7425-40de-944b-e07fc1f90ae7"
                 },
-                "fromTelegramUserID": {
-                    "type": "string"
+                "body": {
+                    "type": "string",
+                    "example": "Runs the **fake giveaway** channels"
                 },
                 "id": {
-                    "type": "string"
-                },
-                "inTelegramChatID": {
-                    "type": "integer"
-                },
-                "messageTelegramID": {
-                    "type": "integer"
+                    "type": "string",
+                    "example": "4c7e8a21-3f5b-4d6e-9a1c-0b2d3e4f5a6b"
                 },
-                "messageText": {
+                "target_id": {
                     "type": "string",
-                    "maxLength": 4096
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "postedAt": {
-                    "type": "string"
+                "target_type": {
+                    "type": "string",
+                    "enum": [
+                        "telegram_user",
+                        "telegram_identity",
+                        "telegram_record"
+                    ]
                 }
             }
         },
-        "handlers.AddTelegramIdentityRequest": {
+        "handlers.TelegramObservationResponse": {
             "type": "object",
             "properties": {
-                "telegram_bio": {
+                "collector_user_id": {
                     "type": "string",
-                    "example": "Hi! I am using Whatsapp"
+                    "example": "7c9e6679-7425-40de-944b-e07fc1f90ae7"
                 },
-                "telegram_first_name": {
+                "id": {
                     "type": "string",
-                    "example": "John"
+                    "example": "3d8f2b6a-1c4e-4f7a-9b0d-5e6f7a8b9c0d"
                 },
-                "telegram_id": {
+                "observed_at": {
                     "type": "string",
-                    "example": "20d8a06c-2fac-4643-ba78-7da267a7fe51"
+                    "example": "2024-01-15T10:30:00Z"
                 },
-                "telegram_last_name": {
+                "target_id": {
                     "type": "string",
-                    "example": "Doe"
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "telegram_phone_number": {
+                "target_type": {
                     "type": "string",
-                    "example": "+11234567890 (Use e164 format)"
+                    "enum": [
+                        "telegram_user",
+                        "telegram_record"
+                    ]
                 },
-                "telegram_username": {
+                "visibility": {
                     "type": "string",
-                    "example": "user1235"
+                    "enum": [
+                        "private",
+                        "team",
+                        "global"
+                    ]
                 }
             }
         },
-        "handlers.AddTelegramIdentityResponse": {
+        "handlers.TelegramProfilePictureResponse": {
             "type": "object",
             "properties": {
-                "record_id": {
+                "added_at": {
                     "type": "string",
-                    "example": "28736582143"
+                    "example": "2024-01-16T10:30:00Z"
+                },
+                "file_size": {
+                    "type": "integer",
+                    "example": 102400
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "mime_type": {
+                    "type": "string",
+                    "example": "image/jpeg"
+                },
+                "posted_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "sha256": {
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b"
                 }
             }
         },
-        "handlers.AddTelegramRecordRequest": {
+        "handlers.TelegramRecordResponse": {
             "type": "object",
             "properties": {
-                "from_user_telegram_id": {
+                "caption": {
+                    "type": "string",
+                    "example": "Look at this"
+                },
+                "caption_entities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramMessageEntity"
+                    }
+                },
+                "deleted_at": {
+                    "type": "string",
+                    "example": "2024-01-16T08:00:00Z"
+                },
+                "edited_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:35:00Z"
+                },
+                "entities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramMessageEntity"
+                    }
+                },
+                "forward_origin": {
+                    "$ref": "#/definitions/handlers.TelegramForwardOrigin"
+                },
+                "from_user_id": {
                     "type": "string",
                     "example": "cf6e273b-ac6e-43f1-abba-d8009ffc1b3f"
                 },
@@ -634,126 +6328,288 @@ const docTemplate = `{
                 "posted_at": {
                     "type": "string",
                     "example": "2024-01-15T10:30:00Z"
+                },
+                "record_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "reply_to_message_telegram_id": {
+                    "type": "integer",
+                    "example": 28736582140
+                },
+                "thread_telegram_id": {
+                    "type": "integer",
+                    "example": 28736582100
                 }
             }
         },
-        "handlers.AddTelegramRecordResponse": {
+        "handlers.TelegramRecordVersionResponse": {
             "type": "object",
             "properties": {
-                "record_id": {
+                "caption": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                    "example": "Look at this"
+                },
+                "caption_entities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramMessageEntity"
+                    }
+                },
+                "current": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "edited_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:35:00Z"
+                },
+                "entities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramMessageEntity"
+                    }
+                },
+                "message_text": {
+                    "type": "string",
+                    "example": "Hello world!"
                 }
             }
         },
-        "handlers.AddTelegramUserRequest": {
+        "handlers.TelegramTagCountResponse": {
             "type": "object",
             "properties": {
-                "telegram_id": {
+                "count": {
                     "type": "integer",
-                    "example": 28736582143
+                    "example": 42
+                },
+                "name": {
+                    "type": "string",
+                    "example": "scammer"
                 }
             }
         },
-        "handlers.AddTelegramUserResponse": {
+        "handlers.TelegramTagResponse": {
             "type": "object",
             "properties": {
-                "record_id": {
+                "added_at": {
                     "type": "string",
-                    "example": "28736582143"
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "added_by": {
+                    "type": "string",
+                    "example": "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "scammer"
+                },
+                "target_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "target_type": {
+                    "type": "string",
+                    "enum": [
+                        "telegram_user",
+                        "telegram_identity",
+                        "telegram_record"
+                    ]
                 }
             }
         },
-        "handlers.CreateUserResponse": {
-            "description": "User creation response with ID",
+        "handlers.TelegramWatchlistEntryResponse": {
             "type": "object",
             "properties": {
+                "added_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
                 "id": {
                     "type": "string",
-                    "example": "019b1a49-dbf6-74d6-97bf-2d7e57d30c75"
+                    "example": "9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"
                 },
-                "message": {
+                "type": {
                     "type": "string",
-                    "example": "The user has been created. You can login now"
+                    "enum": [
+                        "telegram_id",
+                        "username",
+                        "phone",
+                        "keyword"
+                    ],
+                    "example": "username"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "john_doe"
                 }
             }
         },
-        "handlers.GetLatestTelegramRecordsByTelegramIDRequest": {
+        "handlers.TelegramWatchlistResponse": {
             "type": "object",
             "properties": {
-                "telegram_id": {
-                    "type": "integer",
-                    "example": 28736582143
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TelegramWatchlistEntryResponse"
+                    }
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Fraud suspects"
                 }
             }
         },
-        "handlers.GetLatestTelegramRecordsByTelegramIDResponse": {
-            "description": "information response",
+        "handlers.VerifyTelegramChainResponse": {
             "type": "object",
             "properties": {
-                "records": {
+                "breaks": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/domain.TelegramRecord"
+                        "$ref": "#/definitions/handlers.TelegramChainBreakResponse"
                     }
                 },
-                "telegram_id": {
+                "breaks_truncated": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "chains_verified": {
                     "type": "integer",
-                    "example": 428736582143
+                    "example": 3
+                },
+                "checkpoints_verified": {
+                    "type": "integer",
+                    "example": 48
+                },
+                "entries_verified": {
+                    "type": "integer",
+                    "example": 12500
+                },
+                "intact": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "signatures_verified": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "unchained": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer",
+                        "format": "int64"
+                    }
                 }
             }
         },
-        "handlers.GetUserResponse": {
-            "description": "User information response",
+        "handlers.WebhookDeliveryResponse": {
             "type": "object",
             "properties": {
+                "attempts": {
+                    "type": "integer",
+                    "example": 2
+                },
                 "created_at": {
                     "type": "string",
-                    "example": "2025-12-14T00:36:46.545Z"
+                    "example": "2024-01-15T10:30:00Z"
                 },
-                "display_name": {
+                "event_id": {
                     "type": "string",
-                    "example": "John Doe"
+                    "example": "4c7e8a21-3f5b-4d6e-9a1c-0b2d3e4f5a6b"
+                },
+                "event_type": {
+                    "type": "string",
+                    "example": "user.demoted"
                 },
                 "id": {
                     "type": "string",
-                    "example": "019b1a49-dbf6-74d6-97bf-2d7e57d30c75"
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "user_role": {
+                "last_attempt_at": {
                     "type": "string",
-                    "enum": [
-                        "user",
-                        "admin"
-                    ],
-                    "example": "user"
+                    "example": "2024-01-15T10:31:00Z"
                 },
-                "username": {
+                "last_error": {
+                    "type": "string"
+                },
+                "last_response_status": {
+                    "type": "integer",
+                    "example": 503
+                },
+                "next_attempt_at": {
                     "type": "string",
-                    "example": "johndoe"
+                    "example": "2024-01-15T10:32:00Z"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "redelivery_of": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "succeeded",
+                        "failed"
+                    ],
+                    "example": "pending"
                 }
             }
         },
-        "handlers.LoginResponse": {
-            "description": "Login response with session token",
+        "handlers.WebhookSubscriptionResponse": {
             "type": "object",
             "properties": {
-                "message": {
+                "created_at": {
                     "type": "string",
-                    "example": "Login successful"
+                    "example": "2024-01-15T10:30:00Z"
                 },
-                "token": {
+                "created_by_user": {
                     "type": "string",
-                    "example": "dGVzdC10b2tlbi0xMjM0NTY3ODkw"
+                    "example": "9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"
+                },
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "telegram_record.created",
+                        "user.demoted"
+                    ]
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://enrichment.example.com/hooks/trinity"
                 }
             }
         },
-        "handlers.SuccessResponse": {
-            "description": "Standard success response with message",
+        "handlers.createTeamForm": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "message": {
+                "name": {
                     "type": "string",
-                    "example": "User promoted to admin successfully"
+                    "maxLength": 64,
+                    "minLength": 1
                 }
             }
         },
@@ -776,6 +6632,9 @@ const docTemplate = `{
                     "maxLength": 64,
                     "minLength": 8
                 },
+                "team_id": {
+                    "type": "string"
+                },
                 "user_role": {
                     "type": "string",
                     "enum": [
@@ -0,0 +1,9 @@
+package application
+
+import "errors"
+
+var (
+	ErrDatabaseFailed    = errors.New("the database operation has failed")
+	ErrInvalidPagination = errors.New("limit must be between 1 and 10000 and offset must not be negative")
+	ErrInvalidTimeRange  = errors.New("from must be before to")
+)
@@ -0,0 +1,89 @@
+package application
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/audit/domain"
+	"github.com/InWamos/trinity-proto/internal/audit/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+)
+
+const (
+	DefaultAuditEntriesLimit = 100
+	// MaxAuditEntriesLimit is high enough for a CSV export to hold a whole investigation at once
+	MaxAuditEntriesLimit = 10000
+)
+
+type GetAuditEntriesRequest struct {
+	Filter domain.AuditFilter
+	Limit  int
+	Offset int
+}
+
+type GetAuditEntriesResponse struct {
+	Entries []domain.AuditEntry
+}
+
+// GetAuditEntries lists the audit log to the admins, the newest entries first.
+type GetAuditEntries struct {
+	transactionManagerFactory interfaces.TransactionManagerFactory
+	auditRepositoryFactory    repository.AuditRepositoryFactory
+	logger                    *slog.Logger
+}
+
+func NewGetAuditEntries(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	auditRepositoryFactory repository.AuditRepositoryFactory,
+	logger *slog.Logger,
+) *GetAuditEntries {
+	gaeLogger := logger.With(
+		slog.String("component", "interactor"),
+		slog.String("name", "get_audit_entries"),
+	)
+	return &GetAuditEntries{
+		transactionManagerFactory: transactionManagerFactory,
+		auditRepositoryFactory:    auditRepositoryFactory,
+		logger:                    gaeLogger,
+	}
+}
+
+func (interactor *GetAuditEntries) Execute(
+	ctx context.Context,
+	input GetAuditEntriesRequest,
+) (*GetAuditEntriesResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleAdmin); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if input.Limit == 0 {
+		input.Limit = DefaultAuditEntriesLimit
+	}
+	if input.Limit < 1 || input.Limit > MaxAuditEntriesLimit || input.Offset < 0 {
+		return nil, ErrInvalidPagination
+	}
+	if input.Filter.From != nil && input.Filter.To != nil && !input.Filter.From.Before(*input.Filter.To) {
+		return nil, ErrInvalidTimeRange
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, ErrDatabaseFailed
+	}
+	defer rollback(ctx, interactor.logger, transactionManager)
+
+	auditRepository := interactor.auditRepositoryFactory.CreateAuditRepositoryWithTransaction(transactionManager)
+	entries, err := auditRepository.GetAuditEntries(ctx, input.Filter, input.Limit, input.Offset)
+	if err != nil {
+		return nil, ErrDatabaseFailed
+	}
+	return &GetAuditEntriesResponse{Entries: *entries}, nil
+}
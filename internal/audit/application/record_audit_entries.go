@@ -0,0 +1,104 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/InWamos/trinity-proto/internal/audit/domain"
+	"github.com/InWamos/trinity-proto/internal/audit/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	"github.com/google/uuid"
+)
+
+// RecordAuditEntries appends the entries of a change in its transaction. The actor and the origin of the request
+// are taken from the context, it's run by the other modules on behalf of their interactors.
+type RecordAuditEntries struct {
+	auditRepositoryFactory repository.AuditRepositoryFactory
+	logger                 *slog.Logger
+}
+
+func NewRecordAuditEntries(
+	auditRepositoryFactory repository.AuditRepositoryFactory,
+	logger *slog.Logger,
+) *RecordAuditEntries {
+	raeLogger := logger.With(
+		slog.String("component", "interactor"),
+		slog.String("name", "record_audit_entries"),
+	)
+	return &RecordAuditEntries{
+		auditRepositoryFactory: auditRepositoryFactory,
+		logger:                 raeLogger,
+	}
+}
+
+func (interactor *RecordAuditEntries) Execute(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	inputs []auditclient.Entry,
+) error {
+	if len(inputs) == 0 {
+		return nil
+	}
+	now := time.Now().UTC()
+	var requestID, ipAddress string
+	if metadata, ok := ctx.Value(interfaces.RequestMetadataKey).(*interfaces.RequestMetadata); ok && metadata != nil {
+		requestID, ipAddress = metadata.RequestID, metadata.IPAddress
+	}
+	contextActor, _ := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+
+	entries := make([]domain.AuditEntry, len(inputs))
+	for i, input := range inputs {
+		before, err := marshalState(input.Before)
+		if err != nil {
+			interactor.logger.ErrorContext(ctx, "failed to marshal state before the change", slog.Any("err", err))
+			return err
+		}
+		after, err := marshalState(input.After)
+		if err != nil {
+			interactor.logger.ErrorContext(ctx, "failed to marshal state after the change", slog.Any("err", err))
+			return err
+		}
+		entries[i] = domain.AuditEntry{
+			ID:         uuid.New(),
+			OccurredAt: now,
+			Action:     string(input.Action),
+			TargetType: input.TargetType,
+			TargetID:   input.TargetID,
+			RequestID:  requestID,
+			IPAddress:  ipAddress,
+			Before:     before,
+			After:      after,
+		}
+		actor := input.Actor
+		if actor == nil {
+			actor = contextActor
+		}
+		if actor != nil {
+			entries[i].ActorUserID = &actor.UserID
+			entries[i].ActorRole = string(actor.UserRole)
+		}
+	}
+
+	auditRepository := interactor.auditRepositoryFactory.CreateAuditRepositoryWithTransaction(transactionManager)
+	if err := auditRepository.AddAuditEntries(ctx, entries); err != nil {
+		return ErrDatabaseFailed
+	}
+	return nil
+}
+
+// marshalState returns nil for a missing state, a nil pointer included.
+func marshalState(state any) (json.RawMessage, error) {
+	if state == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(state)
+	if err != nil || bytes.Equal(data, []byte("null")) {
+		return nil, err
+	}
+	return data, nil
+}
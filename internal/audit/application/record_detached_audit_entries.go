@@ -0,0 +1,50 @@
+package application
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+)
+
+// RecordDetachedAuditEntries appends the entries of a change stored outside of the database
+// in a transaction of their own.
+type RecordDetachedAuditEntries struct {
+	transactionManagerFactory interfaces.TransactionManagerFactory
+	recordAuditEntries        *RecordAuditEntries
+	logger                    *slog.Logger
+}
+
+func NewRecordDetachedAuditEntries(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	recordAuditEntries *RecordAuditEntries,
+	logger *slog.Logger,
+) *RecordDetachedAuditEntries {
+	rdaeLogger := logger.With(
+		slog.String("component", "interactor"),
+		slog.String("name", "record_detached_audit_entries"),
+	)
+	return &RecordDetachedAuditEntries{
+		transactionManagerFactory: transactionManagerFactory,
+		recordAuditEntries:        recordAuditEntries,
+		logger:                    rdaeLogger,
+	}
+}
+
+func (interactor *RecordDetachedAuditEntries) Execute(ctx context.Context, inputs []auditclient.Entry) error {
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return ErrDatabaseFailed
+	}
+	if err = interactor.recordAuditEntries.Execute(ctx, transactionManager, inputs); err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		return err
+	}
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return ErrDatabaseFailed
+	}
+	return nil
+}
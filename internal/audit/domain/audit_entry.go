@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditEntry is an append-only trace of a change: who made it, from where, and the state of its target around it.
+// An entry without an actor has been made by the system, e.g. on startup.
+type AuditEntry struct {
+	ID          uuid.UUID
+	OccurredAt  time.Time
+	ActorUserID *uuid.UUID
+	ActorRole   string
+	Action      string
+	TargetType  string
+	TargetID    string
+	RequestID   string
+	IPAddress   string
+	Before      json.RawMessage
+	After       json.RawMessage
+}
+
+// AuditFilter narrows the audit log down, the unset fields match every entry.
+type AuditFilter struct {
+	ActorUserID *uuid.UUID
+	Action      string
+	TargetType  string
+	TargetID    string
+	From        *time.Time
+	To          *time.Time
+}
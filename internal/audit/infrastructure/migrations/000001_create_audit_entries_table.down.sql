@@ -0,0 +1,7 @@
+-- squawk-ignore-file ban-drop-table
+-- Drop the audit log
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+DROP TABLE IF EXISTS "audit".entries;
+DROP FUNCTION IF EXISTS "audit".reject_entry_change();
+DROP SCHEMA IF EXISTS "audit";
@@ -0,0 +1,49 @@
+-- Create schema for audit module
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+CREATE SCHEMA IF NOT EXISTS "audit";
+
+CREATE TABLE IF NOT EXISTS "audit"."entries" (
+    id UUID PRIMARY KEY NOT NULL,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    actor_user_id UUID,
+    actor_role TEXT NOT NULL DEFAULT '',
+    action TEXT NOT NULL,
+    target_type TEXT NOT NULL,
+    target_id TEXT NOT NULL,
+    request_id TEXT NOT NULL DEFAULT '',
+    ip_address TEXT NOT NULL DEFAULT '',
+    before_state JSONB,
+    after_state JSONB
+);
+
+-- The log is append-only, the entries may neither be changed nor removed
+CREATE OR REPLACE FUNCTION "audit".reject_entry_change() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit entries are append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER trg_entries_append_only
+BEFORE UPDATE OR DELETE ON "audit"."entries"
+FOR EACH ROW EXECUTE FUNCTION "audit".reject_entry_change();
+
+CREATE OR REPLACE TRIGGER trg_entries_no_truncate
+BEFORE TRUNCATE ON "audit"."entries"
+FOR EACH STATEMENT EXECUTE FUNCTION "audit".reject_entry_change();
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_entries_occurred_at ON "audit"."entries" (occurred_at DESC);
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_entries_actor_occurred_at ON "audit"."entries" (actor_user_id, occurred_at DESC);
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_entries_target ON "audit"."entries" (target_type, target_id);
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_entries_action_occurred_at ON "audit"."entries" (action, occurred_at DESC);
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditEntryModelSqlx keeps the states as text, they're returned byte for byte the way they're read.
+type AuditEntryModelSqlx struct {
+	ID          uuid.UUID  `db:"id"`
+	OccurredAt  time.Time  `db:"occurred_at"`
+	ActorUserID *uuid.UUID `db:"actor_user_id"`
+	ActorRole   string     `db:"actor_role"`
+	Action      string     `db:"action"`
+	TargetType  string     `db:"target_type"`
+	TargetID    string     `db:"target_id"`
+	RequestID   string     `db:"request_id"`
+	IPAddress   string     `db:"ip_address"`
+	BeforeState *string    `db:"before_state"`
+	AfterState  *string    `db:"after_state"`
+}
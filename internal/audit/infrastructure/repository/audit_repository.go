@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/InWamos/trinity-proto/internal/audit/domain"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+)
+
+var ErrDatabaseFailed = errors.New("the request to the database has failed")
+
+// AuditRepository only ever appends to the log, which rejects any change of the stored entries.
+type AuditRepository interface {
+	AddAuditEntries(ctx context.Context, entries []domain.AuditEntry) error
+	// GetAuditEntries returns the entries matching the filter, the newest first.
+	GetAuditEntries(ctx context.Context, filter domain.AuditFilter, limit, offset int) (*[]domain.AuditEntry, error)
+}
+
+type AuditRepositoryFactory interface {
+	CreateAuditRepositoryWithTransaction(tm interfaces.TransactionManager) AuditRepository
+}
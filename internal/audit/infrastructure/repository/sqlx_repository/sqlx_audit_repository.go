@@ -0,0 +1,98 @@
+package sqlxrepository
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/audit/domain"
+	"github.com/InWamos/trinity-proto/internal/audit/infrastructure/models"
+	"github.com/InWamos/trinity-proto/internal/audit/infrastructure/repository"
+	"github.com/jmoiron/sqlx"
+)
+
+// insertBatchSize keeps a batch insert under the limit of 65535 parameters of a statement.
+const insertBatchSize = 1000
+
+type SqlxAuditRepository struct {
+	session    *sqlx.Tx
+	sqlxMapper *SqlxAuditMapper
+	logger     *slog.Logger
+}
+
+func NewSqlxAuditRepository(
+	session *sqlx.Tx,
+	sqlxMapper *SqlxAuditMapper,
+	logger *slog.Logger,
+) repository.AuditRepository {
+	arLogger := logger.With(
+		slog.String("component", "repository"),
+		slog.String("name", "sqlx_audit_repository"),
+	)
+	return &SqlxAuditRepository{
+		session:    session,
+		sqlxMapper: sqlxMapper,
+		logger:     arLogger,
+	}
+}
+
+func (repo *SqlxAuditRepository) AddAuditEntries(ctx context.Context, entries []domain.AuditEntry) error {
+	repo.logger.DebugContext(ctx, "Started AddAuditEntries request", slog.Int("entry_count", len(entries)))
+	query := `INSERT INTO "audit"."entries" (id, occurred_at, actor_user_id, actor_role, action, target_type,
+	target_id, request_id, ip_address, before_state, after_state)
+	VALUES (:id, :occurred_at, :actor_user_id, :actor_role, :action, :target_type,
+	:target_id, :request_id, :ip_address, :before_state, :after_state)`
+	for start := 0; start < len(entries); start += insertBatchSize {
+		batch := entries[start:min(start+insertBatchSize, len(entries))]
+		entryModels := make([]models.AuditEntryModelSqlx, len(batch))
+		for i := range batch {
+			entryModels[i] = repo.sqlxMapper.AuditEntryToModel(&batch[i])
+		}
+		if _, err := repo.session.NamedExecContext(ctx, query, entryModels); err != nil {
+			repo.logger.ErrorContext(ctx, "Failed to add audit entries", slog.Any("err", err))
+			return repository.ErrDatabaseFailed
+		}
+	}
+	return nil
+}
+
+func (repo *SqlxAuditRepository) GetAuditEntries(
+	ctx context.Context,
+	filter domain.AuditFilter,
+	limit, offset int,
+) (*[]domain.AuditEntry, error) {
+	repo.logger.DebugContext(ctx, "Started GetAuditEntries request")
+	var entryModels []models.AuditEntryModelSqlx
+	query := `SELECT id, occurred_at, actor_user_id, actor_role, action, target_type, target_id, request_id,
+	ip_address, before_state, after_state
+	FROM "audit"."entries"
+	WHERE ($1::UUID IS NULL OR actor_user_id = $1)
+	AND ($2 = '' OR action = $2)
+	AND ($3 = '' OR target_type = $3)
+	AND ($4 = '' OR target_id = $4)
+	AND ($5::TIMESTAMPTZ IS NULL OR occurred_at >= $5)
+	AND ($6::TIMESTAMPTZ IS NULL OR occurred_at < $6)
+	ORDER BY occurred_at DESC, id
+	LIMIT $7 OFFSET $8`
+	err := repo.session.SelectContext(
+		ctx,
+		&entryModels,
+		query,
+		filter.ActorUserID,
+		filter.Action,
+		filter.TargetType,
+		filter.TargetID,
+		filter.From,
+		filter.To,
+		limit,
+		offset,
+	)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get audit entries", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	entries := make([]domain.AuditEntry, len(entryModels))
+	for i, entryModel := range entryModels {
+		entries[i] = repo.sqlxMapper.AuditEntryToDomain(&entryModel)
+	}
+	return &entries, nil
+}
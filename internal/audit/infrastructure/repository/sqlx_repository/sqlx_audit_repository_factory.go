@@ -0,0 +1,35 @@
+package sqlxrepository
+
+import (
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/audit/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/jmoiron/sqlx"
+)
+
+type SqlxAuditRepositoryFactory struct {
+	logger     *slog.Logger
+	sqlxMapper *SqlxAuditMapper
+}
+
+func NewSqlxAuditRepositoryFactory(
+	logger *slog.Logger,
+	mapper *SqlxAuditMapper,
+) repository.AuditRepositoryFactory {
+	return &SqlxAuditRepositoryFactory{
+		logger:     logger,
+		sqlxMapper: mapper,
+	}
+}
+
+func (factory *SqlxAuditRepositoryFactory) CreateAuditRepositoryWithTransaction(
+	tm interfaces.TransactionManager,
+) repository.AuditRepository {
+	tx, ok := tm.GetTransaction().(*sqlx.Tx)
+	if !ok {
+		factory.logger.Error("invalid transaction type, expected *sqlx.Tx")
+		panic("invalid transaction type for sqlx repository")
+	}
+	return NewSqlxAuditRepository(tx, factory.sqlxMapper, factory.logger)
+}
@@ -0,0 +1,58 @@
+package sqlxrepository
+
+import (
+	"encoding/json"
+
+	"github.com/InWamos/trinity-proto/internal/audit/domain"
+	"github.com/InWamos/trinity-proto/internal/audit/infrastructure/models"
+)
+
+type SqlxAuditMapper struct{}
+
+func NewSqlxAuditMapper() *SqlxAuditMapper {
+	return &SqlxAuditMapper{}
+}
+
+func (sm *SqlxAuditMapper) AuditEntryToDomain(inputModel *models.AuditEntryModelSqlx) domain.AuditEntry {
+	entry := domain.AuditEntry{
+		ID:          inputModel.ID,
+		OccurredAt:  inputModel.OccurredAt,
+		ActorUserID: inputModel.ActorUserID,
+		ActorRole:   inputModel.ActorRole,
+		Action:      inputModel.Action,
+		TargetType:  inputModel.TargetType,
+		TargetID:    inputModel.TargetID,
+		RequestID:   inputModel.RequestID,
+		IPAddress:   inputModel.IPAddress,
+	}
+	if inputModel.BeforeState != nil {
+		entry.Before = json.RawMessage(*inputModel.BeforeState)
+	}
+	if inputModel.AfterState != nil {
+		entry.After = json.RawMessage(*inputModel.AfterState)
+	}
+	return entry
+}
+
+func (sm *SqlxAuditMapper) AuditEntryToModel(inputDomain *domain.AuditEntry) models.AuditEntryModelSqlx {
+	entryModel := models.AuditEntryModelSqlx{
+		ID:          inputDomain.ID,
+		OccurredAt:  inputDomain.OccurredAt,
+		ActorUserID: inputDomain.ActorUserID,
+		ActorRole:   inputDomain.ActorRole,
+		Action:      inputDomain.Action,
+		TargetType:  inputDomain.TargetType,
+		TargetID:    inputDomain.TargetID,
+		RequestID:   inputDomain.RequestID,
+		IPAddress:   inputDomain.IPAddress,
+	}
+	if inputDomain.Before != nil {
+		before := string(inputDomain.Before)
+		entryModel.BeforeState = &before
+	}
+	if inputDomain.After != nil {
+		after := string(inputDomain.After)
+		entryModel.AfterState = &after
+	}
+	return entryModel
+}
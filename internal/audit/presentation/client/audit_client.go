@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/audit/application"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+)
+
+type AuditClient struct {
+	recordAuditEntriesInteractor         *application.RecordAuditEntries
+	recordDetachedAuditEntriesInteractor *application.RecordDetachedAuditEntries
+	logger                               *slog.Logger
+}
+
+func NewAuditClient(
+	recordAuditEntriesInteractor *application.RecordAuditEntries,
+	recordDetachedAuditEntriesInteractor *application.RecordDetachedAuditEntries,
+	logger *slog.Logger,
+) client.AuditClient {
+	acLogger := logger.With(slog.String("component", "audit_client"))
+	return &AuditClient{
+		recordAuditEntriesInteractor:         recordAuditEntriesInteractor,
+		recordDetachedAuditEntriesInteractor: recordDetachedAuditEntriesInteractor,
+		logger:                               acLogger,
+	}
+}
+
+func (aClient *AuditClient) Record(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	entries ...client.Entry,
+) error {
+	if err := aClient.recordAuditEntriesInteractor.Execute(ctx, transactionManager, entries); err != nil {
+		aClient.logger.ErrorContext(ctx, "failed to record audit entries", slog.Any("err", err))
+		return client.ErrUnexpectedError
+	}
+	return nil
+}
+
+func (aClient *AuditClient) RecordDetached(ctx context.Context, entries ...client.Entry) error {
+	if err := aClient.recordDetachedAuditEntriesInteractor.Execute(ctx, entries); err != nil {
+		aClient.logger.ErrorContext(ctx, "failed to record detached audit entries", slog.Any("err", err))
+		return client.ErrUnexpectedError
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/InWamos/trinity-proto/internal/audit/application"
+	"github.com/InWamos/trinity-proto/internal/audit/domain"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	csvexport "github.com/InWamos/trinity-proto/internal/shared/infrastructure/csv_export"
+	"github.com/google/uuid"
+)
+
+// GetAuditEntriesResponse represents the response from the GetAuditEntries endpoint.
+type GetAuditEntriesResponse struct {
+	Entries []AuditEntryResponse `json:"entries"`
+}
+
+// AuditEntryResponse is a change made through the API. An entry without an actor has been made by the system.
+type AuditEntryResponse struct {
+	ID          uuid.UUID       `json:"id"                      example:"550e8400-e29b-41d4-a716-446655440000"`
+	OccurredAt  time.Time       `json:"occurred_at"             example:"2024-01-15T10:30:00Z"`
+	ActorUserID *uuid.UUID      `json:"actor_user_id,omitempty" example:"9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"`
+	ActorRole   string          `json:"actor_role,omitempty"    example:"admin"`
+	Action      string          `json:"action"                  example:"user.promoted"`
+	TargetType  string          `json:"target_type"             example:"user"`
+	TargetID    string          `json:"target_id"               example:"4c7e8a21-3f5b-4d6e-9a1c-0b2d3e4f5a6b"`
+	RequestID   string          `json:"request_id,omitempty"    example:"0b2d3e4f-5a6b-4c7e-8a21-3f5b4d6e9a1c"`
+	IPAddress   string          `json:"ip_address,omitempty"    example:"203.0.113.7"`
+	Before      json.RawMessage `json:"before,omitempty"        swaggertype:"object"`
+	After       json.RawMessage `json:"after,omitempty"         swaggertype:"object"`
+}
+
+// auditCSVHeader names the columns of the CSV export, the states are kept as JSON. The cells starting like
+// a formula are neutralised, so the export can't run anything in the spreadsheet it's opened in.
+var auditCSVHeader = []string{
+	"id", "occurred_at", "actor_user_id", "actor_role", "action", "target_type", "target_id",
+	"request_id", "ip_address", "before", "after",
+}
+
+type GetAuditEntriesHandler struct {
+	interactor *application.GetAuditEntries
+	logger     *slog.Logger
+}
+
+func NewGetAuditEntriesHandler(
+	interactor *application.GetAuditEntries,
+	logger *slog.Logger,
+) *GetAuditEntriesHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_audit_entries_handler"),
+	)
+
+	return &GetAuditEntriesHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to list the audit log.
+//
+//	@Summary		Get audit log
+//	@Description	List the changes made through the API, newest first. Admin only.
+//	@Description	The log is returned as CSV when format=csv is passed or text/csv is accepted.
+//	@Tags			audit
+//	@Produce		json
+//	@Produce		text/csv
+//	@Param			actor_user_id	query		string					false	"Only list the changes of the user"	format(uuid)
+//	@Param			action			query		string					false	"Only list the action"	example(user.promoted)
+//	@Param			target_type		query		string					false	"Only list the changes of the targets of the type"	example(user)
+//	@Param			target_id		query		string					false	"Only list the changes of the target"
+//	@Param			from			query		string					false	"Only list the changes made since the time"	format(date-time)
+//	@Param			to				query		string					false	"Only list the changes made before the time"	format(date-time)
+//	@Param			format			query		string					false	"Response format"	Enums(json, csv)
+//	@Param			limit			query		int						false	"Amount of entries"	minimum(1)	maximum(10000)	default(100)
+//	@Param			offset			query		int						false	"Amount of entries to skip"	minimum(0)	default(0)
+//	@Success		200				{object}	GetAuditEntriesResponse	"Audit log retrieved successfully"
+//	@Failure		400				"Invalid filter or pagination"
+//	@Failure		403				"Insufficient privileges"
+//	@Failure		500				"Internal server error"
+//	@Security		SessionCookie
+//	@Router			/v1/audit [get]
+func (handler *GetAuditEntriesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	requestDTO := application.GetAuditEntriesRequest{
+		Filter: domain.AuditFilter{
+			Action:     query.Get("action"),
+			TargetType: query.Get("target_type"),
+			TargetID:   query.Get("target_id"),
+		},
+	}
+	var err error
+	if rawActor := query.Get("actor_user_id"); rawActor != "" {
+		actorUserID, parseErr := uuid.Parse(rawActor)
+		if parseErr != nil {
+			http.Error(w, "Invalid actor_user_id format", http.StatusBadRequest)
+			return
+		}
+		requestDTO.Filter.ActorUserID = &actorUserID
+	}
+	if rawFrom := query.Get("from"); rawFrom != "" {
+		from, parseErr := time.Parse(time.RFC3339, rawFrom)
+		if parseErr != nil {
+			http.Error(w, "Invalid from format, expected RFC 3339", http.StatusBadRequest)
+			return
+		}
+		requestDTO.Filter.From = &from
+	}
+	if rawTo := query.Get("to"); rawTo != "" {
+		to, parseErr := time.Parse(time.RFC3339, rawTo)
+		if parseErr != nil {
+			http.Error(w, "Invalid to format, expected RFC 3339", http.StatusBadRequest)
+			return
+		}
+		requestDTO.Filter.To = &to
+	}
+	if rawLimit := query.Get("limit"); rawLimit != "" {
+		if requestDTO.Limit, err = strconv.Atoi(rawLimit); err != nil {
+			http.Error(w, "Invalid limit format", http.StatusBadRequest)
+			return
+		}
+	}
+	if rawOffset := query.Get("offset"); rawOffset != "" {
+		if requestDTO.Offset, err = strconv.Atoi(rawOffset); err != nil {
+			http.Error(w, "Invalid offset format", http.StatusBadRequest)
+			return
+		}
+	}
+	format := query.Get("format")
+	if format == "" && strings.Contains(r.Header.Get("Accept"), "text/csv") {
+		format = "csv"
+	}
+	if format != "" && format != "json" && format != "csv" {
+		http.Error(w, "Format must be either json or csv", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, application.ErrInvalidPagination):
+			http.Error(w, "Limit must be between 1 and 10000 and offset must not be negative", http.StatusBadRequest)
+			return
+		case errors.Is(err, application.ErrInvalidTimeRange):
+			http.Error(w, "From must be before to", http.StatusBadRequest)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if format == "csv" {
+		handler.writeCSV(w, r, resp.Entries)
+		return
+	}
+	response := GetAuditEntriesResponse{Entries: make([]AuditEntryResponse, len(resp.Entries))}
+	for i, entry := range resp.Entries {
+		response.Entries[i] = AuditEntryResponse{
+			ID:          entry.ID,
+			OccurredAt:  entry.OccurredAt,
+			ActorUserID: entry.ActorUserID,
+			ActorRole:   entry.ActorRole,
+			Action:      entry.Action,
+			TargetType:  entry.TargetType,
+			TargetID:    entry.TargetID,
+			RequestID:   entry.RequestID,
+			IPAddress:   entry.IPAddress,
+			Before:      entry.Before,
+			After:       entry.After,
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func (handler *GetAuditEntriesHandler) writeCSV(w http.ResponseWriter, r *http.Request, entries []domain.AuditEntry) {
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="audit.csv"`)
+	w.WriteHeader(http.StatusOK)
+	writer := csvexport.NewWriter(w)
+	_ = writer.Write(auditCSVHeader)
+	for _, entry := range entries {
+		actorUserID := ""
+		if entry.ActorUserID != nil {
+			actorUserID = entry.ActorUserID.String()
+		}
+		_ = writer.Write([]string{
+			entry.ID.String(),
+			entry.OccurredAt.UTC().Format(time.RFC3339Nano),
+			actorUserID,
+			entry.ActorRole,
+			entry.Action,
+			entry.TargetType,
+			entry.TargetID,
+			entry.RequestID,
+			entry.IPAddress,
+			string(entry.Before),
+			string(entry.After),
+		})
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		handler.logger.WarnContext(r.Context(), "failed to write audit CSV", slog.Any("err", err))
+	}
+}
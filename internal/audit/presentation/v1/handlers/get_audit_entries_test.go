@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/InWamos/trinity-proto/internal/audit/application"
+	"github.com/InWamos/trinity-proto/internal/audit/domain"
+	"github.com/InWamos/trinity-proto/internal/audit/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	"github.com/google/uuid"
+)
+
+type fakeTransactionManager struct {
+	interfaces.TransactionManager
+}
+
+func (fakeTransactionManager) Rollback(context.Context) error { return nil }
+
+type fakeTransactionManagerFactory struct{}
+
+func (fakeTransactionManagerFactory) NewTransaction(context.Context) (interfaces.TransactionManager, error) {
+	return fakeTransactionManager{}, nil
+}
+
+// fakeAuditRepository returns its entries and keeps the filter and the page they've been requested with.
+type fakeAuditRepository struct {
+	repository.AuditRepository
+	entries []domain.AuditEntry
+	called  bool
+	filter  domain.AuditFilter
+	limit   int
+	offset  int
+}
+
+func (repo *fakeAuditRepository) GetAuditEntries(
+	_ context.Context,
+	filter domain.AuditFilter,
+	limit, offset int,
+) (*[]domain.AuditEntry, error) {
+	repo.called = true
+	repo.filter, repo.limit, repo.offset = filter, limit, offset
+	return &repo.entries, nil
+}
+
+func (repo *fakeAuditRepository) CreateAuditRepositoryWithTransaction(
+	interfaces.TransactionManager,
+) repository.AuditRepository {
+	return repo
+}
+
+func serveAuditEntries(repo *fakeAuditRepository, role client.UserRole, target string) *httptest.ResponseRecorder {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	handler := NewGetAuditEntriesHandler(
+		application.NewGetAuditEntries(fakeTransactionManagerFactory{}, repo, logger),
+		logger,
+	)
+	identity := &client.UserIdentity{UserID: uuid.New(), UserRole: role}
+	request := httptest.NewRequest(http.MethodGet, target, nil)
+	request = request.WithContext(context.WithValue(request.Context(), client.IdentityProviderKey, identity))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestGetAuditEntriesHandler_Filter(t *testing.T) {
+	actorUserID := uuid.New()
+	from := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+	cases := map[string]struct {
+		query  string
+		status int
+		filter domain.AuditFilter
+		limit  int
+		offset int
+	}{
+		"no filter": {
+			query:  "",
+			status: http.StatusOK,
+			limit:  application.DefaultAuditEntriesLimit,
+		},
+		"every filter": {
+			query: "?actor_user_id=" + actorUserID.String() + "&action=user.promoted&target_type=user&target_id=42" +
+				"&from=2024-01-15T10:30:00Z&to=2024-01-15T11:30:00Z&limit=10&offset=20",
+			status: http.StatusOK,
+			filter: domain.AuditFilter{
+				ActorUserID: &actorUserID,
+				Action:      "user.promoted",
+				TargetType:  "user",
+				TargetID:    "42",
+				From:        &from,
+				To:          &to,
+			},
+			limit:  10,
+			offset: 20,
+		},
+		"invalid actor":       {query: "?actor_user_id=admin", status: http.StatusBadRequest},
+		"invalid from":        {query: "?from=2024-01-15", status: http.StatusBadRequest},
+		"empty time range":    {query: "?from=2024-01-15T10:30:00Z&to=2024-01-15T10:30:00Z", status: http.StatusBadRequest},
+		"limit above maximum": {query: "?limit=10001", status: http.StatusBadRequest},
+		"negative offset":     {query: "?offset=-1", status: http.StatusBadRequest},
+		"unknown format":      {query: "?format=xml", status: http.StatusBadRequest},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			repo := &fakeAuditRepository{}
+			recorder := serveAuditEntries(repo, client.Admin, "/audit"+tc.query)
+
+			if recorder.Code != tc.status {
+				t.Fatalf("expected status %d, got %d: %s", tc.status, recorder.Code, recorder.Body.String())
+			}
+			if tc.status != http.StatusOK {
+				if repo.called {
+					t.Error("expected an invalid request never to reach the repository")
+				}
+				return
+			}
+			if repo.limit != tc.limit || repo.offset != tc.offset {
+				t.Errorf("expected the page %d+%d, got %d+%d", tc.offset, tc.limit, repo.offset, repo.limit)
+			}
+			if !equalFilters(repo.filter, tc.filter) {
+				t.Errorf("expected the filter %+v, got %+v", tc.filter, repo.filter)
+			}
+		})
+	}
+}
+
+func equalFilters(a, b domain.AuditFilter) bool {
+	equalTimes := func(x, y *time.Time) bool { return (x == nil) == (y == nil) && (x == nil || x.Equal(*y)) }
+	return (a.ActorUserID == nil) == (b.ActorUserID == nil) &&
+		(a.ActorUserID == nil || *a.ActorUserID == *b.ActorUserID) &&
+		a.Action == b.Action && a.TargetType == b.TargetType && a.TargetID == b.TargetID &&
+		equalTimes(a.From, b.From) && equalTimes(a.To, b.To)
+}
+
+func TestGetAuditEntriesHandler_AdminOnly(t *testing.T) {
+	repo := &fakeAuditRepository{}
+	recorder := serveAuditEntries(repo, client.User, "/audit")
+
+	if recorder.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, recorder.Code)
+	}
+	if repo.called {
+		t.Error("expected the log never to be read for a user")
+	}
+}
+
+func TestGetAuditEntriesHandler_Formats(t *testing.T) {
+	actorUserID := uuid.New()
+	entry := domain.AuditEntry{
+		ID:          uuid.New(),
+		OccurredAt:  time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+		ActorUserID: &actorUserID,
+		ActorRole:   "admin",
+		Action:      "telegram_watchlist.added",
+		TargetType:  "telegram_watchlist",
+		TargetID:    "=HYPERLINK(\"http://example.com\")",
+		RequestID:   "request",
+		IPAddress:   "203.0.113.7",
+		After:       json.RawMessage(`{"name":"@suspects"}`),
+	}
+
+	t.Run("json", func(t *testing.T) {
+		recorder := serveAuditEntries(&fakeAuditRepository{entries: []domain.AuditEntry{entry}}, client.Admin, "/audit")
+
+		var response GetAuditEntriesResponse
+		if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+			t.Fatalf("failed to unmarshal the response: %v", err)
+		}
+		if len(response.Entries) != 1 || response.Entries[0].TargetID != entry.TargetID {
+			t.Errorf("expected the entry to be returned as it is, got %+v", response.Entries)
+		}
+	})
+
+	for name, target := range map[string]string{"csv format": "/audit?format=csv", "csv accepted": "/audit"} {
+		t.Run(name, func(t *testing.T) {
+			repo := &fakeAuditRepository{entries: []domain.AuditEntry{entry}}
+			logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+			handler := NewGetAuditEntriesHandler(
+				application.NewGetAuditEntries(fakeTransactionManagerFactory{}, repo, logger),
+				logger,
+			)
+			identity := &client.UserIdentity{UserID: uuid.New(), UserRole: client.Admin}
+			request := httptest.NewRequest(http.MethodGet, target, nil)
+			request.Header.Set("Accept", "text/csv")
+			request = request.WithContext(context.WithValue(request.Context(), client.IdentityProviderKey, identity))
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			if !strings.HasPrefix(recorder.Header().Get("Content-Type"), "text/csv") {
+				t.Fatalf("expected a CSV response, got %q", recorder.Header().Get("Content-Type"))
+			}
+			rows, err := csv.NewReader(recorder.Body).ReadAll()
+			if err != nil {
+				t.Fatalf("failed to read the CSV: %v", err)
+			}
+			if len(rows) != 2 || strings.Join(rows[0], ",") != strings.Join(auditCSVHeader, ",") {
+				t.Fatalf("expected the header and a single entry, got %v", rows)
+			}
+			expected := []string{
+				entry.ID.String(), "2024-01-15T10:30:00Z", actorUserID.String(), "admin", "telegram_watchlist.added",
+				"telegram_watchlist", "'=HYPERLINK(\"http://example.com\")", "request", "203.0.113.7", "",
+				`{"name":"@suspects"}`,
+			}
+			if strings.Join(rows[1], "|") != strings.Join(expected, "|") {
+				t.Errorf("expected the row %q, got %q", expected, rows[1])
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package v1
+
+import (
+	"github.com/InWamos/trinity-proto/internal/audit/presentation/v1/handlers"
+	"github.com/go-chi/chi/v5"
+)
+
+type AuditMuxV1 struct {
+	mux *chi.Mux
+}
+
+func NewAuditMuxV1(getAuditEntries *handlers.GetAuditEntriesHandler) *AuditMuxV1 {
+	mux := chi.NewRouter()
+	mux.Get("/", getAuditEntries.ServeHTTP)
+	return &AuditMuxV1{mux: mux}
+}
+
+func (am *AuditMuxV1) GetMux() *chi.Mux {
+	return am.mux
+}
@@ -8,7 +8,10 @@ import (
 
 	"github.com/InWamos/trinity-proto/internal/auth/domain"
 	"github.com/InWamos/trinity-proto/internal/auth/infrastructure"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	authclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
 	"github.com/InWamos/trinity-proto/internal/shared/interfaces/user/client"
+	"github.com/google/uuid"
 )
 
 var (
@@ -29,21 +32,35 @@ type AddSessionResponse struct {
 	Session domain.Session
 }
 
+// sessionAuditState is the state of a session kept in the audit log, the token is never part of it.
+type sessionAuditState struct {
+	SessionID uuid.UUID `json:"session_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	UserRole  string    `json:"user_role"`
+	IPAddress string    `json:"ip_address"`
+	UserAgent string    `json:"user_agent"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
 type AddSession struct {
 	sessionRepository infrastructure.SessionRepository
 	userClient        client.UserClient
+	auditClient       auditclient.AuditClient
 	logger            *slog.Logger
 }
 
 func NewAddSession(
 	sessionRepository infrastructure.SessionRepository,
 	userClient client.UserClient,
+	auditClient auditclient.AuditClient,
 	logger *slog.Logger,
 ) *AddSession {
 	asLogger := logger.With(slog.String("module", "auth"), slog.String("name", "add_session"))
 	return &AddSession{
 		sessionRepository: sessionRepository,
 		userClient:        userClient,
+		auditClient:       auditClient,
 		logger:            asLogger,
 	}
 }
@@ -55,9 +72,11 @@ func (asInteractor *AddSession) Execute(ctx context.Context, input AddSessionReq
 		switch {
 		case errors.Is(err, client.ErrUsernameAbsent):
 			asInteractor.logger.InfoContext(ctx, "Username doesn't exist", slog.String("username", input.Username))
+			asInteractor.recordLoginFailure(ctx, input.Username)
 			return AddSessionResponse{}, ErrInvalidCredentials
 		case errors.Is(err, client.ErrPasswordMissmatch):
 			asInteractor.logger.InfoContext(ctx, "Password didn't match", slog.String("username", input.Username))
+			asInteractor.recordLoginFailure(ctx, input.Username)
 			return AddSessionResponse{}, ErrInvalidCredentials
 		default:
 			asInteractor.logger.ErrorContext(ctx, "Unexpected error during credential verification")
@@ -83,6 +102,31 @@ func (asInteractor *AddSession) Execute(ctx context.Context, input AddSessionReq
 		return AddSessionResponse{}, ErrUnexpected
 	}
 
+	// The session is stored outside of the database, so the entry is appended on its own. The token isn't
+	// handed out if it fails, the session is left to expire.
+	entry := auditclient.Entry{
+		Action:     auditclient.SessionCreated,
+		TargetType: "session",
+		TargetID:   newSession.ID.String(),
+		After: sessionAuditState{
+			SessionID: newSession.ID,
+			UserID:    newSession.UserID,
+			UserRole:  string(newSession.UserRole),
+			IPAddress: newSession.IPAddress,
+			UserAgent: newSession.UserAgent,
+			CreatedAt: newSession.CreatedAt,
+			ExpiresAt: newSession.ExpiresAt,
+		},
+		Actor: &authclient.UserIdentity{
+			UserID:   newSession.UserID,
+			UserRole: authclient.UserRole(newSession.UserRole),
+		},
+	}
+	if err = asInteractor.auditClient.RecordDetached(ctx, entry); err != nil {
+		asInteractor.logger.ErrorContext(ctx, "Failed to record session creation", slog.Any("err", err))
+		return AddSessionResponse{}, ErrUnexpected
+	}
+
 	asInteractor.logger.InfoContext(ctx, "Session created successfully",
 		slog.String("user_id", response.UserID.String()),
 		slog.String("session_id", newSession.ID.String()),
@@ -90,3 +134,16 @@ func (asInteractor *AddSession) Execute(ctx context.Context, input AddSessionReq
 
 	return AddSessionResponse{Session: *newSession}, nil
 }
+
+// recordLoginFailure keeps the failed attempt in the audit log. The caller is told about the invalid credentials
+// whether it's kept or not.
+func (asInteractor *AddSession) recordLoginFailure(ctx context.Context, username string) {
+	entry := auditclient.Entry{
+		Action:     auditclient.LoginFailed,
+		TargetType: "user",
+		TargetID:   username,
+	}
+	if err := asInteractor.auditClient.RecordDetached(ctx, entry); err != nil {
+		asInteractor.logger.ErrorContext(ctx, "Failed to record login failure", slog.Any("err", err))
+	}
+}
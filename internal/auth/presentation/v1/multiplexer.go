@@ -3,6 +3,7 @@ package v1
 import (
 	"github.com/InWamos/trinity-proto/internal/auth/presentation/v1/handlers"
 	"github.com/go-chi/chi/v5"
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
 )
 
 type AuthMuxV1 struct {
@@ -13,6 +14,8 @@ func NewAuthMuxV1(
 	loginHandler *handlers.LoginHandler,
 ) *AuthMuxV1 {
 	mux := chi.NewRouter()
+	// Only allow json content type
+	mux.Use(chiMiddleware.AllowContentType("application/json"))
 	mux.Post("/login", loginHandler.ServeHTTP)
 	return &AuthMuxV1{mux: mux}
 }
@@ -0,0 +1,180 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/InWamos/trinity-proto/config"
+	"github.com/InWamos/trinity-proto/internal/event/domain"
+	"github.com/InWamos/trinity-proto/internal/event/domain/service"
+	"github.com/InWamos/trinity-proto/internal/event/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/event/infrastructure/stream"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/event/client"
+)
+
+// maxLastErrorLength truncates the errors kept in the outbox.
+const maxLastErrorLength = 1024
+
+// DispatchOutboxEvents publishes a batch of the due events, each in a transaction of its own: the subscribers
+// handle it and it's appended to the stream before it's marked as published. A failed event is rolled back
+// and attempted again later, so the stream receives every event at least once.
+type DispatchOutboxEvents struct {
+	transactionManagerFactory interfaces.TransactionManagerFactory
+	outboxRepositoryFactory   repository.OutboxRepositoryFactory
+	eventStream               stream.EventStream
+	subscribers               map[client.EventType][]client.Subscriber
+	retryPolicy               *service.OutboxRetryPolicy
+	batchSize                 int
+	logger                    *slog.Logger
+}
+
+func NewDispatchOutboxEvents(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	outboxRepositoryFactory repository.OutboxRepositoryFactory,
+	eventStream stream.EventStream,
+	subscribers []client.Subscriber,
+	eventConfig *config.EventConfig,
+	logger *slog.Logger,
+) *DispatchOutboxEvents {
+	doeLogger := logger.With(
+		slog.String("component", "interactor"),
+		slog.String("name", "dispatch_outbox_events"),
+	)
+	subscribersByType := make(map[client.EventType][]client.Subscriber)
+	for _, subscriber := range subscribers {
+		for _, eventType := range subscriber.EventTypes() {
+			subscribersByType[eventType] = append(subscribersByType[eventType], subscriber)
+		}
+	}
+	return &DispatchOutboxEvents{
+		transactionManagerFactory: transactionManagerFactory,
+		outboxRepositoryFactory:   outboxRepositoryFactory,
+		eventStream:               eventStream,
+		subscribers:               subscribersByType,
+		retryPolicy:               service.NewOutboxRetryPolicy(),
+		batchSize:                 eventConfig.BatchSize,
+		logger:                    doeLogger,
+	}
+}
+
+// Execute returns how many events have been attempted, a full batch means more may be due already.
+func (interactor *DispatchOutboxEvents) Execute(ctx context.Context) (int, error) {
+	var errs []error
+	attempted := 0
+	for attempted < interactor.batchSize {
+		event, err := interactor.publishNext(ctx)
+		if errors.Is(err, domain.ErrNoDueEvent) {
+			break
+		}
+		attempted++
+		if err == nil {
+			continue
+		}
+		errs = append(errs, err)
+		// The next event would most likely fail the same way
+		if errors.Is(err, ErrDatabaseFailed) {
+			break
+		}
+		if err = interactor.markFailed(ctx, event, err); err != nil {
+			errs = append(errs, err)
+			break
+		}
+	}
+	return attempted, errors.Join(errs...)
+}
+
+// publishNext returns the event it has claimed along with the error it has failed with.
+func (interactor *DispatchOutboxEvents) publishNext(ctx context.Context) (*domain.OutboxEvent, error) {
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, ErrDatabaseFailed
+	}
+	outboxRepository := interactor.outboxRepositoryFactory.CreateOutboxRepositoryWithTransaction(transactionManager)
+	event, err := outboxRepository.ClaimDueOutboxEvent(ctx, time.Now())
+	if err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		if errors.Is(err, domain.ErrNoDueEvent) {
+			return nil, err
+		}
+		return nil, ErrDatabaseFailed
+	}
+
+	subscriberEvent := client.Event{
+		ID:         event.ID,
+		Type:       client.EventType(event.Type),
+		OccurredAt: event.OccurredAt,
+		Data:       event.Data,
+		TenantID:   event.TenantID,
+	}
+	if event.ObservedByUser.Valid && event.Visibility != nil {
+		subscriberEvent.Observation = &client.Observation{
+			ObservedByUser: event.ObservedByUser.UUID,
+			Visibility:     *event.Visibility,
+		}
+	}
+	for _, subscriber := range interactor.subscribers[subscriberEvent.Type] {
+		if err = subscriber.Handle(ctx, transactionManager, subscriberEvent); err != nil {
+			interactor.logger.ErrorContext(
+				ctx,
+				"subscriber has failed to handle event",
+				slog.String("event_id", event.ID.String()),
+				slog.String("event_type", event.Type),
+				slog.Any("err", err),
+			)
+			rollback(ctx, interactor.logger, transactionManager)
+			return event, err
+		}
+	}
+	if err = interactor.eventStream.Publish(ctx, event); err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		return event, err
+	}
+	if err = outboxRepository.MarkOutboxEventPublished(ctx, event.ID, time.Now()); err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		return event, ErrDatabaseFailed
+	}
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return event, ErrDatabaseFailed
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Event has been published",
+		slog.String("event_id", event.ID.String()),
+		slog.String("event_type", event.Type),
+	)
+	return event, nil
+}
+
+func (interactor *DispatchOutboxEvents) markFailed(ctx context.Context, event *domain.OutboxEvent, cause error) error {
+	event.Attempts++
+	event.NextAttemptAt = time.Now().Add(interactor.retryPolicy.NextAttemptDelay(event.Attempts))
+	lastError := cause.Error()
+	if len(lastError) > maxLastErrorLength {
+		lastError = strings.ToValidUTF8(lastError[:maxLastErrorLength], "")
+	}
+	event.LastError = &lastError
+
+	// The failure is stored even if the dispatcher is being stopped, otherwise the backoff would be lost
+	ctx = context.WithoutCancel(ctx)
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return ErrDatabaseFailed
+	}
+	outboxRepository := interactor.outboxRepositoryFactory.CreateOutboxRepositoryWithTransaction(transactionManager)
+	if err = outboxRepository.MarkOutboxEventFailed(ctx, event); err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		return ErrDatabaseFailed
+	}
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return ErrDatabaseFailed
+	}
+	return nil
+}
@@ -0,0 +1,5 @@
+package application
+
+import "errors"
+
+var ErrDatabaseFailed = errors.New("the database operation has failed")
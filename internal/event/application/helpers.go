@@ -0,0 +1,14 @@
+package application
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+)
+
+func rollback(ctx context.Context, logger *slog.Logger, transactionManager interfaces.TransactionManager) {
+	if err := transactionManager.Rollback(ctx); err != nil {
+		logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", err))
+	}
+}
@@ -0,0 +1,56 @@
+package application
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/InWamos/trinity-proto/config"
+	"github.com/InWamos/trinity-proto/internal/event/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+)
+
+// PruneOutboxEvents removes the events published longer ago than the retention.
+type PruneOutboxEvents struct {
+	transactionManagerFactory interfaces.TransactionManagerFactory
+	outboxRepositoryFactory   repository.OutboxRepositoryFactory
+	retention                 time.Duration
+	logger                    *slog.Logger
+}
+
+func NewPruneOutboxEvents(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	outboxRepositoryFactory repository.OutboxRepositoryFactory,
+	eventConfig *config.EventConfig,
+	logger *slog.Logger,
+) *PruneOutboxEvents {
+	poeLogger := logger.With(
+		slog.String("component", "interactor"),
+		slog.String("name", "prune_outbox_events"),
+	)
+	return &PruneOutboxEvents{
+		transactionManagerFactory: transactionManagerFactory,
+		outboxRepositoryFactory:   outboxRepositoryFactory,
+		retention:                 eventConfig.Retention,
+		logger:                    poeLogger,
+	}
+}
+
+func (interactor *PruneOutboxEvents) Execute(ctx context.Context) (int64, error) {
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return 0, ErrDatabaseFailed
+	}
+	outboxRepository := interactor.outboxRepositoryFactory.CreateOutboxRepositoryWithTransaction(transactionManager)
+	removed, err := outboxRepository.RemovePublishedOutboxEvents(ctx, time.Now().Add(-interactor.retention))
+	if err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		return 0, ErrDatabaseFailed
+	}
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return 0, ErrDatabaseFailed
+	}
+	return removed, nil
+}
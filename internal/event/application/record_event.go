@@ -0,0 +1,81 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/InWamos/trinity-proto/internal/event/domain"
+	"github.com/InWamos/trinity-proto/internal/event/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	"github.com/google/uuid"
+)
+
+type RecordEventRequest struct {
+	EventType string
+	Data      any
+	// ObservedByUser and Visibility are set for the changes of observed data.
+	ObservedByUser uuid.NullUUID
+	Visibility     *string
+}
+
+// RecordEvent adds an event to the outbox in the transaction of the change it's about.
+// It's run by the other modules on behalf of their interactors, which have authorized the change already.
+// The event is kept within the team of the user the change has been made on behalf of.
+type RecordEvent struct {
+	outboxRepositoryFactory repository.OutboxRepositoryFactory
+	logger                  *slog.Logger
+}
+
+func NewRecordEvent(
+	outboxRepositoryFactory repository.OutboxRepositoryFactory,
+	logger *slog.Logger,
+) *RecordEvent {
+	reLogger := logger.With(
+		slog.String("component", "interactor"),
+		slog.String("name", "record_event"),
+	)
+	return &RecordEvent{
+		outboxRepositoryFactory: outboxRepositoryFactory,
+		logger:                  reLogger,
+	}
+}
+
+func (interactor *RecordEvent) Execute(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	input RecordEventRequest,
+) error {
+	data, err := json.Marshal(input.Data)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to marshal event data", slog.Any("err", err))
+		return err
+	}
+	now := time.Now().UTC()
+	event := &domain.OutboxEvent{
+		ID:             uuid.New(),
+		Type:           input.EventType,
+		Data:           data,
+		OccurredAt:     now,
+		NextAttemptAt:  now,
+		ObservedByUser: input.ObservedByUser,
+		Visibility:     input.Visibility,
+	}
+	if idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity); ok && idp != nil {
+		event.TenantID = idp.TeamID
+	}
+
+	outboxRepository := interactor.outboxRepositoryFactory.CreateOutboxRepositoryWithTransaction(transactionManager)
+	if err = outboxRepository.AddOutboxEvent(ctx, event); err != nil {
+		return ErrDatabaseFailed
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Event has been recorded",
+		slog.String("event_id", event.ID.String()),
+		slog.String("event_type", event.Type),
+	)
+	return nil
+}
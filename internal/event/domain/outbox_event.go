@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrNoDueEvent = errors.New("no outbox event is due")
+
+// OutboxEvent is a domain event recorded in the transaction of the change it's about.
+// It's kept until PublishedAt, retried at NextAttemptAt after every failed attempt.
+type OutboxEvent struct {
+	ID            uuid.UUID
+	Type          string
+	Data          json.RawMessage
+	OccurredAt    time.Time
+	Attempts      int
+	NextAttemptAt time.Time
+	PublishedAt   *time.Time
+	LastError     *string
+	// TenantID is the team the change has been made within.
+	TenantID uuid.NullUUID
+	// ObservedByUser and Visibility are the observation of the data the change is about, if any.
+	ObservedByUser uuid.NullUUID
+	Visibility     *string
+}
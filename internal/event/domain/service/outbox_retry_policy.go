@@ -0,0 +1,36 @@
+package service
+
+import "time"
+
+const (
+	// DefaultOutboxRetryBaseDelay is waited for after the first failed attempt, it doubles with every next one.
+	DefaultOutboxRetryBaseDelay = 5 * time.Second
+	// DefaultOutboxRetryMaxDelay caps the delay between two attempts.
+	DefaultOutboxRetryMaxDelay = 10 * time.Minute
+)
+
+// OutboxRetryPolicy backs off exponentially between the attempts to publish an event.
+// Unlike a webhook delivery, an event is never given up on: the other modules rely on receiving it.
+type OutboxRetryPolicy struct {
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+func NewOutboxRetryPolicy() *OutboxRetryPolicy {
+	return &OutboxRetryPolicy{
+		BaseDelay: DefaultOutboxRetryBaseDelay,
+		MaxDelay:  DefaultOutboxRetryMaxDelay,
+	}
+}
+
+// NextAttemptDelay returns the delay before the attempt following the given amount of failed ones.
+func (policy *OutboxRetryPolicy) NextAttemptDelay(failedAttempts int) time.Duration {
+	delay := policy.BaseDelay
+	for i := 1; i < failedAttempts; i++ {
+		delay *= 2
+		if delay >= policy.MaxDelay {
+			return policy.MaxDelay
+		}
+	}
+	return min(delay, policy.MaxDelay)
+}
@@ -0,0 +1,24 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/InWamos/trinity-proto/internal/event/domain/service"
+)
+
+func TestOutboxRetryPolicy(t *testing.T) {
+	policy := service.NewOutboxRetryPolicy()
+	expected := []time.Duration{
+		5 * time.Second, 10 * time.Second, 20 * time.Second, 40 * time.Second, 80 * time.Second,
+		160 * time.Second, 320 * time.Second, 10 * time.Minute, 10 * time.Minute,
+	}
+	for i, want := range expected {
+		if delay := policy.NextAttemptDelay(i + 1); delay != want {
+			t.Errorf("after %d failed attempts expected a retry in %s, got %s", i+1, want, delay)
+		}
+	}
+	if delay := policy.NextAttemptDelay(1000); delay != policy.MaxDelay {
+		t.Errorf("expected the delay to stay capped, got %s", delay)
+	}
+}
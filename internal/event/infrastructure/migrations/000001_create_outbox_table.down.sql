@@ -0,0 +1,6 @@
+-- squawk-ignore-file ban-drop-table
+-- Drop the outbox of the domain events
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+DROP TABLE IF EXISTS "events".outbox;
+DROP SCHEMA IF EXISTS "events";
@@ -0,0 +1,24 @@
+-- Create schema for event module
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+CREATE SCHEMA IF NOT EXISTS "events";
+
+CREATE TABLE IF NOT EXISTS "events"."outbox" (
+    id UUID PRIMARY KEY NOT NULL,
+    event_type TEXT NOT NULL,
+    data JSONB NOT NULL,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at TIMESTAMP WITH TIME ZONE,
+    last_error TEXT
+);
+
+-- The dispatcher only ever looks at the unpublished events
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_outbox_unpublished_next_attempt_at ON "events"."outbox" (next_attempt_at) WHERE published_at IS NULL;
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_outbox_published_at ON "events"."outbox" (published_at) WHERE published_at IS NOT NULL;
@@ -0,0 +1,7 @@
+-- squawk-ignore-file ban-drop-column
+-- Drop the audience of the events, every subscriber receives all of them again
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+ALTER TABLE "events"."outbox" DROP COLUMN IF EXISTS visibility;
+ALTER TABLE "events"."outbox" DROP COLUMN IF EXISTS observed_by_user;
+ALTER TABLE "events"."outbox" DROP COLUMN IF EXISTS tenant_id;
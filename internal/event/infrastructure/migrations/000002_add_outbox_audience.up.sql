@@ -0,0 +1,12 @@
+-- Keep the team an event has been recorded within, along with the observation of the data it's about
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+ALTER TABLE "events"."outbox"
+ADD COLUMN IF NOT EXISTS tenant_id UUID;
+
+ALTER TABLE "events"."outbox"
+ADD COLUMN IF NOT EXISTS observed_by_user UUID;
+
+ALTER TABLE "events"."outbox"
+ADD COLUMN IF NOT EXISTS visibility TEXT;
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxEventModelSqlx keeps the data as text, it's handed to the subscribers byte for byte the way it's read.
+type OutboxEventModelSqlx struct {
+	ID             uuid.UUID     `db:"id"`
+	EventType      string        `db:"event_type"`
+	Data           string        `db:"data"`
+	OccurredAt     time.Time     `db:"occurred_at"`
+	Attempts       int           `db:"attempts"`
+	NextAttemptAt  time.Time     `db:"next_attempt_at"`
+	PublishedAt    *time.Time    `db:"published_at"`
+	LastError      *string       `db:"last_error"`
+	TenantID       uuid.NullUUID `db:"tenant_id"`
+	ObservedByUser uuid.NullUUID `db:"observed_by_user"`
+	Visibility     *string       `db:"visibility"`
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/InWamos/trinity-proto/internal/event/domain"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/google/uuid"
+)
+
+var ErrDatabaseFailed = errors.New("the request to the database has failed")
+
+type OutboxRepository interface {
+	AddOutboxEvent(ctx context.Context, event *domain.OutboxEvent) error
+	// ClaimDueOutboxEvent locks the oldest unpublished event due at the time until the end of the transaction,
+	// skipping the ones locked by the other dispatchers. It returns domain.ErrNoDueEvent if there's none.
+	ClaimDueOutboxEvent(ctx context.Context, now time.Time) (*domain.OutboxEvent, error)
+	MarkOutboxEventPublished(ctx context.Context, eventID uuid.UUID, publishedAt time.Time) error
+	// MarkOutboxEventFailed stores the attempts, the next attempt time and the error of the last attempt.
+	MarkOutboxEventFailed(ctx context.Context, event *domain.OutboxEvent) error
+	// RemovePublishedOutboxEvents removes the events published before the time and returns how many there were.
+	RemovePublishedOutboxEvents(ctx context.Context, before time.Time) (int64, error)
+}
+
+type OutboxRepositoryFactory interface {
+	CreateOutboxRepositoryWithTransaction(tm interfaces.TransactionManager) OutboxRepository
+}
@@ -0,0 +1,46 @@
+package sqlxrepository
+
+import (
+	"encoding/json"
+
+	"github.com/InWamos/trinity-proto/internal/event/domain"
+	"github.com/InWamos/trinity-proto/internal/event/infrastructure/models"
+)
+
+type SqlxOutboxMapper struct{}
+
+func NewSqlxOutboxMapper() *SqlxOutboxMapper {
+	return &SqlxOutboxMapper{}
+}
+
+func (sm *SqlxOutboxMapper) OutboxEventToDomain(inputModel *models.OutboxEventModelSqlx) domain.OutboxEvent {
+	return domain.OutboxEvent{
+		ID:             inputModel.ID,
+		Type:           inputModel.EventType,
+		Data:           json.RawMessage(inputModel.Data),
+		OccurredAt:     inputModel.OccurredAt,
+		Attempts:       inputModel.Attempts,
+		NextAttemptAt:  inputModel.NextAttemptAt,
+		PublishedAt:    inputModel.PublishedAt,
+		LastError:      inputModel.LastError,
+		TenantID:       inputModel.TenantID,
+		ObservedByUser: inputModel.ObservedByUser,
+		Visibility:     inputModel.Visibility,
+	}
+}
+
+func (sm *SqlxOutboxMapper) OutboxEventToModel(inputDomain *domain.OutboxEvent) models.OutboxEventModelSqlx {
+	return models.OutboxEventModelSqlx{
+		ID:             inputDomain.ID,
+		EventType:      inputDomain.Type,
+		Data:           string(inputDomain.Data),
+		OccurredAt:     inputDomain.OccurredAt,
+		Attempts:       inputDomain.Attempts,
+		NextAttemptAt:  inputDomain.NextAttemptAt,
+		PublishedAt:    inputDomain.PublishedAt,
+		LastError:      inputDomain.LastError,
+		TenantID:       inputDomain.TenantID,
+		ObservedByUser: inputDomain.ObservedByUser,
+		Visibility:     inputDomain.Visibility,
+	}
+}
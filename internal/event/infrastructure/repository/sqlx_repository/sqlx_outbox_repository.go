@@ -0,0 +1,119 @@
+package sqlxrepository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/InWamos/trinity-proto/internal/event/domain"
+	"github.com/InWamos/trinity-proto/internal/event/infrastructure/models"
+	"github.com/InWamos/trinity-proto/internal/event/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type SqlxOutboxRepository struct {
+	session    *sqlx.Tx
+	sqlxMapper *SqlxOutboxMapper
+	logger     *slog.Logger
+}
+
+func NewSqlxOutboxRepository(
+	session *sqlx.Tx,
+	sqlxMapper *SqlxOutboxMapper,
+	logger *slog.Logger,
+) repository.OutboxRepository {
+	orLogger := logger.With(
+		slog.String("component", "repository"),
+		slog.String("name", "sqlx_outbox_repository"),
+	)
+	return &SqlxOutboxRepository{
+		session:    session,
+		sqlxMapper: sqlxMapper,
+		logger:     orLogger,
+	}
+}
+
+func (repo *SqlxOutboxRepository) AddOutboxEvent(ctx context.Context, event *domain.OutboxEvent) error {
+	repo.logger.DebugContext(
+		ctx,
+		"Started AddOutboxEvent request",
+		slog.String("event_id", event.ID.String()),
+		slog.String("event_type", event.Type),
+	)
+	eventModel := repo.sqlxMapper.OutboxEventToModel(event)
+	query := `INSERT INTO "events"."outbox"
+	(id, event_type, data, occurred_at, next_attempt_at, tenant_id, observed_by_user, visibility)
+	VALUES (:id, :event_type, :data, :occurred_at, :next_attempt_at, :tenant_id, :observed_by_user, :visibility)`
+	if _, err := repo.session.NamedExecContext(ctx, query, eventModel); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to add outbox event", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return nil
+}
+
+func (repo *SqlxOutboxRepository) ClaimDueOutboxEvent(ctx context.Context, now time.Time) (*domain.OutboxEvent, error) {
+	repo.logger.DebugContext(ctx, "Started ClaimDueOutboxEvent request")
+	var eventModel models.OutboxEventModelSqlx
+	// SKIP LOCKED lets several dispatchers claim distinct events at once
+	query := `SELECT id, event_type, data, occurred_at, attempts, next_attempt_at, published_at, last_error,
+	tenant_id, observed_by_user, visibility
+	FROM "events"."outbox"
+	WHERE published_at IS NULL AND next_attempt_at <= $1
+	ORDER BY next_attempt_at, occurred_at
+	LIMIT 1
+	FOR UPDATE SKIP LOCKED`
+	if err := repo.session.GetContext(ctx, &eventModel, query, now); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNoDueEvent
+		}
+		repo.logger.ErrorContext(ctx, "Failed to claim due outbox event", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	event := repo.sqlxMapper.OutboxEventToDomain(&eventModel)
+	return &event, nil
+}
+
+func (repo *SqlxOutboxRepository) MarkOutboxEventPublished(
+	ctx context.Context,
+	eventID uuid.UUID,
+	publishedAt time.Time,
+) error {
+	repo.logger.DebugContext(ctx, "Started MarkOutboxEventPublished request", slog.String("event_id", eventID.String()))
+	query := `UPDATE "events"."outbox" SET published_at = $2, attempts = attempts + 1, last_error = NULL WHERE id = $1`
+	if _, err := repo.session.ExecContext(ctx, query, eventID, publishedAt); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to mark outbox event published", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return nil
+}
+
+func (repo *SqlxOutboxRepository) MarkOutboxEventFailed(ctx context.Context, event *domain.OutboxEvent) error {
+	repo.logger.DebugContext(ctx, "Started MarkOutboxEventFailed request", slog.String("event_id", event.ID.String()))
+	query := `UPDATE "events"."outbox" SET attempts = $2, next_attempt_at = $3, last_error = $4
+	WHERE id = $1 AND published_at IS NULL`
+	_, err := repo.session.ExecContext(ctx, query, event.ID, event.Attempts, event.NextAttemptAt, event.LastError)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to mark outbox event failed", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return nil
+}
+
+func (repo *SqlxOutboxRepository) RemovePublishedOutboxEvents(ctx context.Context, before time.Time) (int64, error) {
+	repo.logger.DebugContext(ctx, "Started RemovePublishedOutboxEvents request")
+	query := `DELETE FROM "events"."outbox" WHERE published_at < $1`
+	result, err := repo.session.ExecContext(ctx, query, before)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to remove published outbox events", slog.Any("err", err))
+		return 0, repository.ErrDatabaseFailed
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get rows affected", slog.Any("err", err))
+		return 0, repository.ErrDatabaseFailed
+	}
+	return rowsAffected, nil
+}
@@ -0,0 +1,35 @@
+package sqlxrepository
+
+import (
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/event/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/jmoiron/sqlx"
+)
+
+type SqlxOutboxRepositoryFactory struct {
+	logger     *slog.Logger
+	sqlxMapper *SqlxOutboxMapper
+}
+
+func NewSqlxOutboxRepositoryFactory(
+	logger *slog.Logger,
+	mapper *SqlxOutboxMapper,
+) repository.OutboxRepositoryFactory {
+	return &SqlxOutboxRepositoryFactory{
+		logger:     logger,
+		sqlxMapper: mapper,
+	}
+}
+
+func (factory *SqlxOutboxRepositoryFactory) CreateOutboxRepositoryWithTransaction(
+	tm interfaces.TransactionManager,
+) repository.OutboxRepository {
+	tx, ok := tm.GetTransaction().(*sqlx.Tx)
+	if !ok {
+		factory.logger.Error("invalid transaction type, expected *sqlx.Tx")
+		panic("invalid transaction type for sqlx repository")
+	}
+	return NewSqlxOutboxRepository(tx, factory.sqlxMapper, factory.logger)
+}
@@ -0,0 +1,17 @@
+package stream
+
+import (
+	"context"
+	"errors"
+
+	"github.com/InWamos/trinity-proto/internal/event/domain"
+)
+
+var ErrStreamFailed = errors.New("the request to the event stream has failed")
+
+// EventStream hands the published events over to the consumers running outside of the process.
+type EventStream interface {
+	// Publish appends the event to the stream. An event may be appended more than once if its publishing
+	// is retried, the consumers deduplicate it by its ID.
+	Publish(ctx context.Context, event *domain.OutboxEvent) error
+}
@@ -0,0 +1,111 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/InWamos/trinity-proto/config"
+	"github.com/InWamos/trinity-proto/internal/event/domain"
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisEventStream appends the events to a Redis stream, which its consumer groups read with XREADGROUP
+// and acknowledge with XACK. Every entry holds the id, type, occurred_at (RFC 3339) and data (JSON) fields.
+type RedisEventStream struct {
+	client    *redis.Client
+	stream    string
+	maxLength int64
+	logger    *slog.Logger
+}
+
+func NewRedisEventStream(
+	redisConfig *config.RedisConfig,
+	eventConfig *config.EventConfig,
+	logger *slog.Logger,
+) (EventStream, error) {
+	streamLogger := logger.With(slog.String("component", "redis_event_stream"))
+
+	dbNumber, err := strconv.Atoi(redisConfig.DBNumberEvents)
+	if err != nil {
+		streamLogger.Error("invalid Redis DB number", slog.String("db", redisConfig.DBNumberEvents))
+		return nil, fmt.Errorf("invalid Redis DB number: %w", err)
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:         fmt.Sprintf("%s:%s", redisConfig.Host, redisConfig.Port),
+		Password:     redisConfig.Password,
+		DB:           dbNumber,
+		Protocol:     3, // Use RESP3 protocol
+		DialTimeout:  5 * time.Second,
+		ReadTimeout:  3 * time.Second,
+		WriteTimeout: 3 * time.Second,
+		PoolSize:     5,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err = client.Ping(ctx).Err(); err != nil {
+		streamLogger.Error("failed to connect to Redis", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	// A group created from the start of the stream receives every event kept in it, the ones published
+	// before its consumers have connected for the first time included
+	for _, group := range eventConfig.Groups() {
+		err = client.XGroupCreateMkStream(ctx, eventConfig.StreamName, group, "0").Err()
+		if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			streamLogger.Error("failed to create consumer group", slog.String("group", group), slog.Any("err", err))
+			return nil, fmt.Errorf("failed to create consumer group %s: %w", group, err)
+		}
+	}
+
+	streamLogger.Info("successfully connected to the event stream",
+		slog.String("stream", eventConfig.StreamName),
+		slog.Int("db", dbNumber),
+	)
+
+	return &RedisEventStream{
+		client:    client,
+		stream:    eventConfig.StreamName,
+		maxLength: eventConfig.StreamMaxLength,
+		logger:    streamLogger,
+	}, nil
+}
+
+func (rs *RedisEventStream) Publish(ctx context.Context, event *domain.OutboxEvent) error {
+	values := map[string]any{
+		"id":          event.ID.String(),
+		"type":        event.Type,
+		"occurred_at": event.OccurredAt.UTC().Format(time.RFC3339Nano),
+		"data":        string(event.Data),
+	}
+	// The consumers keep the events of a team apart by it, the events without one belong to no team
+	if event.TenantID.Valid {
+		values["tenant_id"] = event.TenantID.UUID.String()
+	}
+	if event.ObservedByUser.Valid && event.Visibility != nil {
+		values["observed_by_user"] = event.ObservedByUser.UUID.String()
+		values["visibility"] = *event.Visibility
+	}
+	err := rs.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: rs.stream,
+		MaxLen: rs.maxLength,
+		Approx: true,
+		Values: values,
+	}).Err()
+	if err != nil {
+		rs.logger.ErrorContext(
+			ctx,
+			"failed to append event to the stream",
+			slog.String("event_id", event.ID.String()),
+			slog.Any("err", err),
+		)
+		return ErrStreamFailed
+	}
+	return nil
+}
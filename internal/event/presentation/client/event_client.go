@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/event/application"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/event/client"
+	"github.com/google/uuid"
+)
+
+type EventClient struct {
+	recordEventInteractor *application.RecordEvent
+	logger                *slog.Logger
+}
+
+func NewEventClient(
+	recordEventInteractor *application.RecordEvent,
+	logger *slog.Logger,
+) client.EventClient {
+	ecLogger := logger.With(slog.String("component", "event_client"))
+	return &EventClient{recordEventInteractor: recordEventInteractor, logger: ecLogger}
+}
+
+func (eClient *EventClient) Record(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	eventType client.EventType,
+	data any,
+) error {
+	interactorRequest := application.RecordEventRequest{EventType: string(eventType), Data: data}
+	return eClient.record(ctx, transactionManager, interactorRequest)
+}
+
+func (eClient *EventClient) RecordObserved(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	eventType client.EventType,
+	data any,
+	observation client.Observation,
+) error {
+	interactorRequest := application.RecordEventRequest{
+		EventType:      string(eventType),
+		Data:           data,
+		ObservedByUser: uuid.NullUUID{UUID: observation.ObservedByUser, Valid: true},
+		Visibility:     &observation.Visibility,
+	}
+	return eClient.record(ctx, transactionManager, interactorRequest)
+}
+
+func (eClient *EventClient) record(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	interactorRequest application.RecordEventRequest,
+) error {
+	if err := eClient.recordEventInteractor.Execute(ctx, transactionManager, interactorRequest); err != nil {
+		eClient.logger.ErrorContext(
+			ctx,
+			"failed to record event",
+			slog.String("event_type", interactorRequest.EventType),
+			slog.Any("err", err),
+		)
+		return client.ErrUnexpectedError
+	}
+	return nil
+}
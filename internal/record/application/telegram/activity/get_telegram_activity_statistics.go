@@ -0,0 +1,120 @@
+package activity
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+)
+
+const (
+	// MaxTelegramActivityBreakdown is the amount of the most active chats or senders returned.
+	MaxTelegramActivityBreakdown = 100
+	// MaxTelegramActivityGaps is the amount of the longest gaps in activity returned.
+	MaxTelegramActivityGaps = 10
+)
+
+var ErrInvalidTimezone = errors.New("unknown timezone")
+
+// GetTelegramActivityStatisticsRequest selects a user or a chat by its Telegram ID,
+// an empty Timezone counts the days and hours in UTC.
+type GetTelegramActivityStatisticsRequest struct {
+	Scope      domain.TelegramActivityScope
+	TelegramID int64
+	Timezone   string
+}
+
+type GetTelegramActivityStatisticsResponse struct {
+	Timezone   string
+	Statistics domain.TelegramActivityStatistics
+}
+
+// GetTelegramActivityStatistics computes the activity heatmap and statistics of a Telegram user or chat.
+type GetTelegramActivityStatistics struct {
+	transactionManagerFactory         interfaces.TransactionManagerFactory
+	telegramActivityRepositoryFactory repository.TelegramActivityRepositoryFactory
+	logger                            *slog.Logger
+}
+
+func NewGetTelegramActivityStatistics(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramActivityRepositoryFactory repository.TelegramActivityRepositoryFactory,
+	logger *slog.Logger,
+) *GetTelegramActivityStatistics {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "get_telegram_activity_statistics"),
+	)
+	return &GetTelegramActivityStatistics{
+		transactionManagerFactory:         transactionManagerFactory,
+		telegramActivityRepositoryFactory: telegramActivityRepositoryFactory,
+		logger:                            iLogger,
+	}
+}
+
+func (interactor *GetTelegramActivityStatistics) Execute(
+	ctx context.Context,
+	input GetTelegramActivityStatisticsRequest,
+) (*GetTelegramActivityStatisticsResponse, error) {
+	interactor.logger.DebugContext(
+		ctx,
+		"Started GetTelegramActivityStatistics execution",
+		slog.String("scope", string(input.Scope)),
+		slog.Int64("telegram_id", input.TelegramID),
+	)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	// Timezones are resolved by both Go and Postgres from the IANA database, "Local" is only known to Go
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = time.UTC.String()
+	}
+	if _, err := time.LoadLocation(timezone); err != nil || timezone == time.Local.String() {
+		return nil, ErrInvalidTimezone
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer func() {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+	}()
+
+	activityRepository := interactor.telegramActivityRepositoryFactory.CreateTelegramActivityRepositoryWithTransaction(
+		transactionManager,
+	)
+	statistics, err := activityRepository.GetTelegramActivityStatistics(
+		ctx,
+		input.Scope,
+		input.TelegramID,
+		timezone,
+		MaxTelegramActivityBreakdown,
+		MaxTelegramActivityGaps,
+	)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get telegram activity statistics", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetTelegramActivityStatistics execution")
+	return &GetTelegramActivityStatisticsResponse{Timezone: timezone, Statistics: *statistics}, nil
+}
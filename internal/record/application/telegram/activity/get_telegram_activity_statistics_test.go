@@ -0,0 +1,125 @@
+package activity_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/activity"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	"github.com/google/uuid"
+)
+
+type fakeTransactionManager struct {
+	interfaces.TransactionManager
+}
+
+func (fakeTransactionManager) Rollback(context.Context) error { return nil }
+
+type fakeTransactionManagerFactory struct{}
+
+func (fakeTransactionManagerFactory) NewTransaction(context.Context) (interfaces.TransactionManager, error) {
+	return fakeTransactionManager{}, nil
+}
+
+// fakeActivityRepository records the timezone the statistics were requested in.
+type fakeActivityRepository struct {
+	called   bool
+	timezone string
+}
+
+func (repo *fakeActivityRepository) GetTelegramActivityStatistics(
+	_ context.Context,
+	_ domain.TelegramActivityScope,
+	_ int64,
+	timezone string,
+	_ int,
+	_ int,
+) (*domain.TelegramActivityStatistics, error) {
+	repo.called = true
+	repo.timezone = timezone
+	return &domain.TelegramActivityStatistics{}, nil
+}
+
+func (repo *fakeActivityRepository) CreateTelegramActivityRepositoryWithTransaction(
+	interfaces.TransactionManager,
+) repository.TelegramActivityRepository {
+	return repo
+}
+
+func TestGetTelegramActivityStatistics_Timezone(t *testing.T) {
+	cases := map[string]struct {
+		timezone string
+		resolved string
+		err      error
+	}{
+		"empty defaults to UTC": {timezone: "", resolved: "UTC"},
+		"UTC":                   {timezone: "UTC", resolved: "UTC"},
+		"IANA name":             {timezone: "Europe/Berlin", resolved: "Europe/Berlin"},
+		"Local is only known to Go": {
+			timezone: "Local",
+			err:      application.ErrInvalidTimezone,
+		},
+		"unknown name": {timezone: "Mars/Olympus_Mons", err: application.ErrInvalidTimezone},
+		"offset":       {timezone: "+02:00", err: application.ErrInvalidTimezone},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			repo := &fakeActivityRepository{}
+			interactor := application.NewGetTelegramActivityStatistics(
+				fakeTransactionManagerFactory{},
+				repo,
+				slog.New(slog.NewTextHandler(io.Discard, nil)),
+			)
+			identity := &client.UserIdentity{UserID: uuid.New(), UserRole: client.User}
+			ctx := context.WithValue(context.Background(), client.IdentityProviderKey, identity)
+
+			resp, err := interactor.Execute(ctx, application.GetTelegramActivityStatisticsRequest{
+				Scope:      domain.TelegramActivityScopeUser,
+				TelegramID: 1,
+				Timezone:   tc.timezone,
+			})
+			if tc.err != nil {
+				if !errors.Is(err, tc.err) {
+					t.Fatalf("expected %v, got %v", tc.err, err)
+				}
+				if repo.called {
+					t.Error("expected an invalid timezone never to reach the repository")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if resp.Timezone != tc.resolved || repo.timezone != tc.resolved {
+				t.Errorf("expected the timezone %q, got %q and %q", tc.resolved, resp.Timezone, repo.timezone)
+			}
+		})
+	}
+}
+
+func TestGetTelegramActivityStatistics_RequiresIdentity(t *testing.T) {
+	repo := &fakeActivityRepository{}
+	interactor := application.NewGetTelegramActivityStatistics(
+		fakeTransactionManagerFactory{},
+		repo,
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+	)
+
+	_, err := interactor.Execute(context.Background(), application.GetTelegramActivityStatisticsRequest{
+		Scope:      domain.TelegramActivityScopeChat,
+		TelegramID: -100123,
+	})
+	if !errors.Is(err, rbac.ErrInsufficientPrivileges) {
+		t.Fatalf("expected %v, got %v", rbac.ErrInsufficientPrivileges, err)
+	}
+	if repo.called {
+		t.Error("expected an anonymous request never to reach the repository")
+	}
+}
@@ -8,13 +8,13 @@ import (
 
 	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
 	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
 	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
 
 	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
 	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
 	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
 	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
-	"github.com/InWamos/trinity-proto/middleware"
 	"github.com/google/uuid"
 )
 
@@ -30,6 +30,8 @@ type AddTelegramUser struct {
 	transactionManagerFactory interfaces.TransactionManagerFactory
 	telegramDomainValidator   *service.TelegramModelValidator
 	telegramUserFactory       repository.TelegramUserRepositoryFactory
+	provenanceRecorder        *TelegramProvenanceRecorder
+	auditClient               auditclient.AuditClient
 	logger                    *slog.Logger
 }
 
@@ -37,6 +39,8 @@ func NewAddTelegramUser(
 	transactionManagerFactory interfaces.TransactionManagerFactory,
 	telegramDomainValidator *service.TelegramModelValidator,
 	telegramUserFactory repository.TelegramUserRepositoryFactory,
+	provenanceRecorder *TelegramProvenanceRecorder,
+	auditClient auditclient.AuditClient,
 	logger *slog.Logger,
 ) *AddTelegramUser {
 	iLogger := logger.With(
@@ -47,6 +51,8 @@ func NewAddTelegramUser(
 		transactionManagerFactory: transactionManagerFactory,
 		telegramUserFactory:       telegramUserFactory,
 		telegramDomainValidator:   telegramDomainValidator,
+		provenanceRecorder:        provenanceRecorder,
+		auditClient:               auditClient,
 		logger:                    iLogger,
 	}
 }
@@ -55,7 +61,7 @@ func (interactor *AddTelegramUser) Execute(
 	ctx context.Context,
 	input AddTelegramUserRequest,
 ) (*AddTelegramUserResponse, error) {
-	idp, ok := ctx.Value(middleware.IdentityProviderKey).(*client.UserIdentity)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
 	if !ok || idp == nil {
 		return nil, rbac.ErrInsufficientPrivileges
 	}
@@ -95,7 +101,17 @@ func (interactor *AddTelegramUser) Execute(
 		transactionManager,
 	)
 
-	if err = telegramUserRepository.AddUser(ctx, telegramUser); err != nil {
+	// A user added by another collector before is only observed, the canonical one is returned
+	canonicalUser, err := interactor.provenanceRecorder.AddUser(
+		ctx,
+		transactionManager,
+		telegramUserRepository,
+		telegramUser,
+	)
+	if err != nil {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
 		switch {
 		case errors.Is(err, domain.ErrUserAlreadyExists):
 			interactor.logger.WarnContext(
@@ -114,6 +130,21 @@ func (interactor *AddTelegramUser) Execute(
 			return nil, ErrDatabaseFailed
 		}
 	}
+	if canonicalUser.ID != userID {
+		if err = transactionManager.Commit(ctx); err != nil {
+			interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+			return nil, ErrDatabaseFailed
+		}
+		return &AddTelegramUserResponse{UserID: canonicalUser.ID.String()}, nil
+	}
+	entry := NewTelegramAuditEntry(auditclient.TelegramUserAdded, AuditTargetTelegramUser, userID, nil, telegramUser)
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record audit entry", slog.Any("err", err))
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		return nil, ErrDatabaseFailed
+	}
 	if err = transactionManager.Commit(ctx); err != nil {
 		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
 		return nil, ErrDatabaseFailed
@@ -0,0 +1,108 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+)
+
+const (
+	DefaultTelegramAlerts = 50
+	// MaxTelegramAlerts is the amount of alerts returned at once.
+	MaxTelegramAlerts = 200
+)
+
+var ErrInvalidPagination = errors.New("limit must be between 1 and 200 and offset must not be negative")
+
+type GetTelegramAlertsRequest struct {
+	UnreadOnly bool
+	Limit      int
+	Offset     int
+}
+
+type GetTelegramAlertsResponse struct {
+	Alerts      []domain.TelegramAlert
+	UnreadCount int
+}
+
+// GetTelegramAlerts lists the alerts raised for the watchlists of the current user, the latest first.
+type GetTelegramAlerts struct {
+	transactionManagerFactory      interfaces.TransactionManagerFactory
+	telegramAlertRepositoryFactory repository.TelegramAlertRepositoryFactory
+	logger                         *slog.Logger
+}
+
+func NewGetTelegramAlerts(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramAlertRepositoryFactory repository.TelegramAlertRepositoryFactory,
+	logger *slog.Logger,
+) *GetTelegramAlerts {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "get_telegram_alerts"),
+	)
+	return &GetTelegramAlerts{
+		transactionManagerFactory:      transactionManagerFactory,
+		telegramAlertRepositoryFactory: telegramAlertRepositoryFactory,
+		logger:                         iLogger,
+	}
+}
+
+func (interactor *GetTelegramAlerts) Execute(
+	ctx context.Context,
+	input GetTelegramAlertsRequest,
+) (*GetTelegramAlertsResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+	interactor.logger.DebugContext(ctx, "Started GetTelegramAlerts execution", slog.Bool("unread_only", input.UnreadOnly))
+
+	limit := input.Limit
+	if limit == 0 {
+		limit = DefaultTelegramAlerts
+	}
+	if limit < 1 || limit > MaxTelegramAlerts || input.Offset < 0 {
+		return nil, ErrInvalidPagination
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer func() {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+	}()
+
+	alertRepository := interactor.telegramAlertRepositoryFactory.CreateTelegramAlertRepositoryWithTransaction(
+		transactionManager,
+	)
+	alerts, err := alertRepository.GetTelegramAlertsByOwner(ctx, idp.UserID, input.UnreadOnly, limit, input.Offset)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get telegram alerts", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	unreadCount, err := alertRepository.CountUnreadTelegramAlerts(ctx, idp.UserID)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to count unread telegram alerts", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetTelegramAlerts execution")
+	return &GetTelegramAlertsResponse{Alerts: *alerts, UnreadCount: unreadCount}, nil
+}
@@ -0,0 +1,122 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+// MarkTelegramAlertReadRequest marks the alert read, or unread again when Read is false.
+type MarkTelegramAlertReadRequest struct {
+	AlertID uuid.UUID
+	Read    bool
+}
+
+// telegramAlertReadState is the part of the alert kept in the audit log, the rest of it never changes.
+type telegramAlertReadState struct {
+	ReadAt *time.Time
+}
+
+// MarkTelegramAlertRead changes the read state of an alert of the current user.
+type MarkTelegramAlertRead struct {
+	transactionManagerFactory      interfaces.TransactionManagerFactory
+	telegramAlertRepositoryFactory repository.TelegramAlertRepositoryFactory
+	auditClient                    auditclient.AuditClient
+	logger                         *slog.Logger
+}
+
+func NewMarkTelegramAlertRead(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramAlertRepositoryFactory repository.TelegramAlertRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *MarkTelegramAlertRead {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "mark_telegram_alert_read"),
+	)
+	return &MarkTelegramAlertRead{
+		transactionManagerFactory:      transactionManagerFactory,
+		telegramAlertRepositoryFactory: telegramAlertRepositoryFactory,
+		auditClient:                    auditClient,
+		logger:                         iLogger,
+	}
+}
+
+func (interactor *MarkTelegramAlertRead) Execute(ctx context.Context, input MarkTelegramAlertReadRequest) error {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return rbac.ErrInsufficientPrivileges
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started MarkTelegramAlertRead execution",
+		slog.String("alert_id", input.AlertID.String()),
+		slog.Bool("read", input.Read),
+	)
+
+	var readAt *time.Time
+	if input.Read {
+		now := time.Now()
+		readAt = &now
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+
+	alertRepository := interactor.telegramAlertRepositoryFactory.CreateTelegramAlertRepositoryWithTransaction(
+		transactionManager,
+	)
+	// Alerts of other users are reported as missing
+	previousReadAt, err := alertRepository.SetTelegramAlertReadAt(ctx, input.AlertID, idp.UserID, readAt)
+	if err != nil {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		if errors.Is(err, domain.ErrAlertNotFound) {
+			return err
+		}
+		interactor.logger.ErrorContext(ctx, "failed to mark telegram alert read", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+	entry := application.NewTelegramAuditEntry(
+		auditclient.TelegramAlertRead,
+		application.AuditTargetTelegramAlert,
+		input.AlertID,
+		telegramAlertReadState{ReadAt: previousReadAt},
+		telegramAlertReadState{ReadAt: readAt},
+	)
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record audit entry", slog.Any("err", err))
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		return application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished MarkTelegramAlertRead execution")
+	return nil
+}
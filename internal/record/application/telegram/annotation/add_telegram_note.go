@@ -0,0 +1,128 @@
+package annotation
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type AddTelegramNoteRequest struct {
+	TargetType domain.TelegramAnnotationTargetType
+	TargetID   uuid.UUID
+	Body       string
+}
+
+type AddTelegramNoteResponse struct {
+	Note domain.TelegramNote
+}
+
+// AddTelegramNote writes a markdown note about a telegram user, identity or record on behalf of the current user.
+type AddTelegramNote struct {
+	transactionManagerFactory           interfaces.TransactionManagerFactory
+	telegramDomainValidator             *service.TelegramModelValidator
+	telegramAnnotationRepositoryFactory repository.TelegramAnnotationRepositoryFactory
+	auditClient                         auditclient.AuditClient
+	logger                              *slog.Logger
+}
+
+func NewAddTelegramNote(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramDomainValidator *service.TelegramModelValidator,
+	telegramAnnotationRepositoryFactory repository.TelegramAnnotationRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *AddTelegramNote {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "add_telegram_note"),
+	)
+	return &AddTelegramNote{
+		transactionManagerFactory:           transactionManagerFactory,
+		telegramDomainValidator:             telegramDomainValidator,
+		telegramAnnotationRepositoryFactory: telegramAnnotationRepositoryFactory,
+		auditClient:                         auditClient,
+		logger:                              iLogger,
+	}
+}
+
+func (interactor *AddTelegramNote) Execute(
+	ctx context.Context,
+	input AddTelegramNoteRequest,
+) (*AddTelegramNoteResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	note := &domain.TelegramNote{
+		ID:         uuid.New(),
+		TargetType: input.TargetType,
+		TargetID:   input.TargetID,
+		Body:       input.Body,
+		AddedBy:    idp.UserID,
+		AddedAt:    time.Now(),
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started AddTelegramNote execution",
+		slog.String("target_type", string(input.TargetType)),
+		slog.String("target_id", input.TargetID.String()),
+	)
+	// Validate the rules before adding to the database
+	if err := interactor.telegramDomainValidator.Validate(note); err != nil {
+		return nil, err
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	annotationRepository := interactor.telegramAnnotationRepositoryFactory.
+		CreateTelegramAnnotationRepositoryWithTransaction(transactionManager)
+	if err = annotationRepository.AddTelegramNote(ctx, note); err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		if isTargetNotFound(err) {
+			interactor.logger.DebugContext(ctx, "telegram note has been rejected", slog.Any("err", err))
+			return nil, err
+		}
+		interactor.logger.ErrorContext(ctx, "failed to add telegram note", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	entry := application.NewTelegramAuditEntry(
+		auditclient.TelegramNoteAdded,
+		application.AuditTargetTelegramNote,
+		note.ID,
+		nil,
+		note,
+	)
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record audit entry", slog.Any("err", err))
+		rollback(ctx, interactor.logger, transactionManager)
+		return nil, application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished AddTelegramNote execution")
+	return &AddTelegramNoteResponse{Note: *note}, nil
+}
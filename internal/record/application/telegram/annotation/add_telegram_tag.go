@@ -0,0 +1,155 @@
+package annotation
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type AddTelegramTagRequest struct {
+	TargetType domain.TelegramAnnotationTargetType
+	TargetID   uuid.UUID
+	Name       string
+}
+
+type AddTelegramTagResponse struct {
+	Tag domain.TelegramTag
+}
+
+// AddTelegramTag tags a telegram user, identity or record on behalf of the current user.
+type AddTelegramTag struct {
+	transactionManagerFactory           interfaces.TransactionManagerFactory
+	telegramDomainValidator             *service.TelegramModelValidator
+	telegramAnnotationRepositoryFactory repository.TelegramAnnotationRepositoryFactory
+	auditClient                         auditclient.AuditClient
+	logger                              *slog.Logger
+}
+
+func NewAddTelegramTag(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramDomainValidator *service.TelegramModelValidator,
+	telegramAnnotationRepositoryFactory repository.TelegramAnnotationRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *AddTelegramTag {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "add_telegram_tag"),
+	)
+	return &AddTelegramTag{
+		transactionManagerFactory:           transactionManagerFactory,
+		telegramDomainValidator:             telegramDomainValidator,
+		telegramAnnotationRepositoryFactory: telegramAnnotationRepositoryFactory,
+		auditClient:                         auditClient,
+		logger:                              iLogger,
+	}
+}
+
+func (interactor *AddTelegramTag) Execute(
+	ctx context.Context,
+	input AddTelegramTagRequest,
+) (*AddTelegramTagResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	name, valid := service.NormalizeTelegramTag(input.Name)
+	if !valid {
+		return nil, domain.ErrInvalidTag
+	}
+	tag := &domain.TelegramTag{
+		ID:         uuid.New(),
+		TargetType: input.TargetType,
+		TargetID:   input.TargetID,
+		Name:       name,
+		AddedBy:    idp.UserID,
+		AddedAt:    time.Now(),
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started AddTelegramTag execution",
+		slog.String("target_type", string(input.TargetType)),
+		slog.String("target_id", input.TargetID.String()),
+		slog.String("tag", name),
+	)
+	// Validate the rules before adding to the database
+	if err := interactor.telegramDomainValidator.Validate(tag); err != nil {
+		return nil, err
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	annotationRepository := interactor.telegramAnnotationRepositoryFactory.
+		CreateTelegramAnnotationRepositoryWithTransaction(transactionManager)
+	if err = annotationRepository.AddTelegramTag(ctx, tag); err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		if errors.Is(err, domain.ErrTagAlreadyExists) || isTargetNotFound(err) {
+			interactor.logger.DebugContext(ctx, "telegram tag has been rejected", slog.Any("err", err))
+			return nil, err
+		}
+		interactor.logger.ErrorContext(ctx, "failed to add telegram tag", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	entry := application.NewTelegramAuditEntry(
+		auditclient.TelegramTagAdded,
+		application.AuditTargetTelegramTag,
+		tag.ID,
+		nil,
+		tag,
+	)
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record audit entry", slog.Any("err", err))
+		rollback(ctx, interactor.logger, transactionManager)
+		return nil, application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished AddTelegramTag execution")
+	return &AddTelegramTagResponse{Tag: *tag}, nil
+}
+
+func rollback(ctx context.Context, logger *slog.Logger, transactionManager interfaces.TransactionManager) {
+	if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+		logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+	}
+}
+
+// isTargetNotFound tells whether the error is about the annotated user, identity or record not existing.
+func isTargetNotFound(err error) bool {
+	return errors.Is(err, domain.ErrUserNotFound) ||
+		errors.Is(err, domain.ErrIdentityNotFound) ||
+		errors.Is(err, domain.ErrRecordNotFound)
+}
+
+// authorizeRemoval lets the annotations be removed by the users who have added them and by the admins.
+func authorizeRemoval(idp *client.UserIdentity, addedBy uuid.UUID) error {
+	if idp.UserID == addedBy || rbac.AuthorizeByRole(idp, userDomain.RoleAdmin) == nil {
+		return nil
+	}
+	return rbac.ErrInsufficientPrivileges
+}
@@ -0,0 +1,97 @@
+package annotation
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidAnnotationTarget = errors.New("target type must be telegram_user, telegram_identity or telegram_record")
+
+type GetTelegramAnnotationsRequest struct {
+	TargetType domain.TelegramAnnotationTargetType
+	TargetID   uuid.UUID
+}
+
+type GetTelegramAnnotationsResponse struct {
+	Tags  []domain.TelegramTag
+	Notes []domain.TelegramNote
+}
+
+// GetTelegramAnnotations returns the tags and the notes of a telegram user, identity or record, the oldest first.
+type GetTelegramAnnotations struct {
+	transactionManagerFactory           interfaces.TransactionManagerFactory
+	telegramAnnotationRepositoryFactory repository.TelegramAnnotationRepositoryFactory
+	logger                              *slog.Logger
+}
+
+func NewGetTelegramAnnotations(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramAnnotationRepositoryFactory repository.TelegramAnnotationRepositoryFactory,
+	logger *slog.Logger,
+) *GetTelegramAnnotations {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "get_telegram_annotations"),
+	)
+	return &GetTelegramAnnotations{
+		transactionManagerFactory:           transactionManagerFactory,
+		telegramAnnotationRepositoryFactory: telegramAnnotationRepositoryFactory,
+		logger:                              iLogger,
+	}
+}
+
+func (interactor *GetTelegramAnnotations) Execute(
+	ctx context.Context,
+	input GetTelegramAnnotationsRequest,
+) (*GetTelegramAnnotationsResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+	if !input.TargetType.Valid() {
+		return nil, ErrInvalidAnnotationTarget
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started GetTelegramAnnotations execution",
+		slog.String("target_type", string(input.TargetType)),
+		slog.String("target_id", input.TargetID.String()),
+	)
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer rollback(ctx, interactor.logger, transactionManager)
+
+	annotationRepository := interactor.telegramAnnotationRepositoryFactory.
+		CreateTelegramAnnotationRepositoryWithTransaction(transactionManager)
+	tags, err := annotationRepository.GetTelegramTags(ctx, input.TargetType, input.TargetID)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get telegram tags", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	notes, err := annotationRepository.GetTelegramNotes(ctx, input.TargetType, input.TargetID)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get telegram notes", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetTelegramAnnotations execution")
+	return &GetTelegramAnnotationsResponse{Tags: *tags, Notes: *notes}, nil
+}
@@ -0,0 +1,107 @@
+package annotation
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+)
+
+const (
+	DefaultTelegramTagCatalogue = 20
+	// MaxTelegramTagCatalogue is the amount of tags suggested at once.
+	MaxTelegramTagCatalogue = 100
+)
+
+var ErrInvalidCatalogueLimit = errors.New("limit must be between 1 and 100")
+
+type GetTelegramTagCatalogueRequest struct {
+	Prefix string
+	Limit  int
+}
+
+type GetTelegramTagCatalogueResponse struct {
+	Tags []domain.TelegramTagCount
+}
+
+// GetTelegramTagCatalogue suggests the tags in use starting with a prefix, the most used first,
+// for the tags to be completed as they're typed.
+type GetTelegramTagCatalogue struct {
+	transactionManagerFactory           interfaces.TransactionManagerFactory
+	telegramAnnotationRepositoryFactory repository.TelegramAnnotationRepositoryFactory
+	logger                              *slog.Logger
+}
+
+func NewGetTelegramTagCatalogue(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramAnnotationRepositoryFactory repository.TelegramAnnotationRepositoryFactory,
+	logger *slog.Logger,
+) *GetTelegramTagCatalogue {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "get_telegram_tag_catalogue"),
+	)
+	return &GetTelegramTagCatalogue{
+		transactionManagerFactory:           transactionManagerFactory,
+		telegramAnnotationRepositoryFactory: telegramAnnotationRepositoryFactory,
+		logger:                              iLogger,
+	}
+}
+
+func (interactor *GetTelegramTagCatalogue) Execute(
+	ctx context.Context,
+	input GetTelegramTagCatalogueRequest,
+) (*GetTelegramTagCatalogueResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	limit := input.Limit
+	if limit == 0 {
+		limit = DefaultTelegramTagCatalogue
+	}
+	if limit < 1 || limit > MaxTelegramTagCatalogue {
+		return nil, ErrInvalidCatalogueLimit
+	}
+	// The prefix is normalised like the tags it's matched against
+	prefix := ""
+	if strings.TrimSpace(input.Prefix) != "" {
+		var valid bool
+		if prefix, valid = service.NormalizeTelegramTag(input.Prefix); !valid {
+			return nil, domain.ErrInvalidTag
+		}
+	}
+	interactor.logger.DebugContext(ctx, "Started GetTelegramTagCatalogue execution", slog.String("prefix", prefix))
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer rollback(ctx, interactor.logger, transactionManager)
+
+	annotationRepository := interactor.telegramAnnotationRepositoryFactory.
+		CreateTelegramAnnotationRepositoryWithTransaction(transactionManager)
+	tags, err := annotationRepository.GetTelegramTagCatalogue(ctx, prefix, limit)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get telegram tag catalogue", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetTelegramTagCatalogue execution")
+	return &GetTelegramTagCatalogueResponse{Tags: *tags}, nil
+}
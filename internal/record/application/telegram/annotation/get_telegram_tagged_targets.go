@@ -0,0 +1,112 @@
+package annotation
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+)
+
+const (
+	DefaultTelegramTaggedTargets = 50
+	// MaxTelegramTaggedTargets is the amount of tagged targets returned at once.
+	MaxTelegramTaggedTargets = 200
+)
+
+var ErrInvalidPagination = errors.New("limit must be between 1 and 200 and offset must not be negative")
+
+type GetTelegramTaggedTargetsRequest struct {
+	Name string
+	// TargetType only lists the targets of the type when it's set.
+	TargetType domain.TelegramAnnotationTargetType
+	Limit      int
+	Offset     int
+}
+
+type GetTelegramTaggedTargetsResponse struct {
+	Tags []domain.TelegramTag
+}
+
+// GetTelegramTaggedTargets lists the telegram users, identities and records holding a tag, the latest tagged first.
+type GetTelegramTaggedTargets struct {
+	transactionManagerFactory           interfaces.TransactionManagerFactory
+	telegramAnnotationRepositoryFactory repository.TelegramAnnotationRepositoryFactory
+	logger                              *slog.Logger
+}
+
+func NewGetTelegramTaggedTargets(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramAnnotationRepositoryFactory repository.TelegramAnnotationRepositoryFactory,
+	logger *slog.Logger,
+) *GetTelegramTaggedTargets {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "get_telegram_tagged_targets"),
+	)
+	return &GetTelegramTaggedTargets{
+		transactionManagerFactory:           transactionManagerFactory,
+		telegramAnnotationRepositoryFactory: telegramAnnotationRepositoryFactory,
+		logger:                              iLogger,
+	}
+}
+
+func (interactor *GetTelegramTaggedTargets) Execute(
+	ctx context.Context,
+	input GetTelegramTaggedTargetsRequest,
+) (*GetTelegramTaggedTargetsResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	name, valid := service.NormalizeTelegramTag(input.Name)
+	if !valid {
+		return nil, domain.ErrInvalidTag
+	}
+	if input.TargetType != "" && !input.TargetType.Valid() {
+		return nil, ErrInvalidAnnotationTarget
+	}
+	limit := input.Limit
+	if limit == 0 {
+		limit = DefaultTelegramTaggedTargets
+	}
+	if limit < 1 || limit > MaxTelegramTaggedTargets || input.Offset < 0 {
+		return nil, ErrInvalidPagination
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started GetTelegramTaggedTargets execution",
+		slog.String("tag", name),
+		slog.String("target_type", string(input.TargetType)),
+	)
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer rollback(ctx, interactor.logger, transactionManager)
+
+	annotationRepository := interactor.telegramAnnotationRepositoryFactory.
+		CreateTelegramAnnotationRepositoryWithTransaction(transactionManager)
+	tags, err := annotationRepository.GetTelegramTagsByName(ctx, name, input.TargetType, limit, input.Offset)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get telegram tagged targets", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetTelegramTaggedTargets execution")
+	return &GetTelegramTaggedTargetsResponse{Tags: *tags}, nil
+}
@@ -0,0 +1,116 @@
+package annotation
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type RemoveTelegramNoteRequest struct {
+	TargetType domain.TelegramAnnotationTargetType
+	TargetID   uuid.UUID
+	NoteID     uuid.UUID
+}
+
+// RemoveTelegramNote removes a note about a telegram user, identity or record,
+// only the user who has written the note and the admins remove it.
+type RemoveTelegramNote struct {
+	transactionManagerFactory           interfaces.TransactionManagerFactory
+	telegramAnnotationRepositoryFactory repository.TelegramAnnotationRepositoryFactory
+	auditClient                         auditclient.AuditClient
+	logger                              *slog.Logger
+}
+
+func NewRemoveTelegramNote(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramAnnotationRepositoryFactory repository.TelegramAnnotationRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *RemoveTelegramNote {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "remove_telegram_note"),
+	)
+	return &RemoveTelegramNote{
+		transactionManagerFactory:           transactionManagerFactory,
+		telegramAnnotationRepositoryFactory: telegramAnnotationRepositoryFactory,
+		auditClient:                         auditClient,
+		logger:                              iLogger,
+	}
+}
+
+func (interactor *RemoveTelegramNote) Execute(ctx context.Context, input RemoveTelegramNoteRequest) error {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return rbac.ErrInsufficientPrivileges
+	}
+	if !input.TargetType.Valid() {
+		return ErrInvalidAnnotationTarget
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started RemoveTelegramNote execution",
+		slog.String("target_type", string(input.TargetType)),
+		slog.String("target_id", input.TargetID.String()),
+		slog.String("note_id", input.NoteID.String()),
+	)
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+
+	annotationRepository := interactor.telegramAnnotationRepositoryFactory.
+		CreateTelegramAnnotationRepositoryWithTransaction(transactionManager)
+	note, err := annotationRepository.GetTelegramNote(ctx, input.TargetType, input.TargetID, input.NoteID)
+	if err == nil {
+		if err = authorizeRemoval(idp, note.AddedBy); err == nil {
+			err = annotationRepository.RemoveTelegramNote(ctx, note.ID)
+		}
+	}
+	if err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		switch {
+		case errors.Is(err, domain.ErrNoteNotFound), errors.Is(err, rbac.ErrInsufficientPrivileges):
+			return err
+		default:
+			interactor.logger.ErrorContext(ctx, "failed to remove telegram note", slog.Any("err", err))
+			return application.ErrDatabaseFailed
+		}
+	}
+	entry := application.NewTelegramAuditEntry(
+		auditclient.TelegramNoteRemoved,
+		application.AuditTargetTelegramNote,
+		note.ID,
+		note,
+		nil,
+	)
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record audit entry", slog.Any("err", err))
+		rollback(ctx, interactor.logger, transactionManager)
+		return application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished RemoveTelegramNote execution")
+	return nil
+}
@@ -0,0 +1,121 @@
+package annotation
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type RemoveTelegramTagRequest struct {
+	TargetType domain.TelegramAnnotationTargetType
+	TargetID   uuid.UUID
+	Name       string
+}
+
+// RemoveTelegramTag removes a tag from a telegram user, identity or record,
+// only the user who has added the tag and the admins remove it.
+type RemoveTelegramTag struct {
+	transactionManagerFactory           interfaces.TransactionManagerFactory
+	telegramAnnotationRepositoryFactory repository.TelegramAnnotationRepositoryFactory
+	auditClient                         auditclient.AuditClient
+	logger                              *slog.Logger
+}
+
+func NewRemoveTelegramTag(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramAnnotationRepositoryFactory repository.TelegramAnnotationRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *RemoveTelegramTag {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "remove_telegram_tag"),
+	)
+	return &RemoveTelegramTag{
+		transactionManagerFactory:           transactionManagerFactory,
+		telegramAnnotationRepositoryFactory: telegramAnnotationRepositoryFactory,
+		auditClient:                         auditClient,
+		logger:                              iLogger,
+	}
+}
+
+func (interactor *RemoveTelegramTag) Execute(ctx context.Context, input RemoveTelegramTagRequest) error {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return rbac.ErrInsufficientPrivileges
+	}
+	if !input.TargetType.Valid() {
+		return ErrInvalidAnnotationTarget
+	}
+	name, valid := service.NormalizeTelegramTag(input.Name)
+	if !valid {
+		return domain.ErrTagNotFound
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started RemoveTelegramTag execution",
+		slog.String("target_type", string(input.TargetType)),
+		slog.String("target_id", input.TargetID.String()),
+		slog.String("tag", name),
+	)
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+
+	annotationRepository := interactor.telegramAnnotationRepositoryFactory.
+		CreateTelegramAnnotationRepositoryWithTransaction(transactionManager)
+	tag, err := annotationRepository.GetTelegramTag(ctx, input.TargetType, input.TargetID, name)
+	if err == nil {
+		if err = authorizeRemoval(idp, tag.AddedBy); err == nil {
+			err = annotationRepository.RemoveTelegramTag(ctx, tag.ID)
+		}
+	}
+	if err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		switch {
+		case errors.Is(err, domain.ErrTagNotFound), errors.Is(err, rbac.ErrInsufficientPrivileges):
+			return err
+		default:
+			interactor.logger.ErrorContext(ctx, "failed to remove telegram tag", slog.Any("err", err))
+			return application.ErrDatabaseFailed
+		}
+	}
+	entry := application.NewTelegramAuditEntry(
+		auditclient.TelegramTagRemoved,
+		application.AuditTargetTelegramTag,
+		tag.ID,
+		tag,
+		nil,
+	)
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record audit entry", slog.Any("err", err))
+		rollback(ctx, interactor.logger, transactionManager)
+		return application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished RemoveTelegramTag execution")
+	return nil
+}
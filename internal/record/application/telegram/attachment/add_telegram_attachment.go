@@ -0,0 +1,196 @@
+package attachment
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/InWamos/trinity-proto/config"
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	blobstorage "github.com/InWamos/trinity-proto/internal/shared/infrastructure/blob_storage"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type AddTelegramAttachmentRequest struct {
+	RecordID             uuid.UUID
+	TelegramAttachmentID uint64
+	FileName             string
+	Content              io.Reader
+}
+
+type AddTelegramAttachmentResponse struct {
+	AttachmentID uuid.UUID
+	SHA256       string
+	MimeType     string
+	FileSize     uint64
+}
+
+// AddTelegramAttachment stores the uploaded file in the blob storage and links it to a record.
+// Files are content-addressed, so an identical file uploaded for another record is not stored twice.
+type AddTelegramAttachment struct {
+	transactionManagerFactory interfaces.TransactionManagerFactory
+	telegramDomainValidator   *service.TelegramModelValidator
+	telegramAttachmentFactory repository.TelegramAttachmentRepositoryFactory
+	chainAppender             *application.TelegramChainAppender
+	blobStore                 interfaces.BlobStore
+	maxUploadSize             int64
+	auditClient               auditclient.AuditClient
+	logger                    *slog.Logger
+}
+
+func NewAddTelegramAttachment(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramDomainValidator *service.TelegramModelValidator,
+	telegramAttachmentFactory repository.TelegramAttachmentRepositoryFactory,
+	chainAppender *application.TelegramChainAppender,
+	blobStore interfaces.BlobStore,
+	storageConfig *config.StorageConfig,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *AddTelegramAttachment {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "add_telegram_attachment"),
+	)
+	return &AddTelegramAttachment{
+		transactionManagerFactory: transactionManagerFactory,
+		telegramDomainValidator:   telegramDomainValidator,
+		telegramAttachmentFactory: telegramAttachmentFactory,
+		chainAppender:             chainAppender,
+		blobStore:                 blobStore,
+		maxUploadSize:             storageConfig.MaxUploadSize,
+		auditClient:               auditClient,
+		logger:                    iLogger,
+	}
+}
+
+func (interactor *AddTelegramAttachment) Execute(
+	ctx context.Context,
+	input AddTelegramAttachmentRequest,
+) (*AddTelegramAttachmentResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	interactor.logger.DebugContext(
+		ctx,
+		"Started AddTelegramAttachment execution",
+		slog.String("record_id", input.RecordID.String()),
+	)
+
+	blob, err := blobstorage.SpoolBlob(input.Content, interactor.maxUploadSize)
+	if err != nil {
+		switch {
+		case errors.Is(err, blobstorage.ErrBlobTooLarge), errors.Is(err, blobstorage.ErrBlobEmpty):
+			return nil, err
+		case errors.Is(err, blobstorage.ErrBlobReadFailed):
+			interactor.logger.DebugContext(ctx, "failed to read the upload", slog.Any("err", err))
+			return nil, err
+		}
+		interactor.logger.ErrorContext(ctx, "failed to spool the upload", slog.Any("err", err))
+		return nil, application.ErrBlobStorageFailed
+	}
+	defer func() {
+		if closeErr := blob.Close(); closeErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to remove spooled upload", slog.Any("err", closeErr))
+		}
+	}()
+
+	attachment := &domain.TelegramAttachment{
+		ID:                   uuid.New(),
+		RecordID:             input.RecordID,
+		TelegramAttachmentID: input.TelegramAttachmentID,
+		FileName:             input.FileName,
+		StorageKey:           blob.StorageKey(),
+		SHA256:               blob.SHA256,
+		FileSize:             uint64(blob.Size),
+		MimeType:             blob.MimeType,
+		AddedAt:              time.Now(),
+		AddedByUser:          idp.UserID,
+	}
+	// Validate the rules before adding to the database
+	if err = interactor.telegramDomainValidator.Validate(attachment); err != nil {
+		return nil, err
+	}
+
+	// The blob goes first: a row must never point to missing content.
+	// An orphaned blob left by a failed insert is harmless and reused by the next identical upload.
+	if err = blob.Store(ctx, interactor.blobStore); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to store the blob", slog.Any("err", err))
+		return nil, application.ErrBlobStorageFailed
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	attachmentRepository := interactor.telegramAttachmentFactory.CreateTelegramAttachmentRepositoryWithTransaction(
+		transactionManager,
+	)
+	if err = attachmentRepository.AddAttachment(ctx, attachment); err != nil {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		switch {
+		case errors.Is(err, domain.ErrUnexistentTelegramRecordReferenced),
+			errors.Is(err, domain.ErrAttachmentAlreadyExists):
+			return nil, err
+		default:
+			interactor.logger.ErrorContext(ctx, "failed to add telegram attachment", slog.Any("err", err))
+			return nil, application.ErrDatabaseFailed
+		}
+	}
+
+	if err = interactor.chainAppender.AppendAttachments(ctx, transactionManager, attachment.ID); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to chain telegram attachment", slog.Any("err", err))
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		return nil, application.ErrDatabaseFailed
+	}
+
+	entry := application.NewTelegramAuditEntry(
+		auditclient.TelegramAttachmentAdded,
+		application.AuditTargetTelegramAttachment,
+		attachment.ID,
+		nil,
+		attachment,
+	)
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record audit entry", slog.Any("err", err))
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		return nil, application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished AddTelegramAttachment execution")
+	return &AddTelegramAttachmentResponse{
+		AttachmentID: attachment.ID,
+		SHA256:       attachment.SHA256,
+		MimeType:     attachment.MimeType,
+		FileSize:     attachment.FileSize,
+	}, nil
+}
@@ -0,0 +1,128 @@
+package attachment
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type DownloadTelegramAttachmentRequest struct {
+	AttachmentID uuid.UUID
+}
+
+// DownloadTelegramAttachmentResponse holds an open stream of the attachment content.
+// The caller must close Content.
+type DownloadTelegramAttachmentResponse struct {
+	Attachment domain.TelegramAttachment
+	Content    io.ReadCloser
+}
+
+type DownloadTelegramAttachment struct {
+	transactionManagerFactory interfaces.TransactionManagerFactory
+	telegramAttachmentFactory repository.TelegramAttachmentRepositoryFactory
+	blobStore                 interfaces.BlobStore
+	logger                    *slog.Logger
+}
+
+func NewDownloadTelegramAttachment(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramAttachmentFactory repository.TelegramAttachmentRepositoryFactory,
+	blobStore interfaces.BlobStore,
+	logger *slog.Logger,
+) *DownloadTelegramAttachment {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "download_telegram_attachment"),
+	)
+	return &DownloadTelegramAttachment{
+		transactionManagerFactory: transactionManagerFactory,
+		telegramAttachmentFactory: telegramAttachmentFactory,
+		blobStore:                 blobStore,
+		logger:                    iLogger,
+	}
+}
+
+func (interactor *DownloadTelegramAttachment) Execute(
+	ctx context.Context,
+	input DownloadTelegramAttachmentRequest,
+) (*DownloadTelegramAttachmentResponse, error) {
+	interactor.logger.DebugContext(
+		ctx,
+		"Started DownloadTelegramAttachment execution",
+		slog.String("attachment_id", input.AttachmentID.String()),
+	)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	attachment, err := interactor.getAttachment(ctx, input.AttachmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := interactor.blobStore.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, interfaces.ErrBlobNotFound) {
+			interactor.logger.ErrorContext(
+				ctx,
+				"attachment content is missing from the blob storage",
+				slog.String("storage_key", attachment.StorageKey),
+			)
+			return nil, domain.ErrAttachmentNotFound
+		}
+		interactor.logger.ErrorContext(ctx, "failed to read the blob", slog.Any("err", err))
+		return nil, application.ErrBlobStorageFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished DownloadTelegramAttachment execution")
+	return &DownloadTelegramAttachmentResponse{
+		Attachment: *attachment,
+		Content:    content,
+	}, nil
+}
+
+// getAttachment reads the metadata in its own short transaction,
+// so no database connection is held while the content is streamed.
+func (interactor *DownloadTelegramAttachment) getAttachment(
+	ctx context.Context,
+	attachmentID uuid.UUID,
+) (*domain.TelegramAttachment, error) {
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer func() {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+	}()
+
+	attachmentRepository := interactor.telegramAttachmentFactory.CreateTelegramAttachmentRepositoryWithTransaction(
+		transactionManager,
+	)
+	attachment, err := attachmentRepository.GetAttachmentByID(ctx, attachmentID)
+	if err != nil {
+		if errors.Is(err, domain.ErrAttachmentNotFound) {
+			return nil, err
+		}
+		interactor.logger.ErrorContext(ctx, "failed to get telegram attachment", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	return attachment, nil
+}
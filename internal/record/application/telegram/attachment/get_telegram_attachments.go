@@ -0,0 +1,87 @@
+package attachment
+
+import (
+	"context"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type GetTelegramAttachmentsRequest struct {
+	RecordID uuid.UUID
+}
+
+type GetTelegramAttachmentsResponse struct {
+	Attachments []domain.TelegramAttachment
+}
+
+type GetTelegramAttachments struct {
+	transactionManagerFactory interfaces.TransactionManagerFactory
+	telegramAttachmentFactory repository.TelegramAttachmentRepositoryFactory
+	logger                    *slog.Logger
+}
+
+func NewGetTelegramAttachments(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramAttachmentFactory repository.TelegramAttachmentRepositoryFactory,
+	logger *slog.Logger,
+) *GetTelegramAttachments {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "get_telegram_attachments"),
+	)
+	return &GetTelegramAttachments{
+		transactionManagerFactory: transactionManagerFactory,
+		telegramAttachmentFactory: telegramAttachmentFactory,
+		logger:                    iLogger,
+	}
+}
+
+func (interactor *GetTelegramAttachments) Execute(
+	ctx context.Context,
+	input GetTelegramAttachmentsRequest,
+) (*GetTelegramAttachmentsResponse, error) {
+	interactor.logger.DebugContext(
+		ctx,
+		"Started GetTelegramAttachments execution",
+		slog.String("record_id", input.RecordID.String()),
+	)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer func() {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+	}()
+
+	attachmentRepository := interactor.telegramAttachmentFactory.CreateTelegramAttachmentRepositoryWithTransaction(
+		transactionManager,
+	)
+	attachments, err := attachmentRepository.GetAttachmentsByRecordID(ctx, input.RecordID)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get telegram attachments", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetTelegramAttachments execution")
+	return &GetTelegramAttachmentsResponse{Attachments: *attachments}, nil
+}
@@ -0,0 +1,432 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+// TelegramBotSender is the author of a message.
+// Channel posts are signed by the channel itself, in that case TelegramID is the bare channel ID.
+type TelegramBotSender struct {
+	TelegramID uint64
+	Username   string
+	FirstName  string
+	LastName   string
+}
+
+type TelegramBotChat struct {
+	TelegramID int64
+	Type       domain.TelegramChatType
+	Title      string
+	Username   string
+}
+
+type ReceiveTelegramBotUpdateRequest struct {
+	MessageTelegramID        uint64
+	Sender                   TelegramBotSender
+	Chat                     TelegramBotChat
+	Text                     string
+	Entities                 []domain.TelegramMessageEntity
+	Caption                  string
+	CaptionEntities          []domain.TelegramMessageEntity
+	PostedAt                 time.Time
+	EditedAt                 *time.Time
+	ReplyToMessageTelegramID *uint64
+	ThreadTelegramID         *uint64
+	ForwardOrigin            *domain.TelegramForwardOrigin
+}
+
+type ReceiveTelegramBotUpdateResponse struct {
+	RecordID uuid.UUID
+	// Revised is set when the message has been edited since it has been received.
+	Revised bool
+	// Duplicate is set when the message has already been received unchanged, e.g. it's a redelivery.
+	Duplicate bool
+}
+
+// ReceiveTelegramBotUpdate stores a message received by a bot along with its chat, author and author's identity.
+type ReceiveTelegramBotUpdate struct {
+	transactionManagerFactory interfaces.TransactionManagerFactory
+	telegramDomainValidator   *service.TelegramModelValidator
+	telegramUserFactory       repository.TelegramUserRepositoryFactory
+	telegramIdentityFactory   repository.TelegramIdentityRepositoryFactory
+	telegramChatFactory       repository.TelegramChatRepositoryFactory
+	telegramRecordFactory     repository.TelegramRecordRepositoryFactory
+	recordAnalyzer            *application.TelegramRecordAnalyzer
+	chainAppender             *application.TelegramChainAppender
+	watchlistEvaluator        *application.TelegramWatchlistEvaluator
+	eventPublisher            *application.TelegramEventPublisher
+	provenanceRecorder        *application.TelegramProvenanceRecorder
+	auditClient               auditclient.AuditClient
+	logger                    *slog.Logger
+}
+
+func NewReceiveTelegramBotUpdate(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramDomainValidator *service.TelegramModelValidator,
+	telegramUserFactory repository.TelegramUserRepositoryFactory,
+	telegramIdentityFactory repository.TelegramIdentityRepositoryFactory,
+	telegramChatFactory repository.TelegramChatRepositoryFactory,
+	telegramRecordFactory repository.TelegramRecordRepositoryFactory,
+	recordAnalyzer *application.TelegramRecordAnalyzer,
+	chainAppender *application.TelegramChainAppender,
+	watchlistEvaluator *application.TelegramWatchlistEvaluator,
+	eventPublisher *application.TelegramEventPublisher,
+	provenanceRecorder *application.TelegramProvenanceRecorder,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *ReceiveTelegramBotUpdate {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "receive_telegram_bot_update"),
+	)
+	return &ReceiveTelegramBotUpdate{
+		transactionManagerFactory: transactionManagerFactory,
+		telegramDomainValidator:   telegramDomainValidator,
+		telegramUserFactory:       telegramUserFactory,
+		telegramIdentityFactory:   telegramIdentityFactory,
+		telegramChatFactory:       telegramChatFactory,
+		telegramRecordFactory:     telegramRecordFactory,
+		recordAnalyzer:            recordAnalyzer,
+		chainAppender:             chainAppender,
+		watchlistEvaluator:        watchlistEvaluator,
+		eventPublisher:            eventPublisher,
+		provenanceRecorder:        provenanceRecorder,
+		auditClient:               auditClient,
+		logger:                    iLogger,
+	}
+}
+
+func (interactor *ReceiveTelegramBotUpdate) Execute(
+	ctx context.Context,
+	input ReceiveTelegramBotUpdateRequest,
+) (*ReceiveTelegramBotUpdateResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	interactor.logger.DebugContext(
+		ctx,
+		"Started ReceiveTelegramBotUpdate execution",
+		slog.Uint64("message_telegram_id", input.MessageTelegramID),
+		slog.Int64("chat_telegram_id", input.Chat.TelegramID),
+	)
+
+	now := time.Now()
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	if err = interactor.ensureChat(ctx, transactionManager, idp, input.Chat, now); err != nil {
+		interactor.rollback(ctx, transactionManager)
+		return nil, err
+	}
+	userID, err := interactor.ensureSender(ctx, transactionManager, idp, input.Sender, now)
+	if err != nil {
+		interactor.rollback(ctx, transactionManager)
+		return nil, err
+	}
+
+	telegramRecord := domain.TelegramRecord{
+		ID:                       uuid.New(),
+		MessageTelegramID:        input.MessageTelegramID,
+		FromTelegramUserID:       userID,
+		InTelegramChatID:         input.Chat.TelegramID,
+		MessageText:              input.Text,
+		Entities:                 input.Entities,
+		Caption:                  input.Caption,
+		CaptionEntities:          input.CaptionEntities,
+		PostedAt:                 input.PostedAt,
+		EditedAt:                 input.EditedAt,
+		ReplyToMessageTelegramID: input.ReplyToMessageTelegramID,
+		ThreadTelegramID:         input.ThreadTelegramID,
+		ForwardOrigin:            input.ForwardOrigin,
+		AddedAt:                  now,
+		AddedByUser:              idp.UserID,
+	}
+	// Validate the rules before adding to the database
+	if err = interactor.telegramDomainValidator.Validate(&telegramRecord); err != nil {
+		interactor.rollback(ctx, transactionManager)
+		return nil, err
+	}
+	recordRepository := interactor.telegramRecordFactory.CreateTelegramRecordRepositoryWithTransaction(
+		transactionManager,
+	)
+	recordErrors, err := recordRepository.CreateTelegramRecords(ctx, []domain.TelegramRecord{telegramRecord})
+	if err != nil {
+		interactor.rollback(ctx, transactionManager)
+		return nil, interactor.mapRepositoryError(ctx, err)
+	}
+	response := &ReceiveTelegramBotUpdateResponse{RecordID: telegramRecord.ID}
+	auditEntries := application.NewTelegramRecordsAddedAuditEntries(telegramRecord)
+	// An unchanged message stored by another collector is only observed by this one
+	unchanged := false
+	switch {
+	case errors.Is(recordErrors[0], domain.ErrRecordAlreadyExists):
+		stored, reviseErr := application.ReviseTelegramRecord(ctx, recordRepository, telegramRecord)
+		switch {
+		case reviseErr == nil:
+			response = &ReceiveTelegramBotUpdateResponse{RecordID: stored.ID, Revised: true}
+			auditEntries = []auditclient.Entry{application.NewTelegramRecordRevisedAuditEntry(stored, telegramRecord)}
+			telegramRecord.ID = stored.ID
+		case errors.Is(reviseErr, domain.ErrRecordAlreadyExists) && stored != nil:
+			response = &ReceiveTelegramBotUpdateResponse{RecordID: stored.ID}
+			telegramRecord.ID = stored.ID
+			unchanged = true
+		case errors.Is(reviseErr, domain.ErrRecordAlreadyExists):
+			// The message is stored by a collector whose observation of it this one doesn't see
+			if err = transactionManager.Commit(ctx); err != nil {
+				interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+				return nil, application.ErrDatabaseFailed
+			}
+			return &ReceiveTelegramBotUpdateResponse{Duplicate: true}, nil
+		default:
+			interactor.rollback(ctx, transactionManager)
+			return nil, interactor.mapRepositoryError(ctx, reviseErr)
+		}
+	case recordErrors[0] != nil:
+		interactor.rollback(ctx, transactionManager)
+		return nil, interactor.mapRepositoryError(ctx, recordErrors[0])
+	}
+	observed, err := interactor.provenanceRecorder.ObserveRecords(ctx, transactionManager, idp.UserID, telegramRecord.ID)
+	if err != nil {
+		interactor.rollback(ctx, transactionManager)
+		return nil, interactor.mapRepositoryError(ctx, err)
+	}
+	if unchanged && !observed[telegramRecord.ID] {
+		response = &ReceiveTelegramBotUpdateResponse{Duplicate: true}
+	}
+	if !unchanged {
+		err = interactor.recordAnalyzer.Analyze(
+			ctx,
+			transactionManager,
+			telegramRecord,
+		)
+		if err == nil {
+			err = interactor.chainAppender.AppendRecords(ctx, transactionManager, telegramRecord.ID)
+		}
+		if err == nil && !response.Revised {
+			err = interactor.eventPublisher.PublishRecordsCreated(ctx, transactionManager, telegramRecord)
+		}
+		if err == nil {
+			err = interactor.auditClient.Record(ctx, transactionManager, auditEntries...)
+		}
+		if err != nil {
+			interactor.rollback(ctx, transactionManager)
+			return nil, interactor.mapRepositoryError(ctx, err)
+		}
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished ReceiveTelegramBotUpdate execution")
+	return response, nil
+}
+
+// ensureChat adds the chat the first time a bot sees it.
+func (interactor *ReceiveTelegramBotUpdate) ensureChat(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	idp *client.UserIdentity,
+	input TelegramBotChat,
+	now time.Time,
+) error {
+	chatRepository := interactor.telegramChatFactory.CreateTelegramChatRepositoryWithTransaction(transactionManager)
+	_, err := chatRepository.GetChatByTelegramID(ctx, input.TelegramID, idp.UserID)
+	switch {
+	case err == nil:
+		return nil
+	case !errors.Is(err, domain.ErrChatNotFound):
+		return interactor.mapRepositoryError(ctx, err)
+	}
+
+	telegramChat := &domain.TelegramChat{
+		ID:             uuid.New(),
+		ChatTelegramID: input.TelegramID,
+		Type:           input.Type,
+		AddedAt:        now,
+		AddedByUser:    idp.UserID,
+	}
+	if err = interactor.telegramDomainValidator.Validate(telegramChat); err != nil {
+		return err
+	}
+	if err = chatRepository.AddChat(ctx, telegramChat); err != nil {
+		return interactor.mapRepositoryError(ctx, err)
+	}
+	entry := application.NewTelegramAuditEntry(
+		auditclient.TelegramChatAdded,
+		application.AuditTargetTelegramChat,
+		telegramChat.ID,
+		nil,
+		telegramChat,
+	)
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		return interactor.mapRepositoryError(ctx, err)
+	}
+
+	snapshot := &domain.TelegramChatSnapshot{
+		ID:          uuid.New(),
+		ChatID:      telegramChat.ID,
+		Title:       input.Title,
+		Username:    input.Username,
+		AddedAt:     now,
+		AddedByUser: idp.UserID,
+	}
+	// The chat is still worth keeping when Telegram sends metadata beyond the domain limits
+	if err = interactor.telegramDomainValidator.Validate(snapshot); err != nil {
+		interactor.logger.DebugContext(ctx, "chat snapshot has been skipped", slog.Any("err", err))
+		return nil
+	}
+	if err = chatRepository.AddChatSnapshot(ctx, snapshot); err != nil {
+		return interactor.mapRepositoryError(ctx, err)
+	}
+	entry = application.NewTelegramAuditEntry(
+		auditclient.TelegramChatSnapshotAdded,
+		application.AuditTargetTelegramChatSnapshot,
+		snapshot.ID,
+		nil,
+		snapshot,
+	)
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		return interactor.mapRepositoryError(ctx, err)
+	}
+	return nil
+}
+
+// ensureSender adds the author of the message the first time a bot sees it, or observes the one added
+// by another collector, and a new identity each time the author's profile changes.
+func (interactor *ReceiveTelegramBotUpdate) ensureSender(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	idp *client.UserIdentity,
+	input TelegramBotSender,
+	now time.Time,
+) (uuid.UUID, error) {
+	userRepository := interactor.telegramUserFactory.CreateTelegramUserRepositoryWithTransaction(transactionManager)
+	telegramUser, err := userRepository.GetByTelegramID(ctx, input.TelegramID)
+	switch {
+	case errors.Is(err, domain.ErrUserNotFound):
+		newUser := &domain.TelegramUser{
+			ID:          uuid.New(),
+			TelegramID:  input.TelegramID,
+			AddedAt:     now,
+			AddedByUser: idp.UserID,
+		}
+		if err = interactor.telegramDomainValidator.Validate(newUser); err != nil {
+			return uuid.Nil, err
+		}
+		telegramUser, err = interactor.provenanceRecorder.AddUser(ctx, transactionManager, userRepository, newUser)
+		if err != nil && !errors.Is(err, domain.ErrUserAlreadyExists) {
+			return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+		}
+		if telegramUser.ID != newUser.ID {
+			break
+		}
+		entry := application.NewTelegramAuditEntry(
+			auditclient.TelegramUserAdded,
+			application.AuditTargetTelegramUser,
+			telegramUser.ID,
+			nil,
+			telegramUser,
+		)
+		if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+			return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+		}
+	case err != nil:
+		return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+	default:
+		if _, err = interactor.provenanceRecorder.ObserveUsers(
+			ctx,
+			transactionManager,
+			idp.UserID,
+			telegramUser.ID,
+		); err != nil {
+			return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+		}
+	}
+
+	telegramIdentity := &domain.TelegramIdentity{
+		ID:          uuid.New(),
+		UserID:      telegramUser.ID,
+		Username:    input.Username,
+		FirstName:   input.FirstName,
+		LastName:    input.LastName,
+		AddedAt:     now,
+		AddedByUser: idp.UserID,
+	}
+	// Not every author fits an identity, e.g. the ones without a username
+	if err = interactor.telegramDomainValidator.Validate(telegramIdentity); err != nil {
+		interactor.logger.DebugContext(ctx, "sender identity has been skipped", slog.Any("err", err))
+		return telegramUser.ID, nil
+	}
+	identityRepository := interactor.telegramIdentityFactory.CreateTelegramIdentityRepositoryWithTransaction(
+		transactionManager,
+	)
+	// An unchanged profile is simply not added again
+	err = transactionManager.InSavepoint(ctx, func() error {
+		return identityRepository.AddIdentity(ctx, telegramIdentity)
+	})
+	switch {
+	case errors.Is(err, domain.ErrIdentityAlreadyExists):
+	case err != nil:
+		return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+	default:
+		if err = interactor.watchlistEvaluator.EvaluateIdentities(ctx, transactionManager, telegramIdentity.ID); err != nil {
+			return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+		}
+		if err = interactor.chainAppender.AppendIdentities(ctx, transactionManager, telegramIdentity.ID); err != nil {
+			return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+		}
+		if err = interactor.eventPublisher.PublishIdentityCreated(ctx, transactionManager, telegramIdentity); err != nil {
+			return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+		}
+		entry := application.NewTelegramAuditEntry(
+			auditclient.TelegramIdentityAdded,
+			application.AuditTargetTelegramIdentity,
+			telegramIdentity.ID,
+			nil,
+			telegramIdentity,
+		)
+		if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+			return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+		}
+	}
+	return telegramUser.ID, nil
+}
+
+func (interactor *ReceiveTelegramBotUpdate) mapRepositoryError(ctx context.Context, err error) error {
+	interactor.logger.ErrorContext(ctx, "failed to store telegram bot update", slog.Any("err", err))
+	return application.ErrDatabaseFailed
+}
+
+func (interactor *ReceiveTelegramBotUpdate) rollback(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+) {
+	if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+		interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+	}
+}
@@ -0,0 +1,94 @@
+package chain
+
+import (
+	"context"
+	"crypto/ed25519"
+	"log/slog"
+	"time"
+
+	"github.com/InWamos/trinity-proto/config"
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/google/uuid"
+)
+
+// CheckpointTelegramChains signs the head of every chain that has grown since its latest checkpoint.
+// It runs on behalf of the server, without a signing key there is nothing to do.
+type CheckpointTelegramChains struct {
+	transactionManagerFactory      interfaces.TransactionManagerFactory
+	telegramChainRepositoryFactory repository.TelegramChainRepositoryFactory
+	signingKey                     ed25519.PrivateKey
+	logger                         *slog.Logger
+}
+
+func NewCheckpointTelegramChains(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramChainRepositoryFactory repository.TelegramChainRepositoryFactory,
+	chainConfig *config.ChainConfig,
+	logger *slog.Logger,
+) *CheckpointTelegramChains {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "checkpoint_telegram_chains"),
+	)
+	return &CheckpointTelegramChains{
+		transactionManagerFactory:      transactionManagerFactory,
+		telegramChainRepositoryFactory: telegramChainRepositoryFactory,
+		signingKey:                     chainConfig.SigningKey,
+		logger:                         iLogger,
+	}
+}
+
+// Execute returns how many checkpoints have been signed.
+func (interactor *CheckpointTelegramChains) Execute(ctx context.Context) (int, error) {
+	if interactor.signingKey == nil {
+		return 0, nil
+	}
+	interactor.logger.DebugContext(ctx, "Started CheckpointTelegramChains execution")
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return 0, application.ErrDatabaseFailed
+	}
+	chainRepository := interactor.telegramChainRepositoryFactory.CreateTelegramChainRepositoryWithTransaction(
+		transactionManager,
+	)
+
+	heads, err := chainRepository.GetTelegramChainHeads(ctx)
+	signed := 0
+	for i := 0; err == nil && i < len(*heads); i++ {
+		head := (*heads)[i]
+		if head.Sequence <= head.CheckpointedSequence {
+			continue
+		}
+		checkpoint := &domain.TelegramChainCheckpoint{
+			ID:              uuid.New(),
+			CollectorUserID: head.CollectorUserID,
+			TeamID:          head.TeamID,
+			Sequence:        head.Sequence,
+			EntryHash:       head.EntryHash,
+			SignedAt:        time.Now(),
+		}
+		service.SignTelegramChainCheckpoint(interactor.signingKey, checkpoint)
+		if err = chainRepository.AddTelegramChainCheckpoint(ctx, checkpoint); err == nil {
+			signed++
+		}
+	}
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to checkpoint telegram chains", slog.Any("err", err))
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		return 0, application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return 0, application.ErrDatabaseFailed
+	}
+	interactor.logger.DebugContext(ctx, "Finished CheckpointTelegramChains execution", slog.Int("signed", signed))
+	return signed, nil
+}
@@ -0,0 +1,328 @@
+package chain
+
+import (
+	"context"
+	"crypto/ed25519"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/config"
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+const (
+	// verifyPageSize is the amount of entries checked in a single transaction.
+	verifyPageSize = 500
+	// MaxReportedTelegramChainBreaks caps the breaks a verification reports, the walk goes on past it.
+	MaxReportedTelegramChainBreaks = 1000
+)
+
+type VerifyTelegramChainProgress struct {
+	ChainsVerified  int
+	EntriesVerified int
+}
+
+type VerifyTelegramChainRequest struct {
+	// CollectorUserID restricts the verification to the chain of the user, uuid.Nil verifies every chain
+	CollectorUserID uuid.UUID
+	// OnProgress is called after every verified chain, it may be nil
+	OnProgress func(VerifyTelegramChainProgress)
+}
+
+// VerifyTelegramChainResponse holds the breaks found, the chains are intact when there is none.
+// SignaturesVerified is unset without a signing key, the checkpoints are then only compared with the chains.
+// Unchained counts the subjects no entry covers, they're not breaks as they may predate the chains.
+type VerifyTelegramChainResponse struct {
+	Progress            VerifyTelegramChainProgress
+	CheckpointsVerified int
+	SignaturesVerified  bool
+	Breaks              []domain.TelegramChainBreak
+	BreaksTruncated     bool
+	Unchained           map[domain.TelegramChainSubjectType]int64
+}
+
+// VerifyTelegramChain walks the chains from their first entry: every entry has to follow the previous one,
+// the latest entry of each subject has to match its current content and the signed checkpoints have to
+// match the entries they cover. Entries are read page by page, the ones appended after the walk has started
+// are left for the next verification.
+type VerifyTelegramChain struct {
+	transactionManagerFactory      interfaces.TransactionManagerFactory
+	telegramChainRepositoryFactory repository.TelegramChainRepositoryFactory
+	chainAppender                  *application.TelegramChainAppender
+	publicKey                      ed25519.PublicKey
+	logger                         *slog.Logger
+}
+
+func NewVerifyTelegramChain(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramChainRepositoryFactory repository.TelegramChainRepositoryFactory,
+	chainAppender *application.TelegramChainAppender,
+	chainConfig *config.ChainConfig,
+	logger *slog.Logger,
+) *VerifyTelegramChain {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "verify_telegram_chain"),
+	)
+	return &VerifyTelegramChain{
+		transactionManagerFactory:      transactionManagerFactory,
+		telegramChainRepositoryFactory: telegramChainRepositoryFactory,
+		chainAppender:                  chainAppender,
+		publicKey:                      chainConfig.PublicKey(),
+		logger:                         iLogger,
+	}
+}
+
+func (interactor *VerifyTelegramChain) Execute(
+	ctx context.Context,
+	input VerifyTelegramChainRequest,
+) (*VerifyTelegramChainResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleAdmin); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	interactor.logger.InfoContext(
+		ctx,
+		"Started VerifyTelegramChain execution",
+		slog.String("collector_user_id", input.CollectorUserID.String()),
+	)
+	heads, unchained, err := interactor.getHeads(ctx, input.CollectorUserID)
+	if err != nil {
+		return nil, err
+	}
+	if input.CollectorUserID != uuid.Nil && len(heads) == 0 {
+		return nil, domain.ErrTelegramChainNotFound
+	}
+
+	response := &VerifyTelegramChainResponse{
+		SignaturesVerified: interactor.publicKey != nil,
+		Unchained:          unchained,
+	}
+	for i := range heads {
+		if err = interactor.verifyChain(ctx, &heads[i], response); err != nil {
+			return nil, err
+		}
+		response.Progress.ChainsVerified++
+		if input.OnProgress != nil {
+			input.OnProgress(response.Progress)
+		}
+	}
+
+	interactor.logger.InfoContext(
+		ctx,
+		"Finished VerifyTelegramChain execution",
+		slog.Int("chains_verified", response.Progress.ChainsVerified),
+		slog.Int("entries_verified", response.Progress.EntriesVerified),
+		slog.Int("breaks", len(response.Breaks)),
+	)
+	return response, nil
+}
+
+// getHeads returns the heads of the chains to verify along with the count of the unchained subjects.
+func (interactor *VerifyTelegramChain) getHeads(
+	ctx context.Context,
+	collectorUserID uuid.UUID,
+) ([]domain.TelegramChainHead, map[domain.TelegramChainSubjectType]int64, error) {
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, nil, application.ErrDatabaseFailed
+	}
+	defer interactor.rollback(ctx, transactionManager)
+	chainRepository := interactor.telegramChainRepositoryFactory.CreateTelegramChainRepositoryWithTransaction(
+		transactionManager,
+	)
+
+	heads, err := chainRepository.GetTelegramChainHeads(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get telegram chain heads", slog.Any("err", err))
+		return nil, nil, application.ErrDatabaseFailed
+	}
+	unchained, err := chainRepository.CountUnchainedTelegramSubjects(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to count unchained telegram subjects", slog.Any("err", err))
+		return nil, nil, application.ErrDatabaseFailed
+	}
+	if collectorUserID == uuid.Nil {
+		return *heads, unchained, nil
+	}
+	// The collector has a chain in every team it has archived data in
+	var collectorHeads []domain.TelegramChainHead
+	for _, head := range *heads {
+		if head.CollectorUserID == collectorUserID {
+			collectorHeads = append(collectorHeads, head)
+		}
+	}
+	return collectorHeads, unchained, nil
+}
+
+func (interactor *VerifyTelegramChain) verifyChain(
+	ctx context.Context,
+	head *domain.TelegramChainHead,
+	response *VerifyTelegramChainResponse,
+) error {
+	checkpoints, err := interactor.getCheckpoints(ctx, head)
+	if err != nil {
+		return err
+	}
+	// Only the checkpoints signed with the key of the server are trusted
+	trusted := make([]domain.TelegramChainCheckpoint, 0, len(checkpoints))
+	for _, checkpoint := range checkpoints {
+		if response.SignaturesVerified && !service.VerifyTelegramChainCheckpoint(interactor.publicKey, &checkpoint) {
+			report(response, domain.TelegramChainBreak{
+				CollectorUserID: checkpoint.CollectorUserID,
+				Sequence:        checkpoint.Sequence,
+				Reason:          domain.TelegramChainBreakInvalidSignature,
+			})
+			continue
+		}
+		trusted = append(trusted, checkpoint)
+	}
+	response.CheckpointsVerified += len(trusted)
+
+	walker := service.NewTelegramChainWalker(head.CollectorUserID, trusted)
+	afterSequence := int64(0)
+	for {
+		entries, err := interactor.verifyPage(ctx, head, afterSequence, walker, response)
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			break
+		}
+		response.Progress.EntriesVerified += len(entries)
+		afterSequence = entries[len(entries)-1].Sequence
+		if len(entries) < verifyPageSize || afterSequence >= head.Sequence {
+			break
+		}
+	}
+	report(response, walker.Finish(head)...)
+	return nil
+}
+
+func (interactor *VerifyTelegramChain) getCheckpoints(
+	ctx context.Context,
+	head *domain.TelegramChainHead,
+) ([]domain.TelegramChainCheckpoint, error) {
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer interactor.rollback(ctx, transactionManager)
+	checkpoints, err := interactor.telegramChainRepositoryFactory.CreateTelegramChainRepositoryWithTransaction(
+		transactionManager,
+	).GetTelegramChainCheckpoints(ctx, head.TeamID, head.CollectorUserID)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get telegram chain checkpoints", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	return *checkpoints, nil
+}
+
+// verifyPage walks the entries of the chain following afterSequence up to its head and compares the latest entry
+// of each subject with its current content. It returns the entries it has walked.
+func (interactor *VerifyTelegramChain) verifyPage(
+	ctx context.Context,
+	head *domain.TelegramChainHead,
+	afterSequence int64,
+	walker *service.TelegramChainWalker,
+	response *VerifyTelegramChainResponse,
+) ([]domain.TelegramChainEntry, error) {
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer interactor.rollback(ctx, transactionManager)
+	chainRepository := interactor.telegramChainRepositoryFactory.CreateTelegramChainRepositoryWithTransaction(
+		transactionManager,
+	)
+
+	page, err := chainRepository.GetTelegramChainEntries(
+		ctx,
+		head.TeamID,
+		head.CollectorUserID,
+		afterSequence,
+		verifyPageSize,
+	)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get telegram chain entries", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	entries := *page
+	subjectIDs := make(map[domain.TelegramChainSubjectType][]uuid.UUID)
+	for i, entry := range entries {
+		if entry.Sequence > head.Sequence {
+			entries = entries[:i]
+			break
+		}
+		report(response, walker.Next(&entry)...)
+		if !entry.Superseded {
+			subjectIDs[entry.SubjectType] = append(subjectIDs[entry.SubjectType], entry.SubjectID)
+		}
+	}
+
+	contentHashes := make(map[domain.TelegramChainSubjectType]map[uuid.UUID]string, len(subjectIDs))
+	for subjectType, ids := range subjectIDs {
+		contentHashes[subjectType], err = interactor.chainAppender.ContentHashes(ctx, transactionManager, subjectType, ids)
+		if err != nil {
+			interactor.logger.ErrorContext(ctx, "failed to hash telegram chain subjects", slog.Any("err", err))
+			return nil, application.ErrDatabaseFailed
+		}
+	}
+	for _, entry := range entries {
+		if entry.Superseded {
+			continue
+		}
+		chainBreak := domain.TelegramChainBreak{
+			CollectorUserID: entry.CollectorUserID,
+			Sequence:        entry.Sequence,
+			SubjectType:     entry.SubjectType,
+			SubjectID:       entry.SubjectID,
+		}
+		contentHash, ok := contentHashes[entry.SubjectType][entry.SubjectID]
+		switch {
+		case !ok:
+			chainBreak.Reason = domain.TelegramChainBreakSubjectMissing
+		case contentHash != entry.ContentHash:
+			chainBreak.Reason = domain.TelegramChainBreakContentMismatch
+		default:
+			continue
+		}
+		report(response, chainBreak)
+	}
+	return entries, nil
+}
+
+func (interactor *VerifyTelegramChain) rollback(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+) {
+	if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+		interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+	}
+}
+
+// report keeps the breaks up to MaxReportedTelegramChainBreaks.
+func report(response *VerifyTelegramChainResponse, breaks ...domain.TelegramChainBreak) {
+	for _, chainBreak := range breaks {
+		if len(response.Breaks) == MaxReportedTelegramChainBreaks {
+			response.BreaksTruncated = true
+			return
+		}
+		response.Breaks = append(response.Breaks, chainBreak)
+	}
+}
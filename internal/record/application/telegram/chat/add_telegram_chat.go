@@ -0,0 +1,193 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type AddTelegramChatRequest struct {
+	ChatTelegramID int64
+	Type           domain.TelegramChatType
+	Title          string
+	Username       string
+	MemberCount    uint64
+	Description    string
+}
+
+type AddTelegramChatResponse struct {
+	ChatID     uuid.UUID
+	SnapshotID uuid.UUID
+}
+
+// AddTelegramChat adds a chat on the first submission and a new snapshot of its metadata on every following one.
+// A chat already added along with a record posted in it only gets its type on the first submission.
+type AddTelegramChat struct {
+	transactionManagerFactory interfaces.TransactionManagerFactory
+	telegramDomainValidator   *service.TelegramModelValidator
+	telegramChatFactory       repository.TelegramChatRepositoryFactory
+	auditClient               auditclient.AuditClient
+	logger                    *slog.Logger
+}
+
+func NewAddTelegramChat(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramDomainValidator *service.TelegramModelValidator,
+	telegramChatFactory repository.TelegramChatRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *AddTelegramChat {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "add_telegram_chat"),
+	)
+	return &AddTelegramChat{
+		transactionManagerFactory: transactionManagerFactory,
+		telegramDomainValidator:   telegramDomainValidator,
+		telegramChatFactory:       telegramChatFactory,
+		auditClient:               auditClient,
+		logger:                    iLogger,
+	}
+}
+
+func (interactor *AddTelegramChat) Execute(
+	ctx context.Context,
+	input AddTelegramChatRequest,
+) (*AddTelegramChatResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	now := time.Now()
+	interactor.logger.DebugContext(
+		ctx,
+		"Started AddTelegramChat execution",
+		slog.Int64("chat_telegram_id", input.ChatTelegramID),
+	)
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	chatRepository := interactor.telegramChatFactory.CreateTelegramChatRepositoryWithTransaction(transactionManager)
+
+	var auditEntries []auditclient.Entry
+	telegramChat, err := chatRepository.GetChatByTelegramID(ctx, input.ChatTelegramID, idp.UserID)
+	switch {
+	case errors.Is(err, domain.ErrChatNotFound):
+		telegramChat = &domain.TelegramChat{
+			ID:             uuid.New(),
+			ChatTelegramID: input.ChatTelegramID,
+			Type:           input.Type,
+			AddedAt:        now,
+			AddedByUser:    idp.UserID,
+		}
+		if err = interactor.telegramDomainValidator.Validate(telegramChat); err != nil {
+			interactor.rollback(ctx, transactionManager)
+			return nil, err
+		}
+		if err = chatRepository.AddChat(ctx, telegramChat); err != nil {
+			interactor.rollback(ctx, transactionManager)
+			return nil, interactor.mapRepositoryError(ctx, err)
+		}
+		auditEntries = append(auditEntries, application.NewTelegramAuditEntry(
+			auditclient.TelegramChatAdded,
+			application.AuditTargetTelegramChat,
+			telegramChat.ID,
+			nil,
+			telegramChat,
+		))
+	case err != nil:
+		interactor.rollback(ctx, transactionManager)
+		return nil, interactor.mapRepositoryError(ctx, err)
+	case telegramChat.Type == domain.TelegramChatTypeUnknown:
+		// The chat has been added along with a record posted in it, its type is only known now
+		telegramChat.Type = input.Type
+		if err = interactor.telegramDomainValidator.Validate(telegramChat); err != nil {
+			interactor.rollback(ctx, transactionManager)
+			return nil, err
+		}
+		if err = chatRepository.UpdateChatType(ctx, telegramChat.ID, telegramChat.Type); err != nil {
+			interactor.rollback(ctx, transactionManager)
+			return nil, interactor.mapRepositoryError(ctx, err)
+		}
+	}
+
+	snapshot := &domain.TelegramChatSnapshot{
+		ID:          uuid.New(),
+		ChatID:      telegramChat.ID,
+		Title:       input.Title,
+		Username:    input.Username,
+		MemberCount: input.MemberCount,
+		Description: input.Description,
+		AddedAt:     now,
+		AddedByUser: idp.UserID,
+	}
+	// Validate the rules before adding to the database
+	if err = interactor.telegramDomainValidator.Validate(snapshot); err != nil {
+		interactor.rollback(ctx, transactionManager)
+		return nil, err
+	}
+	if err = chatRepository.AddChatSnapshot(ctx, snapshot); err != nil {
+		interactor.rollback(ctx, transactionManager)
+		return nil, interactor.mapRepositoryError(ctx, err)
+	}
+	auditEntries = append(auditEntries, application.NewTelegramAuditEntry(
+		auditclient.TelegramChatSnapshotAdded,
+		application.AuditTargetTelegramChatSnapshot,
+		snapshot.ID,
+		nil,
+		snapshot,
+	))
+	if err = interactor.auditClient.Record(ctx, transactionManager, auditEntries...); err != nil {
+		interactor.rollback(ctx, transactionManager)
+		return nil, interactor.mapRepositoryError(ctx, err)
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished AddTelegramChat execution")
+	return &AddTelegramChatResponse{
+		ChatID:     telegramChat.ID,
+		SnapshotID: snapshot.ID,
+	}, nil
+}
+
+func (interactor *AddTelegramChat) mapRepositoryError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrChatAlreadyExists), errors.Is(err, domain.ErrChatSnapshotAlreadyExists):
+		interactor.logger.DebugContext(ctx, "telegram chat already exists", slog.Any("err", err))
+		return err
+	default:
+		interactor.logger.ErrorContext(ctx, "failed to add telegram chat", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+}
+
+func (interactor *AddTelegramChat) rollback(ctx context.Context, transactionManager interfaces.TransactionManager) {
+	if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+		interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+	}
+}
@@ -0,0 +1,98 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+)
+
+type GetTelegramChatRequest struct {
+	ChatTelegramID int64
+}
+
+type GetTelegramChatResponse struct {
+	Chat      domain.TelegramChat
+	Snapshots []domain.TelegramChatSnapshot
+}
+
+type GetTelegramChat struct {
+	transactionManagerFactory interfaces.TransactionManagerFactory
+	telegramChatFactory       repository.TelegramChatRepositoryFactory
+	logger                    *slog.Logger
+}
+
+func NewGetTelegramChat(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramChatFactory repository.TelegramChatRepositoryFactory,
+	logger *slog.Logger,
+) *GetTelegramChat {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "get_telegram_chat"),
+	)
+	return &GetTelegramChat{
+		transactionManagerFactory: transactionManagerFactory,
+		telegramChatFactory:       telegramChatFactory,
+		logger:                    iLogger,
+	}
+}
+
+func (interactor *GetTelegramChat) Execute(
+	ctx context.Context,
+	input GetTelegramChatRequest,
+) (*GetTelegramChatResponse, error) {
+	interactor.logger.DebugContext(
+		ctx,
+		"Started GetTelegramChat execution",
+		slog.Int64("chat_telegram_id", input.ChatTelegramID),
+	)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer func() {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+	}()
+
+	chatRepository := interactor.telegramChatFactory.CreateTelegramChatRepositoryWithTransaction(transactionManager)
+	telegramChat, err := chatRepository.GetChatByTelegramID(ctx, input.ChatTelegramID, idp.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrChatNotFound) {
+			return nil, err
+		}
+		interactor.logger.ErrorContext(ctx, "failed to get telegram chat", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	snapshots, err := chatRepository.GetChatSnapshotsByChatID(ctx, telegramChat.ID)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get telegram chat snapshots", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetTelegramChat execution")
+	return &GetTelegramChatResponse{
+		Chat:      *telegramChat,
+		Snapshots: *snapshots,
+	}, nil
+}
@@ -0,0 +1,134 @@
+package correlation
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+)
+
+const (
+	DefaultTelegramCorrelationClusters = 50
+	// MaxTelegramCorrelationClusters is the amount of clusters returned at once.
+	MaxTelegramCorrelationClusters = 100
+)
+
+var (
+	ErrInvalidCorrelationType = errors.New("unknown correlation type")
+	ErrInvalidPagination      = errors.New("limit must be between 1 and 100 and offset must not be negative")
+)
+
+// GetTelegramCorrelationClustersRequest filters the clusters, no Types selects all of them
+// and a zero UserTelegramID keeps the clusters of all users.
+type GetTelegramCorrelationClustersRequest struct {
+	Types          []domain.TelegramCorrelationType
+	UserTelegramID uint64
+	Limit          int
+	Offset         int
+}
+
+type GetTelegramCorrelationClustersResponse struct {
+	Clusters []domain.TelegramCorrelationCluster
+}
+
+// GetTelegramCorrelationClusters finds the Telegram users linked by the same phone number, username or bio
+// in their identities.
+type GetTelegramCorrelationClusters struct {
+	transactionManagerFactory         interfaces.TransactionManagerFactory
+	telegramIdentityRepositoryFactory repository.TelegramIdentityRepositoryFactory
+	logger                            *slog.Logger
+}
+
+func NewGetTelegramCorrelationClusters(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramIdentityRepositoryFactory repository.TelegramIdentityRepositoryFactory,
+	logger *slog.Logger,
+) *GetTelegramCorrelationClusters {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "get_telegram_correlation_clusters"),
+	)
+	return &GetTelegramCorrelationClusters{
+		transactionManagerFactory:         transactionManagerFactory,
+		telegramIdentityRepositoryFactory: telegramIdentityRepositoryFactory,
+		logger:                            iLogger,
+	}
+}
+
+func (interactor *GetTelegramCorrelationClusters) Execute(
+	ctx context.Context,
+	input GetTelegramCorrelationClustersRequest,
+) (*GetTelegramCorrelationClustersResponse, error) {
+	interactor.logger.DebugContext(
+		ctx,
+		"Started GetTelegramCorrelationClusters execution",
+		slog.Uint64("user_telegram_id", input.UserTelegramID),
+	)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	correlationTypes := input.Types
+	if len(correlationTypes) == 0 {
+		correlationTypes = []domain.TelegramCorrelationType{
+			domain.TelegramCorrelationTypePhone,
+			domain.TelegramCorrelationTypeUsername,
+			domain.TelegramCorrelationTypeBio,
+		}
+	}
+	for _, correlationType := range correlationTypes {
+		switch correlationType {
+		case domain.TelegramCorrelationTypePhone, domain.TelegramCorrelationTypeUsername, domain.TelegramCorrelationTypeBio:
+		default:
+			return nil, ErrInvalidCorrelationType
+		}
+	}
+	limit := input.Limit
+	if limit == 0 {
+		limit = DefaultTelegramCorrelationClusters
+	}
+	if limit < 1 || limit > MaxTelegramCorrelationClusters || input.Offset < 0 {
+		return nil, ErrInvalidPagination
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer func() {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+	}()
+
+	identityRepository := interactor.telegramIdentityRepositoryFactory.CreateTelegramIdentityRepositoryWithTransaction(
+		transactionManager,
+	)
+	clusters, err := identityRepository.GetTelegramCorrelationClusters(
+		ctx,
+		correlationTypes,
+		input.UserTelegramID,
+		limit,
+		input.Offset,
+	)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get telegram correlation clusters", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetTelegramCorrelationClusters execution")
+	return &GetTelegramCorrelationClustersResponse{Clusters: *clusters}, nil
+}
@@ -3,5 +3,6 @@ package application
 import "errors"
 
 var (
-	ErrDatabaseFailed = errors.New("the database operation has failed")
+	ErrDatabaseFailed    = errors.New("the database operation has failed")
+	ErrBlobStorageFailed = errors.New("the blob storage operation has failed")
 )
@@ -0,0 +1,568 @@
+package export
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/InWamos/trinity-proto/config"
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/user/client"
+	"github.com/google/uuid"
+)
+
+const (
+	// exportPageSize is the amount of records, or of users, read in a single transaction.
+	exportPageSize = 500
+	// exportLease is how long a claimed export is kept from the other workers, every page extends it.
+	exportLease = 15 * time.Minute
+)
+
+// errBundleFailed hides the temporary files from the error stored along with the export.
+var errBundleFailed = errors.New("failed to write the bundle")
+
+// BuildTelegramExport builds the oldest pending export. The bundle holds:
+//
+//   - records, identities, users and attachments, each as <table>.json and <table>.csv
+//   - attachments/<sha256>, the content of every attachment
+//   - manifest.json, the SHA-256 of every file above
+//   - manifest.sig, the Ed25519 signature of manifest.json, and public_key.pem verifying it
+//
+// The data is read page by page, so that no transaction is held while the bundle is written.
+// It's read on behalf of the requester, with the role and the team they have when the export is built, so the bundle
+// only holds what they see.
+type BuildTelegramExport struct {
+	transactionManagerFactory           interfaces.TransactionManagerFactory
+	telegramExportRepositoryFactory     repository.TelegramExportRepositoryFactory
+	telegramRecordRepositoryFactory     repository.TelegramRecordRepositoryFactory
+	telegramUserRepositoryFactory       repository.TelegramUserRepositoryFactory
+	telegramIdentityRepositoryFactory   repository.TelegramIdentityRepositoryFactory
+	telegramAttachmentRepositoryFactory repository.TelegramAttachmentRepositoryFactory
+	blobStore                           interfaces.BlobStore
+	userClient                          userclient.UserClient
+	signingKey                          ed25519.PrivateKey
+	maxRecords                          int64
+	logger                              *slog.Logger
+}
+
+func NewBuildTelegramExport(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramExportRepositoryFactory repository.TelegramExportRepositoryFactory,
+	telegramRecordRepositoryFactory repository.TelegramRecordRepositoryFactory,
+	telegramUserRepositoryFactory repository.TelegramUserRepositoryFactory,
+	telegramIdentityRepositoryFactory repository.TelegramIdentityRepositoryFactory,
+	telegramAttachmentRepositoryFactory repository.TelegramAttachmentRepositoryFactory,
+	blobStore interfaces.BlobStore,
+	userClient userclient.UserClient,
+	chainConfig *config.ChainConfig,
+	exportConfig *config.ExportConfig,
+	logger *slog.Logger,
+) *BuildTelegramExport {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "build_telegram_export"),
+	)
+	return &BuildTelegramExport{
+		transactionManagerFactory:           transactionManagerFactory,
+		telegramExportRepositoryFactory:     telegramExportRepositoryFactory,
+		telegramRecordRepositoryFactory:     telegramRecordRepositoryFactory,
+		telegramUserRepositoryFactory:       telegramUserRepositoryFactory,
+		telegramIdentityRepositoryFactory:   telegramIdentityRepositoryFactory,
+		telegramAttachmentRepositoryFactory: telegramAttachmentRepositoryFactory,
+		blobStore:                           blobStore,
+		userClient:                          userClient,
+		signingKey:                          chainConfig.SigningKey,
+		maxRecords:                          exportConfig.MaxRecords,
+		logger:                              iLogger,
+	}
+}
+
+// Execute returns whether an export has been claimed, more may be waiting then.
+// An export interrupted by the worker being stopped is left running and claimed again once its lease expires.
+func (interactor *BuildTelegramExport) Execute(ctx context.Context) (bool, error) {
+	export, err := interactor.claim(ctx)
+	if errors.Is(err, domain.ErrTelegramExportNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	interactor.logger.InfoContext(ctx, "Started building telegram export", slog.String("export_id", export.ID.String()))
+	// The export is claimed from every team, but kept within the team it's been requested in
+	ctx = context.WithValue(ctx, client.IdentityProviderKey, &client.UserIdentity{
+		UserID:   export.RequestedBy,
+		UserRole: client.User,
+		TeamID:   export.TeamID,
+	})
+
+	buildCtx, buildErr := interactor.onBehalfOfRequester(ctx, export)
+	if buildErr == nil {
+		buildErr = interactor.build(buildCtx, export)
+	}
+	if buildErr != nil && ctx.Err() != nil {
+		return true, buildErr
+	}
+	completedAt := time.Now()
+	export.CompletedAt = &completedAt
+	if buildErr != nil {
+		interactor.logger.ErrorContext(
+			ctx,
+			"failed to build telegram export",
+			slog.String("export_id", export.ID.String()),
+			slog.Any("err", buildErr),
+		)
+		export.Status = domain.TelegramExportFailed
+		export.Error = buildErr.Error()
+	} else {
+		export.Status = domain.TelegramExportCompleted
+	}
+	if err = interactor.finish(ctx, export); err != nil {
+		return true, err
+	}
+	interactor.logger.InfoContext(
+		ctx,
+		"Finished building telegram export",
+		slog.String("export_id", export.ID.String()),
+		slog.String("status", string(export.Status)),
+	)
+	return true, buildErr
+}
+
+// onBehalfOfRequester returns the context of the requester of the export, with the role and the team
+// they currently have. The export can't be built once they've been removed or have left its team.
+func (interactor *BuildTelegramExport) onBehalfOfRequester(
+	ctx context.Context,
+	export *domain.TelegramExport,
+) (context.Context, error) {
+	requester, err := interactor.userClient.ResolveUser(ctx, export.RequestedBy)
+	if errors.Is(err, userclient.ErrUserAbsent) {
+		return nil, domain.ErrTelegramExportRequesterAbsent
+	}
+	if err != nil {
+		return nil, application.ErrDatabaseFailed
+	}
+	if requester.TeamID != export.TeamID {
+		return nil, domain.ErrTelegramExportRequesterMoved
+	}
+	return context.WithValue(ctx, client.IdentityProviderKey, &client.UserIdentity{
+		UserID:   requester.UserID,
+		UserRole: client.UserRole(requester.UserRole),
+		TeamID:   requester.TeamID,
+	}), nil
+}
+
+func (interactor *BuildTelegramExport) claim(ctx context.Context) (*domain.TelegramExport, error) {
+	now := time.Now()
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	exportRepository := interactor.telegramExportRepositoryFactory.CreateTelegramExportRepositoryWithTransaction(
+		transactionManager,
+	)
+	export, err := exportRepository.ClaimTelegramExport(ctx, now, now.Add(exportLease))
+	if err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		if errors.Is(err, domain.ErrTelegramExportNotFound) {
+			return nil, err
+		}
+		return nil, application.ErrDatabaseFailed
+	}
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	return export, nil
+}
+
+// exportTables are the tables of a bundle being built.
+type exportTables struct {
+	users       *telegramExportTable
+	identities  *telegramExportTable
+	records     *telegramExportTable
+	attachments *telegramExportTable
+}
+
+func (tables *exportTables) all() []*telegramExportTable {
+	return []*telegramExportTable{tables.records, tables.identities, tables.users, tables.attachments}
+}
+
+func (interactor *BuildTelegramExport) build(ctx context.Context, export *domain.TelegramExport) error {
+	if interactor.signingKey == nil {
+		return domain.ErrTelegramExportUnsigned
+	}
+	bundle, err := newTelegramExportBundle(export.CreatedAt)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create the bundle", slog.Any("err", err))
+		return errBundleFailed
+	}
+	defer func() {
+		if closeErr := bundle.close(); closeErr != nil {
+			interactor.logger.WarnContext(ctx, "failed to remove the bundle", slog.Any("err", closeErr))
+		}
+	}()
+	tables, err := newExportTables()
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create the tables", slog.Any("err", err))
+		return errBundleFailed
+	}
+	defer func() {
+		for _, table := range tables.all() {
+			if closeErr := table.close(); closeErr != nil {
+				interactor.logger.WarnContext(ctx, "failed to remove the table", slog.Any("err", closeErr))
+			}
+		}
+	}()
+
+	userIDs, err := interactor.exportRecords(ctx, export, bundle, tables)
+	if err != nil {
+		return err
+	}
+	if err = interactor.exportUsers(ctx, export, userIDs, tables); err != nil {
+		return err
+	}
+	for _, table := range tables.all() {
+		if err = table.addTo(bundle); err != nil {
+			interactor.logger.ErrorContext(ctx, "failed to add the table", slog.Any("err", err))
+			return errBundleFailed
+		}
+	}
+
+	manifest := &domain.TelegramExportManifest{
+		ExportID:    export.ID,
+		RequestedBy: export.RequestedBy,
+		Selection:   export.Selection,
+		CreatedAt:   export.CreatedAt,
+	}
+	signature, err := bundle.sign(interactor.signingKey, manifest)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to sign the bundle", slog.Any("err", err))
+		return errBundleFailed
+	}
+	content, err := bundle.content()
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to rewind the bundle", slog.Any("err", err))
+		return errBundleFailed
+	}
+	storageKey := "exports/" + export.ID.String() + ".zip"
+	if err = interactor.blobStore.Put(ctx, storageKey, content, bundle.Size(), "application/zip"); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to store the bundle", slog.Any("err", err))
+		return application.ErrBlobStorageFailed
+	}
+	export.StorageKey = storageKey
+	export.SHA256 = bundle.SHA256()
+	export.FileSize = bundle.Size()
+	export.PublicKey = manifest.PublicKey
+	export.Signature = base64.StdEncoding.EncodeToString(signature)
+	return nil
+}
+
+func newExportTables() (*exportTables, error) {
+	tables := &exportTables{}
+	var err error
+	for _, table := range []struct {
+		table  **telegramExportTable
+		name   string
+		header []string
+	}{
+		{&tables.records, "records", telegramExportRecordHeader},
+		{&tables.identities, "identities", telegramExportIdentityHeader},
+		{&tables.users, "users", telegramExportUserHeader},
+		{&tables.attachments, "attachments", telegramExportAttachmentHeader},
+	} {
+		if *table.table, err = newTelegramExportTable(table.name, table.header); err != nil {
+			var errs []error
+			for _, created := range tables.all() {
+				if created != nil {
+					errs = append(errs, created.close())
+				}
+			}
+			return nil, errors.Join(append(errs, err)...)
+		}
+	}
+	return tables, nil
+}
+
+// exportRecords writes the records of the selection along with their attachments, it returns the users whose
+// identities are to be exported: the selected ones and the authors of the records.
+func (interactor *BuildTelegramExport) exportRecords(
+	ctx context.Context,
+	export *domain.TelegramExport,
+	bundle *telegramExportBundle,
+	tables *exportTables,
+) ([]uuid.UUID, error) {
+	userIDs, total, err := interactor.getSelectedUsers(ctx, export.Selection)
+	if err != nil {
+		return nil, err
+	}
+	if total > interactor.maxRecords {
+		return nil, domain.ErrTelegramExportTooLarge
+	}
+	export.Progress.RecordsTotal = total
+	if err = interactor.updateProgress(ctx, export); err != nil {
+		return nil, err
+	}
+
+	storedFiles := make(map[string]bool)
+	afterID := uuid.Nil
+	for {
+		records, attachments, err := interactor.getRecordsPage(ctx, export.Selection, afterID)
+		if err != nil {
+			return nil, err
+		}
+		for i := range records {
+			if err = tables.records.write(newTelegramExportRecord(&records[i])); err != nil {
+				interactor.logger.ErrorContext(ctx, "failed to write the record", slog.Any("err", err))
+				return nil, errBundleFailed
+			}
+			userIDs[records[i].FromTelegramUserID] = true
+		}
+		for i := range attachments {
+			attachment := &attachments[i]
+			if err = tables.attachments.write(newTelegramExportAttachment(attachment)); err != nil {
+				interactor.logger.ErrorContext(ctx, "failed to write the attachment", slog.Any("err", err))
+				return nil, errBundleFailed
+			}
+			if storedFiles[attachment.SHA256] {
+				continue
+			}
+			if err = interactor.exportAttachmentContent(ctx, bundle, attachment); err != nil {
+				return nil, err
+			}
+			storedFiles[attachment.SHA256] = true
+		}
+		export.Progress.RecordsExported += int64(len(records))
+		export.Progress.AttachmentsExported += int64(len(attachments))
+		if err = interactor.updateProgress(ctx, export); err != nil {
+			return nil, err
+		}
+		if len(records) < exportPageSize {
+			break
+		}
+		afterID = records[len(records)-1].ID
+	}
+
+	sortedUserIDs := make([]uuid.UUID, 0, len(userIDs))
+	for userID := range userIDs {
+		sortedUserIDs = append(sortedUserIDs, userID)
+	}
+	slices.SortFunc(sortedUserIDs, func(a, b uuid.UUID) int { return slices.Compare(a[:], b[:]) })
+	return sortedUserIDs, nil
+}
+
+// getSelectedUsers returns the users of the selected telegram IDs, as added by every collector,
+// along with the amount of records the selection holds.
+func (interactor *BuildTelegramExport) getSelectedUsers(
+	ctx context.Context,
+	selection domain.TelegramExportSelection,
+) (map[uuid.UUID]bool, int64, error) {
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, 0, application.ErrDatabaseFailed
+	}
+	defer rollback(ctx, interactor.logger, transactionManager)
+
+	users, err := interactor.telegramUserRepositoryFactory.CreateTelegramUserRepositoryWithTransaction(
+		transactionManager,
+	).GetTelegramUsersByTelegramIDs(ctx, selection.UserTelegramIDs)
+	if err != nil {
+		return nil, 0, application.ErrDatabaseFailed
+	}
+	total, err := interactor.telegramRecordRepositoryFactory.CreateTelegramRecordRepositoryWithTransaction(
+		transactionManager,
+	).CountTelegramRecordsBySelection(ctx, selection)
+	if err != nil {
+		return nil, 0, application.ErrDatabaseFailed
+	}
+	userIDs := make(map[uuid.UUID]bool, len(*users))
+	for _, user := range *users {
+		userIDs[user.ID] = true
+	}
+	return userIDs, total, nil
+}
+
+func (interactor *BuildTelegramExport) getRecordsPage(
+	ctx context.Context,
+	selection domain.TelegramExportSelection,
+	afterID uuid.UUID,
+) ([]domain.TelegramRecord, []domain.TelegramAttachment, error) {
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, nil, application.ErrDatabaseFailed
+	}
+	defer rollback(ctx, interactor.logger, transactionManager)
+
+	records, err := interactor.telegramRecordRepositoryFactory.CreateTelegramRecordRepositoryWithTransaction(
+		transactionManager,
+	).GetTelegramRecordsBySelection(ctx, selection, afterID, exportPageSize)
+	if err != nil {
+		return nil, nil, application.ErrDatabaseFailed
+	}
+	recordIDs := make([]uuid.UUID, len(*records))
+	for i, record := range *records {
+		recordIDs[i] = record.ID
+	}
+	attachments, err := interactor.telegramAttachmentRepositoryFactory.
+		CreateTelegramAttachmentRepositoryWithTransaction(transactionManager).
+		GetAttachmentsByRecordIDs(ctx, recordIDs)
+	if err != nil {
+		return nil, nil, application.ErrDatabaseFailed
+	}
+	return *records, *attachments, nil
+}
+
+// exportAttachmentContent copies the content of the attachment to the bundle. Content not matching the hash
+// it has been archived with fails the export, the bundle would not prove anything.
+func (interactor *BuildTelegramExport) exportAttachmentContent(
+	ctx context.Context,
+	bundle *telegramExportBundle,
+	attachment *domain.TelegramAttachment,
+) error {
+	content, err := interactor.blobStore.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, interfaces.ErrBlobNotFound) {
+			interactor.logger.ErrorContext(
+				ctx,
+				"attachment content is missing from the blob storage",
+				slog.String("storage_key", attachment.StorageKey),
+			)
+			return fmt.Errorf("%w: %s", domain.ErrAttachmentNotFound, attachment.ID)
+		}
+		interactor.logger.ErrorContext(ctx, "failed to read the blob", slog.Any("err", err))
+		return application.ErrBlobStorageFailed
+	}
+	defer func() {
+		if closeErr := content.Close(); closeErr != nil {
+			interactor.logger.WarnContext(ctx, "failed to close the blob", slog.Any("err", closeErr))
+		}
+	}()
+
+	sum, err := bundle.addFile(attachmentPath(attachment), content)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to copy the attachment", slog.Any("err", err))
+		return errBundleFailed
+	}
+	if sum != attachment.SHA256 {
+		interactor.logger.ErrorContext(
+			ctx,
+			"attachment content doesn't match its hash",
+			slog.String("attachment_id", attachment.ID.String()),
+			slog.String("sha256", sum),
+		)
+		return fmt.Errorf("%w: %s", domain.ErrTelegramExportAttachmentAltered, attachment.ID)
+	}
+	return nil
+}
+
+// exportUsers writes the users and their identities.
+func (interactor *BuildTelegramExport) exportUsers(
+	ctx context.Context,
+	export *domain.TelegramExport,
+	userIDs []uuid.UUID,
+	tables *exportTables,
+) error {
+	for page := range slices.Chunk(userIDs, exportPageSize) {
+		users, identities, err := interactor.getUsersPage(ctx, page)
+		if err != nil {
+			return err
+		}
+		for i := range users {
+			if err = tables.users.write(newTelegramExportUser(&users[i])); err != nil {
+				interactor.logger.ErrorContext(ctx, "failed to write the user", slog.Any("err", err))
+				return errBundleFailed
+			}
+		}
+		for i := range identities {
+			if err = tables.identities.write(newTelegramExportIdentity(&identities[i])); err != nil {
+				interactor.logger.ErrorContext(ctx, "failed to write the identity", slog.Any("err", err))
+				return errBundleFailed
+			}
+		}
+		export.Progress.IdentitiesExported += int64(len(identities))
+		if err = interactor.updateProgress(ctx, export); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (interactor *BuildTelegramExport) getUsersPage(
+	ctx context.Context,
+	userIDs []uuid.UUID,
+) ([]domain.TelegramUser, []domain.TelegramIdentity, error) {
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, nil, application.ErrDatabaseFailed
+	}
+	defer rollback(ctx, interactor.logger, transactionManager)
+
+	users, err := interactor.telegramUserRepositoryFactory.CreateTelegramUserRepositoryWithTransaction(
+		transactionManager,
+	).GetTelegramUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, nil, application.ErrDatabaseFailed
+	}
+	identities, err := interactor.telegramIdentityRepositoryFactory.CreateTelegramIdentityRepositoryWithTransaction(
+		transactionManager,
+	).GetIdentitiesByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, nil, application.ErrDatabaseFailed
+	}
+	return *users, *identities, nil
+}
+
+func (interactor *BuildTelegramExport) updateProgress(ctx context.Context, export *domain.TelegramExport) error {
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+	exportRepository := interactor.telegramExportRepositoryFactory.CreateTelegramExportRepositoryWithTransaction(
+		transactionManager,
+	)
+	err = exportRepository.UpdateTelegramExportProgress(ctx, export.ID, export.Progress, time.Now().Add(exportLease))
+	if err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		return application.ErrDatabaseFailed
+	}
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+	return nil
+}
+
+func (interactor *BuildTelegramExport) finish(ctx context.Context, export *domain.TelegramExport) error {
+	// The outcome is stored even if the worker is being stopped, otherwise the export would be built again
+	ctx = context.WithoutCancel(ctx)
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+	exportRepository := interactor.telegramExportRepositoryFactory.CreateTelegramExportRepositoryWithTransaction(
+		transactionManager,
+	)
+	if err = exportRepository.FinishTelegramExport(ctx, export); err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		return application.ErrDatabaseFailed
+	}
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type DownloadTelegramExportRequest struct {
+	ExportID uuid.UUID
+}
+
+// DownloadTelegramExportResponse holds an open stream of the bundle. The caller must close Content.
+type DownloadTelegramExportResponse struct {
+	Export  domain.TelegramExport
+	Content io.ReadCloser
+}
+
+// DownloadTelegramExport streams the bundle of a completed export. Every download is audited, so that
+// the custody of the evidence can be traced, and the records it holds are logged as viewed.
+type DownloadTelegramExport struct {
+	transactionManagerFactory       interfaces.TransactionManagerFactory
+	telegramExportRepositoryFactory repository.TelegramExportRepositoryFactory
+	blobStore                       interfaces.BlobStore
+	auditClient                     auditclient.AuditClient
+	logger                          *slog.Logger
+}
+
+func NewDownloadTelegramExport(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramExportRepositoryFactory repository.TelegramExportRepositoryFactory,
+	blobStore interfaces.BlobStore,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *DownloadTelegramExport {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "download_telegram_export"),
+	)
+	return &DownloadTelegramExport{
+		transactionManagerFactory:       transactionManagerFactory,
+		telegramExportRepositoryFactory: telegramExportRepositoryFactory,
+		blobStore:                       blobStore,
+		auditClient:                     auditClient,
+		logger:                          iLogger,
+	}
+}
+
+func (interactor *DownloadTelegramExport) Execute(
+	ctx context.Context,
+	input DownloadTelegramExportRequest,
+) (*DownloadTelegramExportResponse, error) {
+	interactor.logger.DebugContext(
+		ctx,
+		"Started DownloadTelegramExport execution",
+		slog.String("export_id", input.ExportID.String()),
+	)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	export, err := interactor.getExport(ctx, input.ExportID, idp)
+	if err != nil {
+		return nil, err
+	}
+	if export.Status != domain.TelegramExportCompleted {
+		return nil, domain.ErrTelegramExportNotCompleted
+	}
+
+	content, err := interactor.blobStore.Get(ctx, export.StorageKey)
+	if err != nil {
+		if errors.Is(err, interfaces.ErrBlobNotFound) {
+			interactor.logger.ErrorContext(
+				ctx,
+				"export bundle is missing from the blob storage",
+				slog.String("storage_key", export.StorageKey),
+			)
+			return nil, domain.ErrTelegramExportNotFound
+		}
+		interactor.logger.ErrorContext(ctx, "failed to read the blob", slog.Any("err", err))
+		return nil, application.ErrBlobStorageFailed
+	}
+
+	entries := []auditclient.Entry{
+		application.NewTelegramAuditEntry(
+			auditclient.TelegramExportDownloaded,
+			application.AuditTargetTelegramExport,
+			export.ID,
+			nil,
+			nil,
+		),
+		application.NewTelegramExportViewedAuditEntry(export),
+	}
+	if err = interactor.auditClient.RecordDetached(ctx, entries...); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record audit entry", slog.Any("err", err))
+		_ = content.Close()
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished DownloadTelegramExport execution")
+	return &DownloadTelegramExportResponse{
+		Export:  *export,
+		Content: content,
+	}, nil
+}
+
+// getExport reads the export in its own short transaction,
+// so no database connection is held while the bundle is streamed.
+func (interactor *DownloadTelegramExport) getExport(
+	ctx context.Context,
+	exportID uuid.UUID,
+	idp *client.UserIdentity,
+) (*domain.TelegramExport, error) {
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer rollback(ctx, interactor.logger, transactionManager)
+
+	exportRepository := interactor.telegramExportRepositoryFactory.CreateTelegramExportRepositoryWithTransaction(
+		transactionManager,
+	)
+	export, err := getVisibleExport(ctx, exportRepository, exportID, idp)
+	if err != nil {
+		if errors.Is(err, domain.ErrTelegramExportNotFound) {
+			return nil, err
+		}
+		interactor.logger.ErrorContext(ctx, "failed to get telegram export", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	return export, nil
+}
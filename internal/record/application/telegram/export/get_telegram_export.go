@@ -0,0 +1,89 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type GetTelegramExportRequest struct {
+	ExportID uuid.UUID
+}
+
+type GetTelegramExportResponse struct {
+	Export domain.TelegramExport
+}
+
+// GetTelegramExport returns the status and the progress of an export requested by the current user,
+// admins see every export.
+type GetTelegramExport struct {
+	transactionManagerFactory       interfaces.TransactionManagerFactory
+	telegramExportRepositoryFactory repository.TelegramExportRepositoryFactory
+	logger                          *slog.Logger
+}
+
+func NewGetTelegramExport(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramExportRepositoryFactory repository.TelegramExportRepositoryFactory,
+	logger *slog.Logger,
+) *GetTelegramExport {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "get_telegram_export"),
+	)
+	return &GetTelegramExport{
+		transactionManagerFactory:       transactionManagerFactory,
+		telegramExportRepositoryFactory: telegramExportRepositoryFactory,
+		logger:                          iLogger,
+	}
+}
+
+func (interactor *GetTelegramExport) Execute(
+	ctx context.Context,
+	input GetTelegramExportRequest,
+) (*GetTelegramExportResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started GetTelegramExport execution",
+		slog.String("export_id", input.ExportID.String()),
+	)
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer rollback(ctx, interactor.logger, transactionManager)
+
+	exportRepository := interactor.telegramExportRepositoryFactory.CreateTelegramExportRepositoryWithTransaction(
+		transactionManager,
+	)
+	export, err := getVisibleExport(ctx, exportRepository, input.ExportID, idp)
+	if err != nil {
+		if errors.Is(err, domain.ErrTelegramExportNotFound) {
+			return nil, err
+		}
+		interactor.logger.ErrorContext(ctx, "failed to get telegram export", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetTelegramExport execution")
+	return &GetTelegramExportResponse{Export: *export}, nil
+}
@@ -0,0 +1,97 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+)
+
+const (
+	DefaultTelegramExports = 50
+	// MaxTelegramExports is the amount of exports returned at once.
+	MaxTelegramExports = 200
+)
+
+var ErrInvalidPagination = errors.New("limit must be between 1 and 200 and offset must not be negative")
+
+type GetTelegramExportsRequest struct {
+	Limit  int
+	Offset int
+}
+
+type GetTelegramExportsResponse struct {
+	Exports []domain.TelegramExport
+}
+
+// GetTelegramExports lists the exports requested by the current user, the latest first.
+type GetTelegramExports struct {
+	transactionManagerFactory       interfaces.TransactionManagerFactory
+	telegramExportRepositoryFactory repository.TelegramExportRepositoryFactory
+	logger                          *slog.Logger
+}
+
+func NewGetTelegramExports(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramExportRepositoryFactory repository.TelegramExportRepositoryFactory,
+	logger *slog.Logger,
+) *GetTelegramExports {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "get_telegram_exports"),
+	)
+	return &GetTelegramExports{
+		transactionManagerFactory:       transactionManagerFactory,
+		telegramExportRepositoryFactory: telegramExportRepositoryFactory,
+		logger:                          iLogger,
+	}
+}
+
+func (interactor *GetTelegramExports) Execute(
+	ctx context.Context,
+	input GetTelegramExportsRequest,
+) (*GetTelegramExportsResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+	interactor.logger.DebugContext(ctx, "Started GetTelegramExports execution")
+
+	limit := input.Limit
+	if limit == 0 {
+		limit = DefaultTelegramExports
+	}
+	if limit < 1 || limit > MaxTelegramExports || input.Offset < 0 {
+		return nil, ErrInvalidPagination
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer rollback(ctx, interactor.logger, transactionManager)
+
+	exportRepository := interactor.telegramExportRepositoryFactory.CreateTelegramExportRepositoryWithTransaction(
+		transactionManager,
+	)
+	exports, err := exportRepository.GetTelegramExportsByRequester(ctx, idp.UserID, limit, input.Offset)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get telegram exports", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetTelegramExports execution")
+	return &GetTelegramExportsResponse{Exports: *exports}, nil
+}
@@ -0,0 +1,153 @@
+package export
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/InWamos/trinity-proto/config"
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type RequestTelegramExportRequest struct {
+	Selection domain.TelegramExportSelection
+}
+
+type RequestTelegramExportResponse struct {
+	Export domain.TelegramExport
+}
+
+// RequestTelegramExport queues an export of the selection for the current user, it's built in the background
+// by BuildTelegramExport. Exports are refused without a signing key, an unsigned bundle proves nothing.
+type RequestTelegramExport struct {
+	transactionManagerFactory       interfaces.TransactionManagerFactory
+	telegramDomainValidator         *service.TelegramModelValidator
+	telegramExportRepositoryFactory repository.TelegramExportRepositoryFactory
+	auditClient                     auditclient.AuditClient
+	signingEnabled                  bool
+	logger                          *slog.Logger
+}
+
+func NewRequestTelegramExport(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramDomainValidator *service.TelegramModelValidator,
+	telegramExportRepositoryFactory repository.TelegramExportRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	chainConfig *config.ChainConfig,
+	logger *slog.Logger,
+) *RequestTelegramExport {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "request_telegram_export"),
+	)
+	return &RequestTelegramExport{
+		transactionManagerFactory:       transactionManagerFactory,
+		telegramDomainValidator:         telegramDomainValidator,
+		telegramExportRepositoryFactory: telegramExportRepositoryFactory,
+		auditClient:                     auditClient,
+		signingEnabled:                  chainConfig.SigningKey != nil,
+		logger:                          iLogger,
+	}
+}
+
+func (interactor *RequestTelegramExport) Execute(
+	ctx context.Context,
+	input RequestTelegramExportRequest,
+) (*RequestTelegramExportResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	export := &domain.TelegramExport{
+		ID:          uuid.New(),
+		RequestedBy: idp.UserID,
+		Selection:   input.Selection,
+		Status:      domain.TelegramExportPending,
+		CreatedAt:   time.Now(),
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started RequestTelegramExport execution",
+		slog.String("export_id", export.ID.String()),
+	)
+	// Validate the rules before adding to the database
+	if err := interactor.telegramDomainValidator.Validate(export); err != nil {
+		return nil, err
+	}
+	if !interactor.signingEnabled {
+		return nil, domain.ErrTelegramExportUnsigned
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	exportRepository := interactor.telegramExportRepositoryFactory.CreateTelegramExportRepositoryWithTransaction(
+		transactionManager,
+	)
+	if err = exportRepository.AddTelegramExport(ctx, export); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to add telegram export", slog.Any("err", err))
+		rollback(ctx, interactor.logger, transactionManager)
+		return nil, application.ErrDatabaseFailed
+	}
+	entry := application.NewTelegramAuditEntry(
+		auditclient.TelegramExportRequested,
+		application.AuditTargetTelegramExport,
+		export.ID,
+		nil,
+		export,
+	)
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record audit entry", slog.Any("err", err))
+		rollback(ctx, interactor.logger, transactionManager)
+		return nil, application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished RequestTelegramExport execution")
+	return &RequestTelegramExportResponse{Export: *export}, nil
+}
+
+func rollback(ctx context.Context, logger *slog.Logger, transactionManager interfaces.TransactionManager) {
+	if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+		logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+	}
+}
+
+// getVisibleExport hides the exports requested by other users as if they didn't exist, unless the user
+// is an admin.
+func getVisibleExport(
+	ctx context.Context,
+	exportRepository repository.TelegramExportRepository,
+	exportID uuid.UUID,
+	idp *client.UserIdentity,
+) (*domain.TelegramExport, error) {
+	export, err := exportRepository.GetTelegramExportByID(ctx, exportID)
+	if err != nil {
+		return nil, err
+	}
+	if export.RequestedBy != idp.UserID && rbac.AuthorizeByRole(idp, userDomain.RoleAdmin) != nil {
+		return nil, domain.ErrTelegramExportNotFound
+	}
+	return export, nil
+}
@@ -0,0 +1,243 @@
+package export
+
+import (
+	"archive/zip"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"hash"
+	"io"
+	"os"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	csvexport "github.com/InWamos/trinity-proto/internal/shared/infrastructure/csv_export"
+)
+
+// Files of a bundle besides the tables and the attachments. The signature and the public key aren't listed
+// in the manifest, they're what verifies it.
+const (
+	manifestPath  = "manifest.json"
+	signaturePath = "manifest.sig"
+	publicKeyPath = "public_key.pem"
+)
+
+// telegramExportBundle writes the ZIP of an export to a temporary file. Every file added is hashed for the
+// manifest, the archive itself is hashed as it's written.
+type telegramExportBundle struct {
+	file      *os.File
+	hash      hash.Hash
+	size      byteCounter
+	zipWriter *zip.Writer
+	modified  time.Time
+	files     []domain.TelegramExportManifestFile
+}
+
+// newTelegramExportBundle creates the temporary file, the caller must close the returned bundle.
+// The files are dated modified, the creation of the export.
+func newTelegramExportBundle(modified time.Time) (*telegramExportBundle, error) {
+	file, err := os.CreateTemp("", "trinity-export-*.zip")
+	if err != nil {
+		return nil, err
+	}
+	bundle := &telegramExportBundle{file: file, hash: sha256.New(), modified: modified}
+	bundle.zipWriter = zip.NewWriter(io.MultiWriter(file, bundle.hash, &bundle.size))
+	return bundle, nil
+}
+
+// byteCounter counts the bytes written to it.
+type byteCounter int64
+
+func (counter *byteCounter) Write(p []byte) (int, error) {
+	*counter += byteCounter(len(p))
+	return len(p), nil
+}
+
+// addFile copies the content to the archive and lists it in the manifest, it returns the SHA-256 of the content.
+func (bundle *telegramExportBundle) addFile(path string, content io.Reader) (string, error) {
+	writer, err := bundle.zipWriter.CreateHeader(&zip.FileHeader{
+		Name:     path,
+		Method:   zip.Deflate,
+		Modified: bundle.modified,
+	})
+	if err != nil {
+		return "", err
+	}
+	contentHash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(writer, contentHash), content)
+	if err != nil {
+		return "", err
+	}
+	sum := hex.EncodeToString(contentHash.Sum(nil))
+	bundle.files = append(bundle.files, domain.TelegramExportManifestFile{Path: path, SHA256: sum, Size: size})
+	return sum, nil
+}
+
+// sign writes the manifest of the files added so far, its detached signature and the public key verifying it,
+// then finishes the archive. It returns the signature.
+func (bundle *telegramExportBundle) sign(
+	privateKey ed25519.PrivateKey,
+	manifest *domain.TelegramExportManifest,
+) ([]byte, error) {
+	manifest.Files = bundle.files
+	data := service.MarshalTelegramExportManifest(privateKey, manifest)
+	signature := service.SignTelegramExportManifest(privateKey, data)
+	publicKey, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range []struct {
+		path    string
+		content []byte
+	}{
+		{manifestPath, data},
+		{signaturePath, signature},
+		{publicKeyPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})},
+	} {
+		writer, err := bundle.zipWriter.CreateHeader(&zip.FileHeader{
+			Name:     file.path,
+			Method:   zip.Store,
+			Modified: bundle.modified,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err = writer.Write(file.content); err != nil {
+			return nil, err
+		}
+	}
+	if err = bundle.zipWriter.Close(); err != nil {
+		return nil, err
+	}
+	return signature, nil
+}
+
+// SHA256 is the hash of the whole archive, known once it has been signed.
+func (bundle *telegramExportBundle) SHA256() string {
+	return hex.EncodeToString(bundle.hash.Sum(nil))
+}
+
+func (bundle *telegramExportBundle) Size() int64 {
+	return int64(bundle.size)
+}
+
+// content rewinds the archive to be stored.
+func (bundle *telegramExportBundle) content() (io.Reader, error) {
+	if _, err := bundle.file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return bundle.file, nil
+}
+
+// close removes the temporary file.
+func (bundle *telegramExportBundle) close() error {
+	closeErr := bundle.file.Close()
+	if err := os.Remove(bundle.file.Name()); err != nil {
+		return err
+	}
+	return closeErr
+}
+
+type telegramExportRow interface {
+	csvRecord() []string
+}
+
+// telegramExportTable spools the rows of a table to a JSON array and to a CSV file side by side, so that the
+// rows are read once while the archive takes a single file at a time. The CSV cells starting like a formula are
+// neutralised, the JSON keeps the archived text as it is.
+type telegramExportTable struct {
+	name      string
+	jsonFile  *os.File
+	csvFile   *os.File
+	csvWriter *csvexport.Writer
+	rows      int64
+}
+
+// newTelegramExportTable creates the temporary files, the caller must close the returned table.
+func newTelegramExportTable(name string, header []string) (*telegramExportTable, error) {
+	jsonFile, err := os.CreateTemp("", "trinity-export-"+name+"-*.json")
+	if err != nil {
+		return nil, err
+	}
+	csvFile, err := os.CreateTemp("", "trinity-export-"+name+"-*.csv")
+	if err != nil {
+		_ = jsonFile.Close()
+		_ = os.Remove(jsonFile.Name())
+		return nil, err
+	}
+	table := &telegramExportTable{
+		name:      name,
+		jsonFile:  jsonFile,
+		csvFile:   csvFile,
+		csvWriter: csvexport.NewWriter(csvFile),
+	}
+	if _, err = jsonFile.WriteString("["); err != nil {
+		return nil, errors.Join(err, table.close())
+	}
+	if err = table.csvWriter.Write(header); err != nil {
+		return nil, errors.Join(err, table.close())
+	}
+	return table, nil
+}
+
+func (table *telegramExportTable) write(row telegramExportRow) error {
+	data, err := json.Marshal(row)
+	if err != nil {
+		return err
+	}
+	separator := ",\n"
+	if table.rows == 0 {
+		separator = "\n"
+	}
+	if _, err = table.jsonFile.WriteString(separator); err != nil {
+		return err
+	}
+	if _, err = table.jsonFile.Write(data); err != nil {
+		return err
+	}
+	if err = table.csvWriter.Write(row.csvRecord()); err != nil {
+		return err
+	}
+	table.rows++
+	return nil
+}
+
+// addTo finishes the table and adds <name>.json and <name>.csv to the bundle.
+func (table *telegramExportTable) addTo(bundle *telegramExportBundle) error {
+	if _, err := table.jsonFile.WriteString("\n]\n"); err != nil {
+		return err
+	}
+	table.csvWriter.Flush()
+	if err := table.csvWriter.Error(); err != nil {
+		return err
+	}
+	for _, file := range []struct {
+		path string
+		file *os.File
+	}{
+		{table.name + ".json", table.jsonFile},
+		{table.name + ".csv", table.csvFile},
+	} {
+		if _, err := file.file.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		if _, err := bundle.addFile(file.path, file.file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// close removes the temporary files.
+func (table *telegramExportTable) close() error {
+	var errs []error
+	for _, file := range []*os.File{table.jsonFile, table.csvFile} {
+		errs = append(errs, file.Close(), os.Remove(file.Name()))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,246 @@
+package export //nolint:testpackage // the bundle is unexported
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/google/uuid"
+)
+
+func TestTelegramExportBundle(t *testing.T) {
+	_, privateKey, _ := ed25519.GenerateKey(nil)
+	createdAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	bundle, err := newTelegramExportBundle(createdAt)
+	if err != nil {
+		t.Fatalf("failed to create the bundle: %v", err)
+	}
+	defer func() { _ = bundle.close() }()
+
+	table, err := newTelegramExportTable("users", telegramExportUserHeader)
+	if err != nil {
+		t.Fatalf("failed to create the table: %v", err)
+	}
+	defer func() { _ = table.close() }()
+	users := []domain.TelegramUser{
+		{ID: uuid.New(), TelegramID: 42, AddedAt: createdAt, AddedByUser: uuid.New()},
+		{ID: uuid.New(), TelegramID: 43, AddedAt: createdAt, AddedByUser: uuid.New()},
+	}
+	for i := range users {
+		if err = table.write(newTelegramExportUser(&users[i])); err != nil {
+			t.Fatalf("failed to write the row: %v", err)
+		}
+	}
+
+	content := []byte("attachment content")
+	sum, err := bundle.addFile("attachments/content", bytes.NewReader(content))
+	if err != nil {
+		t.Fatalf("failed to add the file: %v", err)
+	}
+	if expected := sha256.Sum256(content); sum != hex.EncodeToString(expected[:]) {
+		t.Errorf("expected the SHA-256 of the content, got %s", sum)
+	}
+	if err = table.addTo(bundle); err != nil {
+		t.Fatalf("failed to add the table: %v", err)
+	}
+	signature, err := bundle.sign(privateKey, &domain.TelegramExportManifest{ExportID: uuid.New(), CreatedAt: createdAt})
+	if err != nil {
+		t.Fatalf("failed to sign the bundle: %v", err)
+	}
+
+	reader, err := bundle.content()
+	if err != nil {
+		t.Fatalf("failed to rewind the bundle: %v", err)
+	}
+	archive, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("failed to read the bundle: %v", err)
+	}
+	if int64(len(archive)) != bundle.Size() {
+		t.Errorf("expected a size of %d, got %d", len(archive), bundle.Size())
+	}
+	if archiveSum := sha256.Sum256(archive); bundle.SHA256() != hex.EncodeToString(archiveSum[:]) {
+		t.Error("expected the SHA-256 of the archive")
+	}
+
+	zipReader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatalf("failed to open the archive: %v", err)
+	}
+	files := make(map[string][]byte)
+	for _, file := range zipReader.File {
+		fileReader, err := file.Open()
+		if err != nil {
+			t.Fatalf("failed to open %s: %v", file.Name, err)
+		}
+		files[file.Name], _ = io.ReadAll(fileReader)
+		_ = fileReader.Close()
+	}
+
+	// The signature verifies the manifest with the key shipped along
+	block, _ := pem.Decode(files[publicKeyPath])
+	if block == nil {
+		t.Fatal("expected a PEM public key")
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		t.Fatalf("failed to parse the public key: %v", err)
+	}
+	if !bytes.Equal(files[signaturePath], signature) {
+		t.Error("expected the signature to be stored")
+	}
+	ed25519PublicKey, ok := publicKey.(ed25519.PublicKey)
+	if !ok {
+		t.Fatalf("expected an Ed25519 public key, got %T", publicKey)
+	}
+	if !ed25519.Verify(ed25519PublicKey, files[manifestPath], files[signaturePath]) {
+		t.Error("expected the signature to verify the manifest")
+	}
+
+	// Every listed file matches its hash, the signature files aren't listed
+	var manifest struct {
+		Files []struct {
+			Path   string `json:"path"`
+			SHA256 string `json:"sha256"`
+			Size   int64  `json:"size"`
+		} `json:"files"`
+	}
+	if err = json.Unmarshal(files[manifestPath], &manifest); err != nil {
+		t.Fatalf("failed to decode the manifest: %v", err)
+	}
+	if len(manifest.Files) != 3 || len(files) != 6 {
+		t.Fatalf("expected 3 listed files out of 6, got %d out of %d", len(manifest.Files), len(files))
+	}
+	for _, file := range manifest.Files {
+		fileSum := sha256.Sum256(files[file.Path])
+		if file.SHA256 != hex.EncodeToString(fileSum[:]) || file.Size != int64(len(files[file.Path])) {
+			t.Errorf("expected %s to match the manifest", file.Path)
+		}
+	}
+
+	var rows []telegramExportUser
+	if err = json.Unmarshal(files["users.json"], &rows); err != nil {
+		t.Fatalf("failed to decode users.json: %v", err)
+	}
+	if len(rows) != 2 || rows[1].TelegramID != 43 {
+		t.Errorf("expected the users to be written to users.json, got %+v", rows)
+	}
+	records, err := csv.NewReader(bytes.NewReader(files["users.csv"])).ReadAll()
+	if err != nil {
+		t.Fatalf("failed to decode users.csv: %v", err)
+	}
+	if len(records) != 3 || strings.Join(records[0], ",") != strings.Join(telegramExportUserHeader, ",") ||
+		records[2][1] != "43" {
+		t.Errorf("expected the users to be written to users.csv, got %v", records)
+	}
+}
+
+func TestTelegramExportTableEmpty(t *testing.T) {
+	bundle, err := newTelegramExportBundle(time.Now())
+	if err != nil {
+		t.Fatalf("failed to create the bundle: %v", err)
+	}
+	defer func() { _ = bundle.close() }()
+	table, err := newTelegramExportTable("records", telegramExportRecordHeader)
+	if err != nil {
+		t.Fatalf("failed to create the table: %v", err)
+	}
+	defer func() { _ = table.close() }()
+	if err = table.addTo(bundle); err != nil {
+		t.Fatalf("failed to add the table: %v", err)
+	}
+	_, privateKey, _ := ed25519.GenerateKey(nil)
+	if _, err = bundle.sign(privateKey, &domain.TelegramExportManifest{}); err != nil {
+		t.Fatalf("failed to sign the bundle: %v", err)
+	}
+
+	reader, _ := bundle.content()
+	archive, _ := io.ReadAll(reader)
+	zipReader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatalf("failed to open the archive: %v", err)
+	}
+	for _, file := range zipReader.File {
+		if file.Name != "records.json" {
+			continue
+		}
+		fileReader, _ := file.Open()
+		var rows []json.RawMessage
+		if err = json.NewDecoder(fileReader).Decode(&rows); err != nil || rows == nil || len(rows) != 0 {
+			t.Errorf("expected an empty JSON array, got %v (%v)", rows, err)
+		}
+		_ = fileReader.Close()
+		return
+	}
+	t.Error("expected records.json in the archive")
+}
+
+func TestTelegramExportTableFormulas(t *testing.T) {
+	bundle, err := newTelegramExportBundle(time.Now())
+	if err != nil {
+		t.Fatalf("failed to create the bundle: %v", err)
+	}
+	defer func() { _ = bundle.close() }()
+	table, err := newTelegramExportTable("records", telegramExportRecordHeader)
+	if err != nil {
+		t.Fatalf("failed to create the table: %v", err)
+	}
+	defer func() { _ = table.close() }()
+	record := domain.TelegramRecord{
+		ID:               uuid.New(),
+		InTelegramChatID: -100123,
+		MessageText:      `=HYPERLINK("http://example.com")`,
+		Caption:          "@everyone",
+		PostedAt:         time.Now(),
+	}
+	if err = table.write(newTelegramExportRecord(&record)); err != nil {
+		t.Fatalf("failed to write the row: %v", err)
+	}
+	if err = table.addTo(bundle); err != nil {
+		t.Fatalf("failed to add the table: %v", err)
+	}
+	_, privateKey, _ := ed25519.GenerateKey(nil)
+	if _, err = bundle.sign(privateKey, &domain.TelegramExportManifest{}); err != nil {
+		t.Fatalf("failed to sign the bundle: %v", err)
+	}
+
+	reader, _ := bundle.content()
+	archive, _ := io.ReadAll(reader)
+	zipReader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatalf("failed to open the archive: %v", err)
+	}
+	files := make(map[string][]byte)
+	for _, file := range zipReader.File {
+		fileReader, _ := file.Open()
+		files[file.Name], _ = io.ReadAll(fileReader)
+		_ = fileReader.Close()
+	}
+
+	// The spreadsheet doesn't evaluate the text, the negative chat ID is still a number
+	rows, err := csv.NewReader(bytes.NewReader(files["records.csv"])).ReadAll()
+	if err != nil || len(rows) != 2 {
+		t.Fatalf("expected a single record in records.csv, got %v (%v)", rows, err)
+	}
+	if rows[1][3] != "-100123" || rows[1][4] != `'=HYPERLINK("http://example.com")` || rows[1][6] != "'@everyone" {
+		t.Errorf("expected the formulas to be neutralised, got %q", rows[1])
+	}
+	var records []telegramExportRecord
+	if err = json.Unmarshal(files["records.json"], &records); err != nil || len(records) != 1 {
+		t.Fatalf("expected a single record in records.json, got %+v (%v)", records, err)
+	}
+	if records[0].MessageText != record.MessageText || records[0].Caption != record.Caption {
+		t.Errorf("expected the JSON to keep the archived text, got %+v", records[0])
+	}
+}
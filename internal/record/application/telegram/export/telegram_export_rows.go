@@ -0,0 +1,285 @@
+package export
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/google/uuid"
+)
+
+// The rows of the tables of a bundle. Each is written both to <table>.json and to <table>.csv,
+// ContentHash is the one the telegram chain holds for the subject.
+
+var (
+	telegramExportUserHeader     = []string{"id", "telegram_id", "added_at", "added_by_user"}
+	telegramExportIdentityHeader = []string{
+		"id", "user_id", "username", "first_name", "last_name", "bio", "phone_number",
+		"added_at", "added_by_user", "content_hash",
+	}
+	telegramExportRecordHeader = []string{
+		"id", "message_telegram_id", "from_telegram_user_id", "in_telegram_chat_id", "message_text", "entities",
+		"caption", "caption_entities", "posted_at", "edited_at", "deleted_at", "reply_to_message_telegram_id",
+		"thread_telegram_id", "forward_from_user_telegram_id", "forward_from_chat_telegram_id",
+		"forward_from_message_telegram_id", "forward_posted_at", "added_at", "added_by_user", "content_hash",
+	}
+	telegramExportAttachmentHeader = []string{
+		"id", "record_id", "telegram_attachment_id", "file_name", "path", "sha256", "file_size", "mime_type",
+		"added_at", "added_by_user", "content_hash",
+	}
+)
+
+type telegramExportUser struct {
+	ID          uuid.UUID `json:"id"`
+	TelegramID  uint64    `json:"telegram_id"`
+	AddedAt     time.Time `json:"added_at"`
+	AddedByUser uuid.UUID `json:"added_by_user"`
+}
+
+func newTelegramExportUser(user *domain.TelegramUser) telegramExportUser {
+	return telegramExportUser{
+		ID:          user.ID,
+		TelegramID:  user.TelegramID,
+		AddedAt:     user.AddedAt.UTC(),
+		AddedByUser: user.AddedByUser,
+	}
+}
+
+func (row telegramExportUser) csvRecord() []string {
+	return []string{
+		row.ID.String(),
+		strconv.FormatUint(row.TelegramID, 10),
+		formatTime(&row.AddedAt),
+		row.AddedByUser.String(),
+	}
+}
+
+type telegramExportIdentity struct {
+	ID          uuid.UUID `json:"id"`
+	UserID      uuid.UUID `json:"user_id"`
+	Username    string    `json:"username"`
+	FirstName   string    `json:"first_name"`
+	LastName    string    `json:"last_name"`
+	Bio         string    `json:"bio"`
+	PhoneNumber string    `json:"phone_number"`
+	AddedAt     time.Time `json:"added_at"`
+	AddedByUser uuid.UUID `json:"added_by_user"`
+	ContentHash string    `json:"content_hash"`
+}
+
+func newTelegramExportIdentity(identity *domain.TelegramIdentity) telegramExportIdentity {
+	return telegramExportIdentity{
+		ID:          identity.ID,
+		UserID:      identity.UserID,
+		Username:    identity.Username,
+		FirstName:   identity.FirstName,
+		LastName:    identity.LastName,
+		Bio:         identity.Bio,
+		PhoneNumber: identity.PhoneNumber,
+		AddedAt:     identity.AddedAt.UTC(),
+		AddedByUser: identity.AddedByUser,
+		ContentHash: service.HashTelegramIdentity(identity),
+	}
+}
+
+func (row telegramExportIdentity) csvRecord() []string {
+	return []string{
+		row.ID.String(),
+		row.UserID.String(),
+		row.Username,
+		row.FirstName,
+		row.LastName,
+		row.Bio,
+		row.PhoneNumber,
+		formatTime(&row.AddedAt),
+		row.AddedByUser.String(),
+		row.ContentHash,
+	}
+}
+
+type telegramExportEntity struct {
+	Type           string `json:"type"`
+	Offset         int    `json:"offset"`
+	Length         int    `json:"length"`
+	URL            string `json:"url,omitempty"`
+	UserTelegramID uint64 `json:"user_telegram_id,omitempty"`
+	Language       string `json:"language,omitempty"`
+	CustomEmojiID  string `json:"custom_emoji_id,omitempty"`
+}
+
+func newTelegramExportEntities(entities []domain.TelegramMessageEntity) []telegramExportEntity {
+	rows := make([]telegramExportEntity, len(entities))
+	for i, entity := range entities {
+		rows[i] = telegramExportEntity{
+			Type:           string(entity.Type),
+			Offset:         entity.Offset,
+			Length:         entity.Length,
+			URL:            entity.URL,
+			UserTelegramID: entity.UserTelegramID,
+			Language:       entity.Language,
+			CustomEmojiID:  entity.CustomEmojiID,
+		}
+	}
+	return rows
+}
+
+type telegramExportRecord struct {
+	ID                           uuid.UUID              `json:"id"`
+	MessageTelegramID            uint64                 `json:"message_telegram_id"`
+	FromTelegramUserID           uuid.UUID              `json:"from_telegram_user_id"`
+	InTelegramChatID             int64                  `json:"in_telegram_chat_id"`
+	MessageText                  string                 `json:"message_text"`
+	Entities                     []telegramExportEntity `json:"entities"`
+	Caption                      string                 `json:"caption"`
+	CaptionEntities              []telegramExportEntity `json:"caption_entities"`
+	PostedAt                     time.Time              `json:"posted_at"`
+	EditedAt                     *time.Time             `json:"edited_at"`
+	DeletedAt                    *time.Time             `json:"deleted_at"`
+	ReplyToMessageTelegramID     *uint64                `json:"reply_to_message_telegram_id"`
+	ThreadTelegramID             *uint64                `json:"thread_telegram_id"`
+	ForwardFromUserTelegramID    *uint64                `json:"forward_from_user_telegram_id"`
+	ForwardFromChatTelegramID    *int64                 `json:"forward_from_chat_telegram_id"`
+	ForwardFromMessageTelegramID *uint64                `json:"forward_from_message_telegram_id"`
+	ForwardPostedAt              *time.Time             `json:"forward_posted_at"`
+	AddedAt                      time.Time              `json:"added_at"`
+	AddedByUser                  uuid.UUID              `json:"added_by_user"`
+	ContentHash                  string                 `json:"content_hash"`
+}
+
+func newTelegramExportRecord(record *domain.TelegramRecord) telegramExportRecord {
+	row := telegramExportRecord{
+		ID:                       record.ID,
+		MessageTelegramID:        record.MessageTelegramID,
+		FromTelegramUserID:       record.FromTelegramUserID,
+		InTelegramChatID:         record.InTelegramChatID,
+		MessageText:              record.MessageText,
+		Entities:                 newTelegramExportEntities(record.Entities),
+		Caption:                  record.Caption,
+		CaptionEntities:          newTelegramExportEntities(record.CaptionEntities),
+		PostedAt:                 record.PostedAt.UTC(),
+		EditedAt:                 utcTime(record.EditedAt),
+		DeletedAt:                utcTime(record.DeletedAt),
+		ReplyToMessageTelegramID: record.ReplyToMessageTelegramID,
+		ThreadTelegramID:         record.ThreadTelegramID,
+		AddedAt:                  record.AddedAt.UTC(),
+		AddedByUser:              record.AddedByUser,
+		ContentHash:              service.HashTelegramRecord(record),
+	}
+	if origin := record.ForwardOrigin; origin != nil {
+		row.ForwardFromUserTelegramID = origin.FromUserTelegramID
+		row.ForwardFromChatTelegramID = origin.FromChatTelegramID
+		row.ForwardFromMessageTelegramID = origin.MessageTelegramID
+		row.ForwardPostedAt = utcTime(origin.PostedAt)
+	}
+	return row
+}
+
+func (row telegramExportRecord) csvRecord() []string {
+	entities, _ := json.Marshal(row.Entities)
+	captionEntities, _ := json.Marshal(row.CaptionEntities)
+	return []string{
+		row.ID.String(),
+		strconv.FormatUint(row.MessageTelegramID, 10),
+		row.FromTelegramUserID.String(),
+		strconv.FormatInt(row.InTelegramChatID, 10),
+		row.MessageText,
+		string(entities),
+		row.Caption,
+		string(captionEntities),
+		formatTime(&row.PostedAt),
+		formatTime(row.EditedAt),
+		formatTime(row.DeletedAt),
+		formatUint(row.ReplyToMessageTelegramID),
+		formatUint(row.ThreadTelegramID),
+		formatUint(row.ForwardFromUserTelegramID),
+		formatInt(row.ForwardFromChatTelegramID),
+		formatUint(row.ForwardFromMessageTelegramID),
+		formatTime(row.ForwardPostedAt),
+		formatTime(&row.AddedAt),
+		row.AddedByUser.String(),
+		row.ContentHash,
+	}
+}
+
+type telegramExportAttachment struct {
+	ID                   uuid.UUID `json:"id"`
+	RecordID             uuid.UUID `json:"record_id"`
+	TelegramAttachmentID uint64    `json:"telegram_attachment_id"`
+	FileName             string    `json:"file_name"`
+	Path                 string    `json:"path"`
+	SHA256               string    `json:"sha256"`
+	FileSize             uint64    `json:"file_size"`
+	MimeType             string    `json:"mime_type"`
+	AddedAt              time.Time `json:"added_at"`
+	AddedByUser          uuid.UUID `json:"added_by_user"`
+	ContentHash          string    `json:"content_hash"`
+}
+
+func newTelegramExportAttachment(attachment *domain.TelegramAttachment) telegramExportAttachment {
+	return telegramExportAttachment{
+		ID:                   attachment.ID,
+		RecordID:             attachment.RecordID,
+		TelegramAttachmentID: attachment.TelegramAttachmentID,
+		FileName:             attachment.FileName,
+		Path:                 attachmentPath(attachment),
+		SHA256:               attachment.SHA256,
+		FileSize:             attachment.FileSize,
+		MimeType:             attachment.MimeType,
+		AddedAt:              attachment.AddedAt.UTC(),
+		AddedByUser:          attachment.AddedByUser,
+		ContentHash:          service.HashTelegramAttachment(attachment),
+	}
+}
+
+func (row telegramExportAttachment) csvRecord() []string {
+	return []string{
+		row.ID.String(),
+		row.RecordID.String(),
+		strconv.FormatUint(row.TelegramAttachmentID, 10),
+		row.FileName,
+		row.Path,
+		row.SHA256,
+		strconv.FormatUint(row.FileSize, 10),
+		row.MimeType,
+		formatTime(&row.AddedAt),
+		row.AddedByUser.String(),
+		row.ContentHash,
+	}
+}
+
+// attachmentPath is where the content of the attachment is stored in the bundle. The files are named after
+// their hash, the same content attached to many records is stored once.
+func attachmentPath(attachment *domain.TelegramAttachment) string {
+	return "attachments/" + attachment.SHA256
+}
+
+func utcTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func formatUint(value *uint64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatUint(*value, 10)
+}
+
+func formatInt(value *int64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatInt(*value, 10)
+}
@@ -11,18 +11,21 @@ import (
 	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
 	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
 	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
 	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
-	"github.com/InWamos/trinity-proto/middleware"
 )
 
 type GetLatestTelegramRecordsByUserTelegramID struct {
 	transactionManagerFactory interfaces.TransactionManagerFactory
 	telegramRecordFactory     repository.TelegramRecordRepositoryFactory
+	auditClient               auditclient.AuditClient
 	logger                    *slog.Logger
 }
 
 type GetLatestTelegramRecordsByUserTelegramIDRequest struct {
 	UserTelegramID uint64
+	// Tag only keeps the records holding it when it's set.
+	Tag string
 }
 
 type GetLatestTelegramRecordsByUserTelegramIDResponse struct {
@@ -32,6 +35,7 @@ type GetLatestTelegramRecordsByUserTelegramIDResponse struct {
 func NewGetLatestTelegramRecordsByUserTelegramID(
 	transactionManagerFactory interfaces.TransactionManagerFactory,
 	telegramRecordFactory repository.TelegramRecordRepositoryFactory,
+	auditClient auditclient.AuditClient,
 	logger *slog.Logger,
 ) *GetLatestTelegramRecordsByUserTelegramID {
 	iLogger := logger.With(
@@ -41,6 +45,7 @@ func NewGetLatestTelegramRecordsByUserTelegramID(
 	return &GetLatestTelegramRecordsByUserTelegramID{
 		transactionManagerFactory: transactionManagerFactory,
 		telegramRecordFactory:     telegramRecordFactory,
+		auditClient:               auditClient,
 		logger:                    iLogger,
 	}
 }
@@ -50,7 +55,7 @@ func (interactor *GetLatestTelegramRecordsByUserTelegramID) Execute(
 	input GetLatestTelegramRecordsByUserTelegramIDRequest,
 ) (*GetLatestTelegramRecordsByUserTelegramIDResponse, error) {
 	interactor.logger.DebugContext(ctx, "Started GetUserByID execution", slog.Uint64("user_id", input.UserTelegramID))
-	idp, ok := ctx.Value(middleware.IdentityProviderKey).(*client.UserIdentity)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
 	if !ok || idp == nil {
 		return nil, rbac.ErrInsufficientPrivileges
 	}
@@ -58,15 +63,28 @@ func (interactor *GetLatestTelegramRecordsByUserTelegramID) Execute(
 	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
 		return nil, rbac.ErrInsufficientPrivileges
 	}
+	tag, err := NormalizeTelegramTagFilter(input.Tag)
+	if err != nil {
+		return nil, err
+	}
 	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
 	if err != nil {
 		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
 		return nil, ErrDatabaseFailed
 	}
+	defer func() {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+	}()
 	recordRepository := interactor.telegramRecordFactory.CreateTelegramRecordRepositoryWithTransaction(
 		transactionManager,
 	)
-	records, err := recordRepository.GetLatestTelegramRecordsByUserTelegramID(ctx, input.UserTelegramID)
+	records, err := recordRepository.GetLatestTelegramRecordsByUserTelegramID(
+		ctx,
+		input.UserTelegramID,
+		tag,
+	)
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrNoRecordsForThisTelegramID):
@@ -86,6 +104,11 @@ func (interactor *GetLatestTelegramRecordsByUserTelegramID) Execute(
 			return nil, ErrDatabaseFailed
 		}
 	}
+
+	// The records are only shown once the viewing has been logged
+	if err = interactor.auditClient.RecordDetached(ctx, NewTelegramRecordsViewedAuditEntries(*records...)...); err != nil {
+		return nil, ErrDatabaseFailed
+	}
 	return &GetLatestTelegramRecordsByUserTelegramIDResponse{
 		TelegramRecords: records,
 	}, nil
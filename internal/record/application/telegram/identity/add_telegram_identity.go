@@ -12,9 +12,9 @@ import (
 	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
 	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
 	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
 	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
 	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
-	"github.com/InWamos/trinity-proto/middleware"
 	"github.com/google/uuid"
 )
 
@@ -35,6 +35,10 @@ type AddTelegramIdentity struct {
 	transactionManagerFactory       interfaces.TransactionManagerFactory
 	telegramIdentityDomainValidator *service.TelegramModelValidator
 	telegramIdentityFactory         repository.TelegramIdentityRepositoryFactory
+	watchlistEvaluator              *application.TelegramWatchlistEvaluator
+	chainAppender                   *application.TelegramChainAppender
+	eventPublisher                  *application.TelegramEventPublisher
+	auditClient                     auditclient.AuditClient
 	logger                          *slog.Logger
 }
 
@@ -42,6 +46,10 @@ func NewAddTelegramIdentity(
 	transactionManagerFactory interfaces.TransactionManagerFactory,
 	telegramIdentityDomainValidator *service.TelegramModelValidator,
 	telegramIdentityFactory repository.TelegramIdentityRepositoryFactory,
+	watchlistEvaluator *application.TelegramWatchlistEvaluator,
+	chainAppender *application.TelegramChainAppender,
+	eventPublisher *application.TelegramEventPublisher,
+	auditClient auditclient.AuditClient,
 	logger *slog.Logger,
 ) *AddTelegramIdentity {
 	iLogger := logger.With(
@@ -52,6 +60,10 @@ func NewAddTelegramIdentity(
 		transactionManagerFactory:       transactionManagerFactory,
 		telegramIdentityFactory:         telegramIdentityFactory,
 		telegramIdentityDomainValidator: telegramIdentityDomainValidator,
+		watchlistEvaluator:              watchlistEvaluator,
+		chainAppender:                   chainAppender,
+		eventPublisher:                  eventPublisher,
+		auditClient:                     auditClient,
 		logger:                          iLogger,
 	}
 }
@@ -60,7 +72,7 @@ func (interactor *AddTelegramIdentity) Execute(
 	ctx context.Context,
 	input AddTelegramIdentityRequest,
 ) (*AddTelegramIdentityResponse, error) {
-	idp, ok := ctx.Value(middleware.IdentityProviderKey).(*client.UserIdentity)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
 	if !ok || idp == nil {
 		return nil, rbac.ErrInsufficientPrivileges
 	}
@@ -137,6 +149,30 @@ func (interactor *AddTelegramIdentity) Execute(
 		}
 	}
 
+	if err = interactor.watchlistEvaluator.EvaluateIdentities(ctx, transactionManager, identityID); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to evaluate watchlists", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	if err = interactor.chainAppender.AppendIdentities(ctx, transactionManager, identityID); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to chain telegram identity", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	if err = interactor.eventPublisher.PublishIdentityCreated(ctx, transactionManager, telegramIdentity); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to publish telegram identity event", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	entry := application.NewTelegramAuditEntry(
+		auditclient.TelegramIdentityAdded,
+		application.AuditTargetTelegramIdentity,
+		identityID,
+		nil,
+		telegramIdentity,
+	)
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record audit entry", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
 	if err = transactionManager.Commit(ctx); err != nil {
 		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
 		return nil, application.ErrDatabaseFailed
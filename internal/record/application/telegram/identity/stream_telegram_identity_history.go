@@ -0,0 +1,112 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+)
+
+// StreamTelegramIdentityHistoryRequest passes the identities to Yield as they're read,
+// an error returned by Yield stops the stream and is returned as it is.
+type StreamTelegramIdentityHistoryRequest struct {
+	UserTelegramID uint64
+	// Tag only keeps the identities holding it when it's set.
+	Tag   string
+	Yield func(*domain.TelegramIdentity) error
+}
+
+type StreamTelegramIdentityHistoryResponse struct {
+	Streamed int64
+}
+
+// StreamTelegramIdentityHistory reads the identities a user has had over time, as added by every collector,
+// the oldest first.
+type StreamTelegramIdentityHistory struct {
+	transactionManagerFactory interfaces.TransactionManagerFactory
+	telegramIdentityFactory   repository.TelegramIdentityRepositoryFactory
+	logger                    *slog.Logger
+}
+
+func NewStreamTelegramIdentityHistory(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramIdentityFactory repository.TelegramIdentityRepositoryFactory,
+	logger *slog.Logger,
+) *StreamTelegramIdentityHistory {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "stream_telegram_identity_history"),
+	)
+	return &StreamTelegramIdentityHistory{
+		transactionManagerFactory: transactionManagerFactory,
+		telegramIdentityFactory:   telegramIdentityFactory,
+		logger:                    iLogger,
+	}
+}
+
+func (interactor *StreamTelegramIdentityHistory) Execute(
+	ctx context.Context,
+	input StreamTelegramIdentityHistoryRequest,
+) (*StreamTelegramIdentityHistoryResponse, error) {
+	interactor.logger.DebugContext(
+		ctx,
+		"Started StreamTelegramIdentityHistory execution",
+		slog.Uint64("user_telegram_id", input.UserTelegramID),
+	)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+	tag, err := application.NormalizeTelegramTagFilter(input.Tag)
+	if err != nil {
+		return nil, err
+	}
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer func() {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+	}()
+
+	identityRepository := interactor.telegramIdentityFactory.CreateTelegramIdentityRepositoryWithTransaction(
+		transactionManager,
+	)
+	var streamed int64
+	err = identityRepository.StreamIdentitiesByUserTelegramID(
+		ctx,
+		input.UserTelegramID,
+		tag,
+		func(identity *domain.TelegramIdentity) error {
+			streamed++
+			return input.Yield(identity)
+		},
+	)
+	if err != nil {
+		if errors.Is(err, repository.ErrDatabaseFailed) {
+			return nil, application.ErrDatabaseFailed
+		}
+		return nil, err
+	}
+
+	interactor.logger.DebugContext(
+		ctx,
+		"Finished StreamTelegramIdentityHistory execution",
+		slog.Int64("streamed", streamed),
+	)
+	return &StreamTelegramIdentityHistoryResponse{Streamed: streamed}, nil
+}
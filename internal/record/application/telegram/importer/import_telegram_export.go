@@ -0,0 +1,619 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"log/slog"
+	"time"
+
+	"github.com/InWamos/trinity-proto/config"
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	blobstorage "github.com/InWamos/trinity-proto/internal/shared/infrastructure/blob_storage"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+// importChunkSize is the amount of messages committed in one transaction.
+// A failed import can be repeated, already imported messages are skipped as duplicates.
+const importChunkSize = 200
+
+type ImportTelegramExportRequest struct {
+	// Export is the content of result.json.
+	Export io.Reader
+	// Files is the export folder media paths are resolved against, nil when only result.json is imported.
+	Files fs.FS
+	// OnProgress is called after every committed chunk of messages, optional.
+	OnProgress func(ImportTelegramExportProgress)
+}
+
+type ImportTelegramExportProgress struct {
+	MessagesProcessed   int
+	RecordsImported     int
+	DuplicatesSkipped   int
+	MessagesSkipped     int
+	UsersCreated        int
+	AttachmentsImported int
+	AttachmentsMissing  int
+}
+
+type ImportTelegramExportResponse struct {
+	ChatID         uuid.UUID
+	ChatTelegramID int64
+	Progress       ImportTelegramExportProgress
+}
+
+// ImportTelegramExport imports a Telegram Desktop "Export chat history" result.json:
+// the chat, the senders, the messages and, when the export folder is provided, their media.
+type ImportTelegramExport struct {
+	transactionManagerFactory interfaces.TransactionManagerFactory
+	telegramDomainValidator   *service.TelegramModelValidator
+	telegramUserFactory       repository.TelegramUserRepositoryFactory
+	telegramChatFactory       repository.TelegramChatRepositoryFactory
+	telegramRecordFactory     repository.TelegramRecordRepositoryFactory
+	telegramAttachmentFactory repository.TelegramAttachmentRepositoryFactory
+	recordAnalyzer            *application.TelegramRecordAnalyzer
+	chainAppender             *application.TelegramChainAppender
+	eventPublisher            *application.TelegramEventPublisher
+	provenanceRecorder        *application.TelegramProvenanceRecorder
+	blobStore                 interfaces.BlobStore
+	maxUploadSize             int64
+	auditClient               auditclient.AuditClient
+	logger                    *slog.Logger
+}
+
+func NewImportTelegramExport(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramDomainValidator *service.TelegramModelValidator,
+	telegramUserFactory repository.TelegramUserRepositoryFactory,
+	telegramChatFactory repository.TelegramChatRepositoryFactory,
+	telegramRecordFactory repository.TelegramRecordRepositoryFactory,
+	telegramAttachmentFactory repository.TelegramAttachmentRepositoryFactory,
+	recordAnalyzer *application.TelegramRecordAnalyzer,
+	chainAppender *application.TelegramChainAppender,
+	eventPublisher *application.TelegramEventPublisher,
+	provenanceRecorder *application.TelegramProvenanceRecorder,
+	blobStore interfaces.BlobStore,
+	storageConfig *config.StorageConfig,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *ImportTelegramExport {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "import_telegram_export"),
+	)
+	return &ImportTelegramExport{
+		transactionManagerFactory: transactionManagerFactory,
+		telegramDomainValidator:   telegramDomainValidator,
+		telegramUserFactory:       telegramUserFactory,
+		telegramChatFactory:       telegramChatFactory,
+		telegramRecordFactory:     telegramRecordFactory,
+		telegramAttachmentFactory: telegramAttachmentFactory,
+		recordAnalyzer:            recordAnalyzer,
+		chainAppender:             chainAppender,
+		eventPublisher:            eventPublisher,
+		provenanceRecorder:        provenanceRecorder,
+		blobStore:                 blobStore,
+		maxUploadSize:             storageConfig.MaxUploadSize,
+		auditClient:               auditClient,
+		logger:                    iLogger,
+	}
+}
+
+// importState is carried between the chunks of a single import.
+type importState struct {
+	idp            *client.UserIdentity
+	chatTelegramID int64
+	files          fs.FS
+	// userIDs caches the telegram users already resolved by their telegram ID
+	userIDs  map[uint64]uuid.UUID
+	progress ImportTelegramExportProgress
+}
+
+func (interactor *ImportTelegramExport) Execute(
+	ctx context.Context,
+	input ImportTelegramExportRequest,
+) (*ImportTelegramExportResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	reader := newExportReader(input.Export)
+	header, err := reader.ReadHeader()
+	if err != nil {
+		return nil, err
+	}
+	interactor.logger.InfoContext(
+		ctx,
+		"Started ImportTelegramExport execution",
+		slog.Int64("export_chat_id", header.ID),
+		slog.String("export_chat_type", header.Type),
+	)
+
+	chatID, err := interactor.importChat(ctx, idp, header)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &importState{
+		idp:            idp,
+		chatTelegramID: header.ChatTelegramID(),
+		files:          input.Files,
+		userIDs:        make(map[uint64]uuid.UUID),
+	}
+	chunk := make([]*exportMessage, 0, importChunkSize)
+	for {
+		message, nextErr := reader.Next()
+		if nextErr != nil && !errors.Is(nextErr, io.EOF) {
+			return nil, nextErr
+		}
+		if message != nil {
+			chunk = append(chunk, message)
+		}
+		if len(chunk) == importChunkSize || (errors.Is(nextErr, io.EOF) && len(chunk) > 0) {
+			if err = interactor.importChunk(ctx, state, chunk); err != nil {
+				return nil, err
+			}
+			chunk = chunk[:0]
+			if input.OnProgress != nil {
+				input.OnProgress(state.progress)
+			}
+		}
+		if errors.Is(nextErr, io.EOF) {
+			break
+		}
+	}
+
+	interactor.logger.InfoContext(
+		ctx,
+		"Finished ImportTelegramExport execution",
+		slog.Int("records_imported", state.progress.RecordsImported),
+		slog.Int("duplicates_skipped", state.progress.DuplicatesSkipped),
+	)
+	return &ImportTelegramExportResponse{
+		ChatID:         chatID,
+		ChatTelegramID: state.chatTelegramID,
+		Progress:       state.progress,
+	}, nil
+}
+
+// importChat creates the chat unless it exists and snapshots its current title.
+func (interactor *ImportTelegramExport) importChat(
+	ctx context.Context,
+	idp *client.UserIdentity,
+	header *exportHeader,
+) (uuid.UUID, error) {
+	now := time.Now()
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return uuid.Nil, application.ErrDatabaseFailed
+	}
+	chatRepository := interactor.telegramChatFactory.CreateTelegramChatRepositoryWithTransaction(transactionManager)
+
+	var auditEntries []auditclient.Entry
+	telegramChat, err := chatRepository.GetChatByTelegramID(ctx, header.ChatTelegramID(), idp.UserID)
+	switch {
+	case errors.Is(err, domain.ErrChatNotFound):
+		telegramChat = &domain.TelegramChat{
+			ID:             uuid.New(),
+			ChatTelegramID: header.ChatTelegramID(),
+			Type:           header.ChatType(),
+			AddedAt:        now,
+			AddedByUser:    idp.UserID,
+		}
+		if err = interactor.telegramDomainValidator.Validate(telegramChat); err != nil {
+			interactor.rollback(ctx, transactionManager)
+			return uuid.Nil, err
+		}
+		if err = chatRepository.AddChat(ctx, telegramChat); err != nil {
+			interactor.rollback(ctx, transactionManager)
+			return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+		}
+		auditEntries = append(auditEntries, application.NewTelegramAuditEntry(
+			auditclient.TelegramChatAdded,
+			application.AuditTargetTelegramChat,
+			telegramChat.ID,
+			nil,
+			telegramChat,
+		))
+	case err != nil:
+		interactor.rollback(ctx, transactionManager)
+		return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+	}
+
+	snapshot := &domain.TelegramChatSnapshot{
+		ID:          uuid.New(),
+		ChatID:      telegramChat.ID,
+		Title:       header.Name,
+		AddedAt:     now,
+		AddedByUser: idp.UserID,
+	}
+	// A snapshot with an unchanged title is simply not added again
+	if err = interactor.telegramDomainValidator.Validate(snapshot); err == nil {
+		err = transactionManager.InSavepoint(ctx, func() error {
+			return chatRepository.AddChatSnapshot(ctx, snapshot)
+		})
+		switch {
+		case err == nil:
+			auditEntries = append(auditEntries, application.NewTelegramAuditEntry(
+				auditclient.TelegramChatSnapshotAdded,
+				application.AuditTargetTelegramChatSnapshot,
+				snapshot.ID,
+				nil,
+				snapshot,
+			))
+		case !errors.Is(err, domain.ErrChatSnapshotAlreadyExists):
+			interactor.rollback(ctx, transactionManager)
+			return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+		}
+	}
+	if err = interactor.auditClient.Record(ctx, transactionManager, auditEntries...); err != nil {
+		interactor.rollback(ctx, transactionManager)
+		return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return uuid.Nil, application.ErrDatabaseFailed
+	}
+	return telegramChat.ID, nil
+}
+
+func (interactor *ImportTelegramExport) importChunk(
+	ctx context.Context,
+	state *importState,
+	chunk []*exportMessage,
+) error {
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+	userRepository := interactor.telegramUserFactory.CreateTelegramUserRepositoryWithTransaction(transactionManager)
+	recordRepository := interactor.telegramRecordFactory.CreateTelegramRecordRepositoryWithTransaction(
+		transactionManager,
+	)
+	attachmentRepository := interactor.telegramAttachmentFactory.CreateTelegramAttachmentRepositoryWithTransaction(
+		transactionManager,
+	)
+
+	now := time.Now()
+	var auditEntries []auditclient.Entry
+	records := make([]domain.TelegramRecord, 0, len(chunk))
+	recordMessages := make([]*exportMessage, 0, len(chunk))
+	for _, message := range chunk {
+		state.progress.MessagesProcessed++
+		senderTelegramID, isUser := message.SenderTelegramID()
+		postedAt, dateErr := message.PostedAt()
+		if message.Type != "message" || !isUser || dateErr != nil {
+			state.progress.MessagesSkipped++
+			continue
+		}
+
+		senderID, known := state.userIDs[senderTelegramID]
+		if !known {
+			sender, created, upsertErr := interactor.upsertUser(
+				ctx,
+				transactionManager,
+				userRepository,
+				state.idp,
+				senderTelegramID,
+				now,
+			)
+			if errors.Is(upsertErr, domain.ErrValidationFailed) {
+				state.progress.MessagesSkipped++
+				continue
+			}
+			if upsertErr != nil {
+				interactor.rollback(ctx, transactionManager)
+				return upsertErr
+			}
+			senderID = sender.ID
+			state.userIDs[senderTelegramID] = senderID
+			if created {
+				state.progress.UsersCreated++
+				auditEntries = append(auditEntries, application.NewTelegramAuditEntry(
+					auditclient.TelegramUserAdded,
+					application.AuditTargetTelegramUser,
+					sender.ID,
+					nil,
+					sender,
+				))
+			}
+		}
+
+		telegramRecord := domain.TelegramRecord{
+			ID:                       uuid.New(),
+			MessageTelegramID:        message.ID,
+			FromTelegramUserID:       senderID,
+			InTelegramChatID:         state.chatTelegramID,
+			PostedAt:                 postedAt,
+			ReplyToMessageTelegramID: message.ReplyToMessageTelegramID(),
+			ForwardOrigin:            message.ForwardOrigin(),
+			AddedAt:                  now,
+			AddedByUser:              state.idp.UserID,
+		}
+		if message.HasMedia() {
+			telegramRecord.Caption = string(message.Text)
+			telegramRecord.CaptionEntities = message.Entities()
+		} else {
+			telegramRecord.MessageText = string(message.Text)
+			telegramRecord.Entities = message.Entities()
+		}
+		if err = interactor.telegramDomainValidator.Validate(&telegramRecord); err != nil {
+			state.progress.MessagesSkipped++
+			continue
+		}
+		records = append(records, telegramRecord)
+		recordMessages = append(recordMessages, message)
+	}
+
+	recordErrors, err := recordRepository.CreateTelegramRecords(ctx, records)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to add telegram records", slog.Any("err", err))
+		interactor.rollback(ctx, transactionManager)
+		return application.ErrDatabaseFailed
+	}
+	importedRecords := make([]domain.TelegramRecord, 0, len(records))
+	importedRecordIDs := make([]uuid.UUID, 0, len(records))
+	// The duplicates already stored by other collectors are observed by the importing user
+	observedRecordIDs := make([]uuid.UUID, 0, len(records))
+	var attachmentIDs []uuid.UUID
+	for i, recordErr := range recordErrors {
+		switch {
+		case recordErr == nil:
+			state.progress.RecordsImported++
+			importedRecords = append(importedRecords, records[i])
+			importedRecordIDs = append(importedRecordIDs, records[i].ID)
+			attachments, attachmentsErr := interactor.importAttachments(
+				ctx,
+				transactionManager,
+				attachmentRepository,
+				state,
+				records[i],
+				recordMessages[i],
+			)
+			if attachmentsErr != nil {
+				interactor.rollback(ctx, transactionManager)
+				return attachmentsErr
+			}
+			for _, attachment := range attachments {
+				attachmentIDs = append(attachmentIDs, attachment.ID)
+				auditEntries = append(auditEntries, application.NewTelegramAuditEntry(
+					auditclient.TelegramAttachmentAdded,
+					application.AuditTargetTelegramAttachment,
+					attachment.ID,
+					nil,
+					attachment,
+				))
+			}
+		case errors.Is(recordErr, domain.ErrRecordAlreadyExists):
+			state.progress.DuplicatesSkipped++
+			stored, storedErr := recordRepository.GetTelegramRecordByMessageTelegramID(
+				ctx,
+				records[i].MessageTelegramID,
+				records[i].InTelegramChatID,
+			)
+			// The message may be stored by a collector whose observation of it the importer doesn't see
+			if errors.Is(storedErr, domain.ErrRecordNotFound) {
+				continue
+			}
+			if storedErr != nil {
+				interactor.rollback(ctx, transactionManager)
+				return interactor.mapRepositoryError(ctx, storedErr)
+			}
+			observedRecordIDs = append(observedRecordIDs, stored.ID)
+		default:
+			state.progress.MessagesSkipped++
+		}
+	}
+	err = interactor.recordAnalyzer.Analyze(
+		ctx,
+		transactionManager,
+		importedRecords...,
+	)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to store telegram indicators", slog.Any("err", err))
+		interactor.rollback(ctx, transactionManager)
+		return application.ErrDatabaseFailed
+	}
+	err = interactor.chainAppender.AppendRecords(ctx, transactionManager, importedRecordIDs...)
+	if err == nil {
+		err = interactor.chainAppender.AppendAttachments(ctx, transactionManager, attachmentIDs...)
+	}
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to chain telegram records", slog.Any("err", err))
+		interactor.rollback(ctx, transactionManager)
+		return application.ErrDatabaseFailed
+	}
+	observedRecordIDs = append(observedRecordIDs, importedRecordIDs...)
+	_, err = interactor.provenanceRecorder.ObserveRecords(ctx, transactionManager, state.idp.UserID, observedRecordIDs...)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to observe telegram records", slog.Any("err", err))
+		interactor.rollback(ctx, transactionManager)
+		return application.ErrDatabaseFailed
+	}
+	if err = interactor.eventPublisher.PublishRecordsCreated(ctx, transactionManager, importedRecords...); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to publish telegram record events", slog.Any("err", err))
+		interactor.rollback(ctx, transactionManager)
+		return application.ErrDatabaseFailed
+	}
+	auditEntries = append(auditEntries, application.NewTelegramRecordsAddedAuditEntries(importedRecords...)...)
+	if err = interactor.auditClient.Record(ctx, transactionManager, auditEntries...); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record telegram audit entries", slog.Any("err", err))
+		interactor.rollback(ctx, transactionManager)
+		return application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+	return nil
+}
+
+// upsertUser returns the canonical telegram user, creating it when missing, observed by the importing user.
+func (interactor *ImportTelegramExport) upsertUser(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	userRepository repository.TelegramUserRepository,
+	idp *client.UserIdentity,
+	telegramID uint64,
+	now time.Time,
+) (*domain.TelegramUser, bool, error) {
+	telegramUser, err := userRepository.GetByTelegramID(ctx, telegramID)
+	if err == nil {
+		if _, err = interactor.provenanceRecorder.ObserveUsers(
+			ctx,
+			transactionManager,
+			idp.UserID,
+			telegramUser.ID,
+		); err != nil {
+			return nil, false, interactor.mapRepositoryError(ctx, err)
+		}
+		return telegramUser, false, nil
+	}
+	if !errors.Is(err, domain.ErrUserNotFound) {
+		return nil, false, interactor.mapRepositoryError(ctx, err)
+	}
+
+	telegramUser = &domain.TelegramUser{
+		ID:          uuid.New(),
+		TelegramID:  telegramID,
+		AddedAt:     now,
+		AddedByUser: idp.UserID,
+	}
+	if err = interactor.telegramDomainValidator.Validate(telegramUser); err != nil {
+		return nil, false, err
+	}
+	canonicalUser, err := interactor.provenanceRecorder.AddUser(ctx, transactionManager, userRepository, telegramUser)
+	if err != nil && !errors.Is(err, domain.ErrUserAlreadyExists) {
+		return nil, false, interactor.mapRepositoryError(ctx, err)
+	}
+	return canonicalUser, canonicalUser.ID == telegramUser.ID, nil
+}
+
+// importAttachments stores the media of the message found in the export folder and returns the stored ones.
+// Exports made without media only mention the files, those are counted as missing,
+// as well as the files repeating the content of another file of the message.
+func (interactor *ImportTelegramExport) importAttachments(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	attachmentRepository repository.TelegramAttachmentRepository,
+	state *importState,
+	telegramRecord domain.TelegramRecord,
+	message *exportMessage,
+) ([]*domain.TelegramAttachment, error) {
+	var stored []*domain.TelegramAttachment
+	storedSHA256 := make(map[string]struct{})
+	for _, file := range message.Files() {
+		if state.files == nil {
+			state.progress.AttachmentsMissing++
+			continue
+		}
+		content, err := state.files.Open(file.Path)
+		if err != nil {
+			state.progress.AttachmentsMissing++
+			continue
+		}
+		blob, err := blobstorage.SpoolBlob(content, interactor.maxUploadSize)
+		content.Close()
+		if err != nil {
+			if errors.Is(err, blobstorage.ErrBlobTooLarge) || errors.Is(err, blobstorage.ErrBlobEmpty) {
+				state.progress.AttachmentsMissing++
+				continue
+			}
+			interactor.logger.ErrorContext(ctx, "failed to spool the attachment", slog.Any("err", err))
+			return nil, application.ErrBlobStorageFailed
+		}
+		// The record is new, so only its own files may clash with the content it's unique by
+		if _, duplicate := storedSHA256[blob.SHA256]; duplicate {
+			if closeErr := blob.Close(); closeErr != nil {
+				interactor.logger.ErrorContext(ctx, "failed to remove spooled attachment", slog.Any("err", closeErr))
+			}
+			state.progress.AttachmentsMissing++
+			continue
+		}
+
+		attachment := &domain.TelegramAttachment{
+			ID:          uuid.New(),
+			RecordID:    telegramRecord.ID,
+			FileName:    file.FileName,
+			StorageKey:  blob.StorageKey(),
+			SHA256:      blob.SHA256,
+			FileSize:    uint64(blob.Size),
+			MimeType:    blob.MimeType,
+			AddedAt:     telegramRecord.AddedAt,
+			AddedByUser: telegramRecord.AddedByUser,
+		}
+		err = interactor.storeAttachment(ctx, transactionManager, attachmentRepository, blob, attachment)
+		if closeErr := blob.Close(); closeErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to remove spooled attachment", slog.Any("err", closeErr))
+		}
+		switch {
+		case err == nil:
+			state.progress.AttachmentsImported++
+			stored = append(stored, attachment)
+			storedSHA256[attachment.SHA256] = struct{}{}
+		case errors.Is(err, domain.ErrValidationFailed), errors.Is(err, domain.ErrAttachmentAlreadyExists):
+			state.progress.AttachmentsMissing++
+		default:
+			return nil, err
+		}
+	}
+	return stored, nil
+}
+
+func (interactor *ImportTelegramExport) storeAttachment(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	attachmentRepository repository.TelegramAttachmentRepository,
+	blob *blobstorage.SpooledBlob,
+	attachment *domain.TelegramAttachment,
+) error {
+	if err := interactor.telegramDomainValidator.Validate(attachment); err != nil {
+		return err
+	}
+	if err := blob.Store(ctx, interactor.blobStore); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to store the blob", slog.Any("err", err))
+		return application.ErrBlobStorageFailed
+	}
+	err := transactionManager.InSavepoint(ctx, func() error {
+		return attachmentRepository.AddAttachment(ctx, attachment)
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrAttachmentAlreadyExists) {
+			return err
+		}
+		return interactor.mapRepositoryError(ctx, err)
+	}
+	return nil
+}
+
+func (interactor *ImportTelegramExport) mapRepositoryError(ctx context.Context, err error) error {
+	interactor.logger.ErrorContext(ctx, "failed to import telegram export", slog.Any("err", err))
+	return application.ErrDatabaseFailed
+}
+
+func (interactor *ImportTelegramExport) rollback(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+) {
+	if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+		interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+	}
+}
@@ -0,0 +1,319 @@
+package importer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+)
+
+var ErrInvalidExport = errors.New("invalid telegram desktop export")
+
+// Bot API chat IDs of supergroups and channels are their MTProto IDs shifted by this offset.
+const botAPIChannelIDOffset = 1_000_000_000_000
+
+// exportHeader is the chat metadata preceding the messages of a result.json file.
+type exportHeader struct {
+	Name string
+	Type string
+	ID   int64
+}
+
+// ChatTelegramID converts the MTProto ID of the export to the Bot API one used across the project.
+func (header exportHeader) ChatTelegramID() int64 {
+	switch header.ChatType() {
+	case domain.TelegramChatTypeGroup:
+		return -header.ID
+	case domain.TelegramChatTypeSupergroup, domain.TelegramChatTypeChannel:
+		return -(botAPIChannelIDOffset + header.ID)
+	default:
+		return header.ID
+	}
+}
+
+func (header exportHeader) ChatType() domain.TelegramChatType {
+	switch header.Type {
+	case "private_group":
+		return domain.TelegramChatTypeGroup
+	case "private_supergroup", "public_supergroup":
+		return domain.TelegramChatTypeSupergroup
+	case "private_channel", "public_channel":
+		return domain.TelegramChatTypeChannel
+	default:
+		// personal_chat, bot_chat, saved_messages
+		return domain.TelegramChatTypePrivate
+	}
+}
+
+// exportMessage is a single entry of the "messages" array.
+type exportMessage struct {
+	ID           uint64             `json:"id"`
+	Type         string             `json:"type"`
+	Date         string             `json:"date"`
+	DateUnixtime string             `json:"date_unixtime"`
+	FromID       string             `json:"from_id"`
+	Text         exportText         `json:"text"`
+	TextEntities []exportTextEntity `json:"text_entities"`
+	Photo        string             `json:"photo"`
+	File         string             `json:"file"`
+	FileName     string             `json:"file_name"`
+	// ReplyToMessageID references a message of the same chat
+	ReplyToMessageID uint64 `json:"reply_to_message_id"`
+	// ForwardedFromID is only present in newer exports, e.g. user42 or channel777
+	ForwardedFromID string `json:"forwarded_from_id"`
+}
+
+// PostedAt prefers the unix time of newer exports, older ones only have the local time without zone.
+func (message exportMessage) PostedAt() (time.Time, error) {
+	if message.DateUnixtime != "" {
+		unixtime, err := strconv.ParseInt(message.DateUnixtime, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Unix(unixtime, 0).UTC(), nil
+	}
+	return time.Parse("2006-01-02T15:04:05", message.Date)
+}
+
+// SenderTelegramID returns the ID of the user who has sent the message.
+// Messages sent on behalf of channels and chats have no user to be attributed to.
+func (message exportMessage) SenderTelegramID() (uint64, bool) {
+	rawID, found := strings.CutPrefix(message.FromID, "user")
+	if !found {
+		return 0, false
+	}
+	senderID, err := strconv.ParseUint(rawID, 10, 64)
+	if err != nil || senderID == 0 {
+		return 0, false
+	}
+	return senderID, true
+}
+
+// ReplyToMessageTelegramID returns the message the message replies to, nil if it's not a reply.
+func (message exportMessage) ReplyToMessageTelegramID() *uint64 {
+	if message.ReplyToMessageID == 0 {
+		return nil
+	}
+	return &message.ReplyToMessageID
+}
+
+// ForwardOrigin returns the author of a forwarded message, converted like ChatTelegramID for chats and channels.
+// Exports hold neither the original message nor its date, and nil is returned for unknown origins.
+func (message exportMessage) ForwardOrigin() *domain.TelegramForwardOrigin {
+	for _, prefix := range []string{"user", "chat", "channel"} {
+		rawID, found := strings.CutPrefix(message.ForwardedFromID, prefix)
+		if !found {
+			continue
+		}
+		originID, err := strconv.ParseUint(rawID, 10, 63)
+		if err != nil || originID == 0 {
+			return nil
+		}
+		switch prefix {
+		case "user":
+			return &domain.TelegramForwardOrigin{FromUserTelegramID: &originID}
+		case "chat":
+			chatID := -int64(originID)
+			return &domain.TelegramForwardOrigin{FromChatTelegramID: &chatID}
+		default:
+			chatID := -(botAPIChannelIDOffset + int64(originID))
+			return &domain.TelegramForwardOrigin{FromChatTelegramID: &chatID}
+		}
+	}
+	return nil
+}
+
+// exportTextEntity is an element of "text_entities", the text split into plain and formatted parts.
+type exportTextEntity struct {
+	Type     string `json:"type"`
+	Text     string `json:"text"`
+	Href     string `json:"href"`
+	UserID   uint64 `json:"user_id"`
+	Language string `json:"language"`
+}
+
+// exportEntityTypes maps the entity types of exports to the Bot API ones, the others are dropped.
+var exportEntityTypes = map[string]domain.TelegramMessageEntityType{
+	"mention":       domain.TelegramMessageEntityTypeMention,
+	"mention_name":  domain.TelegramMessageEntityTypeTextMention,
+	"hashtag":       domain.TelegramMessageEntityTypeHashtag,
+	"cashtag":       domain.TelegramMessageEntityTypeCashtag,
+	"bot_command":   domain.TelegramMessageEntityTypeBotCommand,
+	"link":          domain.TelegramMessageEntityTypeURL,
+	"email":         domain.TelegramMessageEntityTypeEmail,
+	"phone":         domain.TelegramMessageEntityTypePhoneNumber,
+	"bold":          domain.TelegramMessageEntityTypeBold,
+	"italic":        domain.TelegramMessageEntityTypeItalic,
+	"underline":     domain.TelegramMessageEntityTypeUnderline,
+	"strikethrough": domain.TelegramMessageEntityTypeStrikethrough,
+	"spoiler":       domain.TelegramMessageEntityTypeSpoiler,
+	"blockquote":    domain.TelegramMessageEntityTypeBlockquote,
+	"code":          domain.TelegramMessageEntityTypeCode,
+	"pre":           domain.TelegramMessageEntityTypePre,
+	"text_link":     domain.TelegramMessageEntityTypeTextLink,
+}
+
+// Entities converts the formatted parts of the text to entities, the offsets are the UTF-16 lengths of the parts
+// preceding them. Parts missing the attributes their type requires are dropped.
+func (message exportMessage) Entities() []domain.TelegramMessageEntity {
+	var entities []domain.TelegramMessageEntity
+	offset := 0
+	for _, part := range message.TextEntities {
+		length := domain.UTF16Length(part.Text)
+		entityType, known := exportEntityTypes[part.Type]
+		entity := domain.TelegramMessageEntity{
+			Type:           entityType,
+			Offset:         offset,
+			Length:         length,
+			URL:            part.Href,
+			UserTelegramID: part.UserID,
+			Language:       part.Language,
+		}
+		offset += length
+		valid := known && length > 0 &&
+			(entityType != domain.TelegramMessageEntityTypeTextLink || entity.URL != "") &&
+			(entityType != domain.TelegramMessageEntityTypeTextMention || entity.UserTelegramID != 0)
+		if valid {
+			entities = append(entities, entity)
+		}
+	}
+	return entities
+}
+
+// exportFile is a media file the message refers to, relative to the export folder.
+type exportFile struct {
+	Path     string
+	FileName string
+}
+
+// HasMedia tells whether the text of the message is the caption of a media file.
+func (message exportMessage) HasMedia() bool {
+	return message.Photo != "" || message.File != ""
+}
+
+func (message exportMessage) Files() []exportFile {
+	var files []exportFile
+	if message.Photo != "" {
+		files = append(files, exportFile{Path: message.Photo, FileName: baseName(message.Photo)})
+	}
+	if message.File != "" {
+		fileName := message.FileName
+		if fileName == "" {
+			fileName = baseName(message.File)
+		}
+		files = append(files, exportFile{Path: message.File, FileName: fileName})
+	}
+	return files
+}
+
+func baseName(path string) string {
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+// exportText is the "text" field, either a plain string or an array of plain strings
+// and rich text objects ({"type": "bold", "text": "..."}), flattened to plain text.
+type exportText string
+
+func (text *exportText) UnmarshalJSON(data []byte) error {
+	var plain string
+	if err := json.Unmarshal(data, &plain); err == nil {
+		*text = exportText(plain)
+		return nil
+	}
+	var parts []json.RawMessage
+	if err := json.Unmarshal(data, &parts); err != nil {
+		return err
+	}
+	var builder strings.Builder
+	for _, part := range parts {
+		if err := json.Unmarshal(part, &plain); err == nil {
+			builder.WriteString(plain)
+			continue
+		}
+		var entity struct {
+			Text string `json:"text"`
+		}
+		if err := json.Unmarshal(part, &entity); err != nil {
+			return err
+		}
+		builder.WriteString(entity.Text)
+	}
+	*text = exportText(builder.String())
+	return nil
+}
+
+// exportReader streams a result.json file message by message, so exports of any size
+// are imported in bounded memory.
+type exportReader struct {
+	decoder *json.Decoder
+}
+
+func newExportReader(export io.Reader) *exportReader {
+	return &exportReader{decoder: json.NewDecoder(export)}
+}
+
+// ReadHeader reads the chat metadata up to the beginning of the "messages" array.
+func (reader *exportReader) ReadHeader() (*exportHeader, error) {
+	if err := reader.expectDelim('{'); err != nil {
+		return nil, err
+	}
+	header := &exportHeader{}
+	for reader.decoder.More() {
+		token, err := reader.decoder.Token()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidExport, err)
+		}
+		key, _ := token.(string)
+		switch key {
+		case "name":
+			err = reader.decoder.Decode(&header.Name)
+		case "type":
+			err = reader.decoder.Decode(&header.Type)
+		case "id":
+			err = reader.decoder.Decode(&header.ID)
+		case "messages":
+			if header.ID == 0 {
+				return nil, fmt.Errorf("%w: chat id must precede the messages", ErrInvalidExport)
+			}
+			if err = reader.expectDelim('['); err != nil {
+				return nil, err
+			}
+			return header, nil
+		default:
+			var skipped json.RawMessage
+			err = reader.decoder.Decode(&skipped)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidExport, err)
+		}
+	}
+	return nil, fmt.Errorf("%w: no messages", ErrInvalidExport)
+}
+
+// Next returns the next message or io.EOF after the last one.
+func (reader *exportReader) Next() (*exportMessage, error) {
+	if !reader.decoder.More() {
+		return nil, io.EOF
+	}
+	var message exportMessage
+	if err := reader.decoder.Decode(&message); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidExport, err)
+	}
+	return &message, nil
+}
+
+func (reader *exportReader) expectDelim(delim json.Delim) error {
+	token, err := reader.decoder.Token()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidExport, err)
+	}
+	if token != delim {
+		return fmt.Errorf("%w: expected %q", ErrInvalidExport, delim)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package importer //nolint:testpackage // the export format is unexported
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+)
+
+const sampleExport = `{
+ "name": "Trinity discussion",
+ "type": "private_supergroup",
+ "id": 1234567890,
+ "messages": [
+  {
+   "id": 1,
+   "type": "service",
+   "date": "2024-01-15T10:00:00",
+   "actor_id": "user42",
+   "action": "create_group",
+   "text": ""
+  },
+  {
+   "id": 2,
+   "type": "message",
+   "date": "2024-01-15T10:30:00",
+   "date_unixtime": "1705314600",
+   "from": "Alice",
+   "from_id": "user42",
+   "text": ["Hello ", {"type": "bold", "text": "world"}, "!"],
+   "text_entities": [
+    {"type": "plain", "text": "Hello "},
+    {"type": "bold", "text": "world"},
+    {"type": "plain", "text": "!"}
+   ],
+   "photo": "photos/photo_1@15-01-2024_10-30-00.jpg"
+  },
+  {
+   "id": 3,
+   "type": "message",
+   "date": "2024-01-15T10:31:00",
+   "from": "Channel",
+   "from_id": "channel777",
+   "reply_to_message_id": 2,
+   "forwarded_from": "News",
+   "forwarded_from_id": "channel555",
+   "text": "plain",
+   "file": "files/report.pdf",
+   "file_name": "Report.pdf"
+  }
+ ]
+}`
+
+func TestExportReader(t *testing.T) {
+	reader := newExportReader(strings.NewReader(sampleExport))
+	header, err := reader.ReadHeader()
+	if err != nil {
+		t.Fatalf("failed to read header: %v", err)
+	}
+	if header.Name != "Trinity discussion" || header.ChatType() != domain.TelegramChatTypeSupergroup {
+		t.Errorf("unexpected header %+v", header)
+	}
+	if header.ChatTelegramID() != -1001234567890 {
+		t.Errorf("expected Bot API chat id -1001234567890, got %d", header.ChatTelegramID())
+	}
+
+	var messages []*exportMessage
+	for {
+		message, nextErr := reader.Next()
+		if errors.Is(nextErr, io.EOF) {
+			break
+		}
+		if nextErr != nil {
+			t.Fatalf("failed to read message: %v", nextErr)
+		}
+		messages = append(messages, message)
+	}
+	if len(messages) != 3 {
+		t.Fatalf("expected 3 messages, got %d", len(messages))
+	}
+
+	message := messages[1]
+	if message.Text != "Hello world!" {
+		t.Errorf("expected flattened text, got %q", message.Text)
+	}
+	if senderID, isUser := message.SenderTelegramID(); !isUser || senderID != 42 {
+		t.Errorf("expected sender 42, got %d %v", senderID, isUser)
+	}
+	postedAt, err := message.PostedAt()
+	if err != nil || !postedAt.Equal(time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)) {
+		t.Errorf("unexpected posted at %v: %v", postedAt, err)
+	}
+	if files := message.Files(); len(files) != 1 || files[0].FileName != "photo_1@15-01-2024_10-30-00.jpg" {
+		t.Errorf("unexpected files %+v", files)
+	}
+	if !message.HasMedia() {
+		t.Error("expected the text of a photo to be its caption")
+	}
+	entities := message.Entities()
+	if len(entities) != 1 || entities[0].Type != domain.TelegramMessageEntityTypeBold ||
+		entities[0].Offset != 6 || entities[0].Length != 5 {
+		t.Errorf("expected a bold entity over world, got %+v", entities)
+	}
+	if message.ReplyToMessageTelegramID() != nil || message.ForwardOrigin() != nil {
+		t.Error("expected neither a reply nor a forward")
+	}
+
+	channelPost := messages[2]
+	if _, isUser := channelPost.SenderTelegramID(); isUser {
+		t.Error("channel posts must not be attributed to a user")
+	}
+	if files := channelPost.Files(); len(files) != 1 || files[0].FileName != "Report.pdf" {
+		t.Errorf("unexpected files %+v", files)
+	}
+	if replyTo := channelPost.ReplyToMessageTelegramID(); replyTo == nil || *replyTo != 2 {
+		t.Errorf("expected a reply to message 2, got %v", replyTo)
+	}
+	origin := channelPost.ForwardOrigin()
+	if origin == nil || origin.FromChatTelegramID == nil || *origin.FromChatTelegramID != -1000000000555 {
+		t.Errorf("expected a forward from chat -1000000000555, got %+v", origin)
+	}
+}
+
+func TestExportReaderRejectsInvalidExports(t *testing.T) {
+	tests := []struct {
+		name   string
+		export string
+	}{
+		{name: "Not an object", export: `[]`},
+		{name: "No messages", export: `{"name": "x", "id": 1}`},
+		{name: "Messages before id", export: `{"messages": [], "id": 1}`},
+		{name: "Truncated", export: `{"id": 1, "mess`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newExportReader(strings.NewReader(tt.export)).ReadHeader()
+			if !errors.Is(err, ErrInvalidExport) {
+				t.Errorf("expected ErrInvalidExport, got %v", err)
+			}
+		})
+	}
+}
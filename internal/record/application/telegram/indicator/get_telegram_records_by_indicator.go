@@ -0,0 +1,127 @@
+package indicator
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+)
+
+// MaxTelegramRecordsByIndicator is the amount of the latest records mentioning an indicator returned at once.
+const MaxTelegramRecordsByIndicator = 100
+
+var ErrInvalidIndicator = errors.New("value is not a valid indicator of the type")
+
+// GetTelegramRecordsByIndicatorRequest holds the indicator as it's written,
+// it's normalised the same way the extracted indicators are.
+type GetTelegramRecordsByIndicatorRequest struct {
+	Type  domain.TelegramIndicatorType
+	Value string
+	// Tag only keeps the records holding it when it's set.
+	Tag string
+}
+
+type GetTelegramRecordsByIndicatorResponse struct {
+	Records []domain.TelegramRecord
+}
+
+// GetTelegramRecordsByIndicator finds the records mentioning an indicator.
+type GetTelegramRecordsByIndicator struct {
+	transactionManagerFactory       interfaces.TransactionManagerFactory
+	telegramRecordRepositoryFactory repository.TelegramRecordRepositoryFactory
+	indicatorExtractor              *service.TelegramIndicatorExtractor
+	auditClient                     auditclient.AuditClient
+	logger                          *slog.Logger
+}
+
+func NewGetTelegramRecordsByIndicator(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramRecordRepositoryFactory repository.TelegramRecordRepositoryFactory,
+	indicatorExtractor *service.TelegramIndicatorExtractor,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *GetTelegramRecordsByIndicator {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "get_telegram_records_by_indicator"),
+	)
+	return &GetTelegramRecordsByIndicator{
+		transactionManagerFactory:       transactionManagerFactory,
+		telegramRecordRepositoryFactory: telegramRecordRepositoryFactory,
+		indicatorExtractor:              indicatorExtractor,
+		auditClient:                     auditClient,
+		logger:                          iLogger,
+	}
+}
+
+func (interactor *GetTelegramRecordsByIndicator) Execute(
+	ctx context.Context,
+	input GetTelegramRecordsByIndicatorRequest,
+) (*GetTelegramRecordsByIndicatorResponse, error) {
+	interactor.logger.DebugContext(
+		ctx,
+		"Started GetTelegramRecordsByIndicator execution",
+		slog.String("indicator_type", string(input.Type)),
+	)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	value, valid := interactor.indicatorExtractor.Normalize(input.Type, input.Value)
+	if !valid {
+		return nil, ErrInvalidIndicator
+	}
+	tag, err := application.NormalizeTelegramTagFilter(input.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer func() {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+	}()
+
+	recordRepository := interactor.telegramRecordRepositoryFactory.CreateTelegramRecordRepositoryWithTransaction(
+		transactionManager,
+	)
+	records, err := recordRepository.GetTelegramRecordsByIndicator(
+		ctx,
+		input.Type,
+		value,
+		tag,
+		MaxTelegramRecordsByIndicator,
+	)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get telegram records by indicator", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	// The records are only shown once the viewing has been logged
+	err = interactor.auditClient.RecordDetached(ctx, application.NewTelegramRecordsViewedAuditEntries(*records...)...)
+	if err != nil {
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetTelegramRecordsByIndicator execution")
+	return &GetTelegramRecordsByIndicatorResponse{Records: *records}, nil
+}
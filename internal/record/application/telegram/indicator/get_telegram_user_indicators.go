@@ -0,0 +1,87 @@
+package indicator
+
+import (
+	"context"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+)
+
+type GetTelegramUserIndicatorsRequest struct {
+	UserTelegramID uint64
+}
+
+type GetTelegramUserIndicatorsResponse struct {
+	Indicators []domain.TelegramIndicatorSummary
+}
+
+// GetTelegramUserIndicators lists the indicators mentioned in the records sent by a user.
+type GetTelegramUserIndicators struct {
+	transactionManagerFactory          interfaces.TransactionManagerFactory
+	telegramIndicatorRepositoryFactory repository.TelegramIndicatorRepositoryFactory
+	logger                             *slog.Logger
+}
+
+func NewGetTelegramUserIndicators(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramIndicatorRepositoryFactory repository.TelegramIndicatorRepositoryFactory,
+	logger *slog.Logger,
+) *GetTelegramUserIndicators {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "get_telegram_user_indicators"),
+	)
+	return &GetTelegramUserIndicators{
+		transactionManagerFactory:          transactionManagerFactory,
+		telegramIndicatorRepositoryFactory: telegramIndicatorRepositoryFactory,
+		logger:                             iLogger,
+	}
+}
+
+func (interactor *GetTelegramUserIndicators) Execute(
+	ctx context.Context,
+	input GetTelegramUserIndicatorsRequest,
+) (*GetTelegramUserIndicatorsResponse, error) {
+	interactor.logger.DebugContext(
+		ctx,
+		"Started GetTelegramUserIndicators execution",
+		slog.Uint64("user_telegram_id", input.UserTelegramID),
+	)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer func() {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+	}()
+
+	indicatorRepository := interactor.telegramIndicatorRepositoryFactory.CreateTelegramIndicatorRepositoryWithTransaction(
+		transactionManager,
+	)
+	indicators, err := indicatorRepository.GetTelegramIndicatorsByUserTelegramID(ctx, input.UserTelegramID)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get telegram indicators", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetTelegramUserIndicators execution")
+	return &GetTelegramUserIndicatorsResponse{Indicators: *indicators}, nil
+}
@@ -0,0 +1,133 @@
+package indicator
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+)
+
+// StreamTelegramRecordsByIndicatorRequest passes the records to Yield as they're read,
+// an error returned by Yield stops the stream and is returned as it is.
+type StreamTelegramRecordsByIndicatorRequest struct {
+	Type  domain.TelegramIndicatorType
+	Value string
+	// Tag only keeps the records holding it when it's set.
+	Tag   string
+	Yield func(*domain.TelegramRecord) error
+}
+
+type StreamTelegramRecordsByIndicatorResponse struct {
+	Streamed int64
+}
+
+// StreamTelegramRecordsByIndicator reads every record mentioning an indicator, unlike
+// GetTelegramRecordsByIndicator which is limited to the latest ones.
+type StreamTelegramRecordsByIndicator struct {
+	transactionManagerFactory       interfaces.TransactionManagerFactory
+	telegramRecordRepositoryFactory repository.TelegramRecordRepositoryFactory
+	indicatorExtractor              *service.TelegramIndicatorExtractor
+	auditClient                     auditclient.AuditClient
+	logger                          *slog.Logger
+}
+
+func NewStreamTelegramRecordsByIndicator(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramRecordRepositoryFactory repository.TelegramRecordRepositoryFactory,
+	indicatorExtractor *service.TelegramIndicatorExtractor,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *StreamTelegramRecordsByIndicator {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "stream_telegram_records_by_indicator"),
+	)
+	return &StreamTelegramRecordsByIndicator{
+		transactionManagerFactory:       transactionManagerFactory,
+		telegramRecordRepositoryFactory: telegramRecordRepositoryFactory,
+		indicatorExtractor:              indicatorExtractor,
+		auditClient:                     auditClient,
+		logger:                          iLogger,
+	}
+}
+
+func (interactor *StreamTelegramRecordsByIndicator) Execute(
+	ctx context.Context,
+	input StreamTelegramRecordsByIndicatorRequest,
+) (*StreamTelegramRecordsByIndicatorResponse, error) {
+	interactor.logger.DebugContext(
+		ctx,
+		"Started StreamTelegramRecordsByIndicator execution",
+		slog.String("indicator_type", string(input.Type)),
+	)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	value, valid := interactor.indicatorExtractor.Normalize(input.Type, input.Value)
+	if !valid {
+		return nil, ErrInvalidIndicator
+	}
+	tag, err := application.NormalizeTelegramTagFilter(input.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer func() {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+	}()
+
+	recordRepository := interactor.telegramRecordRepositoryFactory.CreateTelegramRecordRepositoryWithTransaction(
+		transactionManager,
+	)
+	// The records are only shown once their viewing has been logged
+	viewLog := application.NewTelegramRecordViewLog(interactor.auditClient, input.Yield)
+	var streamed int64
+	err = recordRepository.StreamTelegramRecordsByIndicator(
+		ctx,
+		input.Type,
+		value,
+		tag,
+		func(record *domain.TelegramRecord) error {
+			streamed++
+			return viewLog.Yield(ctx, record)
+		},
+	)
+	if err == nil {
+		err = viewLog.Flush(ctx)
+	}
+	if err != nil {
+		if errors.Is(err, repository.ErrDatabaseFailed) {
+			return nil, application.ErrDatabaseFailed
+		}
+		return nil, err
+	}
+
+	interactor.logger.DebugContext(
+		ctx,
+		"Finished StreamTelegramRecordsByIndicator execution",
+		slog.Int64("streamed", streamed),
+	)
+	return &StreamTelegramRecordsByIndicatorResponse{Streamed: streamed}, nil
+}
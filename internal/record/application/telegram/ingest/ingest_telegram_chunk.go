@@ -0,0 +1,439 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+var ErrEmptyItem = errors.New("item contains neither a user, an identity nor a record")
+
+type IngestUserInput struct {
+	TelegramID uint64
+}
+
+// IngestIdentityInput references its user by the Telegram ID,
+// so that a stream may add a user and its identities without waiting for the acknowledgement.
+type IngestIdentityInput struct {
+	UserTelegramID uint64
+	Username       string
+	FirstName      string
+	LastName       string
+	Bio            string
+	PhoneNumber    string
+}
+
+// IngestRecordInput references its author by the Telegram ID, like IngestIdentityInput.
+type IngestRecordInput struct {
+	MessageTelegramID        uint64
+	FromUserTelegramID       uint64
+	InTelegramChatID         int64
+	MessageText              string
+	Entities                 []domain.TelegramMessageEntity
+	Caption                  string
+	CaptionEntities          []domain.TelegramMessageEntity
+	PostedAt                 time.Time
+	EditedAt                 *time.Time
+	ReplyToMessageTelegramID *uint64
+	ThreadTelegramID         *uint64
+	ForwardOrigin            *domain.TelegramForwardOrigin
+}
+
+// IngestItem is a single entity of a stream, exactly one of the fields is set.
+type IngestItem struct {
+	User     *IngestUserInput
+	Identity *IngestIdentityInput
+	Record   *IngestRecordInput
+}
+
+type IngestTelegramChunkRequest struct {
+	Items []IngestItem
+}
+
+// IngestResult is the outcome of a single item, either the ID it has been added with or the Err it has been skipped with.
+type IngestResult struct {
+	ID  uuid.UUID
+	Err error
+}
+
+type IngestTelegramChunkResponse struct {
+	Results []IngestResult
+}
+
+// IngestTelegramChunk adds a chunk of mixed users, identities and records in one transaction.
+// Items are processed in order, so an item may reference a user added earlier in the same chunk.
+// Invalid or conflicting items are reported per item and don't fail the rest of the chunk.
+type IngestTelegramChunk struct {
+	transactionManagerFactory interfaces.TransactionManagerFactory
+	telegramDomainValidator   *service.TelegramModelValidator
+	telegramUserFactory       repository.TelegramUserRepositoryFactory
+	telegramIdentityFactory   repository.TelegramIdentityRepositoryFactory
+	telegramRecordFactory     repository.TelegramRecordRepositoryFactory
+	recordAnalyzer            *application.TelegramRecordAnalyzer
+	chainAppender             *application.TelegramChainAppender
+	watchlistEvaluator        *application.TelegramWatchlistEvaluator
+	eventPublisher            *application.TelegramEventPublisher
+	provenanceRecorder        *application.TelegramProvenanceRecorder
+	auditClient               auditclient.AuditClient
+	logger                    *slog.Logger
+}
+
+func NewIngestTelegramChunk(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramDomainValidator *service.TelegramModelValidator,
+	telegramUserFactory repository.TelegramUserRepositoryFactory,
+	telegramIdentityFactory repository.TelegramIdentityRepositoryFactory,
+	telegramRecordFactory repository.TelegramRecordRepositoryFactory,
+	recordAnalyzer *application.TelegramRecordAnalyzer,
+	chainAppender *application.TelegramChainAppender,
+	watchlistEvaluator *application.TelegramWatchlistEvaluator,
+	eventPublisher *application.TelegramEventPublisher,
+	provenanceRecorder *application.TelegramProvenanceRecorder,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *IngestTelegramChunk {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "ingest_telegram_chunk"),
+	)
+	return &IngestTelegramChunk{
+		transactionManagerFactory: transactionManagerFactory,
+		telegramDomainValidator:   telegramDomainValidator,
+		telegramUserFactory:       telegramUserFactory,
+		telegramIdentityFactory:   telegramIdentityFactory,
+		telegramRecordFactory:     telegramRecordFactory,
+		recordAnalyzer:            recordAnalyzer,
+		chainAppender:             chainAppender,
+		watchlistEvaluator:        watchlistEvaluator,
+		eventPublisher:            eventPublisher,
+		provenanceRecorder:        provenanceRecorder,
+		auditClient:               auditClient,
+		logger:                    iLogger,
+	}
+}
+
+// chunkSession holds the repositories of a chunk transaction
+// and the internal IDs of the users it has already resolved.
+type chunkSession struct {
+	transactionManager interfaces.TransactionManager
+	userRepository     repository.TelegramUserRepository
+	identityRepository repository.TelegramIdentityRepository
+	recordRepository   repository.TelegramRecordRepository
+	addedByUser        uuid.UUID
+	now                time.Time
+	userIDs            map[uint64]uuid.UUID
+}
+
+func (interactor *IngestTelegramChunk) Execute(
+	ctx context.Context,
+	input IngestTelegramChunkRequest,
+) (*IngestTelegramChunkResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	interactor.logger.DebugContext(
+		ctx,
+		"Started IngestTelegramChunk execution",
+		slog.Int("item_count", len(input.Items)),
+	)
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	session := &chunkSession{
+		transactionManager: transactionManager,
+		userRepository:     interactor.telegramUserFactory.CreateTelegramUserRepositoryWithTransaction(transactionManager),
+		identityRepository: interactor.telegramIdentityFactory.CreateTelegramIdentityRepositoryWithTransaction(
+			transactionManager,
+		),
+		recordRepository: interactor.telegramRecordFactory.CreateTelegramRecordRepositoryWithTransaction(
+			transactionManager,
+		),
+		addedByUser: idp.UserID,
+		now:         time.Now(),
+		userIDs:     make(map[uint64]uuid.UUID),
+	}
+
+	results := make([]IngestResult, len(input.Items))
+	for i, item := range input.Items {
+		var id uuid.UUID
+		switch {
+		case item.User != nil:
+			id, err = interactor.addUser(ctx, session, item.User)
+		case item.Identity != nil:
+			id, err = interactor.addIdentity(ctx, session, item.Identity)
+		case item.Record != nil:
+			id, err = interactor.addRecord(ctx, session, item.Record)
+		default:
+			err = ErrEmptyItem
+		}
+		if errors.Is(err, application.ErrDatabaseFailed) {
+			interactor.rollback(ctx, transactionManager)
+			return nil, err
+		}
+		results[i] = IngestResult{ID: id, Err: err}
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished IngestTelegramChunk execution")
+	return &IngestTelegramChunkResponse{Results: results}, nil
+}
+
+func (interactor *IngestTelegramChunk) addUser(
+	ctx context.Context,
+	session *chunkSession,
+	input *IngestUserInput,
+) (uuid.UUID, error) {
+	telegramUser := &domain.TelegramUser{
+		ID:          uuid.New(),
+		TelegramID:  input.TelegramID,
+		AddedAt:     session.now,
+		AddedByUser: session.addedByUser,
+	}
+	// Validate the rules before adding to the database
+	if err := interactor.telegramDomainValidator.Validate(telegramUser); err != nil {
+		return uuid.Nil, err
+	}
+	canonicalUser, err := interactor.provenanceRecorder.AddUser(
+		ctx,
+		session.transactionManager,
+		session.userRepository,
+		telegramUser,
+	)
+	if err != nil {
+		return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+	}
+	session.userIDs[canonicalUser.TelegramID] = canonicalUser.ID
+	if canonicalUser.ID != telegramUser.ID {
+		// Another collector has added the user before, the caller only observes it
+		return canonicalUser.ID, nil
+	}
+	entry := application.NewTelegramAuditEntry(
+		auditclient.TelegramUserAdded,
+		application.AuditTargetTelegramUser,
+		telegramUser.ID,
+		nil,
+		telegramUser,
+	)
+	if err = interactor.auditClient.Record(ctx, session.transactionManager, entry); err != nil {
+		return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+	}
+	return telegramUser.ID, nil
+}
+
+func (interactor *IngestTelegramChunk) addIdentity(
+	ctx context.Context,
+	session *chunkSession,
+	input *IngestIdentityInput,
+) (uuid.UUID, error) {
+	userID, err := interactor.resolveUser(ctx, session, input.UserTelegramID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	telegramIdentity := &domain.TelegramIdentity{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Username:    input.Username,
+		FirstName:   input.FirstName,
+		LastName:    input.LastName,
+		Bio:         input.Bio,
+		PhoneNumber: input.PhoneNumber,
+		AddedAt:     session.now,
+		AddedByUser: session.addedByUser,
+	}
+	// Validate the rules before adding to the database
+	if err = interactor.telegramDomainValidator.Validate(telegramIdentity); err != nil {
+		return uuid.Nil, err
+	}
+	err = session.transactionManager.InSavepoint(ctx, func() error {
+		return session.identityRepository.AddIdentity(ctx, telegramIdentity)
+	})
+	if err != nil {
+		return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+	}
+	err = interactor.watchlistEvaluator.EvaluateIdentities(ctx, session.transactionManager, telegramIdentity.ID)
+	if err != nil {
+		return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+	}
+	err = interactor.chainAppender.AppendIdentities(ctx, session.transactionManager, telegramIdentity.ID)
+	if err != nil {
+		return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+	}
+	err = interactor.eventPublisher.PublishIdentityCreated(ctx, session.transactionManager, telegramIdentity)
+	if err != nil {
+		return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+	}
+	entry := application.NewTelegramAuditEntry(
+		auditclient.TelegramIdentityAdded,
+		application.AuditTargetTelegramIdentity,
+		telegramIdentity.ID,
+		nil,
+		telegramIdentity,
+	)
+	if err = interactor.auditClient.Record(ctx, session.transactionManager, entry); err != nil {
+		return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+	}
+	return telegramIdentity.ID, nil
+}
+
+func (interactor *IngestTelegramChunk) addRecord(
+	ctx context.Context,
+	session *chunkSession,
+	input *IngestRecordInput,
+) (uuid.UUID, error) {
+	userID, err := interactor.resolveUser(ctx, session, input.FromUserTelegramID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	telegramRecord := domain.TelegramRecord{
+		ID:                       uuid.New(),
+		MessageTelegramID:        input.MessageTelegramID,
+		FromTelegramUserID:       userID,
+		InTelegramChatID:         input.InTelegramChatID,
+		MessageText:              input.MessageText,
+		Entities:                 input.Entities,
+		Caption:                  input.Caption,
+		CaptionEntities:          input.CaptionEntities,
+		PostedAt:                 input.PostedAt,
+		EditedAt:                 input.EditedAt,
+		ReplyToMessageTelegramID: input.ReplyToMessageTelegramID,
+		ThreadTelegramID:         input.ThreadTelegramID,
+		ForwardOrigin:            input.ForwardOrigin,
+		AddedAt:                  session.now,
+		AddedByUser:              session.addedByUser,
+	}
+	// Validate the rules before adding to the database
+	if err = interactor.telegramDomainValidator.Validate(&telegramRecord); err != nil {
+		return uuid.Nil, err
+	}
+	recordErrors, err := session.recordRepository.CreateTelegramRecords(ctx, []domain.TelegramRecord{telegramRecord})
+	if err != nil {
+		return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+	}
+	auditEntries := application.NewTelegramRecordsAddedAuditEntries(telegramRecord)
+	if errors.Is(recordErrors[0], domain.ErrRecordAlreadyExists) {
+		// A stored message with a different text is a new version of it
+		stored, reviseErr := application.ReviseTelegramRecord(ctx, session.recordRepository, telegramRecord)
+		if errors.Is(reviseErr, domain.ErrRecordAlreadyExists) && stored != nil {
+			return interactor.observeRecord(ctx, session, stored.ID)
+		}
+		if reviseErr != nil {
+			return uuid.Nil, interactor.mapRepositoryError(ctx, reviseErr)
+		}
+		auditEntries = []auditclient.Entry{application.NewTelegramRecordRevisedAuditEntry(stored, telegramRecord)}
+		telegramRecord.ID = stored.ID
+	} else if recordErrors[0] != nil {
+		return uuid.Nil, recordErrors[0]
+	}
+	_, err = interactor.provenanceRecorder.ObserveRecords(
+		ctx,
+		session.transactionManager,
+		session.addedByUser,
+		telegramRecord.ID,
+	)
+	if err != nil {
+		return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+	}
+	if err = interactor.recordAnalyzer.Analyze(ctx, session.transactionManager, telegramRecord); err != nil {
+		return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+	}
+	if err = interactor.chainAppender.AppendRecords(ctx, session.transactionManager, telegramRecord.ID); err != nil {
+		return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+	}
+	if recordErrors[0] == nil {
+		err = interactor.eventPublisher.PublishRecordsCreated(ctx, session.transactionManager, telegramRecord)
+		if err != nil {
+			return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+		}
+	}
+	if err = interactor.auditClient.Record(ctx, session.transactionManager, auditEntries...); err != nil {
+		return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+	}
+	return telegramRecord.ID, nil
+}
+
+// observeRecord makes the caller observe an unchanged record stored before,
+// domain.ErrRecordAlreadyExists is returned when it already has observed it.
+func (interactor *IngestTelegramChunk) observeRecord(
+	ctx context.Context,
+	session *chunkSession,
+	recordID uuid.UUID,
+) (uuid.UUID, error) {
+	observed, err := interactor.provenanceRecorder.ObserveRecords(
+		ctx,
+		session.transactionManager,
+		session.addedByUser,
+		recordID,
+	)
+	if err != nil {
+		return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+	}
+	if !observed[recordID] {
+		return uuid.Nil, interactor.mapRepositoryError(ctx, domain.ErrRecordAlreadyExists)
+	}
+	return recordID, nil
+}
+
+// resolveUser returns the internal ID of the canonical user, whichever collector has added it.
+func (interactor *IngestTelegramChunk) resolveUser(
+	ctx context.Context,
+	session *chunkSession,
+	telegramID uint64,
+) (uuid.UUID, error) {
+	if userID, ok := session.userIDs[telegramID]; ok {
+		return userID, nil
+	}
+	telegramUser, err := session.userRepository.GetByTelegramID(ctx, telegramID)
+	switch {
+	case errors.Is(err, domain.ErrUserNotFound):
+		return uuid.Nil, domain.ErrUnexistentTelegramUserReferenced
+	case err != nil:
+		return uuid.Nil, interactor.mapRepositoryError(ctx, err)
+	}
+	session.userIDs[telegramID] = telegramUser.ID
+	return telegramUser.ID, nil
+}
+
+func (interactor *IngestTelegramChunk) mapRepositoryError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrUserAlreadyExists),
+		errors.Is(err, domain.ErrIdentityAlreadyExists),
+		errors.Is(err, domain.ErrRecordAlreadyExists),
+		errors.Is(err, domain.ErrUnexistentTelegramUserReferenced):
+		interactor.logger.DebugContext(ctx, "telegram item has been skipped", slog.Any("err", err))
+		return err
+	default:
+		interactor.logger.ErrorContext(ctx, "failed to ingest telegram item", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+}
+
+func (interactor *IngestTelegramChunk) rollback(ctx context.Context, transactionManager interfaces.TransactionManager) {
+	if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+		interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+	}
+}
@@ -0,0 +1,108 @@
+package interaction
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+)
+
+const (
+	// MaxTelegramInteractionGraphDepth bounds the walk, the neighbourhood grows exponentially with the depth.
+	MaxTelegramInteractionGraphDepth = 3
+	// MaxTelegramInteractionGraphNodes is the amount of users a graph holds at most, the closest ones are kept.
+	MaxTelegramInteractionGraphNodes = 500
+)
+
+var ErrInvalidGraphDepth = errors.New("graph depth must be between 1 and 3")
+
+type GetTelegramInteractionGraphRequest struct {
+	UserTelegramID uint64
+	Depth          int
+}
+
+type GetTelegramInteractionGraphResponse struct {
+	Graph domain.TelegramInteractionGraph
+}
+
+// GetTelegramInteractionGraph builds the graph of the users a user has interacted with,
+// directly or through up to Depth - 1 other users.
+type GetTelegramInteractionGraph struct {
+	transactionManagerFactory            interfaces.TransactionManagerFactory
+	telegramInteractionRepositoryFactory repository.TelegramInteractionRepositoryFactory
+	logger                               *slog.Logger
+}
+
+func NewGetTelegramInteractionGraph(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramInteractionRepositoryFactory repository.TelegramInteractionRepositoryFactory,
+	logger *slog.Logger,
+) *GetTelegramInteractionGraph {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "get_telegram_interaction_graph"),
+	)
+	return &GetTelegramInteractionGraph{
+		transactionManagerFactory:            transactionManagerFactory,
+		telegramInteractionRepositoryFactory: telegramInteractionRepositoryFactory,
+		logger:                               iLogger,
+	}
+}
+
+func (interactor *GetTelegramInteractionGraph) Execute(
+	ctx context.Context,
+	input GetTelegramInteractionGraphRequest,
+) (*GetTelegramInteractionGraphResponse, error) {
+	interactor.logger.DebugContext(
+		ctx,
+		"Started GetTelegramInteractionGraph execution",
+		slog.Uint64("user_telegram_id", input.UserTelegramID),
+		slog.Int("depth", input.Depth),
+	)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if input.Depth < 1 || input.Depth > MaxTelegramInteractionGraphDepth {
+		return nil, ErrInvalidGraphDepth
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer func() {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+	}()
+
+	interactionRepository := interactor.telegramInteractionRepositoryFactory.
+		CreateTelegramInteractionRepositoryWithTransaction(transactionManager)
+	graph, err := interactionRepository.GetTelegramInteractionGraph(
+		ctx,
+		input.UserTelegramID,
+		input.Depth,
+		MaxTelegramInteractionGraphNodes,
+	)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get telegram interaction graph", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetTelegramInteractionGraph execution")
+	return &GetTelegramInteractionGraphResponse{Graph: *graph}, nil
+}
@@ -0,0 +1,208 @@
+package investigation
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type AddTelegramInvestigationRequest struct {
+	Name        string
+	Description string
+}
+
+type AddTelegramInvestigationResponse struct {
+	ID uuid.UUID
+}
+
+// AddTelegramInvestigation opens an investigation, the current user becomes its owner.
+type AddTelegramInvestigation struct {
+	transactionManagerFactory              interfaces.TransactionManagerFactory
+	telegramDomainValidator                *service.TelegramModelValidator
+	telegramInvestigationRepositoryFactory repository.TelegramInvestigationRepositoryFactory
+	auditClient                            auditclient.AuditClient
+	logger                                 *slog.Logger
+}
+
+func NewAddTelegramInvestigation(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramDomainValidator *service.TelegramModelValidator,
+	telegramInvestigationRepositoryFactory repository.TelegramInvestigationRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *AddTelegramInvestigation {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "add_telegram_investigation"),
+	)
+	return &AddTelegramInvestigation{
+		transactionManagerFactory:              transactionManagerFactory,
+		telegramDomainValidator:                telegramDomainValidator,
+		telegramInvestigationRepositoryFactory: telegramInvestigationRepositoryFactory,
+		auditClient:                            auditClient,
+		logger:                                 iLogger,
+	}
+}
+
+func (interactor *AddTelegramInvestigation) Execute(
+	ctx context.Context,
+	input AddTelegramInvestigationRequest,
+) (*AddTelegramInvestigationResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	now := time.Now()
+	investigation := &domain.TelegramInvestigation{
+		ID:          uuid.New(),
+		Name:        input.Name,
+		Description: input.Description,
+		CreatedBy:   idp.UserID,
+		CreatedAt:   now,
+	}
+	owner := &domain.TelegramInvestigationMember{
+		ID:              uuid.New(),
+		InvestigationID: investigation.ID,
+		UserID:          idp.UserID,
+		Role:            domain.TelegramInvestigationRoleOwner,
+		AddedBy:         idp.UserID,
+		AddedAt:         now,
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started AddTelegramInvestigation execution",
+		slog.String("investigation_id", investigation.ID.String()),
+	)
+	// Validate the rules before adding to the database
+	if err := interactor.telegramDomainValidator.Validate(investigation); err != nil {
+		return nil, err
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	investigationRepository := interactor.telegramInvestigationRepositoryFactory.
+		CreateTelegramInvestigationRepositoryWithTransaction(transactionManager)
+	if err = investigationRepository.AddTelegramInvestigation(ctx, investigation); err == nil {
+		err = investigationRepository.SaveTelegramInvestigationMember(ctx, owner)
+	}
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to add telegram investigation", slog.Any("err", err))
+		rollback(ctx, interactor.logger, transactionManager)
+		return nil, application.ErrDatabaseFailed
+	}
+	entries := []auditclient.Entry{
+		application.NewTelegramAuditEntry(
+			auditclient.TelegramInvestigationAdded,
+			application.AuditTargetTelegramInvestigation,
+			investigation.ID,
+			nil,
+			investigation,
+		),
+		application.NewTelegramAuditEntry(
+			auditclient.TelegramInvestigationMemberAdded,
+			application.AuditTargetTelegramInvestigationMember,
+			owner.ID,
+			nil,
+			owner,
+		),
+	}
+	if err = interactor.auditClient.Record(ctx, transactionManager, entries...); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record audit entry", slog.Any("err", err))
+		rollback(ctx, interactor.logger, transactionManager)
+		return nil, application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished AddTelegramInvestigation execution")
+	return &AddTelegramInvestigationResponse{ID: investigation.ID}, nil
+}
+
+func rollback(ctx context.Context, logger *slog.Logger, transactionManager interfaces.TransactionManager) {
+	if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+		logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+	}
+}
+
+// getMembership hides the investigations the user isn't a member of as if they didn't exist,
+// the annotations of an investigation are only shown to its members.
+func getMembership(
+	ctx context.Context,
+	investigationRepository repository.TelegramInvestigationRepository,
+	investigationID uuid.UUID,
+	userID uuid.UUID,
+) (*domain.TelegramInvestigationMember, error) {
+	member, err := investigationRepository.GetTelegramInvestigationMember(ctx, investigationID, userID)
+	if errors.Is(err, domain.ErrInvestigationMemberNotFound) {
+		return nil, domain.ErrInvestigationNotFound
+	}
+	return member, err
+}
+
+// authorizeMemberManagement lets the owners of the investigation and the admins manage its members,
+// so that an investigation left without an owner can still be taken over.
+func authorizeMemberManagement(
+	ctx context.Context,
+	investigationRepository repository.TelegramInvestigationRepository,
+	investigationID uuid.UUID,
+	idp *client.UserIdentity,
+) error {
+	if rbac.AuthorizeByRole(idp, userDomain.RoleAdmin) == nil {
+		_, err := investigationRepository.LockTelegramInvestigation(ctx, investigationID)
+		return err
+	}
+	member, err := getMembership(ctx, investigationRepository, investigationID, idp.UserID)
+	if err != nil {
+		return err
+	}
+	if member.Role != domain.TelegramInvestigationRoleOwner {
+		return rbac.ErrInsufficientPrivileges
+	}
+	_, err = investigationRepository.LockTelegramInvestigation(ctx, investigationID)
+	return err
+}
+
+// ensureOwnerRemains refuses to take the owner role from the last owner of the investigation.
+func ensureOwnerRemains(
+	ctx context.Context,
+	investigationRepository repository.TelegramInvestigationRepository,
+	member *domain.TelegramInvestigationMember,
+) error {
+	if member.Role != domain.TelegramInvestigationRoleOwner {
+		return nil
+	}
+	members, err := investigationRepository.GetTelegramInvestigationMembers(ctx, member.InvestigationID)
+	if err != nil {
+		return err
+	}
+	for _, other := range *members {
+		if other.Role == domain.TelegramInvestigationRoleOwner && other.UserID != member.UserID {
+			return nil
+		}
+	}
+	return domain.ErrInvestigationLastOwner
+}
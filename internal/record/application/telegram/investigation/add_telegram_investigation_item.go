@@ -0,0 +1,159 @@
+package investigation
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type AddTelegramInvestigationItemRequest struct {
+	InvestigationID uuid.UUID
+	Type            domain.TelegramInvestigationItemType
+	TargetID        uuid.UUID
+	Note            string
+}
+
+type AddTelegramInvestigationItemResponse struct {
+	ID uuid.UUID
+}
+
+// AddTelegramInvestigationItem attaches a telegram user, chat or record with a note to an investigation,
+// the owners and the editors of the investigation attach items.
+type AddTelegramInvestigationItem struct {
+	transactionManagerFactory              interfaces.TransactionManagerFactory
+	telegramDomainValidator                *service.TelegramModelValidator
+	telegramInvestigationRepositoryFactory repository.TelegramInvestigationRepositoryFactory
+	auditClient                            auditclient.AuditClient
+	logger                                 *slog.Logger
+}
+
+func NewAddTelegramInvestigationItem(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramDomainValidator *service.TelegramModelValidator,
+	telegramInvestigationRepositoryFactory repository.TelegramInvestigationRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *AddTelegramInvestigationItem {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "add_telegram_investigation_item"),
+	)
+	return &AddTelegramInvestigationItem{
+		transactionManagerFactory:              transactionManagerFactory,
+		telegramDomainValidator:                telegramDomainValidator,
+		telegramInvestigationRepositoryFactory: telegramInvestigationRepositoryFactory,
+		auditClient:                            auditClient,
+		logger:                                 iLogger,
+	}
+}
+
+func (interactor *AddTelegramInvestigationItem) Execute(
+	ctx context.Context,
+	input AddTelegramInvestigationItemRequest,
+) (*AddTelegramInvestigationItemResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	item := &domain.TelegramInvestigationItem{
+		ID:              uuid.New(),
+		InvestigationID: input.InvestigationID,
+		Type:            input.Type,
+		TargetID:        input.TargetID,
+		Note:            input.Note,
+		AddedBy:         idp.UserID,
+		AddedAt:         time.Now(),
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started AddTelegramInvestigationItem execution",
+		slog.String("investigation_id", input.InvestigationID.String()),
+		slog.String("item_type", string(input.Type)),
+	)
+	// Validate the rules before adding to the database
+	if err := interactor.telegramDomainValidator.Validate(item); err != nil {
+		return nil, err
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	investigationRepository := interactor.telegramInvestigationRepositoryFactory.
+		CreateTelegramInvestigationRepositoryWithTransaction(transactionManager)
+	if err = authorizeEditing(ctx, investigationRepository, input.InvestigationID, idp.UserID); err == nil {
+		err = investigationRepository.AddTelegramInvestigationItem(ctx, item)
+	}
+	if err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		switch {
+		case errors.Is(err, domain.ErrInvestigationNotFound),
+			errors.Is(err, domain.ErrInvestigationItemAlreadyExists),
+			errors.Is(err, domain.ErrUserNotFound),
+			errors.Is(err, domain.ErrChatNotFound),
+			errors.Is(err, domain.ErrRecordNotFound),
+			errors.Is(err, rbac.ErrInsufficientPrivileges):
+			interactor.logger.DebugContext(ctx, "telegram investigation item has been rejected", slog.Any("err", err))
+			return nil, err
+		default:
+			interactor.logger.ErrorContext(ctx, "failed to add telegram investigation item", slog.Any("err", err))
+			return nil, application.ErrDatabaseFailed
+		}
+	}
+	entry := application.NewTelegramAuditEntry(
+		auditclient.TelegramInvestigationItemAdded,
+		application.AuditTargetTelegramInvestigationItem,
+		item.ID,
+		nil,
+		item,
+	)
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record audit entry", slog.Any("err", err))
+		rollback(ctx, interactor.logger, transactionManager)
+		return nil, application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished AddTelegramInvestigationItem execution")
+	return &AddTelegramInvestigationItemResponse{ID: item.ID}, nil
+}
+
+// authorizeEditing lets the owners and the editors of the investigation change its items.
+func authorizeEditing(
+	ctx context.Context,
+	investigationRepository repository.TelegramInvestigationRepository,
+	investigationID uuid.UUID,
+	userID uuid.UUID,
+) error {
+	member, err := getMembership(ctx, investigationRepository, investigationID, userID)
+	if err != nil {
+		return err
+	}
+	if !member.Role.CanEdit() {
+		return rbac.ErrInsufficientPrivileges
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package investigation
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type GetTelegramInvestigationRequest struct {
+	InvestigationID uuid.UUID
+}
+
+// GetTelegramInvestigationResponse holds the investigation with its members and items,
+// Role is the one of the current user.
+type GetTelegramInvestigationResponse struct {
+	Investigation domain.TelegramInvestigation
+	Role          domain.TelegramInvestigationRole
+}
+
+// GetTelegramInvestigation shows everything in an investigation to one of its members.
+type GetTelegramInvestigation struct {
+	transactionManagerFactory              interfaces.TransactionManagerFactory
+	telegramInvestigationRepositoryFactory repository.TelegramInvestigationRepositoryFactory
+	logger                                 *slog.Logger
+}
+
+func NewGetTelegramInvestigation(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramInvestigationRepositoryFactory repository.TelegramInvestigationRepositoryFactory,
+	logger *slog.Logger,
+) *GetTelegramInvestigation {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "get_telegram_investigation"),
+	)
+	return &GetTelegramInvestigation{
+		transactionManagerFactory:              transactionManagerFactory,
+		telegramInvestigationRepositoryFactory: telegramInvestigationRepositoryFactory,
+		logger:                                 iLogger,
+	}
+}
+
+func (interactor *GetTelegramInvestigation) Execute(
+	ctx context.Context,
+	input GetTelegramInvestigationRequest,
+) (*GetTelegramInvestigationResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started GetTelegramInvestigation execution",
+		slog.String("investigation_id", input.InvestigationID.String()),
+	)
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer rollback(ctx, interactor.logger, transactionManager)
+
+	investigationRepository := interactor.telegramInvestigationRepositoryFactory.
+		CreateTelegramInvestigationRepositoryWithTransaction(transactionManager)
+	investigation, member, err := interactor.getInvestigation(ctx, investigationRepository, input.InvestigationID, idp)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvestigationNotFound) {
+			return nil, err
+		}
+		interactor.logger.ErrorContext(ctx, "failed to get telegram investigation", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetTelegramInvestigation execution")
+	return &GetTelegramInvestigationResponse{Investigation: *investigation, Role: member.Role}, nil
+}
+
+func (interactor *GetTelegramInvestigation) getInvestigation(
+	ctx context.Context,
+	investigationRepository repository.TelegramInvestigationRepository,
+	investigationID uuid.UUID,
+	idp *client.UserIdentity,
+) (*domain.TelegramInvestigation, *domain.TelegramInvestigationMember, error) {
+	member, err := getMembership(ctx, investigationRepository, investigationID, idp.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	investigation, err := investigationRepository.GetTelegramInvestigationByID(ctx, investigationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	members, err := investigationRepository.GetTelegramInvestigationMembers(ctx, investigationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	items, err := investigationRepository.GetTelegramInvestigationItems(ctx, investigationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	investigation.Members = *members
+	investigation.Items = *items
+	return investigation, member, nil
+}
@@ -0,0 +1,71 @@
+package investigation
+
+import (
+	"context"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+)
+
+type GetTelegramInvestigationsResponse struct {
+	Investigations []domain.TelegramInvestigation
+}
+
+// GetTelegramInvestigations lists the investigations the current user is a member of with their members.
+type GetTelegramInvestigations struct {
+	transactionManagerFactory              interfaces.TransactionManagerFactory
+	telegramInvestigationRepositoryFactory repository.TelegramInvestigationRepositoryFactory
+	logger                                 *slog.Logger
+}
+
+func NewGetTelegramInvestigations(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramInvestigationRepositoryFactory repository.TelegramInvestigationRepositoryFactory,
+	logger *slog.Logger,
+) *GetTelegramInvestigations {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "get_telegram_investigations"),
+	)
+	return &GetTelegramInvestigations{
+		transactionManagerFactory:              transactionManagerFactory,
+		telegramInvestigationRepositoryFactory: telegramInvestigationRepositoryFactory,
+		logger:                                 iLogger,
+	}
+}
+
+func (interactor *GetTelegramInvestigations) Execute(ctx context.Context) (*GetTelegramInvestigationsResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+	interactor.logger.DebugContext(ctx, "Started GetTelegramInvestigations execution")
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer rollback(ctx, interactor.logger, transactionManager)
+
+	investigationRepository := interactor.telegramInvestigationRepositoryFactory.
+		CreateTelegramInvestigationRepositoryWithTransaction(transactionManager)
+	investigations, err := investigationRepository.GetTelegramInvestigationsByMember(ctx, idp.UserID)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get telegram investigations", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetTelegramInvestigations execution")
+	return &GetTelegramInvestigationsResponse{Investigations: *investigations}, nil
+}
@@ -0,0 +1,130 @@
+package investigation
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type RemoveTelegramInvestigationRequest struct {
+	InvestigationID uuid.UUID
+}
+
+// RemoveTelegramInvestigation closes an investigation owned by the current user along with its members and
+// items, the telegram data it refers to is kept.
+type RemoveTelegramInvestigation struct {
+	transactionManagerFactory              interfaces.TransactionManagerFactory
+	telegramInvestigationRepositoryFactory repository.TelegramInvestigationRepositoryFactory
+	auditClient                            auditclient.AuditClient
+	logger                                 *slog.Logger
+}
+
+func NewRemoveTelegramInvestigation(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramInvestigationRepositoryFactory repository.TelegramInvestigationRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *RemoveTelegramInvestigation {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "remove_telegram_investigation"),
+	)
+	return &RemoveTelegramInvestigation{
+		transactionManagerFactory:              transactionManagerFactory,
+		telegramInvestigationRepositoryFactory: telegramInvestigationRepositoryFactory,
+		auditClient:                            auditClient,
+		logger:                                 iLogger,
+	}
+}
+
+func (interactor *RemoveTelegramInvestigation) Execute(
+	ctx context.Context,
+	input RemoveTelegramInvestigationRequest,
+) error {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return rbac.ErrInsufficientPrivileges
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started RemoveTelegramInvestigation execution",
+		slog.String("investigation_id", input.InvestigationID.String()),
+	)
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+
+	investigationRepository := interactor.telegramInvestigationRepositoryFactory.
+		CreateTelegramInvestigationRepositoryWithTransaction(transactionManager)
+	investigation, err := interactor.removeInvestigation(ctx, investigationRepository, input.InvestigationID, idp)
+	if err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		switch {
+		case errors.Is(err, domain.ErrInvestigationNotFound), errors.Is(err, rbac.ErrInsufficientPrivileges):
+			return err
+		default:
+			interactor.logger.ErrorContext(ctx, "failed to remove telegram investigation", slog.Any("err", err))
+			return application.ErrDatabaseFailed
+		}
+	}
+	entry := application.NewTelegramAuditEntry(
+		auditclient.TelegramInvestigationRemoved,
+		application.AuditTargetTelegramInvestigation,
+		input.InvestigationID,
+		investigation,
+		nil,
+	)
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record audit entry", slog.Any("err", err))
+		rollback(ctx, interactor.logger, transactionManager)
+		return application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished RemoveTelegramInvestigation execution")
+	return nil
+}
+
+func (interactor *RemoveTelegramInvestigation) removeInvestigation(
+	ctx context.Context,
+	investigationRepository repository.TelegramInvestigationRepository,
+	investigationID uuid.UUID,
+	idp *client.UserIdentity,
+) (*domain.TelegramInvestigation, error) {
+	member, err := getMembership(ctx, investigationRepository, investigationID, idp.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if member.Role != domain.TelegramInvestigationRoleOwner {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+	investigation, err := investigationRepository.GetTelegramInvestigationByID(ctx, investigationID)
+	if err != nil {
+		return nil, err
+	}
+	if err = investigationRepository.RemoveTelegramInvestigation(ctx, investigationID); err != nil {
+		return nil, err
+	}
+	return investigation, nil
+}
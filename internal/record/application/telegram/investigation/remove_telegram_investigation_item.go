@@ -0,0 +1,114 @@
+package investigation
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type RemoveTelegramInvestigationItemRequest struct {
+	InvestigationID uuid.UUID
+	ItemID          uuid.UUID
+}
+
+// RemoveTelegramInvestigationItem detaches an item from an investigation along with its note,
+// the owners and the editors of the investigation detach items.
+type RemoveTelegramInvestigationItem struct {
+	transactionManagerFactory              interfaces.TransactionManagerFactory
+	telegramInvestigationRepositoryFactory repository.TelegramInvestigationRepositoryFactory
+	auditClient                            auditclient.AuditClient
+	logger                                 *slog.Logger
+}
+
+func NewRemoveTelegramInvestigationItem(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramInvestigationRepositoryFactory repository.TelegramInvestigationRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *RemoveTelegramInvestigationItem {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "remove_telegram_investigation_item"),
+	)
+	return &RemoveTelegramInvestigationItem{
+		transactionManagerFactory:              transactionManagerFactory,
+		telegramInvestigationRepositoryFactory: telegramInvestigationRepositoryFactory,
+		auditClient:                            auditClient,
+		logger:                                 iLogger,
+	}
+}
+
+func (interactor *RemoveTelegramInvestigationItem) Execute(
+	ctx context.Context,
+	input RemoveTelegramInvestigationItemRequest,
+) error {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return rbac.ErrInsufficientPrivileges
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started RemoveTelegramInvestigationItem execution",
+		slog.String("investigation_id", input.InvestigationID.String()),
+		slog.String("item_id", input.ItemID.String()),
+	)
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+
+	investigationRepository := interactor.telegramInvestigationRepositoryFactory.
+		CreateTelegramInvestigationRepositoryWithTransaction(transactionManager)
+	var item *domain.TelegramInvestigationItem
+	if err = authorizeEditing(ctx, investigationRepository, input.InvestigationID, idp.UserID); err == nil {
+		item, err = investigationRepository.RemoveTelegramInvestigationItem(ctx, input.InvestigationID, input.ItemID)
+	}
+	if err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		switch {
+		case errors.Is(err, domain.ErrInvestigationNotFound),
+			errors.Is(err, domain.ErrInvestigationItemNotFound),
+			errors.Is(err, rbac.ErrInsufficientPrivileges):
+			return err
+		default:
+			interactor.logger.ErrorContext(ctx, "failed to remove telegram investigation item", slog.Any("err", err))
+			return application.ErrDatabaseFailed
+		}
+	}
+	entry := application.NewTelegramAuditEntry(
+		auditclient.TelegramInvestigationItemRemoved,
+		application.AuditTargetTelegramInvestigationItem,
+		item.ID,
+		item,
+		nil,
+	)
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record audit entry", slog.Any("err", err))
+		rollback(ctx, interactor.logger, transactionManager)
+		return application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished RemoveTelegramInvestigationItem execution")
+	return nil
+}
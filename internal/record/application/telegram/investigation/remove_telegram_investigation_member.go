@@ -0,0 +1,141 @@
+package investigation
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type RemoveTelegramInvestigationMemberRequest struct {
+	InvestigationID uuid.UUID
+	UserID          uuid.UUID
+}
+
+// RemoveTelegramInvestigationMember removes a user from an investigation. The owners and the admins remove
+// any member, every member may leave, but the last owner can't.
+type RemoveTelegramInvestigationMember struct {
+	transactionManagerFactory              interfaces.TransactionManagerFactory
+	telegramInvestigationRepositoryFactory repository.TelegramInvestigationRepositoryFactory
+	auditClient                            auditclient.AuditClient
+	logger                                 *slog.Logger
+}
+
+func NewRemoveTelegramInvestigationMember(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramInvestigationRepositoryFactory repository.TelegramInvestigationRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *RemoveTelegramInvestigationMember {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "remove_telegram_investigation_member"),
+	)
+	return &RemoveTelegramInvestigationMember{
+		transactionManagerFactory:              transactionManagerFactory,
+		telegramInvestigationRepositoryFactory: telegramInvestigationRepositoryFactory,
+		auditClient:                            auditClient,
+		logger:                                 iLogger,
+	}
+}
+
+func (interactor *RemoveTelegramInvestigationMember) Execute(
+	ctx context.Context,
+	input RemoveTelegramInvestigationMemberRequest,
+) error {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return rbac.ErrInsufficientPrivileges
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started RemoveTelegramInvestigationMember execution",
+		slog.String("investigation_id", input.InvestigationID.String()),
+		slog.String("user_id", input.UserID.String()),
+	)
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+
+	investigationRepository := interactor.telegramInvestigationRepositoryFactory.
+		CreateTelegramInvestigationRepositoryWithTransaction(transactionManager)
+	member, err := interactor.removeMember(ctx, investigationRepository, input, idp)
+	if err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		switch {
+		case errors.Is(err, domain.ErrInvestigationNotFound),
+			errors.Is(err, domain.ErrInvestigationMemberNotFound),
+			errors.Is(err, domain.ErrInvestigationLastOwner),
+			errors.Is(err, rbac.ErrInsufficientPrivileges):
+			return err
+		default:
+			interactor.logger.ErrorContext(ctx, "failed to remove telegram investigation member", slog.Any("err", err))
+			return application.ErrDatabaseFailed
+		}
+	}
+	entry := application.NewTelegramAuditEntry(
+		auditclient.TelegramInvestigationMemberRemoved,
+		application.AuditTargetTelegramInvestigationMember,
+		member.ID,
+		member,
+		nil,
+	)
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record audit entry", slog.Any("err", err))
+		rollback(ctx, interactor.logger, transactionManager)
+		return application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished RemoveTelegramInvestigationMember execution")
+	return nil
+}
+
+func (interactor *RemoveTelegramInvestigationMember) removeMember(
+	ctx context.Context,
+	investigationRepository repository.TelegramInvestigationRepository,
+	input RemoveTelegramInvestigationMemberRequest,
+	idp *client.UserIdentity,
+) (*domain.TelegramInvestigationMember, error) {
+	var err error
+	if input.UserID == idp.UserID {
+		// Leaving needs the membership only, it's checked along with the member below
+		_, err = investigationRepository.LockTelegramInvestigation(ctx, input.InvestigationID)
+	} else {
+		err = authorizeMemberManagement(ctx, investigationRepository, input.InvestigationID, idp)
+	}
+	if err != nil {
+		return nil, err
+	}
+	member, err := investigationRepository.GetTelegramInvestigationMember(ctx, input.InvestigationID, input.UserID)
+	if err != nil {
+		if input.UserID == idp.UserID && errors.Is(err, domain.ErrInvestigationMemberNotFound) {
+			return nil, domain.ErrInvestigationNotFound
+		}
+		return nil, err
+	}
+	if err = ensureOwnerRemains(ctx, investigationRepository, member); err != nil {
+		return nil, err
+	}
+	return investigationRepository.RemoveTelegramInvestigationMember(ctx, input.InvestigationID, input.UserID)
+}
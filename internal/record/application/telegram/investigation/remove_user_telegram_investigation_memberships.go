@@ -0,0 +1,58 @@
+package investigation
+
+import (
+	"context"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/google/uuid"
+)
+
+type RemoveUserTelegramInvestigationMembershipsRequest struct {
+	UserID uuid.UUID
+}
+
+// RemoveUserTelegramInvestigationMemberships removes a removed user from the investigations, the ones left
+// without members are removed. It runs in the transaction the user.removed event is published in,
+// the removal has been authorized already.
+type RemoveUserTelegramInvestigationMemberships struct {
+	telegramInvestigationRepositoryFactory repository.TelegramInvestigationRepositoryFactory
+	logger                                 *slog.Logger
+}
+
+func NewRemoveUserTelegramInvestigationMemberships(
+	telegramInvestigationRepositoryFactory repository.TelegramInvestigationRepositoryFactory,
+	logger *slog.Logger,
+) *RemoveUserTelegramInvestigationMemberships {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "remove_user_telegram_investigation_memberships"),
+	)
+	return &RemoveUserTelegramInvestigationMemberships{
+		telegramInvestigationRepositoryFactory: telegramInvestigationRepositoryFactory,
+		logger:                                 iLogger,
+	}
+}
+
+func (interactor *RemoveUserTelegramInvestigationMemberships) Execute(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	input RemoveUserTelegramInvestigationMembershipsRequest,
+) error {
+	investigationRepository := interactor.telegramInvestigationRepositoryFactory.
+		CreateTelegramInvestigationRepositoryWithTransaction(transactionManager)
+	removed, err := investigationRepository.RemoveTelegramInvestigationMembershipsByUser(ctx, input.UserID)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to remove telegram investigation memberships", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Investigation memberships of the removed user have been removed",
+		slog.String("user_id", input.UserID.String()),
+		slog.Int64("memberships", removed),
+	)
+	return nil
+}
@@ -0,0 +1,180 @@
+package investigation
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type SaveTelegramInvestigationMemberRequest struct {
+	InvestigationID uuid.UUID
+	UserID          uuid.UUID
+	Role            domain.TelegramInvestigationRole
+}
+
+// SaveTelegramInvestigationMemberResponse tells whether the user has been added rather than given another role.
+type SaveTelegramInvestigationMemberResponse struct {
+	Member  domain.TelegramInvestigationMember
+	Created bool
+}
+
+// SaveTelegramInvestigationMember adds a platform user to an investigation or changes the role of a member.
+// Only the owners and the admins manage the members, the last owner can't be demoted.
+type SaveTelegramInvestigationMember struct {
+	transactionManagerFactory              interfaces.TransactionManagerFactory
+	telegramDomainValidator                *service.TelegramModelValidator
+	telegramInvestigationRepositoryFactory repository.TelegramInvestigationRepositoryFactory
+	auditClient                            auditclient.AuditClient
+	logger                                 *slog.Logger
+}
+
+func NewSaveTelegramInvestigationMember(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramDomainValidator *service.TelegramModelValidator,
+	telegramInvestigationRepositoryFactory repository.TelegramInvestigationRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *SaveTelegramInvestigationMember {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "save_telegram_investigation_member"),
+	)
+	return &SaveTelegramInvestigationMember{
+		transactionManagerFactory:              transactionManagerFactory,
+		telegramDomainValidator:                telegramDomainValidator,
+		telegramInvestigationRepositoryFactory: telegramInvestigationRepositoryFactory,
+		auditClient:                            auditClient,
+		logger:                                 iLogger,
+	}
+}
+
+func (interactor *SaveTelegramInvestigationMember) Execute(
+	ctx context.Context,
+	input SaveTelegramInvestigationMemberRequest,
+) (*SaveTelegramInvestigationMemberResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	member := &domain.TelegramInvestigationMember{
+		ID:              uuid.New(),
+		InvestigationID: input.InvestigationID,
+		UserID:          input.UserID,
+		Role:            input.Role,
+		AddedBy:         idp.UserID,
+		AddedAt:         time.Now(),
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started SaveTelegramInvestigationMember execution",
+		slog.String("investigation_id", input.InvestigationID.String()),
+		slog.String("user_id", input.UserID.String()),
+	)
+	// Validate the rules before adding to the database
+	if err := interactor.telegramDomainValidator.Validate(member); err != nil {
+		return nil, err
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	investigationRepository := interactor.telegramInvestigationRepositoryFactory.
+		CreateTelegramInvestigationRepositoryWithTransaction(transactionManager)
+	previous, err := interactor.saveMember(ctx, investigationRepository, member, idp)
+	if err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		switch {
+		case errors.Is(err, domain.ErrInvestigationNotFound),
+			errors.Is(err, domain.ErrInvestigationLastOwner),
+			errors.Is(err, rbac.ErrInsufficientPrivileges):
+			interactor.logger.DebugContext(ctx, "telegram investigation member has been rejected", slog.Any("err", err))
+			return nil, err
+		default:
+			interactor.logger.ErrorContext(ctx, "failed to save telegram investigation member", slog.Any("err", err))
+			return nil, application.ErrDatabaseFailed
+		}
+	}
+	entry := application.NewTelegramAuditEntry(
+		auditclient.TelegramInvestigationMemberAdded,
+		application.AuditTargetTelegramInvestigationMember,
+		member.ID,
+		nil,
+		member,
+	)
+	if previous != nil {
+		entry = application.NewTelegramAuditEntry(
+			auditclient.TelegramInvestigationMemberRoleChanged,
+			application.AuditTargetTelegramInvestigationMember,
+			member.ID,
+			previous,
+			member,
+		)
+	}
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record audit entry", slog.Any("err", err))
+		rollback(ctx, interactor.logger, transactionManager)
+		return nil, application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished SaveTelegramInvestigationMember execution")
+	return &SaveTelegramInvestigationMemberResponse{Member: *member, Created: previous == nil}, nil
+}
+
+// saveMember returns the membership the member replaces, nil when the user wasn't a member.
+// A member keeps its ID and the time it has been added at, member is updated to the stored state.
+func (interactor *SaveTelegramInvestigationMember) saveMember(
+	ctx context.Context,
+	investigationRepository repository.TelegramInvestigationRepository,
+	member *domain.TelegramInvestigationMember,
+	idp *client.UserIdentity,
+) (*domain.TelegramInvestigationMember, error) {
+	err := authorizeMemberManagement(ctx, investigationRepository, member.InvestigationID, idp)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := investigationRepository.GetTelegramInvestigationMember(ctx, member.InvestigationID, member.UserID)
+	switch {
+	case errors.Is(err, domain.ErrInvestigationMemberNotFound):
+		previous = nil
+	case err != nil:
+		return nil, err
+	default:
+		if member.Role != domain.TelegramInvestigationRoleOwner {
+			if err = ensureOwnerRemains(ctx, investigationRepository, previous); err != nil {
+				return nil, err
+			}
+		}
+		member.ID = previous.ID
+		member.AddedBy = previous.AddedBy
+		member.AddedAt = previous.AddedAt
+	}
+	if err = investigationRepository.SaveTelegramInvestigationMember(ctx, member); err != nil {
+		return nil, err
+	}
+	return previous, nil
+}
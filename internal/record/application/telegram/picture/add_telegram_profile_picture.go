@@ -0,0 +1,178 @@
+package picture
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/InWamos/trinity-proto/config"
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	blobstorage "github.com/InWamos/trinity-proto/internal/shared/infrastructure/blob_storage"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type AddTelegramProfilePictureRequest struct {
+	UserTelegramID uint64
+	PostedAt       time.Time
+	Content        io.Reader
+}
+
+type AddTelegramProfilePictureResponse struct {
+	ProfilePictureID uuid.UUID
+	SHA256           string
+	MimeType         string
+}
+
+// AddTelegramProfilePicture adds an avatar to the history of a telegram user.
+type AddTelegramProfilePicture struct {
+	transactionManagerFactory     interfaces.TransactionManagerFactory
+	telegramDomainValidator       *service.TelegramModelValidator
+	telegramProfilePictureFactory repository.TelegramProfilePictureRepositoryFactory
+	blobStore                     interfaces.BlobStore
+	maxUploadSize                 int64
+	auditClient                   auditclient.AuditClient
+	logger                        *slog.Logger
+}
+
+func NewAddTelegramProfilePicture(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramDomainValidator *service.TelegramModelValidator,
+	telegramProfilePictureFactory repository.TelegramProfilePictureRepositoryFactory,
+	blobStore interfaces.BlobStore,
+	storageConfig *config.StorageConfig,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *AddTelegramProfilePicture {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "add_telegram_profile_picture"),
+	)
+	return &AddTelegramProfilePicture{
+		transactionManagerFactory:     transactionManagerFactory,
+		telegramDomainValidator:       telegramDomainValidator,
+		telegramProfilePictureFactory: telegramProfilePictureFactory,
+		blobStore:                     blobStore,
+		maxUploadSize:                 storageConfig.MaxUploadSize,
+		auditClient:                   auditClient,
+		logger:                        iLogger,
+	}
+}
+
+func (interactor *AddTelegramProfilePicture) Execute(
+	ctx context.Context,
+	input AddTelegramProfilePictureRequest,
+) (*AddTelegramProfilePictureResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	interactor.logger.DebugContext(
+		ctx,
+		"Started AddTelegramProfilePicture execution",
+		slog.Uint64("user_telegram_id", input.UserTelegramID),
+	)
+
+	blob, err := blobstorage.SpoolBlob(input.Content, interactor.maxUploadSize)
+	if err != nil {
+		switch {
+		case errors.Is(err, blobstorage.ErrBlobTooLarge), errors.Is(err, blobstorage.ErrBlobEmpty):
+			return nil, err
+		case errors.Is(err, blobstorage.ErrBlobReadFailed):
+			interactor.logger.DebugContext(ctx, "failed to read the upload", slog.Any("err", err))
+			return nil, err
+		}
+		interactor.logger.ErrorContext(ctx, "failed to spool the upload", slog.Any("err", err))
+		return nil, application.ErrBlobStorageFailed
+	}
+	defer func() {
+		if closeErr := blob.Close(); closeErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to remove spooled upload", slog.Any("err", closeErr))
+		}
+	}()
+
+	picture := &domain.TelegramProfilePicture{
+		ID:             uuid.New(),
+		UserTelegramID: input.UserTelegramID,
+		StorageKey:     blob.StorageKey(),
+		SHA256:         blob.SHA256,
+		FileSize:       uint64(blob.Size),
+		PostedAt:       input.PostedAt,
+		MimeType:       blob.MimeType,
+		AddedAt:        time.Now(),
+		AddedByUser:    idp.UserID,
+	}
+	// Validate the rules before adding to the database, this also rejects anything but images
+	if err = interactor.telegramDomainValidator.Validate(picture); err != nil {
+		return nil, err
+	}
+
+	if err = blob.Store(ctx, interactor.blobStore); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to store the blob", slog.Any("err", err))
+		return nil, application.ErrBlobStorageFailed
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	pictureRepository := interactor.telegramProfilePictureFactory.CreateTelegramProfilePictureRepositoryWithTransaction(
+		transactionManager,
+	)
+	if err = pictureRepository.AddProfilePicture(ctx, picture); err != nil {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		switch {
+		case errors.Is(err, domain.ErrUnexistentTelegramUserReferenced),
+			errors.Is(err, domain.ErrProfilePictureAlreadyExists):
+			return nil, err
+		default:
+			interactor.logger.ErrorContext(ctx, "failed to add telegram profile picture", slog.Any("err", err))
+			return nil, application.ErrDatabaseFailed
+		}
+	}
+
+	entry := application.NewTelegramAuditEntry(
+		auditclient.TelegramProfilePictureAdded,
+		application.AuditTargetTelegramProfilePicture,
+		picture.ID,
+		nil,
+		picture,
+	)
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record audit entry", slog.Any("err", err))
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		return nil, application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished AddTelegramProfilePicture execution")
+	return &AddTelegramProfilePictureResponse{
+		ProfilePictureID: picture.ID,
+		SHA256:           picture.SHA256,
+		MimeType:         picture.MimeType,
+	}, nil
+}
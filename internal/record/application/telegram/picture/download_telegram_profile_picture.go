@@ -0,0 +1,128 @@
+package picture
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type DownloadTelegramProfilePictureRequest struct {
+	ProfilePictureID uuid.UUID
+}
+
+// DownloadTelegramProfilePictureResponse holds an open stream of the profile picture content.
+// The caller must close Content.
+type DownloadTelegramProfilePictureResponse struct {
+	ProfilePicture domain.TelegramProfilePicture
+	Content        io.ReadCloser
+}
+
+type DownloadTelegramProfilePicture struct {
+	transactionManagerFactory     interfaces.TransactionManagerFactory
+	telegramProfilePictureFactory repository.TelegramProfilePictureRepositoryFactory
+	blobStore                     interfaces.BlobStore
+	logger                        *slog.Logger
+}
+
+func NewDownloadTelegramProfilePicture(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramProfilePictureFactory repository.TelegramProfilePictureRepositoryFactory,
+	blobStore interfaces.BlobStore,
+	logger *slog.Logger,
+) *DownloadTelegramProfilePicture {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "download_telegram_profile_picture"),
+	)
+	return &DownloadTelegramProfilePicture{
+		transactionManagerFactory:     transactionManagerFactory,
+		telegramProfilePictureFactory: telegramProfilePictureFactory,
+		blobStore:                     blobStore,
+		logger:                        iLogger,
+	}
+}
+
+func (interactor *DownloadTelegramProfilePicture) Execute(
+	ctx context.Context,
+	input DownloadTelegramProfilePictureRequest,
+) (*DownloadTelegramProfilePictureResponse, error) {
+	interactor.logger.DebugContext(
+		ctx,
+		"Started DownloadTelegramProfilePicture execution",
+		slog.String("profile_picture_id", input.ProfilePictureID.String()),
+	)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	picture, err := interactor.getProfilePicture(ctx, input.ProfilePictureID)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := interactor.blobStore.Get(ctx, picture.StorageKey)
+	if err != nil {
+		if errors.Is(err, interfaces.ErrBlobNotFound) {
+			interactor.logger.ErrorContext(
+				ctx,
+				"profile picture content is missing from the blob storage",
+				slog.String("storage_key", picture.StorageKey),
+			)
+			return nil, domain.ErrProfilePictureNotFound
+		}
+		interactor.logger.ErrorContext(ctx, "failed to read the blob", slog.Any("err", err))
+		return nil, application.ErrBlobStorageFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished DownloadTelegramProfilePicture execution")
+	return &DownloadTelegramProfilePictureResponse{
+		ProfilePicture: *picture,
+		Content:        content,
+	}, nil
+}
+
+// getProfilePicture reads the metadata in its own short transaction,
+// so no database connection is held while the content is streamed.
+func (interactor *DownloadTelegramProfilePicture) getProfilePicture(
+	ctx context.Context,
+	pictureID uuid.UUID,
+) (*domain.TelegramProfilePicture, error) {
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer func() {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+	}()
+
+	pictureRepository := interactor.telegramProfilePictureFactory.CreateTelegramProfilePictureRepositoryWithTransaction(
+		transactionManager,
+	)
+	picture, err := pictureRepository.GetProfilePictureByID(ctx, pictureID)
+	if err != nil {
+		if errors.Is(err, domain.ErrProfilePictureNotFound) {
+			return nil, err
+		}
+		interactor.logger.ErrorContext(ctx, "failed to get telegram profile picture", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	return picture, nil
+}
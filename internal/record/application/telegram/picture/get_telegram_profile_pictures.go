@@ -0,0 +1,86 @@
+package picture
+
+import (
+	"context"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+)
+
+type GetTelegramProfilePicturesRequest struct {
+	UserTelegramID uint64
+}
+
+type GetTelegramProfilePicturesResponse struct {
+	ProfilePictures []domain.TelegramProfilePicture
+}
+
+type GetTelegramProfilePictures struct {
+	transactionManagerFactory     interfaces.TransactionManagerFactory
+	telegramProfilePictureFactory repository.TelegramProfilePictureRepositoryFactory
+	logger                        *slog.Logger
+}
+
+func NewGetTelegramProfilePictures(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramProfilePictureFactory repository.TelegramProfilePictureRepositoryFactory,
+	logger *slog.Logger,
+) *GetTelegramProfilePictures {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "get_telegram_profile_pictures"),
+	)
+	return &GetTelegramProfilePictures{
+		transactionManagerFactory:     transactionManagerFactory,
+		telegramProfilePictureFactory: telegramProfilePictureFactory,
+		logger:                        iLogger,
+	}
+}
+
+func (interactor *GetTelegramProfilePictures) Execute(
+	ctx context.Context,
+	input GetTelegramProfilePicturesRequest,
+) (*GetTelegramProfilePicturesResponse, error) {
+	interactor.logger.DebugContext(
+		ctx,
+		"Started GetTelegramProfilePictures execution",
+		slog.Uint64("user_telegram_id", input.UserTelegramID),
+	)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer func() {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+	}()
+
+	pictureRepository := interactor.telegramProfilePictureFactory.CreateTelegramProfilePictureRepositoryWithTransaction(
+		transactionManager,
+	)
+	pictures, err := pictureRepository.GetProfilePicturesByUserTelegramID(ctx, input.UserTelegramID)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get telegram profile pictures", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetTelegramProfilePictures execution")
+	return &GetTelegramProfilePicturesResponse{ProfilePictures: *pictures}, nil
+}
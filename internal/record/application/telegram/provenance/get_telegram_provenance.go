@@ -0,0 +1,112 @@
+package provenance
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidProvenanceTarget = errors.New("target type must be telegram_user or telegram_record")
+
+type GetTelegramProvenanceRequest struct {
+	TargetType domain.TelegramObservationTargetType
+	TargetID   uuid.UUID
+}
+
+type GetTelegramProvenanceResponse struct {
+	Observations []domain.TelegramObservation
+}
+
+// GetTelegramProvenance returns the collectors who have archived a telegram user or record, the earliest first.
+// Only the observations visible to the caller are returned.
+type GetTelegramProvenance struct {
+	transactionManagerFactory            interfaces.TransactionManagerFactory
+	telegramObservationRepositoryFactory repository.TelegramObservationRepositoryFactory
+	visibilityPolicy                     *application.TelegramVisibilityPolicy
+	logger                               *slog.Logger
+}
+
+func NewGetTelegramProvenance(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramObservationRepositoryFactory repository.TelegramObservationRepositoryFactory,
+	visibilityPolicy *application.TelegramVisibilityPolicy,
+	logger *slog.Logger,
+) *GetTelegramProvenance {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "get_telegram_provenance"),
+	)
+	return &GetTelegramProvenance{
+		transactionManagerFactory:            transactionManagerFactory,
+		telegramObservationRepositoryFactory: telegramObservationRepositoryFactory,
+		visibilityPolicy:                     visibilityPolicy,
+		logger:                               iLogger,
+	}
+}
+
+func (interactor *GetTelegramProvenance) Execute(
+	ctx context.Context,
+	input GetTelegramProvenanceRequest,
+) (*GetTelegramProvenanceResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+	if !input.TargetType.Valid() {
+		return nil, ErrInvalidProvenanceTarget
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started GetTelegramProvenance execution",
+		slog.String("target_type", string(input.TargetType)),
+		slog.String("target_id", input.TargetID.String()),
+	)
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer rollback(ctx, interactor.logger, transactionManager)
+
+	observationRepository := interactor.telegramObservationRepositoryFactory.
+		CreateTelegramObservationRepositoryWithTransaction(transactionManager)
+	observations, err := observationRepository.GetTelegramObservations(ctx, input.TargetType, input.TargetID)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get telegram observations", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	scope := interactor.visibilityPolicy.Scope(idp)
+	visible := make([]domain.TelegramObservation, 0, len(*observations))
+	for _, observation := range *observations {
+		if scope.Sees(observation) {
+			visible = append(visible, observation)
+		}
+	}
+	// The target is unknown to whoever sees none of its collectors
+	if len(visible) == 0 {
+		return nil, domain.ErrObservationNotFound
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetTelegramProvenance execution")
+	return &GetTelegramProvenanceResponse{Observations: visible}, nil
+}
+
+func rollback(ctx context.Context, logger *slog.Logger, transactionManager interfaces.TransactionManager) {
+	if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+		logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+	}
+}
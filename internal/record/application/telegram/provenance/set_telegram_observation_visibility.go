@@ -0,0 +1,142 @@
+package provenance
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type SetTelegramObservationVisibilityRequest struct {
+	TargetType domain.TelegramObservationTargetType
+	TargetID   uuid.UUID
+	Visibility domain.TelegramVisibility
+}
+
+type SetTelegramObservationVisibilityResponse struct {
+	Observation domain.TelegramObservation
+}
+
+// SetTelegramObservationVisibility changes who sees that the caller has archived a telegram user or record.
+// A collector only changes the visibility of its own observations.
+type SetTelegramObservationVisibility struct {
+	transactionManagerFactory            interfaces.TransactionManagerFactory
+	telegramObservationRepositoryFactory repository.TelegramObservationRepositoryFactory
+	auditClient                          auditclient.AuditClient
+	logger                               *slog.Logger
+}
+
+func NewSetTelegramObservationVisibility(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramObservationRepositoryFactory repository.TelegramObservationRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *SetTelegramObservationVisibility {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "set_telegram_observation_visibility"),
+	)
+	return &SetTelegramObservationVisibility{
+		transactionManagerFactory:            transactionManagerFactory,
+		telegramObservationRepositoryFactory: telegramObservationRepositoryFactory,
+		auditClient:                          auditClient,
+		logger:                               iLogger,
+	}
+}
+
+func (interactor *SetTelegramObservationVisibility) Execute(
+	ctx context.Context,
+	input SetTelegramObservationVisibilityRequest,
+) (*SetTelegramObservationVisibilityResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+	if !input.TargetType.Valid() {
+		return nil, ErrInvalidProvenanceTarget
+	}
+	if !input.Visibility.Valid() {
+		return nil, domain.ErrInvalidVisibility
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started SetTelegramObservationVisibility execution",
+		slog.String("target_type", string(input.TargetType)),
+		slog.String("target_id", input.TargetID.String()),
+		slog.String("visibility", string(input.Visibility)),
+	)
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	observationRepository := interactor.telegramObservationRepositoryFactory.
+		CreateTelegramObservationRepositoryWithTransaction(transactionManager)
+	observations, err := observationRepository.GetTelegramObservations(ctx, input.TargetType, input.TargetID)
+	var observation *domain.TelegramObservation
+	if err == nil {
+		for i := range *observations {
+			if (*observations)[i].CollectorUserID == idp.UserID {
+				observation = &(*observations)[i]
+				break
+			}
+		}
+		if observation == nil {
+			err = domain.ErrObservationNotFound
+		}
+	}
+	if err == nil {
+		err = observationRepository.UpdateTelegramObservationVisibility(
+			ctx,
+			input.TargetType,
+			input.TargetID,
+			idp.UserID,
+			input.Visibility,
+		)
+	}
+	if err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		if errors.Is(err, domain.ErrObservationNotFound) {
+			return nil, err
+		}
+		interactor.logger.ErrorContext(ctx, "failed to set telegram observation visibility", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	updated := *observation
+	updated.Visibility = input.Visibility
+	entry := application.NewTelegramAuditEntry(
+		auditclient.TelegramObservationVisibilityChanged,
+		application.AuditTargetTelegramObservation,
+		observation.ID,
+		observation,
+		updated,
+	)
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record audit entry", slog.Any("err", err))
+		rollback(ctx, interactor.logger, transactionManager)
+		return nil, application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished SetTelegramObservationVisibility execution")
+	return &SetTelegramObservationVisibilityResponse{Observation: updated}, nil
+}
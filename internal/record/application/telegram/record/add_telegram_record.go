@@ -2,6 +2,7 @@ package record
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"time"
 
@@ -11,9 +12,9 @@ import (
 	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
 	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
 	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
 	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
 	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
-	"github.com/InWamos/trinity-proto/middleware"
 	"github.com/google/uuid"
 )
 
@@ -22,17 +23,32 @@ type AddTelegramRecordRequest struct {
 	FromUserTelegramID uuid.UUID
 	InTelegramChatID   int64
 	MessageText        string
+	Entities           []domain.TelegramMessageEntity
+	Caption            string
+	CaptionEntities    []domain.TelegramMessageEntity
 	PostedAt           time.Time
+	EditedAt           *time.Time
+	// ReplyToMessageTelegramID, ThreadTelegramID and ForwardOrigin are only set when known
+	ReplyToMessageTelegramID *uint64
+	ThreadTelegramID         *uint64
+	ForwardOrigin            *domain.TelegramForwardOrigin
 }
 
 type AddTelegramRecordResponse struct {
 	RecordID string
+	// Revised is set when the message has already been stored and the submission is a new version of it
+	Revised bool
 }
 
 type AddTelegramRecord struct {
 	transactionManagerFactory       interfaces.TransactionManagerFactory
 	telegramDomainValidator         *service.TelegramModelValidator
 	telegramRecordRepositoryFactory repository.TelegramRecordRepositoryFactory
+	recordAnalyzer                  *application.TelegramRecordAnalyzer
+	chainAppender                   *application.TelegramChainAppender
+	eventPublisher                  *application.TelegramEventPublisher
+	provenanceRecorder              *application.TelegramProvenanceRecorder
+	auditClient                     auditclient.AuditClient
 	logger                          *slog.Logger
 }
 
@@ -40,6 +56,11 @@ func NewAddTelegramRecord(
 	transactionManagerFactory interfaces.TransactionManagerFactory,
 	telegramDomainValidator *service.TelegramModelValidator,
 	telegramRecordRepositoryFactory repository.TelegramRecordRepositoryFactory,
+	recordAnalyzer *application.TelegramRecordAnalyzer,
+	chainAppender *application.TelegramChainAppender,
+	eventPublisher *application.TelegramEventPublisher,
+	provenanceRecorder *application.TelegramProvenanceRecorder,
+	auditClient auditclient.AuditClient,
 	logger *slog.Logger,
 ) *AddTelegramRecord {
 	iLogger := logger.With(
@@ -49,7 +70,12 @@ func NewAddTelegramRecord(
 	return &AddTelegramRecord{
 		transactionManagerFactory:       transactionManagerFactory,
 		telegramRecordRepositoryFactory: telegramRecordRepositoryFactory,
+		recordAnalyzer:                  recordAnalyzer,
+		chainAppender:                   chainAppender,
+		eventPublisher:                  eventPublisher,
+		provenanceRecorder:              provenanceRecorder,
 		telegramDomainValidator:         telegramDomainValidator,
+		auditClient:                     auditClient,
 		logger:                          iLogger,
 	}
 }
@@ -58,7 +84,7 @@ func (interactor *AddTelegramRecord) Execute(
 	ctx context.Context,
 	input AddTelegramRecordRequest,
 ) (*AddTelegramRecordResponse, error) {
-	idp, ok := ctx.Value(middleware.IdentityProviderKey).(*client.UserIdentity)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
 	if !ok || idp == nil {
 		return nil, rbac.ErrInsufficientPrivileges
 	}
@@ -78,14 +104,21 @@ func (interactor *AddTelegramRecord) Execute(
 	)
 
 	telegramRecord := domain.TelegramRecord{
-		ID:                 recordID,
-		MessageTelegramID:  input.MessageTelegramID,
-		FromTelegramUserID: input.FromUserTelegramID,
-		InTelegramChatID:   input.InTelegramChatID,
-		MessageText:        input.MessageText,
-		PostedAt:           input.PostedAt,
-		AddedAt:            now,
-		AddedByUser:        idp.UserID,
+		ID:                       recordID,
+		MessageTelegramID:        input.MessageTelegramID,
+		FromTelegramUserID:       input.FromUserTelegramID,
+		InTelegramChatID:         input.InTelegramChatID,
+		MessageText:              input.MessageText,
+		Entities:                 input.Entities,
+		Caption:                  input.Caption,
+		CaptionEntities:          input.CaptionEntities,
+		PostedAt:                 input.PostedAt,
+		EditedAt:                 input.EditedAt,
+		ReplyToMessageTelegramID: input.ReplyToMessageTelegramID,
+		ThreadTelegramID:         input.ThreadTelegramID,
+		ForwardOrigin:            input.ForwardOrigin,
+		AddedAt:                  now,
+		AddedByUser:              idp.UserID,
 	}
 
 	// Validate the rules before adding to the database
@@ -103,16 +136,52 @@ func (interactor *AddTelegramRecord) Execute(
 		transactionManager,
 	)
 
-	if err = telegramRecordRepository.CreateTelegramRecord(ctx, telegramRecord); err != nil {
-		interactor.logger.InfoContext(
-			ctx,
-			"failed to add telegram record",
-			slog.String("record_id", recordID.String()),
-			slog.Any("err", err),
-		)
-		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
-			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+	response := &AddTelegramRecordResponse{RecordID: recordID.String()}
+	auditEntries := application.NewTelegramRecordsAddedAuditEntries(telegramRecord)
+	err = transactionManager.InSavepoint(ctx, func() error {
+		return telegramRecordRepository.CreateTelegramRecord(ctx, telegramRecord)
+	})
+	// An unchanged record stored before is only observed by the collector, unless it already has observed it
+	unchanged := false
+	switch {
+	case errors.Is(err, domain.ErrRecordAlreadyExists):
+		// The message is stored already, the resubmission may be a new version of it
+		stored, reviseErr := application.ReviseTelegramRecord(ctx, telegramRecordRepository, telegramRecord)
+		switch {
+		case reviseErr == nil:
+			response = &AddTelegramRecordResponse{RecordID: stored.ID.String(), Revised: true}
+			auditEntries = []auditclient.Entry{application.NewTelegramRecordRevisedAuditEntry(stored, telegramRecord)}
+		case errors.Is(reviseErr, domain.ErrRecordAlreadyExists) && stored != nil:
+			response = &AddTelegramRecordResponse{RecordID: stored.ID.String()}
+			unchanged = true
+		default:
+			interactor.rollback(ctx, transactionManager, recordID, reviseErr)
+			return nil, reviseErr
 		}
+		telegramRecord.ID = stored.ID
+	case err != nil:
+		interactor.rollback(ctx, transactionManager, recordID, err)
+		return nil, err
+	}
+
+	observed, err := interactor.provenanceRecorder.ObserveRecords(ctx, transactionManager, idp.UserID, telegramRecord.ID)
+	if err == nil && unchanged && !observed[telegramRecord.ID] {
+		err = domain.ErrRecordAlreadyExists
+	}
+	if err == nil && !unchanged {
+		err = interactor.recordAnalyzer.Analyze(ctx, transactionManager, telegramRecord)
+	}
+	if err == nil && !unchanged {
+		err = interactor.chainAppender.AppendRecords(ctx, transactionManager, telegramRecord.ID)
+	}
+	if err == nil && !unchanged && !response.Revised {
+		err = interactor.eventPublisher.PublishRecordsCreated(ctx, transactionManager, telegramRecord)
+	}
+	if err == nil && !unchanged {
+		err = interactor.auditClient.Record(ctx, transactionManager, auditEntries...)
+	}
+	if err != nil {
+		interactor.rollback(ctx, transactionManager, recordID, err)
 		return nil, err
 	}
 
@@ -122,7 +191,22 @@ func (interactor *AddTelegramRecord) Execute(
 	}
 
 	interactor.logger.DebugContext(ctx, "Finished AddTelegramRecord execution")
-	return &AddTelegramRecordResponse{
-		RecordID: recordID.String(),
-	}, nil
+	return response, nil
+}
+
+func (interactor *AddTelegramRecord) rollback(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	recordID uuid.UUID,
+	err error,
+) {
+	interactor.logger.InfoContext(
+		ctx,
+		"failed to add telegram record",
+		slog.String("record_id", recordID.String()),
+		slog.Any("err", err),
+	)
+	if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+		interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+	}
 }
@@ -0,0 +1,284 @@
+package record
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/InWamos/trinity-proto/config"
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrBatchEmpty    = errors.New("batch contains no records")
+	ErrBatchTooLarge = errors.New("batch contains too many records")
+)
+
+type AddTelegramRecordsBatchRequest struct {
+	Records []AddTelegramRecordRequest
+}
+
+// AddTelegramRecordsBatchResult is the outcome of a single record,
+// either its RecordID or the Err it has been skipped with.
+// Revised is set when the record has been stored before and the submission is a new version of it.
+type AddTelegramRecordsBatchResult struct {
+	RecordID string
+	Revised  bool
+	Err      error
+}
+
+type AddTelegramRecordsBatchResponse struct {
+	Results []AddTelegramRecordsBatchResult
+}
+
+// AddTelegramRecordsBatch adds many records in one transaction.
+// Invalid or conflicting records are reported per item and don't fail the rest of the batch.
+type AddTelegramRecordsBatch struct {
+	transactionManagerFactory       interfaces.TransactionManagerFactory
+	telegramDomainValidator         *service.TelegramModelValidator
+	telegramRecordRepositoryFactory repository.TelegramRecordRepositoryFactory
+	recordAnalyzer                  *application.TelegramRecordAnalyzer
+	chainAppender                   *application.TelegramChainAppender
+	eventPublisher                  *application.TelegramEventPublisher
+	provenanceRecorder              *application.TelegramProvenanceRecorder
+	auditClient                     auditclient.AuditClient
+	maxBatchSize                    int
+	logger                          *slog.Logger
+}
+
+func NewAddTelegramRecordsBatch(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramDomainValidator *service.TelegramModelValidator,
+	telegramRecordRepositoryFactory repository.TelegramRecordRepositoryFactory,
+	recordAnalyzer *application.TelegramRecordAnalyzer,
+	chainAppender *application.TelegramChainAppender,
+	eventPublisher *application.TelegramEventPublisher,
+	provenanceRecorder *application.TelegramProvenanceRecorder,
+	auditClient auditclient.AuditClient,
+	ingestConfig *config.IngestConfig,
+	logger *slog.Logger,
+) *AddTelegramRecordsBatch {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "add_telegram_records_batch"),
+	)
+	return &AddTelegramRecordsBatch{
+		transactionManagerFactory:       transactionManagerFactory,
+		telegramRecordRepositoryFactory: telegramRecordRepositoryFactory,
+		recordAnalyzer:                  recordAnalyzer,
+		chainAppender:                   chainAppender,
+		eventPublisher:                  eventPublisher,
+		provenanceRecorder:              provenanceRecorder,
+		telegramDomainValidator:         telegramDomainValidator,
+		auditClient:                     auditClient,
+		maxBatchSize:                    ingestConfig.BatchSize,
+		logger:                          iLogger,
+	}
+}
+
+func (interactor *AddTelegramRecordsBatch) Execute(
+	ctx context.Context,
+	input AddTelegramRecordsBatchRequest,
+) (*AddTelegramRecordsBatchResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	switch {
+	case len(input.Records) == 0:
+		return nil, ErrBatchEmpty
+	case len(input.Records) > interactor.maxBatchSize:
+		return nil, ErrBatchTooLarge
+	}
+
+	interactor.logger.DebugContext(
+		ctx,
+		"Started AddTelegramRecordsBatch execution",
+		slog.Int("record_count", len(input.Records)),
+	)
+
+	now := time.Now()
+	results := make([]AddTelegramRecordsBatchResult, len(input.Records))
+	validRecords := make([]domain.TelegramRecord, 0, len(input.Records))
+	validIndexes := make([]int, 0, len(input.Records))
+	for i, recordInput := range input.Records {
+		telegramRecord := domain.TelegramRecord{
+			ID:                       uuid.New(),
+			MessageTelegramID:        recordInput.MessageTelegramID,
+			FromTelegramUserID:       recordInput.FromUserTelegramID,
+			InTelegramChatID:         recordInput.InTelegramChatID,
+			MessageText:              recordInput.MessageText,
+			Entities:                 recordInput.Entities,
+			Caption:                  recordInput.Caption,
+			CaptionEntities:          recordInput.CaptionEntities,
+			PostedAt:                 recordInput.PostedAt,
+			EditedAt:                 recordInput.EditedAt,
+			ReplyToMessageTelegramID: recordInput.ReplyToMessageTelegramID,
+			ThreadTelegramID:         recordInput.ThreadTelegramID,
+			ForwardOrigin:            recordInput.ForwardOrigin,
+			AddedAt:                  now,
+			AddedByUser:              idp.UserID,
+		}
+		// Validate the rules before adding to the database
+		if err := interactor.telegramDomainValidator.Validate(&telegramRecord); err != nil {
+			results[i].Err = err
+			continue
+		}
+		validRecords = append(validRecords, telegramRecord)
+		validIndexes = append(validIndexes, i)
+	}
+
+	if len(validRecords) > 0 {
+		recordResults, err := interactor.createRecords(ctx, idp.UserID, validRecords)
+		if err != nil {
+			return nil, err
+		}
+		for j, i := range validIndexes {
+			results[i] = recordResults[j]
+		}
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished AddTelegramRecordsBatch execution")
+	return &AddTelegramRecordsBatchResponse{Results: results}, nil
+}
+
+func (interactor *AddTelegramRecordsBatch) createRecords(
+	ctx context.Context,
+	collectorUserID uuid.UUID,
+	telegramRecords []domain.TelegramRecord,
+) ([]AddTelegramRecordsBatchResult, error) {
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	telegramRecordRepository := interactor.telegramRecordRepositoryFactory.CreateTelegramRecordRepositoryWithTransaction(
+		transactionManager,
+	)
+	recordErrors, err := telegramRecordRepository.CreateTelegramRecords(ctx, telegramRecords)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to add telegram records", slog.Any("err", err))
+		interactor.rollback(ctx, transactionManager)
+		return nil, application.ErrDatabaseFailed
+	}
+
+	results := make([]AddTelegramRecordsBatchResult, len(telegramRecords))
+	storedRecords := make([]domain.TelegramRecord, 0, len(telegramRecords))
+	createdRecords := make([]domain.TelegramRecord, 0, len(telegramRecords))
+	var auditEntries []auditclient.Entry
+	// The unchanged records stored before are only reported once the collector has observed them
+	unchangedRecordIDs := make(map[int]uuid.UUID)
+	for i, recordErr := range recordErrors {
+		switch {
+		case recordErr == nil:
+			results[i].RecordID = telegramRecords[i].ID.String()
+			storedRecords = append(storedRecords, telegramRecords[i])
+			createdRecords = append(createdRecords, telegramRecords[i])
+		case errors.Is(recordErr, domain.ErrRecordAlreadyExists):
+			stored, reviseErr := application.ReviseTelegramRecord(ctx, telegramRecordRepository, telegramRecords[i])
+			switch {
+			case reviseErr == nil:
+				results[i] = AddTelegramRecordsBatchResult{RecordID: stored.ID.String(), Revised: true}
+				revisedRecord := telegramRecords[i]
+				revisedRecord.ID = stored.ID
+				storedRecords = append(storedRecords, revisedRecord)
+				auditEntries = append(auditEntries, application.NewTelegramRecordRevisedAuditEntry(stored, telegramRecords[i]))
+			case errors.Is(reviseErr, domain.ErrRecordAlreadyExists):
+				results[i].Err = reviseErr
+				if stored != nil {
+					unchangedRecordIDs[i] = stored.ID
+				}
+			default:
+				interactor.logger.ErrorContext(ctx, "failed to revise telegram record", slog.Any("err", reviseErr))
+				interactor.rollback(ctx, transactionManager)
+				return nil, application.ErrDatabaseFailed
+			}
+		default:
+			results[i].Err = recordErr
+		}
+	}
+
+	err = interactor.recordAnalyzer.Analyze(
+		ctx,
+		transactionManager,
+		storedRecords...,
+	)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to store telegram indicators", slog.Any("err", err))
+		interactor.rollback(ctx, transactionManager)
+		return nil, application.ErrDatabaseFailed
+	}
+	storedRecordIDs := make([]uuid.UUID, len(storedRecords))
+	for i, storedRecord := range storedRecords {
+		storedRecordIDs[i] = storedRecord.ID
+	}
+	if err = interactor.chainAppender.AppendRecords(ctx, transactionManager, storedRecordIDs...); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to chain telegram records", slog.Any("err", err))
+		interactor.rollback(ctx, transactionManager)
+		return nil, application.ErrDatabaseFailed
+	}
+	observedRecordIDs := storedRecordIDs
+	for _, recordID := range unchangedRecordIDs {
+		observedRecordIDs = append(observedRecordIDs, recordID)
+	}
+	observed, err := interactor.provenanceRecorder.ObserveRecords(
+		ctx,
+		transactionManager,
+		collectorUserID,
+		observedRecordIDs...,
+	)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to observe telegram records", slog.Any("err", err))
+		interactor.rollback(ctx, transactionManager)
+		return nil, application.ErrDatabaseFailed
+	}
+	for i, recordID := range unchangedRecordIDs {
+		// A record repeated inside of the batch is only reported once as observed
+		if observed[recordID] {
+			results[i] = AddTelegramRecordsBatchResult{RecordID: recordID.String()}
+			delete(observed, recordID)
+		}
+	}
+	if err = interactor.eventPublisher.PublishRecordsCreated(ctx, transactionManager, createdRecords...); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to publish telegram record events", slog.Any("err", err))
+		interactor.rollback(ctx, transactionManager)
+		return nil, application.ErrDatabaseFailed
+	}
+	auditEntries = append(auditEntries, application.NewTelegramRecordsAddedAuditEntries(createdRecords...)...)
+	if err = interactor.auditClient.Record(ctx, transactionManager, auditEntries...); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record telegram record audit entries", slog.Any("err", err))
+		interactor.rollback(ctx, transactionManager)
+		return nil, application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	return results, nil
+}
+
+func (interactor *AddTelegramRecordsBatch) rollback(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+) {
+	if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+		interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+	}
+}
@@ -0,0 +1,426 @@
+package record_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/InWamos/trinity-proto/config"
+	telegram "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/record"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	eventclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/event/client"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type fakeTransactionManager struct {
+	interfaces.TransactionManager
+}
+
+func (fakeTransactionManager) Commit(context.Context) error   { return nil }
+func (fakeTransactionManager) Rollback(context.Context) error { return nil }
+
+type fakeTransactionManagerFactory struct{}
+
+func (fakeTransactionManagerFactory) NewTransaction(context.Context) (interfaces.TransactionManager, error) {
+	return fakeTransactionManager{}, nil
+}
+
+// fakeRecordRepository rejects the messages of the listed ids with the given errors and records the rest.
+// The stored messages are the ones the rejected duplicates are compared with.
+type fakeRecordRepository struct {
+	repository.TelegramRecordRepository
+	rejected map[uint64]error
+	stored   map[uint64]domain.TelegramRecord
+	received []domain.TelegramRecord
+}
+
+func (repo *fakeRecordRepository) CreateTelegramRecords(
+	_ context.Context,
+	telegramRecords []domain.TelegramRecord,
+) ([]error, error) {
+	repo.received = telegramRecords
+	recordErrors := make([]error, len(telegramRecords))
+	for i, record := range telegramRecords {
+		recordErrors[i] = repo.rejected[record.MessageTelegramID]
+	}
+	return recordErrors, nil
+}
+
+func (repo *fakeRecordRepository) GetTelegramRecordByMessageTelegramID(
+	_ context.Context,
+	messageTelegramID uint64,
+	_ int64,
+) (*domain.TelegramRecord, error) {
+	if stored, ok := repo.stored[messageTelegramID]; ok {
+		return &stored, nil
+	}
+	return nil, domain.ErrRecordNotFound
+}
+
+func (repo *fakeRecordRepository) GetTelegramRecordsByIDs(
+	_ context.Context,
+	recordIDs []uuid.UUID,
+) (*[]domain.TelegramRecord, error) {
+	var records []domain.TelegramRecord
+	for _, record := range repo.received {
+		if slices.Contains(recordIDs, record.ID) {
+			records = append(records, record)
+		}
+	}
+	return &records, nil
+}
+
+func (repo *fakeRecordRepository) CreateTelegramRecordRepositoryWithTransaction(
+	interfaces.TransactionManager,
+) repository.TelegramRecordRepository {
+	return repo
+}
+
+// fakeChainRepository keeps the appended entries in a single chain, whatever their collector.
+type fakeChainRepository struct {
+	repository.TelegramChainRepository
+	head    domain.TelegramChainHead
+	entries []domain.TelegramChainEntry
+}
+
+func (repo *fakeChainRepository) LockTelegramChainHead(
+	_ context.Context,
+	collectorUserID uuid.UUID,
+) (*domain.TelegramChainHead, error) {
+	head := repo.head
+	head.CollectorUserID = collectorUserID
+	return &head, nil
+}
+
+func (repo *fakeChainRepository) AppendTelegramChainEntries(
+	_ context.Context,
+	entries []domain.TelegramChainEntry,
+) error {
+	repo.entries = append(repo.entries, entries...)
+	if len(entries) > 0 {
+		repo.head.Sequence = entries[len(entries)-1].Sequence
+		repo.head.EntryHash = entries[len(entries)-1].EntryHash
+	}
+	return nil
+}
+
+func (repo *fakeChainRepository) GetLatestTelegramChainContentHashes(
+	context.Context,
+	domain.TelegramChainSubjectType,
+	[]uuid.UUID,
+) (map[uuid.UUID]string, error) {
+	contentHashes := make(map[uuid.UUID]string)
+	for _, entry := range repo.entries {
+		contentHashes[entry.SubjectID] = entry.ContentHash
+	}
+	return contentHashes, nil
+}
+
+func (repo *fakeChainRepository) CreateTelegramChainRepositoryWithTransaction(
+	interfaces.TransactionManager,
+) repository.TelegramChainRepository {
+	return repo
+}
+
+// fakeObservationRepository keeps the targets the collector has observed, whatever their type.
+type fakeObservationRepository struct {
+	repository.TelegramObservationRepository
+	observed map[uuid.UUID]bool
+}
+
+func (repo *fakeObservationRepository) AddTelegramObservations(
+	_ context.Context,
+	observations []domain.TelegramObservation,
+) ([]uuid.UUID, error) {
+	if repo.observed == nil {
+		repo.observed = make(map[uuid.UUID]bool)
+	}
+	var observedIDs []uuid.UUID
+	for _, observation := range observations {
+		if !repo.observed[observation.TargetID] {
+			repo.observed[observation.TargetID] = true
+			observedIDs = append(observedIDs, observation.TargetID)
+		}
+	}
+	return observedIDs, nil
+}
+
+func (repo *fakeObservationRepository) CreateTelegramObservationRepositoryWithTransaction(
+	interfaces.TransactionManager,
+) repository.TelegramObservationRepository {
+	return repo
+}
+
+// fakeAnalysisRepository keeps the indicators and the interactions derived from the stored records.
+type fakeAnalysisRepository struct {
+	repository.TelegramIndicatorRepository
+	repository.TelegramInteractionRepository
+	repository.TelegramAlertRepository
+	indicators []domain.TelegramIndicator
+	derived    []uuid.UUID
+}
+
+func (repo *fakeAnalysisRepository) AddTelegramIndicators(
+	_ context.Context,
+	indicators []domain.TelegramIndicator,
+) error {
+	repo.indicators = append(repo.indicators, indicators...)
+	return nil
+}
+
+func (repo *fakeAnalysisRepository) DeriveTelegramInteractions(_ context.Context, recordID uuid.UUID) error {
+	repo.derived = append(repo.derived, recordID)
+	return nil
+}
+
+func (repo *fakeAnalysisRepository) AddTelegramAlertsForRecords(context.Context, []uuid.UUID) error {
+	return nil
+}
+
+func (repo *fakeAnalysisRepository) CreateTelegramIndicatorRepositoryWithTransaction(
+	interfaces.TransactionManager,
+) repository.TelegramIndicatorRepository {
+	return repo
+}
+
+func (repo *fakeAnalysisRepository) CreateTelegramInteractionRepositoryWithTransaction(
+	interfaces.TransactionManager,
+) repository.TelegramInteractionRepository {
+	return repo
+}
+
+func (repo *fakeAnalysisRepository) CreateTelegramAlertRepositoryWithTransaction(
+	interfaces.TransactionManager,
+) repository.TelegramAlertRepository {
+	return repo
+}
+
+// fakeEventClient keeps the IDs of the records recorded as created.
+type fakeEventClient struct {
+	published []uuid.UUID
+}
+
+func (ec *fakeEventClient) Record(
+	_ context.Context,
+	_ interfaces.TransactionManager,
+	_ eventclient.EventType,
+	data any,
+) error {
+	ec.published = append(ec.published, data.(telegram.TelegramRecordEvent).ID)
+	return nil
+}
+
+func (ec *fakeEventClient) RecordObserved(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	eventType eventclient.EventType,
+	data any,
+	_ eventclient.Observation,
+) error {
+	return ec.Record(ctx, transactionManager, eventType, data)
+}
+
+// fakeAuditClient appends nothing, the audit log isn't kept.
+type fakeAuditClient struct {
+	auditclient.AuditClient
+}
+
+func (fakeAuditClient) Record(context.Context, interfaces.TransactionManager, ...auditclient.Entry) error {
+	return nil
+}
+
+func newRecordAnalyzer(repo *fakeAnalysisRepository) *telegram.TelegramRecordAnalyzer {
+	return telegram.NewTelegramRecordAnalyzer(
+		repo,
+		repo,
+		service.NewTelegramIndicatorExtractor(),
+		telegram.NewTelegramWatchlistEvaluator(repo),
+	)
+}
+
+func newBatchInteractor(
+	repo *fakeRecordRepository,
+	chain *fakeChainRepository,
+	observations *fakeObservationRepository,
+	events *fakeEventClient,
+	batchSize int,
+) *application.AddTelegramRecordsBatch {
+	visibilityPolicy := telegram.NewTelegramVisibilityPolicy(&config.VisibilityConfig{Visibility: "global"})
+	return application.NewAddTelegramRecordsBatch(
+		fakeTransactionManagerFactory{},
+		service.NewTelegramModelValidator(validator.New()),
+		repo,
+		newRecordAnalyzer(&fakeAnalysisRepository{}),
+		telegram.NewTelegramChainAppender(chain, repo, nil, nil),
+		telegram.NewTelegramEventPublisher(events, visibilityPolicy),
+		telegram.NewTelegramProvenanceRecorder(observations, visibilityPolicy),
+		fakeAuditClient{},
+		&config.IngestConfig{BatchSize: batchSize},
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+	)
+}
+
+func batchContext() context.Context {
+	identity := &client.UserIdentity{UserID: uuid.New(), UserRole: client.User}
+	return context.WithValue(context.Background(), client.IdentityProviderKey, identity)
+}
+
+func batchRecord(messageID uint64, text string) application.AddTelegramRecordRequest {
+	return application.AddTelegramRecordRequest{
+		MessageTelegramID:  messageID,
+		FromUserTelegramID: uuid.New(),
+		InTelegramChatID:   -100123,
+		MessageText:        text,
+		PostedAt:           time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+	}
+}
+
+func TestAddTelegramRecordsBatch_Results(t *testing.T) {
+	storedID := uuid.New()
+	repo := &fakeRecordRepository{
+		rejected: map[uint64]error{
+			2: domain.ErrRecordAlreadyExists,
+			4: domain.ErrUnexistentTelegramUserReferenced,
+		},
+		stored: map[uint64]domain.TelegramRecord{2: {ID: storedID, MessageText: "duplicate"}},
+	}
+	// The collector has observed the duplicate already
+	observations := &fakeObservationRepository{observed: map[uuid.UUID]bool{storedID: true}}
+	records := []application.AddTelegramRecordRequest{
+		batchRecord(1, "first"),
+		batchRecord(2, "duplicate"),
+		batchRecord(3, ""),
+		batchRecord(4, "unknown author"),
+		batchRecord(5, strings.Repeat("x", 4097)),
+		batchRecord(6, "last"),
+	}
+	chain := &fakeChainRepository{}
+	events := &fakeEventClient{}
+	resp, err := newBatchInteractor(repo, chain, observations, events, 10).Execute(
+		batchContext(),
+		application.AddTelegramRecordsBatchRequest{Records: records},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// Invalid records never reach the repository, the others keep their order
+	if len(repo.received) != 4 {
+		t.Fatalf("expected 4 valid records to be stored, got %d", len(repo.received))
+	}
+	cases := []struct {
+		created bool
+		err     error
+	}{
+		{created: true},
+		{err: domain.ErrRecordAlreadyExists},
+		{err: domain.ErrValidationFailed},
+		{err: domain.ErrUnexistentTelegramUserReferenced},
+		{err: domain.ErrValidationFailed},
+		{created: true},
+	}
+	if len(resp.Results) != len(cases) {
+		t.Fatalf("expected %d results, got %d", len(cases), len(resp.Results))
+	}
+	for i, tc := range cases {
+		result := resp.Results[i]
+		if tc.created && (result.Err != nil || result.RecordID == "") {
+			t.Errorf("result %d: expected the record to be created, got %+v", i, result)
+		}
+		if !tc.created && (!errors.Is(result.Err, tc.err) || result.RecordID != "") {
+			t.Errorf("result %d: expected %v, got %+v", i, tc.err, result)
+		}
+	}
+	if resp.Results[0].RecordID != repo.received[0].ID.String() ||
+		resp.Results[5].RecordID != repo.received[3].ID.String() {
+		t.Error("expected the record ids to be reported at the index of their records")
+	}
+	// Only the newly created records are published, not the ones stored already
+	if len(events.published) != 2 ||
+		events.published[0] != repo.received[0].ID || events.published[1] != repo.received[3].ID {
+		t.Errorf("expected the created records to be published, got %v", events.published)
+	}
+	// The stored records are chained in their order, each entry following the previous one
+	if len(chain.entries) != 2 ||
+		chain.entries[0].SubjectID != repo.received[0].ID || chain.entries[1].SubjectID != repo.received[3].ID {
+		t.Fatalf("expected the created records to be chained, got %+v", chain.entries)
+	}
+	if chain.entries[1].Sequence != 2 || chain.entries[1].PreviousHash != chain.entries[0].EntryHash {
+		t.Errorf("expected the second entry to follow the first one, got %+v", chain.entries[1])
+	}
+}
+
+func TestAddTelegramRecordsBatch_Size(t *testing.T) {
+	cases := map[string]struct {
+		records int
+		err     error
+	}{
+		"empty":        {records: 0, err: application.ErrBatchEmpty},
+		"at the limit": {records: 3},
+		"too large":    {records: 4, err: application.ErrBatchTooLarge},
+	}
+	for name, tc := range cases {
+		records := make([]application.AddTelegramRecordRequest, tc.records)
+		for i := range records {
+			records[i] = batchRecord(uint64(i+1), "text")
+		}
+		_, err := newBatchInteractor(
+			&fakeRecordRepository{},
+			&fakeChainRepository{},
+			&fakeObservationRepository{},
+			&fakeEventClient{},
+			3,
+		).Execute(
+			batchContext(),
+			application.AddTelegramRecordsBatchRequest{Records: records},
+		)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%s: expected %v, got %v", name, tc.err, err)
+		}
+	}
+}
+
+func TestAddTelegramRecordsBatch_Observed(t *testing.T) {
+	storedID := uuid.New()
+	repo := &fakeRecordRepository{
+		rejected: map[uint64]error{1: domain.ErrRecordAlreadyExists},
+		stored:   map[uint64]domain.TelegramRecord{1: {ID: storedID, MessageText: "stored"}},
+	}
+	observations := &fakeObservationRepository{}
+	chain := &fakeChainRepository{}
+	events := &fakeEventClient{}
+	request := application.AddTelegramRecordsBatchRequest{Records: []application.AddTelegramRecordRequest{
+		batchRecord(1, "stored"),
+	}}
+
+	// The message stored by another collector is only observed by this one, it's reported as added once
+	for i, expected := range []error{nil, domain.ErrRecordAlreadyExists} {
+		resp, err := newBatchInteractor(repo, chain, observations, events, 10).Execute(batchContext(), request)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		result := resp.Results[0]
+		if !errors.Is(result.Err, expected) || (expected == nil) != (result.RecordID == storedID.String()) {
+			t.Errorf("submission %d: expected %v, got %+v", i, expected, result)
+		}
+	}
+	if !observations.observed[storedID] {
+		t.Error("expected the stored record to be observed by the collector")
+	}
+	if len(chain.entries) != 0 || len(events.published) != 0 {
+		t.Errorf("expected the unchanged record neither to be chained nor published, got %+v and %v",
+			chain.entries, events.published)
+	}
+}
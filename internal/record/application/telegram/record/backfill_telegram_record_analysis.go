@@ -0,0 +1,135 @@
+package record
+
+import (
+	"context"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+// backfillPageSize is the amount of records processed in a single transaction.
+const backfillPageSize = 500
+
+type BackfillTelegramRecordAnalysisProgress struct {
+	RecordsProcessed int
+}
+
+type BackfillTelegramRecordAnalysisRequest struct {
+	// OnProgress is called after every committed page, it may be nil
+	OnProgress func(BackfillTelegramRecordAnalysisProgress)
+}
+
+type BackfillTelegramRecordAnalysisResponse struct {
+	Progress BackfillTelegramRecordAnalysisProgress
+}
+
+// BackfillTelegramRecordAnalysis analyzes all stored records again, e.g. the ones stored before an analysis
+// was introduced or after the indicator extraction has been improved. Records are walked page by page,
+// each page in its own transaction, so an interrupted backfill keeps the pages it has committed
+// and can simply be run again.
+type BackfillTelegramRecordAnalysis struct {
+	transactionManagerFactory       interfaces.TransactionManagerFactory
+	telegramRecordRepositoryFactory repository.TelegramRecordRepositoryFactory
+	recordAnalyzer                  *application.TelegramRecordAnalyzer
+	logger                          *slog.Logger
+}
+
+func NewBackfillTelegramRecordAnalysis(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramRecordRepositoryFactory repository.TelegramRecordRepositoryFactory,
+	recordAnalyzer *application.TelegramRecordAnalyzer,
+	logger *slog.Logger,
+) *BackfillTelegramRecordAnalysis {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "backfill_telegram_record_analysis"),
+	)
+	return &BackfillTelegramRecordAnalysis{
+		transactionManagerFactory:       transactionManagerFactory,
+		telegramRecordRepositoryFactory: telegramRecordRepositoryFactory,
+		recordAnalyzer:                  recordAnalyzer,
+		logger:                          iLogger,
+	}
+}
+
+func (interactor *BackfillTelegramRecordAnalysis) Execute(
+	ctx context.Context,
+	input BackfillTelegramRecordAnalysisRequest,
+) (*BackfillTelegramRecordAnalysisResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleAdmin); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	interactor.logger.InfoContext(ctx, "Started BackfillTelegramRecordAnalysis execution")
+	var progress BackfillTelegramRecordAnalysisProgress
+	afterID := uuid.Nil
+	for {
+		pageSize, lastID, err := interactor.backfillPage(ctx, afterID)
+		if err != nil {
+			return nil, err
+		}
+		if pageSize == 0 {
+			break
+		}
+		progress.RecordsProcessed += pageSize
+		afterID = lastID
+		if input.OnProgress != nil {
+			input.OnProgress(progress)
+		}
+	}
+
+	interactor.logger.InfoContext(
+		ctx,
+		"Finished BackfillTelegramRecordAnalysis execution",
+		slog.Int("records_processed", progress.RecordsProcessed),
+	)
+	return &BackfillTelegramRecordAnalysisResponse{Progress: progress}, nil
+}
+
+// backfillPage stores the indicators of the records following afterID and returns how many records
+// it has processed and the ID of the last one.
+func (interactor *BackfillTelegramRecordAnalysis) backfillPage(
+	ctx context.Context,
+	afterID uuid.UUID,
+) (int, uuid.UUID, error) {
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return 0, uuid.Nil, application.ErrDatabaseFailed
+	}
+	recordRepository := interactor.telegramRecordRepositoryFactory.CreateTelegramRecordRepositoryWithTransaction(
+		transactionManager,
+	)
+
+	records, err := recordRepository.GetTelegramRecordsAfterID(ctx, afterID, backfillPageSize)
+	if err == nil && len(*records) > 0 {
+		err = interactor.recordAnalyzer.Analyze(ctx, transactionManager, *records...)
+	}
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to analyze telegram records", slog.Any("err", err))
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		return 0, uuid.Nil, application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return 0, uuid.Nil, application.ErrDatabaseFailed
+	}
+	if len(*records) == 0 {
+		return 0, uuid.Nil, nil
+	}
+	return len(*records), (*records)[len(*records)-1].ID, nil
+}
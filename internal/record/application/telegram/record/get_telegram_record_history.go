@@ -0,0 +1,112 @@
+package record
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type GetTelegramRecordHistoryRequest struct {
+	RecordID uuid.UUID
+}
+
+// GetTelegramRecordHistoryResponse holds the record in its current version
+// and the superseded versions of it, the oldest first.
+type GetTelegramRecordHistoryResponse struct {
+	Record    domain.TelegramRecord
+	Revisions []domain.TelegramRecordRevision
+}
+
+type GetTelegramRecordHistory struct {
+	transactionManagerFactory       interfaces.TransactionManagerFactory
+	telegramRecordRepositoryFactory repository.TelegramRecordRepositoryFactory
+	auditClient                     auditclient.AuditClient
+	logger                          *slog.Logger
+}
+
+func NewGetTelegramRecordHistory(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramRecordRepositoryFactory repository.TelegramRecordRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *GetTelegramRecordHistory {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "get_telegram_record_history"),
+	)
+	return &GetTelegramRecordHistory{
+		transactionManagerFactory:       transactionManagerFactory,
+		telegramRecordRepositoryFactory: telegramRecordRepositoryFactory,
+		auditClient:                     auditClient,
+		logger:                          iLogger,
+	}
+}
+
+func (interactor *GetTelegramRecordHistory) Execute(
+	ctx context.Context,
+	input GetTelegramRecordHistoryRequest,
+) (*GetTelegramRecordHistoryResponse, error) {
+	interactor.logger.DebugContext(
+		ctx,
+		"Started GetTelegramRecordHistory execution",
+		slog.String("record_id", input.RecordID.String()),
+	)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer func() {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+	}()
+
+	recordRepository := interactor.telegramRecordRepositoryFactory.CreateTelegramRecordRepositoryWithTransaction(
+		transactionManager,
+	)
+	telegramRecord, err := recordRepository.GetTelegramRecordByID(ctx, input.RecordID)
+	if err != nil {
+		if errors.Is(err, domain.ErrRecordNotFound) {
+			return nil, err
+		}
+		interactor.logger.ErrorContext(ctx, "failed to get telegram record", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	revisions, err := recordRepository.GetTelegramRecordRevisions(ctx, input.RecordID)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get telegram record revisions", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	// The record is only shown once the viewing has been logged
+	err = interactor.auditClient.RecordDetached(ctx, application.NewTelegramRecordsViewedAuditEntries(*telegramRecord)...)
+	if err != nil {
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetTelegramRecordHistory execution")
+	return &GetTelegramRecordHistoryResponse{
+		Record:    *telegramRecord,
+		Revisions: *revisions,
+	}, nil
+}
@@ -0,0 +1,110 @@
+package record
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+// MaxTelegramRecordThreadSize is the amount of records returned for a single thread.
+const MaxTelegramRecordThreadSize = 1000
+
+type GetTelegramRecordThreadRequest struct {
+	RecordID uuid.UUID
+}
+
+// GetTelegramRecordThreadResponse holds the records of the reply thread, from the root to the latest reply.
+type GetTelegramRecordThreadResponse struct {
+	Records []domain.TelegramRecord
+}
+
+type GetTelegramRecordThread struct {
+	transactionManagerFactory       interfaces.TransactionManagerFactory
+	telegramRecordRepositoryFactory repository.TelegramRecordRepositoryFactory
+	auditClient                     auditclient.AuditClient
+	logger                          *slog.Logger
+}
+
+func NewGetTelegramRecordThread(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramRecordRepositoryFactory repository.TelegramRecordRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *GetTelegramRecordThread {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "get_telegram_record_thread"),
+	)
+	return &GetTelegramRecordThread{
+		transactionManagerFactory:       transactionManagerFactory,
+		telegramRecordRepositoryFactory: telegramRecordRepositoryFactory,
+		auditClient:                     auditClient,
+		logger:                          iLogger,
+	}
+}
+
+func (interactor *GetTelegramRecordThread) Execute(
+	ctx context.Context,
+	input GetTelegramRecordThreadRequest,
+) (*GetTelegramRecordThreadResponse, error) {
+	interactor.logger.DebugContext(
+		ctx,
+		"Started GetTelegramRecordThread execution",
+		slog.String("record_id", input.RecordID.String()),
+	)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer func() {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+	}()
+
+	recordRepository := interactor.telegramRecordRepositoryFactory.CreateTelegramRecordRepositoryWithTransaction(
+		transactionManager,
+	)
+	// The record is looked up first to tell an unknown record from a thread without replies
+	if _, err = recordRepository.GetTelegramRecordByID(ctx, input.RecordID); err != nil {
+		if errors.Is(err, domain.ErrRecordNotFound) {
+			return nil, err
+		}
+		interactor.logger.ErrorContext(ctx, "failed to get telegram record", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	records, err := recordRepository.GetTelegramRecordThread(ctx, input.RecordID, MaxTelegramRecordThreadSize)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get telegram record thread", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	// The records are only shown once the viewing has been logged
+	err = interactor.auditClient.RecordDetached(ctx, application.NewTelegramRecordsViewedAuditEntries(*records...)...)
+	if err != nil {
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetTelegramRecordThread execution")
+	return &GetTelegramRecordThreadResponse{Records: *records}, nil
+}
@@ -0,0 +1,104 @@
+package record
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+)
+
+// MaxForwardedTelegramRecords is the amount of the latest forwarded records returned at once.
+const MaxForwardedTelegramRecords = 100
+
+var ErrForwardOriginRequired = errors.New("either the user or the chat of the forward origin is required")
+
+// GetTelegramRecordsForwardedFromRequest matches the records forwarded from a user or from a chat,
+// optionally narrowed down to a single message of that chat.
+type GetTelegramRecordsForwardedFromRequest struct {
+	Origin domain.TelegramForwardOrigin
+}
+
+type GetTelegramRecordsForwardedFromResponse struct {
+	Records []domain.TelegramRecord
+}
+
+type GetTelegramRecordsForwardedFrom struct {
+	transactionManagerFactory       interfaces.TransactionManagerFactory
+	telegramRecordRepositoryFactory repository.TelegramRecordRepositoryFactory
+	auditClient                     auditclient.AuditClient
+	logger                          *slog.Logger
+}
+
+func NewGetTelegramRecordsForwardedFrom(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramRecordRepositoryFactory repository.TelegramRecordRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *GetTelegramRecordsForwardedFrom {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "get_telegram_records_forwarded_from"),
+	)
+	return &GetTelegramRecordsForwardedFrom{
+		transactionManagerFactory:       transactionManagerFactory,
+		telegramRecordRepositoryFactory: telegramRecordRepositoryFactory,
+		auditClient:                     auditClient,
+		logger:                          iLogger,
+	}
+}
+
+func (interactor *GetTelegramRecordsForwardedFrom) Execute(
+	ctx context.Context,
+	input GetTelegramRecordsForwardedFromRequest,
+) (*GetTelegramRecordsForwardedFromResponse, error) {
+	interactor.logger.DebugContext(ctx, "Started GetTelegramRecordsForwardedFrom execution")
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if input.Origin.FromUserTelegramID == nil && input.Origin.FromChatTelegramID == nil {
+		return nil, ErrForwardOriginRequired
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer func() {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+	}()
+
+	recordRepository := interactor.telegramRecordRepositoryFactory.CreateTelegramRecordRepositoryWithTransaction(
+		transactionManager,
+	)
+	records, err := recordRepository.GetTelegramRecordsForwardedFrom(ctx, input.Origin, MaxForwardedTelegramRecords)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get forwarded telegram records", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	// The records are only shown once the viewing has been logged
+	err = interactor.auditClient.RecordDetached(ctx, application.NewTelegramRecordsViewedAuditEntries(*records...)...)
+	if err != nil {
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetTelegramRecordsForwardedFrom execution")
+	return &GetTelegramRecordsForwardedFromResponse{Records: *records}, nil
+}
@@ -0,0 +1,146 @@
+package record
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type MarkTelegramRecordDeletedRequest struct {
+	RecordID uuid.UUID
+	// DeletedAt is the time the deletion has been noticed at, now when it isn't known
+	DeletedAt *time.Time
+}
+
+type MarkTelegramRecordDeletedResponse struct {
+	DeletedAt time.Time
+}
+
+// MarkTelegramRecordDeleted marks a record whose message has been deleted in Telegram.
+// The archived content is kept, only the user who has added the record or an admin may mark it.
+type MarkTelegramRecordDeleted struct {
+	transactionManagerFactory       interfaces.TransactionManagerFactory
+	telegramRecordRepositoryFactory repository.TelegramRecordRepositoryFactory
+	auditClient                     auditclient.AuditClient
+	logger                          *slog.Logger
+}
+
+func NewMarkTelegramRecordDeleted(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramRecordRepositoryFactory repository.TelegramRecordRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *MarkTelegramRecordDeleted {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "mark_telegram_record_deleted"),
+	)
+	return &MarkTelegramRecordDeleted{
+		transactionManagerFactory:       transactionManagerFactory,
+		telegramRecordRepositoryFactory: telegramRecordRepositoryFactory,
+		auditClient:                     auditClient,
+		logger:                          iLogger,
+	}
+}
+
+func (interactor *MarkTelegramRecordDeleted) Execute(
+	ctx context.Context,
+	input MarkTelegramRecordDeletedRequest,
+) (*MarkTelegramRecordDeletedResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	interactor.logger.DebugContext(
+		ctx,
+		"Started MarkTelegramRecordDeleted execution",
+		slog.String("record_id", input.RecordID.String()),
+	)
+	deletedAt := time.Now()
+	if input.DeletedAt != nil {
+		deletedAt = *input.DeletedAt
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	recordRepository := interactor.telegramRecordRepositoryFactory.CreateTelegramRecordRepositoryWithTransaction(
+		transactionManager,
+	)
+	telegramRecord, err := recordRepository.GetTelegramRecordByID(ctx, input.RecordID)
+	if err != nil {
+		interactor.rollback(ctx, transactionManager)
+		return nil, interactor.mapRepositoryError(ctx, err)
+	}
+	if telegramRecord.AddedByUser != idp.UserID && rbac.AuthorizeByRole(idp, userDomain.RoleAdmin) != nil {
+		interactor.rollback(ctx, transactionManager)
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+	// The first noticed deletion is kept
+	if telegramRecord.DeletedAt != nil {
+		interactor.rollback(ctx, transactionManager)
+		return &MarkTelegramRecordDeletedResponse{DeletedAt: *telegramRecord.DeletedAt}, nil
+	}
+	if err = recordRepository.MarkTelegramRecordDeleted(ctx, input.RecordID, deletedAt); err != nil {
+		interactor.rollback(ctx, transactionManager)
+		return nil, interactor.mapRepositoryError(ctx, err)
+	}
+	deletedRecord := *telegramRecord
+	deletedRecord.DeletedAt = &deletedAt
+	entry := application.NewTelegramAuditEntry(
+		auditclient.TelegramRecordDeleted,
+		application.AuditTargetTelegramRecord,
+		input.RecordID,
+		telegramRecord,
+		deletedRecord,
+	)
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		interactor.rollback(ctx, transactionManager)
+		return nil, interactor.mapRepositoryError(ctx, err)
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished MarkTelegramRecordDeleted execution")
+	return &MarkTelegramRecordDeletedResponse{DeletedAt: deletedAt}, nil
+}
+
+func (interactor *MarkTelegramRecordDeleted) mapRepositoryError(ctx context.Context, err error) error {
+	if errors.Is(err, domain.ErrRecordNotFound) {
+		interactor.logger.DebugContext(ctx, "telegram record not found", slog.Any("err", err))
+		return err
+	}
+	interactor.logger.ErrorContext(ctx, "failed to mark telegram record deleted", slog.Any("err", err))
+	return application.ErrDatabaseFailed
+}
+
+func (interactor *MarkTelegramRecordDeleted) rollback(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+) {
+	if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+		interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+	}
+}
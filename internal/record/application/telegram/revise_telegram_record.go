@@ -0,0 +1,87 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/google/uuid"
+)
+
+// ReviseTelegramRecord handles a resubmission of an already stored message.
+// The version with the latest EditedAt becomes the current one of the record and the other one is kept
+// as a revision, so edits may arrive in any order. A version without a known edit date is dated by PostedAt,
+// so it never supersedes a dated edit and the outcome doesn't depend on when the versions arrive.
+// It returns the stored record as it was before the resubmission, along with domain.ErrRecordAlreadyExists
+// when the content hasn't changed or that version is already known.
+// The stored record is the canonical one of the message, whichever collector has added it.
+// A stored record the resubmitter doesn't see is neither revised nor returned, only domain.ErrRecordAlreadyExists is.
+func ReviseTelegramRecord(
+	ctx context.Context,
+	recordRepository repository.TelegramRecordRepository,
+	resubmitted domain.TelegramRecord,
+) (*domain.TelegramRecord, error) {
+	stored, err := recordRepository.GetTelegramRecordByMessageTelegramID(
+		ctx,
+		resubmitted.MessageTelegramID,
+		resubmitted.InTelegramChatID,
+	)
+	if errors.Is(err, domain.ErrRecordNotFound) {
+		return nil, domain.ErrRecordAlreadyExists
+	}
+	if err != nil {
+		return nil, err
+	}
+	if stored.SameContent(&resubmitted) {
+		return stored, domain.ErrRecordAlreadyExists
+	}
+
+	editedAt := resubmitted.PostedAt
+	if resubmitted.EditedAt != nil {
+		editedAt = *resubmitted.EditedAt
+	}
+	storedAt := stored.PostedAt
+	if stored.EditedAt != nil {
+		storedAt = *stored.EditedAt
+	}
+
+	revision := &domain.TelegramRecordRevision{
+		ID:              uuid.New(),
+		RecordID:        stored.ID,
+		MessageText:     resubmitted.MessageText,
+		Entities:        resubmitted.Entities,
+		Caption:         resubmitted.Caption,
+		CaptionEntities: resubmitted.CaptionEntities,
+		EditedAt:        &editedAt,
+		AddedAt:         resubmitted.AddedAt,
+		AddedByUser:     resubmitted.AddedByUser,
+	}
+	if !editedAt.After(storedAt) {
+		// An older version has arrived late, the current one stays
+		if err = recordRepository.AddTelegramRecordRevision(ctx, revision); err != nil {
+			if errors.Is(err, domain.ErrRevisionAlreadyExists) {
+				return stored, domain.ErrRecordAlreadyExists
+			}
+			return nil, err
+		}
+		return stored, nil
+	}
+
+	// The superseded content is kept as it has been stored, along with who has added it and when
+	revision.MessageText = stored.MessageText
+	revision.Entities = stored.Entities
+	revision.Caption = stored.Caption
+	revision.CaptionEntities = stored.CaptionEntities
+	revision.EditedAt = stored.EditedAt
+	revision.AddedAt = stored.AddedAt
+	revision.AddedByUser = stored.AddedByUser
+	if err = recordRepository.AddTelegramRecordRevision(ctx, revision); err != nil &&
+		!errors.Is(err, domain.ErrRevisionAlreadyExists) {
+		return nil, err
+	}
+	if err = recordRepository.UpdateTelegramRecordContent(ctx, stored.ID, resubmitted, editedAt); err != nil {
+		return nil, err
+	}
+	return stored, nil
+}
@@ -0,0 +1,183 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/google/uuid"
+)
+
+// revisionRepository holds a single stored record and the writes made to it.
+type revisionRepository struct {
+	repository.TelegramRecordRepository
+	stored    domain.TelegramRecord
+	revisions []domain.TelegramRecordRevision
+}
+
+func (repo *revisionRepository) GetTelegramRecordByMessageTelegramID(
+	_ context.Context,
+	messageTelegramID uint64,
+	chatTelegramID int64,
+) (*domain.TelegramRecord, error) {
+	if messageTelegramID != repo.stored.MessageTelegramID || chatTelegramID != repo.stored.InTelegramChatID {
+		return nil, domain.ErrRecordNotFound
+	}
+	stored := repo.stored
+	return &stored, nil
+}
+
+func (repo *revisionRepository) AddTelegramRecordRevision(
+	_ context.Context,
+	revision *domain.TelegramRecordRevision,
+) error {
+	for _, known := range repo.revisions {
+		if known.EditedAt != nil && revision.EditedAt != nil && known.EditedAt.Equal(*revision.EditedAt) {
+			return domain.ErrRevisionAlreadyExists
+		}
+	}
+	repo.revisions = append(repo.revisions, *revision)
+	return nil
+}
+
+func (repo *revisionRepository) UpdateTelegramRecordContent(
+	_ context.Context,
+	recordID uuid.UUID,
+	content domain.TelegramRecord,
+	editedAt time.Time,
+) error {
+	if recordID != repo.stored.ID {
+		return domain.ErrRecordNotFound
+	}
+	repo.stored.MessageText = content.MessageText
+	repo.stored.Entities = content.Entities
+	repo.stored.Caption = content.Caption
+	repo.stored.CaptionEntities = content.CaptionEntities
+	repo.stored.EditedAt = &editedAt
+	return nil
+}
+
+func TestReviseTelegramRecord(t *testing.T) {
+	postedAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	at := func(minutes int) *time.Time {
+		date := postedAt.Add(time.Duration(minutes) * time.Minute)
+		return &date
+	}
+	cases := map[string]struct {
+		storedText     string
+		storedEditedAt *time.Time
+		known          []domain.TelegramRecordRevision
+		text           string
+		editedAt       *time.Time
+		err            error
+		currentText    string
+		revisionText   string
+		// storedRevision is set when the revision holds the stored content, added by the collector of the record
+		storedRevision bool
+	}{
+		"identical resubmission": {
+			storedText:  "hello",
+			text:        "hello",
+			err:         domain.ErrRecordAlreadyExists,
+			currentText: "hello",
+		},
+		"superseding edit": {
+			storedText:     "hello",
+			text:           "hello, world",
+			editedAt:       at(5),
+			currentText:    "hello, world",
+			revisionText:   "hello",
+			storedRevision: true,
+		},
+		"late older version": {
+			storedText:     "third",
+			storedEditedAt: at(10),
+			text:           "second",
+			editedAt:       at(5),
+			currentText:    "third",
+			revisionText:   "second",
+		},
+		"known older version": {
+			storedText:     "third",
+			storedEditedAt: at(10),
+			known:          []domain.TelegramRecordRevision{{MessageText: "second", EditedAt: at(5)}},
+			text:           "second",
+			editedAt:       at(5),
+			err:            domain.ErrRecordAlreadyExists,
+			currentText:    "third",
+		},
+		"undated version after an edit": {
+			storedText:     "edited",
+			storedEditedAt: at(5),
+			text:           "original",
+			currentText:    "edited",
+			revisionText:   "original",
+		},
+	}
+	for name, tc := range cases {
+		stored := domain.TelegramRecord{
+			ID:                uuid.New(),
+			MessageTelegramID: 7,
+			InTelegramChatID:  -100123,
+			MessageText:       tc.storedText,
+			PostedAt:          postedAt,
+			EditedAt:          tc.storedEditedAt,
+			AddedAt:           postedAt.Add(time.Hour),
+			AddedByUser:       uuid.New(),
+		}
+		repo := &revisionRepository{stored: stored, revisions: tc.known}
+		resubmitted := domain.TelegramRecord{
+			ID:                uuid.New(),
+			MessageTelegramID: 7,
+			InTelegramChatID:  -100123,
+			MessageText:       tc.text,
+			PostedAt:          postedAt,
+			EditedAt:          tc.editedAt,
+			AddedAt:           time.Now(),
+			AddedByUser:       uuid.New(),
+		}
+
+		revised, err := application.ReviseTelegramRecord(context.Background(), repo, resubmitted)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%s: expected %v, got %v", name, tc.err, err)
+			continue
+		}
+		if err == nil && revised.ID != stored.ID {
+			t.Errorf("%s: expected the stored record %s to be revised, got %s", name, stored.ID, revised.ID)
+		}
+		if repo.stored.MessageText != tc.currentText {
+			t.Errorf("%s: expected the current text %q, got %q", name, tc.currentText, repo.stored.MessageText)
+		}
+		added := repo.revisions[len(tc.known):]
+		switch {
+		case tc.revisionText == "" && len(added) != 0:
+			t.Errorf("%s: expected no revision to be added, got %+v", name, added)
+		case tc.revisionText != "" && (len(added) != 1 || added[0].MessageText != tc.revisionText):
+			t.Errorf("%s: expected a revision of %q, got %+v", name, tc.revisionText, added)
+		case tc.storedRevision && (added[0].AddedByUser != stored.AddedByUser || !added[0].AddedAt.Equal(stored.AddedAt)):
+			t.Errorf("%s: expected the stored content to keep its provenance, got %+v", name, added[0])
+		case tc.revisionText != "" && !tc.storedRevision && added[0].AddedByUser != resubmitted.AddedByUser:
+			t.Errorf("%s: expected the resubmitted content to be added by its collector, got %+v", name, added[0])
+		}
+	}
+}
+
+// A message the lookup doesn't find is stored by a collector the resubmitter doesn't see
+func TestReviseTelegramRecord_InvisibleMessage(t *testing.T) {
+	repo := &revisionRepository{stored: domain.TelegramRecord{ID: uuid.New(), MessageTelegramID: 7}}
+	stored, err := application.ReviseTelegramRecord(
+		context.Background(),
+		repo,
+		domain.TelegramRecord{MessageTelegramID: 8, MessageText: "hello"},
+	)
+	if !errors.Is(err, domain.ErrRecordAlreadyExists) {
+		t.Errorf("expected %v, got %v", domain.ErrRecordAlreadyExists, err)
+	}
+	if stored != nil || len(repo.revisions) != 0 {
+		t.Errorf("expected the invisible record to be left alone, got %+v and %+v", stored, repo.revisions)
+	}
+}
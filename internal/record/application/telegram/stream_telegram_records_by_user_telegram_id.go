@@ -0,0 +1,120 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+)
+
+// StreamTelegramRecordsByUserTelegramIDRequest passes the records to Yield as they're read,
+// an error returned by Yield stops the stream and is returned as it is.
+type StreamTelegramRecordsByUserTelegramIDRequest struct {
+	UserTelegramID uint64
+	// Tag only keeps the records holding it when it's set.
+	Tag   string
+	Yield func(*domain.TelegramRecord) error
+}
+
+type StreamTelegramRecordsByUserTelegramIDResponse struct {
+	Streamed int64
+}
+
+// StreamTelegramRecordsByUserTelegramID reads every record of a user, the latest first, without holding them
+// in memory. It's meant for exports of the records, GetLatestTelegramRecordsByUserTelegramID previews them.
+type StreamTelegramRecordsByUserTelegramID struct {
+	transactionManagerFactory interfaces.TransactionManagerFactory
+	telegramRecordFactory     repository.TelegramRecordRepositoryFactory
+	auditClient               auditclient.AuditClient
+	logger                    *slog.Logger
+}
+
+func NewStreamTelegramRecordsByUserTelegramID(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramRecordFactory repository.TelegramRecordRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *StreamTelegramRecordsByUserTelegramID {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "stream_telegram_records_by_user_telegram_id"),
+	)
+	return &StreamTelegramRecordsByUserTelegramID{
+		transactionManagerFactory: transactionManagerFactory,
+		telegramRecordFactory:     telegramRecordFactory,
+		auditClient:               auditClient,
+		logger:                    iLogger,
+	}
+}
+
+func (interactor *StreamTelegramRecordsByUserTelegramID) Execute(
+	ctx context.Context,
+	input StreamTelegramRecordsByUserTelegramIDRequest,
+) (*StreamTelegramRecordsByUserTelegramIDResponse, error) {
+	interactor.logger.DebugContext(
+		ctx,
+		"Started StreamTelegramRecordsByUserTelegramID execution",
+		slog.Uint64("user_telegram_id", input.UserTelegramID),
+	)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+	tag, err := NormalizeTelegramTagFilter(input.Tag)
+	if err != nil {
+		return nil, err
+	}
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, ErrDatabaseFailed
+	}
+	defer func() {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+	}()
+
+	recordRepository := interactor.telegramRecordFactory.CreateTelegramRecordRepositoryWithTransaction(
+		transactionManager,
+	)
+	// The records are only shown once their viewing has been logged
+	viewLog := NewTelegramRecordViewLog(interactor.auditClient, input.Yield)
+	var streamed int64
+	err = recordRepository.StreamTelegramRecordsByUserTelegramID(
+		ctx,
+		input.UserTelegramID,
+		tag,
+		func(record *domain.TelegramRecord) error {
+			streamed++
+			return viewLog.Yield(ctx, record)
+		},
+	)
+	if err == nil {
+		err = viewLog.Flush(ctx)
+	}
+	if err != nil {
+		if errors.Is(err, repository.ErrDatabaseFailed) {
+			return nil, ErrDatabaseFailed
+		}
+		return nil, err
+	}
+
+	interactor.logger.DebugContext(
+		ctx,
+		"Finished StreamTelegramRecordsByUserTelegramID execution",
+		slog.Int64("streamed", streamed),
+	)
+	return &StreamTelegramRecordsByUserTelegramIDResponse{Streamed: streamed}, nil
+}
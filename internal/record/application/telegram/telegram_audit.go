@@ -0,0 +1,105 @@
+package application
+
+import (
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/google/uuid"
+)
+
+// Target types of the audit entries about the telegram data, the states kept are the domain models.
+const (
+	AuditTargetTelegramUser           = "telegram_user"
+	AuditTargetTelegramChat           = "telegram_chat"
+	AuditTargetTelegramChatSnapshot   = "telegram_chat_snapshot"
+	AuditTargetTelegramIdentity       = "telegram_identity"
+	AuditTargetTelegramRecord         = "telegram_record"
+	AuditTargetTelegramAttachment     = "telegram_attachment"
+	AuditTargetTelegramProfilePicture = "telegram_profile_picture"
+	AuditTargetTelegramWatchlist      = "telegram_watchlist"
+	AuditTargetTelegramWatchlistEntry = "telegram_watchlist_entry"
+	AuditTargetTelegramAlert          = "telegram_alert"
+	AuditTargetTelegramExport         = "telegram_export"
+
+	AuditTargetTelegramInvestigation       = "telegram_investigation"
+	AuditTargetTelegramInvestigationMember = "telegram_investigation_member"
+	AuditTargetTelegramInvestigationItem   = "telegram_investigation_item"
+
+	AuditTargetTelegramTag  = "telegram_tag"
+	AuditTargetTelegramNote = "telegram_note"
+
+	AuditTargetTelegramObservation = "telegram_observation"
+)
+
+// NewTelegramRecordsAddedAuditEntries returns an entry for each of the newly added records.
+func NewTelegramRecordsAddedAuditEntries(records ...domain.TelegramRecord) []auditclient.Entry {
+	entries := make([]auditclient.Entry, len(records))
+	for i, record := range records {
+		entries[i] = auditclient.Entry{
+			Action:     auditclient.TelegramRecordAdded,
+			TargetType: AuditTargetTelegramRecord,
+			TargetID:   record.ID.String(),
+			After:      record,
+		}
+	}
+	return entries
+}
+
+// NewTelegramRecordsViewedAuditEntries returns an entry for each of the records shown to the user,
+// so the log tells who has seen a record.
+func NewTelegramRecordsViewedAuditEntries(records ...domain.TelegramRecord) []auditclient.Entry {
+	entries := make([]auditclient.Entry, len(records))
+	for i, record := range records {
+		entries[i] = auditclient.Entry{
+			Action:     auditclient.TelegramRecordViewed,
+			TargetType: AuditTargetTelegramRecord,
+			TargetID:   record.ID.String(),
+		}
+	}
+	return entries
+}
+
+// NewTelegramExportViewedAuditEntry returns the entry of the records handed over by the download of
+// an export. The bundle doesn't list its records, the entry holds the selection they've been read by instead.
+func NewTelegramExportViewedAuditEntry(export *domain.TelegramExport) auditclient.Entry {
+	return auditclient.Entry{
+		Action:     auditclient.TelegramRecordViewed,
+		TargetType: AuditTargetTelegramExport,
+		TargetID:   export.ID.String(),
+		After: struct {
+			Selection domain.TelegramExportSelection
+			Progress  domain.TelegramExportProgress
+		}{export.Selection, export.Progress},
+	}
+}
+
+// NewTelegramRecordRevisedAuditEntry returns the entry of a resubmission handled by ReviseTelegramRecord,
+// stored is the record it has returned.
+func NewTelegramRecordRevisedAuditEntry(
+	stored *domain.TelegramRecord,
+	resubmitted domain.TelegramRecord,
+) auditclient.Entry {
+	resubmitted.ID = stored.ID
+	return auditclient.Entry{
+		Action:     auditclient.TelegramRecordRevised,
+		TargetType: AuditTargetTelegramRecord,
+		TargetID:   stored.ID.String(),
+		Before:     stored,
+		After:      resubmitted,
+	}
+}
+
+// NewTelegramAuditEntry returns the entry of a change of any other telegram data.
+func NewTelegramAuditEntry(
+	action auditclient.Action,
+	targetType string,
+	targetID uuid.UUID,
+	before, after any,
+) auditclient.Entry {
+	return auditclient.Entry{
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID.String(),
+		Before:     before,
+		After:      after,
+	}
+}
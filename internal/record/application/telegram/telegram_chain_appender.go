@@ -0,0 +1,206 @@
+package application
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/google/uuid"
+)
+
+// TelegramChainAppender links the archived records, identities and attachments to the hash chain
+// of their collector, the user who has added them.
+type TelegramChainAppender struct {
+	telegramChainRepositoryFactory      repository.TelegramChainRepositoryFactory
+	telegramRecordRepositoryFactory     repository.TelegramRecordRepositoryFactory
+	telegramIdentityRepositoryFactory   repository.TelegramIdentityRepositoryFactory
+	telegramAttachmentRepositoryFactory repository.TelegramAttachmentRepositoryFactory
+}
+
+func NewTelegramChainAppender(
+	telegramChainRepositoryFactory repository.TelegramChainRepositoryFactory,
+	telegramRecordRepositoryFactory repository.TelegramRecordRepositoryFactory,
+	telegramIdentityRepositoryFactory repository.TelegramIdentityRepositoryFactory,
+	telegramAttachmentRepositoryFactory repository.TelegramAttachmentRepositoryFactory,
+) *TelegramChainAppender {
+	return &TelegramChainAppender{
+		telegramChainRepositoryFactory:      telegramChainRepositoryFactory,
+		telegramRecordRepositoryFactory:     telegramRecordRepositoryFactory,
+		telegramIdentityRepositoryFactory:   telegramIdentityRepositoryFactory,
+		telegramAttachmentRepositoryFactory: telegramAttachmentRepositoryFactory,
+	}
+}
+
+// chainedSubject is the content hash of a subject along with the chain it belongs to.
+type chainedSubject struct {
+	collectorUserID uuid.UUID
+	id              uuid.UUID
+	contentHash     string
+}
+
+// AppendRecords runs in the transaction the records have been stored or revised in. They are read back,
+// so that the hashed content is the one the verification reads. A record whose content hasn't changed since
+// its latest entry, e.g. when an older version has arrived late, isn't appended again.
+func (appender *TelegramChainAppender) AppendRecords(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	recordIDs ...uuid.UUID,
+) error {
+	return appender.append(ctx, transactionManager, domain.TelegramChainSubjectRecord, recordIDs)
+}
+
+// AppendIdentities runs in the transaction the identities have been added in.
+func (appender *TelegramChainAppender) AppendIdentities(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	identityIDs ...uuid.UUID,
+) error {
+	return appender.append(ctx, transactionManager, domain.TelegramChainSubjectIdentity, identityIDs)
+}
+
+// AppendAttachments runs in the transaction the attachments have been added in.
+func (appender *TelegramChainAppender) AppendAttachments(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	attachmentIDs ...uuid.UUID,
+) error {
+	return appender.append(ctx, transactionManager, domain.TelegramChainSubjectAttachment, attachmentIDs)
+}
+
+// ContentHashes returns the hash of the current content of the given subjects, the ones that don't exist
+// are left out.
+func (appender *TelegramChainAppender) ContentHashes(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	subjectType domain.TelegramChainSubjectType,
+	subjectIDs []uuid.UUID,
+) (map[uuid.UUID]string, error) {
+	subjects, err := appender.readSubjects(ctx, transactionManager, subjectType, subjectIDs)
+	if err != nil {
+		return nil, err
+	}
+	contentHashes := make(map[uuid.UUID]string, len(subjects))
+	for _, subject := range subjects {
+		contentHashes[subject.id] = subject.contentHash
+	}
+	return contentHashes, nil
+}
+
+func (appender *TelegramChainAppender) readSubjects(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	subjectType domain.TelegramChainSubjectType,
+	subjectIDs []uuid.UUID,
+) ([]chainedSubject, error) {
+	if len(subjectIDs) == 0 {
+		return nil, nil
+	}
+	var subjects []chainedSubject
+	switch subjectType {
+	case domain.TelegramChainSubjectRecord:
+		records, err := appender.telegramRecordRepositoryFactory.CreateTelegramRecordRepositoryWithTransaction(
+			transactionManager,
+		).GetTelegramRecordsByIDs(ctx, subjectIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range *records {
+			subjects = append(subjects, chainedSubject{
+				record.AddedByUser,
+				record.ID,
+				service.HashTelegramRecord(&record),
+			})
+		}
+	case domain.TelegramChainSubjectIdentity:
+		identities, err := appender.telegramIdentityRepositoryFactory.CreateTelegramIdentityRepositoryWithTransaction(
+			transactionManager,
+		).GetIdentitiesByIDs(ctx, subjectIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, identity := range *identities {
+			subjects = append(subjects, chainedSubject{
+				identity.AddedByUser,
+				identity.ID,
+				service.HashTelegramIdentity(&identity),
+			})
+		}
+	case domain.TelegramChainSubjectAttachment:
+		attachments, err := appender.telegramAttachmentRepositoryFactory.
+			CreateTelegramAttachmentRepositoryWithTransaction(transactionManager).
+			GetAttachmentsByIDs(ctx, subjectIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, attachment := range *attachments {
+			subjects = append(subjects, chainedSubject{
+				attachment.AddedByUser,
+				attachment.ID,
+				service.HashTelegramAttachment(&attachment),
+			})
+		}
+	}
+	return subjects, nil
+}
+
+func (appender *TelegramChainAppender) append(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	subjectType domain.TelegramChainSubjectType,
+	subjectIDs []uuid.UUID,
+) error {
+	subjects, err := appender.readSubjects(ctx, transactionManager, subjectType, subjectIDs)
+	if err != nil || len(subjects) == 0 {
+		return err
+	}
+	chainRepository := appender.telegramChainRepositoryFactory.CreateTelegramChainRepositoryWithTransaction(
+		transactionManager,
+	)
+	latestHashes, err := chainRepository.GetLatestTelegramChainContentHashes(ctx, subjectType, subjectIDs)
+	if err != nil {
+		return err
+	}
+	subjectsByCollector := make(map[uuid.UUID][]chainedSubject)
+	for _, subject := range subjects {
+		if latestHashes[subject.id] == subject.contentHash {
+			continue
+		}
+		subjectsByCollector[subject.collectorUserID] = append(subjectsByCollector[subject.collectorUserID], subject)
+	}
+
+	// The heads are locked in the same order by every transaction, so that they can't deadlock
+	collectorUserIDs := make([]uuid.UUID, 0, len(subjectsByCollector))
+	for collectorUserID := range subjectsByCollector {
+		collectorUserIDs = append(collectorUserIDs, collectorUserID)
+	}
+	slices.SortFunc(collectorUserIDs, func(a, b uuid.UUID) int { return slices.Compare(a[:], b[:]) })
+
+	addedAt := time.Now()
+	var entries []domain.TelegramChainEntry
+	for _, collectorUserID := range collectorUserIDs {
+		head, err := chainRepository.LockTelegramChainHead(ctx, collectorUserID)
+		if err != nil {
+			return err
+		}
+		for _, subject := range subjectsByCollector[collectorUserID] {
+			entry := domain.TelegramChainEntry{
+				CollectorUserID: collectorUserID,
+				Sequence:        head.Sequence + 1,
+				SubjectType:     subjectType,
+				SubjectID:       subject.id,
+				ContentHash:     subject.contentHash,
+				PreviousHash:    head.EntryHash,
+				AddedAt:         addedAt,
+			}
+			entry.EntryHash = service.HashTelegramChainEntry(&entry)
+			head.Sequence = entry.Sequence
+			head.EntryHash = entry.EntryHash
+			entries = append(entries, entry)
+		}
+	}
+	return chainRepository.AppendTelegramChainEntries(ctx, entries)
+}
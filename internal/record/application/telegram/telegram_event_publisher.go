@@ -0,0 +1,118 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/event/client"
+	"github.com/google/uuid"
+)
+
+// TelegramRecordEvent is the data of the telegram_record.created event.
+type TelegramRecordEvent struct {
+	ID                 uuid.UUID  `json:"id"`
+	MessageTelegramID  uint64     `json:"message_telegram_id"`
+	FromTelegramUserID uuid.UUID  `json:"from_telegram_user_id"`
+	InTelegramChatID   int64      `json:"in_telegram_chat_id"`
+	MessageText        string     `json:"message_text"`
+	Caption            string     `json:"caption,omitempty"`
+	PostedAt           time.Time  `json:"posted_at"`
+	EditedAt           *time.Time `json:"edited_at,omitempty"`
+	AddedAt            time.Time  `json:"added_at"`
+	AddedByUser        uuid.UUID  `json:"added_by_user"`
+}
+
+// TelegramIdentityEvent is the data of the telegram_identity.created event.
+type TelegramIdentityEvent struct {
+	ID          uuid.UUID `json:"id"`
+	UserID      uuid.UUID `json:"user_id"`
+	Username    string    `json:"username"`
+	FirstName   string    `json:"first_name"`
+	LastName    string    `json:"last_name,omitempty"`
+	Bio         string    `json:"bio,omitempty"`
+	PhoneNumber string    `json:"phone_number,omitempty"`
+	AddedAt     time.Time `json:"added_at"`
+	AddedByUser uuid.UUID `json:"added_by_user"`
+}
+
+// TelegramEventPublisher records the events of the newly added records and identities in the outbox.
+// It runs in the transaction they've been stored in, revisions of already stored records aren't published.
+// The events carry the observation of their collector, so that they're only handed to the users seeing it.
+type TelegramEventPublisher struct {
+	eventClient      client.EventClient
+	visibilityPolicy *TelegramVisibilityPolicy
+}
+
+func NewTelegramEventPublisher(
+	eventClient client.EventClient,
+	visibilityPolicy *TelegramVisibilityPolicy,
+) *TelegramEventPublisher {
+	return &TelegramEventPublisher{eventClient: eventClient, visibilityPolicy: visibilityPolicy}
+}
+
+func (publisher *TelegramEventPublisher) PublishRecordsCreated(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	records ...domain.TelegramRecord,
+) error {
+	for _, record := range records {
+		event := TelegramRecordEvent{
+			ID:                 record.ID,
+			MessageTelegramID:  record.MessageTelegramID,
+			FromTelegramUserID: record.FromTelegramUserID,
+			InTelegramChatID:   record.InTelegramChatID,
+			MessageText:        record.MessageText,
+			Caption:            record.Caption,
+			PostedAt:           record.PostedAt,
+			EditedAt:           record.EditedAt,
+			AddedAt:            record.AddedAt,
+			AddedByUser:        record.AddedByUser,
+		}
+		err := publisher.eventClient.RecordObserved(
+			ctx,
+			transactionManager,
+			client.TelegramRecordCreated,
+			event,
+			publisher.observedBy(record.AddedByUser),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (publisher *TelegramEventPublisher) PublishIdentityCreated(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	identity *domain.TelegramIdentity,
+) error {
+	event := TelegramIdentityEvent{
+		ID:          identity.ID,
+		UserID:      identity.UserID,
+		Username:    identity.Username,
+		FirstName:   identity.FirstName,
+		LastName:    identity.LastName,
+		Bio:         identity.Bio,
+		PhoneNumber: identity.PhoneNumber,
+		AddedAt:     identity.AddedAt,
+		AddedByUser: identity.AddedByUser,
+	}
+	return publisher.eventClient.RecordObserved(
+		ctx,
+		transactionManager,
+		client.TelegramIdentityCreated,
+		event,
+		publisher.observedBy(identity.AddedByUser),
+	)
+}
+
+// observedBy returns the observation the collector makes of the data it adds.
+func (publisher *TelegramEventPublisher) observedBy(collectorUserID uuid.UUID) client.Observation {
+	return client.Observation{
+		ObservedByUser: collectorUserID,
+		Visibility:     string(publisher.visibilityPolicy.visibility),
+	}
+}
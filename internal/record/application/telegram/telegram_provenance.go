@@ -0,0 +1,144 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/InWamos/trinity-proto/config"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+// TelegramVisibilityPolicy holds the visibility of the observations the collectors make,
+// their team observations are shared with the members of their team. The reads of the repositories filter
+// the users, identities and records by the same rules, within the transaction opened on behalf of the platform user.
+type TelegramVisibilityPolicy struct {
+	visibility domain.TelegramVisibility
+}
+
+func NewTelegramVisibilityPolicy(visibilityConfig *config.VisibilityConfig) *TelegramVisibilityPolicy {
+	return &TelegramVisibilityPolicy{
+		visibility: domain.TelegramVisibility(visibilityConfig.Visibility),
+	}
+}
+
+// Scope returns what the platform user sees of the observations, admins see all of them.
+func (policy *TelegramVisibilityPolicy) Scope(idp *client.UserIdentity) domain.TelegramVisibilityScope {
+	return domain.TelegramVisibilityScope{
+		ViewerID:     idp.UserID,
+		TeamID:       idp.TeamID,
+		Unrestricted: rbac.AuthorizeByRole(idp, userDomain.RoleAdmin) == nil,
+	}
+}
+
+// TelegramProvenanceRecorder keeps the collectors observing the canonical telegram users and records.
+// A user or a record is stored once, by the first collector adding it, the other ones only observe it.
+type TelegramProvenanceRecorder struct {
+	telegramObservationRepositoryFactory repository.TelegramObservationRepositoryFactory
+	visibilityPolicy                     *TelegramVisibilityPolicy
+}
+
+func NewTelegramProvenanceRecorder(
+	telegramObservationRepositoryFactory repository.TelegramObservationRepositoryFactory,
+	visibilityPolicy *TelegramVisibilityPolicy,
+) *TelegramProvenanceRecorder {
+	return &TelegramProvenanceRecorder{
+		telegramObservationRepositoryFactory: telegramObservationRepositoryFactory,
+		visibilityPolicy:                     visibilityPolicy,
+	}
+}
+
+// ObserveUsers runs in the transaction the canonical users have been stored or looked up in.
+// It returns the users the collector observes for the first time.
+func (recorder *TelegramProvenanceRecorder) ObserveUsers(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	collectorUserID uuid.UUID,
+	userIDs ...uuid.UUID,
+) (map[uuid.UUID]bool, error) {
+	return recorder.observe(ctx, transactionManager, domain.TelegramObservationTargetTypeUser, collectorUserID, userIDs)
+}
+
+// ObserveRecords runs in the transaction the canonical records have been stored or revised in.
+// It returns the records the collector observes for the first time.
+func (recorder *TelegramProvenanceRecorder) ObserveRecords(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	collectorUserID uuid.UUID,
+	recordIDs ...uuid.UUID,
+) (map[uuid.UUID]bool, error) {
+	return recorder.observe(
+		ctx,
+		transactionManager,
+		domain.TelegramObservationTargetTypeRecord,
+		collectorUserID,
+		recordIDs,
+	)
+}
+
+// AddUser stores the user when its telegram ID is new, otherwise the collector adding it observes the canonical
+// user. It returns the canonical user, along with domain.ErrUserAlreadyExists when the collector has already
+// observed it. The user is added in a savepoint, so that the transaction goes on when it already exists.
+func (recorder *TelegramProvenanceRecorder) AddUser(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	userRepository repository.TelegramUserRepository,
+	telegramUser *domain.TelegramUser,
+) (*domain.TelegramUser, error) {
+	canonical := telegramUser
+	err := transactionManager.InSavepoint(ctx, func() error {
+		return userRepository.AddUser(ctx, telegramUser)
+	})
+	if errors.Is(err, domain.ErrUserAlreadyExists) {
+		canonical, err = userRepository.GetByTelegramID(ctx, telegramUser.TelegramID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	observed, err := recorder.ObserveUsers(ctx, transactionManager, telegramUser.AddedByUser, canonical.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !observed[canonical.ID] {
+		return canonical, domain.ErrUserAlreadyExists
+	}
+	return canonical, nil
+}
+
+func (recorder *TelegramProvenanceRecorder) observe(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	targetType domain.TelegramObservationTargetType,
+	collectorUserID uuid.UUID,
+	targetIDs []uuid.UUID,
+) (map[uuid.UUID]bool, error) {
+	now := time.Now()
+	observations := make([]domain.TelegramObservation, len(targetIDs))
+	for i, targetID := range targetIDs {
+		observations[i] = domain.TelegramObservation{
+			ID:              uuid.New(),
+			TargetType:      targetType,
+			TargetID:        targetID,
+			CollectorUserID: collectorUserID,
+			Visibility:      recorder.visibilityPolicy.visibility,
+			ObservedAt:      now,
+		}
+	}
+	observationRepository := recorder.telegramObservationRepositoryFactory.
+		CreateTelegramObservationRepositoryWithTransaction(transactionManager)
+	observedIDs, err := observationRepository.AddTelegramObservations(ctx, observations)
+	if err != nil {
+		return nil, err
+	}
+	observed := make(map[uuid.UUID]bool, len(observedIDs))
+	for _, observedID := range observedIDs {
+		observed[observedID] = true
+	}
+	return observed, nil
+}
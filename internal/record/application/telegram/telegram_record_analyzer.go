@@ -0,0 +1,78 @@
+package application
+
+import (
+	"context"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/google/uuid"
+)
+
+// TelegramRecordAnalyzer derives the data kept alongside the stored records: the indicators their texts mention,
+// the interactions between their senders and the alerts for the watchlists they match.
+type TelegramRecordAnalyzer struct {
+	telegramIndicatorRepositoryFactory   repository.TelegramIndicatorRepositoryFactory
+	telegramInteractionRepositoryFactory repository.TelegramInteractionRepositoryFactory
+	indicatorExtractor                   *service.TelegramIndicatorExtractor
+	watchlistEvaluator                   *TelegramWatchlistEvaluator
+}
+
+func NewTelegramRecordAnalyzer(
+	telegramIndicatorRepositoryFactory repository.TelegramIndicatorRepositoryFactory,
+	telegramInteractionRepositoryFactory repository.TelegramInteractionRepositoryFactory,
+	indicatorExtractor *service.TelegramIndicatorExtractor,
+	watchlistEvaluator *TelegramWatchlistEvaluator,
+) *TelegramRecordAnalyzer {
+	return &TelegramRecordAnalyzer{
+		telegramIndicatorRepositoryFactory:   telegramIndicatorRepositoryFactory,
+		telegramInteractionRepositoryFactory: telegramInteractionRepositoryFactory,
+		indicatorExtractor:                   indicatorExtractor,
+		watchlistEvaluator:                   watchlistEvaluator,
+	}
+}
+
+// Analyze runs in the transaction the records have been stored in, so that they are never kept without their
+// derived data. The ID of a revised record has to be the one of the stored record, so that the indicators
+// of all its versions are linked to it. Analyzing a record again only adds what it hasn't been derived yet.
+func (analyzer *TelegramRecordAnalyzer) Analyze(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	records ...domain.TelegramRecord,
+) error {
+	if len(records) == 0 {
+		return nil
+	}
+	var indicators []domain.TelegramIndicator
+	for _, record := range records {
+		for _, extracted := range analyzer.indicatorExtractor.Extract(&record) {
+			indicators = append(indicators, domain.TelegramIndicator{
+				ID:          uuid.New(),
+				RecordID:    record.ID,
+				Type:        extracted.Type,
+				Value:       extracted.Value,
+				AddedAt:     record.AddedAt,
+				AddedByUser: record.AddedByUser,
+			})
+		}
+	}
+	indicatorRepository := analyzer.telegramIndicatorRepositoryFactory.CreateTelegramIndicatorRepositoryWithTransaction(
+		transactionManager,
+	)
+	if err := indicatorRepository.AddTelegramIndicators(ctx, indicators); err != nil {
+		return err
+	}
+
+	// Mentions are resolved from the indicators, so the interactions are derived after them
+	interactionRepository := analyzer.telegramInteractionRepositoryFactory.
+		CreateTelegramInteractionRepositoryWithTransaction(transactionManager)
+	recordIDs := make([]uuid.UUID, len(records))
+	for i, record := range records {
+		if err := interactionRepository.DeriveTelegramInteractions(ctx, record.ID); err != nil {
+			return err
+		}
+		recordIDs[i] = record.ID
+	}
+	return analyzer.watchlistEvaluator.EvaluateRecords(ctx, transactionManager, recordIDs...)
+}
@@ -0,0 +1,124 @@
+package application_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/google/uuid"
+)
+
+// analysisRepository keeps the writes of the analyzer in the order they're made.
+type analysisRepository struct {
+	repository.TelegramIndicatorRepository
+	repository.TelegramInteractionRepository
+	repository.TelegramAlertRepository
+	writes     []string
+	indicators []domain.TelegramIndicator
+	derived    []uuid.UUID
+	evaluated  []uuid.UUID
+}
+
+func (repo *analysisRepository) AddTelegramIndicators(_ context.Context, indicators []domain.TelegramIndicator) error {
+	repo.writes = append(repo.writes, "indicators")
+	repo.indicators = append(repo.indicators, indicators...)
+	return nil
+}
+
+func (repo *analysisRepository) DeriveTelegramInteractions(_ context.Context, recordID uuid.UUID) error {
+	repo.writes = append(repo.writes, "interactions")
+	repo.derived = append(repo.derived, recordID)
+	return nil
+}
+
+func (repo *analysisRepository) AddTelegramAlertsForRecords(_ context.Context, recordIDs []uuid.UUID) error {
+	repo.writes = append(repo.writes, "alerts")
+	repo.evaluated = append(repo.evaluated, recordIDs...)
+	return nil
+}
+
+func (repo *analysisRepository) CreateTelegramIndicatorRepositoryWithTransaction(
+	interfaces.TransactionManager,
+) repository.TelegramIndicatorRepository {
+	return repo
+}
+
+func (repo *analysisRepository) CreateTelegramInteractionRepositoryWithTransaction(
+	interfaces.TransactionManager,
+) repository.TelegramInteractionRepository {
+	return repo
+}
+
+func (repo *analysisRepository) CreateTelegramAlertRepositoryWithTransaction(
+	interfaces.TransactionManager,
+) repository.TelegramAlertRepository {
+	return repo
+}
+
+func TestTelegramRecordAnalyzer_Analyze(t *testing.T) {
+	record := func(text string) domain.TelegramRecord {
+		return domain.TelegramRecord{
+			ID:          uuid.New(),
+			MessageText: text,
+			AddedAt:     time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+			AddedByUser: uuid.New(),
+		}
+	}
+	cases := map[string]struct {
+		records    []domain.TelegramRecord
+		indicators int
+		writes     []string
+	}{
+		"no records": {},
+		"record without indicators": {
+			records: []domain.TelegramRecord{record("hello")},
+			writes:  []string{"indicators", "interactions", "alerts"},
+		},
+		// Mentions are resolved from the indicators, so they're stored before the interactions are derived
+		// and the watchlists are matched
+		"records with mentions": {
+			records:    []domain.TelegramRecord{record("hi @alice_doe"), record("and @bob_doe #news")},
+			indicators: 3,
+			writes:     []string{"indicators", "interactions", "interactions", "alerts"},
+		},
+	}
+	for name, tc := range cases {
+		repo := &analysisRepository{}
+		analyzer := application.NewTelegramRecordAnalyzer(
+			repo,
+			repo,
+			service.NewTelegramIndicatorExtractor(),
+			application.NewTelegramWatchlistEvaluator(repo),
+		)
+		if err := analyzer.Analyze(context.Background(), nil, tc.records...); err != nil {
+			t.Errorf("%s: unexpected error %v", name, err)
+			continue
+		}
+		if !slices.Equal(repo.writes, tc.writes) {
+			t.Errorf("%s: expected the writes %v, got %v", name, tc.writes, repo.writes)
+		}
+		if len(repo.indicators) != tc.indicators {
+			t.Errorf("%s: expected %d indicators, got %+v", name, tc.indicators, repo.indicators)
+		}
+		for _, indicator := range repo.indicators {
+			i := slices.IndexFunc(tc.records, func(r domain.TelegramRecord) bool { return r.ID == indicator.RecordID })
+			if i < 0 || indicator.AddedByUser != tc.records[i].AddedByUser || !indicator.AddedAt.Equal(tc.records[i].AddedAt) {
+				t.Errorf("%s: expected the indicator to be linked to its record, got %+v", name, indicator)
+			}
+		}
+		for i, recordID := range repo.derived {
+			if recordID != tc.records[i].ID {
+				t.Errorf("%s: expected the interactions of %s to be derived, got %s", name, tc.records[i].ID, recordID)
+			}
+		}
+		if len(repo.evaluated) != len(tc.records) {
+			t.Errorf("%s: expected all records to be matched against the watchlists, got %v", name, repo.evaluated)
+		}
+	}
+}
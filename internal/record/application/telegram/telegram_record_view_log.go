@@ -0,0 +1,59 @@
+package application
+
+import (
+	"context"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+)
+
+// viewLogPageSize is the amount of streamed records logged as viewed in a single audit transaction.
+const viewLogPageSize = 500
+
+// TelegramRecordViewLog holds back the records of a stream until their viewing has been logged, a page
+// at a time, so a streamed record is never shown unless it's in the audit log. Flush must be called once
+// the stream has ended, for the records of the last page.
+type TelegramRecordViewLog struct {
+	auditClient auditclient.AuditClient
+	yield       func(*domain.TelegramRecord) error
+	pending     []domain.TelegramRecord
+}
+
+func NewTelegramRecordViewLog(
+	auditClient auditclient.AuditClient,
+	yield func(*domain.TelegramRecord) error,
+) *TelegramRecordViewLog {
+	return &TelegramRecordViewLog{
+		auditClient: auditClient,
+		yield:       yield,
+		pending:     make([]domain.TelegramRecord, 0, viewLogPageSize),
+	}
+}
+
+// Yield takes the next record of the stream, the record is copied as the stream may reuse it.
+func (viewLog *TelegramRecordViewLog) Yield(ctx context.Context, record *domain.TelegramRecord) error {
+	viewLog.pending = append(viewLog.pending, *record)
+	if len(viewLog.pending) < viewLogPageSize {
+		return nil
+	}
+	return viewLog.Flush(ctx)
+}
+
+// Flush logs the pending records as viewed and passes them on. It returns ErrDatabaseFailed if they couldn't
+// be logged, the errors of the yield are returned as they are.
+func (viewLog *TelegramRecordViewLog) Flush(ctx context.Context) error {
+	if len(viewLog.pending) == 0 {
+		return nil
+	}
+	err := viewLog.auditClient.RecordDetached(ctx, NewTelegramRecordsViewedAuditEntries(viewLog.pending...)...)
+	if err != nil {
+		return ErrDatabaseFailed
+	}
+	for i := range viewLog.pending {
+		if err = viewLog.yield(&viewLog.pending[i]); err != nil {
+			return err
+		}
+	}
+	viewLog.pending = viewLog.pending[:0]
+	return nil
+}
@@ -0,0 +1,100 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/google/uuid"
+)
+
+// viewAuditClient fails with err if it's set, pages keeps the amount of records of each logged page.
+type viewAuditClient struct {
+	auditclient.AuditClient
+	err   error
+	pages *[]int
+}
+
+func (ac viewAuditClient) RecordDetached(_ context.Context, entries ...auditclient.Entry) error {
+	if ac.err != nil {
+		return ac.err
+	}
+	for _, entry := range entries {
+		if entry.Action != auditclient.TelegramRecordViewed || entry.TargetType != application.AuditTargetTelegramRecord {
+			return fmt.Errorf("unexpected entry %+v", entry)
+		}
+	}
+	*ac.pages = append(*ac.pages, len(entries))
+	return nil
+}
+
+func TestTelegramRecordViewLog(t *testing.T) {
+	cases := map[string]struct {
+		records int
+		pages   []int
+	}{
+		"empty":      {records: 0, pages: nil},
+		"short page": {records: 2, pages: []int{2}},
+		"full page":  {records: 500, pages: []int{500}},
+		"two pages":  {records: 501, pages: []int{500, 1}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var pages []int
+			var yielded []uuid.UUID
+			yield := func(record *domain.TelegramRecord) error {
+				logged := 0
+				for _, page := range pages {
+					logged += page
+				}
+				if len(yielded) >= logged {
+					t.Fatalf("expected record %d to be logged before it's shown", len(yielded))
+				}
+				yielded = append(yielded, record.ID)
+				return nil
+			}
+			viewLog := application.NewTelegramRecordViewLog(viewAuditClient{pages: &pages}, yield)
+			recordIDs := make([]uuid.UUID, tc.records)
+			for i := range recordIDs {
+				recordIDs[i] = uuid.New()
+				if err := viewLog.Yield(context.Background(), &domain.TelegramRecord{ID: recordIDs[i]}); err != nil {
+					t.Fatalf("unexpected error %v", err)
+				}
+			}
+			if err := viewLog.Flush(context.Background()); err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if !slices.Equal(pages, tc.pages) {
+				t.Errorf("expected the pages %v to be logged, got %v", tc.pages, pages)
+			}
+			if !slices.Equal(yielded, recordIDs) {
+				t.Errorf("expected the %d records to be shown in their order, got %d", len(recordIDs), len(yielded))
+			}
+		})
+	}
+}
+
+func TestTelegramRecordViewLog_NotLogged(t *testing.T) {
+	yielded := 0
+	viewLog := application.NewTelegramRecordViewLog(
+		viewAuditClient{err: errors.New("audit log unavailable")},
+		func(*domain.TelegramRecord) error {
+			yielded++
+			return nil
+		},
+	)
+	if err := viewLog.Yield(context.Background(), &domain.TelegramRecord{ID: uuid.New()}); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := viewLog.Flush(context.Background()); !errors.Is(err, application.ErrDatabaseFailed) {
+		t.Fatalf("expected %v, got %v", application.ErrDatabaseFailed, err)
+	}
+	if yielded != 0 {
+		t.Errorf("expected no record to be shown unless logged, got %d", yielded)
+	}
+}
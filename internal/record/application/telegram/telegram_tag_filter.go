@@ -0,0 +1,19 @@
+package application
+
+import (
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+)
+
+// NormalizeTelegramTagFilter normalises the tag the results of a query are filtered by,
+// an empty tag doesn't filter them.
+func NormalizeTelegramTagFilter(tag string) (string, error) {
+	if tag == "" {
+		return "", nil
+	}
+	normalized, valid := service.NormalizeTelegramTag(tag)
+	if !valid {
+		return "", domain.ErrInvalidTag
+	}
+	return normalized, nil
+}
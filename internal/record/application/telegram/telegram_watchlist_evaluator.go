@@ -0,0 +1,47 @@
+package application
+
+import (
+	"context"
+
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/google/uuid"
+)
+
+// TelegramWatchlistEvaluator raises alerts for the watchlist entries the newly added records and identities match.
+type TelegramWatchlistEvaluator struct {
+	telegramAlertRepositoryFactory repository.TelegramAlertRepositoryFactory
+}
+
+func NewTelegramWatchlistEvaluator(
+	telegramAlertRepositoryFactory repository.TelegramAlertRepositoryFactory,
+) *TelegramWatchlistEvaluator {
+	return &TelegramWatchlistEvaluator{
+		telegramAlertRepositoryFactory: telegramAlertRepositoryFactory,
+	}
+}
+
+// EvaluateRecords runs in the transaction the records have been stored in, after their indicators,
+// which the mentions and the phone numbers are matched by.
+func (evaluator *TelegramWatchlistEvaluator) EvaluateRecords(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	recordIDs ...uuid.UUID,
+) error {
+	alertRepository := evaluator.telegramAlertRepositoryFactory.CreateTelegramAlertRepositoryWithTransaction(
+		transactionManager,
+	)
+	return alertRepository.AddTelegramAlertsForRecords(ctx, recordIDs)
+}
+
+// EvaluateIdentities runs in the transaction the identities have been stored in.
+func (evaluator *TelegramWatchlistEvaluator) EvaluateIdentities(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	identityIDs ...uuid.UUID,
+) error {
+	alertRepository := evaluator.telegramAlertRepositoryFactory.CreateTelegramAlertRepositoryWithTransaction(
+		transactionManager,
+	)
+	return alertRepository.AddTelegramAlertsForIdentities(ctx, identityIDs)
+}
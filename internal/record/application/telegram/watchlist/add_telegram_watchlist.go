@@ -0,0 +1,143 @@
+package watchlist
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type AddTelegramWatchlistRequest struct {
+	Name string
+}
+
+type AddTelegramWatchlistResponse struct {
+	ID uuid.UUID
+}
+
+// AddTelegramWatchlist creates a watchlist owned by the current user.
+type AddTelegramWatchlist struct {
+	transactionManagerFactory          interfaces.TransactionManagerFactory
+	telegramDomainValidator            *service.TelegramModelValidator
+	telegramWatchlistRepositoryFactory repository.TelegramWatchlistRepositoryFactory
+	auditClient                        auditclient.AuditClient
+	logger                             *slog.Logger
+}
+
+func NewAddTelegramWatchlist(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramDomainValidator *service.TelegramModelValidator,
+	telegramWatchlistRepositoryFactory repository.TelegramWatchlistRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *AddTelegramWatchlist {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "add_telegram_watchlist"),
+	)
+	return &AddTelegramWatchlist{
+		transactionManagerFactory:          transactionManagerFactory,
+		telegramDomainValidator:            telegramDomainValidator,
+		telegramWatchlistRepositoryFactory: telegramWatchlistRepositoryFactory,
+		auditClient:                        auditClient,
+		logger:                             iLogger,
+	}
+}
+
+func (interactor *AddTelegramWatchlist) Execute(
+	ctx context.Context,
+	input AddTelegramWatchlistRequest,
+) (*AddTelegramWatchlistResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	watchlist := &domain.TelegramWatchlist{
+		ID:          uuid.New(),
+		OwnerUserID: idp.UserID,
+		Name:        input.Name,
+		CreatedAt:   time.Now(),
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started AddTelegramWatchlist execution",
+		slog.String("watchlist_id", watchlist.ID.String()),
+	)
+	// Validate the rules before adding to the database
+	if err := interactor.telegramDomainValidator.Validate(watchlist); err != nil {
+		return nil, err
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	watchlistRepository := interactor.telegramWatchlistRepositoryFactory.CreateTelegramWatchlistRepositoryWithTransaction(
+		transactionManager,
+	)
+	if err = watchlistRepository.AddTelegramWatchlist(ctx, watchlist); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to add telegram watchlist", slog.Any("err", err))
+		rollback(ctx, interactor.logger, transactionManager)
+		return nil, application.ErrDatabaseFailed
+	}
+	entry := application.NewTelegramAuditEntry(
+		auditclient.TelegramWatchlistAdded,
+		application.AuditTargetTelegramWatchlist,
+		watchlist.ID,
+		nil,
+		watchlist,
+	)
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record audit entry", slog.Any("err", err))
+		rollback(ctx, interactor.logger, transactionManager)
+		return nil, application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished AddTelegramWatchlist execution")
+	return &AddTelegramWatchlistResponse{ID: watchlist.ID}, nil
+}
+
+func rollback(ctx context.Context, logger *slog.Logger, transactionManager interfaces.TransactionManager) {
+	if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+		logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+	}
+}
+
+// getOwnedWatchlist hides the watchlists of other users as if they didn't exist.
+func getOwnedWatchlist(
+	ctx context.Context,
+	watchlistRepository repository.TelegramWatchlistRepository,
+	watchlistID uuid.UUID,
+	ownerUserID uuid.UUID,
+) (*domain.TelegramWatchlist, error) {
+	watchlist, err := watchlistRepository.GetTelegramWatchlistByID(ctx, watchlistID)
+	if err != nil {
+		return nil, err
+	}
+	if watchlist.OwnerUserID != ownerUserID {
+		return nil, domain.ErrWatchlistNotFound
+	}
+	return watchlist, nil
+}
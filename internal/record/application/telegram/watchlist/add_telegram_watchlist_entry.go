@@ -0,0 +1,177 @@
+package watchlist
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+// minKeywordLength keeps keywords from matching nearly every record.
+const minKeywordLength = 3
+
+var ErrInvalidWatchlistEntry = errors.New("value is not valid for the watchlist entry type")
+
+// AddTelegramWatchlistEntryRequest holds the value as it's written,
+// it's normalised the same way the values it's matched against are.
+type AddTelegramWatchlistEntryRequest struct {
+	WatchlistID uuid.UUID
+	Type        domain.TelegramWatchlistEntryType
+	Value       string
+}
+
+type AddTelegramWatchlistEntryResponse struct {
+	ID    uuid.UUID
+	Value string
+}
+
+// AddTelegramWatchlistEntry adds a person of interest or a keyword to a watchlist of the current user.
+type AddTelegramWatchlistEntry struct {
+	transactionManagerFactory          interfaces.TransactionManagerFactory
+	telegramDomainValidator            *service.TelegramModelValidator
+	telegramWatchlistRepositoryFactory repository.TelegramWatchlistRepositoryFactory
+	indicatorExtractor                 *service.TelegramIndicatorExtractor
+	auditClient                        auditclient.AuditClient
+	logger                             *slog.Logger
+}
+
+func NewAddTelegramWatchlistEntry(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramDomainValidator *service.TelegramModelValidator,
+	telegramWatchlistRepositoryFactory repository.TelegramWatchlistRepositoryFactory,
+	indicatorExtractor *service.TelegramIndicatorExtractor,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *AddTelegramWatchlistEntry {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "add_telegram_watchlist_entry"),
+	)
+	return &AddTelegramWatchlistEntry{
+		transactionManagerFactory:          transactionManagerFactory,
+		telegramDomainValidator:            telegramDomainValidator,
+		telegramWatchlistRepositoryFactory: telegramWatchlistRepositoryFactory,
+		indicatorExtractor:                 indicatorExtractor,
+		auditClient:                        auditClient,
+		logger:                             iLogger,
+	}
+}
+
+func (interactor *AddTelegramWatchlistEntry) Execute(
+	ctx context.Context,
+	input AddTelegramWatchlistEntryRequest,
+) (*AddTelegramWatchlistEntryResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	value, valid := interactor.normalize(input.Type, input.Value)
+	if !valid {
+		return nil, ErrInvalidWatchlistEntry
+	}
+	entry := &domain.TelegramWatchlistEntry{
+		ID:          uuid.New(),
+		WatchlistID: input.WatchlistID,
+		Type:        input.Type,
+		Value:       value,
+		AddedAt:     time.Now(),
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started AddTelegramWatchlistEntry execution",
+		slog.String("watchlist_id", input.WatchlistID.String()),
+		slog.String("entry_type", string(input.Type)),
+	)
+	// Validate the rules before adding to the database
+	if err := interactor.telegramDomainValidator.Validate(entry); err != nil {
+		return nil, err
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	watchlistRepository := interactor.telegramWatchlistRepositoryFactory.CreateTelegramWatchlistRepositoryWithTransaction(
+		transactionManager,
+	)
+	if _, err = getOwnedWatchlist(ctx, watchlistRepository, input.WatchlistID, idp.UserID); err == nil {
+		err = watchlistRepository.AddTelegramWatchlistEntry(ctx, entry)
+	}
+	if err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		switch {
+		case errors.Is(err, domain.ErrWatchlistNotFound), errors.Is(err, domain.ErrWatchlistEntryAlreadyExists):
+			interactor.logger.DebugContext(ctx, "telegram watchlist entry has been rejected", slog.Any("err", err))
+			return nil, err
+		default:
+			interactor.logger.ErrorContext(ctx, "failed to add telegram watchlist entry", slog.Any("err", err))
+			return nil, application.ErrDatabaseFailed
+		}
+	}
+	auditEntry := application.NewTelegramAuditEntry(
+		auditclient.TelegramWatchlistEntryAdded,
+		application.AuditTargetTelegramWatchlistEntry,
+		entry.ID,
+		nil,
+		entry,
+	)
+	if err = interactor.auditClient.Record(ctx, transactionManager, auditEntry); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record audit entry", slog.Any("err", err))
+		rollback(ctx, interactor.logger, transactionManager)
+		return nil, application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished AddTelegramWatchlistEntry execution")
+	return &AddTelegramWatchlistEntryResponse{ID: entry.ID, Value: entry.Value}, nil
+}
+
+// normalize brings the value to the form the records and the identities are matched in.
+func (interactor *AddTelegramWatchlistEntry) normalize(
+	entryType domain.TelegramWatchlistEntryType,
+	value string,
+) (string, bool) {
+	value = strings.TrimSpace(value)
+	switch entryType {
+	case domain.TelegramWatchlistEntryTypeTelegramID:
+		telegramID, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || telegramID == 0 {
+			return "", false
+		}
+		return strconv.FormatUint(telegramID, 10), true
+	case domain.TelegramWatchlistEntryTypeUsername:
+		return interactor.indicatorExtractor.Normalize(domain.TelegramIndicatorTypeMention, value)
+	case domain.TelegramWatchlistEntryTypePhone:
+		return interactor.indicatorExtractor.Normalize(domain.TelegramIndicatorTypePhone, value)
+	case domain.TelegramWatchlistEntryTypeKeyword:
+		keyword := strings.ToLower(strings.Join(strings.Fields(value), " "))
+		return keyword, utf8.RuneCountInString(keyword) >= minKeywordLength
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,159 @@
+package watchlist_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/watchlist"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type fakeTransactionManager struct {
+	interfaces.TransactionManager
+}
+
+func (fakeTransactionManager) Commit(context.Context) error   { return nil }
+func (fakeTransactionManager) Rollback(context.Context) error { return nil }
+
+type fakeTransactionManagerFactory struct{}
+
+func (fakeTransactionManagerFactory) NewTransaction(context.Context) (interfaces.TransactionManager, error) {
+	return fakeTransactionManager{}, nil
+}
+
+// fakeWatchlistRepository holds a single watchlist and the entries added to it.
+type fakeWatchlistRepository struct {
+	repository.TelegramWatchlistRepository
+	watchlist domain.TelegramWatchlist
+	entries   []domain.TelegramWatchlistEntry
+}
+
+func (repo *fakeWatchlistRepository) GetTelegramWatchlistByID(
+	_ context.Context,
+	watchlistID uuid.UUID,
+) (*domain.TelegramWatchlist, error) {
+	if watchlistID != repo.watchlist.ID {
+		return nil, domain.ErrWatchlistNotFound
+	}
+	return &repo.watchlist, nil
+}
+
+func (repo *fakeWatchlistRepository) AddTelegramWatchlistEntry(
+	_ context.Context,
+	entry *domain.TelegramWatchlistEntry,
+) error {
+	repo.entries = append(repo.entries, *entry)
+	return nil
+}
+
+func (repo *fakeWatchlistRepository) CreateTelegramWatchlistRepositoryWithTransaction(
+	interfaces.TransactionManager,
+) repository.TelegramWatchlistRepository {
+	return repo
+}
+
+type fakeAuditClient struct {
+	auditclient.AuditClient
+}
+
+func (fakeAuditClient) Record(context.Context, interfaces.TransactionManager, ...auditclient.Entry) error {
+	return nil
+}
+
+func newEntryInteractor(repo *fakeWatchlistRepository) *application.AddTelegramWatchlistEntry {
+	return application.NewAddTelegramWatchlistEntry(
+		fakeTransactionManagerFactory{},
+		service.NewTelegramModelValidator(validator.New()),
+		repo,
+		service.NewTelegramIndicatorExtractor(),
+		fakeAuditClient{},
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+	)
+}
+
+func entryContext(userID uuid.UUID) context.Context {
+	identity := &client.UserIdentity{UserID: userID, UserRole: client.User}
+	return context.WithValue(context.Background(), client.IdentityProviderKey, identity)
+}
+
+func TestAddTelegramWatchlistEntry_Normalize(t *testing.T) {
+	telegramID := domain.TelegramWatchlistEntryTypeTelegramID
+	username := domain.TelegramWatchlistEntryTypeUsername
+	phone := domain.TelegramWatchlistEntryTypePhone
+	keyword := domain.TelegramWatchlistEntryTypeKeyword
+	invalid := application.ErrInvalidWatchlistEntry
+	cases := map[string]struct {
+		entryType domain.TelegramWatchlistEntryType
+		value     string
+		expected  string
+		err       error
+	}{
+		"telegram id":          {entryType: telegramID, value: " 0042 ", expected: "42"},
+		"zero telegram id":     {entryType: telegramID, value: "0", err: invalid},
+		"negative telegram id": {entryType: telegramID, value: "-100123", err: invalid},
+		"username":             {entryType: username, value: "@John_Doe", expected: "john_doe"},
+		"short username":       {entryType: username, value: "@jd", err: invalid},
+		"phone":                {entryType: phone, value: "+1 (555) 123-4567", expected: "+15551234567"},
+		"short phone":          {entryType: phone, value: "+1 555", err: invalid},
+		"keyword":              {entryType: keyword, value: "  Wire \t Transfer\n", expected: "wire transfer"},
+		"short keyword":        {entryType: keyword, value: " ab ", err: invalid},
+		"long keyword":         {entryType: keyword, value: strings.Repeat("x", 257), err: domain.ErrValidationFailed},
+		"unknown type":         {entryType: "email", value: "john@example.com", err: invalid},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ownerUserID := uuid.New()
+			repo := &fakeWatchlistRepository{watchlist: domain.TelegramWatchlist{ID: uuid.New(), OwnerUserID: ownerUserID}}
+
+			request := application.AddTelegramWatchlistEntryRequest{
+				WatchlistID: repo.watchlist.ID,
+				Type:        tc.entryType,
+				Value:       tc.value,
+			}
+			resp, err := newEntryInteractor(repo).Execute(entryContext(ownerUserID), request)
+			if tc.err != nil {
+				if !errors.Is(err, tc.err) {
+					t.Fatalf("expected %v, got %v", tc.err, err)
+				}
+				if len(repo.entries) != 0 {
+					t.Errorf("expected an invalid entry never to be stored, got %+v", repo.entries)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if resp.Value != tc.expected || len(repo.entries) != 1 || repo.entries[0].Value != tc.expected {
+				t.Errorf("expected the value %q to be stored, got %q and %+v", tc.expected, resp.Value, repo.entries)
+			}
+		})
+	}
+}
+
+func TestAddTelegramWatchlistEntry_OtherOwner(t *testing.T) {
+	repo := &fakeWatchlistRepository{watchlist: domain.TelegramWatchlist{ID: uuid.New(), OwnerUserID: uuid.New()}}
+
+	// The watchlists of other users are reported missing, so that their IDs can't be probed
+	_, err := newEntryInteractor(repo).Execute(entryContext(uuid.New()), application.AddTelegramWatchlistEntryRequest{
+		WatchlistID: repo.watchlist.ID,
+		Type:        domain.TelegramWatchlistEntryTypeKeyword,
+		Value:       "wire transfer",
+	})
+	if !errors.Is(err, domain.ErrWatchlistNotFound) {
+		t.Fatalf("expected %v, got %v", domain.ErrWatchlistNotFound, err)
+	}
+	if len(repo.entries) != 0 {
+		t.Errorf("expected no entry to be added to the watchlist of another user, got %+v", repo.entries)
+	}
+}
@@ -0,0 +1,72 @@
+package watchlist
+
+import (
+	"context"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+)
+
+type GetTelegramWatchlistsResponse struct {
+	Watchlists []domain.TelegramWatchlist
+}
+
+// GetTelegramWatchlists lists the watchlists of the current user with their entries.
+type GetTelegramWatchlists struct {
+	transactionManagerFactory          interfaces.TransactionManagerFactory
+	telegramWatchlistRepositoryFactory repository.TelegramWatchlistRepositoryFactory
+	logger                             *slog.Logger
+}
+
+func NewGetTelegramWatchlists(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramWatchlistRepositoryFactory repository.TelegramWatchlistRepositoryFactory,
+	logger *slog.Logger,
+) *GetTelegramWatchlists {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "get_telegram_watchlists"),
+	)
+	return &GetTelegramWatchlists{
+		transactionManagerFactory:          transactionManagerFactory,
+		telegramWatchlistRepositoryFactory: telegramWatchlistRepositoryFactory,
+		logger:                             iLogger,
+	}
+}
+
+func (interactor *GetTelegramWatchlists) Execute(ctx context.Context) (*GetTelegramWatchlistsResponse, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+	interactor.logger.DebugContext(ctx, "Started GetTelegramWatchlists execution")
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+	defer rollback(ctx, interactor.logger, transactionManager)
+
+	watchlistRepository := interactor.telegramWatchlistRepositoryFactory.CreateTelegramWatchlistRepositoryWithTransaction(
+		transactionManager,
+	)
+	watchlists, err := watchlistRepository.GetTelegramWatchlistsByOwner(ctx, idp.UserID)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get telegram watchlists", slog.Any("err", err))
+		return nil, application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetTelegramWatchlists execution")
+	return &GetTelegramWatchlistsResponse{Watchlists: *watchlists}, nil
+}
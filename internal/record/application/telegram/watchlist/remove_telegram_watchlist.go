@@ -0,0 +1,105 @@
+package watchlist
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type RemoveTelegramWatchlistRequest struct {
+	WatchlistID uuid.UUID
+}
+
+// RemoveTelegramWatchlist removes a watchlist of the current user along with its entries and alerts.
+type RemoveTelegramWatchlist struct {
+	transactionManagerFactory          interfaces.TransactionManagerFactory
+	telegramWatchlistRepositoryFactory repository.TelegramWatchlistRepositoryFactory
+	auditClient                        auditclient.AuditClient
+	logger                             *slog.Logger
+}
+
+func NewRemoveTelegramWatchlist(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramWatchlistRepositoryFactory repository.TelegramWatchlistRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *RemoveTelegramWatchlist {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "remove_telegram_watchlist"),
+	)
+	return &RemoveTelegramWatchlist{
+		transactionManagerFactory:          transactionManagerFactory,
+		telegramWatchlistRepositoryFactory: telegramWatchlistRepositoryFactory,
+		auditClient:                        auditClient,
+		logger:                             iLogger,
+	}
+}
+
+func (interactor *RemoveTelegramWatchlist) Execute(ctx context.Context, input RemoveTelegramWatchlistRequest) error {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return rbac.ErrInsufficientPrivileges
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started RemoveTelegramWatchlist execution",
+		slog.String("watchlist_id", input.WatchlistID.String()),
+	)
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+
+	watchlistRepository := interactor.telegramWatchlistRepositoryFactory.CreateTelegramWatchlistRepositoryWithTransaction(
+		transactionManager,
+	)
+	watchlist, err := getOwnedWatchlist(ctx, watchlistRepository, input.WatchlistID, idp.UserID)
+	if err == nil {
+		err = watchlistRepository.RemoveTelegramWatchlist(ctx, input.WatchlistID)
+	}
+	if err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		if errors.Is(err, domain.ErrWatchlistNotFound) {
+			return err
+		}
+		interactor.logger.ErrorContext(ctx, "failed to remove telegram watchlist", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+	entry := application.NewTelegramAuditEntry(
+		auditclient.TelegramWatchlistRemoved,
+		application.AuditTargetTelegramWatchlist,
+		input.WatchlistID,
+		watchlist,
+		nil,
+	)
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record audit entry", slog.Any("err", err))
+		rollback(ctx, interactor.logger, transactionManager)
+		return application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished RemoveTelegramWatchlist execution")
+	return nil
+}
@@ -0,0 +1,112 @@
+package watchlist
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+type RemoveTelegramWatchlistEntryRequest struct {
+	WatchlistID uuid.UUID
+	EntryID     uuid.UUID
+}
+
+// RemoveTelegramWatchlistEntry removes an entry of a watchlist of the current user along with its alerts.
+type RemoveTelegramWatchlistEntry struct {
+	transactionManagerFactory          interfaces.TransactionManagerFactory
+	telegramWatchlistRepositoryFactory repository.TelegramWatchlistRepositoryFactory
+	auditClient                        auditclient.AuditClient
+	logger                             *slog.Logger
+}
+
+func NewRemoveTelegramWatchlistEntry(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	telegramWatchlistRepositoryFactory repository.TelegramWatchlistRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *RemoveTelegramWatchlistEntry {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "remove_telegram_watchlist_entry"),
+	)
+	return &RemoveTelegramWatchlistEntry{
+		transactionManagerFactory:          transactionManagerFactory,
+		telegramWatchlistRepositoryFactory: telegramWatchlistRepositoryFactory,
+		auditClient:                        auditClient,
+		logger:                             iLogger,
+	}
+}
+
+func (interactor *RemoveTelegramWatchlistEntry) Execute(
+	ctx context.Context,
+	input RemoveTelegramWatchlistEntryRequest,
+) error {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleUser); err != nil {
+		return rbac.ErrInsufficientPrivileges
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started RemoveTelegramWatchlistEntry execution",
+		slog.String("watchlist_id", input.WatchlistID.String()),
+		slog.String("entry_id", input.EntryID.String()),
+	)
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+
+	watchlistRepository := interactor.telegramWatchlistRepositoryFactory.CreateTelegramWatchlistRepositoryWithTransaction(
+		transactionManager,
+	)
+	var removed *domain.TelegramWatchlistEntry
+	if _, err = getOwnedWatchlist(ctx, watchlistRepository, input.WatchlistID, idp.UserID); err == nil {
+		removed, err = watchlistRepository.RemoveTelegramWatchlistEntry(ctx, input.WatchlistID, input.EntryID)
+	}
+	if err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		switch {
+		case errors.Is(err, domain.ErrWatchlistNotFound), errors.Is(err, domain.ErrWatchlistEntryNotFound):
+			return err
+		default:
+			interactor.logger.ErrorContext(ctx, "failed to remove telegram watchlist entry", slog.Any("err", err))
+			return application.ErrDatabaseFailed
+		}
+	}
+	entry := application.NewTelegramAuditEntry(
+		auditclient.TelegramWatchlistEntryRemoved,
+		application.AuditTargetTelegramWatchlistEntry,
+		input.EntryID,
+		removed,
+		nil,
+	)
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to record audit entry", slog.Any("err", err))
+		rollback(ctx, interactor.logger, transactionManager)
+		return application.ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished RemoveTelegramWatchlistEntry execution")
+	return nil
+}
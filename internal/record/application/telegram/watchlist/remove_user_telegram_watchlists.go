@@ -0,0 +1,58 @@
+package watchlist
+
+import (
+	"context"
+	"log/slog"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/google/uuid"
+)
+
+type RemoveUserTelegramWatchlistsRequest struct {
+	UserID uuid.UUID
+}
+
+// RemoveUserTelegramWatchlists removes the watchlists of a removed user along with their entries and alerts.
+// It runs in the transaction the user.removed event is published in, the removal has been authorized already.
+type RemoveUserTelegramWatchlists struct {
+	telegramWatchlistRepositoryFactory repository.TelegramWatchlistRepositoryFactory
+	logger                             *slog.Logger
+}
+
+func NewRemoveUserTelegramWatchlists(
+	telegramWatchlistRepositoryFactory repository.TelegramWatchlistRepositoryFactory,
+	logger *slog.Logger,
+) *RemoveUserTelegramWatchlists {
+	iLogger := logger.With(
+		slog.String("module", "record"),
+		slog.String("name", "remove_user_telegram_watchlists"),
+	)
+	return &RemoveUserTelegramWatchlists{
+		telegramWatchlistRepositoryFactory: telegramWatchlistRepositoryFactory,
+		logger:                             iLogger,
+	}
+}
+
+func (interactor *RemoveUserTelegramWatchlists) Execute(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	input RemoveUserTelegramWatchlistsRequest,
+) error {
+	watchlistRepository := interactor.telegramWatchlistRepositoryFactory.CreateTelegramWatchlistRepositoryWithTransaction(
+		transactionManager,
+	)
+	removed, err := watchlistRepository.RemoveTelegramWatchlistsByOwner(ctx, input.UserID)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to remove telegram watchlists", slog.Any("err", err))
+		return application.ErrDatabaseFailed
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Watchlists of the removed user have been removed",
+		slog.String("user_id", input.UserID.String()),
+		slog.Int64("watchlists", removed),
+	)
+	return nil
+}
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/google/uuid"
+)
+
+// TelegramChainGenesisHash is the previous hash of the first entry of every chain.
+var TelegramChainGenesisHash = strings.Repeat("0", sha256.Size*2)
+
+// telegramChainHashVersion is hashed along with every content, it changes whenever the hashed fields do.
+const telegramChainHashVersion = "v1"
+
+// HashTelegramRecord hashes the archived content of the record. DeletedAt isn't part of it,
+// marking a record deleted keeps its content.
+func HashTelegramRecord(record *domain.TelegramRecord) string {
+	origin := domain.TelegramForwardOrigin{}
+	if record.ForwardOrigin != nil {
+		origin = *record.ForwardOrigin
+	}
+	return hashCanonical(struct {
+		Version                   string                         `json:"version"`
+		ID                        uuid.UUID                      `json:"id"`
+		MessageTelegramID         uint64                         `json:"message_telegram_id"`
+		FromTelegramUserID        uuid.UUID                      `json:"from_telegram_user_id"`
+		InTelegramChatID          int64                          `json:"in_telegram_chat_id"`
+		MessageText               string                         `json:"message_text"`
+		Entities                  []domain.TelegramMessageEntity `json:"entities"`
+		Caption                   string                         `json:"caption"`
+		CaptionEntities           []domain.TelegramMessageEntity `json:"caption_entities"`
+		PostedAt                  string                         `json:"posted_at"`
+		EditedAt                  string                         `json:"edited_at"`
+		ReplyToMessageTelegramID  *uint64                        `json:"reply_to_message_telegram_id"`
+		ThreadTelegramID          *uint64                        `json:"thread_telegram_id"`
+		ForwardFromUserTelegramID *uint64                        `json:"forward_from_user_telegram_id"`
+		ForwardFromChatTelegramID *int64                         `json:"forward_from_chat_telegram_id"`
+		ForwardMessageTelegramID  *uint64                        `json:"forward_message_telegram_id"`
+		ForwardPostedAt           string                         `json:"forward_posted_at"`
+		AddedAt                   string                         `json:"added_at"`
+		AddedByUser               uuid.UUID                      `json:"added_by_user"`
+	}{
+		Version:                   telegramChainHashVersion,
+		ID:                        record.ID,
+		MessageTelegramID:         record.MessageTelegramID,
+		FromTelegramUserID:        record.FromTelegramUserID,
+		InTelegramChatID:          record.InTelegramChatID,
+		MessageText:               record.MessageText,
+		Entities:                  canonicalEntities(record.Entities),
+		Caption:                   record.Caption,
+		CaptionEntities:           canonicalEntities(record.CaptionEntities),
+		PostedAt:                  canonicalTime(&record.PostedAt),
+		EditedAt:                  canonicalTime(record.EditedAt),
+		ReplyToMessageTelegramID:  record.ReplyToMessageTelegramID,
+		ThreadTelegramID:          record.ThreadTelegramID,
+		ForwardFromUserTelegramID: origin.FromUserTelegramID,
+		ForwardFromChatTelegramID: origin.FromChatTelegramID,
+		ForwardMessageTelegramID:  origin.MessageTelegramID,
+		ForwardPostedAt:           canonicalTime(origin.PostedAt),
+		AddedAt:                   canonicalTime(&record.AddedAt),
+		AddedByUser:               record.AddedByUser,
+	})
+}
+
+// HashTelegramIdentity hashes the identity as archived.
+func HashTelegramIdentity(identity *domain.TelegramIdentity) string {
+	return hashCanonical(struct {
+		Version     string    `json:"version"`
+		ID          uuid.UUID `json:"id"`
+		UserID      uuid.UUID `json:"user_id"`
+		Username    string    `json:"username"`
+		FirstName   string    `json:"first_name"`
+		LastName    string    `json:"last_name"`
+		Bio         string    `json:"bio"`
+		PhoneNumber string    `json:"phone_number"`
+		AddedAt     string    `json:"added_at"`
+		AddedByUser uuid.UUID `json:"added_by_user"`
+	}{
+		Version:     telegramChainHashVersion,
+		ID:          identity.ID,
+		UserID:      identity.UserID,
+		Username:    identity.Username,
+		FirstName:   identity.FirstName,
+		LastName:    identity.LastName,
+		Bio:         identity.Bio,
+		PhoneNumber: identity.PhoneNumber,
+		AddedAt:     canonicalTime(&identity.AddedAt),
+		AddedByUser: identity.AddedByUser,
+	})
+}
+
+// HashTelegramAttachment hashes the metadata of the attachment, the file itself is covered by its SHA256.
+func HashTelegramAttachment(attachment *domain.TelegramAttachment) string {
+	return hashCanonical(struct {
+		Version              string    `json:"version"`
+		ID                   uuid.UUID `json:"id"`
+		RecordID             uuid.UUID `json:"record_id"`
+		TelegramAttachmentID uint64    `json:"telegram_attachment_id"`
+		FileName             string    `json:"file_name"`
+		StorageKey           string    `json:"storage_key"`
+		SHA256               string    `json:"sha256"`
+		FileSize             uint64    `json:"file_size"`
+		MimeType             string    `json:"mime_type"`
+		AddedAt              string    `json:"added_at"`
+		AddedByUser          uuid.UUID `json:"added_by_user"`
+	}{
+		Version:              telegramChainHashVersion,
+		ID:                   attachment.ID,
+		RecordID:             attachment.RecordID,
+		TelegramAttachmentID: attachment.TelegramAttachmentID,
+		FileName:             attachment.FileName,
+		StorageKey:           attachment.StorageKey,
+		SHA256:               attachment.SHA256,
+		FileSize:             attachment.FileSize,
+		MimeType:             attachment.MimeType,
+		AddedAt:              canonicalTime(&attachment.AddedAt),
+		AddedByUser:          attachment.AddedByUser,
+	})
+}
+
+// HashTelegramChainEntry hashes everything of the entry but its own EntryHash, the previous hash included.
+func HashTelegramChainEntry(entry *domain.TelegramChainEntry) string {
+	return hashCanonical(struct {
+		Version         string                          `json:"version"`
+		CollectorUserID uuid.UUID                       `json:"collector_user_id"`
+		Sequence        int64                           `json:"sequence"`
+		SubjectType     domain.TelegramChainSubjectType `json:"subject_type"`
+		SubjectID       uuid.UUID                       `json:"subject_id"`
+		ContentHash     string                          `json:"content_hash"`
+		PreviousHash    string                          `json:"previous_hash"`
+		AddedAt         string                          `json:"added_at"`
+	}{
+		Version:         telegramChainHashVersion,
+		CollectorUserID: entry.CollectorUserID,
+		Sequence:        entry.Sequence,
+		SubjectType:     entry.SubjectType,
+		SubjectID:       entry.SubjectID,
+		ContentHash:     entry.ContentHash,
+		PreviousHash:    entry.PreviousHash,
+		AddedAt:         canonicalTime(&entry.AddedAt),
+	})
+}
+
+// SignTelegramChainCheckpoint sets the public key and the signature of the checkpoint.
+func SignTelegramChainCheckpoint(privateKey ed25519.PrivateKey, checkpoint *domain.TelegramChainCheckpoint) {
+	publicKey, _ := privateKey.Public().(ed25519.PublicKey)
+	checkpoint.PublicKey = base64.StdEncoding.EncodeToString(publicKey)
+	signature := ed25519.Sign(privateKey, telegramChainCheckpointMessage(checkpoint))
+	checkpoint.Signature = base64.StdEncoding.EncodeToString(signature)
+}
+
+// VerifyTelegramChainCheckpoint tells whether the checkpoint has been signed with the key of publicKey.
+// The public key stored along with the checkpoint isn't trusted, only compared.
+func VerifyTelegramChainCheckpoint(publicKey ed25519.PublicKey, checkpoint *domain.TelegramChainCheckpoint) bool {
+	if checkpoint.PublicKey != base64.StdEncoding.EncodeToString(publicKey) {
+		return false
+	}
+	signature, err := base64.StdEncoding.DecodeString(checkpoint.Signature)
+	if err != nil {
+		return false
+	}
+	return ed25519.Verify(publicKey, telegramChainCheckpointMessage(checkpoint), signature)
+}
+
+func telegramChainCheckpointMessage(checkpoint *domain.TelegramChainCheckpoint) []byte {
+	return []byte(strings.Join([]string{
+		"trinity-telegram-chain-checkpoint",
+		telegramChainHashVersion,
+		checkpoint.CollectorUserID.String(),
+		strconv.FormatInt(checkpoint.Sequence, 10),
+		checkpoint.EntryHash,
+		canonicalTime(&checkpoint.SignedAt),
+	}, "\n"))
+}
+
+func hashCanonical(content any) string {
+	// Structs of plain fields always marshal the same way
+	data, _ := json.Marshal(content)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// canonicalTime matches the precision the database keeps, so that the hash of a stored subject doesn't change
+// once it's read back.
+func canonicalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano)
+}
+
+// canonicalEntities doesn't tell a missing list from an empty one, the database doesn't either.
+func canonicalEntities(entities []domain.TelegramMessageEntity) []domain.TelegramMessageEntity {
+	if entities == nil {
+		return []domain.TelegramMessageEntity{}
+	}
+	return entities
+}
+
+// TelegramChainWalker checks the chain of a collector, fed with its entries in order. After a break it carries on
+// from the stored entry, so that a single altered entry is reported once.
+type TelegramChainWalker struct {
+	collectorUserID uuid.UUID
+	sequence        int64
+	entryHash       string
+	checkpoints     map[int64]domain.TelegramChainCheckpoint
+}
+
+// NewTelegramChainWalker returns a walker checking the entries against the given checkpoints of the collector,
+// their signatures are verified separately.
+func NewTelegramChainWalker(
+	collectorUserID uuid.UUID,
+	checkpoints []domain.TelegramChainCheckpoint,
+) *TelegramChainWalker {
+	walker := &TelegramChainWalker{
+		collectorUserID: collectorUserID,
+		entryHash:       TelegramChainGenesisHash,
+		checkpoints:     make(map[int64]domain.TelegramChainCheckpoint, len(checkpoints)),
+	}
+	for _, checkpoint := range checkpoints {
+		walker.checkpoints[checkpoint.Sequence] = checkpoint
+	}
+	return walker
+}
+
+// Next checks the entry following the ones walked so far.
+func (walker *TelegramChainWalker) Next(entry *domain.TelegramChainEntry) []domain.TelegramChainBreak {
+	var breaks []domain.TelegramChainBreak
+	report := func(reason domain.TelegramChainBreakReason) {
+		breaks = append(breaks, domain.TelegramChainBreak{
+			CollectorUserID: walker.collectorUserID,
+			Sequence:        entry.Sequence,
+			SubjectType:     entry.SubjectType,
+			SubjectID:       entry.SubjectID,
+			Reason:          reason,
+		})
+	}
+	switch {
+	case entry.Sequence != walker.sequence+1:
+		// A missing entry breaks the link as well, it's reported once
+		report(domain.TelegramChainBreakSequenceGap)
+	case entry.PreviousHash != walker.entryHash:
+		report(domain.TelegramChainBreakBrokenLink)
+	}
+	if HashTelegramChainEntry(entry) != entry.EntryHash {
+		report(domain.TelegramChainBreakEntryMismatch)
+	}
+	if checkpoint, ok := walker.checkpoints[entry.Sequence]; ok {
+		if checkpoint.EntryHash != entry.EntryHash {
+			report(domain.TelegramChainBreakCheckpointMismatch)
+		}
+		delete(walker.checkpoints, entry.Sequence)
+	}
+	walker.sequence = entry.Sequence
+	walker.entryHash = entry.EntryHash
+	return breaks
+}
+
+// Finish checks the head of the chain once all of its entries have been walked. The checkpoints left
+// have signed entries that aren't there anymore.
+func (walker *TelegramChainWalker) Finish(head *domain.TelegramChainHead) []domain.TelegramChainBreak {
+	var breaks []domain.TelegramChainBreak
+	if head.Sequence != walker.sequence || head.EntryHash != walker.entryHash {
+		breaks = append(breaks, domain.TelegramChainBreak{
+			CollectorUserID: walker.collectorUserID,
+			Sequence:        head.Sequence,
+			Reason:          domain.TelegramChainBreakHeadMismatch,
+		})
+	}
+	for sequence := range walker.checkpoints {
+		breaks = append(breaks, domain.TelegramChainBreak{
+			CollectorUserID: walker.collectorUserID,
+			Sequence:        sequence,
+			Reason:          domain.TelegramChainBreakCheckpointMismatch,
+		})
+	}
+	return breaks
+}
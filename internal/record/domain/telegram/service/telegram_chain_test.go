@@ -0,0 +1,153 @@
+package service_test
+
+import (
+	"crypto/ed25519"
+	"slices"
+	"testing"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/google/uuid"
+)
+
+func TestHashTelegramRecordIsCanonical(t *testing.T) {
+	addedAt := time.Date(2024, 1, 15, 10, 0, 0, 123456789, time.FixedZone("UTC+2", 2*60*60))
+	record := &domain.TelegramRecord{
+		ID:          uuid.New(),
+		MessageText: "hello",
+		PostedAt:    addedAt,
+		AddedAt:     addedAt,
+		DeletedAt:   nil,
+	}
+	stored := *record
+	stored.Entities = []domain.TelegramMessageEntity{}
+	stored.ForwardOrigin = &domain.TelegramForwardOrigin{}
+	stored.PostedAt = addedAt.UTC().Truncate(time.Microsecond)
+	stored.AddedAt = addedAt.UTC().Truncate(time.Microsecond)
+	deletedAt := time.Now()
+	stored.DeletedAt = &deletedAt
+
+	if service.HashTelegramRecord(record) != service.HashTelegramRecord(&stored) {
+		t.Error("expected the record read back from the database to keep its hash")
+	}
+	stored.MessageText = "hello!"
+	if service.HashTelegramRecord(record) == service.HashTelegramRecord(&stored) {
+		t.Error("expected an altered record to change its hash")
+	}
+}
+
+func TestTelegramChainCheckpointSignature(t *testing.T) {
+	publicKey, privateKey, _ := ed25519.GenerateKey(nil)
+	otherPublicKey, _, _ := ed25519.GenerateKey(nil)
+	checkpoint := &domain.TelegramChainCheckpoint{
+		CollectorUserID: uuid.New(),
+		Sequence:        42,
+		EntryHash:       service.TelegramChainGenesisHash,
+		SignedAt:        time.Unix(1700000000, 0),
+	}
+	service.SignTelegramChainCheckpoint(privateKey, checkpoint)
+
+	if !service.VerifyTelegramChainCheckpoint(publicKey, checkpoint) {
+		t.Errorf("expected signature %s to be verified", checkpoint.Signature)
+	}
+	if service.VerifyTelegramChainCheckpoint(otherPublicKey, checkpoint) {
+		t.Error("expected signature with another key to be rejected")
+	}
+	tampered := *checkpoint
+	tampered.Sequence = 41
+	if service.VerifyTelegramChainCheckpoint(publicKey, &tampered) {
+		t.Error("expected signature of another sequence to be rejected")
+	}
+}
+
+func TestTelegramChainWalker(t *testing.T) {
+	collectorUserID := uuid.New()
+	entries := newTelegramChain(collectorUserID, 4)
+	checkpoints := []domain.TelegramChainCheckpoint{
+		{CollectorUserID: collectorUserID, Sequence: 2, EntryHash: entries[1].EntryHash},
+	}
+	head := &domain.TelegramChainHead{CollectorUserID: collectorUserID, Sequence: 4, EntryHash: entries[3].EntryHash}
+
+	cases := []struct {
+		name     string
+		tamper   func(entries []domain.TelegramChainEntry) []domain.TelegramChainEntry
+		expected []domain.TelegramChainBreakReason
+	}{
+		{
+			name:   "intact",
+			tamper: func(entries []domain.TelegramChainEntry) []domain.TelegramChainEntry { return entries },
+		},
+		{
+			name: "altered",
+			tamper: func(entries []domain.TelegramChainEntry) []domain.TelegramChainEntry {
+				entries[2].ContentHash = service.TelegramChainGenesisHash
+				return entries
+			},
+			expected: []domain.TelegramChainBreakReason{domain.TelegramChainBreakEntryMismatch},
+		},
+		{
+			name: "removed",
+			tamper: func(entries []domain.TelegramChainEntry) []domain.TelegramChainEntry {
+				return slices.Delete(entries, 2, 3)
+			},
+			expected: []domain.TelegramChainBreakReason{domain.TelegramChainBreakSequenceGap},
+		},
+		{
+			name: "rewritten",
+			tamper: func(entries []domain.TelegramChainEntry) []domain.TelegramChainEntry {
+				entries[1].ContentHash = service.TelegramChainGenesisHash
+				entries[1].EntryHash = service.HashTelegramChainEntry(&entries[1])
+				return entries
+			},
+			expected: []domain.TelegramChainBreakReason{
+				domain.TelegramChainBreakCheckpointMismatch,
+				domain.TelegramChainBreakBrokenLink,
+			},
+		},
+		{
+			name: "truncated",
+			tamper: func(entries []domain.TelegramChainEntry) []domain.TelegramChainEntry {
+				return entries[:1]
+			},
+			expected: []domain.TelegramChainBreakReason{
+				domain.TelegramChainBreakHeadMismatch,
+				domain.TelegramChainBreakCheckpointMismatch,
+			},
+		},
+	}
+	for _, tc := range cases {
+		walker := service.NewTelegramChainWalker(collectorUserID, checkpoints)
+		var reasons []domain.TelegramChainBreakReason
+		for _, entry := range tc.tamper(slices.Clone(entries)) {
+			for _, chainBreak := range walker.Next(&entry) {
+				reasons = append(reasons, chainBreak.Reason)
+			}
+		}
+		for _, chainBreak := range walker.Finish(head) {
+			reasons = append(reasons, chainBreak.Reason)
+		}
+		if !slices.Equal(reasons, tc.expected) {
+			t.Errorf("%s: expected breaks %v, got %v", tc.name, tc.expected, reasons)
+		}
+	}
+}
+
+func newTelegramChain(collectorUserID uuid.UUID, length int) []domain.TelegramChainEntry {
+	entries := make([]domain.TelegramChainEntry, length)
+	previousHash := service.TelegramChainGenesisHash
+	for i := range entries {
+		entries[i] = domain.TelegramChainEntry{
+			CollectorUserID: collectorUserID,
+			Sequence:        int64(i + 1),
+			SubjectType:     domain.TelegramChainSubjectRecord,
+			SubjectID:       uuid.New(),
+			ContentHash:     service.HashTelegramIdentity(&domain.TelegramIdentity{ID: uuid.New()}),
+			PreviousHash:    previousHash,
+			AddedAt:         time.Unix(1700000000+int64(i), 0),
+		}
+		entries[i].EntryHash = service.HashTelegramChainEntry(&entries[i])
+		previousHash = entries[i].EntryHash
+	}
+	return entries
+}
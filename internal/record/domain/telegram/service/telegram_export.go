@@ -0,0 +1,68 @@
+package service
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/google/uuid"
+)
+
+// TelegramExportManifestVersion is the format of manifest.json, it changes whenever the listed fields do.
+const TelegramExportManifestVersion = "v1"
+
+type telegramExportManifestFile struct {
+	Path   string `json:"path"`
+	SHA256 string `json:"sha256"`
+	Size   int64  `json:"size"`
+}
+
+// MarshalTelegramExportManifest renders manifest.json. The signature covers these exact bytes.
+func MarshalTelegramExportManifest(privateKey ed25519.PrivateKey, manifest *domain.TelegramExportManifest) []byte {
+	publicKey, _ := privateKey.Public().(ed25519.PublicKey)
+	manifest.Version = TelegramExportManifestVersion
+	manifest.PublicKey = base64.StdEncoding.EncodeToString(publicKey)
+	files := make([]telegramExportManifestFile, len(manifest.Files))
+	for i, file := range manifest.Files {
+		files[i] = telegramExportManifestFile(file)
+	}
+	selection := manifest.Selection
+	if selection.UserTelegramIDs == nil {
+		selection.UserTelegramIDs = []uint64{}
+	}
+	if selection.ChatTelegramIDs == nil {
+		selection.ChatTelegramIDs = []int64{}
+	}
+	data, _ := json.MarshalIndent(struct {
+		Version         string                       `json:"version"`
+		ExportID        uuid.UUID                    `json:"export_id"`
+		RequestedBy     uuid.UUID                    `json:"requested_by"`
+		UserTelegramIDs []uint64                     `json:"user_telegram_ids"`
+		ChatTelegramIDs []int64                      `json:"chat_telegram_ids"`
+		CreatedAt       string                       `json:"created_at"`
+		PublicKey       string                       `json:"public_key"`
+		Files           []telegramExportManifestFile `json:"files"`
+	}{
+		manifest.Version,
+		manifest.ExportID,
+		manifest.RequestedBy,
+		selection.UserTelegramIDs,
+		selection.ChatTelegramIDs,
+		canonicalTime(&manifest.CreatedAt),
+		manifest.PublicKey,
+		files,
+	}, "", "  ")
+	return data
+}
+
+// SignTelegramExportManifest returns the detached signature of manifest.json. The bytes are signed as they are,
+// so that the bundle can be verified with any Ed25519 tool.
+func SignTelegramExportManifest(privateKey ed25519.PrivateKey, manifest []byte) []byte {
+	return ed25519.Sign(privateKey, manifest)
+}
+
+// VerifyTelegramExportManifest tells whether the manifest has been signed with the key of publicKey.
+func VerifyTelegramExportManifest(publicKey ed25519.PublicKey, manifest, signature []byte) bool {
+	return len(publicKey) == ed25519.PublicKeySize && ed25519.Verify(publicKey, manifest, signature)
+}
@@ -0,0 +1,76 @@
+package service_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/json"
+	"testing"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/google/uuid"
+)
+
+func TestMarshalTelegramExportManifest(t *testing.T) {
+	publicKey, privateKey, _ := ed25519.GenerateKey(nil)
+	manifest := &domain.TelegramExportManifest{
+		ExportID:    uuid.New(),
+		RequestedBy: uuid.New(),
+		Selection:   domain.TelegramExportSelection{ChatTelegramIDs: []int64{-1001234567890}},
+		CreatedAt:   time.Date(2024, 1, 15, 10, 0, 0, 123456789, time.FixedZone("UTC+2", 2*60*60)),
+		Files: []domain.TelegramExportManifestFile{
+			{Path: "records.json", SHA256: service.TelegramChainGenesisHash, Size: 2},
+		},
+	}
+	data := service.MarshalTelegramExportManifest(privateKey, manifest)
+	if !bytes.Equal(data, service.MarshalTelegramExportManifest(privateKey, manifest)) {
+		t.Error("expected the manifest to marshal the same way twice")
+	}
+
+	var decoded struct {
+		Version         string   `json:"version"`
+		UserTelegramIDs []uint64 `json:"user_telegram_ids"`
+		CreatedAt       string   `json:"created_at"`
+		PublicKey       []byte   `json:"public_key"`
+		Files           []struct {
+			Path string `json:"path"`
+		} `json:"files"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("expected a JSON manifest, got %v", err)
+	}
+	if decoded.Version != service.TelegramExportManifestVersion {
+		t.Errorf("expected version %s, got %s", service.TelegramExportManifestVersion, decoded.Version)
+	}
+	if decoded.UserTelegramIDs == nil {
+		t.Error("expected an empty selection to be listed as an empty array")
+	}
+	if decoded.CreatedAt != "2024-01-15T08:00:00.123456Z" {
+		t.Errorf("expected the creation time in UTC, got %s", decoded.CreatedAt)
+	}
+	if !bytes.Equal(decoded.PublicKey, publicKey) {
+		t.Error("expected the manifest to hold the public key")
+	}
+	if len(decoded.Files) != 1 || decoded.Files[0].Path != "records.json" {
+		t.Errorf("expected the files to be listed, got %+v", decoded.Files)
+	}
+}
+
+func TestTelegramExportManifestSignature(t *testing.T) {
+	publicKey, privateKey, _ := ed25519.GenerateKey(nil)
+	otherPublicKey, _, _ := ed25519.GenerateKey(nil)
+	manifest := service.MarshalTelegramExportManifest(privateKey, &domain.TelegramExportManifest{ExportID: uuid.New()})
+	signature := service.SignTelegramExportManifest(privateKey, manifest)
+
+	if !service.VerifyTelegramExportManifest(publicKey, manifest, signature) {
+		t.Error("expected the signature to be verified")
+	}
+	if service.VerifyTelegramExportManifest(otherPublicKey, manifest, signature) {
+		t.Error("expected signature with another key to be rejected")
+	}
+	tampered := bytes.Replace(manifest, []byte(`"v1"`), []byte(`"v2"`), 1)
+	if service.VerifyTelegramExportManifest(publicKey, tampered, signature) {
+		t.Error("expected signature of a tampered manifest to be rejected")
+	}
+}
@@ -0,0 +1,418 @@
+package service
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+)
+
+var (
+	emailPattern   = regexp.MustCompile(`(?i)[a-z0-9._%+-]+@[a-z0-9-]+(?:\.[a-z0-9-]+)*\.[a-z]{2,}`)
+	urlPattern     = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>"]+`)
+	mentionPattern = regexp.MustCompile(`(?:^|[^\w@.])@([A-Za-z][A-Za-z0-9_]{3,31})\b`)
+	hashtagPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_#])#([\p{L}\p{N}_]*\p{L}[\p{L}\p{N}_]*)`)
+	phonePattern   = regexp.MustCompile(`\+?\d[\d ().-]{5,}\d`)
+	ibanPattern    = regexp.MustCompile(`\b[A-Z]{2}\d{2}(?: ?[A-Z0-9]){11,30}\b`)
+	ethPattern     = regexp.MustCompile(`\b0x[0-9a-fA-F]{40}\b`)
+	bech32Pattern  = regexp.MustCompile(`\b(?:bc1|BC1)[02-9ac-hj-np-zAC-HJ-NP-Z]{25,87}\b`)
+	base58Pattern  = regexp.MustCompile(`\b[13T][1-9A-HJ-NP-Za-km-z]{25,34}\b`)
+)
+
+const (
+	minPhoneDigits = 7
+	// Numbers without the country code prefix are told apart from dates, amounts etc. by their length
+	minLocalPhoneDigits = 10
+	maxPhoneDigits      = 15
+	minIBANLength       = 15
+	maxIBANLength       = 34
+)
+
+// ExtractedIndicator is a normalised indicator found in the content of a record.
+type ExtractedIndicator struct {
+	Type  domain.TelegramIndicatorType
+	Value string
+}
+
+// TelegramIndicatorExtractor finds phone numbers, emails, URLs, mentions, hashtags, IBANs
+// and crypto wallet addresses in records. Matches are verified where the format allows it,
+// e.g. IBAN and wallet checksums, to keep false positives out.
+type TelegramIndicatorExtractor struct{}
+
+func NewTelegramIndicatorExtractor() *TelegramIndicatorExtractor {
+	return &TelegramIndicatorExtractor{}
+}
+
+// Extract returns the distinct indicators of the text and the caption of the record,
+// including the ones Telegram has detected as entities and the links hidden behind text.
+func (extractor *TelegramIndicatorExtractor) Extract(record *domain.TelegramRecord) []ExtractedIndicator {
+	collected := &indicatorSet{seen: make(map[ExtractedIndicator]bool)}
+	for _, content := range []struct {
+		text     string
+		entities []domain.TelegramMessageEntity
+	}{
+		{record.MessageText, record.Entities},
+		{record.Caption, record.CaptionEntities},
+	} {
+		extractor.extractFromText(collected, content.text)
+		extractor.extractFromEntities(collected, content.text, content.entities)
+	}
+	return collected.indicators
+}
+
+// Normalize brings a value to the form indicators of the type are stored in, so that it can be looked up.
+// It returns false when the value isn't a valid indicator of the type.
+func (extractor *TelegramIndicatorExtractor) Normalize(
+	indicatorType domain.TelegramIndicatorType,
+	value string,
+) (string, bool) {
+	value = strings.TrimSpace(value)
+	switch indicatorType {
+	case domain.TelegramIndicatorTypePhone:
+		return normalizePhone(value, minPhoneDigits)
+	case domain.TelegramIndicatorTypeEmail:
+		if !emailPattern.MatchString(value) || emailPattern.FindString(value) != value {
+			return "", false
+		}
+		return strings.ToLower(value), true
+	case domain.TelegramIndicatorTypeURL:
+		return normalizeURL(value)
+	case domain.TelegramIndicatorTypeMention:
+		return normalizeMention(value)
+	case domain.TelegramIndicatorTypeHashtag:
+		return normalizeHashtag(value)
+	case domain.TelegramIndicatorTypeIBAN:
+		return normalizeIBAN(value)
+	case domain.TelegramIndicatorTypeCryptoWallet:
+		return normalizeWallet(value)
+	default:
+		return "", false
+	}
+}
+
+type indicatorSet struct {
+	seen       map[ExtractedIndicator]bool
+	indicators []ExtractedIndicator
+}
+
+func (set *indicatorSet) add(indicatorType domain.TelegramIndicatorType, value string, ok bool) {
+	indicator := ExtractedIndicator{Type: indicatorType, Value: value}
+	if !ok || set.seen[indicator] {
+		return
+	}
+	set.seen[indicator] = true
+	set.indicators = append(set.indicators, indicator)
+}
+
+// adder lets the result of a normalize function be passed to add as is.
+func (set *indicatorSet) adder(indicatorType domain.TelegramIndicatorType) func(string, bool) {
+	return func(value string, ok bool) {
+		set.add(indicatorType, value, ok)
+	}
+}
+
+func (extractor *TelegramIndicatorExtractor) extractFromText(collected *indicatorSet, text string) {
+	if text == "" {
+		return
+	}
+	// Phones, mentions and hashtags are also found inside of links and emails, so their spans are skipped
+	var covered [][]int
+	for _, span := range urlPattern.FindAllStringIndex(text, -1) {
+		covered = append(covered, span)
+		collected.adder(domain.TelegramIndicatorTypeURL)(normalizeURL(text[span[0]:span[1]]))
+	}
+	for _, span := range emailPattern.FindAllStringIndex(text, -1) {
+		if isCovered(covered, span) {
+			continue
+		}
+		covered = append(covered, span)
+		collected.add(domain.TelegramIndicatorTypeEmail, strings.ToLower(text[span[0]:span[1]]), true)
+	}
+	for _, match := range mentionPattern.FindAllStringSubmatchIndex(text, -1) {
+		if !isCovered(covered, match[2:4]) {
+			collected.adder(domain.TelegramIndicatorTypeMention)(normalizeMention(text[match[2]:match[3]]))
+		}
+	}
+	for _, match := range hashtagPattern.FindAllStringSubmatchIndex(text, -1) {
+		if !isCovered(covered, match[2:4]) {
+			collected.adder(domain.TelegramIndicatorTypeHashtag)(normalizeHashtag(text[match[2]:match[3]]))
+		}
+	}
+	for _, span := range ibanPattern.FindAllStringIndex(text, -1) {
+		if value, ok := normalizeIBAN(text[span[0]:span[1]]); ok {
+			covered = append(covered, span)
+			collected.add(domain.TelegramIndicatorTypeIBAN, value, true)
+		}
+	}
+	for _, pattern := range []*regexp.Regexp{ethPattern, bech32Pattern, base58Pattern} {
+		for _, span := range pattern.FindAllStringIndex(text, -1) {
+			if value, ok := normalizeWallet(text[span[0]:span[1]]); ok && !isCovered(covered, span) {
+				covered = append(covered, span)
+				collected.add(domain.TelegramIndicatorTypeCryptoWallet, value, true)
+			}
+		}
+	}
+	for _, span := range phonePattern.FindAllStringIndex(text, -1) {
+		if isCovered(covered, span) {
+			continue
+		}
+		candidate := text[span[0]:span[1]]
+		// Bare digits are only taken for a phone when they are written like one
+		if !strings.HasPrefix(candidate, "+") && !strings.ContainsAny(candidate, " ()-.") {
+			continue
+		}
+		collected.adder(domain.TelegramIndicatorTypePhone)(normalizePhone(candidate, minLocalPhoneDigits))
+	}
+}
+
+func (extractor *TelegramIndicatorExtractor) extractFromEntities(
+	collected *indicatorSet,
+	text string,
+	entities []domain.TelegramMessageEntity,
+) {
+	if len(entities) == 0 {
+		return
+	}
+	encoded := utf16.Encode([]rune(text))
+	for _, entity := range entities {
+		if entity.Offset+entity.Length > len(encoded) {
+			continue
+		}
+		entityText := string(utf16.Decode(encoded[entity.Offset : entity.Offset+entity.Length]))
+		switch entity.Type {
+		case domain.TelegramMessageEntityTypeTextLink:
+			collected.adder(domain.TelegramIndicatorTypeURL)(normalizeURL(entity.URL))
+		case domain.TelegramMessageEntityTypeURL:
+			collected.adder(domain.TelegramIndicatorTypeURL)(normalizeURL(entityText))
+		case domain.TelegramMessageEntityTypeEmail:
+			collected.add(domain.TelegramIndicatorTypeEmail, strings.ToLower(entityText), true)
+		case domain.TelegramMessageEntityTypePhoneNumber:
+			collected.adder(domain.TelegramIndicatorTypePhone)(normalizePhone(entityText, minPhoneDigits))
+		case domain.TelegramMessageEntityTypeMention:
+			collected.adder(domain.TelegramIndicatorTypeMention)(normalizeMention(entityText))
+		case domain.TelegramMessageEntityTypeHashtag:
+			collected.adder(domain.TelegramIndicatorTypeHashtag)(normalizeHashtag(entityText))
+		default:
+		}
+	}
+}
+
+func isCovered(covered [][]int, span []int) bool {
+	for _, coveredSpan := range covered {
+		if span[0] < coveredSpan[1] && coveredSpan[0] < span[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizePhone keeps the digits and the leading plus, numbers without it must have at least minDigits.
+func normalizePhone(value string, minDigits int) (string, bool) {
+	var digits strings.Builder
+	for _, r := range value {
+		if r >= '0' && r <= '9' {
+			digits.WriteRune(r)
+		}
+	}
+	if strings.HasPrefix(value, "+") {
+		minDigits = minPhoneDigits
+	}
+	if digits.Len() < minDigits || digits.Len() > maxPhoneDigits {
+		return "", false
+	}
+	if strings.HasPrefix(value, "+") {
+		return "+" + digits.String(), true
+	}
+	return digits.String(), true
+}
+
+// normalizeURL lowercases the scheme and the host and drops the fragment and the punctuation the link ends a sentence with.
+func normalizeURL(value string) (string, bool) {
+	value = strings.TrimRight(value, ".,;:!?)]}'\"")
+	if !strings.Contains(value, "://") {
+		value = "http://" + value
+	}
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Host == "" {
+		return "", false
+	}
+	parsed.Scheme = strings.ToLower(parsed.Scheme)
+	parsed.Host = strings.ToLower(parsed.Host)
+	parsed.Fragment = ""
+	parsed.RawFragment = ""
+	if parsed.Path == "/" {
+		parsed.Path = ""
+	}
+	return parsed.String(), true
+}
+
+// normalizeMention lowercases the username without the @, like Telegram compares them.
+func normalizeMention(value string) (string, bool) {
+	username := strings.ToLower(strings.TrimPrefix(value, "@"))
+	matches := mentionPattern.FindStringSubmatch("@" + username)
+	if matches == nil || matches[1] != username {
+		return "", false
+	}
+	return username, true
+}
+
+func normalizeHashtag(value string) (string, bool) {
+	tag := strings.ToLower(strings.TrimPrefix(value, "#"))
+	matches := hashtagPattern.FindStringSubmatch("#" + tag)
+	if matches == nil || matches[1] != tag {
+		return "", false
+	}
+	return tag, true
+}
+
+// normalizeIBAN strips the spaces and verifies the mod 97 checksum of ISO 13616.
+func normalizeIBAN(value string) (string, bool) {
+	iban := strings.ToUpper(strings.ReplaceAll(value, " ", ""))
+	if len(iban) < minIBANLength || len(iban) > maxIBANLength {
+		return "", false
+	}
+	// The country code and the check digits are moved to the end and the letters are replaced by numbers
+	var numeric strings.Builder
+	for _, r := range iban[4:] + iban[:4] {
+		switch {
+		case r >= '0' && r <= '9':
+			numeric.WriteRune(r)
+		case r >= 'A' && r <= 'Z':
+			numeric.WriteString(strconv.Itoa(int(r-'A') + 10))
+		default:
+			return "", false
+		}
+	}
+	remainder := 0
+	for _, digit := range numeric.String() {
+		remainder = (remainder*10 + int(digit-'0')) % 97
+	}
+	if remainder != 1 {
+		return "", false
+	}
+	return iban, true
+}
+
+// normalizeWallet verifies Ethereum, Bitcoin (legacy and SegWit) and Tron addresses.
+// Ethereum and SegWit addresses are case-insensitive, so they are lowercased.
+func normalizeWallet(value string) (string, bool) {
+	switch {
+	case ethPattern.MatchString(value) && len(value) == 42:
+		return strings.ToLower(value), true
+	case bech32Pattern.MatchString(value) && len(bech32Pattern.FindString(value)) == len(value):
+		address := strings.ToLower(value)
+		if value != address && value != strings.ToUpper(value) {
+			return "", false
+		}
+		return address, validBech32(address)
+	case base58Pattern.MatchString(value) && len(base58Pattern.FindString(value)) == len(value):
+		return value, validBase58Address(value)
+	default:
+		return "", false
+	}
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// Version bytes of the addresses with a base58check encoding.
+const (
+	bitcoinP2PKHVersion = 0x00
+	bitcoinP2SHVersion  = 0x05
+	tronVersion         = 0x41
+	base58AddressLength = 25
+	base58ChecksumSize  = 4
+)
+
+func validBase58Address(address string) bool {
+	decoded := big.NewInt(0)
+	radix := big.NewInt(int64(len(base58Alphabet)))
+	for _, r := range address {
+		index := strings.IndexRune(base58Alphabet, r)
+		if index < 0 {
+			return false
+		}
+		decoded.Mul(decoded, radix)
+		decoded.Add(decoded, big.NewInt(int64(index)))
+	}
+	payload := decoded.Bytes()
+	// Leading ones stand for the zero bytes the number has lost
+	for i := 0; i < len(address) && address[i] == '1'; i++ {
+		payload = append([]byte{0}, payload...)
+	}
+	if len(payload) != base58AddressLength {
+		return false
+	}
+	switch payload[0] {
+	case bitcoinP2PKHVersion, bitcoinP2SHVersion:
+		if address[0] == 'T' {
+			return false
+		}
+	case tronVersion:
+		if address[0] != 'T' {
+			return false
+		}
+	default:
+		return false
+	}
+	body := payload[:base58AddressLength-base58ChecksumSize]
+	first := sha256.Sum256(body)
+	second := sha256.Sum256(first[:])
+	return string(second[:base58ChecksumSize]) == string(payload[base58AddressLength-base58ChecksumSize:])
+}
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// Checksum constants of BIP 173 for SegWit v0 and of BIP 350 for the later versions.
+const (
+	bech32Constant  = 1
+	bech32mConstant = 0x2bc830a3
+)
+
+func validBech32(address string) bool {
+	separator := strings.LastIndexByte(address, '1')
+	if separator < 1 || separator+7 > len(address) {
+		return false
+	}
+	hrp, data := address[:separator], address[separator+1:]
+	values := make([]int, 0, len(hrp)*2+1+len(data))
+	for i := range len(hrp) {
+		values = append(values, int(hrp[i])>>5)
+	}
+	values = append(values, 0)
+	for i := range len(hrp) {
+		values = append(values, int(hrp[i])&31)
+	}
+	for _, r := range data {
+		index := strings.IndexRune(bech32Charset, r)
+		if index < 0 {
+			return false
+		}
+		values = append(values, index)
+	}
+	checksum := bech32Polymod(values)
+	witnessVersion := values[len(hrp)*2+1]
+	if witnessVersion == 0 {
+		return checksum == bech32Constant
+	}
+	return checksum == bech32mConstant
+}
+
+func bech32Polymod(values []int) int {
+	generator := [5]int{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	checksum := 1
+	for _, value := range values {
+		top := checksum >> 25
+		checksum = (checksum&0x1ffffff)<<5 ^ value
+		for i := range 5 {
+			if (top>>i)&1 == 1 {
+				checksum ^= generator[i]
+			}
+		}
+	}
+	return checksum
+}
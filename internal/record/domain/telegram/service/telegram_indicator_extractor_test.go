@@ -0,0 +1,92 @@
+package service_test
+
+import (
+	"slices"
+	"testing"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+)
+
+func TestExtractIndicators(t *testing.T) {
+	record := &domain.TelegramRecord{
+		MessageText: "Contact @John_Doe or JOHN@Example.com, call +1 (555) 123-4567. " +
+			"Docs at https://Example.com/path?q=1#top. #Sale #sale " +
+			"IBAN DE89 3704 0044 0532 0130 00, wallets 0xde0B295669a9FD93d5F28D9Ec85E40f4cb697BAe, " +
+			"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa, bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq " +
+			"and TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t",
+		Caption: "see docs",
+		CaptionEntities: []domain.TelegramMessageEntity{
+			{Type: domain.TelegramMessageEntityTypeTextLink, Offset: 4, Length: 4, URL: "https://docs.example.org/"},
+		},
+	}
+	expected := []service.ExtractedIndicator{
+		{Type: domain.TelegramIndicatorTypeURL, Value: "https://example.com/path?q=1"},
+		{Type: domain.TelegramIndicatorTypeEmail, Value: "john@example.com"},
+		{Type: domain.TelegramIndicatorTypeMention, Value: "john_doe"},
+		{Type: domain.TelegramIndicatorTypeHashtag, Value: "sale"},
+		{Type: domain.TelegramIndicatorTypeIBAN, Value: "DE89370400440532013000"},
+		{Type: domain.TelegramIndicatorTypeCryptoWallet, Value: "0xde0b295669a9fd93d5f28d9ec85e40f4cb697bae"},
+		{Type: domain.TelegramIndicatorTypeCryptoWallet, Value: "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq"},
+		{Type: domain.TelegramIndicatorTypeCryptoWallet, Value: "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa"},
+		{Type: domain.TelegramIndicatorTypeCryptoWallet, Value: "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"},
+		{Type: domain.TelegramIndicatorTypePhone, Value: "+15551234567"},
+		{Type: domain.TelegramIndicatorTypeURL, Value: "https://docs.example.org"},
+	}
+	indicators := service.NewTelegramIndicatorExtractor().Extract(record)
+	if !slices.Equal(indicators, expected) {
+		t.Errorf("expected indicators\n%v\ngot\n%v", expected, indicators)
+	}
+}
+
+func TestExtractIndicatorsIgnoresLookalikes(t *testing.T) {
+	record := &domain.TelegramRecord{
+		MessageText: "Paid 1500000 on 2024-01-15, order 12345678901. " +
+			"Fake IBAN DE00 3704 0044 0532 0130 00 and wallet 1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNb, " +
+			"no mention in mail@host.com or issue#12",
+	}
+	expected := []service.ExtractedIndicator{
+		{Type: domain.TelegramIndicatorTypeEmail, Value: "mail@host.com"},
+	}
+	indicators := service.NewTelegramIndicatorExtractor().Extract(record)
+	if !slices.Equal(indicators, expected) {
+		t.Errorf("expected indicators %v, got %v", expected, indicators)
+	}
+}
+
+func TestNormalizeIndicator(t *testing.T) {
+	cases := []struct {
+		indicatorType domain.TelegramIndicatorType
+		value         string
+		expected      string
+		valid         bool
+	}{
+		{domain.TelegramIndicatorTypeMention, "@John_Doe", "john_doe", true},
+		{domain.TelegramIndicatorTypeMention, "@ab", "", false},
+		{domain.TelegramIndicatorTypeHashtag, "#Sale", "sale", true},
+		{domain.TelegramIndicatorTypePhone, "+44 20 7946 0958", "+442079460958", true},
+		{domain.TelegramIndicatorTypePhone, "12", "", false},
+		{domain.TelegramIndicatorTypeEmail, "Mail@Host.COM", "mail@host.com", true},
+		{domain.TelegramIndicatorTypeURL, "www.Example.com/", "http://www.example.com", true},
+		{domain.TelegramIndicatorTypeIBAN, "gb82 west 1234 5698 7654 32", "GB82WEST12345698765432", true},
+		{domain.TelegramIndicatorTypeIBAN, "GB83WEST12345698765432", "", false},
+		{
+			domain.TelegramIndicatorTypeCryptoWallet,
+			"bc1p5d7rjq7g6rdk2yhzks9smlaqtedr4dekq08ge8ztwac72sfr9rusxg3297",
+			"bc1p5d7rjq7g6rdk2yhzks9smlaqtedr4dekq08ge8ztwac72sfr9rusxg3297",
+			true,
+		},
+		{domain.TelegramIndicatorTypeCryptoWallet, "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", true},
+		{domain.TelegramIndicatorType("unknown"), "value", "", false},
+	}
+	extractor := service.NewTelegramIndicatorExtractor()
+	for _, testCase := range cases {
+		value, valid := extractor.Normalize(testCase.indicatorType, testCase.value)
+		if value != testCase.expected || valid != testCase.valid {
+			t.Errorf(
+				"expected %s %q to normalise to %q (%t), got %q (%t)",
+				testCase.indicatorType, testCase.value, testCase.expected, testCase.valid, value, valid,
+			)
+		}
+	}
+}
@@ -18,5 +18,13 @@ func (service *TelegramModelValidator) Validate(model any) error {
 	if err != nil {
 		return domain.ErrValidationFailed
 	}
+	// Entity offsets depend on the text they format, which struct tags can't express
+	if record, ok := model.(*domain.TelegramRecord); ok && !record.HasEntitiesWithinText() {
+		return domain.ErrValidationFailed
+	}
+	// Either list of the selection may be empty, but not both
+	if export, ok := model.(*domain.TelegramExport); ok && export.Selection.IsEmpty() {
+		return domain.ErrValidationFailed
+	}
 	return nil
 }
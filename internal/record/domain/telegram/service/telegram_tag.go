@@ -0,0 +1,29 @@
+package service
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxTelegramTagLength is the amount of characters a tag is made of at most.
+const MaxTelegramTagLength = 64
+
+// NormalizeTelegramTag brings a tag to the form it's stored and looked up in: trimmed, lower case,
+// with the spaces turned into dashes. It returns false when the tag is empty, too long or holds characters
+// other than letters, digits, dashes and underscores.
+func NormalizeTelegramTag(value string) (string, bool) {
+	tag := strings.Join(strings.Fields(strings.ToLower(value)), "-")
+	if tag == "" || utf8.RuneCountInString(tag) > MaxTelegramTagLength {
+		return "", false
+	}
+	for _, r := range tag {
+		if !isTelegramTagRune(r) {
+			return "", false
+		}
+	}
+	return tag, true
+}
+
+func isTelegramTagRune(r rune) bool {
+	return r == '-' || r == '_' || (r >= '0' && r <= '9') || (r >= 'a' && r <= 'z')
+}
@@ -0,0 +1,33 @@
+package service_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+)
+
+func TestNormalizeTelegramTag(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+		valid    bool
+	}{
+		{value: "scammer", expected: "scammer", valid: true},
+		{value: "  Verified ", expected: "verified", valid: true},
+		{value: "crypto  Giveaway", expected: "crypto-giveaway", valid: true},
+		{value: "bot_net-2", expected: "bot_net-2", valid: true},
+		{value: "", valid: false},
+		{value: "   ", valid: false},
+		{value: "#scammer", valid: false},
+		{value: "мошенник", valid: false},
+		{value: strings.Repeat("a", service.MaxTelegramTagLength), expected: strings.Repeat("a", 64), valid: true},
+		{value: strings.Repeat("a", service.MaxTelegramTagLength+1), valid: false},
+	}
+	for _, test := range tests {
+		tag, valid := service.NormalizeTelegramTag(test.value)
+		if valid != test.valid || tag != test.expected {
+			t.Errorf("NormalizeTelegramTag(%q) = %q, %v, expected %q, %v", test.value, tag, valid, test.expected, test.valid)
+		}
+	}
+}
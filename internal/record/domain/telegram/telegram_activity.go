@@ -0,0 +1,48 @@
+package domain
+
+import "time"
+
+// TelegramActivityScope selects the records activity statistics are computed over.
+type TelegramActivityScope string
+
+const (
+	// TelegramActivityScopeUser covers the records sent by a Telegram user, broken down by chat.
+	TelegramActivityScopeUser TelegramActivityScope = "user"
+	// TelegramActivityScopeChat covers the records sent in a Telegram chat, broken down by sender.
+	TelegramActivityScopeChat TelegramActivityScope = "chat"
+)
+
+// TelegramActivityStatistics describe when and how much a user or a chat has been active. Messages collected
+// by several users are counted once, the days and hours are in the timezone the statistics were requested in.
+type TelegramActivityStatistics struct {
+	MessageCount int
+	// AverageMessageLength is the average amount of characters of the messages having text
+	AverageMessageLength float64
+	FirstSeenAt          *time.Time
+	LastSeenAt           *time.Time
+	Daily                []TelegramDailyActivity
+	// HourOfWeek holds the amount of messages by weekday, Monday first, and hour
+	HourOfWeek [7][24]int
+	Breakdown  []TelegramActivityBreakdown
+	// Gaps are the longest periods without messages, the longest first
+	Gaps []TelegramActivityGap
+}
+
+type TelegramDailyActivity struct {
+	Day          time.Time
+	MessageCount int
+}
+
+// TelegramActivityBreakdown is the activity of a user in a chat, TelegramID is the chat for the statistics
+// of a user and the user for the statistics of a chat.
+type TelegramActivityBreakdown struct {
+	TelegramID   int64
+	MessageCount int
+	FirstSeenAt  time.Time
+	LastSeenAt   time.Time
+}
+
+type TelegramActivityGap struct {
+	StartedAt time.Time
+	EndedAt   time.Time
+}
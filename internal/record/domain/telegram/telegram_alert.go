@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrAlertNotFound = errors.New("alert not found")
+
+// TelegramAlert is raised for the owner of a watchlist entry when a record or an identity matching it is added.
+// Exactly one of RecordID and IdentityID is set.
+type TelegramAlert struct {
+	ID          uuid.UUID
+	OwnerUserID uuid.UUID
+	WatchlistID uuid.UUID
+	EntryID     uuid.UUID
+	EntryType   TelegramWatchlistEntryType
+	EntryValue  string
+	RecordID    *uuid.UUID
+	IdentityID  *uuid.UUID
+	TriggeredAt time.Time
+	ReadAt      *time.Time
+}
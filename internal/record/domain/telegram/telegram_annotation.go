@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTagNotFound      = errors.New("tag not found")
+	ErrTagAlreadyExists = errors.New("tag already exists")
+	ErrNoteNotFound     = errors.New("note not found")
+	ErrInvalidTag       = errors.New("tag must be made of at most 64 letters, digits, dashes and underscores")
+)
+
+// TelegramAnnotationTargetType is the kind of telegram data a tag or a note is attached to.
+type TelegramAnnotationTargetType string
+
+const (
+	TelegramAnnotationTargetTypeUser     TelegramAnnotationTargetType = "telegram_user"
+	TelegramAnnotationTargetTypeIdentity TelegramAnnotationTargetType = "telegram_identity"
+	TelegramAnnotationTargetTypeRecord   TelegramAnnotationTargetType = "telegram_record"
+)
+
+// Valid tells whether the type is one of the kinds annotations are attached to.
+func (targetType TelegramAnnotationTargetType) Valid() bool {
+	switch targetType {
+	case TelegramAnnotationTargetTypeUser, TelegramAnnotationTargetTypeIdentity, TelegramAnnotationTargetTypeRecord:
+		return true
+	default:
+		return false
+	}
+}
+
+// TelegramTag labels the telegram user, identity or record with the TargetID, the Name is normalised.
+// A target holds a tag once, it's attributed to the platform user who has added it first.
+type TelegramTag struct {
+	ID         uuid.UUID                    `validate:"required,uuid"`
+	TargetType TelegramAnnotationTargetType `validate:"required,oneof=telegram_user telegram_identity telegram_record"`
+	TargetID   uuid.UUID                    `validate:"required,uuid"`
+	Name       string                       `validate:"required,min=1,max=64"`
+	AddedBy    uuid.UUID                    `validate:"required,uuid"`
+	AddedAt    time.Time                    `validate:"required"`
+}
+
+// TelegramTagCount is a tag of the catalogue along with the amount of targets holding it.
+type TelegramTagCount struct {
+	Name  string
+	Count int64
+}
+
+// TelegramNote is a markdown note of an analyst about the telegram user, identity or record with the TargetID.
+type TelegramNote struct {
+	ID         uuid.UUID                    `validate:"required,uuid"`
+	TargetType TelegramAnnotationTargetType `validate:"required,oneof=telegram_user telegram_identity telegram_record"`
+	TargetID   uuid.UUID                    `validate:"required,uuid"`
+	Body       string                       `validate:"required,min=1,max=16384"`
+	AddedBy    uuid.UUID                    `validate:"required,uuid"`
+	AddedAt    time.Time                    `validate:"required"`
+}
@@ -1,13 +1,30 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"errors"
+	"time"
 
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAttachmentNotFound                 = errors.New("attachment not found")
+	ErrAttachmentAlreadyExists            = errors.New("attachment already exists")
+	ErrUnexistentTelegramRecordReferenced = errors.New("telegram record does not exist")
+)
+
+// TelegramAttachment is a file (photo, video, document, voice) attached to a record.
+// The content itself lives in the blob storage under StorageKey, which is derived from SHA256,
+// so the same file attached to many records is stored once.
 type TelegramAttachment struct {
-	ID                   uuid.UUID
+	ID                   uuid.UUID `validate:"required,uuid"`
+	RecordID             uuid.UUID `validate:"required,uuid"`
 	TelegramAttachmentID uint64
-	FileName             string
-	StorageKey           string
-	FileSizeType         uint64
-	MimeType             string
-	AddedByUser          uuid.UUID
+	FileName             string    `validate:"max=255"`
+	StorageKey           string    `validate:"required,max=255"`
+	SHA256               string    `validate:"required,len=64,hexadecimal"`
+	FileSize             uint64    `validate:"required,gt=0"`
+	MimeType             string    `validate:"required,max=255"`
+	AddedAt              time.Time `validate:"required"`
+	AddedByUser          uuid.UUID `validate:"required,uuid"`
 }
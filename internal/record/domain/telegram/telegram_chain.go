@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrTelegramChainNotFound = errors.New("no telegram chain for this collector")
+
+// TelegramChainSubjectType is the kind of archived data a chain entry covers.
+type TelegramChainSubjectType string
+
+const (
+	TelegramChainSubjectRecord     TelegramChainSubjectType = "record"
+	TelegramChainSubjectIdentity   TelegramChainSubjectType = "identity"
+	TelegramChainSubjectAttachment TelegramChainSubjectType = "attachment"
+)
+
+// TelegramChainEntry links the content hash of an archived record, identity or attachment to the previous entry
+// of the chain of its collector, the user who has added it. Every change of the content, e.g. a new version
+// of a record, appends another entry, so the latest entry of a subject matches its current content.
+type TelegramChainEntry struct {
+	CollectorUserID uuid.UUID
+	Sequence        int64
+	SubjectType     TelegramChainSubjectType
+	SubjectID       uuid.UUID
+	ContentHash     string
+	PreviousHash    string
+	EntryHash       string
+	AddedAt         time.Time
+	// Superseded is set when a later entry covers the same subject, it's only known when the chain is read back
+	Superseded bool
+}
+
+// TelegramChainHead is the last entry of the chain of a collector, Sequence is 0 while the chain is empty.
+// CheckpointedSequence is the sequence of its latest checkpoint, 0 when it has none. A collector has a chain
+// per team it has archived data in, TeamID is the team of the chain.
+type TelegramChainHead struct {
+	CollectorUserID      uuid.UUID
+	TeamID               uuid.NullUUID
+	Sequence             int64
+	EntryHash            string
+	CheckpointedSequence int64
+}
+
+// TelegramChainCheckpoint is the head of a chain signed with the Ed25519 key of the server. Once signed,
+// the entries up to Sequence can't be rewritten, removed or reordered without the checkpoint revealing it.
+// Signature and PublicKey are base64 encoded.
+type TelegramChainCheckpoint struct {
+	ID              uuid.UUID
+	CollectorUserID uuid.UUID
+	TeamID          uuid.NullUUID
+	Sequence        int64
+	EntryHash       string
+	PublicKey       string
+	Signature       string
+	SignedAt        time.Time
+}
+
+// TelegramChainBreakReason tells how the archived data differs from its chain.
+type TelegramChainBreakReason string
+
+const (
+	// TelegramChainBreakSequenceGap is reported when an entry has been removed or inserted.
+	TelegramChainBreakSequenceGap TelegramChainBreakReason = "sequence_gap"
+	// TelegramChainBreakBrokenLink is reported when an entry doesn't reference the hash of the previous one.
+	TelegramChainBreakBrokenLink TelegramChainBreakReason = "broken_link"
+	// TelegramChainBreakEntryMismatch is reported when an entry has been altered.
+	TelegramChainBreakEntryMismatch TelegramChainBreakReason = "entry_mismatch"
+	// TelegramChainBreakContentMismatch is reported when the subject has been altered after its latest entry.
+	TelegramChainBreakContentMismatch TelegramChainBreakReason = "content_mismatch"
+	// TelegramChainBreakSubjectMissing is reported when the subject of the latest entry has been removed.
+	TelegramChainBreakSubjectMissing TelegramChainBreakReason = "subject_missing"
+	// TelegramChainBreakHeadMismatch is reported when the head doesn't point to the last entry of the chain.
+	TelegramChainBreakHeadMismatch TelegramChainBreakReason = "head_mismatch"
+	// TelegramChainBreakCheckpointMismatch is reported when the chain differs from what a checkpoint has signed,
+	// e.g. it has been rewritten or truncated.
+	TelegramChainBreakCheckpointMismatch TelegramChainBreakReason = "checkpoint_mismatch"
+	// TelegramChainBreakInvalidSignature is reported when a checkpoint hasn't been signed with the key
+	// of the server.
+	TelegramChainBreakInvalidSignature TelegramChainBreakReason = "invalid_signature"
+)
+
+// TelegramChainBreak is a single finding of a verification. SubjectType and SubjectID are only set
+// for the breaks about a subject.
+type TelegramChainBreak struct {
+	CollectorUserID uuid.UUID
+	Sequence        int64
+	SubjectType     TelegramChainSubjectType
+	SubjectID       uuid.UUID
+	Reason          TelegramChainBreakReason
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrChatNotFound                     = errors.New("chat not found")
+	ErrChatAlreadyExists                = errors.New("chat already exists")
+	ErrChatSnapshotAlreadyExists        = errors.New("chat snapshot already exists")
+	ErrUnexistentTelegramChatReferenced = errors.New("telegram chat ID does not exist")
+)
+
+type TelegramChatType string
+
+// All chat types Enum. Mirrors the `type` field of the Bot API Chat object.
+const (
+	TelegramChatTypePrivate    TelegramChatType = "private"
+	TelegramChatTypeGroup      TelegramChatType = "group"
+	TelegramChatTypeSupergroup TelegramChatType = "supergroup"
+	TelegramChatTypeChannel    TelegramChatType = "channel"
+	// TelegramChatTypeUnknown is the type of a chat only known from the records posted in it,
+	// until its metadata is added.
+	TelegramChatTypeUnknown TelegramChatType = "unknown"
+)
+
+// TelegramChat is a chat or a channel a record has been posted in.
+// The metadata that changes over time lives in TelegramChatSnapshot.
+type TelegramChat struct {
+	ID             uuid.UUID        `validate:"required,uuid"`
+	ChatTelegramID int64            `validate:"required"`
+	Type           TelegramChatType `validate:"required,oneof=private group supergroup channel"`
+	AddedAt        time.Time        `validate:"required"`
+	AddedByUser    uuid.UUID        `validate:"required,uuid"`
+}
+
+// TelegramChatSnapshot Validation domain rules according to https://limits.tginfo.me/en.
+type TelegramChatSnapshot struct {
+	ID          uuid.UUID `validate:"required,uuid"`
+	ChatID      uuid.UUID `validate:"required,uuid"`
+	Title       string    `validate:"max=128"`
+	Username    string    `validate:"omitempty,min=4,max=32"`
+	MemberCount uint64    `validate:"lte=200000000"`
+	Description string    `validate:"max=255"`
+	AddedAt     time.Time `validate:"required"`
+	AddedByUser uuid.UUID `validate:"required,uuid"`
+}
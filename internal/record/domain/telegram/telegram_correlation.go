@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TelegramCorrelationType is the identity attribute the users of a correlation cluster share.
+type TelegramCorrelationType string
+
+const (
+	// TelegramCorrelationTypePhone links the users whose identities have the same phone number.
+	TelegramCorrelationTypePhone TelegramCorrelationType = "phone"
+	// TelegramCorrelationTypeUsername links the users that held the same username, at the same time or one after another.
+	TelegramCorrelationTypeUsername TelegramCorrelationType = "username"
+	// TelegramCorrelationTypeBio links the users whose identities have the same bio.
+	TelegramCorrelationTypeBio TelegramCorrelationType = "bio"
+)
+
+// TelegramCorrelationMember is a user of a correlation cluster with the identities the shared value was seen in.
+type TelegramCorrelationMember struct {
+	UserTelegramID uint64
+	IdentityIDs    []uuid.UUID
+	FirstSeenAt    time.Time
+	LastSeenAt     time.Time
+}
+
+// TelegramCorrelationCluster is a group of different Telegram users whose identities share a normalised value.
+type TelegramCorrelationCluster struct {
+	Type    TelegramCorrelationType
+	Value   string
+	Members []TelegramCorrelationMember
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTelegramExportNotFound     = errors.New("export not found")
+	ErrTelegramExportNotCompleted = errors.New("export has not been completed")
+	// ErrTelegramExportUnsigned means no signing key is configured, so no export can be produced.
+	ErrTelegramExportUnsigned = errors.New("exports can't be signed without a signing key")
+	// ErrTelegramExportTooLarge means the selection holds more records than a single export may.
+	ErrTelegramExportTooLarge = errors.New("export selects too many records")
+	// ErrTelegramExportAttachmentAltered means the content of an attachment doesn't match its hash.
+	ErrTelegramExportAttachmentAltered = errors.New("attachment content doesn't match its hash")
+	// ErrTelegramExportRequesterAbsent means the user the export has been requested by has been removed since.
+	ErrTelegramExportRequesterAbsent = errors.New("export requester no longer exists")
+	// ErrTelegramExportRequesterMoved means the requester no longer belongs to the team the export is kept within.
+	ErrTelegramExportRequesterMoved = errors.New("export requester has left the team of the export")
+)
+
+type TelegramExportStatus string
+
+const (
+	TelegramExportPending   TelegramExportStatus = "pending"
+	TelegramExportRunning   TelegramExportStatus = "running"
+	TelegramExportCompleted TelegramExportStatus = "completed"
+	TelegramExportFailed    TelegramExportStatus = "failed"
+)
+
+// TelegramExportSelection picks the records posted by any of the users or in any of the chats,
+// along with the identities of their authors and their attachments.
+type TelegramExportSelection struct {
+	UserTelegramIDs []uint64 `validate:"max=100,dive,gt=0,lte=300000000000"`
+	ChatTelegramIDs []int64  `validate:"max=100,dive,ne=0"`
+}
+
+// IsEmpty tells whether the selection picks nothing.
+func (selection *TelegramExportSelection) IsEmpty() bool {
+	return len(selection.UserTelegramIDs) == 0 && len(selection.ChatTelegramIDs) == 0
+}
+
+// TelegramExportProgress counts the subjects written to the bundle so far. RecordsTotal is known once
+// the export has started.
+type TelegramExportProgress struct {
+	RecordsTotal        int64
+	RecordsExported     int64
+	IdentitiesExported  int64
+	AttachmentsExported int64
+}
+
+// TelegramExport is a bundle of the archived data requested for evidence. It is built in the background:
+// once completed, the ZIP lives in the blob storage under StorageKey and its manifest is signed with
+// the key whose public part is PublicKey.
+type TelegramExport struct {
+	ID          uuid.UUID `validate:"required,uuid"`
+	RequestedBy uuid.UUID `validate:"required,uuid"`
+	// TeamID is the team of the requester, the export is kept and built within it. It's set once stored.
+	TeamID      uuid.NullUUID
+	Selection   TelegramExportSelection
+	Status      TelegramExportStatus `validate:"required,oneof=pending running completed failed"`
+	Progress    TelegramExportProgress
+	StorageKey  string
+	SHA256      string
+	FileSize    int64
+	PublicKey   string
+	Signature   string
+	Error       string
+	CreatedAt   time.Time `validate:"required"`
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+}
+
+// TelegramExportManifest lists every file of a bundle with its hash. It is stored as manifest.json
+// next to a detached signature of its exact bytes.
+type TelegramExportManifest struct {
+	Version     string
+	ExportID    uuid.UUID
+	RequestedBy uuid.UUID
+	Selection   TelegramExportSelection
+	CreatedAt   time.Time
+	PublicKey   string
+	Files       []TelegramExportManifestFile
+}
+
+type TelegramExportManifestFile struct {
+	Path   string
+	SHA256 string
+	Size   int64
+}
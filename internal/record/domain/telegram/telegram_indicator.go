@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TelegramIndicatorType string
+
+// All indicator types Enum.
+const (
+	TelegramIndicatorTypePhone        TelegramIndicatorType = "phone"
+	TelegramIndicatorTypeEmail        TelegramIndicatorType = "email"
+	TelegramIndicatorTypeURL          TelegramIndicatorType = "url"
+	TelegramIndicatorTypeMention      TelegramIndicatorType = "mention"
+	TelegramIndicatorTypeHashtag      TelegramIndicatorType = "hashtag"
+	TelegramIndicatorTypeIBAN         TelegramIndicatorType = "iban"
+	TelegramIndicatorTypeCryptoWallet TelegramIndicatorType = "crypto_wallet"
+)
+
+// TelegramIndicator is a phone number, an email, a wallet address etc. a record mentions.
+// Value is normalised, so that the same indicator written differently is matched across records:
+// phones are +digits, emails, URL hosts, mentions and hashtags are lowercased, IBANs have no spaces.
+type TelegramIndicator struct {
+	ID          uuid.UUID             `validate:"required,uuid"`
+	RecordID    uuid.UUID             `validate:"required,uuid"`
+	Type        TelegramIndicatorType `validate:"required,oneof=phone email url mention hashtag iban crypto_wallet"`
+	Value       string                `validate:"required,max=2048"`
+	AddedAt     time.Time             `validate:"required"`
+	AddedByUser uuid.UUID             `validate:"required,uuid"`
+}
+
+// TelegramIndicatorSummary is an indicator aggregated over the records of a user mentioning it.
+// FirstSeenAt and LastSeenAt are the PostedAt of the earliest and the latest of those records.
+type TelegramIndicatorSummary struct {
+	Type        TelegramIndicatorType
+	Value       string
+	RecordCount int
+	FirstSeenAt time.Time
+	LastSeenAt  time.Time
+}
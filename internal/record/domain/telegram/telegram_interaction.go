@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+type TelegramInteractionType string
+
+// All interaction types Enum.
+const (
+	// TelegramInteractionTypeReply is a reply of the sender to a message of the recipient.
+	TelegramInteractionTypeReply TelegramInteractionType = "reply"
+	// TelegramInteractionTypeMention is a mention of the recipient's username by the sender.
+	TelegramInteractionTypeMention TelegramInteractionType = "mention"
+	// TelegramInteractionTypeSharedChat links two users who have both posted in a chat, it's kept in both directions.
+	// Large chats, where everyone would be linked to everyone, are left out.
+	TelegramInteractionTypeSharedChat TelegramInteractionType = "shared_chat"
+)
+
+// TelegramInteraction is an edge between two Telegram users derived from records.
+// Users are identified by their Telegram IDs, so that the records of all collectors add up.
+// The interactions are kept per record they're derived from, Weight is the amount of replies, mentions
+// or shared chats summed up over the records.
+type TelegramInteraction struct {
+	FromUserTelegramID uint64
+	ToUserTelegramID   uint64
+	Type               TelegramInteractionType
+	Weight             int
+	FirstInteractionAt time.Time
+	LastInteractionAt  time.Time
+}
+
+// TelegramInteractionGraphNode is a user of an interaction graph, labelled with their latest known identity.
+// Depth is the amount of edges between the user and the one the graph is built around.
+type TelegramInteractionGraphNode struct {
+	UserTelegramID uint64
+	Username       string
+	FirstName      string
+	LastName       string
+	Depth          int
+}
+
+// TelegramInteractionGraph is the neighbourhood of a user and the interactions between its members.
+type TelegramInteractionGraph struct {
+	Nodes []TelegramInteractionGraphNode
+	Edges []TelegramInteraction
+}
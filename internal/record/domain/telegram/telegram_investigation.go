@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvestigationNotFound          = errors.New("investigation not found")
+	ErrInvestigationMemberNotFound    = errors.New("investigation member not found")
+	ErrInvestigationLastOwner         = errors.New("investigation must keep an owner")
+	ErrInvestigationItemNotFound      = errors.New("investigation item not found")
+	ErrInvestigationItemAlreadyExists = errors.New("investigation item already exists")
+)
+
+// TelegramInvestigationRole is what a member may do with an investigation.
+type TelegramInvestigationRole string
+
+const (
+	// TelegramInvestigationRoleOwner manages the members and removes the investigation.
+	TelegramInvestigationRoleOwner TelegramInvestigationRole = "owner"
+	// TelegramInvestigationRoleEditor attaches and detaches items.
+	TelegramInvestigationRoleEditor TelegramInvestigationRole = "editor"
+	// TelegramInvestigationRoleViewer only reads the investigation.
+	TelegramInvestigationRoleViewer TelegramInvestigationRole = "viewer"
+)
+
+// CanEdit tells whether the role may attach and detach items.
+func (role TelegramInvestigationRole) CanEdit() bool {
+	return role == TelegramInvestigationRoleOwner || role == TelegramInvestigationRoleEditor
+}
+
+// TelegramInvestigationItemType is the kind of telegram data an item refers to.
+type TelegramInvestigationItemType string
+
+const (
+	TelegramInvestigationItemTypeUser   TelegramInvestigationItemType = "telegram_user"
+	TelegramInvestigationItemTypeChat   TelegramInvestigationItemType = "telegram_chat"
+	TelegramInvestigationItemTypeRecord TelegramInvestigationItemType = "telegram_record"
+)
+
+// TelegramInvestigation is a case grouping telegram users, chats and records, it's only visible to its members.
+type TelegramInvestigation struct {
+	ID          uuid.UUID `validate:"required,uuid"`
+	Name        string    `validate:"required,min=1,max=128"`
+	Description string    `validate:"max=4096"`
+	CreatedBy   uuid.UUID `validate:"required,uuid"`
+	CreatedAt   time.Time `validate:"required"`
+	Members     []TelegramInvestigationMember
+	Items       []TelegramInvestigationItem
+}
+
+// TelegramInvestigationMember is a platform user taking part in an investigation.
+type TelegramInvestigationMember struct {
+	ID              uuid.UUID                 `validate:"required,uuid"`
+	InvestigationID uuid.UUID                 `validate:"required,uuid"`
+	UserID          uuid.UUID                 `validate:"required,uuid"`
+	Role            TelegramInvestigationRole `validate:"required,oneof=owner editor viewer"`
+	AddedBy         uuid.UUID                 `validate:"required,uuid"`
+	AddedAt         time.Time                 `validate:"required"`
+}
+
+// TelegramInvestigationItem attaches the telegram user, chat or record with the TargetID to an investigation,
+// the Note annotates it within the investigation only.
+type TelegramInvestigationItem struct {
+	ID              uuid.UUID                     `validate:"required,uuid"`
+	InvestigationID uuid.UUID                     `validate:"required,uuid"`
+	Type            TelegramInvestigationItemType `validate:"required,oneof=telegram_user telegram_chat telegram_record"`
+	TargetID        uuid.UUID                     `validate:"required,uuid"`
+	Note            string                        `validate:"max=4096"`
+	AddedBy         uuid.UUID                     `validate:"required,uuid"`
+	AddedAt         time.Time                     `validate:"required"`
+}
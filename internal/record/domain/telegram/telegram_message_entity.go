@@ -0,0 +1,90 @@
+package domain
+
+import "unicode/utf16"
+
+// MaxTelegramMessageEntities is the amount of entities Telegram keeps for a single text.
+const MaxTelegramMessageEntities = 100
+
+type TelegramMessageEntityType string
+
+// All entity types Enum. Mirrors the `type` field of the Bot API MessageEntity object.
+const (
+	TelegramMessageEntityTypeMention              TelegramMessageEntityType = "mention"
+	TelegramMessageEntityTypeHashtag              TelegramMessageEntityType = "hashtag"
+	TelegramMessageEntityTypeCashtag              TelegramMessageEntityType = "cashtag"
+	TelegramMessageEntityTypeBotCommand           TelegramMessageEntityType = "bot_command"
+	TelegramMessageEntityTypeURL                  TelegramMessageEntityType = "url"
+	TelegramMessageEntityTypeEmail                TelegramMessageEntityType = "email"
+	TelegramMessageEntityTypePhoneNumber          TelegramMessageEntityType = "phone_number"
+	TelegramMessageEntityTypeBold                 TelegramMessageEntityType = "bold"
+	TelegramMessageEntityTypeItalic               TelegramMessageEntityType = "italic"
+	TelegramMessageEntityTypeUnderline            TelegramMessageEntityType = "underline"
+	TelegramMessageEntityTypeStrikethrough        TelegramMessageEntityType = "strikethrough"
+	TelegramMessageEntityTypeSpoiler              TelegramMessageEntityType = "spoiler"
+	TelegramMessageEntityTypeBlockquote           TelegramMessageEntityType = "blockquote"
+	TelegramMessageEntityTypeExpandableBlockquote TelegramMessageEntityType = "expandable_blockquote"
+	TelegramMessageEntityTypeCode                 TelegramMessageEntityType = "code"
+	TelegramMessageEntityTypePre                  TelegramMessageEntityType = "pre"
+	TelegramMessageEntityTypeTextLink             TelegramMessageEntityType = "text_link"
+	TelegramMessageEntityTypeTextMention          TelegramMessageEntityType = "text_mention"
+	TelegramMessageEntityTypeCustomEmoji          TelegramMessageEntityType = "custom_emoji"
+)
+
+// IsKnown tells whether the type is one of the above, Telegram keeps adding new ones.
+func (entityType TelegramMessageEntityType) IsKnown() bool {
+	switch entityType {
+	case TelegramMessageEntityTypeMention, TelegramMessageEntityTypeHashtag, TelegramMessageEntityTypeCashtag,
+		TelegramMessageEntityTypeBotCommand, TelegramMessageEntityTypeURL, TelegramMessageEntityTypeEmail,
+		TelegramMessageEntityTypePhoneNumber, TelegramMessageEntityTypeBold, TelegramMessageEntityTypeItalic,
+		TelegramMessageEntityTypeUnderline, TelegramMessageEntityTypeStrikethrough, TelegramMessageEntityTypeSpoiler,
+		TelegramMessageEntityTypeBlockquote, TelegramMessageEntityTypeExpandableBlockquote,
+		TelegramMessageEntityTypeCode, TelegramMessageEntityTypePre, TelegramMessageEntityTypeTextLink,
+		TelegramMessageEntityTypeTextMention, TelegramMessageEntityTypeCustomEmoji:
+		return true
+	default:
+		return false
+	}
+}
+
+// TelegramMessageEntity is a special part of a text, like a hashtag, a link hidden behind the text
+// or a code block. Offset and Length are counted in UTF-16 code units, as Telegram does.
+// URL is only set for text_link, UserTelegramID for text_mention, Language for pre
+// and CustomEmojiID for custom_emoji.
+type TelegramMessageEntity struct {
+	Type           TelegramMessageEntityType `validate:"required,oneof=mention hashtag cashtag bot_command url email phone_number bold italic underline strikethrough spoiler blockquote expandable_blockquote code pre text_link text_mention custom_emoji"`
+	Offset         int                       `validate:"gte=0"`
+	Length         int                       `validate:"gt=0"`
+	URL            string                    `validate:"required_if=Type text_link,max=2048"`
+	UserTelegramID uint64                    `validate:"required_if=Type text_mention"`
+	Language       string                    `validate:"max=64"`
+	CustomEmojiID  string                    `validate:"required_if=Type custom_emoji,max=64"`
+}
+
+// EntitiesWithinText tells whether all entities lie within the text, the check struct tags can't express.
+func EntitiesWithinText(text string, entities []TelegramMessageEntity) bool {
+	if len(entities) == 0 {
+		return true
+	}
+	textLength := UTF16Length(text)
+	for _, entity := range entities {
+		// Compared without adding them up, hostile offsets and lengths could overflow
+		if entity.Offset < 0 || entity.Length < 0 || entity.Offset > textLength ||
+			entity.Length > textLength-entity.Offset {
+			return false
+		}
+	}
+	return true
+}
+
+// UTF16Length is the length of the text in UTF-16 code units, the unit of entity offsets.
+func UTF16Length(text string) int {
+	length := 0
+	for _, r := range text {
+		if utf16.RuneLen(r) == 2 {
+			length += 2
+			continue
+		}
+		length++
+	}
+	return length
+}
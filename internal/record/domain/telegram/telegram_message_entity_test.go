@@ -0,0 +1,58 @@
+package domain_test
+
+import (
+	"math"
+	"testing"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+)
+
+func TestUTF16Length(t *testing.T) {
+	cases := map[string]int{
+		"":       0,
+		"hello":  5,
+		"привет": 6,
+		"hi 👋":   5,
+		"👨‍👩‍👧":  8,
+	}
+	for text, expected := range cases {
+		if length := domain.UTF16Length(text); length != expected {
+			t.Errorf("expected %q to be %d UTF-16 code units long, got %d", text, expected, length)
+		}
+	}
+}
+
+func TestEntitiesWithinText(t *testing.T) {
+	// The emoji takes two code units, so the link spans up to the end of the text
+	text := "👋 see docs"
+	entities := []domain.TelegramMessageEntity{
+		{Type: domain.TelegramMessageEntityTypeBold, Offset: 0, Length: 2},
+		{Type: domain.TelegramMessageEntityTypeTextLink, Offset: 7, Length: 4, URL: "https://example.com"},
+	}
+	if !domain.EntitiesWithinText(text, entities) {
+		t.Error("expected the entities to lie within the text")
+	}
+
+	entities = append(entities, domain.TelegramMessageEntity{
+		Type:   domain.TelegramMessageEntityTypeItalic,
+		Offset: 8,
+		Length: 4,
+	})
+	if domain.EntitiesWithinText(text, entities) {
+		t.Error("expected an entity past the end of the text to be rejected")
+	}
+}
+
+func TestEntitiesWithinText_Overflow(t *testing.T) {
+	entities := []domain.TelegramMessageEntity{
+		{Type: domain.TelegramMessageEntityTypeBold, Offset: 1, Length: math.MaxInt},
+	}
+	if domain.EntitiesWithinText("hello", entities) {
+		t.Error("expected an entity overflowing its end to be rejected")
+	}
+
+	entities[0] = domain.TelegramMessageEntity{Type: domain.TelegramMessageEntityTypeBold, Offset: math.MaxInt, Length: 1}
+	if domain.EntitiesWithinText("hello", entities) {
+		t.Error("expected an entity starting past the end of the text to be rejected")
+	}
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrObservationNotFound = errors.New("observation not found")
+	ErrInvalidVisibility   = errors.New("visibility must be one of private, team or global")
+)
+
+// TelegramVisibility tells who sees what a collector has observed.
+type TelegramVisibility string
+
+const (
+	// TelegramVisibilityPrivate is only seen by the collector.
+	TelegramVisibilityPrivate TelegramVisibility = "private"
+	// TelegramVisibilityTeam is seen by the collector and its teammates.
+	TelegramVisibilityTeam TelegramVisibility = "team"
+	// TelegramVisibilityGlobal is seen by everyone.
+	TelegramVisibilityGlobal TelegramVisibility = "global"
+)
+
+// Valid tells whether the visibility is one of the known ones.
+func (visibility TelegramVisibility) Valid() bool {
+	switch visibility {
+	case TelegramVisibilityPrivate, TelegramVisibilityTeam, TelegramVisibilityGlobal:
+		return true
+	default:
+		return false
+	}
+}
+
+// TelegramObservationTargetType is the kind of canonical telegram data a collector observes.
+type TelegramObservationTargetType string
+
+const (
+	TelegramObservationTargetTypeUser   TelegramObservationTargetType = "telegram_user"
+	TelegramObservationTargetTypeRecord TelegramObservationTargetType = "telegram_record"
+)
+
+// Valid tells whether the type is one of the kinds collectors observe.
+func (targetType TelegramObservationTargetType) Valid() bool {
+	return targetType == TelegramObservationTargetTypeUser || targetType == TelegramObservationTargetTypeRecord
+}
+
+// TelegramObservation is the provenance of a canonical telegram user or record: the collector with CollectorUserID
+// has archived it as well. A user or a record is stored once, the first collector adding it,
+// and is seen by the platform users the observations of its collectors are visible to.
+type TelegramObservation struct {
+	ID              uuid.UUID                     `validate:"required,uuid"`
+	TargetType      TelegramObservationTargetType `validate:"required,oneof=telegram_user telegram_record"`
+	TargetID        uuid.UUID                     `validate:"required,uuid"`
+	CollectorUserID uuid.UUID                     `validate:"required,uuid"`
+	Visibility      TelegramVisibility            `validate:"required,oneof=private team global"`
+	ObservedAt      time.Time                     `validate:"required"`
+	// TeamID is the team of the collector, it's set once stored.
+	TeamID uuid.NullUUID
+}
+
+// TelegramVisibilityScope is what a platform user sees of the observations: the global ones, its own ones
+// and the team ones of the members of its team. An Unrestricted scope sees all of them.
+type TelegramVisibilityScope struct {
+	ViewerID uuid.UUID
+	// TeamID is the team of the viewer, users without a team only see their own team observations.
+	TeamID       uuid.NullUUID
+	Unrestricted bool
+}
+
+// Sees tells whether the observation is visible within the scope.
+func (scope TelegramVisibilityScope) Sees(observation TelegramObservation) bool {
+	switch {
+	case scope.Unrestricted, observation.Visibility == TelegramVisibilityGlobal,
+		observation.CollectorUserID == scope.ViewerID:
+		return true
+	case observation.Visibility == TelegramVisibilityTeam:
+		return scope.TeamID.Valid && observation.TeamID == scope.TeamID
+	default:
+		return false
+	}
+}
@@ -0,0 +1,50 @@
+package domain_test
+
+import (
+	"testing"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/google/uuid"
+)
+
+func TestTelegramVisibilityScopeSees(t *testing.T) {
+	viewer, teammate, stranger := uuid.New(), uuid.New(), uuid.New()
+	team := uuid.NullUUID{UUID: uuid.New(), Valid: true}
+	otherTeam := uuid.NullUUID{UUID: uuid.New(), Valid: true}
+	scope := domain.TelegramVisibilityScope{ViewerID: viewer, TeamID: team}
+	tests := []struct {
+		collector  uuid.UUID
+		team       uuid.NullUUID
+		visibility domain.TelegramVisibility
+		expected   bool
+	}{
+		{collector: viewer, team: team, visibility: domain.TelegramVisibilityPrivate, expected: true},
+		{collector: teammate, team: team, visibility: domain.TelegramVisibilityPrivate, expected: false},
+		{collector: teammate, team: team, visibility: domain.TelegramVisibilityTeam, expected: true},
+		{collector: stranger, team: otherTeam, visibility: domain.TelegramVisibilityTeam, expected: false},
+		{collector: stranger, visibility: domain.TelegramVisibilityTeam, expected: false},
+		{collector: stranger, visibility: domain.TelegramVisibilityGlobal, expected: true},
+	}
+	for _, test := range tests {
+		observation := domain.TelegramObservation{
+			CollectorUserID: test.collector,
+			TeamID:          test.team,
+			Visibility:      test.visibility,
+		}
+		if seen := scope.Sees(observation); seen != test.expected {
+			t.Errorf("expected a %s observation to be seen: %v, got %v", test.visibility, test.expected, seen)
+		}
+	}
+
+	teamless := domain.TelegramVisibilityScope{ViewerID: viewer}
+	shared := domain.TelegramObservation{CollectorUserID: stranger, Visibility: domain.TelegramVisibilityTeam}
+	if teamless.Sees(shared) {
+		t.Error("expected users without a team not to share their team observations")
+	}
+
+	unrestricted := domain.TelegramVisibilityScope{ViewerID: viewer, Unrestricted: true}
+	private := domain.TelegramObservation{CollectorUserID: stranger, Visibility: domain.TelegramVisibilityPrivate}
+	if !unrestricted.Sees(private) {
+		t.Error("expected an unrestricted scope to see a private observation of another collector")
+	}
+}
@@ -1,17 +1,27 @@
 package domain
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+var (
+	ErrProfilePictureNotFound      = errors.New("profile picture not found")
+	ErrProfilePictureAlreadyExists = errors.New("profile picture already exists")
+)
+
+// TelegramProfilePicture is an avatar a user has had since PostedAt.
+// Like attachments, the image lives in the blob storage under a key derived from SHA256.
 type TelegramProfilePicture struct {
-	ID             uuid.UUID
-	UserTelegramID uint64
-	StorageKey     string
-	PostedAt       time.Time
-	MimeType       string
-	AddedAt        time.Time
-	AddedByUser    uuid.UUID
+	ID             uuid.UUID `validate:"required,uuid"`
+	UserTelegramID uint64    `validate:"required,gt=0"`
+	StorageKey     string    `validate:"required,max=255"`
+	SHA256         string    `validate:"required,len=64,hexadecimal"`
+	FileSize       uint64    `validate:"required,gt=0"`
+	PostedAt       time.Time `validate:"required"`
+	MimeType       string    `validate:"required,startswith=image/,max=255"`
+	AddedAt        time.Time `validate:"required"`
+	AddedByUser    uuid.UUID `validate:"required,uuid"`
 }
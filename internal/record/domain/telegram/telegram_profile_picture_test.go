@@ -0,0 +1,56 @@
+package domain_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+func validProfilePicture() domain.TelegramProfilePicture {
+	sha := strings.Repeat("ab", 32)
+	return domain.TelegramProfilePicture{
+		ID:             uuid.New(),
+		UserTelegramID: 42,
+		StorageKey:     "sha256/ab/" + sha,
+		SHA256:         sha,
+		FileSize:       1024,
+		PostedAt:       time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+		MimeType:       "image/jpeg",
+		AddedAt:        time.Now(),
+		AddedByUser:    uuid.New(),
+	}
+}
+
+func TestTelegramProfilePictureValidation(t *testing.T) {
+	cases := map[string]struct {
+		mutate func(picture *domain.TelegramProfilePicture)
+		valid  bool
+	}{
+		"valid":             {mutate: func(*domain.TelegramProfilePicture) {}, valid: true},
+		"png":               {mutate: func(p *domain.TelegramProfilePicture) { p.MimeType = "image/png" }, valid: true},
+		"not an image":      {mutate: func(p *domain.TelegramProfilePicture) { p.MimeType = "application/pdf" }},
+		"short hash":        {mutate: func(p *domain.TelegramProfilePicture) { p.SHA256 = "abcdef" }},
+		"non hex hash":      {mutate: func(p *domain.TelegramProfilePicture) { p.SHA256 = strings.Repeat("zz", 32) }},
+		"empty file":        {mutate: func(p *domain.TelegramProfilePicture) { p.FileSize = 0 }},
+		"missing user":      {mutate: func(p *domain.TelegramProfilePicture) { p.UserTelegramID = 0 }},
+		"missing posted at": {mutate: func(p *domain.TelegramProfilePicture) { p.PostedAt = time.Time{} }},
+	}
+	validator := service.NewTelegramModelValidator(validator.New())
+	for name, tc := range cases {
+		picture := validProfilePicture()
+		tc.mutate(&picture)
+		err := validator.Validate(&picture)
+		if tc.valid && err != nil {
+			t.Errorf("%s: expected the picture to be valid, got %v", name, err)
+		}
+		if !tc.valid && !errors.Is(err, domain.ErrValidationFailed) {
+			t.Errorf("%s: expected a validation failure, got %v", name, err)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,18 +11,71 @@ import (
 var (
 	ErrRecordAlreadyExists        = errors.New("record already exists")
 	ErrNoRecordsForThisTelegramID = errors.New("no records for this telegram id")
+	ErrRecordNotFound             = errors.New("record not found")
+	ErrRevisionAlreadyExists      = errors.New("record revision already exists")
 )
 
 // TelegramRecord to see all possible parseable fields of a message, see
 // this beautiful implementation of MTProto client
 // https://github.com/KurimuzonAkuma/kurigram/blob/dev/pyrogram/types/messages_and_media/message.py
+//
+// The record holds the current version of the message, EditedAt is nil until it has been edited.
+// DeletedAt is set once the message has been deleted in Telegram, the archived content is kept.
+// Entities format MessageText, Caption is the text of a media message with its own CaptionEntities.
+// MessageText is required unless the message has a caption, captions are limited to 1024 characters by Telegram.
+// ReplyToMessageTelegramID references the message of the same chat the record replies to,
+// ThreadTelegramID is the forum topic or the comment thread the message has been posted in.
 type TelegramRecord struct {
-	ID                 uuid.UUID `validate:"required,uuid"`
-	MessageTelegramID  uint64    `validate:"required,gt=0"`
-	FromTelegramUserID uuid.UUID `validate:"required,uuid"`
-	InTelegramChatID   int64     `validate:"required"`
-	MessageText        string    `validate:"required,max=4096"`
-	PostedAt           time.Time `validate:"required"`
-	AddedAt            time.Time `validate:"required"`
-	AddedByUser        uuid.UUID `validate:"required,uuid"`
+	ID                       uuid.UUID               `validate:"required,uuid"`
+	MessageTelegramID        uint64                  `validate:"required,gt=0"`
+	FromTelegramUserID       uuid.UUID               `validate:"required,uuid"`
+	InTelegramChatID         int64                   `validate:"required"`
+	MessageText              string                  `validate:"required_without=Caption,max=4096"`
+	Entities                 []TelegramMessageEntity `validate:"max=100,dive"`
+	Caption                  string                  `validate:"max=1024"`
+	CaptionEntities          []TelegramMessageEntity `validate:"max=100,dive"`
+	PostedAt                 time.Time               `validate:"required"`
+	EditedAt                 *time.Time
+	DeletedAt                *time.Time
+	ReplyToMessageTelegramID *uint64 `validate:"omitempty,gt=0"`
+	ThreadTelegramID         *uint64 `validate:"omitempty,gt=0"`
+	ForwardOrigin            *TelegramForwardOrigin
+	AddedAt                  time.Time `validate:"required"`
+	AddedByUser              uuid.UUID `validate:"required,uuid"`
+}
+
+// TelegramForwardOrigin is where a forwarded message has been posted originally.
+// The author is unknown for accounts hidden from forwards, the chat and the message are only known
+// for messages forwarded from groups and channels.
+type TelegramForwardOrigin struct {
+	FromUserTelegramID *uint64 `validate:"omitempty,gt=0"`
+	FromChatTelegramID *int64
+	MessageTelegramID  *uint64 `validate:"omitempty,gt=0"`
+	PostedAt           *time.Time
+}
+
+// TelegramRecordRevision is a superseded version of a record.
+// EditedAt is nil for the original version of the message.
+type TelegramRecordRevision struct {
+	ID              uuid.UUID `validate:"required,uuid"`
+	RecordID        uuid.UUID `validate:"required,uuid"`
+	MessageText     string    `validate:"max=4096"`
+	Entities        []TelegramMessageEntity
+	Caption         string
+	CaptionEntities []TelegramMessageEntity
+	EditedAt        *time.Time
+	AddedAt         time.Time `validate:"required"`
+	AddedByUser     uuid.UUID `validate:"required,uuid"`
+}
+
+// HasEntitiesWithinText tells whether the entities of both the text and the caption lie within them.
+func (record *TelegramRecord) HasEntitiesWithinText() bool {
+	return EntitiesWithinText(record.MessageText, record.Entities) &&
+		EntitiesWithinText(record.Caption, record.CaptionEntities)
+}
+
+// SameContent tells whether the other version of the message has the same text, caption and formatting.
+func (record *TelegramRecord) SameContent(other *TelegramRecord) bool {
+	return record.MessageText == other.MessageText && record.Caption == other.Caption &&
+		slices.Equal(record.Entities, other.Entities) && slices.Equal(record.CaptionEntities, other.CaptionEntities)
 }
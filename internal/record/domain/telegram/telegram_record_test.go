@@ -0,0 +1,70 @@
+package domain_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+func validRecord() domain.TelegramRecord {
+	return domain.TelegramRecord{
+		ID:                 uuid.New(),
+		MessageTelegramID:  1337,
+		FromTelegramUserID: uuid.New(),
+		InTelegramChatID:   -1001234567890,
+		MessageText:        "Hello world!",
+		PostedAt:           time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+		AddedAt:            time.Now(),
+		AddedByUser:        uuid.New(),
+	}
+}
+
+func TestTelegramRecordValidation(t *testing.T) {
+	cases := map[string]struct {
+		mutate func(record *domain.TelegramRecord)
+		valid  bool
+	}{
+		"valid": {mutate: func(*domain.TelegramRecord) {}, valid: true},
+		"longest text": {
+			mutate: func(r *domain.TelegramRecord) { r.MessageText = strings.Repeat("x", 4096) },
+			valid:  true,
+		},
+		"text too long": {mutate: func(r *domain.TelegramRecord) { r.MessageText = strings.Repeat("x", 4097) }},
+		"caption only": {
+			mutate: func(r *domain.TelegramRecord) { r.MessageText, r.Caption = "", "Look at this" },
+			valid:  true,
+		},
+		"longest caption": {
+			mutate: func(r *domain.TelegramRecord) { r.Caption = strings.Repeat("x", 1024) },
+			valid:  true,
+		},
+		"caption too long": {mutate: func(r *domain.TelegramRecord) { r.Caption = strings.Repeat("x", 1025) }},
+		"no text":          {mutate: func(r *domain.TelegramRecord) { r.MessageText = "" }},
+		"entity past the caption": {
+			mutate: func(r *domain.TelegramRecord) {
+				r.Caption = "Look"
+				r.CaptionEntities = []domain.TelegramMessageEntity{
+					{Type: domain.TelegramMessageEntityTypeBold, Offset: 0, Length: 5},
+				}
+			},
+		},
+	}
+	validator := service.NewTelegramModelValidator(validator.New())
+	for name, tc := range cases {
+		record := validRecord()
+		tc.mutate(&record)
+		err := validator.Validate(&record)
+		if tc.valid && err != nil {
+			t.Errorf("%s: expected the record to be valid, got %v", name, err)
+		}
+		if !tc.valid && !errors.Is(err, domain.ErrValidationFailed) {
+			t.Errorf("%s: expected a validation failure, got %v", name, err)
+		}
+	}
+}
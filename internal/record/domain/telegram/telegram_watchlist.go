@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrWatchlistNotFound           = errors.New("watchlist not found")
+	ErrWatchlistEntryNotFound      = errors.New("watchlist entry not found")
+	ErrWatchlistEntryAlreadyExists = errors.New("watchlist entry already exists")
+)
+
+// TelegramWatchlistEntryType is what a watchlist entry is matched against.
+type TelegramWatchlistEntryType string
+
+const (
+	// TelegramWatchlistEntryTypeTelegramID matches the sender or the forward origin of records
+	// and the user of identities.
+	TelegramWatchlistEntryTypeTelegramID TelegramWatchlistEntryType = "telegram_id"
+	// TelegramWatchlistEntryTypeUsername matches the mentions in records and the usernames of identities.
+	TelegramWatchlistEntryTypeUsername TelegramWatchlistEntryType = "username"
+	// TelegramWatchlistEntryTypePhone matches the phone numbers in records and the ones of identities.
+	TelegramWatchlistEntryTypePhone TelegramWatchlistEntryType = "phone"
+	// TelegramWatchlistEntryTypeKeyword matches the texts and the captions of records and the names and bios
+	// of identities, ignoring case.
+	TelegramWatchlistEntryTypeKeyword TelegramWatchlistEntryType = "keyword"
+)
+
+// TelegramWatchlist is a named list of persons of interest of a user, who is alerted on their new activity.
+type TelegramWatchlist struct {
+	ID          uuid.UUID `validate:"required,uuid"`
+	OwnerUserID uuid.UUID `validate:"required,uuid"`
+	Name        string    `validate:"required,min=1,max=128"`
+	CreatedAt   time.Time `validate:"required"`
+	Entries     []TelegramWatchlistEntry
+}
+
+// TelegramWatchlistEntry holds a normalised Value, only records and identities added after it are matched.
+type TelegramWatchlistEntry struct {
+	ID          uuid.UUID                  `validate:"required,uuid"`
+	WatchlistID uuid.UUID                  `validate:"required,uuid"`
+	Type        TelegramWatchlistEntryType `validate:"required,oneof=telegram_id username phone keyword"`
+	Value       string                     `validate:"required,min=1,max=256"`
+	AddedAt     time.Time                  `validate:"required"`
+}
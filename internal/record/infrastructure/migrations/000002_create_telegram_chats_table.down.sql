@@ -0,0 +1,14 @@
+-- squawk-ignore-file ban-drop-table
+-- Drop telegram chats
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+ALTER TABLE "records"."telegram_records"
+DROP CONSTRAINT IF EXISTS "fk_telegram_records_chat";
+-- squawk-ignore constraint-missing-not-valid,disallowed-unique-constraint
+ALTER TABLE "records"."telegram_records"
+DROP CONSTRAINT IF EXISTS "unique_telegram_message_id",
+ADD CONSTRAINT "unique_telegram_message_id" UNIQUE (
+    message_telegram_id, added_by_user
+);
+DROP TABLE IF EXISTS "records".telegram_chat_snapshots;
+DROP TABLE IF EXISTS "records".telegram_chats;
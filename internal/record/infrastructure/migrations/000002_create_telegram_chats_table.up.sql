@@ -0,0 +1,47 @@
+-- Create telegram chats and link records to them
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+CREATE TABLE IF NOT EXISTS "records"."telegram_chats" (
+    id UUID PRIMARY KEY NOT NULL,
+    chat_telegram_id BIGINT NOT NULL,
+    chat_type TEXT NOT NULL,
+    added_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    added_by_user UUID NOT NULL,
+    CONSTRAINT "unique_telegram_chat_per_user" UNIQUE (
+        chat_telegram_id, added_by_user
+    ),
+    CONSTRAINT "telegram_chat_type" CHECK (
+        chat_type IN ('private', 'group', 'supergroup', 'channel')
+    )
+);
+
+CREATE TABLE IF NOT EXISTS "records"."telegram_chat_snapshots" (
+    id UUID PRIMARY KEY NOT NULL,
+    chat_id UUID NOT NULL CONSTRAINT "fk_telegram_chat_snapshots_chat"
+    REFERENCES "records".telegram_chats (id),
+    title TEXT NOT NULL DEFAULT '',
+    username TEXT NOT NULL DEFAULT '',
+    member_count BIGINT NOT NULL DEFAULT 0,
+    description TEXT NOT NULL DEFAULT '',
+    added_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    added_by_user UUID NOT NULL,
+    CONSTRAINT "unique_telegram_chat_snapshot" UNIQUE (
+        chat_id, title, username, member_count, description
+    )
+);
+
+-- Message IDs are only unique inside of a chat
+-- squawk-ignore constraint-missing-not-valid,disallowed-unique-constraint
+ALTER TABLE "records"."telegram_records"
+DROP CONSTRAINT IF EXISTS "unique_telegram_message_id",
+ADD CONSTRAINT "unique_telegram_message_id" UNIQUE (
+    message_telegram_id, in_telegram_chat_id, added_by_user
+);
+
+-- Records added before chats existed are not checked
+ALTER TABLE "records"."telegram_records"
+ADD CONSTRAINT "fk_telegram_records_chat"
+FOREIGN KEY (in_telegram_chat_id, added_by_user)
+REFERENCES "records".telegram_chats (chat_telegram_id, added_by_user)
+NOT VALID;
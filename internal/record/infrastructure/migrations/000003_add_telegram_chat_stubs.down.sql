@@ -0,0 +1,38 @@
+-- Drop the chat stubs, the records have to reference chats added beforehand again
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+ALTER TABLE "records"."telegram_chat_snapshots"
+DROP CONSTRAINT IF EXISTS "unique_telegram_chat_snapshot";
+
+-- The snapshots whose metadata came back after a change would break the former key, only the first one is kept
+DELETE FROM "records"."telegram_chat_snapshots" s
+USING "records"."telegram_chat_snapshots" earlier
+WHERE earlier.chat_id = s.chat_id
+AND (earlier.title, earlier.username, earlier.member_count, earlier.description)
+= (s.title, s.username, s.member_count, s.description)
+AND (earlier.added_at, earlier.id) < (s.added_at, s.id);
+
+-- squawk-ignore constraint-missing-not-valid,disallowed-unique-constraint
+ALTER TABLE "records"."telegram_chat_snapshots"
+ADD CONSTRAINT "unique_telegram_chat_snapshot" UNIQUE (
+    chat_id, title, username, member_count, description
+);
+
+DROP TRIGGER IF EXISTS trg_telegram_records_chat_stub ON "records"."telegram_records";
+
+DROP FUNCTION IF EXISTS "records".add_telegram_chat_stub();
+
+-- The stubs stay referenced by their records, they're typed after their ids: the ones of the users are positive
+UPDATE "records"."telegram_chats"
+SET chat_type = CASE WHEN chat_telegram_id > 0 THEN 'private' ELSE 'supergroup' END
+WHERE chat_type = 'unknown';
+
+ALTER TABLE "records"."telegram_chats"
+DROP CONSTRAINT IF EXISTS "telegram_chat_type";
+
+-- squawk-ignore constraint-missing-not-valid
+ALTER TABLE "records"."telegram_chats"
+ADD CONSTRAINT "telegram_chat_type" CHECK (
+    chat_type IN ('private', 'group', 'supergroup', 'channel')
+);
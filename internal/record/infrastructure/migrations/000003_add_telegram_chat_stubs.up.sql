@@ -0,0 +1,41 @@
+-- Add the chats the records are posted in on their own and key the chat snapshots by the time they're captured at
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+-- A chat only known from the records posted in it is a stub of an unknown type, until its metadata is added
+ALTER TABLE "records"."telegram_chats"
+DROP CONSTRAINT IF EXISTS "telegram_chat_type";
+
+-- squawk-ignore constraint-missing-not-valid
+ALTER TABLE "records"."telegram_chats"
+ADD CONSTRAINT "telegram_chat_type" CHECK (
+    chat_type IN ('unknown', 'private', 'group', 'supergroup', 'channel')
+);
+
+CREATE OR REPLACE FUNCTION "records".add_telegram_chat_stub() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO "records"."telegram_chats" (id, chat_telegram_id, chat_type, added_at, added_by_user)
+    VALUES (gen_random_uuid(), NEW.in_telegram_chat_id, 'unknown', NEW.added_at, NEW.added_by_user)
+    ON CONFLICT ON CONSTRAINT "unique_telegram_chat_per_user" DO NOTHING;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER trg_telegram_records_chat_stub
+BEFORE INSERT ON "records"."telegram_records"
+FOR EACH ROW EXECUTE FUNCTION "records".add_telegram_chat_stub();
+
+-- The records added before the chats existed get theirs too
+INSERT INTO "records"."telegram_chats" (id, chat_telegram_id, chat_type, added_at, added_by_user)
+SELECT gen_random_uuid(), in_telegram_chat_id, 'unknown', MIN(added_at), added_by_user
+FROM "records"."telegram_records"
+GROUP BY in_telegram_chat_id, added_by_user
+ON CONFLICT ON CONSTRAINT "unique_telegram_chat_per_user" DO NOTHING;
+
+-- The same metadata may come back after a change, only an unchanged one isn't added again
+ALTER TABLE "records"."telegram_chat_snapshots"
+DROP CONSTRAINT IF EXISTS "unique_telegram_chat_snapshot";
+
+-- squawk-ignore constraint-missing-not-valid,disallowed-unique-constraint
+ALTER TABLE "records"."telegram_chat_snapshots"
+ADD CONSTRAINT "unique_telegram_chat_snapshot" UNIQUE (chat_id, added_at);
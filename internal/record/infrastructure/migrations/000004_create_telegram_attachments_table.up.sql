@@ -0,0 +1,24 @@
+-- Create telegram attachments stored in the blob storage
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+CREATE TABLE IF NOT EXISTS "records"."telegram_attachments" (
+    id UUID PRIMARY KEY NOT NULL,
+    record_id UUID NOT NULL CONSTRAINT "fk_telegram_attachments_record"
+    REFERENCES "records".telegram_records (id),
+    telegram_attachment_id BIGINT NOT NULL DEFAULT 0,
+    file_name TEXT NOT NULL DEFAULT '',
+    storage_key TEXT NOT NULL,
+    sha256 TEXT NOT NULL,
+    file_size BIGINT NOT NULL,
+    mime_type TEXT NOT NULL,
+    added_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    added_by_user UUID NOT NULL,
+    CONSTRAINT "unique_telegram_attachment_per_record" UNIQUE (
+        record_id, sha256
+    )
+);
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_attachments_sha256 ON "records"."telegram_attachments" (sha256);
@@ -0,0 +1,5 @@
+-- squawk-ignore-file ban-drop-table
+-- Drop telegram profile pictures, blobs are left in the storage
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+DROP TABLE IF EXISTS "records".telegram_profile_pictures;
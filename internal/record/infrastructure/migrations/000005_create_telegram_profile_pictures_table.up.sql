@@ -0,0 +1,25 @@
+-- Create telegram profile pictures stored in the blob storage
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+CREATE TABLE IF NOT EXISTS "records"."telegram_profile_pictures" (
+    id UUID PRIMARY KEY NOT NULL,
+    user_telegram_id BIGINT NOT NULL,
+    storage_key TEXT NOT NULL,
+    sha256 TEXT NOT NULL,
+    file_size BIGINT NOT NULL,
+    posted_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    mime_type TEXT NOT NULL,
+    added_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    added_by_user UUID NOT NULL,
+    CONSTRAINT "fk_telegram_profile_pictures_user"
+    FOREIGN KEY (user_telegram_id, added_by_user)
+    REFERENCES "records".telegram_users (telegram_id, added_by_user),
+    CONSTRAINT "unique_telegram_profile_picture_posted_at" UNIQUE (
+        user_telegram_id, posted_at, added_by_user
+    ),
+    -- Re-scraping the same avatar must not add it to the history twice
+    CONSTRAINT "unique_telegram_profile_picture_content" UNIQUE (
+        user_telegram_id, sha256, added_by_user
+    )
+);
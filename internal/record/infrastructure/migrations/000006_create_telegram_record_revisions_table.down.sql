@@ -0,0 +1,8 @@
+-- squawk-ignore-file ban-drop-table,ban-drop-column
+-- Drop the edit history of telegram records
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+DROP TABLE IF EXISTS "records".telegram_record_revisions;
+ALTER TABLE "records"."telegram_records"
+DROP COLUMN IF EXISTS edited_at,
+DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,22 @@
+-- Keep the edit history and the deletion of telegram records
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+ALTER TABLE "records"."telegram_records"
+ADD COLUMN IF NOT EXISTS edited_at TIMESTAMP WITH TIME ZONE,
+ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+
+-- The record holds the current version, the superseded ones are moved here
+CREATE TABLE IF NOT EXISTS "records"."telegram_record_revisions" (
+    id UUID PRIMARY KEY NOT NULL,
+    record_id UUID NOT NULL CONSTRAINT "fk_telegram_record_revisions_record"
+    REFERENCES "records".telegram_records (id),
+    message_text TEXT,
+    edited_at TIMESTAMP WITH TIME ZONE,
+    added_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    added_by_user UUID NOT NULL,
+    -- Redelivered edits must not be added to the history twice
+    CONSTRAINT "unique_telegram_record_revision_edited_at" UNIQUE (
+        record_id, edited_at
+    )
+);
@@ -0,0 +1,11 @@
+-- squawk-ignore-file ban-drop-column
+-- Drop the reply, thread and forward linkage of telegram records, the indexes are dropped with the columns
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+ALTER TABLE "records"."telegram_records"
+DROP COLUMN IF EXISTS reply_to_message_telegram_id,
+DROP COLUMN IF EXISTS thread_telegram_id,
+DROP COLUMN IF EXISTS forward_from_user_telegram_id,
+DROP COLUMN IF EXISTS forward_from_chat_telegram_id,
+DROP COLUMN IF EXISTS forward_from_message_telegram_id,
+DROP COLUMN IF EXISTS forward_posted_at;
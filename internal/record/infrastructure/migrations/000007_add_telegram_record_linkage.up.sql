@@ -0,0 +1,27 @@
+-- Link telegram records to the messages they reply to and the messages they have been forwarded from
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+ALTER TABLE "records"."telegram_records"
+ADD COLUMN IF NOT EXISTS reply_to_message_telegram_id BIGINT,
+ADD COLUMN IF NOT EXISTS thread_telegram_id BIGINT,
+ADD COLUMN IF NOT EXISTS forward_from_user_telegram_id BIGINT,
+ADD COLUMN IF NOT EXISTS forward_from_chat_telegram_id BIGINT,
+ADD COLUMN IF NOT EXISTS forward_from_message_telegram_id BIGINT,
+ADD COLUMN IF NOT EXISTS forward_posted_at TIMESTAMP WITH TIME ZONE;
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_records_reply_to ON "records"."telegram_records" (
+    in_telegram_chat_id, reply_to_message_telegram_id
+) WHERE reply_to_message_telegram_id IS NOT NULL;
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_records_forward_from_user ON "records"."telegram_records" (
+    forward_from_user_telegram_id
+) WHERE forward_from_user_telegram_id IS NOT NULL;
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_records_forward_from_chat ON "records"."telegram_records" (
+    forward_from_chat_telegram_id, forward_from_message_telegram_id
+) WHERE forward_from_chat_telegram_id IS NOT NULL;
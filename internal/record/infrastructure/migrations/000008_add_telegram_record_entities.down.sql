@@ -0,0 +1,12 @@
+-- squawk-ignore-file ban-drop-column
+-- Drop the formatting and the captions of telegram records
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+ALTER TABLE "records"."telegram_record_revisions"
+DROP COLUMN IF EXISTS entities,
+DROP COLUMN IF EXISTS caption,
+DROP COLUMN IF EXISTS caption_entities;
+ALTER TABLE "records"."telegram_records"
+DROP COLUMN IF EXISTS entities,
+DROP COLUMN IF EXISTS caption,
+DROP COLUMN IF EXISTS caption_entities;
@@ -0,0 +1,14 @@
+-- Keep the formatting of telegram records and the captions of media messages
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+-- Entities are lists of Bot API MessageEntity objects, offsets are counted in UTF-16 code units
+ALTER TABLE "records"."telegram_records"
+ADD COLUMN IF NOT EXISTS entities JSONB NOT NULL DEFAULT '[]'::JSONB,
+ADD COLUMN IF NOT EXISTS caption TEXT,
+ADD COLUMN IF NOT EXISTS caption_entities JSONB NOT NULL DEFAULT '[]'::JSONB;
+
+ALTER TABLE "records"."telegram_record_revisions"
+ADD COLUMN IF NOT EXISTS entities JSONB NOT NULL DEFAULT '[]'::JSONB,
+ADD COLUMN IF NOT EXISTS caption TEXT,
+ADD COLUMN IF NOT EXISTS caption_entities JSONB NOT NULL DEFAULT '[]'::JSONB;
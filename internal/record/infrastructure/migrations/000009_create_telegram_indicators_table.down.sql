@@ -0,0 +1,5 @@
+-- squawk-ignore-file ban-drop-table
+-- Drop indicators extracted from the texts of telegram records
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+DROP TABLE IF EXISTS "records".telegram_indicators;
@@ -0,0 +1,20 @@
+-- Create indicators extracted from the texts of telegram records
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+CREATE TABLE IF NOT EXISTS "records"."telegram_indicators" (
+    id UUID PRIMARY KEY NOT NULL,
+    record_id UUID NOT NULL CONSTRAINT "fk_telegram_indicators_record"
+    REFERENCES "records".telegram_records (id),
+    indicator_type TEXT NOT NULL,
+    value TEXT NOT NULL,
+    added_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    added_by_user UUID NOT NULL,
+    CONSTRAINT "unique_telegram_indicator_per_record" UNIQUE (
+        record_id, indicator_type, value
+    )
+);
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_indicators_type_value ON "records"."telegram_indicators" (indicator_type, value);
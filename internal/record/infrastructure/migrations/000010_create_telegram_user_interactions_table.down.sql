@@ -0,0 +1,8 @@
+-- squawk-ignore-file ban-drop-table,ban-drop-column
+-- Drop interactions between telegram users derived from records
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+DROP INDEX IF EXISTS "records".idx_telegram_identities_username;
+DROP INDEX IF EXISTS "records".idx_telegram_records_chat;
+ALTER TABLE "records"."telegram_records" DROP COLUMN IF EXISTS interactions_derived_at;
+DROP TABLE IF EXISTS "records".telegram_user_interactions;
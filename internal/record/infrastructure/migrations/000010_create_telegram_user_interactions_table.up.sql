@@ -0,0 +1,32 @@
+-- Create interactions between telegram users derived from records
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+CREATE TABLE IF NOT EXISTS "records"."telegram_user_interactions" (
+    from_user_telegram_id BIGINT NOT NULL,
+    to_user_telegram_id BIGINT NOT NULL,
+    interaction_type TEXT NOT NULL,
+    weight BIGINT NOT NULL,
+    first_interaction_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_interaction_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CONSTRAINT "pk_telegram_user_interactions" PRIMARY KEY (
+        from_user_telegram_id, to_user_telegram_id, interaction_type
+    )
+);
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_user_interactions_to ON "records"."telegram_user_interactions" (to_user_telegram_id);
+
+-- Records are derived once, the ones stored before are left for the backfill
+ALTER TABLE "records"."telegram_records"
+ADD COLUMN IF NOT EXISTS interactions_derived_at TIMESTAMP WITH TIME ZONE;
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_records_chat ON "records"."telegram_records" (in_telegram_chat_id);
+
+-- Mentions are resolved to users by their usernames
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_identities_username ON "records"."telegram_identities" (LOWER(username));
@@ -0,0 +1,49 @@
+-- squawk-ignore-file ban-drop-column
+-- Sum the interactions per user pair again
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+-- squawk-ignore require-concurrent-index-deletion
+DROP INDEX IF EXISTS "records".idx_telegram_user_interactions_related_record;
+
+-- squawk-ignore require-concurrent-index-deletion
+DROP INDEX IF EXISTS "records".idx_telegram_user_interactions_from;
+
+ALTER TABLE "records"."telegram_user_interactions"
+DROP CONSTRAINT IF EXISTS "unique_telegram_user_interaction";
+
+ALTER TABLE "records"."telegram_user_interactions" ALTER COLUMN record_id DROP NOT NULL;
+
+WITH per_record AS (
+    DELETE FROM "records"."telegram_user_interactions"
+    RETURNING
+        from_user_telegram_id,
+        to_user_telegram_id,
+        interaction_type,
+        weight,
+        first_interaction_at,
+        last_interaction_at
+)
+
+INSERT INTO "records"."telegram_user_interactions" (
+    from_user_telegram_id, to_user_telegram_id, interaction_type, weight, first_interaction_at, last_interaction_at
+)
+SELECT
+    from_user_telegram_id,
+    to_user_telegram_id,
+    interaction_type,
+    SUM(weight),
+    MIN(first_interaction_at),
+    MAX(last_interaction_at)
+FROM per_record
+GROUP BY from_user_telegram_id, to_user_telegram_id, interaction_type;
+
+ALTER TABLE "records"."telegram_user_interactions" DROP COLUMN IF EXISTS related_record_id;
+
+ALTER TABLE "records"."telegram_user_interactions" DROP COLUMN IF EXISTS record_id;
+
+-- squawk-ignore constraint-missing-not-valid
+ALTER TABLE "records"."telegram_user_interactions"
+ADD CONSTRAINT "pk_telegram_user_interactions" PRIMARY KEY (
+    from_user_telegram_id, to_user_telegram_id, interaction_type
+);
@@ -0,0 +1,142 @@
+-- Keep the interactions per record they're derived from instead of summing them up per user pair
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+ALTER TABLE "records"."telegram_user_interactions"
+DROP CONSTRAINT IF EXISTS "pk_telegram_user_interactions";
+
+-- record_id is the record the interaction is derived from, related_record_id the other record it involves:
+-- the record replied to, or the first record of the other member of the chat
+ALTER TABLE "records"."telegram_user_interactions"
+ADD COLUMN IF NOT EXISTS record_id UUID CONSTRAINT "fk_telegram_user_interactions_record"
+REFERENCES "records".telegram_records (id);
+
+ALTER TABLE "records"."telegram_user_interactions"
+ADD COLUMN IF NOT EXISTS related_record_id UUID CONSTRAINT "fk_telegram_user_interactions_related_record"
+REFERENCES "records".telegram_records (id);
+
+-- The sums can't be split by record, so the interactions of the records derived so far are derived again from them,
+-- the way DeriveTelegramInteractions does. A shared chat belongs to the first record of the member derived later.
+WITH derived AS (
+    SELECT
+        r.id,
+        r.from_telegram_user_id,
+        r.in_telegram_chat_id,
+        r.reply_to_message_telegram_id,
+        r.posted_at,
+        r.added_by_user,
+        r.interactions_derived_at,
+        u.telegram_id
+    FROM "records"."telegram_records" AS r
+    INNER JOIN "records"."telegram_users" AS u ON r.from_telegram_user_id = u.id
+    WHERE r.interactions_derived_at IS NOT NULL
+),
+
+replies AS (
+    SELECT
+        d.id AS record_id,
+        p.id AS related_record_id,
+        d.telegram_id AS from_id,
+        pu.telegram_id AS to_id,
+        'reply' AS interaction_type,
+        d.posted_at
+    FROM derived AS d
+    INNER JOIN "records"."telegram_records" AS p
+        ON
+            d.in_telegram_chat_id = p.in_telegram_chat_id
+            AND d.reply_to_message_telegram_id = p.message_telegram_id
+            AND d.added_by_user = p.added_by_user
+    INNER JOIN "records"."telegram_users" AS pu ON p.from_telegram_user_id = pu.id
+),
+
+mentions AS (
+    SELECT DISTINCT
+        d.id AS record_id,
+        NULL::UUID AS related_record_id,
+        d.telegram_id AS from_id,
+        mu.telegram_id AS to_id,
+        'mention' AS interaction_type,
+        d.posted_at
+    FROM derived AS d
+    INNER JOIN "records"."telegram_indicators" AS i ON d.id = i.record_id AND i.indicator_type = 'mention'
+    INNER JOIN "records"."telegram_identities" AS ti ON i.value = LOWER(ti.username)
+    INNER JOIN "records"."telegram_users" AS mu ON ti.user_id = mu.id
+),
+
+first_records AS (
+    SELECT DISTINCT ON (in_telegram_chat_id, telegram_id)
+        id,
+        in_telegram_chat_id,
+        telegram_id,
+        posted_at,
+        interactions_derived_at
+    FROM derived
+    ORDER BY in_telegram_chat_id, telegram_id, interactions_derived_at, id
+),
+
+small_chats AS (
+    SELECT in_telegram_chat_id FROM derived
+    GROUP BY in_telegram_chat_id
+    HAVING COUNT(DISTINCT from_telegram_user_id) <= 500
+),
+
+members AS (
+    SELECT
+        f.id AS record_id,
+        o.id AS related_record_id,
+        f.telegram_id AS from_id,
+        o.telegram_id AS to_id,
+        f.posted_at
+    FROM first_records AS f
+    INNER JOIN first_records AS o
+        ON
+            f.in_telegram_chat_id = o.in_telegram_chat_id
+            AND (o.interactions_derived_at, o.id) < (f.interactions_derived_at, f.id)
+    INNER JOIN small_chats AS s ON f.in_telegram_chat_id = s.in_telegram_chat_id
+),
+
+interactions AS (
+    SELECT record_id, related_record_id, from_id, to_id, interaction_type, posted_at FROM replies
+    UNION ALL
+    SELECT record_id, related_record_id, from_id, to_id, interaction_type, posted_at FROM mentions
+    UNION ALL
+    SELECT record_id, related_record_id, from_id, to_id, 'shared_chat', posted_at FROM members
+    UNION ALL
+    SELECT record_id, related_record_id, to_id, from_id, 'shared_chat', posted_at FROM members
+)
+
+INSERT INTO "records"."telegram_user_interactions" (
+    record_id, related_record_id, from_user_telegram_id, to_user_telegram_id, interaction_type,
+    weight, first_interaction_at, last_interaction_at
+)
+SELECT
+    record_id,
+    related_record_id,
+    from_id,
+    to_id,
+    interaction_type,
+    COUNT(*),
+    MIN(posted_at),
+    MAX(posted_at)
+FROM interactions WHERE from_id <> to_id
+GROUP BY record_id, related_record_id, from_id, to_id, interaction_type;
+
+-- Only the sums derived again above are replaced
+DELETE FROM "records"."telegram_user_interactions" WHERE record_id IS NULL;
+
+-- squawk-ignore adding-not-nullable-field
+ALTER TABLE "records"."telegram_user_interactions" ALTER COLUMN record_id SET NOT NULL;
+
+-- squawk-ignore constraint-missing-not-valid,disallowed-unique-constraint
+ALTER TABLE "records"."telegram_user_interactions"
+ADD CONSTRAINT "unique_telegram_user_interaction" UNIQUE NULLS NOT DISTINCT (
+    record_id, related_record_id, from_user_telegram_id, to_user_telegram_id, interaction_type
+);
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_user_interactions_from ON "records"."telegram_user_interactions" (from_user_telegram_id);
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_user_interactions_related_record ON "records"."telegram_user_interactions" (related_record_id);
@@ -0,0 +1,5 @@
+-- Drop the indexes of the normalised identity values
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+DROP INDEX IF EXISTS "records".idx_telegram_identities_bio;
+DROP INDEX IF EXISTS "records".idx_telegram_identities_phone_digits;
@@ -0,0 +1,15 @@
+-- Index the normalised identity values users are correlated by
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_identities_phone_digits ON "records"."telegram_identities" (
+    REGEXP_REPLACE(phone_number, '\D', '', 'g')
+);
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_identities_bio ON "records"."telegram_identities" (
+    LOWER(REGEXP_REPLACE(TRIM(bio), '\s+', ' ', 'g'))
+);
@@ -0,0 +1,4 @@
+-- Drop the index of the records of a sender by time
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+DROP INDEX IF EXISTS "records".idx_telegram_records_user_posted_at;
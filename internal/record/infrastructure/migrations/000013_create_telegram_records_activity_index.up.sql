@@ -0,0 +1,7 @@
+-- Index the records of a sender by time for activity statistics
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_records_user_posted_at ON "records"."telegram_records" (from_telegram_user_id, posted_at);
@@ -0,0 +1,7 @@
+-- squawk-ignore-file ban-drop-table
+-- Drop watchlists and their alerts
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+DROP TABLE IF EXISTS "records".telegram_alerts;
+DROP TABLE IF EXISTS "records".telegram_watchlist_entries;
+DROP TABLE IF EXISTS "records".telegram_watchlists;
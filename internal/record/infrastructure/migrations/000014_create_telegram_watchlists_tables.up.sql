@@ -0,0 +1,59 @@
+-- Create watchlists of users and the alerts raised on new activity matching them
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+CREATE TABLE IF NOT EXISTS "records"."telegram_watchlists" (
+    id UUID PRIMARY KEY NOT NULL,
+    owner_user_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_watchlists_owner ON "records"."telegram_watchlists" (owner_user_id);
+
+CREATE TABLE IF NOT EXISTS "records"."telegram_watchlist_entries" (
+    id UUID PRIMARY KEY NOT NULL,
+    watchlist_id UUID NOT NULL CONSTRAINT "fk_telegram_watchlist_entries_watchlist"
+    REFERENCES "records".telegram_watchlists (id) ON DELETE CASCADE,
+    entry_type TEXT NOT NULL,
+    value TEXT NOT NULL,
+    added_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT "unique_telegram_watchlist_entry" UNIQUE (
+        watchlist_id, entry_type, value
+    )
+);
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_watchlist_entries_type_value ON "records"."telegram_watchlist_entries" (entry_type, value);
+
+CREATE TABLE IF NOT EXISTS "records"."telegram_alerts" (
+    id UUID PRIMARY KEY NOT NULL,
+    owner_user_id UUID NOT NULL,
+    watchlist_id UUID NOT NULL CONSTRAINT "fk_telegram_alerts_watchlist"
+    REFERENCES "records".telegram_watchlists (id) ON DELETE CASCADE,
+    entry_id UUID NOT NULL CONSTRAINT "fk_telegram_alerts_entry"
+    REFERENCES "records".telegram_watchlist_entries (id) ON DELETE CASCADE,
+    record_id UUID CONSTRAINT "fk_telegram_alerts_record"
+    REFERENCES "records".telegram_records (id),
+    identity_id UUID CONSTRAINT "fk_telegram_alerts_identity"
+    REFERENCES "records".telegram_identities (id) ON DELETE CASCADE,
+    triggered_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    read_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT "check_telegram_alert_subject" CHECK (
+        (record_id IS NULL) <> (identity_id IS NULL)
+    ),
+    -- Analyzing a record or an identity again must not raise the same alert twice
+    CONSTRAINT "unique_telegram_alert_record" UNIQUE (entry_id, record_id),
+    CONSTRAINT "unique_telegram_alert_identity" UNIQUE (entry_id, identity_id)
+);
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_alerts_owner_triggered_at ON "records"."telegram_alerts" (owner_user_id, triggered_at DESC);
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_alerts_owner_unread ON "records"."telegram_alerts" (owner_user_id) WHERE read_at IS NULL;
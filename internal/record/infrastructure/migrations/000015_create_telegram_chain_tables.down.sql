@@ -0,0 +1,8 @@
+-- squawk-ignore-file ban-drop-table
+-- Drop the hash chains and their checkpoints
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+DROP TABLE IF EXISTS "records".telegram_chain_checkpoints;
+DROP TABLE IF EXISTS "records".telegram_chain_entries;
+DROP TABLE IF EXISTS "records".telegram_chain_heads;
+DROP FUNCTION IF EXISTS "records".reject_telegram_chain_change();
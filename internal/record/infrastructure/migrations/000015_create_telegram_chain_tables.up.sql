@@ -0,0 +1,61 @@
+-- Create the hash chains over the archived records, identities and attachments and their signed checkpoints
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+-- A head per collector serializes the appends to its chain
+CREATE TABLE IF NOT EXISTS "records"."telegram_chain_heads" (
+    collector_user_id UUID PRIMARY KEY NOT NULL,
+    sequence BIGINT NOT NULL DEFAULT 0,
+    entry_hash TEXT NOT NULL
+);
+
+-- Entries don't reference their subjects, a removed subject is reported by the verification
+CREATE TABLE IF NOT EXISTS "records"."telegram_chain_entries" (
+    collector_user_id UUID NOT NULL,
+    sequence BIGINT NOT NULL,
+    subject_type TEXT NOT NULL,
+    subject_id UUID NOT NULL,
+    content_hash TEXT NOT NULL,
+    previous_hash TEXT NOT NULL,
+    entry_hash TEXT NOT NULL,
+    added_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (collector_user_id, sequence)
+);
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_chain_entries_subject ON "records"."telegram_chain_entries" (subject_type, subject_id, sequence DESC);
+
+CREATE TABLE IF NOT EXISTS "records"."telegram_chain_checkpoints" (
+    id UUID PRIMARY KEY NOT NULL,
+    collector_user_id UUID NOT NULL,
+    sequence BIGINT NOT NULL,
+    entry_hash TEXT NOT NULL,
+    public_key TEXT NOT NULL,
+    signature TEXT NOT NULL,
+    signed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT "unique_telegram_chain_checkpoint" UNIQUE (collector_user_id, sequence)
+);
+
+-- The entries and the checkpoints are append-only, the verification still tells when they've been tampered with
+CREATE OR REPLACE FUNCTION "records".reject_telegram_chain_change() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'telegram chains are append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER trg_telegram_chain_entries_append_only
+BEFORE UPDATE OR DELETE ON "records"."telegram_chain_entries"
+FOR EACH ROW EXECUTE FUNCTION "records".reject_telegram_chain_change();
+
+CREATE OR REPLACE TRIGGER trg_telegram_chain_entries_no_truncate
+BEFORE TRUNCATE ON "records"."telegram_chain_entries"
+FOR EACH STATEMENT EXECUTE FUNCTION "records".reject_telegram_chain_change();
+
+CREATE OR REPLACE TRIGGER trg_telegram_chain_checkpoints_append_only
+BEFORE UPDATE OR DELETE ON "records"."telegram_chain_checkpoints"
+FOR EACH ROW EXECUTE FUNCTION "records".reject_telegram_chain_change();
+
+CREATE OR REPLACE TRIGGER trg_telegram_chain_checkpoints_no_truncate
+BEFORE TRUNCATE ON "records"."telegram_chain_checkpoints"
+FOR EACH STATEMENT EXECUTE FUNCTION "records".reject_telegram_chain_change();
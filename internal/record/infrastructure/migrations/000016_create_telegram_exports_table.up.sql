@@ -0,0 +1,34 @@
+-- Create the signed evidence exports built in the background
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+CREATE TABLE IF NOT EXISTS "records"."telegram_exports" (
+    id UUID PRIMARY KEY NOT NULL,
+    requested_by UUID NOT NULL,
+    selection JSONB NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    records_total BIGINT NOT NULL DEFAULT 0,
+    records_exported BIGINT NOT NULL DEFAULT 0,
+    identities_exported BIGINT NOT NULL DEFAULT 0,
+    attachments_exported BIGINT NOT NULL DEFAULT 0,
+    storage_key TEXT NOT NULL DEFAULT '',
+    sha256 TEXT NOT NULL DEFAULT '',
+    file_size BIGINT NOT NULL DEFAULT 0,
+    public_key TEXT NOT NULL DEFAULT '',
+    signature TEXT NOT NULL DEFAULT '',
+    error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    started_at TIMESTAMP WITH TIME ZONE,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    -- A running export whose lease has expired has lost its worker and is claimed again
+    lease_until TIMESTAMP WITH TIME ZONE
+);
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_exports_requested_by ON "records"."telegram_exports" (requested_by, created_at DESC);
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_exports_unfinished ON "records"."telegram_exports" (created_at)
+WHERE status IN ('pending', 'running');
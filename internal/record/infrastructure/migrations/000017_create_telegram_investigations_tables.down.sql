@@ -0,0 +1,7 @@
+-- squawk-ignore-file ban-drop-table
+-- Drop the investigations along with their members and items
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+DROP TABLE IF EXISTS "records".telegram_investigation_items;
+DROP TABLE IF EXISTS "records".telegram_investigation_members;
+DROP TABLE IF EXISTS "records".telegram_investigations;
@@ -0,0 +1,59 @@
+-- Create investigations grouping telegram users, chats and records, shared with their members
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+CREATE TABLE IF NOT EXISTS "records"."telegram_investigations" (
+    id UUID PRIMARY KEY NOT NULL,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS "records"."telegram_investigation_members" (
+    id UUID PRIMARY KEY NOT NULL,
+    investigation_id UUID NOT NULL CONSTRAINT "fk_telegram_investigation_members_investigation"
+    REFERENCES "records".telegram_investigations (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    role TEXT NOT NULL,
+    added_by UUID NOT NULL,
+    added_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT "unique_telegram_investigation_member" UNIQUE (investigation_id, user_id),
+    CONSTRAINT "telegram_investigation_member_role" CHECK (
+        role IN ('owner', 'editor', 'viewer')
+    )
+);
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_investigation_members_user ON "records"."telegram_investigation_members" (user_id);
+
+CREATE TABLE IF NOT EXISTS "records"."telegram_investigation_items" (
+    id UUID PRIMARY KEY NOT NULL,
+    investigation_id UUID NOT NULL CONSTRAINT "fk_telegram_investigation_items_investigation"
+    REFERENCES "records".telegram_investigations (id) ON DELETE CASCADE,
+    item_type TEXT NOT NULL,
+    telegram_user_id UUID CONSTRAINT "fk_telegram_investigation_items_user"
+    REFERENCES "records".telegram_users (id) ON DELETE CASCADE,
+    telegram_chat_id UUID CONSTRAINT "fk_telegram_investigation_items_chat"
+    REFERENCES "records".telegram_chats (id) ON DELETE CASCADE,
+    telegram_record_id UUID CONSTRAINT "fk_telegram_investigation_items_record"
+    REFERENCES "records".telegram_records (id) ON DELETE CASCADE,
+    note TEXT NOT NULL DEFAULT '',
+    added_by UUID NOT NULL,
+    added_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT "telegram_investigation_item_type" CHECK (
+        item_type IN ('telegram_user', 'telegram_chat', 'telegram_record')
+    ),
+    -- Only the column of the item type holds the target
+    CONSTRAINT "check_telegram_investigation_item_target" CHECK (
+        num_nonnulls(telegram_user_id, telegram_chat_id, telegram_record_id) = 1
+        AND (item_type <> 'telegram_user' OR telegram_user_id IS NOT NULL)
+        AND (item_type <> 'telegram_chat' OR telegram_chat_id IS NOT NULL)
+        AND (item_type <> 'telegram_record' OR telegram_record_id IS NOT NULL)
+    ),
+    CONSTRAINT "unique_telegram_investigation_item_user" UNIQUE (investigation_id, telegram_user_id),
+    CONSTRAINT "unique_telegram_investigation_item_chat" UNIQUE (investigation_id, telegram_chat_id),
+    CONSTRAINT "unique_telegram_investigation_item_record" UNIQUE (investigation_id, telegram_record_id)
+);
+
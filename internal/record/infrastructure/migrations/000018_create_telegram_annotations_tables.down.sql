@@ -0,0 +1,6 @@
+-- squawk-ignore-file ban-drop-table
+-- Drop the tags and the notes
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+DROP TABLE IF EXISTS "records".telegram_notes;
+DROP TABLE IF EXISTS "records".telegram_tags;
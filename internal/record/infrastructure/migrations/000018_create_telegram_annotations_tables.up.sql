@@ -0,0 +1,70 @@
+-- Create the tags and the notes analysts attach to telegram users, identities and records
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+CREATE TABLE IF NOT EXISTS "records"."telegram_tags" (
+    id UUID PRIMARY KEY NOT NULL,
+    target_type TEXT NOT NULL,
+    telegram_user_id UUID CONSTRAINT "fk_telegram_tags_user"
+    REFERENCES "records".telegram_users (id) ON DELETE CASCADE,
+    telegram_identity_id UUID CONSTRAINT "fk_telegram_tags_identity"
+    REFERENCES "records".telegram_identities (id) ON DELETE CASCADE,
+    telegram_record_id UUID CONSTRAINT "fk_telegram_tags_record"
+    REFERENCES "records".telegram_records (id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    added_by UUID NOT NULL,
+    added_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT "telegram_tag_target_type" CHECK (
+        target_type IN ('telegram_user', 'telegram_identity', 'telegram_record')
+    ),
+    -- Only the column of the target type holds the target
+    CONSTRAINT "check_telegram_tag_target" CHECK (
+        num_nonnulls(telegram_user_id, telegram_identity_id, telegram_record_id) = 1
+        AND (target_type <> 'telegram_user' OR telegram_user_id IS NOT NULL)
+        AND (target_type <> 'telegram_identity' OR telegram_identity_id IS NOT NULL)
+        AND (target_type <> 'telegram_record' OR telegram_record_id IS NOT NULL)
+    ),
+    CONSTRAINT "unique_telegram_tag_user" UNIQUE (telegram_user_id, name),
+    CONSTRAINT "unique_telegram_tag_identity" UNIQUE (telegram_identity_id, name),
+    CONSTRAINT "unique_telegram_tag_record" UNIQUE (telegram_record_id, name)
+);
+
+-- The catalogue and the tagged targets are looked up by name
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_tags_name ON "records"."telegram_tags" (name, added_at);
+
+CREATE TABLE IF NOT EXISTS "records"."telegram_notes" (
+    id UUID PRIMARY KEY NOT NULL,
+    target_type TEXT NOT NULL,
+    telegram_user_id UUID CONSTRAINT "fk_telegram_notes_user"
+    REFERENCES "records".telegram_users (id) ON DELETE CASCADE,
+    telegram_identity_id UUID CONSTRAINT "fk_telegram_notes_identity"
+    REFERENCES "records".telegram_identities (id) ON DELETE CASCADE,
+    telegram_record_id UUID CONSTRAINT "fk_telegram_notes_record"
+    REFERENCES "records".telegram_records (id) ON DELETE CASCADE,
+    body TEXT NOT NULL,
+    added_by UUID NOT NULL,
+    added_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT "telegram_note_target_type" CHECK (
+        target_type IN ('telegram_user', 'telegram_identity', 'telegram_record')
+    ),
+    CONSTRAINT "check_telegram_note_target" CHECK (
+        num_nonnulls(telegram_user_id, telegram_identity_id, telegram_record_id) = 1
+        AND (target_type <> 'telegram_user' OR telegram_user_id IS NOT NULL)
+        AND (target_type <> 'telegram_identity' OR telegram_identity_id IS NOT NULL)
+        AND (target_type <> 'telegram_record' OR telegram_record_id IS NOT NULL)
+    )
+);
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_notes_user ON "records"."telegram_notes" (telegram_user_id);
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_notes_identity ON "records"."telegram_notes" (telegram_identity_id);
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_telegram_notes_record ON "records"."telegram_notes" (telegram_record_id);
@@ -0,0 +1,27 @@
+-- squawk-ignore-file ban-drop-table,ban-drop-column
+-- Drop the provenance and go back to the telegram users and records stored per collector
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+DROP TABLE IF EXISTS "records".telegram_observations;
+-- squawk-ignore require-concurrent-index-deletion
+DROP INDEX IF EXISTS "records".unique_telegram_message_id;
+-- squawk-ignore constraint-missing-not-valid,disallowed-unique-constraint
+ALTER TABLE "records"."telegram_records"
+ADD CONSTRAINT "unique_telegram_message_id" UNIQUE (
+    message_telegram_id, in_telegram_chat_id, added_by_user
+);
+-- squawk-ignore require-concurrent-index-deletion
+DROP INDEX IF EXISTS "records".unique_telegram_id;
+-- squawk-ignore constraint-missing-not-valid,disallowed-unique-constraint
+ALTER TABLE "records"."telegram_users"
+ADD CONSTRAINT "unique_telegram_id_per_user" UNIQUE (telegram_id, added_by_user);
+-- The pictures of users a collector hasn't added itself are not checked
+ALTER TABLE "records"."telegram_profile_pictures"
+ADD CONSTRAINT "fk_telegram_profile_pictures_user"
+FOREIGN KEY (user_telegram_id, added_by_user)
+REFERENCES "records".telegram_users (telegram_id, added_by_user)
+NOT VALID;
+ALTER TABLE "records"."telegram_records"
+DROP COLUMN IF EXISTS canonical_id;
+ALTER TABLE "records"."telegram_users"
+DROP COLUMN IF EXISTS canonical_id;
@@ -0,0 +1,112 @@
+-- Store every telegram user and record once and keep the collectors observing them as their provenance
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+-- The duplicates stored per collector before are kept, their content is linked to the hash chains,
+-- and point at the canonical row: the first one stored
+ALTER TABLE "records"."telegram_users"
+ADD COLUMN IF NOT EXISTS canonical_id UUID CONSTRAINT "fk_telegram_users_canonical"
+REFERENCES "records".telegram_users (id);
+
+ALTER TABLE "records"."telegram_records"
+ADD COLUMN IF NOT EXISTS canonical_id UUID CONSTRAINT "fk_telegram_records_canonical"
+REFERENCES "records".telegram_records (id);
+
+WITH ranked AS (
+    SELECT
+        id,
+        FIRST_VALUE(id) OVER (PARTITION BY telegram_id ORDER BY added_at, id) AS canonical_id
+    FROM "records"."telegram_users"
+)
+
+UPDATE "records"."telegram_users" u
+SET canonical_id = ranked.canonical_id
+FROM ranked
+WHERE ranked.id = u.id AND ranked.canonical_id <> u.id;
+
+WITH ranked AS (
+    SELECT
+        id,
+        FIRST_VALUE(id) OVER (
+            PARTITION BY message_telegram_id, in_telegram_chat_id ORDER BY added_at, id
+        ) AS canonical_id
+    FROM "records"."telegram_records"
+)
+
+UPDATE "records"."telegram_records" r
+SET canonical_id = ranked.canonical_id
+FROM ranked
+WHERE ranked.id = r.id AND ranked.canonical_id <> r.id;
+
+-- A collector references the canonical user, which it may not have added itself
+ALTER TABLE "records"."telegram_profile_pictures"
+DROP CONSTRAINT IF EXISTS "fk_telegram_profile_pictures_user";
+
+ALTER TABLE "records"."telegram_users"
+DROP CONSTRAINT IF EXISTS "unique_telegram_id_per_user";
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE UNIQUE INDEX IF NOT EXISTS
+unique_telegram_id ON "records"."telegram_users" (telegram_id) WHERE canonical_id IS NULL;
+
+ALTER TABLE "records"."telegram_records"
+DROP CONSTRAINT IF EXISTS "unique_telegram_message_id";
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE UNIQUE INDEX IF NOT EXISTS
+unique_telegram_message_id ON "records"."telegram_records" (
+    message_telegram_id, in_telegram_chat_id
+) WHERE canonical_id IS NULL;
+
+CREATE TABLE IF NOT EXISTS "records"."telegram_observations" (
+    id UUID PRIMARY KEY NOT NULL,
+    target_type TEXT NOT NULL,
+    telegram_user_id UUID CONSTRAINT "fk_telegram_observations_user"
+    REFERENCES "records".telegram_users (id) ON DELETE CASCADE,
+    telegram_record_id UUID CONSTRAINT "fk_telegram_observations_record"
+    REFERENCES "records".telegram_records (id) ON DELETE CASCADE,
+    collector_user_id UUID NOT NULL,
+    visibility TEXT NOT NULL,
+    observed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT "telegram_observation_target_type" CHECK (
+        target_type IN ('telegram_user', 'telegram_record')
+    ),
+    CONSTRAINT "telegram_observation_visibility" CHECK (
+        visibility IN ('private', 'team', 'global')
+    ),
+    -- Only the column of the target type holds the target
+    CONSTRAINT "check_telegram_observation_target" CHECK (
+        num_nonnulls(telegram_user_id, telegram_record_id) = 1
+        AND (target_type <> 'telegram_user' OR telegram_user_id IS NOT NULL)
+        AND (target_type <> 'telegram_record' OR telegram_record_id IS NOT NULL)
+    ),
+    CONSTRAINT "unique_telegram_observation_user" UNIQUE (telegram_user_id, collector_user_id),
+    CONSTRAINT "unique_telegram_observation_record" UNIQUE (telegram_record_id, collector_user_id)
+);
+
+-- Everything stored so far has been seen by everyone
+INSERT INTO "records"."telegram_observations" (
+    id, target_type, telegram_user_id, collector_user_id, visibility, observed_at
+)
+SELECT DISTINCT ON (COALESCE(canonical_id, id), added_by_user)
+    gen_random_uuid(),
+    'telegram_user',
+    COALESCE(canonical_id, id),
+    added_by_user,
+    'global',
+    added_at
+FROM "records"."telegram_users"
+ORDER BY COALESCE(canonical_id, id), added_by_user, added_at;
+
+INSERT INTO "records"."telegram_observations" (
+    id, target_type, telegram_record_id, collector_user_id, visibility, observed_at
+)
+SELECT DISTINCT ON (COALESCE(canonical_id, id), added_by_user)
+    gen_random_uuid(),
+    'telegram_record',
+    COALESCE(canonical_id, id),
+    added_by_user,
+    'global',
+    added_at
+FROM "records"."telegram_records"
+ORDER BY COALESCE(canonical_id, id), added_by_user, added_at;
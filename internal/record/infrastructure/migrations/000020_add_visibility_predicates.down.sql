@@ -0,0 +1,9 @@
+-- Drop the visibility predicates
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+DROP FUNCTION IF EXISTS "records".is_target_visible(UUID, UUID, UUID);
+DROP FUNCTION IF EXISTS "records".is_identity_visible(UUID);
+DROP FUNCTION IF EXISTS "records".is_user_visible(UUID);
+DROP FUNCTION IF EXISTS "records".is_record_visible(UUID);
+DROP FUNCTION IF EXISTS "records".is_observation_visible(TEXT, UUID);
@@ -0,0 +1,59 @@
+-- Share what a platform user sees of the telegram data observed by the collectors with every read
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+-- The transactions on behalf of a user publish them by app.user_id and app.user_role, and the members of their teams
+-- by app.team_user_ids. Admins see every observation, the others see the global observations, their own ones
+-- and the team ones of their teammates. A transaction on behalf of no one sees the global observations only.
+CREATE OR REPLACE FUNCTION "records".is_observation_visible(
+    observation_visibility TEXT, observation_collector_user_id UUID
+) RETURNS BOOLEAN AS $$
+    SELECT COALESCE(current_setting('app.user_role', true), '') = 'admin'
+    OR observation_visibility = 'global'
+    OR observation_collector_user_id = NULLIF(current_setting('app.user_id', true), '')::UUID
+    OR (
+        observation_visibility = 'team'
+        AND observation_collector_user_id = ANY(NULLIF(current_setting('app.team_user_ids', true), '')::UUID[])
+    );
+$$ LANGUAGE sql STABLE;
+
+-- A record is seen through any visible observation of it. The duplicates stored per collector before the records
+-- were deduplicated are never observed, only their canonical record is seen.
+CREATE OR REPLACE FUNCTION "records".is_record_visible(target_record_id UUID) RETURNS BOOLEAN AS $$
+    SELECT EXISTS (
+        SELECT 1 FROM "records"."telegram_observations" o
+        WHERE o.telegram_record_id = target_record_id
+        AND "records".is_observation_visible(o.visibility, o.collector_user_id)
+    );
+$$ LANGUAGE sql STABLE;
+
+-- A user is seen through any visible observation of its canonical user
+CREATE OR REPLACE FUNCTION "records".is_user_visible(target_user_id UUID) RETURNS BOOLEAN AS $$
+    SELECT EXISTS (
+        SELECT 1 FROM "records"."telegram_users" u
+        JOIN "records"."telegram_observations" o ON o.telegram_user_id = COALESCE(u.canonical_id, u.id)
+        WHERE u.id = target_user_id
+        AND "records".is_observation_visible(o.visibility, o.collector_user_id)
+    );
+$$ LANGUAGE sql STABLE;
+
+-- An identity is the user as its collector has seen it, so it takes the observation of that collector
+CREATE OR REPLACE FUNCTION "records".is_identity_visible(target_identity_id UUID) RETURNS BOOLEAN AS $$
+    SELECT EXISTS (
+        SELECT 1 FROM "records"."telegram_identities" i
+        JOIN "records"."telegram_users" u ON u.id = i.user_id
+        JOIN "records"."telegram_observations" o ON o.telegram_user_id = COALESCE(u.canonical_id, u.id)
+        AND o.collector_user_id = i.added_by_user
+        WHERE i.id = target_identity_id
+        AND "records".is_observation_visible(o.visibility, o.collector_user_id)
+    );
+$$ LANGUAGE sql STABLE;
+
+-- The tags, the notes and the investigation items reference one of these, the other ones being NULL
+CREATE OR REPLACE FUNCTION "records".is_target_visible(
+    target_user_id UUID, target_identity_id UUID, target_record_id UUID
+) RETURNS BOOLEAN AS $$
+    SELECT (target_user_id IS NULL OR "records".is_user_visible(target_user_id))
+    AND (target_identity_id IS NULL OR "records".is_identity_visible(target_identity_id))
+    AND (target_record_id IS NULL OR "records".is_record_visible(target_record_id));
+$$ LANGUAGE sql STABLE;
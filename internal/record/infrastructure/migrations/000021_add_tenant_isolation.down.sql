@@ -0,0 +1,54 @@
+-- squawk-ignore-file ban-drop-column
+-- Drop the isolation of the tenants, every user sees the data of the others again
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+-- squawk-ignore require-concurrent-index-deletion
+DROP INDEX IF EXISTS "records".unique_telegram_message_id;
+-- squawk-ignore require-concurrent-index-creation
+CREATE UNIQUE INDEX IF NOT EXISTS
+unique_telegram_message_id ON "records"."telegram_records" (
+    message_telegram_id, in_telegram_chat_id
+) WHERE canonical_id IS NULL;
+-- squawk-ignore require-concurrent-index-deletion
+DROP INDEX IF EXISTS "records".unique_telegram_id;
+-- squawk-ignore require-concurrent-index-creation
+CREATE UNIQUE INDEX IF NOT EXISTS
+unique_telegram_id ON "records"."telegram_users" (telegram_id) WHERE canonical_id IS NULL;
+DO $$
+DECLARE
+    table_name TEXT;
+BEGIN
+    FOREACH table_name IN ARRAY ARRAY[
+        'telegram_users',
+        'telegram_records',
+        'telegram_identities',
+        'telegram_chats',
+        'telegram_chat_snapshots',
+        'telegram_attachments',
+        'telegram_profile_pictures',
+        'telegram_record_revisions',
+        'telegram_indicators',
+        'telegram_user_interactions',
+        'telegram_watchlists',
+        'telegram_watchlist_entries',
+        'telegram_alerts',
+        'telegram_chain_heads',
+        'telegram_chain_entries',
+        'telegram_chain_checkpoints',
+        'telegram_exports',
+        'telegram_investigations',
+        'telegram_investigation_members',
+        'telegram_investigation_items',
+        'telegram_tags',
+        'telegram_notes',
+        'telegram_observations'
+    ] LOOP
+        EXECUTE format('DROP POLICY IF EXISTS tenant_isolation ON "records".%I', table_name);
+        EXECUTE format('ALTER TABLE "records".%I NO FORCE ROW LEVEL SECURITY', table_name);
+        EXECUTE format('ALTER TABLE "records".%I DISABLE ROW LEVEL SECURITY', table_name);
+        EXECUTE format('ALTER TABLE "records".%I DROP COLUMN IF EXISTS tenant_id', table_name);
+    END LOOP;
+END;
+$$;
+DROP FUNCTION IF EXISTS "records".is_tenant_visible(UUID);
+DROP FUNCTION IF EXISTS "records".current_tenant_id();
@@ -0,0 +1,79 @@
+-- Keep the data of the teams sharing the deployment apart by row-level security
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+-- The transactions are scoped to the team of their caller by app.tenant_id, empty for the users without a team:
+-- they share the data stored before the teams. The transactions on behalf of nobody, as the background jobs open,
+-- set app.tenant_bypass to see every tenant. A later migration changing the data has to set it as well.
+CREATE OR REPLACE FUNCTION "records".current_tenant_id() RETURNS UUID AS $$
+    SELECT NULLIF(current_setting('app.tenant_id', true), '')::UUID;
+$$ LANGUAGE sql STABLE;
+
+CREATE OR REPLACE FUNCTION "records".is_tenant_visible(row_tenant_id UUID) RETURNS BOOLEAN AS $$
+    SELECT COALESCE(current_setting('app.tenant_bypass', true), '') = 'on'
+    OR row_tenant_id IS NOT DISTINCT FROM "records".current_tenant_id();
+$$ LANGUAGE sql STABLE;
+
+-- A row belongs to the tenant of the transaction inserting it. FORCE keeps the owner of the tables,
+-- which the application usually connects as, subject to the policies too.
+DO $$
+DECLARE
+    table_name TEXT;
+BEGIN
+    FOREACH table_name IN ARRAY ARRAY[
+        'telegram_users',
+        'telegram_records',
+        'telegram_identities',
+        'telegram_chats',
+        'telegram_chat_snapshots',
+        'telegram_attachments',
+        'telegram_profile_pictures',
+        'telegram_record_revisions',
+        'telegram_indicators',
+        'telegram_user_interactions',
+        'telegram_watchlists',
+        'telegram_watchlist_entries',
+        'telegram_alerts',
+        'telegram_chain_heads',
+        'telegram_chain_entries',
+        'telegram_chain_checkpoints',
+        'telegram_exports',
+        'telegram_investigations',
+        'telegram_investigation_members',
+        'telegram_investigation_items',
+        'telegram_tags',
+        'telegram_notes',
+        'telegram_observations'
+    ] LOOP
+        EXECUTE format(
+            'ALTER TABLE "records".%I ADD COLUMN IF NOT EXISTS tenant_id UUID DEFAULT "records".current_tenant_id()',
+            table_name
+        );
+        EXECUTE format('ALTER TABLE "records".%I ENABLE ROW LEVEL SECURITY', table_name);
+        EXECUTE format('ALTER TABLE "records".%I FORCE ROW LEVEL SECURITY', table_name);
+        EXECUTE format(
+            'CREATE POLICY tenant_isolation ON "records".%I '
+            'USING ("records".is_tenant_visible(tenant_id)) WITH CHECK ("records".is_tenant_visible(tenant_id))',
+            table_name
+        );
+    END LOOP;
+END;
+$$;
+
+-- The same telegram user or record is stored once per tenant
+-- squawk-ignore require-concurrent-index-deletion
+DROP INDEX IF EXISTS "records".unique_telegram_id;
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE UNIQUE INDEX IF NOT EXISTS
+unique_telegram_id ON "records"."telegram_users" (telegram_id, tenant_id) NULLS NOT DISTINCT
+WHERE canonical_id IS NULL;
+
+-- squawk-ignore require-concurrent-index-deletion
+DROP INDEX IF EXISTS "records".unique_telegram_message_id;
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE UNIQUE INDEX IF NOT EXISTS
+unique_telegram_message_id ON "records"."telegram_records" (
+    message_telegram_id, in_telegram_chat_id, tenant_id
+) NULLS NOT DISTINCT WHERE canonical_id IS NULL;
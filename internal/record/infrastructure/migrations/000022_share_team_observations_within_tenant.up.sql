@@ -0,0 +1,49 @@
+-- Share the team observations within the team of their collector instead of the configured teams
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+-- Admins see every observation, as do the background jobs seeing every tenant. The others see the global
+-- observations, their own ones and the team ones of their team: the users without a team don't share any.
+CREATE OR REPLACE FUNCTION "records".is_observation_visible(
+    observation_visibility TEXT, observation_collector_user_id UUID, observation_tenant_id UUID
+) RETURNS BOOLEAN AS $$
+    SELECT COALESCE(current_setting('app.tenant_bypass', true), '') = 'on'
+    OR COALESCE(current_setting('app.user_role', true), '') = 'admin'
+    OR observation_visibility = 'global'
+    OR observation_collector_user_id = NULLIF(current_setting('app.user_id', true), '')::UUID
+    OR (observation_visibility = 'team' AND observation_tenant_id = "records".current_tenant_id());
+$$ LANGUAGE sql STABLE;
+
+-- A record is seen through any visible observation of it. The duplicates stored per collector before the records
+-- were deduplicated are never observed, only their canonical record is seen.
+CREATE OR REPLACE FUNCTION "records".is_record_visible(target_record_id UUID) RETURNS BOOLEAN AS $$
+    SELECT EXISTS (
+        SELECT 1 FROM "records"."telegram_observations" o
+        WHERE o.telegram_record_id = target_record_id
+        AND "records".is_observation_visible(o.visibility, o.collector_user_id, o.tenant_id)
+    );
+$$ LANGUAGE sql STABLE;
+
+-- A user is seen through any visible observation of its canonical user
+CREATE OR REPLACE FUNCTION "records".is_user_visible(target_user_id UUID) RETURNS BOOLEAN AS $$
+    SELECT EXISTS (
+        SELECT 1 FROM "records"."telegram_users" u
+        JOIN "records"."telegram_observations" o ON o.telegram_user_id = COALESCE(u.canonical_id, u.id)
+        WHERE u.id = target_user_id
+        AND "records".is_observation_visible(o.visibility, o.collector_user_id, o.tenant_id)
+    );
+$$ LANGUAGE sql STABLE;
+
+-- An identity is the user as its collector has seen it, so it takes the observation of that collector
+CREATE OR REPLACE FUNCTION "records".is_identity_visible(target_identity_id UUID) RETURNS BOOLEAN AS $$
+    SELECT EXISTS (
+        SELECT 1 FROM "records"."telegram_identities" i
+        JOIN "records"."telegram_users" u ON u.id = i.user_id
+        JOIN "records"."telegram_observations" o ON o.telegram_user_id = COALESCE(u.canonical_id, u.id)
+        AND o.collector_user_id = i.added_by_user
+        WHERE i.id = target_identity_id
+        AND "records".is_observation_visible(o.visibility, o.collector_user_id, o.tenant_id)
+    );
+$$ LANGUAGE sql STABLE;
+
+DROP FUNCTION IF EXISTS "records".is_observation_visible(TEXT, UUID);
@@ -0,0 +1,18 @@
+-- Let the transactions scoped to no tenant see every tenant again
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+CREATE OR REPLACE FUNCTION "records".is_tenant_visible(row_tenant_id UUID) RETURNS BOOLEAN AS $$
+    SELECT COALESCE(current_setting('app.tenant_bypass', true), '') = 'on'
+    OR row_tenant_id IS NOT DISTINCT FROM "records".current_tenant_id();
+$$ LANGUAGE sql STABLE;
+
+CREATE OR REPLACE FUNCTION "records".is_observation_visible(
+    observation_visibility TEXT, observation_collector_user_id UUID, observation_tenant_id UUID
+) RETURNS BOOLEAN AS $$
+    SELECT COALESCE(current_setting('app.tenant_bypass', true), '') = 'on'
+    OR COALESCE(current_setting('app.user_role', true), '') = 'admin'
+    OR observation_visibility = 'global'
+    OR observation_collector_user_id = NULLIF(current_setting('app.user_id', true), '')::UUID
+    OR (observation_visibility = 'team' AND observation_tenant_id = "records".current_tenant_id());
+$$ LANGUAGE sql STABLE;
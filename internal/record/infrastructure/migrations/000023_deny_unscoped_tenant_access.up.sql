@@ -0,0 +1,25 @@
+-- Deny the transactions scoped to no tenant instead of letting them see every tenant
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+-- app.tenant_scope is 'team' for the transactions on behalf of a user, scoped to their team by app.tenant_id,
+-- and 'all' for the background jobs marked to see every tenant. Any other transaction sees nothing,
+-- so a later migration changing the data has to set app.tenant_scope to 'all'.
+CREATE OR REPLACE FUNCTION "records".is_tenant_visible(row_tenant_id UUID) RETURNS BOOLEAN AS $$
+    SELECT CASE COALESCE(current_setting('app.tenant_scope', true), '')
+        WHEN 'all' THEN true
+        WHEN 'team' THEN row_tenant_id IS NOT DISTINCT FROM "records".current_tenant_id()
+        ELSE false
+    END;
+$$ LANGUAGE sql STABLE;
+
+-- The background jobs seeing every tenant see every observation too
+CREATE OR REPLACE FUNCTION "records".is_observation_visible(
+    observation_visibility TEXT, observation_collector_user_id UUID, observation_tenant_id UUID
+) RETURNS BOOLEAN AS $$
+    SELECT COALESCE(current_setting('app.tenant_scope', true), '') = 'all'
+    OR COALESCE(current_setting('app.user_role', true), '') = 'admin'
+    OR observation_visibility = 'global'
+    OR observation_collector_user_id = NULLIF(current_setting('app.user_id', true), '')::UUID
+    OR (observation_visibility = 'team' AND observation_tenant_id = "records".current_tenant_id());
+$$ LANGUAGE sql STABLE;
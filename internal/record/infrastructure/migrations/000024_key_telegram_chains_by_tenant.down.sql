@@ -0,0 +1,24 @@
+-- Key the chains by their collector alone again, it fails while a collector has chains in several tenants
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+ALTER TABLE "records"."telegram_chain_checkpoints"
+DROP CONSTRAINT IF EXISTS "unique_telegram_chain_checkpoint";
+
+-- squawk-ignore constraint-missing-not-valid,disallowed-unique-constraint
+ALTER TABLE "records"."telegram_chain_checkpoints"
+ADD CONSTRAINT "unique_telegram_chain_checkpoint" UNIQUE (collector_user_id, sequence);
+
+ALTER TABLE "records"."telegram_chain_entries"
+DROP CONSTRAINT IF EXISTS "unique_telegram_chain_entry";
+
+-- squawk-ignore constraint-missing-not-valid
+ALTER TABLE "records"."telegram_chain_entries"
+ADD CONSTRAINT "telegram_chain_entries_pkey" PRIMARY KEY (collector_user_id, sequence);
+
+ALTER TABLE "records"."telegram_chain_heads"
+DROP CONSTRAINT IF EXISTS "unique_telegram_chain_head";
+
+-- squawk-ignore constraint-missing-not-valid
+ALTER TABLE "records"."telegram_chain_heads"
+ADD CONSTRAINT "telegram_chain_heads_pkey" PRIMARY KEY (collector_user_id);
@@ -0,0 +1,26 @@
+-- Keep a chain per collector and tenant, a collector moved to another team starts a chain within it
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+ALTER TABLE "records"."telegram_chain_heads"
+DROP CONSTRAINT IF EXISTS "telegram_chain_heads_pkey";
+
+-- squawk-ignore constraint-missing-not-valid,disallowed-unique-constraint
+ALTER TABLE "records"."telegram_chain_heads"
+ADD CONSTRAINT "unique_telegram_chain_head" UNIQUE NULLS NOT DISTINCT (collector_user_id, tenant_id);
+
+ALTER TABLE "records"."telegram_chain_entries"
+DROP CONSTRAINT IF EXISTS "telegram_chain_entries_pkey";
+
+-- squawk-ignore constraint-missing-not-valid,disallowed-unique-constraint
+ALTER TABLE "records"."telegram_chain_entries"
+ADD CONSTRAINT "unique_telegram_chain_entry" UNIQUE NULLS NOT DISTINCT (collector_user_id, tenant_id, sequence);
+
+ALTER TABLE "records"."telegram_chain_checkpoints"
+DROP CONSTRAINT IF EXISTS "unique_telegram_chain_checkpoint";
+
+-- squawk-ignore constraint-missing-not-valid,disallowed-unique-constraint
+ALTER TABLE "records"."telegram_chain_checkpoints"
+ADD CONSTRAINT "unique_telegram_chain_checkpoint" UNIQUE NULLS NOT DISTINCT (
+    collector_user_id, tenant_id, sequence
+);
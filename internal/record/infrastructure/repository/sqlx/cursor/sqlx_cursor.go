@@ -0,0 +1,62 @@
+package cursor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// FetchSize is the amount of rows fetched from the cursor at once.
+const FetchSize = 1000
+
+// Stream runs the query in a server-side cursor of the transaction and passes the rows to yield one at a time,
+// so that a result of millions of rows is never held in memory. A stream stopped by an error leaves the cursor
+// to be closed along with the transaction.
+// Database errors wrap repository.ErrDatabaseFailed, the errors of yield are returned as they are.
+func Stream[T any](ctx context.Context, session *sqlx.Tx, query string, args []any, yield func(*T) error) error {
+	name := "stream_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	if _, err := session.ExecContext(ctx, "DECLARE "+name+" NO SCROLL CURSOR FOR "+query, args...); err != nil {
+		return fmt.Errorf("%w: %w", repository.ErrDatabaseFailed, err)
+	}
+	fetch := fmt.Sprintf("FETCH FORWARD %d FROM %s", FetchSize, name)
+	for {
+		fetched, err := fetchRows(ctx, session, fetch, yield)
+		if err != nil {
+			return err
+		}
+		if fetched < FetchSize {
+			break
+		}
+	}
+	if _, err := session.ExecContext(ctx, "CLOSE "+name); err != nil {
+		return fmt.Errorf("%w: %w", repository.ErrDatabaseFailed, err)
+	}
+	return nil
+}
+
+func fetchRows[T any](ctx context.Context, session *sqlx.Tx, fetch string, yield func(*T) error) (int, error) {
+	rows, err := session.QueryxContext(ctx, fetch)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", repository.ErrDatabaseFailed, err)
+	}
+	defer func() { _ = rows.Close() }()
+	fetched := 0
+	for rows.Next() {
+		var row T
+		if err = rows.StructScan(&row); err != nil {
+			return fetched, fmt.Errorf("%w: %w", repository.ErrDatabaseFailed, err)
+		}
+		fetched++
+		if err = yield(&row); err != nil {
+			return fetched, err
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return fetched, fmt.Errorf("%w: %w", repository.ErrDatabaseFailed, err)
+	}
+	return fetched, nil
+}
@@ -0,0 +1,52 @@
+package mappers
+
+import (
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+)
+
+type SqlxTelegramActivityMapper struct{}
+
+func NewSqlxTelegramActivityMapper() *SqlxTelegramActivityMapper {
+	return &SqlxTelegramActivityMapper{}
+}
+
+func (sm *SqlxTelegramActivityMapper) ToDomain(
+	summaryModel models.TelegramActivitySummaryModel,
+	dailyModels []models.TelegramDailyActivityModel,
+	hourOfWeekModels []models.TelegramHourOfWeekActivityModel,
+	breakdownModels []models.TelegramActivityBreakdownModel,
+	gapModels []models.TelegramActivityGapModel,
+) domain.TelegramActivityStatistics {
+	statistics := domain.TelegramActivityStatistics{
+		MessageCount:         summaryModel.MessageCount,
+		AverageMessageLength: summaryModel.AverageMessageLength,
+		Daily:                make([]domain.TelegramDailyActivity, len(dailyModels)),
+		Breakdown:            make([]domain.TelegramActivityBreakdown, len(breakdownModels)),
+		Gaps:                 make([]domain.TelegramActivityGap, len(gapModels)),
+	}
+	if summaryModel.FirstSeenAt.Valid {
+		statistics.FirstSeenAt = &summaryModel.FirstSeenAt.Time
+	}
+	if summaryModel.LastSeenAt.Valid {
+		statistics.LastSeenAt = &summaryModel.LastSeenAt.Time
+	}
+	for i, dailyModel := range dailyModels {
+		statistics.Daily[i] = domain.TelegramDailyActivity{Day: dailyModel.Day, MessageCount: dailyModel.MessageCount}
+	}
+	for _, hourOfWeekModel := range hourOfWeekModels {
+		statistics.HourOfWeek[hourOfWeekModel.Weekday-1][hourOfWeekModel.Hour] = hourOfWeekModel.MessageCount
+	}
+	for i, breakdownModel := range breakdownModels {
+		statistics.Breakdown[i] = domain.TelegramActivityBreakdown{
+			TelegramID:   breakdownModel.TelegramID,
+			MessageCount: breakdownModel.MessageCount,
+			FirstSeenAt:  breakdownModel.FirstSeenAt,
+			LastSeenAt:   breakdownModel.LastSeenAt,
+		}
+	}
+	for i, gapModel := range gapModels {
+		statistics.Gaps[i] = domain.TelegramActivityGap{StartedAt: gapModel.StartedAt, EndedAt: gapModel.EndedAt}
+	}
+	return statistics
+}
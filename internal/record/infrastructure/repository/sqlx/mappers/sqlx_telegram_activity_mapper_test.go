@@ -0,0 +1,81 @@
+package mappers_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActivityToDomain_HourOfWeek(t *testing.T) {
+	mapper := mappers.NewSqlxTelegramActivityMapper()
+	cases := map[string]struct {
+		weekday int
+		hour    int
+		day     int
+	}{
+		"monday midnight":  {weekday: 1, hour: 0, day: 0},
+		"wednesday noon":   {weekday: 3, hour: 12, day: 2},
+		"sunday last hour": {weekday: 7, hour: 23, day: 6},
+		"saturday morning": {weekday: 6, hour: 7, day: 5},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			statistics := mapper.ToDomain(
+				models.TelegramActivitySummaryModel{MessageCount: 3},
+				nil,
+				[]models.TelegramHourOfWeekActivityModel{{Weekday: tc.weekday, Hour: tc.hour, MessageCount: 3}},
+				nil,
+				nil,
+			)
+
+			// ISO weekdays start at 1 for Monday, the heatmap starts at 0
+			assert.Equal(t, 3, statistics.HourOfWeek[tc.day][tc.hour])
+			total := 0
+			for _, hours := range statistics.HourOfWeek {
+				for _, count := range hours {
+					total += count
+				}
+			}
+			assert.Equal(t, 3, total)
+		})
+	}
+}
+
+func TestActivityToDomain_Summary(t *testing.T) {
+	mapper := mappers.NewSqlxTelegramActivityMapper()
+	firstSeenAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	lastSeenAt := firstSeenAt.Add(48 * time.Hour)
+
+	empty := mapper.ToDomain(models.TelegramActivitySummaryModel{}, nil, nil, nil, nil)
+	assert.Nil(t, empty.FirstSeenAt)
+	assert.Nil(t, empty.LastSeenAt)
+	assert.Empty(t, empty.Daily)
+	assert.Empty(t, empty.Breakdown)
+	assert.Empty(t, empty.Gaps)
+
+	statistics := mapper.ToDomain(
+		models.TelegramActivitySummaryModel{
+			MessageCount:         2,
+			AverageMessageLength: 12.5,
+			FirstSeenAt:          sql.NullTime{Time: firstSeenAt, Valid: true},
+			LastSeenAt:           sql.NullTime{Time: lastSeenAt, Valid: true},
+		},
+		[]models.TelegramDailyActivityModel{{Day: firstSeenAt, MessageCount: 1}, {Day: lastSeenAt, MessageCount: 1}},
+		nil,
+		[]models.TelegramActivityBreakdownModel{
+			{TelegramID: -100123, MessageCount: 2, FirstSeenAt: firstSeenAt, LastSeenAt: lastSeenAt},
+		},
+		[]models.TelegramActivityGapModel{{StartedAt: firstSeenAt, EndedAt: lastSeenAt}},
+	)
+	assert.Equal(t, 2, statistics.MessageCount)
+	assert.InDelta(t, 12.5, statistics.AverageMessageLength, 0)
+	assert.Equal(t, firstSeenAt, *statistics.FirstSeenAt)
+	assert.Equal(t, lastSeenAt, *statistics.LastSeenAt)
+	assert.Len(t, statistics.Daily, 2)
+	assert.Equal(t, int64(-100123), statistics.Breakdown[0].TelegramID)
+	assert.Equal(t, lastSeenAt, statistics.Gaps[0].EndedAt)
+}
@@ -0,0 +1,27 @@
+package mappers
+
+import (
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+)
+
+type SqlxTelegramAlertMapper struct{}
+
+func NewSqlxTelegramAlertMapper() *SqlxTelegramAlertMapper {
+	return &SqlxTelegramAlertMapper{}
+}
+
+func (sm *SqlxTelegramAlertMapper) ToDomain(inputModel models.TelegramAlertModel) domain.TelegramAlert {
+	return domain.TelegramAlert{
+		ID:          inputModel.ID,
+		OwnerUserID: inputModel.OwnerUserID,
+		WatchlistID: inputModel.WatchlistID,
+		EntryID:     inputModel.EntryID,
+		EntryType:   domain.TelegramWatchlistEntryType(inputModel.EntryType),
+		EntryValue:  inputModel.EntryValue,
+		RecordID:    inputModel.RecordID,
+		IdentityID:  inputModel.IdentityID,
+		TriggeredAt: inputModel.TriggeredAt,
+		ReadAt:      inputModel.ReadAt,
+	}
+}
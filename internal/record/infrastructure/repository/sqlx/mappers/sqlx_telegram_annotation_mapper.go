@@ -0,0 +1,101 @@
+package mappers
+
+import (
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+	"github.com/google/uuid"
+)
+
+type SqlxTelegramAnnotationMapper struct{}
+
+func NewSqlxTelegramAnnotationMapper() *SqlxTelegramAnnotationMapper {
+	return &SqlxTelegramAnnotationMapper{}
+}
+
+func (sm *SqlxTelegramAnnotationMapper) TagToDomain(inputModel models.TelegramTagModel) domain.TelegramTag {
+	return domain.TelegramTag{
+		ID:         inputModel.ID,
+		TargetType: domain.TelegramAnnotationTargetType(inputModel.TargetType),
+		TargetID:   annotationTarget(inputModel.TelegramUserID, inputModel.TelegramIdentityID, inputModel.TelegramRecordID),
+		Name:       inputModel.Name,
+		AddedBy:    inputModel.AddedBy,
+		AddedAt:    inputModel.AddedAt,
+	}
+}
+
+// TagToModel sets the column of the target type to the target.
+func (sm *SqlxTelegramAnnotationMapper) TagToModel(inputEntity domain.TelegramTag) models.TelegramTagModel {
+	tagModel := models.TelegramTagModel{
+		ID:         inputEntity.ID,
+		TargetType: string(inputEntity.TargetType),
+		Name:       inputEntity.Name,
+		AddedBy:    inputEntity.AddedBy,
+		AddedAt:    inputEntity.AddedAt,
+	}
+	tagModel.TelegramUserID, tagModel.TelegramIdentityID, tagModel.TelegramRecordID = annotationTargetColumns(
+		inputEntity.TargetType,
+		inputEntity.TargetID,
+	)
+	return tagModel
+}
+
+func (sm *SqlxTelegramAnnotationMapper) TagCountToDomain(
+	inputModel models.TelegramTagCountModel,
+) domain.TelegramTagCount {
+	return domain.TelegramTagCount{
+		Name:  inputModel.Name,
+		Count: inputModel.Count,
+	}
+}
+
+func (sm *SqlxTelegramAnnotationMapper) NoteToDomain(inputModel models.TelegramNoteModel) domain.TelegramNote {
+	return domain.TelegramNote{
+		ID:         inputModel.ID,
+		TargetType: domain.TelegramAnnotationTargetType(inputModel.TargetType),
+		TargetID:   annotationTarget(inputModel.TelegramUserID, inputModel.TelegramIdentityID, inputModel.TelegramRecordID),
+		Body:       inputModel.Body,
+		AddedBy:    inputModel.AddedBy,
+		AddedAt:    inputModel.AddedAt,
+	}
+}
+
+// NoteToModel sets the column of the target type to the target.
+func (sm *SqlxTelegramAnnotationMapper) NoteToModel(inputEntity domain.TelegramNote) models.TelegramNoteModel {
+	noteModel := models.TelegramNoteModel{
+		ID:         inputEntity.ID,
+		TargetType: string(inputEntity.TargetType),
+		Body:       inputEntity.Body,
+		AddedBy:    inputEntity.AddedBy,
+		AddedAt:    inputEntity.AddedAt,
+	}
+	noteModel.TelegramUserID, noteModel.TelegramIdentityID, noteModel.TelegramRecordID = annotationTargetColumns(
+		inputEntity.TargetType,
+		inputEntity.TargetID,
+	)
+	return noteModel
+}
+
+func annotationTarget(targetIDs ...*uuid.UUID) uuid.UUID {
+	for _, targetID := range targetIDs {
+		if targetID != nil {
+			return *targetID
+		}
+	}
+	return uuid.Nil
+}
+
+func annotationTargetColumns(
+	targetType domain.TelegramAnnotationTargetType,
+	targetID uuid.UUID,
+) (*uuid.UUID, *uuid.UUID, *uuid.UUID) {
+	switch targetType {
+	case domain.TelegramAnnotationTargetTypeUser:
+		return &targetID, nil, nil
+	case domain.TelegramAnnotationTargetTypeIdentity:
+		return nil, &targetID, nil
+	case domain.TelegramAnnotationTargetTypeRecord:
+		return nil, nil, &targetID
+	default:
+		return nil, nil, nil
+	}
+}
@@ -0,0 +1,49 @@
+package mappers_test
+
+import (
+	"testing"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagToModel(t *testing.T) {
+	mapper := mappers.NewSqlxTelegramAnnotationMapper()
+	targetID := uuid.New()
+
+	for _, targetType := range []domain.TelegramAnnotationTargetType{
+		domain.TelegramAnnotationTargetTypeUser,
+		domain.TelegramAnnotationTargetTypeIdentity,
+		domain.TelegramAnnotationTargetTypeRecord,
+	} {
+		t.Run(string(targetType), func(t *testing.T) {
+			tag := domain.TelegramTag{
+				ID:         uuid.New(),
+				TargetType: targetType,
+				TargetID:   targetID,
+				Name:       "scammer",
+				AddedBy:    uuid.New(),
+				AddedAt:    time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+			}
+
+			tagModel := mapper.TagToModel(tag)
+
+			targets := map[domain.TelegramAnnotationTargetType]*uuid.UUID{
+				domain.TelegramAnnotationTargetTypeUser:     tagModel.TelegramUserID,
+				domain.TelegramAnnotationTargetTypeIdentity: tagModel.TelegramIdentityID,
+				domain.TelegramAnnotationTargetTypeRecord:   tagModel.TelegramRecordID,
+			}
+			for otherType, target := range targets {
+				if otherType == targetType {
+					assert.Equal(t, &targetID, target)
+				} else {
+					assert.Nil(t, target)
+				}
+			}
+			assert.Equal(t, tag, mapper.TagToDomain(tagModel))
+		})
+	}
+}
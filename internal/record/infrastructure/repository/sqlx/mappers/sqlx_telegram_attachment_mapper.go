@@ -0,0 +1,46 @@
+package mappers
+
+import (
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+)
+
+type SqlxTelegramAttachmentMapper struct{}
+
+func NewSqlxTelegramAttachmentMapper() *SqlxTelegramAttachmentMapper {
+	return &SqlxTelegramAttachmentMapper{}
+}
+
+func (sm *SqlxTelegramAttachmentMapper) ToDomain(
+	inputModel models.TelegramAttachmentModel,
+) domain.TelegramAttachment {
+	return domain.TelegramAttachment{
+		ID:                   inputModel.ID,
+		RecordID:             inputModel.RecordID,
+		TelegramAttachmentID: inputModel.TelegramAttachmentID,
+		FileName:             inputModel.FileName,
+		StorageKey:           inputModel.StorageKey,
+		SHA256:               inputModel.SHA256,
+		FileSize:             inputModel.FileSize,
+		MimeType:             inputModel.MimeType,
+		AddedAt:              inputModel.AddedAt,
+		AddedByUser:          inputModel.AddedByUser,
+	}
+}
+
+func (sm *SqlxTelegramAttachmentMapper) ToModel(
+	inputEntity domain.TelegramAttachment,
+) models.TelegramAttachmentModel {
+	return models.TelegramAttachmentModel{
+		ID:                   inputEntity.ID,
+		RecordID:             inputEntity.RecordID,
+		TelegramAttachmentID: inputEntity.TelegramAttachmentID,
+		FileName:             inputEntity.FileName,
+		StorageKey:           inputEntity.StorageKey,
+		SHA256:               inputEntity.SHA256,
+		FileSize:             inputEntity.FileSize,
+		MimeType:             inputEntity.MimeType,
+		AddedAt:              inputEntity.AddedAt,
+		AddedByUser:          inputEntity.AddedByUser,
+	}
+}
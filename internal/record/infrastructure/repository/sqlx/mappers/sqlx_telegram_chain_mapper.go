@@ -0,0 +1,79 @@
+package mappers
+
+import (
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+)
+
+type SqlxTelegramChainMapper struct{}
+
+func NewSqlxTelegramChainMapper() *SqlxTelegramChainMapper {
+	return &SqlxTelegramChainMapper{}
+}
+
+func (sm *SqlxTelegramChainMapper) HeadToDomain(inputModel models.TelegramChainHeadModel) domain.TelegramChainHead {
+	return domain.TelegramChainHead{
+		CollectorUserID:      inputModel.CollectorUserID,
+		TeamID:               inputModel.TenantID,
+		Sequence:             inputModel.Sequence,
+		EntryHash:            inputModel.EntryHash,
+		CheckpointedSequence: inputModel.CheckpointedSequence,
+	}
+}
+
+func (sm *SqlxTelegramChainMapper) EntryToDomain(inputModel models.TelegramChainEntryModel) domain.TelegramChainEntry {
+	return domain.TelegramChainEntry{
+		CollectorUserID: inputModel.CollectorUserID,
+		Sequence:        inputModel.Sequence,
+		SubjectType:     domain.TelegramChainSubjectType(inputModel.SubjectType),
+		SubjectID:       inputModel.SubjectID,
+		ContentHash:     inputModel.ContentHash,
+		PreviousHash:    inputModel.PreviousHash,
+		EntryHash:       inputModel.EntryHash,
+		AddedAt:         inputModel.AddedAt,
+		Superseded:      inputModel.Superseded,
+	}
+}
+
+func (sm *SqlxTelegramChainMapper) EntryToModel(inputEntity domain.TelegramChainEntry) models.TelegramChainEntryModel {
+	return models.TelegramChainEntryModel{
+		CollectorUserID: inputEntity.CollectorUserID,
+		Sequence:        inputEntity.Sequence,
+		SubjectType:     string(inputEntity.SubjectType),
+		SubjectID:       inputEntity.SubjectID,
+		ContentHash:     inputEntity.ContentHash,
+		PreviousHash:    inputEntity.PreviousHash,
+		EntryHash:       inputEntity.EntryHash,
+		AddedAt:         inputEntity.AddedAt,
+	}
+}
+
+func (sm *SqlxTelegramChainMapper) CheckpointToDomain(
+	inputModel models.TelegramChainCheckpointModel,
+) domain.TelegramChainCheckpoint {
+	return domain.TelegramChainCheckpoint{
+		ID:              inputModel.ID,
+		CollectorUserID: inputModel.CollectorUserID,
+		TeamID:          inputModel.TenantID,
+		Sequence:        inputModel.Sequence,
+		EntryHash:       inputModel.EntryHash,
+		PublicKey:       inputModel.PublicKey,
+		Signature:       inputModel.Signature,
+		SignedAt:        inputModel.SignedAt,
+	}
+}
+
+func (sm *SqlxTelegramChainMapper) CheckpointToModel(
+	inputEntity domain.TelegramChainCheckpoint,
+) models.TelegramChainCheckpointModel {
+	return models.TelegramChainCheckpointModel{
+		ID:              inputEntity.ID,
+		CollectorUserID: inputEntity.CollectorUserID,
+		TenantID:        inputEntity.TeamID,
+		Sequence:        inputEntity.Sequence,
+		EntryHash:       inputEntity.EntryHash,
+		PublicKey:       inputEntity.PublicKey,
+		Signature:       inputEntity.Signature,
+		SignedAt:        inputEntity.SignedAt,
+	}
+}
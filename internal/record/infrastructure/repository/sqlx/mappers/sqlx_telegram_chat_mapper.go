@@ -0,0 +1,62 @@
+package mappers
+
+import (
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+)
+
+type SqlxTelegramChatMapper struct{}
+
+func NewSqlxTelegramChatMapper() *SqlxTelegramChatMapper {
+	return &SqlxTelegramChatMapper{}
+}
+
+func (sm *SqlxTelegramChatMapper) ToDomain(inputModel models.TelegramChatModel) domain.TelegramChat {
+	return domain.TelegramChat{
+		ID:             inputModel.ID,
+		ChatTelegramID: inputModel.ChatTelegramID,
+		Type:           domain.TelegramChatType(inputModel.ChatType),
+		AddedAt:        inputModel.AddedAt,
+		AddedByUser:    inputModel.AddedByUser,
+	}
+}
+
+func (sm *SqlxTelegramChatMapper) ToModel(inputEntity domain.TelegramChat) models.TelegramChatModel {
+	return models.TelegramChatModel{
+		ID:             inputEntity.ID,
+		ChatTelegramID: inputEntity.ChatTelegramID,
+		ChatType:       string(inputEntity.Type),
+		AddedAt:        inputEntity.AddedAt,
+		AddedByUser:    inputEntity.AddedByUser,
+	}
+}
+
+func (sm *SqlxTelegramChatMapper) SnapshotToDomain(
+	inputModel models.TelegramChatSnapshotModel,
+) domain.TelegramChatSnapshot {
+	return domain.TelegramChatSnapshot{
+		ID:          inputModel.ID,
+		ChatID:      inputModel.ChatID,
+		Title:       inputModel.Title,
+		Username:    inputModel.Username,
+		MemberCount: inputModel.MemberCount,
+		Description: inputModel.Description,
+		AddedAt:     inputModel.AddedAt,
+		AddedByUser: inputModel.AddedByUser,
+	}
+}
+
+func (sm *SqlxTelegramChatMapper) SnapshotToModel(
+	inputEntity domain.TelegramChatSnapshot,
+) models.TelegramChatSnapshotModel {
+	return models.TelegramChatSnapshotModel{
+		ID:          inputEntity.ID,
+		ChatID:      inputEntity.ChatID,
+		Title:       inputEntity.Title,
+		Username:    inputEntity.Username,
+		MemberCount: inputEntity.MemberCount,
+		Description: inputEntity.Description,
+		AddedAt:     inputEntity.AddedAt,
+		AddedByUser: inputEntity.AddedByUser,
+	}
+}
@@ -0,0 +1,60 @@
+package mappers
+
+import (
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+)
+
+type SqlxTelegramExportMapper struct{}
+
+func NewSqlxTelegramExportMapper() *SqlxTelegramExportMapper {
+	return &SqlxTelegramExportMapper{}
+}
+
+func (sm *SqlxTelegramExportMapper) ToDomain(inputModel models.TelegramExportModel) domain.TelegramExport {
+	return domain.TelegramExport{
+		ID:          inputModel.ID,
+		RequestedBy: inputModel.RequestedBy,
+		TeamID:      inputModel.TenantID,
+		Selection:   domain.TelegramExportSelection(inputModel.Selection),
+		Status:      domain.TelegramExportStatus(inputModel.Status),
+		Progress: domain.TelegramExportProgress{
+			RecordsTotal:        inputModel.RecordsTotal,
+			RecordsExported:     inputModel.RecordsExported,
+			IdentitiesExported:  inputModel.IdentitiesExported,
+			AttachmentsExported: inputModel.AttachmentsExported,
+		},
+		StorageKey:  inputModel.StorageKey,
+		SHA256:      inputModel.SHA256,
+		FileSize:    inputModel.FileSize,
+		PublicKey:   inputModel.PublicKey,
+		Signature:   inputModel.Signature,
+		Error:       inputModel.Error,
+		CreatedAt:   inputModel.CreatedAt,
+		StartedAt:   inputModel.StartedAt,
+		CompletedAt: inputModel.CompletedAt,
+	}
+}
+
+func (sm *SqlxTelegramExportMapper) ToModel(inputEntity domain.TelegramExport) models.TelegramExportModel {
+	return models.TelegramExportModel{
+		ID:                  inputEntity.ID,
+		RequestedBy:         inputEntity.RequestedBy,
+		TenantID:            inputEntity.TeamID,
+		Selection:           models.TelegramExportSelectionModel(inputEntity.Selection),
+		Status:              string(inputEntity.Status),
+		RecordsTotal:        inputEntity.Progress.RecordsTotal,
+		RecordsExported:     inputEntity.Progress.RecordsExported,
+		IdentitiesExported:  inputEntity.Progress.IdentitiesExported,
+		AttachmentsExported: inputEntity.Progress.AttachmentsExported,
+		StorageKey:          inputEntity.StorageKey,
+		SHA256:              inputEntity.SHA256,
+		FileSize:            inputEntity.FileSize,
+		PublicKey:           inputEntity.PublicKey,
+		Signature:           inputEntity.Signature,
+		Error:               inputEntity.Error,
+		CreatedAt:           inputEntity.CreatedAt,
+		StartedAt:           inputEntity.StartedAt,
+		CompletedAt:         inputEntity.CompletedAt,
+	}
+}
@@ -38,3 +38,28 @@ func (sm *SqlxTelegramIdentityMapper) ToModel(inputEntity domain.TelegramIdentit
 		AddedByUser: inputEntity.AddedByUser,
 	}
 }
+
+// EvidenceToDomain groups the evidence ordered by cluster and user into correlation clusters.
+func (sm *SqlxTelegramIdentityMapper) EvidenceToDomain(
+	inputModels []models.TelegramCorrelationEvidenceModel,
+) []domain.TelegramCorrelationCluster {
+	clusters := make([]domain.TelegramCorrelationCluster, 0)
+	for _, evidence := range inputModels {
+		correlationType := domain.TelegramCorrelationType(evidence.CorrelationType)
+		if len(clusters) == 0 || clusters[len(clusters)-1].Type != correlationType ||
+			clusters[len(clusters)-1].Value != evidence.Value {
+			clusters = append(clusters, domain.TelegramCorrelationCluster{Type: correlationType, Value: evidence.Value})
+		}
+		cluster := &clusters[len(clusters)-1]
+		if len(cluster.Members) == 0 || cluster.Members[len(cluster.Members)-1].UserTelegramID != evidence.UserTelegramID {
+			cluster.Members = append(cluster.Members, domain.TelegramCorrelationMember{
+				UserTelegramID: evidence.UserTelegramID,
+				FirstSeenAt:    evidence.AddedAt,
+			})
+		}
+		member := &cluster.Members[len(cluster.Members)-1]
+		member.IdentityIDs = append(member.IdentityIDs, evidence.IdentityID)
+		member.LastSeenAt = evidence.AddedAt
+	}
+	return clusters
+}
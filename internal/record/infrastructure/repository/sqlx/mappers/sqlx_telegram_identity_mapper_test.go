@@ -0,0 +1,69 @@
+package mappers_test
+
+import (
+	"testing"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvidenceToDomain(t *testing.T) {
+	mapper := mappers.NewSqlxTelegramIdentityMapper()
+	identityIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+	firstSeenAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	lastSeenAt := firstSeenAt.Add(24 * time.Hour)
+
+	evidence := func(
+		correlationType, value string,
+		userTelegramID uint64,
+		identityID uuid.UUID,
+		addedAt time.Time,
+	) models.TelegramCorrelationEvidenceModel {
+		return models.TelegramCorrelationEvidenceModel{
+			CorrelationType: correlationType,
+			Value:           value,
+			UserTelegramID:  userTelegramID,
+			IdentityID:      identityID,
+			AddedAt:         addedAt,
+		}
+	}
+
+	clusters := mapper.EvidenceToDomain([]models.TelegramCorrelationEvidenceModel{
+		evidence("phone", "+15551234567", 1, identityIDs[0], firstSeenAt),
+		evidence("phone", "+15551234567", 1, identityIDs[1], lastSeenAt),
+		evidence("phone", "+15551234567", 2, identityIDs[2], firstSeenAt),
+		evidence("username", "john_doe", 1, identityIDs[0], firstSeenAt),
+		evidence("username", "john_doe", 3, identityIDs[3], lastSeenAt),
+		evidence("bio", "john_doe", 3, identityIDs[4], lastSeenAt),
+	})
+
+	assert.Equal(t, []domain.TelegramCorrelationCluster{
+		{
+			Type:  domain.TelegramCorrelationTypePhone,
+			Value: "+15551234567",
+			Members: []domain.TelegramCorrelationMember{
+				{UserTelegramID: 1, IdentityIDs: identityIDs[:2], FirstSeenAt: firstSeenAt, LastSeenAt: lastSeenAt},
+				{UserTelegramID: 2, IdentityIDs: identityIDs[2:3], FirstSeenAt: firstSeenAt, LastSeenAt: firstSeenAt},
+			},
+		},
+		{
+			Type:  domain.TelegramCorrelationTypeUsername,
+			Value: "john_doe",
+			Members: []domain.TelegramCorrelationMember{
+				{UserTelegramID: 1, IdentityIDs: identityIDs[:1], FirstSeenAt: firstSeenAt, LastSeenAt: firstSeenAt},
+				{UserTelegramID: 3, IdentityIDs: identityIDs[3:4], FirstSeenAt: lastSeenAt, LastSeenAt: lastSeenAt},
+			},
+		},
+		{
+			Type:  domain.TelegramCorrelationTypeBio,
+			Value: "john_doe",
+			Members: []domain.TelegramCorrelationMember{
+				{UserTelegramID: 3, IdentityIDs: identityIDs[4:5], FirstSeenAt: lastSeenAt, LastSeenAt: lastSeenAt},
+			},
+		},
+	}, clusters)
+}
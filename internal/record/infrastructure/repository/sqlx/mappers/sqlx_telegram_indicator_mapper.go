@@ -0,0 +1,50 @@
+package mappers
+
+import (
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+)
+
+type SqlxTelegramIndicatorMapper struct{}
+
+func NewSqlxTelegramIndicatorMapper() *SqlxTelegramIndicatorMapper {
+	return &SqlxTelegramIndicatorMapper{}
+}
+
+func (sm *SqlxTelegramIndicatorMapper) ToDomain(
+	inputModel models.TelegramIndicatorModel,
+) domain.TelegramIndicator {
+	return domain.TelegramIndicator{
+		ID:          inputModel.ID,
+		RecordID:    inputModel.RecordID,
+		Type:        domain.TelegramIndicatorType(inputModel.IndicatorType),
+		Value:       inputModel.Value,
+		AddedAt:     inputModel.AddedAt,
+		AddedByUser: inputModel.AddedByUser,
+	}
+}
+
+func (sm *SqlxTelegramIndicatorMapper) ToModel(
+	inputEntity domain.TelegramIndicator,
+) models.TelegramIndicatorModel {
+	return models.TelegramIndicatorModel{
+		ID:            inputEntity.ID,
+		RecordID:      inputEntity.RecordID,
+		IndicatorType: string(inputEntity.Type),
+		Value:         inputEntity.Value,
+		AddedAt:       inputEntity.AddedAt,
+		AddedByUser:   inputEntity.AddedByUser,
+	}
+}
+
+func (sm *SqlxTelegramIndicatorMapper) SummaryToDomain(
+	inputModel models.TelegramIndicatorSummaryModel,
+) domain.TelegramIndicatorSummary {
+	return domain.TelegramIndicatorSummary{
+		Type:        domain.TelegramIndicatorType(inputModel.IndicatorType),
+		Value:       inputModel.Value,
+		RecordCount: inputModel.RecordCount,
+		FirstSeenAt: inputModel.FirstSeenAt,
+		LastSeenAt:  inputModel.LastSeenAt,
+	}
+}
@@ -0,0 +1,37 @@
+package mappers
+
+import (
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+)
+
+type SqlxTelegramInteractionMapper struct{}
+
+func NewSqlxTelegramInteractionMapper() *SqlxTelegramInteractionMapper {
+	return &SqlxTelegramInteractionMapper{}
+}
+
+func (sm *SqlxTelegramInteractionMapper) ToDomain(
+	inputModel models.TelegramInteractionModel,
+) domain.TelegramInteraction {
+	return domain.TelegramInteraction{
+		FromUserTelegramID: inputModel.FromUserTelegramID,
+		ToUserTelegramID:   inputModel.ToUserTelegramID,
+		Type:               domain.TelegramInteractionType(inputModel.InteractionType),
+		Weight:             inputModel.Weight,
+		FirstInteractionAt: inputModel.FirstInteractionAt,
+		LastInteractionAt:  inputModel.LastInteractionAt,
+	}
+}
+
+func (sm *SqlxTelegramInteractionMapper) NodeToDomain(
+	inputModel models.TelegramInteractionGraphNodeModel,
+) domain.TelegramInteractionGraphNode {
+	return domain.TelegramInteractionGraphNode{
+		UserTelegramID: inputModel.UserTelegramID,
+		Username:       inputModel.Username.String,
+		FirstName:      inputModel.FirstName.String,
+		LastName:       inputModel.LastName.String,
+		Depth:          inputModel.Depth,
+	}
+}
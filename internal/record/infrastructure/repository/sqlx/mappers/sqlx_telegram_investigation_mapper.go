@@ -0,0 +1,110 @@
+package mappers
+
+import (
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+	"github.com/google/uuid"
+)
+
+type SqlxTelegramInvestigationMapper struct{}
+
+func NewSqlxTelegramInvestigationMapper() *SqlxTelegramInvestigationMapper {
+	return &SqlxTelegramInvestigationMapper{}
+}
+
+func (sm *SqlxTelegramInvestigationMapper) ToDomain(
+	inputModel models.TelegramInvestigationModel,
+) domain.TelegramInvestigation {
+	return domain.TelegramInvestigation{
+		ID:          inputModel.ID,
+		Name:        inputModel.Name,
+		Description: inputModel.Description,
+		CreatedBy:   inputModel.CreatedBy,
+		CreatedAt:   inputModel.CreatedAt,
+	}
+}
+
+func (sm *SqlxTelegramInvestigationMapper) ToModel(
+	inputEntity domain.TelegramInvestigation,
+) models.TelegramInvestigationModel {
+	return models.TelegramInvestigationModel{
+		ID:          inputEntity.ID,
+		Name:        inputEntity.Name,
+		Description: inputEntity.Description,
+		CreatedBy:   inputEntity.CreatedBy,
+		CreatedAt:   inputEntity.CreatedAt,
+	}
+}
+
+func (sm *SqlxTelegramInvestigationMapper) MemberToDomain(
+	inputModel models.TelegramInvestigationMemberModel,
+) domain.TelegramInvestigationMember {
+	return domain.TelegramInvestigationMember{
+		ID:              inputModel.ID,
+		InvestigationID: inputModel.InvestigationID,
+		UserID:          inputModel.UserID,
+		Role:            domain.TelegramInvestigationRole(inputModel.Role),
+		AddedBy:         inputModel.AddedBy,
+		AddedAt:         inputModel.AddedAt,
+	}
+}
+
+func (sm *SqlxTelegramInvestigationMapper) MemberToModel(
+	inputEntity domain.TelegramInvestigationMember,
+) models.TelegramInvestigationMemberModel {
+	return models.TelegramInvestigationMemberModel{
+		ID:              inputEntity.ID,
+		InvestigationID: inputEntity.InvestigationID,
+		UserID:          inputEntity.UserID,
+		Role:            string(inputEntity.Role),
+		AddedBy:         inputEntity.AddedBy,
+		AddedAt:         inputEntity.AddedAt,
+	}
+}
+
+func (sm *SqlxTelegramInvestigationMapper) ItemToDomain(
+	inputModel models.TelegramInvestigationItemModel,
+) domain.TelegramInvestigationItem {
+	item := domain.TelegramInvestigationItem{
+		ID:              inputModel.ID,
+		InvestigationID: inputModel.InvestigationID,
+		Type:            domain.TelegramInvestigationItemType(inputModel.ItemType),
+		Note:            inputModel.Note,
+		AddedBy:         inputModel.AddedBy,
+		AddedAt:         inputModel.AddedAt,
+	}
+	for _, targetID := range []*uuid.UUID{
+		inputModel.TelegramUserID,
+		inputModel.TelegramChatID,
+		inputModel.TelegramRecordID,
+	} {
+		if targetID != nil {
+			item.TargetID = *targetID
+		}
+	}
+	return item
+}
+
+// ItemToModel sets the column of the item type to the target.
+func (sm *SqlxTelegramInvestigationMapper) ItemToModel(
+	inputEntity domain.TelegramInvestigationItem,
+) models.TelegramInvestigationItemModel {
+	itemModel := models.TelegramInvestigationItemModel{
+		ID:              inputEntity.ID,
+		InvestigationID: inputEntity.InvestigationID,
+		ItemType:        string(inputEntity.Type),
+		Note:            inputEntity.Note,
+		AddedBy:         inputEntity.AddedBy,
+		AddedAt:         inputEntity.AddedAt,
+	}
+	targetID := inputEntity.TargetID
+	switch inputEntity.Type {
+	case domain.TelegramInvestigationItemTypeUser:
+		itemModel.TelegramUserID = &targetID
+	case domain.TelegramInvestigationItemTypeChat:
+		itemModel.TelegramChatID = &targetID
+	case domain.TelegramInvestigationItemTypeRecord:
+		itemModel.TelegramRecordID = &targetID
+	}
+	return itemModel
+}
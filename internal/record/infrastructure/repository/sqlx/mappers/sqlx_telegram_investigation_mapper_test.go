@@ -0,0 +1,50 @@
+package mappers_test
+
+import (
+	"testing"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvestigationItemToModel(t *testing.T) {
+	mapper := mappers.NewSqlxTelegramInvestigationMapper()
+	targetID := uuid.New()
+
+	for _, itemType := range []domain.TelegramInvestigationItemType{
+		domain.TelegramInvestigationItemTypeUser,
+		domain.TelegramInvestigationItemTypeChat,
+		domain.TelegramInvestigationItemTypeRecord,
+	} {
+		t.Run(string(itemType), func(t *testing.T) {
+			item := domain.TelegramInvestigationItem{
+				ID:              uuid.New(),
+				InvestigationID: uuid.New(),
+				Type:            itemType,
+				TargetID:        targetID,
+				Note:            "Posts the wallet address",
+				AddedBy:         uuid.New(),
+				AddedAt:         time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+			}
+
+			itemModel := mapper.ItemToModel(item)
+
+			targets := map[domain.TelegramInvestigationItemType]*uuid.UUID{
+				domain.TelegramInvestigationItemTypeUser:   itemModel.TelegramUserID,
+				domain.TelegramInvestigationItemTypeChat:   itemModel.TelegramChatID,
+				domain.TelegramInvestigationItemTypeRecord: itemModel.TelegramRecordID,
+			}
+			for targetType, target := range targets {
+				if targetType == itemType {
+					assert.Equal(t, &targetID, target)
+				} else {
+					assert.Nil(t, target)
+				}
+			}
+			assert.Equal(t, item, mapper.ItemToDomain(itemModel))
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package mappers
+
+import (
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+)
+
+type SqlxTelegramObservationMapper struct{}
+
+func NewSqlxTelegramObservationMapper() *SqlxTelegramObservationMapper {
+	return &SqlxTelegramObservationMapper{}
+}
+
+func (sm *SqlxTelegramObservationMapper) ToDomain(
+	inputModel models.TelegramObservationModel,
+) domain.TelegramObservation {
+	return domain.TelegramObservation{
+		ID:              inputModel.ID,
+		TargetType:      domain.TelegramObservationTargetType(inputModel.TargetType),
+		TargetID:        annotationTarget(inputModel.TelegramUserID, inputModel.TelegramRecordID),
+		CollectorUserID: inputModel.CollectorUserID,
+		Visibility:      domain.TelegramVisibility(inputModel.Visibility),
+		ObservedAt:      inputModel.ObservedAt,
+		TeamID:          inputModel.TenantID,
+	}
+}
+
+// ToModel sets the column of the target type to the target.
+func (sm *SqlxTelegramObservationMapper) ToModel(
+	inputEntity domain.TelegramObservation,
+) models.TelegramObservationModel {
+	observationModel := models.TelegramObservationModel{
+		ID:              inputEntity.ID,
+		TargetType:      string(inputEntity.TargetType),
+		CollectorUserID: inputEntity.CollectorUserID,
+		Visibility:      string(inputEntity.Visibility),
+		ObservedAt:      inputEntity.ObservedAt,
+	}
+	targetID := inputEntity.TargetID
+	switch inputEntity.TargetType {
+	case domain.TelegramObservationTargetTypeUser:
+		observationModel.TelegramUserID = &targetID
+	case domain.TelegramObservationTargetTypeRecord:
+		observationModel.TelegramRecordID = &targetID
+	}
+	return observationModel
+}
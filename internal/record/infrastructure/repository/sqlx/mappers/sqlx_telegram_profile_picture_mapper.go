@@ -0,0 +1,44 @@
+package mappers
+
+import (
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+)
+
+type SqlxTelegramProfilePictureMapper struct{}
+
+func NewSqlxTelegramProfilePictureMapper() *SqlxTelegramProfilePictureMapper {
+	return &SqlxTelegramProfilePictureMapper{}
+}
+
+func (sm *SqlxTelegramProfilePictureMapper) ToDomain(
+	inputModel models.TelegramProfilePictureModel,
+) domain.TelegramProfilePicture {
+	return domain.TelegramProfilePicture{
+		ID:             inputModel.ID,
+		UserTelegramID: inputModel.UserTelegramID,
+		StorageKey:     inputModel.StorageKey,
+		SHA256:         inputModel.SHA256,
+		FileSize:       inputModel.FileSize,
+		PostedAt:       inputModel.PostedAt,
+		MimeType:       inputModel.MimeType,
+		AddedAt:        inputModel.AddedAt,
+		AddedByUser:    inputModel.AddedByUser,
+	}
+}
+
+func (sm *SqlxTelegramProfilePictureMapper) ToModel(
+	inputEntity domain.TelegramProfilePicture,
+) models.TelegramProfilePictureModel {
+	return models.TelegramProfilePictureModel{
+		ID:             inputEntity.ID,
+		UserTelegramID: inputEntity.UserTelegramID,
+		StorageKey:     inputEntity.StorageKey,
+		SHA256:         inputEntity.SHA256,
+		FileSize:       inputEntity.FileSize,
+		PostedAt:       inputEntity.PostedAt,
+		MimeType:       inputEntity.MimeType,
+		AddedAt:        inputEntity.AddedAt,
+		AddedByUser:    inputEntity.AddedByUser,
+	}
+}
@@ -13,26 +13,129 @@ func NewSqlxTelegramRecordMapper() *SqlxTelegramRecordMapper {
 
 func (sm *SqlxTelegramRecordMapper) ToDomain(inputModel models.SQLXTelegramRecordModel) domain.TelegramRecord {
 	return domain.TelegramRecord{
-		ID:                 inputModel.ID,
-		MessageTelegramID:  inputModel.MessageTelegramID,
-		FromTelegramUserID: inputModel.FromTelegramUserID,
-		InTelegramChatID:   inputModel.InTelegramChatID,
-		MessageText:        inputModel.MessageText,
-		PostedAt:           inputModel.PostedAt,
-		AddedAt:            inputModel.AddedAt,
-		AddedByUser:        inputModel.AddedByUser,
+		ID:                       inputModel.ID,
+		MessageTelegramID:        inputModel.MessageTelegramID,
+		FromTelegramUserID:       inputModel.FromTelegramUserID,
+		InTelegramChatID:         inputModel.InTelegramChatID,
+		MessageText:              inputModel.MessageText,
+		Entities:                 entitiesToDomain(inputModel.Entities),
+		Caption:                  inputModel.Caption,
+		CaptionEntities:          entitiesToDomain(inputModel.CaptionEntities),
+		PostedAt:                 inputModel.PostedAt,
+		EditedAt:                 inputModel.EditedAt,
+		DeletedAt:                inputModel.DeletedAt,
+		ReplyToMessageTelegramID: inputModel.ReplyToMessageTelegramID,
+		ThreadTelegramID:         inputModel.ThreadTelegramID,
+		ForwardOrigin:            forwardOriginToDomain(inputModel),
+		AddedAt:                  inputModel.AddedAt,
+		AddedByUser:              inputModel.AddedByUser,
 	}
 }
 
 func (sm *SqlxTelegramRecordMapper) ToModel(inputEntity domain.TelegramRecord) models.SQLXTelegramRecordModel {
-	return models.SQLXTelegramRecordModel{
-		ID:                 inputEntity.ID,
-		MessageTelegramID:  inputEntity.MessageTelegramID,
-		FromTelegramUserID: inputEntity.FromTelegramUserID,
-		InTelegramChatID:   inputEntity.InTelegramChatID,
-		MessageText:        inputEntity.MessageText,
-		PostedAt:           inputEntity.PostedAt,
-		AddedAt:            inputEntity.AddedAt,
-		AddedByUser:        inputEntity.AddedByUser,
+	recordModel := models.SQLXTelegramRecordModel{
+		ID:                       inputEntity.ID,
+		MessageTelegramID:        inputEntity.MessageTelegramID,
+		FromTelegramUserID:       inputEntity.FromTelegramUserID,
+		InTelegramChatID:         inputEntity.InTelegramChatID,
+		MessageText:              inputEntity.MessageText,
+		Entities:                 entitiesToModel(inputEntity.Entities),
+		Caption:                  inputEntity.Caption,
+		CaptionEntities:          entitiesToModel(inputEntity.CaptionEntities),
+		PostedAt:                 inputEntity.PostedAt,
+		EditedAt:                 inputEntity.EditedAt,
+		DeletedAt:                inputEntity.DeletedAt,
+		ReplyToMessageTelegramID: inputEntity.ReplyToMessageTelegramID,
+		ThreadTelegramID:         inputEntity.ThreadTelegramID,
+		AddedAt:                  inputEntity.AddedAt,
+		AddedByUser:              inputEntity.AddedByUser,
 	}
+	if origin := inputEntity.ForwardOrigin; origin != nil {
+		recordModel.ForwardFromUserTelegramID = origin.FromUserTelegramID
+		recordModel.ForwardFromChatTelegramID = origin.FromChatTelegramID
+		recordModel.ForwardFromMessageTelegramID = origin.MessageTelegramID
+		recordModel.ForwardPostedAt = origin.PostedAt
+	}
+	return recordModel
+}
+
+// forwardOriginToDomain leaves the origin nil for records that haven't been forwarded.
+func forwardOriginToDomain(inputModel models.SQLXTelegramRecordModel) *domain.TelegramForwardOrigin {
+	if inputModel.ForwardFromUserTelegramID == nil && inputModel.ForwardFromChatTelegramID == nil &&
+		inputModel.ForwardFromMessageTelegramID == nil && inputModel.ForwardPostedAt == nil {
+		return nil
+	}
+	return &domain.TelegramForwardOrigin{
+		FromUserTelegramID: inputModel.ForwardFromUserTelegramID,
+		FromChatTelegramID: inputModel.ForwardFromChatTelegramID,
+		MessageTelegramID:  inputModel.ForwardFromMessageTelegramID,
+		PostedAt:           inputModel.ForwardPostedAt,
+	}
+}
+
+func (sm *SqlxTelegramRecordMapper) RevisionToDomain(
+	inputModel models.SQLXTelegramRecordRevisionModel,
+) domain.TelegramRecordRevision {
+	return domain.TelegramRecordRevision{
+		ID:              inputModel.ID,
+		RecordID:        inputModel.RecordID,
+		MessageText:     inputModel.MessageText,
+		Entities:        entitiesToDomain(inputModel.Entities),
+		Caption:         inputModel.Caption,
+		CaptionEntities: entitiesToDomain(inputModel.CaptionEntities),
+		EditedAt:        inputModel.EditedAt,
+		AddedAt:         inputModel.AddedAt,
+		AddedByUser:     inputModel.AddedByUser,
+	}
+}
+
+func (sm *SqlxTelegramRecordMapper) RevisionToModel(
+	inputEntity domain.TelegramRecordRevision,
+) models.SQLXTelegramRecordRevisionModel {
+	return models.SQLXTelegramRecordRevisionModel{
+		ID:              inputEntity.ID,
+		RecordID:        inputEntity.RecordID,
+		MessageText:     inputEntity.MessageText,
+		Entities:        entitiesToModel(inputEntity.Entities),
+		Caption:         inputEntity.Caption,
+		CaptionEntities: entitiesToModel(inputEntity.CaptionEntities),
+		EditedAt:        inputEntity.EditedAt,
+		AddedAt:         inputEntity.AddedAt,
+		AddedByUser:     inputEntity.AddedByUser,
+	}
+}
+
+func entitiesToDomain(inputModels models.SQLXTelegramMessageEntitiesModel) []domain.TelegramMessageEntity {
+	if len(inputModels) == 0 {
+		return nil
+	}
+	entities := make([]domain.TelegramMessageEntity, len(inputModels))
+	for i, inputModel := range inputModels {
+		entities[i] = domain.TelegramMessageEntity{
+			Type:           domain.TelegramMessageEntityType(inputModel.Type),
+			Offset:         inputModel.Offset,
+			Length:         inputModel.Length,
+			URL:            inputModel.URL,
+			UserTelegramID: inputModel.UserTelegramID,
+			Language:       inputModel.Language,
+			CustomEmojiID:  inputModel.CustomEmojiID,
+		}
+	}
+	return entities
+}
+
+func entitiesToModel(inputEntities []domain.TelegramMessageEntity) models.SQLXTelegramMessageEntitiesModel {
+	entityModels := make(models.SQLXTelegramMessageEntitiesModel, len(inputEntities))
+	for i, inputEntity := range inputEntities {
+		entityModels[i] = models.SQLXTelegramMessageEntityModel{
+			Type:           string(inputEntity.Type),
+			Offset:         inputEntity.Offset,
+			Length:         inputEntity.Length,
+			URL:            inputEntity.URL,
+			UserTelegramID: inputEntity.UserTelegramID,
+			Language:       inputEntity.Language,
+			CustomEmojiID:  inputEntity.CustomEmojiID,
+		}
+	}
+	return entityModels
 }
@@ -0,0 +1,46 @@
+package mappers_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/google/uuid"
+)
+
+func TestSqlxTelegramRecordMapper_RoundTrip(t *testing.T) {
+	userID := uint64(28736582)
+	chatID := int64(-1001234567890)
+	messageID := uint64(42)
+	replyTo := uint64(1336)
+	thread := uint64(1300)
+	postedAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	cases := map[string]*domain.TelegramForwardOrigin{
+		"not forwarded":    nil,
+		"from a user":      {FromUserTelegramID: &userID, PostedAt: &postedAt},
+		"from a channel":   {FromChatTelegramID: &chatID, MessageTelegramID: &messageID, PostedAt: &postedAt},
+		"from hidden user": {PostedAt: &postedAt},
+	}
+	mapper := mappers.NewSqlxTelegramRecordMapper()
+	for name, origin := range cases {
+		record := domain.TelegramRecord{
+			ID:                       uuid.New(),
+			MessageTelegramID:        1337,
+			FromTelegramUserID:       uuid.New(),
+			InTelegramChatID:         1234567890,
+			MessageText:              "Hello world!",
+			PostedAt:                 postedAt,
+			ReplyToMessageTelegramID: &replyTo,
+			ThreadTelegramID:         &thread,
+			ForwardOrigin:            origin,
+			AddedAt:                  postedAt.Add(time.Hour),
+			AddedByUser:              uuid.New(),
+		}
+		if mapped := mapper.ToDomain(mapper.ToModel(record)); !reflect.DeepEqual(mapped, record) {
+			t.Errorf("%s: expected %+v, got %+v", name, record, mapped)
+		}
+	}
+}
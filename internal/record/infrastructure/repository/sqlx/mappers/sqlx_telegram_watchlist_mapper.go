@@ -0,0 +1,54 @@
+package mappers
+
+import (
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+)
+
+type SqlxTelegramWatchlistMapper struct{}
+
+func NewSqlxTelegramWatchlistMapper() *SqlxTelegramWatchlistMapper {
+	return &SqlxTelegramWatchlistMapper{}
+}
+
+func (sm *SqlxTelegramWatchlistMapper) ToDomain(inputModel models.TelegramWatchlistModel) domain.TelegramWatchlist {
+	return domain.TelegramWatchlist{
+		ID:          inputModel.ID,
+		OwnerUserID: inputModel.OwnerUserID,
+		Name:        inputModel.Name,
+		CreatedAt:   inputModel.CreatedAt,
+	}
+}
+
+func (sm *SqlxTelegramWatchlistMapper) ToModel(inputEntity domain.TelegramWatchlist) models.TelegramWatchlistModel {
+	return models.TelegramWatchlistModel{
+		ID:          inputEntity.ID,
+		OwnerUserID: inputEntity.OwnerUserID,
+		Name:        inputEntity.Name,
+		CreatedAt:   inputEntity.CreatedAt,
+	}
+}
+
+func (sm *SqlxTelegramWatchlistMapper) EntryToDomain(
+	inputModel models.TelegramWatchlistEntryModel,
+) domain.TelegramWatchlistEntry {
+	return domain.TelegramWatchlistEntry{
+		ID:          inputModel.ID,
+		WatchlistID: inputModel.WatchlistID,
+		Type:        domain.TelegramWatchlistEntryType(inputModel.EntryType),
+		Value:       inputModel.Value,
+		AddedAt:     inputModel.AddedAt,
+	}
+}
+
+func (sm *SqlxTelegramWatchlistMapper) EntryToModel(
+	inputEntity domain.TelegramWatchlistEntry,
+) models.TelegramWatchlistEntryModel {
+	return models.TelegramWatchlistEntryModel{
+		ID:          inputEntity.ID,
+		WatchlistID: inputEntity.WatchlistID,
+		EntryType:   string(inputEntity.Type),
+		Value:       inputEntity.Value,
+		AddedAt:     inputEntity.AddedAt,
+	}
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// TelegramActivitySummaryModel is the aggregate of all records of a user or a chat.
+type TelegramActivitySummaryModel struct {
+	MessageCount         int          `db:"message_count"`
+	AverageMessageLength float64      `db:"average_message_length"`
+	FirstSeenAt          sql.NullTime `db:"first_seen_at"`
+	LastSeenAt           sql.NullTime `db:"last_seen_at"`
+}
+
+type TelegramDailyActivityModel struct {
+	Day          time.Time `db:"day"`
+	MessageCount int       `db:"message_count"`
+}
+
+// TelegramHourOfWeekActivityModel is the amount of messages in an hour of an ISO weekday, 1 being Monday.
+type TelegramHourOfWeekActivityModel struct {
+	Weekday      int `db:"weekday"`
+	Hour         int `db:"hour"`
+	MessageCount int `db:"message_count"`
+}
+
+type TelegramActivityBreakdownModel struct {
+	TelegramID   int64     `db:"telegram_id"`
+	MessageCount int       `db:"message_count"`
+	FirstSeenAt  time.Time `db:"first_seen_at"`
+	LastSeenAt   time.Time `db:"last_seen_at"`
+}
+
+type TelegramActivityGapModel struct {
+	StartedAt time.Time `db:"started_at"`
+	EndedAt   time.Time `db:"ended_at"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TelegramAlertModel is a row of the telegram_alerts table joined with the entry it has been raised for.
+type TelegramAlertModel struct {
+	ID          uuid.UUID  `db:"id"`
+	OwnerUserID uuid.UUID  `db:"owner_user_id"`
+	WatchlistID uuid.UUID  `db:"watchlist_id"`
+	EntryID     uuid.UUID  `db:"entry_id"`
+	EntryType   string     `db:"entry_type"`
+	EntryValue  string     `db:"entry_value"`
+	RecordID    *uuid.UUID `db:"record_id"`
+	IdentityID  *uuid.UUID `db:"identity_id"`
+	TriggeredAt time.Time  `db:"triggered_at"`
+	ReadAt      *time.Time `db:"read_at"`
+}
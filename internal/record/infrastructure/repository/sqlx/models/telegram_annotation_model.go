@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TelegramTagModel represents the sqlx model for the telegram_tags table, only the column of the target type is set.
+type TelegramTagModel struct {
+	ID                 uuid.UUID  `db:"id"`
+	TargetType         string     `db:"target_type"`
+	TelegramUserID     *uuid.UUID `db:"telegram_user_id"`
+	TelegramIdentityID *uuid.UUID `db:"telegram_identity_id"`
+	TelegramRecordID   *uuid.UUID `db:"telegram_record_id"`
+	Name               string     `db:"name"`
+	AddedBy            uuid.UUID  `db:"added_by"`
+	AddedAt            time.Time  `db:"added_at"`
+}
+
+// TelegramTagCountModel represents a tag of the catalogue along with the amount of targets holding it.
+type TelegramTagCountModel struct {
+	Name  string `db:"name"`
+	Count int64  `db:"count"`
+}
+
+// TelegramNoteModel represents the sqlx model for the telegram_notes table, only the column of the target type is set.
+type TelegramNoteModel struct {
+	ID                 uuid.UUID  `db:"id"`
+	TargetType         string     `db:"target_type"`
+	TelegramUserID     *uuid.UUID `db:"telegram_user_id"`
+	TelegramIdentityID *uuid.UUID `db:"telegram_identity_id"`
+	TelegramRecordID   *uuid.UUID `db:"telegram_record_id"`
+	Body               string     `db:"body"`
+	AddedBy            uuid.UUID  `db:"added_by"`
+	AddedAt            time.Time  `db:"added_at"`
+}
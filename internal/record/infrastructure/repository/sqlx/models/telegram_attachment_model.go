@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TelegramAttachmentModel represents the sqlx model for the telegram_attachments table.
+type TelegramAttachmentModel struct {
+	ID                   uuid.UUID `db:"id"`
+	RecordID             uuid.UUID `db:"record_id"`
+	TelegramAttachmentID uint64    `db:"telegram_attachment_id"`
+	FileName             string    `db:"file_name"`
+	StorageKey           string    `db:"storage_key"`
+	SHA256               string    `db:"sha256"`
+	FileSize             uint64    `db:"file_size"`
+	MimeType             string    `db:"mime_type"`
+	AddedAt              time.Time `db:"added_at"`
+	AddedByUser          uuid.UUID `db:"added_by_user"`
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TelegramChainHeadModel represents the sqlx model for the telegram_chain_heads table,
+// CheckpointedSequence is the sequence of the latest checkpoint of the chain.
+type TelegramChainHeadModel struct {
+	CollectorUserID      uuid.UUID     `db:"collector_user_id"`
+	TenantID             uuid.NullUUID `db:"tenant_id"`
+	Sequence             int64         `db:"sequence"`
+	EntryHash            string        `db:"entry_hash"`
+	CheckpointedSequence int64         `db:"checkpointed_sequence"`
+}
+
+// TelegramChainEntryModel represents the sqlx model for the telegram_chain_entries table,
+// Superseded is computed when the entries are read back.
+type TelegramChainEntryModel struct {
+	CollectorUserID uuid.UUID `db:"collector_user_id"`
+	Sequence        int64     `db:"sequence"`
+	SubjectType     string    `db:"subject_type"`
+	SubjectID       uuid.UUID `db:"subject_id"`
+	ContentHash     string    `db:"content_hash"`
+	PreviousHash    string    `db:"previous_hash"`
+	EntryHash       string    `db:"entry_hash"`
+	AddedAt         time.Time `db:"added_at"`
+	Superseded      bool      `db:"superseded"`
+}
+
+// TelegramChainCheckpointModel represents the sqlx model for the telegram_chain_checkpoints table.
+type TelegramChainCheckpointModel struct {
+	ID              uuid.UUID     `db:"id"`
+	CollectorUserID uuid.UUID     `db:"collector_user_id"`
+	TenantID        uuid.NullUUID `db:"tenant_id"`
+	Sequence        int64         `db:"sequence"`
+	EntryHash       string        `db:"entry_hash"`
+	PublicKey       string        `db:"public_key"`
+	Signature       string        `db:"signature"`
+	SignedAt        time.Time     `db:"signed_at"`
+}
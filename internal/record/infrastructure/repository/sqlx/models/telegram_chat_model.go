@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TelegramChatModel represents the sqlx model for the telegram_chats table.
+type TelegramChatModel struct {
+	ID             uuid.UUID `db:"id"`
+	ChatTelegramID int64     `db:"chat_telegram_id"`
+	ChatType       string    `db:"chat_type"`
+	AddedAt        time.Time `db:"added_at"`
+	AddedByUser    uuid.UUID `db:"added_by_user"`
+}
+
+// TelegramChatSnapshotModel represents the sqlx model for the telegram_chat_snapshots table.
+type TelegramChatSnapshotModel struct {
+	ID          uuid.UUID `db:"id"`
+	ChatID      uuid.UUID `db:"chat_id"`
+	Title       string    `db:"title"`
+	Username    string    `db:"username"`
+	MemberCount uint64    `db:"member_count"`
+	Description string    `db:"description"`
+	AddedAt     time.Time `db:"added_at"`
+	AddedByUser uuid.UUID `db:"added_by_user"`
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TelegramExportModel represents the sqlx model for the telegram_exports table.
+type TelegramExportModel struct {
+	ID                  uuid.UUID                    `db:"id"`
+	RequestedBy         uuid.UUID                    `db:"requested_by"`
+	TenantID            uuid.NullUUID                `db:"tenant_id"`
+	Selection           TelegramExportSelectionModel `db:"selection"`
+	Status              string                       `db:"status"`
+	RecordsTotal        int64                        `db:"records_total"`
+	RecordsExported     int64                        `db:"records_exported"`
+	IdentitiesExported  int64                        `db:"identities_exported"`
+	AttachmentsExported int64                        `db:"attachments_exported"`
+	StorageKey          string                       `db:"storage_key"`
+	SHA256              string                       `db:"sha256"`
+	FileSize            int64                        `db:"file_size"`
+	PublicKey           string                       `db:"public_key"`
+	Signature           string                       `db:"signature"`
+	Error               string                       `db:"error"`
+	CreatedAt           time.Time                    `db:"created_at"`
+	StartedAt           *time.Time                   `db:"started_at"`
+	CompletedAt         *time.Time                   `db:"completed_at"`
+}
+
+// TelegramExportSelectionModel is stored as a JSONB object.
+type TelegramExportSelectionModel struct {
+	UserTelegramIDs []uint64 `json:"user_telegram_ids"`
+	ChatTelegramIDs []int64  `json:"chat_telegram_ids"`
+}
+
+func (selection TelegramExportSelectionModel) Value() (driver.Value, error) {
+	data, err := json.Marshal(selection)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (selection *TelegramExportSelectionModel) Scan(src any) error {
+	switch data := src.(type) {
+	case []byte:
+		return json.Unmarshal(data, selection)
+	case string:
+		return json.Unmarshal([]byte(data), selection)
+	default:
+		return fmt.Errorf("unsupported type %T of telegram export selection", src)
+	}
+}
@@ -18,3 +18,12 @@ type TelegramIdentityModel struct {
 	AddedAt     time.Time `db:"added_at"`
 	AddedByUser uuid.UUID `db:"added_by_user"`
 }
+
+// TelegramCorrelationEvidenceModel is an identity holding a value shared with the identities of other users.
+type TelegramCorrelationEvidenceModel struct {
+	CorrelationType string    `db:"correlation_type"`
+	Value           string    `db:"value"`
+	UserTelegramID  uint64    `db:"user_telegram_id"`
+	IdentityID      uuid.UUID `db:"identity_id"`
+	AddedAt         time.Time `db:"added_at"`
+}
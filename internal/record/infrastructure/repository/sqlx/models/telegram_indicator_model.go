@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TelegramIndicatorModel represents the sqlx model for the telegram_indicators table.
+type TelegramIndicatorModel struct {
+	ID            uuid.UUID `db:"id"`
+	RecordID      uuid.UUID `db:"record_id"`
+	IndicatorType string    `db:"indicator_type"`
+	Value         string    `db:"value"`
+	AddedAt       time.Time `db:"added_at"`
+	AddedByUser   uuid.UUID `db:"added_by_user"`
+}
+
+// TelegramIndicatorSummaryModel is a row of the indicators aggregated over records.
+type TelegramIndicatorSummaryModel struct {
+	IndicatorType string    `db:"indicator_type"`
+	Value         string    `db:"value"`
+	RecordCount   int       `db:"record_count"`
+	FirstSeenAt   time.Time `db:"first_seen_at"`
+	LastSeenAt    time.Time `db:"last_seen_at"`
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// TelegramInteractionModel represents the sqlx model for the telegram_user_interactions table.
+type TelegramInteractionModel struct {
+	FromUserTelegramID uint64    `db:"from_user_telegram_id"`
+	ToUserTelegramID   uint64    `db:"to_user_telegram_id"`
+	InteractionType    string    `db:"interaction_type"`
+	Weight             int       `db:"weight"`
+	FirstInteractionAt time.Time `db:"first_interaction_at"`
+	LastInteractionAt  time.Time `db:"last_interaction_at"`
+}
+
+// TelegramInteractionGraphNodeModel is a user reached while walking the interactions, with their latest identity.
+type TelegramInteractionGraphNodeModel struct {
+	UserTelegramID uint64         `db:"user_telegram_id"`
+	Username       sql.NullString `db:"username"`
+	FirstName      sql.NullString `db:"first_name"`
+	LastName       sql.NullString `db:"last_name"`
+	Depth          int            `db:"depth"`
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TelegramInvestigationModel represents the sqlx model for the telegram_investigations table.
+type TelegramInvestigationModel struct {
+	ID          uuid.UUID `db:"id"`
+	Name        string    `db:"name"`
+	Description string    `db:"description"`
+	CreatedBy   uuid.UUID `db:"created_by"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+// TelegramInvestigationMemberModel represents the sqlx model for the telegram_investigation_members table.
+type TelegramInvestigationMemberModel struct {
+	ID              uuid.UUID `db:"id"`
+	InvestigationID uuid.UUID `db:"investigation_id"`
+	UserID          uuid.UUID `db:"user_id"`
+	Role            string    `db:"role"`
+	AddedBy         uuid.UUID `db:"added_by"`
+	AddedAt         time.Time `db:"added_at"`
+}
+
+// TelegramInvestigationItemModel represents the sqlx model for the telegram_investigation_items table,
+// only the column of the item type is set.
+type TelegramInvestigationItemModel struct {
+	ID               uuid.UUID  `db:"id"`
+	InvestigationID  uuid.UUID  `db:"investigation_id"`
+	ItemType         string     `db:"item_type"`
+	TelegramUserID   *uuid.UUID `db:"telegram_user_id"`
+	TelegramChatID   *uuid.UUID `db:"telegram_chat_id"`
+	TelegramRecordID *uuid.UUID `db:"telegram_record_id"`
+	Note             string     `db:"note"`
+	AddedBy          uuid.UUID  `db:"added_by"`
+	AddedAt          time.Time  `db:"added_at"`
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TelegramObservationModel represents the sqlx model for the telegram_observations table,
+// only the column of the target type is set. The tenant is set by the database.
+type TelegramObservationModel struct {
+	ID               uuid.UUID     `db:"id"`
+	TargetType       string        `db:"target_type"`
+	TelegramUserID   *uuid.UUID    `db:"telegram_user_id"`
+	TelegramRecordID *uuid.UUID    `db:"telegram_record_id"`
+	CollectorUserID  uuid.UUID     `db:"collector_user_id"`
+	Visibility       string        `db:"visibility"`
+	ObservedAt       time.Time     `db:"observed_at"`
+	TenantID         uuid.NullUUID `db:"tenant_id"`
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TelegramProfilePictureModel represents the sqlx model for the telegram_profile_pictures table.
+type TelegramProfilePictureModel struct {
+	ID             uuid.UUID `db:"id"`
+	UserTelegramID uint64    `db:"user_telegram_id"`
+	StorageKey     string    `db:"storage_key"`
+	SHA256         string    `db:"sha256"`
+	FileSize       uint64    `db:"file_size"`
+	PostedAt       time.Time `db:"posted_at"`
+	MimeType       string    `db:"mime_type"`
+	AddedAt        time.Time `db:"added_at"`
+	AddedByUser    uuid.UUID `db:"added_by_user"`
+}
@@ -1,18 +1,83 @@
 package models
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type SQLXTelegramRecordModel struct {
-	ID                 uuid.UUID `db:"id"`
-	MessageTelegramID  uint64    `db:"message_telegram_id"`
-	FromTelegramUserID uuid.UUID `db:"from_user_telegram_id"`
-	InTelegramChatID   int64     `db:"in_telegram_chat_id"`
-	MessageText        string    `db:"message_text"`
-	PostedAt           time.Time `db:"posted_at"`
-	AddedAt            time.Time `db:"added_at"`
-	AddedByUser        uuid.UUID `db:"added_by_user"`
+	ID                           uuid.UUID                        `db:"id"`
+	MessageTelegramID            uint64                           `db:"message_telegram_id"`
+	FromTelegramUserID           uuid.UUID                        `db:"from_telegram_user_id"`
+	InTelegramChatID             int64                            `db:"in_telegram_chat_id"`
+	MessageText                  string                           `db:"message_text"`
+	Entities                     SQLXTelegramMessageEntitiesModel `db:"entities"`
+	Caption                      string                           `db:"caption"`
+	CaptionEntities              SQLXTelegramMessageEntitiesModel `db:"caption_entities"`
+	PostedAt                     time.Time                        `db:"posted_at"`
+	EditedAt                     *time.Time                       `db:"edited_at"`
+	DeletedAt                    *time.Time                       `db:"deleted_at"`
+	ReplyToMessageTelegramID     *uint64                          `db:"reply_to_message_telegram_id"`
+	ThreadTelegramID             *uint64                          `db:"thread_telegram_id"`
+	ForwardFromUserTelegramID    *uint64                          `db:"forward_from_user_telegram_id"`
+	ForwardFromChatTelegramID    *int64                           `db:"forward_from_chat_telegram_id"`
+	ForwardFromMessageTelegramID *uint64                          `db:"forward_from_message_telegram_id"`
+	ForwardPostedAt              *time.Time                       `db:"forward_posted_at"`
+	AddedAt                      time.Time                        `db:"added_at"`
+	AddedByUser                  uuid.UUID                        `db:"added_by_user"`
+}
+
+type SQLXTelegramRecordRevisionModel struct {
+	ID              uuid.UUID                        `db:"id"`
+	RecordID        uuid.UUID                        `db:"record_id"`
+	MessageText     string                           `db:"message_text"`
+	Entities        SQLXTelegramMessageEntitiesModel `db:"entities"`
+	Caption         string                           `db:"caption"`
+	CaptionEntities SQLXTelegramMessageEntitiesModel `db:"caption_entities"`
+	EditedAt        *time.Time                       `db:"edited_at"`
+	AddedAt         time.Time                        `db:"added_at"`
+	AddedByUser     uuid.UUID                        `db:"added_by_user"`
+}
+
+// SQLXTelegramMessageEntityModel is an element of a JSONB entities column, shaped like the Bot API MessageEntity.
+type SQLXTelegramMessageEntityModel struct {
+	Type           string `json:"type"`
+	Offset         int    `json:"offset"`
+	Length         int    `json:"length"`
+	URL            string `json:"url,omitempty"`
+	UserTelegramID uint64 `json:"user_id,omitempty"`
+	Language       string `json:"language,omitempty"`
+	CustomEmojiID  string `json:"custom_emoji_id,omitempty"`
+}
+
+// SQLXTelegramMessageEntitiesModel is stored as a JSONB array, an empty one when there are no entities.
+type SQLXTelegramMessageEntitiesModel []SQLXTelegramMessageEntityModel
+
+func (entities SQLXTelegramMessageEntitiesModel) Value() (driver.Value, error) {
+	if entities == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal(entities)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (entities *SQLXTelegramMessageEntitiesModel) Scan(src any) error {
+	switch data := src.(type) {
+	case nil:
+		*entities = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(data, entities)
+	case string:
+		return json.Unmarshal([]byte(data), entities)
+	default:
+		return fmt.Errorf("unsupported type %T of telegram message entities", src)
+	}
 }
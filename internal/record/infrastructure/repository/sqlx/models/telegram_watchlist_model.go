@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TelegramWatchlistModel represents the sqlx model for the telegram_watchlists table.
+type TelegramWatchlistModel struct {
+	ID          uuid.UUID `db:"id"`
+	OwnerUserID uuid.UUID `db:"owner_user_id"`
+	Name        string    `db:"name"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+// TelegramWatchlistEntryModel represents the sqlx model for the telegram_watchlist_entries table.
+type TelegramWatchlistEntryModel struct {
+	ID          uuid.UUID `db:"id"`
+	WatchlistID uuid.UUID `db:"watchlist_id"`
+	EntryType   string    `db:"entry_type"`
+	Value       string    `db:"value"`
+	AddedAt     time.Time `db:"added_at"`
+}
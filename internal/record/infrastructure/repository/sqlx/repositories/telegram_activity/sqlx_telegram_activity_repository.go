@@ -0,0 +1,138 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+	"github.com/jmoiron/sqlx"
+)
+
+// activityScopeColumns are the columns of the messages CTE a scope is filtered and broken down by.
+var activityScopeColumns = map[domain.TelegramActivityScope]struct {
+	filter    string
+	breakdown string
+}{
+	domain.TelegramActivityScopeUser: {filter: "u.telegram_id", breakdown: "chat_telegram_id"},
+	domain.TelegramActivityScopeChat: {filter: "r.in_telegram_chat_id", breakdown: "user_telegram_id"},
+}
+
+// activityMessagesCTE selects the messages of the scope visible to the caller,
+// a message collected by several users is kept once.
+const activityMessagesCTE = `WITH messages AS (
+	SELECT DISTINCT ON (r.in_telegram_chat_id, r.message_telegram_id)
+	r.in_telegram_chat_id AS chat_telegram_id, u.telegram_id AS user_telegram_id, r.posted_at, r.message_text
+	FROM "records"."telegram_records" r
+	JOIN "records"."telegram_users" u ON u.id = r.from_telegram_user_id
+	WHERE %s = $1 AND "records".is_record_visible(r.id)
+	ORDER BY r.in_telegram_chat_id, r.message_telegram_id, r.added_at
+)
+`
+
+type SQLXTelegramActivityRepository struct {
+	session    *sqlx.Tx
+	sqlxMapper *mappers.SqlxTelegramActivityMapper
+	logger     *slog.Logger
+}
+
+func NewSQLXTelegramActivityRepository(
+	session *sqlx.Tx,
+	sqlxMapper *mappers.SqlxTelegramActivityMapper,
+	logger *slog.Logger,
+) repository.TelegramActivityRepository {
+	tarLogger := logger.With(
+		slog.String("component", "repository"),
+		slog.String("name", "sqlx_telegram_activity_repository"),
+	)
+	return &SQLXTelegramActivityRepository{
+		session:    session,
+		sqlxMapper: sqlxMapper,
+		logger:     tarLogger,
+	}
+}
+
+func (repo *SQLXTelegramActivityRepository) GetTelegramActivityStatistics(
+	ctx context.Context,
+	scope domain.TelegramActivityScope,
+	telegramID int64,
+	timezone string,
+	maxBreakdown int,
+	maxGaps int,
+) (*domain.TelegramActivityStatistics, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramActivityStatistics request",
+		slog.String("scope", string(scope)),
+		slog.Int64("telegram_id", telegramID),
+		slog.String("timezone", timezone),
+	)
+	columns, ok := activityScopeColumns[scope]
+	if !ok {
+		repo.logger.ErrorContext(ctx, "Unknown telegram activity scope", slog.String("scope", string(scope)))
+		return nil, repository.ErrDatabaseFailed
+	}
+	messages := fmt.Sprintf(activityMessagesCTE, columns.filter)
+
+	var summaryModel models.TelegramActivitySummaryModel
+	summaryQuery := messages + `SELECT COUNT(*) AS message_count,
+	COALESCE(AVG(NULLIF(CHAR_LENGTH(message_text), 0)), 0) AS average_message_length,
+	MIN(posted_at) AS first_seen_at, MAX(posted_at) AS last_seen_at
+	FROM messages`
+	if err := repo.session.GetContext(ctx, &summaryModel, summaryQuery, telegramID); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram activity summary", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+
+	var dailyModels []models.TelegramDailyActivityModel
+	dailyQuery := messages + `SELECT (posted_at AT TIME ZONE $2)::DATE AS day, COUNT(*) AS message_count
+	FROM messages
+	GROUP BY day
+	ORDER BY day`
+	if err := repo.session.SelectContext(ctx, &dailyModels, dailyQuery, telegramID, timezone); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram daily activity", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+
+	var hourOfWeekModels []models.TelegramHourOfWeekActivityModel
+	hourOfWeekQuery := messages + `SELECT EXTRACT(ISODOW FROM posted_at AT TIME ZONE $2)::INT AS weekday,
+	EXTRACT(HOUR FROM posted_at AT TIME ZONE $2)::INT AS hour, COUNT(*) AS message_count
+	FROM messages
+	GROUP BY weekday, hour`
+	if err := repo.session.SelectContext(ctx, &hourOfWeekModels, hourOfWeekQuery, telegramID, timezone); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram hour of week activity", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+
+	var breakdownModels []models.TelegramActivityBreakdownModel
+	breakdownQuery := messages + fmt.Sprintf(`SELECT %[1]s AS telegram_id, COUNT(*) AS message_count,
+	MIN(posted_at) AS first_seen_at, MAX(posted_at) AS last_seen_at
+	FROM messages
+	GROUP BY %[1]s
+	ORDER BY message_count DESC, last_seen_at DESC
+	LIMIT $2`, columns.breakdown)
+	if err := repo.session.SelectContext(ctx, &breakdownModels, breakdownQuery, telegramID, maxBreakdown); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram activity breakdown", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+
+	var gapModels []models.TelegramActivityGapModel
+	gapsQuery := messages + `SELECT started_at, ended_at
+	FROM (
+		SELECT LAG(posted_at) OVER (ORDER BY posted_at) AS started_at, posted_at AS ended_at
+		FROM messages
+	) gaps
+	WHERE started_at IS NOT NULL
+	ORDER BY ended_at - started_at DESC, started_at DESC
+	LIMIT $2`
+	if err := repo.session.SelectContext(ctx, &gapModels, gapsQuery, telegramID, maxGaps); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram activity gaps", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+
+	statistics := repo.sqlxMapper.ToDomain(summaryModel, dailyModels, hourOfWeekModels, breakdownModels, gapModels)
+	return &statistics, nil
+}
@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXTelegramActivityRepositoryFactory struct {
+	logger     *slog.Logger
+	sqlxMapper *mappers.SqlxTelegramActivityMapper
+}
+
+func NewSQLXTelegramActivityRepositoryFactory(
+	logger *slog.Logger,
+	mapper *mappers.SqlxTelegramActivityMapper,
+) repository.TelegramActivityRepositoryFactory {
+	return &SQLXTelegramActivityRepositoryFactory{
+		logger:     logger,
+		sqlxMapper: mapper,
+	}
+}
+
+func (factory *SQLXTelegramActivityRepositoryFactory) CreateTelegramActivityRepositoryWithTransaction(
+	tm interfaces.TransactionManager,
+) repository.TelegramActivityRepository {
+	tx, ok := tm.GetTransaction().(*sqlx.Tx)
+	if !ok {
+		factory.logger.Error("invalid transaction type, expected *sqlx.Tx")
+		panic("invalid transaction type for sqlx repository")
+	}
+	return &SQLXTelegramActivityRepository{
+		session:    tx,
+		sqlxMapper: factory.sqlxMapper,
+		logger:     factory.logger,
+	}
+}
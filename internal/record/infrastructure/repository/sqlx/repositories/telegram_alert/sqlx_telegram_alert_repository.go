@@ -0,0 +1,226 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// insertMatchedAlerts raises the alerts for the entry_id and record_id or identity_id pairs of the matches CTE.
+const insertMatchedAlerts = `
+	INSERT INTO "records"."telegram_alerts" (id, owner_user_id, watchlist_id, entry_id, record_id, identity_id, triggered_at)
+	SELECT gen_random_uuid(), w.owner_user_id, w.id, m.entry_id, m.record_id, m.identity_id, CURRENT_TIMESTAMP
+	FROM matches m
+	JOIN "records"."telegram_watchlist_entries" e ON e.id = m.entry_id
+	JOIN "records"."telegram_watchlists" w ON w.id = e.watchlist_id
+	ON CONFLICT DO NOTHING`
+
+type SQLXTelegramAlertRepository struct {
+	session    *sqlx.Tx
+	sqlxMapper *mappers.SqlxTelegramAlertMapper
+	logger     *slog.Logger
+}
+
+func NewSQLXTelegramAlertRepository(
+	session *sqlx.Tx,
+	sqlxMapper *mappers.SqlxTelegramAlertMapper,
+	logger *slog.Logger,
+) repository.TelegramAlertRepository {
+	tarLogger := logger.With(
+		slog.String("component", "repository"),
+		slog.String("name", "sqlx_telegram_alert_repository"),
+	)
+	return &SQLXTelegramAlertRepository{
+		session:    session,
+		sqlxMapper: sqlxMapper,
+		logger:     tarLogger,
+	}
+}
+
+func (repo *SQLXTelegramAlertRepository) AddTelegramAlertsForRecords(ctx context.Context, recordIDs []uuid.UUID) error {
+	repo.logger.DebugContext(ctx, "Started AddTelegramAlertsForRecords request", slog.Int("record_count", len(recordIDs)))
+	if len(recordIDs) == 0 {
+		return nil
+	}
+	// Entries only match the records added or revised after them, so that analyzing the stored records again
+	// doesn't alert on the past activity. Usernames match both the mentions and the sender's identities.
+	// Keywords are stored lowercase with their whitespace collapsed, the content is compared the same way.
+	query, args, err := sqlx.In(`WITH candidates AS (
+		SELECT r.id AS record_id, u.telegram_id AS sender_telegram_id, r.forward_from_user_telegram_id,
+		COALESCE(
+			(SELECT MAX(v.added_at) FROM "records"."telegram_record_revisions" v WHERE v.record_id = r.id), r.added_at
+		) AS added_at,
+		LOWER(REGEXP_REPLACE(CONCAT_WS(' ', r.message_text, r.caption), '\s+', ' ', 'g')) AS content
+		FROM "records"."telegram_records" r
+		JOIN "records"."telegram_users" u ON u.id = r.from_telegram_user_id
+		WHERE r.id IN (?)
+	), matches AS (
+		SELECT e.id AS entry_id, c.record_id, NULL::UUID AS identity_id
+		FROM candidates c
+		JOIN "records"."telegram_watchlist_entries" e ON e.entry_type = 'telegram_id'
+		AND e.value IN (c.sender_telegram_id::TEXT, c.forward_from_user_telegram_id::TEXT)
+		WHERE e.added_at <= c.added_at
+		UNION
+		SELECT e.id, c.record_id, NULL::UUID
+		FROM candidates c
+		JOIN "records"."telegram_users" su ON su.telegram_id = c.sender_telegram_id
+		JOIN "records"."telegram_identities" si ON si.user_id = su.id
+		JOIN "records"."telegram_watchlist_entries" e ON e.entry_type = 'username' AND e.value = LOWER(si.username)
+		WHERE e.added_at <= c.added_at
+		UNION
+		SELECT e.id, c.record_id, NULL::UUID
+		FROM candidates c
+		JOIN "records"."telegram_indicators" i ON i.record_id = c.record_id AND i.indicator_type = 'mention'
+		JOIN "records"."telegram_watchlist_entries" e ON e.entry_type = 'username' AND e.value = i.value
+		WHERE e.added_at <= c.added_at
+		UNION
+		SELECT e.id, c.record_id, NULL::UUID
+		FROM candidates c
+		JOIN "records"."telegram_indicators" i ON i.record_id = c.record_id AND i.indicator_type = 'phone'
+		JOIN "records"."telegram_watchlist_entries" e ON e.entry_type = 'phone'
+		AND REGEXP_REPLACE(e.value, '\D', '', 'g') = REGEXP_REPLACE(i.value, '\D', '', 'g')
+		WHERE e.added_at <= c.added_at
+		UNION
+		SELECT e.id, c.record_id, NULL::UUID
+		FROM candidates c
+		JOIN "records"."telegram_watchlist_entries" e ON e.entry_type = 'keyword' AND POSITION(e.value IN c.content) > 0
+		WHERE e.added_at <= c.added_at
+	)`+insertMatchedAlerts, recordIDs)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to build telegram record alerts query", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	if _, err = repo.session.ExecContext(ctx, repo.session.Rebind(query), args...); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to add telegram record alerts", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramAlertRepository) AddTelegramAlertsForIdentities(
+	ctx context.Context,
+	identityIDs []uuid.UUID,
+) error {
+	repo.logger.DebugContext(
+		ctx,
+		"Started AddTelegramAlertsForIdentities request",
+		slog.Int("identity_count", len(identityIDs)),
+	)
+	if len(identityIDs) == 0 {
+		return nil
+	}
+	query, args, err := sqlx.In(`WITH candidates AS (
+		SELECT i.id AS identity_id, i.added_at, u.telegram_id, LOWER(i.username) AS username,
+		REGEXP_REPLACE(i.phone_number, '\D', '', 'g') AS phone_digits,
+		LOWER(REGEXP_REPLACE(CONCAT_WS(' ', i.first_name, i.last_name, i.bio), '\s+', ' ', 'g')) AS content
+		FROM "records"."telegram_identities" i
+		JOIN "records"."telegram_users" u ON u.id = i.user_id
+		WHERE i.id IN (?)
+	), matches AS (
+		SELECT e.id AS entry_id, NULL::UUID AS record_id, c.identity_id
+		FROM candidates c
+		JOIN "records"."telegram_watchlist_entries" e ON
+		(e.entry_type = 'telegram_id' AND e.value = c.telegram_id::TEXT)
+		OR (e.entry_type = 'username' AND e.value = c.username)
+		OR (e.entry_type = 'phone' AND REGEXP_REPLACE(e.value, '\D', '', 'g') = c.phone_digits)
+		OR (e.entry_type = 'keyword' AND POSITION(e.value IN c.content) > 0)
+		WHERE e.added_at <= c.added_at
+	)`+insertMatchedAlerts, identityIDs)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to build telegram identity alerts query", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	if _, err = repo.session.ExecContext(ctx, repo.session.Rebind(query), args...); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to add telegram identity alerts", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramAlertRepository) GetTelegramAlertsByOwner(
+	ctx context.Context,
+	ownerUserID uuid.UUID,
+	unreadOnly bool,
+	limit int,
+	offset int,
+) (*[]domain.TelegramAlert, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramAlertsByOwner request",
+		slog.String("owner_user_id", ownerUserID.String()),
+		slog.Bool("unread_only", unreadOnly),
+	)
+	var alertModels []models.TelegramAlertModel
+	query := `SELECT a.id, a.owner_user_id, a.watchlist_id, a.entry_id, e.entry_type, e.value AS entry_value,
+	a.record_id, a.identity_id, a.triggered_at, a.read_at
+	FROM "records"."telegram_alerts" a
+	JOIN "records"."telegram_watchlist_entries" e ON e.id = a.entry_id
+	WHERE a.owner_user_id = $1 AND (NOT $2 OR a.read_at IS NULL)
+	ORDER BY a.triggered_at DESC, a.id
+	LIMIT $3 OFFSET $4`
+	if err := repo.session.SelectContext(ctx, &alertModels, query, ownerUserID, unreadOnly, limit, offset); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram alerts", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	alerts := make([]domain.TelegramAlert, len(alertModels))
+	for i, alertModel := range alertModels {
+		alerts[i] = repo.sqlxMapper.ToDomain(alertModel)
+	}
+	return &alerts, nil
+}
+
+func (repo *SQLXTelegramAlertRepository) CountUnreadTelegramAlerts(
+	ctx context.Context,
+	ownerUserID uuid.UUID,
+) (int, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started CountUnreadTelegramAlerts request",
+		slog.String("owner_user_id", ownerUserID.String()),
+	)
+	var unreadCount int
+	query := `SELECT COUNT(*) FROM "records"."telegram_alerts" WHERE owner_user_id = $1 AND read_at IS NULL`
+	if err := repo.session.GetContext(ctx, &unreadCount, query, ownerUserID); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to count unread telegram alerts", slog.Any("err", err))
+		return 0, repository.ErrDatabaseFailed
+	}
+	return unreadCount, nil
+}
+
+func (repo *SQLXTelegramAlertRepository) SetTelegramAlertReadAt(
+	ctx context.Context,
+	alertID uuid.UUID,
+	ownerUserID uuid.UUID,
+	readAt *time.Time,
+) (*time.Time, error) {
+	repo.logger.DebugContext(ctx, "Started SetTelegramAlertReadAt request", slog.String("alert_id", alertID.String()))
+	// The row is locked before the update, so the previous read time returned is the one replaced
+	query := `UPDATE "records"."telegram_alerts" a SET read_at = $1
+	FROM (
+		SELECT id, read_at FROM "records"."telegram_alerts" WHERE id = $2 AND owner_user_id = $3 FOR UPDATE
+	) previous
+	WHERE a.id = previous.id
+	RETURNING previous.read_at`
+	var previousReadAt sql.NullTime
+	if err := repo.session.GetContext(ctx, &previousReadAt, query, readAt, alertID, ownerUserID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrAlertNotFound
+		}
+		repo.logger.ErrorContext(ctx, "Failed to set telegram alert read time", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	var previous *time.Time
+	if previousReadAt.Valid {
+		previous = &previousReadAt.Time
+	}
+	return previous, nil
+}
@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXTelegramAlertRepositoryFactory struct {
+	logger     *slog.Logger
+	sqlxMapper *mappers.SqlxTelegramAlertMapper
+}
+
+func NewSQLXTelegramAlertRepositoryFactory(
+	logger *slog.Logger,
+	mapper *mappers.SqlxTelegramAlertMapper,
+) repository.TelegramAlertRepositoryFactory {
+	return &SQLXTelegramAlertRepositoryFactory{
+		logger:     logger,
+		sqlxMapper: mapper,
+	}
+}
+
+func (factory *SQLXTelegramAlertRepositoryFactory) CreateTelegramAlertRepositoryWithTransaction(
+	tm interfaces.TransactionManager,
+) repository.TelegramAlertRepository {
+	tx, ok := tm.GetTransaction().(*sqlx.Tx)
+	if !ok {
+		factory.logger.Error("invalid transaction type, expected *sqlx.Tx")
+		panic("invalid transaction type for sqlx repository")
+	}
+	return &SQLXTelegramAlertRepository{
+		session:    tx,
+		sqlxMapper: factory.sqlxMapper,
+		logger:     factory.logger,
+	}
+}
@@ -0,0 +1,307 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	tagColumns = `id, target_type, telegram_user_id, telegram_identity_id, telegram_record_id,
+	name, added_by, added_at`
+	noteColumns = `id, target_type, telegram_user_id, telegram_identity_id, telegram_record_id,
+	body, added_by, added_at`
+)
+
+// annotationVisibilityFilter keeps the tags and the notes of the targets visible to the caller of the transaction.
+const annotationVisibilityFilter = `"records".is_target_visible(
+	telegram_user_id, telegram_identity_id, telegram_record_id
+)`
+
+// targetColumns are the columns holding the targets of each type, the only ones put in the queries.
+var targetColumns = map[domain.TelegramAnnotationTargetType]string{
+	domain.TelegramAnnotationTargetTypeUser:     "telegram_user_id",
+	domain.TelegramAnnotationTargetTypeIdentity: "telegram_identity_id",
+	domain.TelegramAnnotationTargetTypeRecord:   "telegram_record_id",
+}
+
+type SQLXTelegramAnnotationRepository struct {
+	session    *sqlx.Tx
+	sqlxMapper *mappers.SqlxTelegramAnnotationMapper
+	logger     *slog.Logger
+}
+
+func NewSQLXTelegramAnnotationRepository(
+	session *sqlx.Tx,
+	sqlxMapper *mappers.SqlxTelegramAnnotationMapper,
+	logger *slog.Logger,
+) repository.TelegramAnnotationRepository {
+	tarLogger := logger.With(
+		slog.String("component", "repository"),
+		slog.String("name", "sqlx_telegram_annotation_repository"),
+	)
+	return &SQLXTelegramAnnotationRepository{
+		session:    session,
+		sqlxMapper: sqlxMapper,
+		logger:     tarLogger,
+	}
+}
+
+func (repo *SQLXTelegramAnnotationRepository) AddTelegramTag(ctx context.Context, tag *domain.TelegramTag) error {
+	repo.logger.DebugContext(ctx, "Started AddTelegramTag request", slog.String("tag_id", tag.ID.String()))
+	query := `INSERT INTO "records"."telegram_tags" (` + tagColumns + `)
+	VALUES (:id, :target_type, :telegram_user_id, :telegram_identity_id, :telegram_record_id,
+	:name, :added_by, :added_at)`
+	if _, err := repo.session.NamedExecContext(ctx, query, repo.sqlxMapper.TagToModel(*tag)); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.ConstraintName {
+			case "unique_telegram_tag_user", "unique_telegram_tag_identity", "unique_telegram_tag_record":
+				repo.logger.InfoContext(ctx, "Telegram tag already exists")
+				return domain.ErrTagAlreadyExists
+			case "fk_telegram_tags_user":
+				return domain.ErrUserNotFound
+			case "fk_telegram_tags_identity":
+				return domain.ErrIdentityNotFound
+			case "fk_telegram_tags_record":
+				return domain.ErrRecordNotFound
+			}
+		}
+		repo.logger.ErrorContext(ctx, "Failed to add telegram tag", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramAnnotationRepository) GetTelegramTag(
+	ctx context.Context,
+	targetType domain.TelegramAnnotationTargetType,
+	targetID uuid.UUID,
+	name string,
+) (*domain.TelegramTag, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramTag request",
+		slog.String("target_id", targetID.String()),
+		slog.String("name", name),
+	)
+	column, err := repo.targetColumn(ctx, targetType)
+	if err != nil {
+		return nil, err
+	}
+	var tagModel models.TelegramTagModel
+	query := `SELECT ` + tagColumns + ` FROM "records"."telegram_tags" WHERE ` + column + ` = $1 AND name = $2`
+	if err = repo.session.GetContext(ctx, &tagModel, query, targetID, name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrTagNotFound
+		}
+		repo.logger.ErrorContext(ctx, "Failed to get telegram tag", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	tag := repo.sqlxMapper.TagToDomain(tagModel)
+	return &tag, nil
+}
+
+func (repo *SQLXTelegramAnnotationRepository) GetTelegramTags(
+	ctx context.Context,
+	targetType domain.TelegramAnnotationTargetType,
+	targetID uuid.UUID,
+) (*[]domain.TelegramTag, error) {
+	repo.logger.DebugContext(ctx, "Started GetTelegramTags request", slog.String("target_id", targetID.String()))
+	column, err := repo.targetColumn(ctx, targetType)
+	if err != nil {
+		return nil, err
+	}
+	var tagModels []models.TelegramTagModel
+	query := `SELECT ` + tagColumns + ` FROM "records"."telegram_tags" WHERE ` + column + ` = $1
+	AND ` + annotationVisibilityFilter + `
+	ORDER BY added_at, id`
+	if err = repo.session.SelectContext(ctx, &tagModels, query, targetID); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram tags", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	return repo.tagsToDomain(tagModels), nil
+}
+
+func (repo *SQLXTelegramAnnotationRepository) GetTelegramTagCatalogue(
+	ctx context.Context,
+	prefix string,
+	limit int,
+) (*[]domain.TelegramTagCount, error) {
+	repo.logger.DebugContext(ctx, "Started GetTelegramTagCatalogue request", slog.String("prefix", prefix))
+	var countModels []models.TelegramTagCountModel
+	query := `SELECT name, count(*) AS count FROM "records"."telegram_tags"
+	WHERE starts_with(name, $1) AND ` + annotationVisibilityFilter + `
+	GROUP BY name
+	ORDER BY count DESC, name
+	LIMIT $2`
+	if err := repo.session.SelectContext(ctx, &countModels, query, prefix, limit); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram tag catalogue", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	counts := make([]domain.TelegramTagCount, len(countModels))
+	for i, countModel := range countModels {
+		counts[i] = repo.sqlxMapper.TagCountToDomain(countModel)
+	}
+	return &counts, nil
+}
+
+func (repo *SQLXTelegramAnnotationRepository) GetTelegramTagsByName(
+	ctx context.Context,
+	name string,
+	targetType domain.TelegramAnnotationTargetType,
+	limit int,
+	offset int,
+) (*[]domain.TelegramTag, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramTagsByName request",
+		slog.String("name", name),
+		slog.String("target_type", string(targetType)),
+	)
+	var tagModels []models.TelegramTagModel
+	query := `SELECT ` + tagColumns + ` FROM "records"."telegram_tags"
+	WHERE name = $1 AND ($2 = '' OR target_type = $2) AND ` + annotationVisibilityFilter + `
+	ORDER BY added_at DESC, id
+	LIMIT $3 OFFSET $4`
+	if err := repo.session.SelectContext(ctx, &tagModels, query, name, string(targetType), limit, offset); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram tags by name", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	return repo.tagsToDomain(tagModels), nil
+}
+
+func (repo *SQLXTelegramAnnotationRepository) RemoveTelegramTag(ctx context.Context, tagID uuid.UUID) error {
+	repo.logger.DebugContext(ctx, "Started RemoveTelegramTag request", slog.String("tag_id", tagID.String()))
+	query := `DELETE FROM "records"."telegram_tags" WHERE id = $1`
+	return repo.remove(ctx, query, tagID, domain.ErrTagNotFound)
+}
+
+func (repo *SQLXTelegramAnnotationRepository) AddTelegramNote(ctx context.Context, note *domain.TelegramNote) error {
+	repo.logger.DebugContext(ctx, "Started AddTelegramNote request", slog.String("note_id", note.ID.String()))
+	query := `INSERT INTO "records"."telegram_notes" (` + noteColumns + `)
+	VALUES (:id, :target_type, :telegram_user_id, :telegram_identity_id, :telegram_record_id,
+	:body, :added_by, :added_at)`
+	if _, err := repo.session.NamedExecContext(ctx, query, repo.sqlxMapper.NoteToModel(*note)); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.ConstraintName {
+			case "fk_telegram_notes_user":
+				return domain.ErrUserNotFound
+			case "fk_telegram_notes_identity":
+				return domain.ErrIdentityNotFound
+			case "fk_telegram_notes_record":
+				return domain.ErrRecordNotFound
+			}
+		}
+		repo.logger.ErrorContext(ctx, "Failed to add telegram note", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramAnnotationRepository) GetTelegramNote(
+	ctx context.Context,
+	targetType domain.TelegramAnnotationTargetType,
+	targetID uuid.UUID,
+	noteID uuid.UUID,
+) (*domain.TelegramNote, error) {
+	repo.logger.DebugContext(ctx, "Started GetTelegramNote request", slog.String("note_id", noteID.String()))
+	column, err := repo.targetColumn(ctx, targetType)
+	if err != nil {
+		return nil, err
+	}
+	var noteModel models.TelegramNoteModel
+	query := `SELECT ` + noteColumns + ` FROM "records"."telegram_notes" WHERE id = $1 AND ` + column + ` = $2`
+	if err = repo.session.GetContext(ctx, &noteModel, query, noteID, targetID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNoteNotFound
+		}
+		repo.logger.ErrorContext(ctx, "Failed to get telegram note", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	note := repo.sqlxMapper.NoteToDomain(noteModel)
+	return &note, nil
+}
+
+func (repo *SQLXTelegramAnnotationRepository) GetTelegramNotes(
+	ctx context.Context,
+	targetType domain.TelegramAnnotationTargetType,
+	targetID uuid.UUID,
+) (*[]domain.TelegramNote, error) {
+	repo.logger.DebugContext(ctx, "Started GetTelegramNotes request", slog.String("target_id", targetID.String()))
+	column, err := repo.targetColumn(ctx, targetType)
+	if err != nil {
+		return nil, err
+	}
+	var noteModels []models.TelegramNoteModel
+	query := `SELECT ` + noteColumns + ` FROM "records"."telegram_notes" WHERE ` + column + ` = $1
+	AND ` + annotationVisibilityFilter + `
+	ORDER BY added_at, id`
+	if err = repo.session.SelectContext(ctx, &noteModels, query, targetID); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram notes", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	notes := make([]domain.TelegramNote, len(noteModels))
+	for i, noteModel := range noteModels {
+		notes[i] = repo.sqlxMapper.NoteToDomain(noteModel)
+	}
+	return &notes, nil
+}
+
+func (repo *SQLXTelegramAnnotationRepository) RemoveTelegramNote(ctx context.Context, noteID uuid.UUID) error {
+	repo.logger.DebugContext(ctx, "Started RemoveTelegramNote request", slog.String("note_id", noteID.String()))
+	query := `DELETE FROM "records"."telegram_notes" WHERE id = $1`
+	return repo.remove(ctx, query, noteID, domain.ErrNoteNotFound)
+}
+
+func (repo *SQLXTelegramAnnotationRepository) remove(
+	ctx context.Context,
+	query string,
+	id uuid.UUID,
+	errNotFound error,
+) error {
+	result, err := repo.session.ExecContext(ctx, query, id)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to remove telegram annotation", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get rows affected", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	if rowsAffected == 0 {
+		return errNotFound
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramAnnotationRepository) targetColumn(
+	ctx context.Context,
+	targetType domain.TelegramAnnotationTargetType,
+) (string, error) {
+	column, ok := targetColumns[targetType]
+	if !ok {
+		repo.logger.ErrorContext(ctx, "Unknown telegram annotation target type", slog.String("type", string(targetType)))
+		return "", repository.ErrDatabaseFailed
+	}
+	return column, nil
+}
+
+func (repo *SQLXTelegramAnnotationRepository) tagsToDomain(tagModels []models.TelegramTagModel) *[]domain.TelegramTag {
+	tags := make([]domain.TelegramTag, len(tagModels))
+	for i, tagModel := range tagModels {
+		tags[i] = repo.sqlxMapper.TagToDomain(tagModel)
+	}
+	return &tags
+}
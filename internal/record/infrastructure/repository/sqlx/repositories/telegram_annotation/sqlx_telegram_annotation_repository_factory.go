@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXTelegramAnnotationRepositoryFactory struct {
+	logger     *slog.Logger
+	sqlxMapper *mappers.SqlxTelegramAnnotationMapper
+}
+
+func NewSQLXTelegramAnnotationRepositoryFactory(
+	logger *slog.Logger,
+	mapper *mappers.SqlxTelegramAnnotationMapper,
+) repository.TelegramAnnotationRepositoryFactory {
+	return &SQLXTelegramAnnotationRepositoryFactory{
+		logger:     logger,
+		sqlxMapper: mapper,
+	}
+}
+
+func (factory *SQLXTelegramAnnotationRepositoryFactory) CreateTelegramAnnotationRepositoryWithTransaction(
+	tm interfaces.TransactionManager,
+) repository.TelegramAnnotationRepository {
+	tx, ok := tm.GetTransaction().(*sqlx.Tx)
+	if !ok {
+		factory.logger.Error("invalid transaction type, expected *sqlx.Tx")
+		panic("invalid transaction type for sqlx repository")
+	}
+	return &SQLXTelegramAnnotationRepository{
+		session:    tx,
+		sqlxMapper: factory.sqlxMapper,
+		logger:     factory.logger,
+	}
+}
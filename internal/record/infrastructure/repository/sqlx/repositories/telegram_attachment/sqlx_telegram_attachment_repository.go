@@ -0,0 +1,177 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXTelegramAttachmentRepository struct {
+	session    *sqlx.Tx
+	sqlxMapper *mappers.SqlxTelegramAttachmentMapper
+	logger     *slog.Logger
+}
+
+func NewSQLXTelegramAttachmentRepository(
+	session *sqlx.Tx,
+	sqlxMapper *mappers.SqlxTelegramAttachmentMapper,
+	logger *slog.Logger,
+) repository.TelegramAttachmentRepository {
+	tarLogger := logger.With(
+		slog.String("component", "repository"),
+		slog.String("name", "sqlx_telegram_attachment_repository"),
+	)
+	return &SQLXTelegramAttachmentRepository{
+		session:    session,
+		sqlxMapper: sqlxMapper,
+		logger:     tarLogger,
+	}
+}
+
+func (repo *SQLXTelegramAttachmentRepository) AddAttachment(
+	ctx context.Context,
+	attachment *domain.TelegramAttachment,
+) error {
+	repo.logger.DebugContext(
+		ctx,
+		"Started AddAttachment request",
+		slog.String("attachment_id", attachment.ID.String()),
+	)
+	attachmentModel := repo.sqlxMapper.ToModel(*attachment)
+	query := `INSERT INTO "records"."telegram_attachments" (id, record_id, telegram_attachment_id, file_name,
+	storage_key, sha256, file_size, mime_type, added_at, added_by_user)
+	VALUES (:id, :record_id, :telegram_attachment_id, :file_name,
+	:storage_key, :sha256, :file_size, :mime_type, :added_at, :added_by_user)`
+	_, err := repo.session.NamedExecContext(ctx, query, attachmentModel)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.ConstraintName {
+			case "fk_telegram_attachments_record":
+				repo.logger.InfoContext(
+					ctx,
+					"Record with this id doesn't exist",
+					slog.String("record_id", attachment.RecordID.String()),
+				)
+				return domain.ErrUnexistentTelegramRecordReferenced
+			case "unique_telegram_attachment_per_record":
+				repo.logger.InfoContext(
+					ctx,
+					"This file is already attached to the record",
+					slog.String("record_id", attachment.RecordID.String()),
+					slog.String("sha256", attachment.SHA256),
+				)
+				return domain.ErrAttachmentAlreadyExists
+			}
+		}
+		repo.logger.ErrorContext(ctx, "Failed to add telegram attachment", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramAttachmentRepository) GetAttachmentByID(
+	ctx context.Context,
+	attachmentID uuid.UUID,
+) (*domain.TelegramAttachment, error) {
+	repo.logger.DebugContext(ctx, "Started GetAttachmentByID request", slog.String("attachment_id", attachmentID.String()))
+	var attachmentModel models.TelegramAttachmentModel
+	query := `SELECT id, record_id, telegram_attachment_id, file_name, storage_key, sha256, file_size, mime_type,
+	added_at, added_by_user
+	FROM "records"."telegram_attachments" WHERE id = $1`
+	err := repo.session.GetContext(ctx, &attachmentModel, query, attachmentID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			repo.logger.InfoContext(ctx, "Telegram attachment not found", slog.String("attachment_id", attachmentID.String()))
+			return nil, domain.ErrAttachmentNotFound
+		}
+		repo.logger.ErrorContext(ctx, "Failed to get telegram attachment", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	attachment := repo.sqlxMapper.ToDomain(attachmentModel)
+	return &attachment, nil
+}
+
+func (repo *SQLXTelegramAttachmentRepository) GetAttachmentsByRecordID(
+	ctx context.Context,
+	recordID uuid.UUID,
+) (*[]domain.TelegramAttachment, error) {
+	repo.logger.DebugContext(ctx, "Started GetAttachmentsByRecordID request", slog.String("record_id", recordID.String()))
+	var attachmentModels []models.TelegramAttachmentModel
+	query := `SELECT id, record_id, telegram_attachment_id, file_name, storage_key, sha256, file_size, mime_type,
+	added_at, added_by_user
+	FROM "records"."telegram_attachments" WHERE record_id = $1 ORDER BY added_at`
+	err := repo.session.SelectContext(ctx, &attachmentModels, query, recordID)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram attachments", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	attachments := make([]domain.TelegramAttachment, len(attachmentModels))
+	for i, attachmentModel := range attachmentModels {
+		attachments[i] = repo.sqlxMapper.ToDomain(attachmentModel)
+	}
+	return &attachments, nil
+}
+
+func (repo *SQLXTelegramAttachmentRepository) GetAttachmentsByIDs(
+	ctx context.Context,
+	attachmentIDs []uuid.UUID,
+) (*[]domain.TelegramAttachment, error) {
+	repo.logger.DebugContext(ctx, "Started GetAttachmentsByIDs request", slog.Int("attachment_count", len(attachmentIDs)))
+	if len(attachmentIDs) == 0 {
+		return &[]domain.TelegramAttachment{}, nil
+	}
+	query, args, err := sqlx.In(`SELECT id, record_id, telegram_attachment_id, file_name, storage_key, sha256,
+	file_size, mime_type, added_at, added_by_user
+	FROM "records"."telegram_attachments" WHERE id IN (?) ORDER BY id`, attachmentIDs)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to build telegram attachments query", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	var attachmentModels []models.TelegramAttachmentModel
+	if err = repo.session.SelectContext(ctx, &attachmentModels, repo.session.Rebind(query), args...); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram attachments", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	attachments := make([]domain.TelegramAttachment, len(attachmentModels))
+	for i, attachmentModel := range attachmentModels {
+		attachments[i] = repo.sqlxMapper.ToDomain(attachmentModel)
+	}
+	return &attachments, nil
+}
+
+func (repo *SQLXTelegramAttachmentRepository) GetAttachmentsByRecordIDs(
+	ctx context.Context,
+	recordIDs []uuid.UUID,
+) (*[]domain.TelegramAttachment, error) {
+	repo.logger.DebugContext(ctx, "Started GetAttachmentsByRecordIDs request", slog.Int("record_count", len(recordIDs)))
+	if len(recordIDs) == 0 {
+		return &[]domain.TelegramAttachment{}, nil
+	}
+	query, args, err := sqlx.In(`SELECT id, record_id, telegram_attachment_id, file_name, storage_key, sha256,
+	file_size, mime_type, added_at, added_by_user
+	FROM "records"."telegram_attachments" WHERE record_id IN (?) ORDER BY id`, recordIDs)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to build telegram attachments query", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	var attachmentModels []models.TelegramAttachmentModel
+	if err = repo.session.SelectContext(ctx, &attachmentModels, repo.session.Rebind(query), args...); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram attachments", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	attachments := make([]domain.TelegramAttachment, len(attachmentModels))
+	for i, attachmentModel := range attachmentModels {
+		attachments[i] = repo.sqlxMapper.ToDomain(attachmentModel)
+	}
+	return &attachments, nil
+}
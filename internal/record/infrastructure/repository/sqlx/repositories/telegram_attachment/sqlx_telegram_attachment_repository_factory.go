@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXTelegramAttachmentRepositoryFactory struct {
+	logger     *slog.Logger
+	sqlxMapper *mappers.SqlxTelegramAttachmentMapper
+}
+
+func NewSQLXTelegramAttachmentRepositoryFactory(
+	logger *slog.Logger,
+	mapper *mappers.SqlxTelegramAttachmentMapper,
+) repository.TelegramAttachmentRepositoryFactory {
+	return &SQLXTelegramAttachmentRepositoryFactory{
+		logger:     logger,
+		sqlxMapper: mapper,
+	}
+}
+
+func (factory *SQLXTelegramAttachmentRepositoryFactory) CreateTelegramAttachmentRepositoryWithTransaction(
+	tm interfaces.TransactionManager,
+) repository.TelegramAttachmentRepository {
+	tx, ok := tm.GetTransaction().(*sqlx.Tx)
+	if !ok {
+		factory.logger.Error("invalid transaction type, expected *sqlx.Tx")
+		panic("invalid transaction type for sqlx repository")
+	}
+	return &SQLXTelegramAttachmentRepository{
+		session:    tx,
+		sqlxMapper: factory.sqlxMapper,
+		logger:     factory.logger,
+	}
+}
@@ -0,0 +1,270 @@
+package repositories
+
+import (
+	"context"
+	"log/slog"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXTelegramChainRepository struct {
+	session    *sqlx.Tx
+	sqlxMapper *mappers.SqlxTelegramChainMapper
+	logger     *slog.Logger
+}
+
+func NewSQLXTelegramChainRepository(
+	session *sqlx.Tx,
+	sqlxMapper *mappers.SqlxTelegramChainMapper,
+	logger *slog.Logger,
+) repository.TelegramChainRepository {
+	tcrLogger := logger.With(
+		slog.String("component", "repository"),
+		slog.String("name", "sqlx_telegram_chain_repository"),
+	)
+	return &SQLXTelegramChainRepository{
+		session:    session,
+		sqlxMapper: sqlxMapper,
+		logger:     tcrLogger,
+	}
+}
+
+func (repo *SQLXTelegramChainRepository) LockTelegramChainHead(
+	ctx context.Context,
+	collectorUserID uuid.UUID,
+) (*domain.TelegramChainHead, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started LockTelegramChainHead request",
+		slog.String("collector_user_id", collectorUserID.String()),
+	)
+	// The head is created in the team of the transaction, the heads of the other teams aren't visible
+	insertQuery := `INSERT INTO "records"."telegram_chain_heads" (collector_user_id, sequence, entry_hash)
+	VALUES ($1, 0, $2) ON CONFLICT ON CONSTRAINT "unique_telegram_chain_head" DO NOTHING`
+	_, err := repo.session.ExecContext(ctx, insertQuery, collectorUserID, service.TelegramChainGenesisHash)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to create telegram chain head", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	var headModel models.TelegramChainHeadModel
+	query := `SELECT collector_user_id, tenant_id, sequence, entry_hash, 0 AS checkpointed_sequence
+	FROM "records"."telegram_chain_heads"
+	WHERE collector_user_id = $1 AND tenant_id IS NOT DISTINCT FROM "records".current_tenant_id() FOR UPDATE`
+	if err = repo.session.GetContext(ctx, &headModel, query, collectorUserID); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to lock telegram chain head", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	head := repo.sqlxMapper.HeadToDomain(headModel)
+	return &head, nil
+}
+
+func (repo *SQLXTelegramChainRepository) AppendTelegramChainEntries(
+	ctx context.Context,
+	entries []domain.TelegramChainEntry,
+) error {
+	repo.logger.DebugContext(ctx, "Started AppendTelegramChainEntries request", slog.Int("entry_count", len(entries)))
+	if len(entries) == 0 {
+		return nil
+	}
+	entryModels := make([]models.TelegramChainEntryModel, len(entries))
+	heads := make(map[uuid.UUID]domain.TelegramChainEntry)
+	for i, entry := range entries {
+		entryModels[i] = repo.sqlxMapper.EntryToModel(entry)
+		if entry.Sequence > heads[entry.CollectorUserID].Sequence {
+			heads[entry.CollectorUserID] = entry
+		}
+	}
+	query := `INSERT INTO "records"."telegram_chain_entries"
+	(collector_user_id, sequence, subject_type, subject_id, content_hash, previous_hash, entry_hash, added_at)
+	VALUES (:collector_user_id, :sequence, :subject_type, :subject_id, :content_hash, :previous_hash, :entry_hash,
+	:added_at)`
+	if _, err := repo.session.NamedExecContext(ctx, query, entryModels); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to add telegram chain entries", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	headQuery := `UPDATE "records"."telegram_chain_heads" SET sequence = $2, entry_hash = $3
+	WHERE collector_user_id = $1 AND tenant_id IS NOT DISTINCT FROM "records".current_tenant_id()`
+	for collectorUserID, last := range heads {
+		if _, err := repo.session.ExecContext(ctx, headQuery, collectorUserID, last.Sequence, last.EntryHash); err != nil {
+			repo.logger.ErrorContext(ctx, "Failed to move telegram chain head", slog.Any("err", err))
+			return repository.ErrDatabaseFailed
+		}
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramChainRepository) GetLatestTelegramChainContentHashes(
+	ctx context.Context,
+	subjectType domain.TelegramChainSubjectType,
+	subjectIDs []uuid.UUID,
+) (map[uuid.UUID]string, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetLatestTelegramChainContentHashes request",
+		slog.String("subject_type", string(subjectType)),
+		slog.Int("subject_count", len(subjectIDs)),
+	)
+	if len(subjectIDs) == 0 {
+		return map[uuid.UUID]string{}, nil
+	}
+	query, args, err := sqlx.In(`SELECT DISTINCT ON (subject_id) subject_id, content_hash
+	FROM "records"."telegram_chain_entries" WHERE subject_type = ? AND subject_id IN (?)
+	ORDER BY subject_id, added_at DESC, sequence DESC`, string(subjectType), subjectIDs)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to build telegram chain entries query", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	var latest []struct {
+		SubjectID   uuid.UUID `db:"subject_id"`
+		ContentHash string    `db:"content_hash"`
+	}
+	if err = repo.session.SelectContext(ctx, &latest, repo.session.Rebind(query), args...); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get latest telegram chain entries", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	contentHashes := make(map[uuid.UUID]string, len(latest))
+	for _, entry := range latest {
+		contentHashes[entry.SubjectID] = entry.ContentHash
+	}
+	return contentHashes, nil
+}
+
+func (repo *SQLXTelegramChainRepository) GetTelegramChainHeads(
+	ctx context.Context,
+) (*[]domain.TelegramChainHead, error) {
+	repo.logger.DebugContext(ctx, "Started GetTelegramChainHeads request")
+	var headModels []models.TelegramChainHeadModel
+	query := `SELECT h.collector_user_id, h.tenant_id, h.sequence, h.entry_hash,
+	COALESCE((SELECT MAX(c.sequence) FROM "records"."telegram_chain_checkpoints" c
+	WHERE c.collector_user_id = h.collector_user_id AND c.tenant_id IS NOT DISTINCT FROM h.tenant_id), 0)
+	AS checkpointed_sequence
+	FROM "records"."telegram_chain_heads" h ORDER BY h.collector_user_id, h.tenant_id NULLS FIRST`
+	if err := repo.session.SelectContext(ctx, &headModels, query); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram chain heads", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	heads := make([]domain.TelegramChainHead, len(headModels))
+	for i, headModel := range headModels {
+		heads[i] = repo.sqlxMapper.HeadToDomain(headModel)
+	}
+	return &heads, nil
+}
+
+func (repo *SQLXTelegramChainRepository) GetTelegramChainEntries(
+	ctx context.Context,
+	teamID uuid.NullUUID,
+	collectorUserID uuid.UUID,
+	afterSequence int64,
+	limit int,
+) (*[]domain.TelegramChainEntry, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramChainEntries request",
+		slog.String("collector_user_id", collectorUserID.String()),
+		slog.Int64("after_sequence", afterSequence),
+	)
+	var entryModels []models.TelegramChainEntryModel
+	query := `SELECT e.collector_user_id, e.sequence, e.subject_type, e.subject_id, e.content_hash, e.previous_hash,
+	e.entry_hash, e.added_at,
+	EXISTS (SELECT 1 FROM "records"."telegram_chain_entries" later
+	WHERE later.subject_type = e.subject_type AND later.subject_id = e.subject_id
+	AND later.collector_user_id = e.collector_user_id AND later.tenant_id IS NOT DISTINCT FROM e.tenant_id
+	AND later.sequence > e.sequence) AS superseded
+	FROM "records"."telegram_chain_entries" e
+	WHERE e.collector_user_id = $1 AND e.tenant_id IS NOT DISTINCT FROM $2 AND e.sequence > $3
+	ORDER BY e.sequence LIMIT $4`
+	err := repo.session.SelectContext(ctx, &entryModels, query, collectorUserID, teamID, afterSequence, limit)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram chain entries", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	entries := make([]domain.TelegramChainEntry, len(entryModels))
+	for i, entryModel := range entryModels {
+		entries[i] = repo.sqlxMapper.EntryToDomain(entryModel)
+	}
+	return &entries, nil
+}
+
+func (repo *SQLXTelegramChainRepository) AddTelegramChainCheckpoint(
+	ctx context.Context,
+	checkpoint *domain.TelegramChainCheckpoint,
+) error {
+	repo.logger.DebugContext(
+		ctx,
+		"Started AddTelegramChainCheckpoint request",
+		slog.String("collector_user_id", checkpoint.CollectorUserID.String()),
+		slog.Int64("sequence", checkpoint.Sequence),
+	)
+	// Another instance may have signed the same head already. The checkpoints are signed on behalf of nobody,
+	// so the tenant of the chain is set explicitly
+	query := `INSERT INTO "records"."telegram_chain_checkpoints"
+	(id, collector_user_id, tenant_id, sequence, entry_hash, public_key, signature, signed_at)
+	VALUES (:id, :collector_user_id, :tenant_id, :sequence, :entry_hash, :public_key, :signature, :signed_at)
+	ON CONFLICT ON CONSTRAINT "unique_telegram_chain_checkpoint" DO NOTHING`
+	if _, err := repo.session.NamedExecContext(ctx, query, repo.sqlxMapper.CheckpointToModel(*checkpoint)); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to add telegram chain checkpoint", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramChainRepository) GetTelegramChainCheckpoints(
+	ctx context.Context,
+	teamID uuid.NullUUID,
+	collectorUserID uuid.UUID,
+) (*[]domain.TelegramChainCheckpoint, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramChainCheckpoints request",
+		slog.String("collector_user_id", collectorUserID.String()),
+	)
+	var checkpointModels []models.TelegramChainCheckpointModel
+	query := `SELECT id, collector_user_id, tenant_id, sequence, entry_hash, public_key, signature, signed_at
+	FROM "records"."telegram_chain_checkpoints"
+	WHERE collector_user_id = $1 AND tenant_id IS NOT DISTINCT FROM $2 ORDER BY sequence`
+	if err := repo.session.SelectContext(ctx, &checkpointModels, query, collectorUserID, teamID); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram chain checkpoints", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	checkpoints := make([]domain.TelegramChainCheckpoint, len(checkpointModels))
+	for i, checkpointModel := range checkpointModels {
+		checkpoints[i] = repo.sqlxMapper.CheckpointToDomain(checkpointModel)
+	}
+	return &checkpoints, nil
+}
+
+func (repo *SQLXTelegramChainRepository) CountUnchainedTelegramSubjects(
+	ctx context.Context,
+) (map[domain.TelegramChainSubjectType]int64, error) {
+	repo.logger.DebugContext(ctx, "Started CountUnchainedTelegramSubjects request")
+	var counts []struct {
+		SubjectType string `db:"subject_type"`
+		Count       int64  `db:"count"`
+	}
+	query := `SELECT 'record' AS subject_type, COUNT(*) AS count FROM "records"."telegram_records" s
+	WHERE NOT EXISTS (SELECT 1 FROM "records"."telegram_chain_entries" e
+	WHERE e.subject_type = 'record' AND e.subject_id = s.id)
+	UNION ALL
+	SELECT 'identity', COUNT(*) FROM "records"."telegram_identities" s
+	WHERE NOT EXISTS (SELECT 1 FROM "records"."telegram_chain_entries" e
+	WHERE e.subject_type = 'identity' AND e.subject_id = s.id)
+	UNION ALL
+	SELECT 'attachment', COUNT(*) FROM "records"."telegram_attachments" s
+	WHERE NOT EXISTS (SELECT 1 FROM "records"."telegram_chain_entries" e
+	WHERE e.subject_type = 'attachment' AND e.subject_id = s.id)`
+	if err := repo.session.SelectContext(ctx, &counts, query); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to count unchained telegram subjects", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	unchained := make(map[domain.TelegramChainSubjectType]int64, len(counts))
+	for _, count := range counts {
+		unchained[domain.TelegramChainSubjectType(count.SubjectType)] = count.Count
+	}
+	return unchained, nil
+}
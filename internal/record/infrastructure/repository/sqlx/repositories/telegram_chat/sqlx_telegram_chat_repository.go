@@ -0,0 +1,199 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXTelegramChatRepository struct {
+	session    *sqlx.Tx
+	sqlxMapper *mappers.SqlxTelegramChatMapper
+	logger     *slog.Logger
+}
+
+func NewSQLXTelegramChatRepository(
+	session *sqlx.Tx,
+	sqlxMapper *mappers.SqlxTelegramChatMapper,
+	logger *slog.Logger,
+) repository.TelegramChatRepository {
+	tcrLogger := logger.With(
+		slog.String("component", "repository"),
+		slog.String("name", "sqlx_telegram_chat_repository"),
+	)
+	return &SQLXTelegramChatRepository{
+		session:    session,
+		sqlxMapper: sqlxMapper,
+		logger:     tcrLogger,
+	}
+}
+
+func (repo *SQLXTelegramChatRepository) AddChat(ctx context.Context, chat *domain.TelegramChat) error {
+	repo.logger.DebugContext(ctx, "Started AddChat request", slog.String("chat_id", chat.ID.String()))
+	chatModel := repo.sqlxMapper.ToModel(*chat)
+	query := `INSERT INTO "records"."telegram_chats" (id, chat_telegram_id, chat_type, added_at, added_by_user)
+	VALUES ($1, $2, $3, $4, $5)`
+	_, err := repo.session.ExecContext(ctx, query,
+		chatModel.ID,
+		chatModel.ChatTelegramID,
+		chatModel.ChatType,
+		chatModel.AddedAt,
+		chatModel.AddedByUser,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "unique_telegram_chat_per_user" {
+			repo.logger.InfoContext(
+				ctx,
+				"Telegram chat already added by this user",
+				slog.Int64("chat_telegram_id", chat.ChatTelegramID),
+				slog.String("constraint", pgErr.ConstraintName),
+			)
+			return domain.ErrChatAlreadyExists
+		}
+		repo.logger.ErrorContext(ctx, "Failed to add telegram chat", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramChatRepository) GetChatByTelegramID(
+	ctx context.Context,
+	chatTelegramID int64,
+	addedByUser uuid.UUID,
+) (*domain.TelegramChat, error) {
+	repo.logger.DebugContext(ctx, "Started GetChatByTelegramID request", slog.Int64("chat_telegram_id", chatTelegramID))
+	var chatModel models.TelegramChatModel
+	query := `SELECT id, chat_telegram_id, chat_type, added_at, added_by_user
+	FROM "records"."telegram_chats" WHERE chat_telegram_id = $1 AND added_by_user = $2`
+	err := repo.session.GetContext(ctx, &chatModel, query, chatTelegramID, addedByUser)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			repo.logger.InfoContext(ctx, "Telegram chat not found", slog.Int64("chat_telegram_id", chatTelegramID))
+			return nil, domain.ErrChatNotFound
+		}
+		repo.logger.ErrorContext(ctx, "Failed to get telegram chat", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	chat := repo.sqlxMapper.ToDomain(chatModel)
+	return &chat, nil
+}
+
+func (repo *SQLXTelegramChatRepository) UpdateChatType(
+	ctx context.Context,
+	chatID uuid.UUID,
+	chatType domain.TelegramChatType,
+) error {
+	repo.logger.DebugContext(ctx, "Started UpdateChatType request", slog.String("chat_id", chatID.String()))
+	query := `UPDATE "records"."telegram_chats" SET chat_type = $2 WHERE id = $1`
+	result, err := repo.session.ExecContext(ctx, query, chatID, string(chatType))
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to update telegram chat type", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get affected rows", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	if updated == 0 {
+		repo.logger.InfoContext(ctx, "Telegram chat not found", slog.String("chat_id", chatID.String()))
+		return domain.ErrChatNotFound
+	}
+	return nil
+}
+
+// AddChatSnapshot adds the snapshot unless the metadata is the same as in the latest snapshot of the chat.
+func (repo *SQLXTelegramChatRepository) AddChatSnapshot(
+	ctx context.Context,
+	snapshot *domain.TelegramChatSnapshot,
+) error {
+	repo.logger.DebugContext(ctx, "Started AddChatSnapshot request", slog.String("snapshot_id", snapshot.ID.String()))
+	snapshotModel := repo.sqlxMapper.SnapshotToModel(*snapshot)
+	query := `INSERT INTO "records"."telegram_chat_snapshots" (id, chat_id, title, username, member_count, description, added_at, added_by_user)
+	SELECT $1, $2, $3, $4, $5, $6, $7, $8
+	WHERE NOT EXISTS (
+		SELECT 1 FROM (
+			SELECT title, username, member_count, description FROM "records"."telegram_chat_snapshots"
+			WHERE chat_id = $2 ORDER BY added_at DESC, id DESC LIMIT 1
+		) latest
+		WHERE (latest.title, latest.username, latest.member_count, latest.description) = ($3, $4, $5, $6)
+	)`
+	result, err := repo.session.ExecContext(ctx, query,
+		snapshotModel.ID,
+		snapshotModel.ChatID,
+		snapshotModel.Title,
+		snapshotModel.Username,
+		snapshotModel.MemberCount,
+		snapshotModel.Description,
+		snapshotModel.AddedAt,
+		snapshotModel.AddedByUser,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.ConstraintName {
+			case "fk_telegram_chat_snapshots_chat":
+				repo.logger.InfoContext(
+					ctx,
+					"Telegram chat with that id not found",
+					slog.String("chat_id", snapshotModel.ChatID.String()),
+					slog.String("constraint_name", pgErr.ConstraintName),
+				)
+				return domain.ErrUnexistentTelegramChatReferenced
+			case "unique_telegram_chat_snapshot":
+				repo.logger.InfoContext(
+					ctx,
+					"A chat snapshot has already been captured at this time",
+					slog.String("chat_id", snapshotModel.ChatID.String()),
+					slog.String("constraint_name", pgErr.ConstraintName),
+				)
+				return domain.ErrChatSnapshotAlreadyExists
+			}
+		}
+		repo.logger.ErrorContext(ctx, "Failed to add telegram chat snapshot", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	added, err := result.RowsAffected()
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get affected rows", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	if added == 0 {
+		repo.logger.InfoContext(
+			ctx,
+			"The metadata of the chat hasn't changed since its latest snapshot",
+			slog.String("chat_id", snapshotModel.ChatID.String()),
+		)
+		return domain.ErrChatSnapshotAlreadyExists
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramChatRepository) GetChatSnapshotsByChatID(
+	ctx context.Context,
+	chatID uuid.UUID,
+) (*[]domain.TelegramChatSnapshot, error) {
+	repo.logger.DebugContext(ctx, "Started GetChatSnapshotsByChatID request", slog.String("chat_id", chatID.String()))
+	var snapshotModels []models.TelegramChatSnapshotModel
+	query := `SELECT id, chat_id, title, username, member_count, description, added_at, added_by_user
+	FROM "records"."telegram_chat_snapshots" WHERE chat_id = $1 ORDER BY added_at`
+	if err := repo.session.SelectContext(ctx, &snapshotModels, query, chatID); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram chat snapshots", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	snapshots := make([]domain.TelegramChatSnapshot, len(snapshotModels))
+	for i, snapshotModel := range snapshotModels {
+		snapshots[i] = repo.sqlxMapper.SnapshotToDomain(snapshotModel)
+	}
+	return &snapshots, nil
+}
@@ -0,0 +1,177 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+const exportColumns = `id, requested_by, selection, status, records_total, records_exported, identities_exported,
+	attachments_exported, storage_key, sha256, file_size, public_key, signature, error, created_at, started_at,
+	completed_at, tenant_id`
+
+type SQLXTelegramExportRepository struct {
+	session    *sqlx.Tx
+	sqlxMapper *mappers.SqlxTelegramExportMapper
+	logger     *slog.Logger
+}
+
+func NewSQLXTelegramExportRepository(
+	session *sqlx.Tx,
+	sqlxMapper *mappers.SqlxTelegramExportMapper,
+	logger *slog.Logger,
+) repository.TelegramExportRepository {
+	terLogger := logger.With(
+		slog.String("component", "repository"),
+		slog.String("name", "sqlx_telegram_export_repository"),
+	)
+	return &SQLXTelegramExportRepository{
+		session:    session,
+		sqlxMapper: sqlxMapper,
+		logger:     terLogger,
+	}
+}
+
+func (repo *SQLXTelegramExportRepository) AddTelegramExport(ctx context.Context, export *domain.TelegramExport) error {
+	repo.logger.DebugContext(ctx, "Started AddTelegramExport request", slog.String("export_id", export.ID.String()))
+	query := `INSERT INTO "records"."telegram_exports" (id, requested_by, selection, status, created_at)
+	VALUES (:id, :requested_by, :selection, :status, :created_at)`
+	if _, err := repo.session.NamedExecContext(ctx, query, repo.sqlxMapper.ToModel(*export)); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to add telegram export", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramExportRepository) GetTelegramExportByID(
+	ctx context.Context,
+	exportID uuid.UUID,
+) (*domain.TelegramExport, error) {
+	repo.logger.DebugContext(ctx, "Started GetTelegramExportByID request", slog.String("export_id", exportID.String()))
+	var exportModel models.TelegramExportModel
+	query := `SELECT ` + exportColumns + ` FROM "records"."telegram_exports" WHERE id = $1`
+	if err := repo.session.GetContext(ctx, &exportModel, query, exportID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrTelegramExportNotFound
+		}
+		repo.logger.ErrorContext(ctx, "Failed to get telegram export", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	export := repo.sqlxMapper.ToDomain(exportModel)
+	return &export, nil
+}
+
+func (repo *SQLXTelegramExportRepository) GetTelegramExportsByRequester(
+	ctx context.Context,
+	requestedBy uuid.UUID,
+	limit int,
+	offset int,
+) (*[]domain.TelegramExport, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramExportsByRequester request",
+		slog.String("requested_by", requestedBy.String()),
+	)
+	var exportModels []models.TelegramExportModel
+	query := `SELECT ` + exportColumns + ` FROM "records"."telegram_exports"
+	WHERE requested_by = $1 ORDER BY created_at DESC, id LIMIT $2 OFFSET $3`
+	if err := repo.session.SelectContext(ctx, &exportModels, query, requestedBy, limit, offset); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram exports", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	exports := make([]domain.TelegramExport, len(exportModels))
+	for i, exportModel := range exportModels {
+		exports[i] = repo.sqlxMapper.ToDomain(exportModel)
+	}
+	return &exports, nil
+}
+
+func (repo *SQLXTelegramExportRepository) ClaimTelegramExport(
+	ctx context.Context,
+	now, leaseUntil time.Time,
+) (*domain.TelegramExport, error) {
+	repo.logger.DebugContext(ctx, "Started ClaimTelegramExport request")
+	var exportModel models.TelegramExportModel
+	// SKIP LOCKED lets several workers claim distinct exports at once
+	query := `WITH due AS (
+		SELECT id FROM "records"."telegram_exports"
+		WHERE status = 'pending' OR (status = 'running' AND lease_until <= $1)
+		ORDER BY created_at
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	)
+	UPDATE "records"."telegram_exports" e
+	SET status = 'running', started_at = $1, lease_until = $2,
+	records_total = 0, records_exported = 0, identities_exported = 0, attachments_exported = 0
+	FROM due
+	WHERE e.id = due.id
+	RETURNING ` + exportColumns
+	if err := repo.session.GetContext(ctx, &exportModel, query, now, leaseUntil); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrTelegramExportNotFound
+		}
+		repo.logger.ErrorContext(ctx, "Failed to claim telegram export", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	export := repo.sqlxMapper.ToDomain(exportModel)
+	return &export, nil
+}
+
+func (repo *SQLXTelegramExportRepository) UpdateTelegramExportProgress(
+	ctx context.Context,
+	exportID uuid.UUID,
+	progress domain.TelegramExportProgress,
+	leaseUntil time.Time,
+) error {
+	repo.logger.DebugContext(
+		ctx,
+		"Started UpdateTelegramExportProgress request",
+		slog.String("export_id", exportID.String()),
+	)
+	query := `UPDATE "records"."telegram_exports"
+	SET records_total = $2, records_exported = $3, identities_exported = $4, attachments_exported = $5,
+	lease_until = $6
+	WHERE id = $1`
+	_, err := repo.session.ExecContext(
+		ctx,
+		query,
+		exportID,
+		progress.RecordsTotal,
+		progress.RecordsExported,
+		progress.IdentitiesExported,
+		progress.AttachmentsExported,
+		leaseUntil,
+	)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to update telegram export progress", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramExportRepository) FinishTelegramExport(
+	ctx context.Context,
+	export *domain.TelegramExport,
+) error {
+	repo.logger.DebugContext(ctx, "Started FinishTelegramExport request", slog.String("export_id", export.ID.String()))
+	query := `UPDATE "records"."telegram_exports"
+	SET status = :status, records_total = :records_total, records_exported = :records_exported,
+	identities_exported = :identities_exported, attachments_exported = :attachments_exported,
+	storage_key = :storage_key, sha256 = :sha256, file_size = :file_size, public_key = :public_key,
+	signature = :signature, error = :error, completed_at = :completed_at, lease_until = NULL
+	WHERE id = :id`
+	if _, err := repo.session.NamedExecContext(ctx, query, repo.sqlxMapper.ToModel(*export)); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to finish telegram export", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return nil
+}
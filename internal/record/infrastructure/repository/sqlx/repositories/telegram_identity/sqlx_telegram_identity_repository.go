@@ -4,10 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"log/slog"
 
 	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
 	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/cursor"
 	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
 	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
 	"github.com/google/uuid"
@@ -125,3 +127,179 @@ func (repo *SQLXTelegramIdentityRepository) GetIdentityByID(
 	identity := repo.sqlxMapper.ToDomain(identityModel)
 	return &identity, nil
 }
+
+func (repo *SQLXTelegramIdentityRepository) GetIdentitiesByIDs(
+	ctx context.Context,
+	identityIDs []uuid.UUID,
+) (*[]domain.TelegramIdentity, error) {
+	repo.logger.DebugContext(ctx, "Started GetIdentitiesByIDs request", slog.Int("identity_count", len(identityIDs)))
+	if len(identityIDs) == 0 {
+		return &[]domain.TelegramIdentity{}, nil
+	}
+	query, args, err := sqlx.In(`SELECT id, user_id, first_name, last_name, username, phone_number, bio,
+	added_at, added_by_user
+	FROM "records"."telegram_identities" WHERE id IN (?) ORDER BY id`, identityIDs)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to build telegram identities query", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	var identityModels []models.TelegramIdentityModel
+	if err = repo.session.SelectContext(ctx, &identityModels, repo.session.Rebind(query), args...); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram identities", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	identities := make([]domain.TelegramIdentity, len(identityModels))
+	for i, identityModel := range identityModels {
+		identities[i] = repo.sqlxMapper.ToDomain(identityModel)
+	}
+	return &identities, nil
+}
+
+func (repo *SQLXTelegramIdentityRepository) GetIdentitiesByUserIDs(
+	ctx context.Context,
+	userIDs []uuid.UUID,
+) (*[]domain.TelegramIdentity, error) {
+	repo.logger.DebugContext(ctx, "Started GetIdentitiesByUserIDs request", slog.Int("user_count", len(userIDs)))
+	if len(userIDs) == 0 {
+		return &[]domain.TelegramIdentity{}, nil
+	}
+	query, args, err := sqlx.In(`SELECT id, user_id, first_name, last_name, username, phone_number, bio,
+	added_at, added_by_user
+	FROM "records"."telegram_identities" WHERE user_id IN (?) AND "records".is_identity_visible(id)
+	ORDER BY id`, userIDs)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to build telegram identities query", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	var identityModels []models.TelegramIdentityModel
+	if err = repo.session.SelectContext(ctx, &identityModels, repo.session.Rebind(query), args...); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram identities", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	identities := make([]domain.TelegramIdentity, len(identityModels))
+	for i, identityModel := range identityModels {
+		identities[i] = repo.sqlxMapper.ToDomain(identityModel)
+	}
+	return &identities, nil
+}
+
+func (repo *SQLXTelegramIdentityRepository) StreamIdentitiesByUserTelegramID(
+	ctx context.Context,
+	userTelegramID uint64,
+	tag string,
+	yield func(*domain.TelegramIdentity) error,
+) error {
+	repo.logger.DebugContext(
+		ctx,
+		"Started StreamIdentitiesByUserTelegramID request",
+		slog.Uint64("user_telegram_id", userTelegramID),
+	)
+	query := `SELECT i.id, i.user_id, i.first_name, i.last_name, i.username, i.phone_number, i.bio,
+	i.added_at, i.added_by_user
+	FROM "records"."telegram_identities" i
+	JOIN "records"."telegram_users" u ON u.id = i.user_id
+	WHERE u.telegram_id = $1 AND ($2 = '' OR EXISTS (
+		SELECT 1 FROM "records"."telegram_tags" t WHERE t.telegram_identity_id = i.id AND t.name = $2
+	)) AND "records".is_identity_visible(i.id)
+	ORDER BY i.added_at, i.id`
+	args := []any{userTelegramID, tag}
+	err := cursor.Stream(ctx, repo.session, query, args, func(identity *models.TelegramIdentityModel) error {
+		domainIdentity := repo.sqlxMapper.ToDomain(*identity)
+		return yield(&domainIdentity)
+	})
+	if errors.Is(err, repository.ErrDatabaseFailed) {
+		repo.logger.ErrorContext(ctx, "Failed to stream telegram identities", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return err
+}
+
+func (repo *SQLXTelegramIdentityRepository) GetTelegramCorrelationClusters(
+	ctx context.Context,
+	correlationTypes []domain.TelegramCorrelationType,
+	userTelegramID uint64,
+	limit int,
+	offset int,
+) (*[]domain.TelegramCorrelationCluster, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramCorrelationClusters request",
+		slog.Any("correlation_types", correlationTypes),
+		slog.Uint64("user_telegram_id", userTelegramID),
+	)
+	// The values are normalised exactly the way they're indexed, phones keep their digits
+	// and bios are compared ignoring case and whitespace.
+	// Only the values of the user are looked up when one is given, the others can't form clusters with them.
+	// The identities the caller doesn't see are left out of the clusters.
+	phoneValue := fmt.Sprintf(telegramIdentityPhoneExpression, "i")
+	bioValue := fmt.Sprintf(telegramIdentityBioExpression, "i")
+	usernameValue := fmt.Sprintf(telegramIdentityUsernameExpression, "i")
+	var phoneFilter, usernameFilter, bioFilter string
+	var userArgs []any
+	if userTelegramID != 0 {
+		phoneFilter = correlationUserFilter(telegramIdentityPhoneExpression)
+		usernameFilter = correlationUserFilter(telegramIdentityUsernameExpression)
+		bioFilter = correlationUserFilter(telegramIdentityBioExpression)
+		userArgs = []any{userTelegramID, userTelegramID, userTelegramID}
+	}
+	query, args, err := sqlx.In(`WITH keyed AS (
+		SELECT 'phone' AS correlation_type, `+phoneValue+` AS value,
+		u.telegram_id AS user_telegram_id, i.id AS identity_id, i.added_at
+		FROM "records"."telegram_identities" i
+		JOIN "records"."telegram_users" u ON u.id = i.user_id
+		WHERE "records".is_identity_visible(i.id) AND LENGTH(`+phoneValue+`) >= 7`+phoneFilter+`
+		UNION ALL
+		SELECT 'username', `+usernameValue+`, u.telegram_id, i.id, i.added_at
+		FROM "records"."telegram_identities" i
+		JOIN "records"."telegram_users" u ON u.id = i.user_id
+		WHERE "records".is_identity_visible(i.id) AND i.username <> ''`+usernameFilter+`
+		UNION ALL
+		SELECT 'bio', `+bioValue+`, u.telegram_id, i.id, i.added_at
+		FROM "records"."telegram_identities" i
+		JOIN "records"."telegram_users" u ON u.id = i.user_id
+		WHERE "records".is_identity_visible(i.id) AND TRIM(i.bio) <> ''`+bioFilter+`
+	), clusters AS (
+		SELECT correlation_type, value, COUNT(DISTINCT user_telegram_id) AS user_count
+		FROM keyed
+		WHERE correlation_type IN (?)
+		GROUP BY correlation_type, value
+		HAVING COUNT(DISTINCT user_telegram_id) > 1
+		ORDER BY user_count DESC, correlation_type, value
+		LIMIT ? OFFSET ?
+	)
+	SELECT k.correlation_type,
+	CASE WHEN k.correlation_type = 'phone' THEN '+' || k.value ELSE k.value END AS value,
+	k.user_telegram_id, k.identity_id, k.added_at
+	FROM clusters c
+	JOIN keyed k ON k.correlation_type = c.correlation_type AND k.value = c.value
+	ORDER BY c.user_count DESC, c.correlation_type, c.value, k.user_telegram_id, k.added_at, k.identity_id`,
+		append(userArgs, correlationTypes, limit, offset)...)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to build telegram correlation query", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	var evidenceModels []models.TelegramCorrelationEvidenceModel
+	if err = repo.session.SelectContext(ctx, &evidenceModels, repo.session.Rebind(query), args...); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram correlation clusters", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	clusters := repo.sqlxMapper.EvidenceToDomain(evidenceModels)
+	return &clusters, nil
+}
+
+// The expressions identity values are correlated by, formatted with the alias of the identities table.
+// They have to match the ones of the indexes of the migrations to use them.
+const (
+	telegramIdentityPhoneExpression    = `REGEXP_REPLACE(%s.phone_number, '\D', '', 'g')`
+	telegramIdentityUsernameExpression = `LOWER(%s.username)`
+	telegramIdentityBioExpression      = `LOWER(REGEXP_REPLACE(TRIM(%s.bio), '\s+', ' ', 'g'))`
+)
+
+// correlationUserFilter keeps the identities sharing the value of the expression with an identity of the user.
+func correlationUserFilter(expression string) string {
+	return fmt.Sprintf(` AND %s IN (
+			SELECT %s FROM "records"."telegram_identities" ui
+			JOIN "records"."telegram_users" uu ON uu.id = ui.user_id
+			WHERE uu.telegram_id = ? AND "records".is_identity_visible(ui.id)
+		)`, fmt.Sprintf(expression, "i"), fmt.Sprintf(expression, "ui"))
+}
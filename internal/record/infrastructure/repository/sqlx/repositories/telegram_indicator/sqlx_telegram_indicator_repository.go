@@ -0,0 +1,104 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
+)
+
+// maxIndicatorsPerInsert keeps a bulk insert within the 65535 bind parameters Postgres accepts per statement.
+const maxIndicatorsPerInsert = 5000
+
+type SQLXTelegramIndicatorRepository struct {
+	session    *sqlx.Tx
+	sqlxMapper *mappers.SqlxTelegramIndicatorMapper
+	logger     *slog.Logger
+}
+
+func NewSQLXTelegramIndicatorRepository(
+	session *sqlx.Tx,
+	sqlxMapper *mappers.SqlxTelegramIndicatorMapper,
+	logger *slog.Logger,
+) repository.TelegramIndicatorRepository {
+	tirLogger := logger.With(
+		slog.String("component", "repository"),
+		slog.String("name", "sqlx_telegram_indicator_repository"),
+	)
+	return &SQLXTelegramIndicatorRepository{
+		session:    session,
+		sqlxMapper: sqlxMapper,
+		logger:     tirLogger,
+	}
+}
+
+func (repo *SQLXTelegramIndicatorRepository) AddTelegramIndicators(
+	ctx context.Context,
+	indicators []domain.TelegramIndicator,
+) error {
+	repo.logger.DebugContext(
+		ctx,
+		"Started AddTelegramIndicators request",
+		slog.Int("indicator_count", len(indicators)),
+	)
+	if len(indicators) == 0 {
+		return nil
+	}
+	indicatorModels := make([]models.TelegramIndicatorModel, len(indicators))
+	for i, indicator := range indicators {
+		indicatorModels[i] = repo.sqlxMapper.ToModel(indicator)
+	}
+	query := `INSERT INTO "records"."telegram_indicators" (id, record_id, indicator_type, value, added_at, added_by_user)
+	VALUES (:id, :record_id, :indicator_type, :value, :added_at, :added_by_user)
+	ON CONFLICT ON CONSTRAINT "unique_telegram_indicator_per_record" DO NOTHING`
+	for chunk := range slices.Chunk(indicatorModels, maxIndicatorsPerInsert) {
+		_, err := repo.session.NamedExecContext(ctx, query, chunk)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.ConstraintName == "fk_telegram_indicators_record" {
+				repo.logger.InfoContext(ctx, "Indicators reference a record that doesn't exist")
+				return domain.ErrUnexistentTelegramRecordReferenced
+			}
+			repo.logger.ErrorContext(ctx, "Failed to add telegram indicators", slog.Any("err", err))
+			return repository.ErrDatabaseFailed
+		}
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramIndicatorRepository) GetTelegramIndicatorsByUserTelegramID(
+	ctx context.Context,
+	userTelegramID uint64,
+) (*[]domain.TelegramIndicatorSummary, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramIndicatorsByUserTelegramID request",
+		slog.Uint64("user_telegram_id", userTelegramID),
+	)
+	var summaryModels []models.TelegramIndicatorSummaryModel
+	query := `SELECT i.indicator_type, i.value, COUNT(DISTINCT r.id) AS record_count,
+	MIN(r.posted_at) AS first_seen_at, MAX(r.posted_at) AS last_seen_at
+	FROM "records"."telegram_indicators" i
+	JOIN "records"."telegram_records" r ON r.id = i.record_id
+	JOIN "records"."telegram_users" u ON u.id = r.from_telegram_user_id
+	WHERE u.telegram_id = $1
+	GROUP BY i.indicator_type, i.value
+	ORDER BY record_count DESC, last_seen_at DESC`
+	err := repo.session.SelectContext(ctx, &summaryModels, query, userTelegramID)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram indicators", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	summaries := make([]domain.TelegramIndicatorSummary, len(summaryModels))
+	for i, summaryModel := range summaryModels {
+		summaries[i] = repo.sqlxMapper.SummaryToDomain(summaryModel)
+	}
+	return &summaries, nil
+}
@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXTelegramIndicatorRepositoryFactory struct {
+	logger     *slog.Logger
+	sqlxMapper *mappers.SqlxTelegramIndicatorMapper
+}
+
+func NewSQLXTelegramIndicatorRepositoryFactory(
+	logger *slog.Logger,
+	mapper *mappers.SqlxTelegramIndicatorMapper,
+) repository.TelegramIndicatorRepositoryFactory {
+	return &SQLXTelegramIndicatorRepositoryFactory{
+		logger:     logger,
+		sqlxMapper: mapper,
+	}
+}
+
+func (factory *SQLXTelegramIndicatorRepositoryFactory) CreateTelegramIndicatorRepositoryWithTransaction(
+	tm interfaces.TransactionManager,
+) repository.TelegramIndicatorRepository {
+	tx, ok := tm.GetTransaction().(*sqlx.Tx)
+	if !ok {
+		factory.logger.Error("invalid transaction type, expected *sqlx.Tx")
+		panic("invalid transaction type for sqlx repository")
+	}
+	return &SQLXTelegramIndicatorRepository{
+		session:    tx,
+		sqlxMapper: factory.sqlxMapper,
+		logger:     factory.logger,
+	}
+}
@@ -0,0 +1,215 @@
+package repositories
+
+import (
+	"context"
+	"log/slog"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// maxSharedChatMembers is the size above which a chat no longer links its members with shared_chat interactions:
+// every member pair would be an edge, telling little about how close they are.
+const maxSharedChatMembers = 500
+
+// interactionVisibilityFilter keeps the interactions derived from records visible to the caller of the transaction,
+// both the record and the one it relates to, so that the weights only count what the caller sees.
+const interactionVisibilityFilter = `"records".is_record_visible(record_id)
+	AND (related_record_id IS NULL OR "records".is_record_visible(related_record_id))`
+
+type SQLXTelegramInteractionRepository struct {
+	session    *sqlx.Tx
+	sqlxMapper *mappers.SqlxTelegramInteractionMapper
+	logger     *slog.Logger
+}
+
+func NewSQLXTelegramInteractionRepository(
+	session *sqlx.Tx,
+	sqlxMapper *mappers.SqlxTelegramInteractionMapper,
+	logger *slog.Logger,
+) repository.TelegramInteractionRepository {
+	tirLogger := logger.With(
+		slog.String("component", "repository"),
+		slog.String("name", "sqlx_telegram_interaction_repository"),
+	)
+	return &SQLXTelegramInteractionRepository{
+		session:    session,
+		sqlxMapper: sqlxMapper,
+		logger:     tirLogger,
+	}
+}
+
+func (repo *SQLXTelegramInteractionRepository) DeriveTelegramInteractions(
+	ctx context.Context,
+	recordID uuid.UUID,
+) error {
+	repo.logger.DebugContext(ctx, "Started DeriveTelegramInteractions request", slog.String("record_id", recordID.String()))
+	// The marked record is still unmarked for the other subqueries of the statement, they all see the same snapshot.
+	// A shared chat is only counted for the first record of the sender in the chat, as long as the chat is small,
+	// and is related to the first record of the other member.
+	query := `WITH derived AS (
+		UPDATE "records"."telegram_records" r SET interactions_derived_at = CURRENT_TIMESTAMP
+		FROM "records"."telegram_users" u
+		WHERE r.id = $1 AND r.interactions_derived_at IS NULL AND u.id = r.from_telegram_user_id
+		RETURNING r.id, r.in_telegram_chat_id, r.reply_to_message_telegram_id, r.posted_at, r.added_by_user,
+		u.telegram_id
+	), replies AS (
+		SELECT d.telegram_id AS from_id, pu.telegram_id AS to_id, 'reply' AS interaction_type, d.posted_at,
+		p.id AS related_record_id
+		FROM derived d
+		JOIN "records"."telegram_records" p ON p.in_telegram_chat_id = d.in_telegram_chat_id
+		AND p.message_telegram_id = d.reply_to_message_telegram_id AND p.added_by_user = d.added_by_user
+		JOIN "records"."telegram_users" pu ON pu.id = p.from_telegram_user_id
+	), mentions AS (
+		SELECT DISTINCT d.telegram_id AS from_id, mu.telegram_id AS to_id, 'mention' AS interaction_type, d.posted_at,
+		NULL::UUID AS related_record_id
+		FROM derived d
+		JOIN "records"."telegram_indicators" i ON i.record_id = d.id AND i.indicator_type = 'mention'
+		JOIN "records"."telegram_identities" ti ON LOWER(ti.username) = i.value
+		JOIN "records"."telegram_users" mu ON mu.id = ti.user_id
+	), members AS (
+		SELECT DISTINCT ON (ou.telegram_id) d.telegram_id AS from_id, ou.telegram_id AS to_id, d.posted_at,
+		o.id AS related_record_id
+		FROM derived d
+		JOIN "records"."telegram_records" o ON o.in_telegram_chat_id = d.in_telegram_chat_id
+		AND o.interactions_derived_at IS NOT NULL
+		JOIN "records"."telegram_users" ou ON ou.id = o.from_telegram_user_id
+		WHERE NOT EXISTS (
+			SELECT 1 FROM "records"."telegram_records" s
+			JOIN "records"."telegram_users" su ON su.id = s.from_telegram_user_id
+			WHERE s.in_telegram_chat_id = d.in_telegram_chat_id AND su.telegram_id = d.telegram_id
+			AND s.interactions_derived_at IS NOT NULL
+		)
+		AND (
+			SELECT COUNT(*) FROM (
+				SELECT DISTINCT m.from_telegram_user_id FROM "records"."telegram_records" m
+				WHERE m.in_telegram_chat_id = d.in_telegram_chat_id AND m.interactions_derived_at IS NOT NULL
+				LIMIT $2 + 1
+			) chat_members
+		) <= $2
+		ORDER BY ou.telegram_id, o.interactions_derived_at, o.id
+	), interactions AS (
+		SELECT from_id, to_id, interaction_type, posted_at, related_record_id FROM replies
+		UNION ALL
+		SELECT from_id, to_id, interaction_type, posted_at, related_record_id FROM mentions
+		UNION ALL
+		SELECT from_id, to_id, 'shared_chat', posted_at, related_record_id FROM members
+		UNION ALL
+		SELECT to_id, from_id, 'shared_chat', posted_at, related_record_id FROM members
+	)
+	INSERT INTO "records"."telegram_user_interactions" (record_id, related_record_id, from_user_telegram_id,
+	to_user_telegram_id, interaction_type, weight, first_interaction_at, last_interaction_at)
+	SELECT $1, related_record_id, from_id, to_id, interaction_type, COUNT(*), MIN(posted_at), MAX(posted_at)
+	FROM interactions WHERE from_id <> to_id
+	GROUP BY related_record_id, from_id, to_id, interaction_type`
+	if _, err := repo.session.ExecContext(ctx, query, recordID, maxSharedChatMembers); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to derive telegram interactions", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramInteractionRepository) GetTelegramInteractionGraph(
+	ctx context.Context,
+	userTelegramID uint64,
+	depth int,
+	maxNodes int,
+) (*domain.TelegramInteractionGraph, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramInteractionGraph request",
+		slog.Uint64("user_telegram_id", userTelegramID),
+		slog.Int("depth", depth),
+	)
+	// Interactions are walked in both directions, a user replied to is as close as the one replying.
+	// The walk goes a level at a time, so the limit bounds every step and not only the result.
+	depths := map[uint64]int{userTelegramID: 0}
+	nodeIDs := []uint64{userTelegramID}
+	frontier := []uint64{userTelegramID}
+	for level := 1; level <= depth && len(frontier) > 0 && len(nodeIDs) < maxNodes; level++ {
+		neighboursQuery, args, err := sqlx.In(`SELECT user_telegram_id FROM (
+			SELECT to_user_telegram_id AS user_telegram_id FROM "records"."telegram_user_interactions"
+			WHERE from_user_telegram_id IN (?) AND `+interactionVisibilityFilter+`
+			UNION
+			SELECT from_user_telegram_id FROM "records"."telegram_user_interactions"
+			WHERE to_user_telegram_id IN (?) AND `+interactionVisibilityFilter+`
+		) n
+		WHERE user_telegram_id NOT IN (?)
+		ORDER BY user_telegram_id LIMIT ?`, frontier, frontier, nodeIDs, maxNodes-len(nodeIDs))
+		if err != nil {
+			repo.logger.ErrorContext(ctx, "Failed to build telegram interaction graph query", slog.Any("err", err))
+			return nil, repository.ErrDatabaseFailed
+		}
+		var neighbours []uint64
+		if err = repo.session.SelectContext(ctx, &neighbours, repo.session.Rebind(neighboursQuery), args...); err != nil {
+			repo.logger.ErrorContext(ctx, "Failed to get telegram interaction graph nodes", slog.Any("err", err))
+			return nil, repository.ErrDatabaseFailed
+		}
+		for _, neighbour := range neighbours {
+			depths[neighbour] = level
+		}
+		nodeIDs = append(nodeIDs, neighbours...)
+		frontier = neighbours
+	}
+
+	identitiesQuery, args, err := sqlx.In(`SELECT DISTINCT ON (u.telegram_id) u.telegram_id AS user_telegram_id,
+	i.username, i.first_name, i.last_name
+	FROM "records"."telegram_users" u
+	JOIN "records"."telegram_identities" i ON i.user_id = u.id
+	WHERE u.telegram_id IN (?) AND "records".is_identity_visible(i.id)
+	ORDER BY u.telegram_id, i.added_at DESC`, nodeIDs)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to build telegram interaction graph query", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	var identityModels []models.TelegramInteractionGraphNodeModel
+	if err = repo.session.SelectContext(ctx, &identityModels, repo.session.Rebind(identitiesQuery), args...); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram interaction graph nodes", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	identities := make(map[uint64]models.TelegramInteractionGraphNodeModel, len(identityModels))
+	for _, identityModel := range identityModels {
+		identities[identityModel.UserTelegramID] = identityModel
+	}
+	// The nodes are ordered by depth, then by ID, as each level is
+	graph := &domain.TelegramInteractionGraph{
+		Nodes: make([]domain.TelegramInteractionGraphNode, len(nodeIDs)),
+		Edges: []domain.TelegramInteraction{},
+	}
+	for i, nodeID := range nodeIDs {
+		nodeModel := identities[nodeID]
+		nodeModel.UserTelegramID = nodeID
+		nodeModel.Depth = depths[nodeID]
+		graph.Nodes[i] = repo.sqlxMapper.NodeToDomain(nodeModel)
+	}
+	if len(nodeIDs) < 2 {
+		return graph, nil
+	}
+
+	// The interactions are kept per record, an edge sums up the ones of a user pair
+	edgesQuery, edgesArgs, err := sqlx.In(`SELECT from_user_telegram_id, to_user_telegram_id, interaction_type,
+	SUM(weight)::BIGINT AS weight, MIN(first_interaction_at) AS first_interaction_at,
+	MAX(last_interaction_at) AS last_interaction_at
+	FROM "records"."telegram_user_interactions"
+	WHERE from_user_telegram_id IN (?) AND to_user_telegram_id IN (?) AND `+interactionVisibilityFilter+`
+	GROUP BY from_user_telegram_id, to_user_telegram_id, interaction_type
+	ORDER BY from_user_telegram_id, to_user_telegram_id, interaction_type`, nodeIDs, nodeIDs)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to build telegram interaction graph query", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	var edgeModels []models.TelegramInteractionModel
+	if err = repo.session.SelectContext(ctx, &edgeModels, repo.session.Rebind(edgesQuery), edgesArgs...); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram interaction graph edges", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	graph.Edges = make([]domain.TelegramInteraction, len(edgeModels))
+	for i, edgeModel := range edgeModels {
+		graph.Edges[i] = repo.sqlxMapper.ToDomain(edgeModel)
+	}
+	return graph, nil
+}
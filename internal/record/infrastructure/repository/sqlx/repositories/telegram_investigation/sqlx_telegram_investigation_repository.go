@@ -0,0 +1,388 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	investigationColumns = `id, name, description, created_by, created_at`
+	memberColumns        = `id, investigation_id, user_id, role, added_by, added_at`
+	itemColumns          = `id, investigation_id, item_type, telegram_user_id, telegram_chat_id, telegram_record_id,
+	note, added_by, added_at`
+)
+
+type SQLXTelegramInvestigationRepository struct {
+	session    *sqlx.Tx
+	sqlxMapper *mappers.SqlxTelegramInvestigationMapper
+	logger     *slog.Logger
+}
+
+func NewSQLXTelegramInvestigationRepository(
+	session *sqlx.Tx,
+	sqlxMapper *mappers.SqlxTelegramInvestigationMapper,
+	logger *slog.Logger,
+) repository.TelegramInvestigationRepository {
+	tirLogger := logger.With(
+		slog.String("component", "repository"),
+		slog.String("name", "sqlx_telegram_investigation_repository"),
+	)
+	return &SQLXTelegramInvestigationRepository{
+		session:    session,
+		sqlxMapper: sqlxMapper,
+		logger:     tirLogger,
+	}
+}
+
+func (repo *SQLXTelegramInvestigationRepository) AddTelegramInvestigation(
+	ctx context.Context,
+	investigation *domain.TelegramInvestigation,
+) error {
+	repo.logger.DebugContext(
+		ctx,
+		"Started AddTelegramInvestigation request",
+		slog.String("investigation_id", investigation.ID.String()),
+	)
+	query := `INSERT INTO "records"."telegram_investigations" (id, name, description, created_by, created_at)
+	VALUES (:id, :name, :description, :created_by, :created_at)`
+	if _, err := repo.session.NamedExecContext(ctx, query, repo.sqlxMapper.ToModel(*investigation)); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to add telegram investigation", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramInvestigationRepository) GetTelegramInvestigationByID(
+	ctx context.Context,
+	investigationID uuid.UUID,
+) (*domain.TelegramInvestigation, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramInvestigationByID request",
+		slog.String("investigation_id", investigationID.String()),
+	)
+	query := `SELECT ` + investigationColumns + ` FROM "records"."telegram_investigations" WHERE id = $1`
+	return repo.getInvestigation(ctx, query, investigationID)
+}
+
+func (repo *SQLXTelegramInvestigationRepository) LockTelegramInvestigation(
+	ctx context.Context,
+	investigationID uuid.UUID,
+) (*domain.TelegramInvestigation, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started LockTelegramInvestigation request",
+		slog.String("investigation_id", investigationID.String()),
+	)
+	query := `SELECT ` + investigationColumns + ` FROM "records"."telegram_investigations" WHERE id = $1 FOR UPDATE`
+	return repo.getInvestigation(ctx, query, investigationID)
+}
+
+func (repo *SQLXTelegramInvestigationRepository) getInvestigation(
+	ctx context.Context,
+	query string,
+	investigationID uuid.UUID,
+) (*domain.TelegramInvestigation, error) {
+	var investigationModel models.TelegramInvestigationModel
+	if err := repo.session.GetContext(ctx, &investigationModel, query, investigationID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrInvestigationNotFound
+		}
+		repo.logger.ErrorContext(ctx, "Failed to get telegram investigation", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	investigation := repo.sqlxMapper.ToDomain(investigationModel)
+	return &investigation, nil
+}
+
+func (repo *SQLXTelegramInvestigationRepository) GetTelegramInvestigationsByMember(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*[]domain.TelegramInvestigation, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramInvestigationsByMember request",
+		slog.String("user_id", userID.String()),
+	)
+	var investigationModels []models.TelegramInvestigationModel
+	query := `SELECT i.id, i.name, i.description, i.created_by, i.created_at
+	FROM "records"."telegram_investigations" i
+	JOIN "records"."telegram_investigation_members" m ON m.investigation_id = i.id
+	WHERE m.user_id = $1
+	ORDER BY i.created_at DESC, i.id`
+	if err := repo.session.SelectContext(ctx, &investigationModels, query, userID); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram investigations", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	var memberModels []models.TelegramInvestigationMemberModel
+	membersQuery := `SELECT o.id, o.investigation_id, o.user_id, o.role, o.added_by, o.added_at
+	FROM "records"."telegram_investigation_members" o
+	JOIN "records"."telegram_investigation_members" m ON m.investigation_id = o.investigation_id
+	WHERE m.user_id = $1
+	ORDER BY o.added_at, o.id`
+	if err := repo.session.SelectContext(ctx, &memberModels, membersQuery, userID); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram investigation members", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+
+	investigations := make([]domain.TelegramInvestigation, len(investigationModels))
+	investigationIndexes := make(map[uuid.UUID]int, len(investigationModels))
+	for i, investigationModel := range investigationModels {
+		investigations[i] = repo.sqlxMapper.ToDomain(investigationModel)
+		investigations[i].Members = make([]domain.TelegramInvestigationMember, 0)
+		investigationIndexes[investigationModel.ID] = i
+	}
+	for _, memberModel := range memberModels {
+		i := investigationIndexes[memberModel.InvestigationID]
+		investigations[i].Members = append(investigations[i].Members, repo.sqlxMapper.MemberToDomain(memberModel))
+	}
+	return &investigations, nil
+}
+
+func (repo *SQLXTelegramInvestigationRepository) RemoveTelegramInvestigation(
+	ctx context.Context,
+	investigationID uuid.UUID,
+) error {
+	repo.logger.DebugContext(
+		ctx,
+		"Started RemoveTelegramInvestigation request",
+		slog.String("investigation_id", investigationID.String()),
+	)
+	query := `DELETE FROM "records"."telegram_investigations" WHERE id = $1`
+	result, err := repo.session.ExecContext(ctx, query, investigationID)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to remove telegram investigation", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get rows affected", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	if rowsAffected == 0 {
+		return domain.ErrInvestigationNotFound
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramInvestigationRepository) GetTelegramInvestigationMember(
+	ctx context.Context,
+	investigationID uuid.UUID,
+	userID uuid.UUID,
+) (*domain.TelegramInvestigationMember, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramInvestigationMember request",
+		slog.String("investigation_id", investigationID.String()),
+		slog.String("user_id", userID.String()),
+	)
+	var memberModel models.TelegramInvestigationMemberModel
+	query := `SELECT ` + memberColumns + ` FROM "records"."telegram_investigation_members"
+	WHERE investigation_id = $1 AND user_id = $2`
+	if err := repo.session.GetContext(ctx, &memberModel, query, investigationID, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrInvestigationMemberNotFound
+		}
+		repo.logger.ErrorContext(ctx, "Failed to get telegram investigation member", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	member := repo.sqlxMapper.MemberToDomain(memberModel)
+	return &member, nil
+}
+
+func (repo *SQLXTelegramInvestigationRepository) GetTelegramInvestigationMembers(
+	ctx context.Context,
+	investigationID uuid.UUID,
+) (*[]domain.TelegramInvestigationMember, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramInvestigationMembers request",
+		slog.String("investigation_id", investigationID.String()),
+	)
+	var memberModels []models.TelegramInvestigationMemberModel
+	query := `SELECT ` + memberColumns + ` FROM "records"."telegram_investigation_members"
+	WHERE investigation_id = $1
+	ORDER BY added_at, id`
+	if err := repo.session.SelectContext(ctx, &memberModels, query, investigationID); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram investigation members", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	members := make([]domain.TelegramInvestigationMember, len(memberModels))
+	for i, memberModel := range memberModels {
+		members[i] = repo.sqlxMapper.MemberToDomain(memberModel)
+	}
+	return &members, nil
+}
+
+func (repo *SQLXTelegramInvestigationRepository) SaveTelegramInvestigationMember(
+	ctx context.Context,
+	member *domain.TelegramInvestigationMember,
+) error {
+	repo.logger.DebugContext(
+		ctx,
+		"Started SaveTelegramInvestigationMember request",
+		slog.String("investigation_id", member.InvestigationID.String()),
+		slog.String("user_id", member.UserID.String()),
+	)
+	query := `INSERT INTO "records"."telegram_investigation_members"
+	(id, investigation_id, user_id, role, added_by, added_at)
+	VALUES (:id, :investigation_id, :user_id, :role, :added_by, :added_at)
+	ON CONFLICT ON CONSTRAINT "unique_telegram_investigation_member" DO UPDATE SET role = EXCLUDED.role`
+	if _, err := repo.session.NamedExecContext(ctx, query, repo.sqlxMapper.MemberToModel(*member)); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "fk_telegram_investigation_members_investigation" {
+			repo.logger.InfoContext(ctx, "Telegram investigation member references an investigation that doesn't exist")
+			return domain.ErrInvestigationNotFound
+		}
+		repo.logger.ErrorContext(ctx, "Failed to save telegram investigation member", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramInvestigationRepository) RemoveTelegramInvestigationMember(
+	ctx context.Context,
+	investigationID uuid.UUID,
+	userID uuid.UUID,
+) (*domain.TelegramInvestigationMember, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started RemoveTelegramInvestigationMember request",
+		slog.String("investigation_id", investigationID.String()),
+		slog.String("user_id", userID.String()),
+	)
+	var memberModel models.TelegramInvestigationMemberModel
+	query := `DELETE FROM "records"."telegram_investigation_members" WHERE investigation_id = $1 AND user_id = $2
+	RETURNING ` + memberColumns
+	if err := repo.session.GetContext(ctx, &memberModel, query, investigationID, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrInvestigationMemberNotFound
+		}
+		repo.logger.ErrorContext(ctx, "Failed to remove telegram investigation member", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	member := repo.sqlxMapper.MemberToDomain(memberModel)
+	return &member, nil
+}
+
+func (repo *SQLXTelegramInvestigationRepository) RemoveTelegramInvestigationMembershipsByUser(
+	ctx context.Context,
+	userID uuid.UUID,
+) (int64, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started RemoveTelegramInvestigationMembershipsByUser request",
+		slog.String("user_id", userID.String()),
+	)
+	// Nobody could reach an investigation left without members anymore. The statements of the query see
+	// the memberships as they were before it, hence the removed ones are excluded explicitly.
+	query := `WITH removed AS (
+		DELETE FROM "records"."telegram_investigation_members" WHERE user_id = $1 RETURNING investigation_id
+	), orphans AS (
+		DELETE FROM "records"."telegram_investigations" i
+		WHERE i.id IN (SELECT investigation_id FROM removed) AND NOT EXISTS (
+			SELECT 1 FROM "records"."telegram_investigation_members" m
+			WHERE m.investigation_id = i.id AND m.user_id <> $1
+		)
+	)
+	SELECT count(*) FROM removed`
+	var removed int64
+	if err := repo.session.GetContext(ctx, &removed, query, userID); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to remove telegram investigation memberships", slog.Any("err", err))
+		return 0, repository.ErrDatabaseFailed
+	}
+	return removed, nil
+}
+
+func (repo *SQLXTelegramInvestigationRepository) AddTelegramInvestigationItem(
+	ctx context.Context,
+	item *domain.TelegramInvestigationItem,
+) error {
+	repo.logger.DebugContext(ctx, "Started AddTelegramInvestigationItem request", slog.String("item_id", item.ID.String()))
+	query := `INSERT INTO "records"."telegram_investigation_items" (` + itemColumns + `)
+	VALUES (:id, :investigation_id, :item_type, :telegram_user_id, :telegram_chat_id, :telegram_record_id,
+	:note, :added_by, :added_at)`
+	if _, err := repo.session.NamedExecContext(ctx, query, repo.sqlxMapper.ItemToModel(*item)); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.ConstraintName {
+			case "unique_telegram_investigation_item_user",
+				"unique_telegram_investigation_item_chat",
+				"unique_telegram_investigation_item_record":
+				repo.logger.InfoContext(ctx, "Telegram investigation item already exists")
+				return domain.ErrInvestigationItemAlreadyExists
+			case "fk_telegram_investigation_items_investigation":
+				repo.logger.InfoContext(ctx, "Telegram investigation item references an investigation that doesn't exist")
+				return domain.ErrInvestigationNotFound
+			case "fk_telegram_investigation_items_user":
+				return domain.ErrUserNotFound
+			case "fk_telegram_investigation_items_chat":
+				return domain.ErrChatNotFound
+			case "fk_telegram_investigation_items_record":
+				return domain.ErrRecordNotFound
+			}
+		}
+		repo.logger.ErrorContext(ctx, "Failed to add telegram investigation item", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramInvestigationRepository) GetTelegramInvestigationItems(
+	ctx context.Context,
+	investigationID uuid.UUID,
+) (*[]domain.TelegramInvestigationItem, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramInvestigationItems request",
+		slog.String("investigation_id", investigationID.String()),
+	)
+	var itemModels []models.TelegramInvestigationItemModel
+	// The chats aren't observed by the collectors, they're seen by everyone
+	query := `SELECT ` + itemColumns + ` FROM "records"."telegram_investigation_items"
+	WHERE investigation_id = $1 AND "records".is_target_visible(telegram_user_id, NULL, telegram_record_id)
+	ORDER BY added_at, id`
+	if err := repo.session.SelectContext(ctx, &itemModels, query, investigationID); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram investigation items", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	items := make([]domain.TelegramInvestigationItem, len(itemModels))
+	for i, itemModel := range itemModels {
+		items[i] = repo.sqlxMapper.ItemToDomain(itemModel)
+	}
+	return &items, nil
+}
+
+func (repo *SQLXTelegramInvestigationRepository) RemoveTelegramInvestigationItem(
+	ctx context.Context,
+	investigationID uuid.UUID,
+	itemID uuid.UUID,
+) (*domain.TelegramInvestigationItem, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started RemoveTelegramInvestigationItem request",
+		slog.String("item_id", itemID.String()),
+	)
+	var itemModel models.TelegramInvestigationItemModel
+	query := `DELETE FROM "records"."telegram_investigation_items" WHERE id = $1 AND investigation_id = $2
+	RETURNING ` + itemColumns
+	if err := repo.session.GetContext(ctx, &itemModel, query, itemID, investigationID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrInvestigationItemNotFound
+		}
+		repo.logger.ErrorContext(ctx, "Failed to remove telegram investigation item", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	item := repo.sqlxMapper.ItemToDomain(itemModel)
+	return &item, nil
+}
@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXTelegramInvestigationRepositoryFactory struct {
+	logger     *slog.Logger
+	sqlxMapper *mappers.SqlxTelegramInvestigationMapper
+}
+
+func NewSQLXTelegramInvestigationRepositoryFactory(
+	logger *slog.Logger,
+	mapper *mappers.SqlxTelegramInvestigationMapper,
+) repository.TelegramInvestigationRepositoryFactory {
+	return &SQLXTelegramInvestigationRepositoryFactory{
+		logger:     logger,
+		sqlxMapper: mapper,
+	}
+}
+
+func (factory *SQLXTelegramInvestigationRepositoryFactory) CreateTelegramInvestigationRepositoryWithTransaction(
+	tm interfaces.TransactionManager,
+) repository.TelegramInvestigationRepository {
+	tx, ok := tm.GetTransaction().(*sqlx.Tx)
+	if !ok {
+		factory.logger.Error("invalid transaction type, expected *sqlx.Tx")
+		panic("invalid transaction type for sqlx repository")
+	}
+	return &SQLXTelegramInvestigationRepository{
+		session:    tx,
+		sqlxMapper: factory.sqlxMapper,
+		logger:     factory.logger,
+	}
+}
@@ -0,0 +1,165 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
+)
+
+const observationColumns = `id, target_type, telegram_user_id, telegram_record_id, collector_user_id,
+	visibility, observed_at`
+
+// targetColumns are the columns holding the targets of each type, the only ones put in the queries.
+var targetColumns = map[domain.TelegramObservationTargetType]string{
+	domain.TelegramObservationTargetTypeUser:   "telegram_user_id",
+	domain.TelegramObservationTargetTypeRecord: "telegram_record_id",
+}
+
+type SQLXTelegramObservationRepository struct {
+	session    *sqlx.Tx
+	sqlxMapper *mappers.SqlxTelegramObservationMapper
+	logger     *slog.Logger
+}
+
+func NewSQLXTelegramObservationRepository(
+	session *sqlx.Tx,
+	sqlxMapper *mappers.SqlxTelegramObservationMapper,
+	logger *slog.Logger,
+) repository.TelegramObservationRepository {
+	torLogger := logger.With(
+		slog.String("component", "repository"),
+		slog.String("name", "sqlx_telegram_observation_repository"),
+	)
+	return &SQLXTelegramObservationRepository{
+		session:    session,
+		sqlxMapper: sqlxMapper,
+		logger:     torLogger,
+	}
+}
+
+func (repo *SQLXTelegramObservationRepository) AddTelegramObservations(
+	ctx context.Context,
+	observations []domain.TelegramObservation,
+) ([]uuid.UUID, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started AddTelegramObservations request",
+		slog.Int("observation_count", len(observations)),
+	)
+	if len(observations) == 0 {
+		return nil, nil
+	}
+	observationModels := make([]models.TelegramObservationModel, len(observations))
+	for i, observation := range observations {
+		observationModels[i] = repo.sqlxMapper.ToModel(observation)
+	}
+	query, args, err := sqlx.Named(`INSERT INTO "records"."telegram_observations" (`+observationColumns+`)
+	VALUES (:id, :target_type, :telegram_user_id, :telegram_record_id, :collector_user_id,
+	:visibility, :observed_at)`, observationModels)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to build telegram observations query", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	// The collectors observing a target again keep their first observation
+	query = repo.session.Rebind(query + ` ON CONFLICT DO NOTHING
+	RETURNING COALESCE(telegram_user_id, telegram_record_id)`)
+	var observedIDs []uuid.UUID
+	if err = repo.session.SelectContext(ctx, &observedIDs, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.ConstraintName {
+			case "fk_telegram_observations_user":
+				return nil, domain.ErrUserNotFound
+			case "fk_telegram_observations_record":
+				return nil, domain.ErrRecordNotFound
+			}
+		}
+		repo.logger.ErrorContext(ctx, "Failed to add telegram observations", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	return observedIDs, nil
+}
+
+func (repo *SQLXTelegramObservationRepository) GetTelegramObservations(
+	ctx context.Context,
+	targetType domain.TelegramObservationTargetType,
+	targetID uuid.UUID,
+) (*[]domain.TelegramObservation, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramObservations request",
+		slog.String("target_id", targetID.String()),
+	)
+	column, err := repo.targetColumn(ctx, targetType)
+	if err != nil {
+		return nil, err
+	}
+	var observationModels []models.TelegramObservationModel
+	query := `SELECT ` + observationColumns + `, tenant_id FROM "records"."telegram_observations"
+	WHERE ` + column + ` = $1
+	ORDER BY observed_at, id`
+	if err = repo.session.SelectContext(ctx, &observationModels, query, targetID); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram observations", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	observations := make([]domain.TelegramObservation, len(observationModels))
+	for i, observationModel := range observationModels {
+		observations[i] = repo.sqlxMapper.ToDomain(observationModel)
+	}
+	return &observations, nil
+}
+
+func (repo *SQLXTelegramObservationRepository) UpdateTelegramObservationVisibility(
+	ctx context.Context,
+	targetType domain.TelegramObservationTargetType,
+	targetID uuid.UUID,
+	collectorUserID uuid.UUID,
+	visibility domain.TelegramVisibility,
+) error {
+	repo.logger.DebugContext(
+		ctx,
+		"Started UpdateTelegramObservationVisibility request",
+		slog.String("target_id", targetID.String()),
+		slog.String("visibility", string(visibility)),
+	)
+	column, err := repo.targetColumn(ctx, targetType)
+	if err != nil {
+		return err
+	}
+	query := `UPDATE "records"."telegram_observations" SET visibility = $1
+	WHERE ` + column + ` = $2 AND collector_user_id = $3`
+	result, err := repo.session.ExecContext(ctx, query, string(visibility), targetID, collectorUserID)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to update telegram observation", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get rows affected", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	if rowsAffected == 0 {
+		return domain.ErrObservationNotFound
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramObservationRepository) targetColumn(
+	ctx context.Context,
+	targetType domain.TelegramObservationTargetType,
+) (string, error) {
+	column, ok := targetColumns[targetType]
+	if !ok {
+		repo.logger.ErrorContext(ctx, "Unknown telegram observation target type", slog.String("type", string(targetType)))
+		return "", repository.ErrDatabaseFailed
+	}
+	return column, nil
+}
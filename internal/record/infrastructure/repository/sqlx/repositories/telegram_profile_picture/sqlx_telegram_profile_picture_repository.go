@@ -0,0 +1,141 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXTelegramProfilePictureRepository struct {
+	session    *sqlx.Tx
+	sqlxMapper *mappers.SqlxTelegramProfilePictureMapper
+	logger     *slog.Logger
+}
+
+func NewSQLXTelegramProfilePictureRepository(
+	session *sqlx.Tx,
+	sqlxMapper *mappers.SqlxTelegramProfilePictureMapper,
+	logger *slog.Logger,
+) repository.TelegramProfilePictureRepository {
+	tprLogger := logger.With(
+		slog.String("component", "repository"),
+		slog.String("name", "sqlx_telegram_profile_picture_repository"),
+	)
+	return &SQLXTelegramProfilePictureRepository{
+		session:    session,
+		sqlxMapper: sqlxMapper,
+		logger:     tprLogger,
+	}
+}
+
+func (repo *SQLXTelegramProfilePictureRepository) AddProfilePicture(
+	ctx context.Context,
+	picture *domain.TelegramProfilePicture,
+) error {
+	repo.logger.DebugContext(
+		ctx,
+		"Started AddProfilePicture request",
+		slog.String("profile_picture_id", picture.ID.String()),
+	)
+	pictureModel := repo.sqlxMapper.ToModel(*picture)
+	// The users are deduplicated across the collectors, so the user is checked instead of a foreign key
+	query := `INSERT INTO "records"."telegram_profile_pictures" (id, user_telegram_id, storage_key, sha256,
+	file_size, posted_at, mime_type, added_at, added_by_user)
+	SELECT :id, :user_telegram_id, :storage_key, :sha256,
+	:file_size, :posted_at, :mime_type, :added_at, :added_by_user
+	WHERE EXISTS (SELECT 1 FROM "records"."telegram_users" WHERE telegram_id = :user_telegram_id)`
+	result, err := repo.session.NamedExecContext(ctx, query, pictureModel)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.ConstraintName {
+			case "unique_telegram_profile_picture_posted_at", "unique_telegram_profile_picture_content":
+				repo.logger.InfoContext(
+					ctx,
+					"This profile picture has already been added",
+					slog.Uint64("user_telegram_id", picture.UserTelegramID),
+					slog.String("constraint", pgErr.ConstraintName),
+				)
+				return domain.ErrProfilePictureAlreadyExists
+			}
+		}
+		repo.logger.ErrorContext(ctx, "Failed to add telegram profile picture", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get affected rows", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	if rowsAffected == 0 {
+		repo.logger.InfoContext(
+			ctx,
+			"User with this telegram id doesn't exist",
+			slog.Uint64("user_telegram_id", picture.UserTelegramID),
+		)
+		return domain.ErrUnexistentTelegramUserReferenced
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramProfilePictureRepository) GetProfilePictureByID(
+	ctx context.Context,
+	pictureID uuid.UUID,
+) (*domain.TelegramProfilePicture, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetProfilePictureByID request",
+		slog.String("profile_picture_id", pictureID.String()),
+	)
+	var pictureModel models.TelegramProfilePictureModel
+	query := `SELECT id, user_telegram_id, storage_key, sha256, file_size, posted_at, mime_type, added_at, added_by_user
+	FROM "records"."telegram_profile_pictures" WHERE id = $1`
+	err := repo.session.GetContext(ctx, &pictureModel, query, pictureID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			repo.logger.InfoContext(
+				ctx,
+				"Telegram profile picture not found",
+				slog.String("profile_picture_id", pictureID.String()),
+			)
+			return nil, domain.ErrProfilePictureNotFound
+		}
+		repo.logger.ErrorContext(ctx, "Failed to get telegram profile picture", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	picture := repo.sqlxMapper.ToDomain(pictureModel)
+	return &picture, nil
+}
+
+func (repo *SQLXTelegramProfilePictureRepository) GetProfilePicturesByUserTelegramID(
+	ctx context.Context,
+	userTelegramID uint64,
+) (*[]domain.TelegramProfilePicture, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetProfilePicturesByUserTelegramID request",
+		slog.Uint64("user_telegram_id", userTelegramID),
+	)
+	var pictureModels []models.TelegramProfilePictureModel
+	query := `SELECT id, user_telegram_id, storage_key, sha256, file_size, posted_at, mime_type, added_at, added_by_user
+	FROM "records"."telegram_profile_pictures" WHERE user_telegram_id = $1 ORDER BY posted_at DESC`
+	err := repo.session.SelectContext(ctx, &pictureModels, query, userTelegramID)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram profile pictures", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	pictures := make([]domain.TelegramProfilePicture, len(pictureModels))
+	for i, pictureModel := range pictureModels {
+		pictures[i] = repo.sqlxMapper.ToDomain(pictureModel)
+	}
+	return &pictures, nil
+}
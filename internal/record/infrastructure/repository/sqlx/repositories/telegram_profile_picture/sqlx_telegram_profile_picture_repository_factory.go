@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXTelegramProfilePictureRepositoryFactory struct {
+	logger     *slog.Logger
+	sqlxMapper *mappers.SqlxTelegramProfilePictureMapper
+}
+
+func NewSQLXTelegramProfilePictureRepositoryFactory(
+	logger *slog.Logger,
+	mapper *mappers.SqlxTelegramProfilePictureMapper,
+) repository.TelegramProfilePictureRepositoryFactory {
+	return &SQLXTelegramProfilePictureRepositoryFactory{
+		logger:     logger,
+		sqlxMapper: mapper,
+	}
+}
+
+func (factory *SQLXTelegramProfilePictureRepositoryFactory) CreateTelegramProfilePictureRepositoryWithTransaction(
+	tm interfaces.TransactionManager,
+) repository.TelegramProfilePictureRepository {
+	tx, ok := tm.GetTransaction().(*sqlx.Tx)
+	if !ok {
+		factory.logger.Error("invalid transaction type, expected *sqlx.Tx")
+		panic("invalid transaction type for sqlx repository")
+	}
+	return &SQLXTelegramProfilePictureRepository{
+		session:    tx,
+		sqlxMapper: factory.sqlxMapper,
+		logger:     factory.logger,
+	}
+}
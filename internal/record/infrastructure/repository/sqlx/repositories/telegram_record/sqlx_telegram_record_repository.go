@@ -4,12 +4,17 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"log/slog"
+	"strings"
+	"time"
 
 	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
 	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/cursor"
 	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
 	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jmoiron/sqlx"
 )
@@ -39,12 +44,20 @@ func NewSQLXTelegramRecordRepository(
 func (repo *SQLXTelegramRecordRepository) GetLatestTelegramRecordsByUserTelegramID(
 	ctx context.Context,
 	userTelegramID uint64,
+	tag string,
 ) (*[]domain.TelegramRecord, error) {
 	repo.logger.DebugContext(ctx, "Started GetLatestTelegramRecordsByUserTelegramID request")
 	var records []models.SQLXTelegramRecordModel
-	query := `SELECT id, from_user_telegram_id, in_telegram_chat_id, message_text, posted_at, added_at, added_by_user
-	FROM "records"."telegram_records" WHERE from_user_telegram_id = $1 LIMIT 5`
-	err := repo.session.SelectContext(ctx, &records, query, userTelegramID)
+	query := `SELECT r.id, r.message_telegram_id, r.from_telegram_user_id, r.in_telegram_chat_id,
+	COALESCE(r.message_text, '') AS message_text, r.entities, COALESCE(r.caption, '') AS caption,
+	r.caption_entities, r.posted_at, r.edited_at, r.deleted_at, r.reply_to_message_telegram_id, r.thread_telegram_id,
+	r.forward_from_user_telegram_id, r.forward_from_chat_telegram_id, r.forward_from_message_telegram_id,
+	r.forward_posted_at, r.added_at, r.added_by_user
+	FROM "records"."telegram_records" r
+	JOIN "records"."telegram_users" u ON u.id = r.from_telegram_user_id
+	WHERE u.telegram_id = $1 AND ` + recordTagFilter("$2") + ` AND "records".is_record_visible(r.id)
+	ORDER BY r.posted_at DESC LIMIT 5`
+	err := repo.session.SelectContext(ctx, &records, query, userTelegramID, tag)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			repo.logger.InfoContext(ctx, "Telegram record not found", slog.Uint64("user_telegram_id", userTelegramID))
@@ -71,15 +84,28 @@ func (repo *SQLXTelegramRecordRepository) CreateTelegramRecord(
 		slog.String("record_id", telegramRecord.ID.String()),
 	)
 	recordModel := repo.sqlxMapper.ToModel(telegramRecord)
-	query := `INSERT INTO "records"."telegram_records" (id, message_telegram_id, from_telegram_user_id, in_telegram_chat_id, message_text, posted_at, added_at, added_by_user)
-	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	query := `INSERT INTO "records"."telegram_records" (id, message_telegram_id, from_telegram_user_id, in_telegram_chat_id,
+	message_text, entities, caption, caption_entities, posted_at, edited_at, reply_to_message_telegram_id,
+	thread_telegram_id, forward_from_user_telegram_id, forward_from_chat_telegram_id, forward_from_message_telegram_id,
+	forward_posted_at, added_at, added_by_user)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)`
 	_, err := repo.session.ExecContext(ctx, query,
 		recordModel.ID,
 		recordModel.MessageTelegramID,
 		recordModel.FromTelegramUserID,
 		recordModel.InTelegramChatID,
 		recordModel.MessageText,
+		recordModel.Entities,
+		recordModel.Caption,
+		recordModel.CaptionEntities,
 		recordModel.PostedAt,
+		recordModel.EditedAt,
+		recordModel.ReplyToMessageTelegramID,
+		recordModel.ThreadTelegramID,
+		recordModel.ForwardFromUserTelegramID,
+		recordModel.ForwardFromChatTelegramID,
+		recordModel.ForwardFromMessageTelegramID,
+		recordModel.ForwardPostedAt,
 		recordModel.AddedAt,
 		recordModel.AddedByUser,
 	)
@@ -94,10 +120,17 @@ func (repo *SQLXTelegramRecordRepository) CreateTelegramRecord(
 					slog.String("user_telegram_id", telegramRecord.FromTelegramUserID.String()),
 				)
 				return domain.ErrUnexistentTelegramUserReferenced
+			case "fk_telegram_records_chat":
+				repo.logger.InfoContext(
+					ctx,
+					"Chat with this telegram id doesn't exist",
+					slog.Int64("chat_telegram_id", telegramRecord.InTelegramChatID),
+				)
+				return domain.ErrUnexistentTelegramChatReferenced
 			case "unique_telegram_message_id":
 				repo.logger.InfoContext(
 					ctx,
-					"This user has already been added this message",
+					"This message has already been added",
 					slog.Uint64("telegram_message_id", telegramRecord.MessageTelegramID),
 					slog.String("added_by_user_id", telegramRecord.AddedByUser.String()),
 				)
@@ -113,22 +146,657 @@ func (repo *SQLXTelegramRecordRepository) CreateTelegramRecord(
 func (repo *SQLXTelegramRecordRepository) CreateTelegramRecords(
 	ctx context.Context,
 	telegramRecords []domain.TelegramRecord,
-) error {
+) ([]error, error) {
 	repo.logger.DebugContext(
 		ctx,
 		"Started CreateTelegramRecords request",
 		slog.Int("record_count", len(telegramRecords)),
 	)
-	query := `INSERT INTO "records"."telegram_records" (id, message_telegram_id, from_user_telegram_id, in_telegram_chat_id, message_text, posted_at, added_at, added_by_user)
-	VALUES (:id, :message_telegram_id, :from_user_telegram_id, :in_telegram_chat_id, :message_text, :posted_at, :added_at, :added_by_user)`
-	recordModels := make([]models.SQLXTelegramRecordModel, len(telegramRecords))
+	recordErrors := make([]error, len(telegramRecords))
+	if len(telegramRecords) == 0 {
+		return recordErrors, nil
+	}
+
+	// A foreign key violation aborts the whole statement, so the references are checked upfront
+	existingUsers, err := repo.getExistingUserIDs(ctx, telegramRecords)
+	if err != nil {
+		return nil, err
+	}
+	existingChats, err := repo.getExistingChatIDs(ctx, telegramRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	recordModels := make([]models.SQLXTelegramRecordModel, 0, len(telegramRecords))
 	for i, record := range telegramRecords {
-		recordModels[i] = repo.sqlxMapper.ToModel(record)
+		switch {
+		case !existingUsers[record.FromTelegramUserID]:
+			recordErrors[i] = domain.ErrUnexistentTelegramUserReferenced
+		case !existingChats[chatKey{record.InTelegramChatID, record.AddedByUser}]:
+			recordErrors[i] = domain.ErrUnexistentTelegramChatReferenced
+		default:
+			recordModels = append(recordModels, repo.sqlxMapper.ToModel(record))
+		}
 	}
-	_, err := repo.session.NamedExecContext(ctx, query, recordModels)
+	if len(recordModels) == 0 {
+		return recordErrors, nil
+	}
+
+	// Duplicates, either already stored or repeated inside of the batch, are skipped instead of failing it
+	query, args, err := sqlx.Named(`INSERT INTO "records"."telegram_records" (id, message_telegram_id,
+	from_telegram_user_id, in_telegram_chat_id, message_text, entities, caption, caption_entities, posted_at, edited_at,
+	reply_to_message_telegram_id,
+	thread_telegram_id, forward_from_user_telegram_id, forward_from_chat_telegram_id, forward_from_message_telegram_id,
+	forward_posted_at, added_at, added_by_user)
+	VALUES (:id, :message_telegram_id, :from_telegram_user_id, :in_telegram_chat_id, :message_text, :entities,
+	:caption, :caption_entities, :posted_at, :edited_at, :reply_to_message_telegram_id, :thread_telegram_id, :forward_from_user_telegram_id,
+	:forward_from_chat_telegram_id, :forward_from_message_telegram_id, :forward_posted_at, :added_at,
+	:added_by_user)`, recordModels)
 	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to build telegram records query", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	query = repo.session.Rebind(query + ` ON CONFLICT (message_telegram_id, in_telegram_chat_id,
+	tenant_id) WHERE canonical_id IS NULL DO NOTHING RETURNING id`)
+	var insertedIDs []uuid.UUID
+	if err = repo.session.SelectContext(ctx, &insertedIDs, query, args...); err != nil {
 		repo.logger.ErrorContext(ctx, "Failed to create telegram records", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+
+	inserted := make(map[uuid.UUID]bool, len(insertedIDs))
+	for _, id := range insertedIDs {
+		inserted[id] = true
+	}
+	for i, record := range telegramRecords {
+		if recordErrors[i] == nil && !inserted[record.ID] {
+			recordErrors[i] = domain.ErrRecordAlreadyExists
+		}
+	}
+	return recordErrors, nil
+}
+
+func (repo *SQLXTelegramRecordRepository) GetTelegramRecordByID(
+	ctx context.Context,
+	recordID uuid.UUID,
+) (*domain.TelegramRecord, error) {
+	repo.logger.DebugContext(ctx, "Started GetTelegramRecordByID request", slog.String("record_id", recordID.String()))
+	var recordModel models.SQLXTelegramRecordModel
+	query := `SELECT id, message_telegram_id, from_telegram_user_id, in_telegram_chat_id,
+	COALESCE(message_text, '') AS message_text, entities, COALESCE(caption, '') AS caption, caption_entities,
+	posted_at, edited_at, deleted_at, reply_to_message_telegram_id, thread_telegram_id, forward_from_user_telegram_id,
+	forward_from_chat_telegram_id, forward_from_message_telegram_id, forward_posted_at, added_at, added_by_user
+	FROM "records"."telegram_records" WHERE id = $1 AND "records".is_record_visible(id)`
+	if err := repo.session.GetContext(ctx, &recordModel, query, recordID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			repo.logger.InfoContext(ctx, "Telegram record not found", slog.String("record_id", recordID.String()))
+			return nil, domain.ErrRecordNotFound
+		}
+		repo.logger.ErrorContext(ctx, "Failed to get telegram record", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	telegramRecord := repo.sqlxMapper.ToDomain(recordModel)
+	return &telegramRecord, nil
+}
+
+func (repo *SQLXTelegramRecordRepository) GetTelegramRecordByMessageTelegramID(
+	ctx context.Context,
+	messageTelegramID uint64,
+	chatTelegramID int64,
+) (*domain.TelegramRecord, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramRecordByMessageTelegramID request",
+		slog.Uint64("message_telegram_id", messageTelegramID),
+	)
+	var recordModel models.SQLXTelegramRecordModel
+	query := `SELECT id, message_telegram_id, from_telegram_user_id, in_telegram_chat_id,
+	COALESCE(message_text, '') AS message_text, entities, COALESCE(caption, '') AS caption, caption_entities,
+	posted_at, edited_at, deleted_at, reply_to_message_telegram_id, thread_telegram_id, forward_from_user_telegram_id,
+	forward_from_chat_telegram_id, forward_from_message_telegram_id, forward_posted_at, added_at, added_by_user
+	FROM "records"."telegram_records"
+	WHERE message_telegram_id = $1 AND in_telegram_chat_id = $2 AND canonical_id IS NULL
+	AND "records".is_record_visible(id)`
+	if err := repo.session.GetContext(ctx, &recordModel, query, messageTelegramID, chatTelegramID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			repo.logger.InfoContext(
+				ctx,
+				"Telegram record not found",
+				slog.Uint64("message_telegram_id", messageTelegramID),
+			)
+			return nil, domain.ErrRecordNotFound
+		}
+		repo.logger.ErrorContext(ctx, "Failed to get telegram record", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	telegramRecord := repo.sqlxMapper.ToDomain(recordModel)
+	return &telegramRecord, nil
+}
+
+func (repo *SQLXTelegramRecordRepository) UpdateTelegramRecordContent(
+	ctx context.Context,
+	recordID uuid.UUID,
+	content domain.TelegramRecord,
+	editedAt time.Time,
+) error {
+	repo.logger.DebugContext(
+		ctx,
+		"Started UpdateTelegramRecordContent request",
+		slog.String("record_id", recordID.String()),
+	)
+	contentModel := repo.sqlxMapper.ToModel(content)
+	query := `UPDATE "records"."telegram_records" SET message_text = $2, entities = $3, caption = $4,
+	caption_entities = $5, edited_at = $6 WHERE id = $1`
+	return repo.updateRecord(
+		ctx,
+		recordID,
+		query,
+		contentModel.MessageText,
+		contentModel.Entities,
+		contentModel.Caption,
+		contentModel.CaptionEntities,
+		editedAt,
+	)
+}
+
+func (repo *SQLXTelegramRecordRepository) MarkTelegramRecordDeleted(
+	ctx context.Context,
+	recordID uuid.UUID,
+	deletedAt time.Time,
+) error {
+	repo.logger.DebugContext(ctx, "Started MarkTelegramRecordDeleted request", slog.String("record_id", recordID.String()))
+	query := `UPDATE "records"."telegram_records" SET deleted_at = $2 WHERE id = $1`
+	return repo.updateRecord(ctx, recordID, query, deletedAt)
+}
+
+func (repo *SQLXTelegramRecordRepository) updateRecord(
+	ctx context.Context,
+	recordID uuid.UUID,
+	query string,
+	args ...any,
+) error {
+	result, err := repo.session.ExecContext(ctx, query, append([]any{recordID}, args...)...)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to update telegram record", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get rows affected", slog.Any("err", err))
 		return repository.ErrDatabaseFailed
 	}
+	if rowsAffected == 0 {
+		repo.logger.InfoContext(ctx, "Telegram record not found for update", slog.String("record_id", recordID.String()))
+		return domain.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramRecordRepository) AddTelegramRecordRevision(
+	ctx context.Context,
+	revision *domain.TelegramRecordRevision,
+) error {
+	repo.logger.DebugContext(
+		ctx,
+		"Started AddTelegramRecordRevision request",
+		slog.String("record_id", revision.RecordID.String()),
+	)
+	revisionModel := repo.sqlxMapper.RevisionToModel(*revision)
+	// A redelivered edit is skipped instead of aborting the transaction
+	query := `INSERT INTO "records"."telegram_record_revisions" (id, record_id, message_text, entities, caption,
+	caption_entities, edited_at, added_at, added_by_user)
+	VALUES (:id, :record_id, :message_text, :entities, :caption, :caption_entities, :edited_at, :added_at,
+	:added_by_user)
+	ON CONFLICT ON CONSTRAINT "unique_telegram_record_revision_edited_at" DO NOTHING`
+	result, err := repo.session.NamedExecContext(ctx, query, revisionModel)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "fk_telegram_record_revisions_record" {
+			repo.logger.InfoContext(
+				ctx,
+				"Telegram record with that id not found",
+				slog.String("record_id", revisionModel.RecordID.String()),
+				slog.String("constraint_name", pgErr.ConstraintName),
+			)
+			return domain.ErrRecordNotFound
+		}
+		repo.logger.ErrorContext(ctx, "Failed to add telegram record revision", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get rows affected", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	if rowsAffected == 0 {
+		repo.logger.InfoContext(
+			ctx,
+			"This record revision already exists",
+			slog.String("record_id", revisionModel.RecordID.String()),
+		)
+		return domain.ErrRevisionAlreadyExists
+	}
 	return nil
 }
+
+func (repo *SQLXTelegramRecordRepository) GetTelegramRecordRevisions(
+	ctx context.Context,
+	recordID uuid.UUID,
+) (*[]domain.TelegramRecordRevision, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramRecordRevisions request",
+		slog.String("record_id", recordID.String()),
+	)
+	var revisionModels []models.SQLXTelegramRecordRevisionModel
+	query := `SELECT id, record_id, COALESCE(message_text, '') AS message_text, entities,
+	COALESCE(caption, '') AS caption, caption_entities, edited_at, added_at, added_by_user
+	FROM "records"."telegram_record_revisions" WHERE record_id = $1 AND "records".is_record_visible(record_id)
+	ORDER BY edited_at ASC NULLS FIRST`
+	if err := repo.session.SelectContext(ctx, &revisionModels, query, recordID); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram record revisions", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	revisions := make([]domain.TelegramRecordRevision, len(revisionModels))
+	for i, revisionModel := range revisionModels {
+		revisions[i] = repo.sqlxMapper.RevisionToDomain(revisionModel)
+	}
+	return &revisions, nil
+}
+
+// maxThreadDepth bounds the walk over the replies, a reply chain may form a cycle with inconsistent data.
+const maxThreadDepth = 100
+
+func (repo *SQLXTelegramRecordRepository) GetTelegramRecordThread(
+	ctx context.Context,
+	recordID uuid.UUID,
+	limit int,
+) (*[]domain.TelegramRecord, error) {
+	repo.logger.DebugContext(ctx, "Started GetTelegramRecordThread request", slog.String("record_id", recordID.String()))
+	// Message IDs are unique per chat, so the replies are only followed within the chat, among the canonical records.
+	// The chain is walked up to the root first, then all the replies to it are collected.
+	query := `WITH RECURSIVE ancestors AS (
+		SELECT id, message_telegram_id, reply_to_message_telegram_id, in_telegram_chat_id, 0 AS depth
+		FROM "records"."telegram_records" WHERE id = $1
+		UNION ALL
+		SELECT p.id, p.message_telegram_id, p.reply_to_message_telegram_id, p.in_telegram_chat_id, a.depth + 1
+		FROM ancestors a
+		JOIN "records"."telegram_records" p ON p.message_telegram_id = a.reply_to_message_telegram_id
+		AND p.in_telegram_chat_id = a.in_telegram_chat_id AND p.canonical_id IS NULL
+		WHERE a.depth < $2
+	), root AS (
+		SELECT id, message_telegram_id, in_telegram_chat_id FROM ancestors ORDER BY depth DESC LIMIT 1
+	), thread AS (
+		SELECT id, message_telegram_id, in_telegram_chat_id, 0 AS depth FROM root
+		UNION ALL
+		SELECT c.id, c.message_telegram_id, c.in_telegram_chat_id, t.depth + 1
+		FROM thread t
+		JOIN "records"."telegram_records" c ON c.reply_to_message_telegram_id = t.message_telegram_id
+		AND c.in_telegram_chat_id = t.in_telegram_chat_id AND c.canonical_id IS NULL
+		WHERE t.depth < $2
+	)
+	SELECT r.id, r.message_telegram_id, r.from_telegram_user_id, r.in_telegram_chat_id,
+	COALESCE(r.message_text, '') AS message_text, r.entities, COALESCE(r.caption, '') AS caption,
+	r.caption_entities, r.posted_at, r.edited_at, r.deleted_at, r.reply_to_message_telegram_id,
+	r.thread_telegram_id, r.forward_from_user_telegram_id, r.forward_from_chat_telegram_id,
+	r.forward_from_message_telegram_id, r.forward_posted_at, r.added_at, r.added_by_user
+	FROM "records"."telegram_records" r
+	WHERE r.id IN (SELECT id FROM thread) AND "records".is_record_visible(r.id)
+	ORDER BY r.posted_at ASC, r.message_telegram_id ASC LIMIT $3`
+	var records []models.SQLXTelegramRecordModel
+	if err := repo.session.SelectContext(ctx, &records, query, recordID, maxThreadDepth, limit); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram record thread", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	domainRecords := make([]domain.TelegramRecord, len(records))
+	for i, record := range records {
+		domainRecords[i] = repo.sqlxMapper.ToDomain(record)
+	}
+	return &domainRecords, nil
+}
+
+func (repo *SQLXTelegramRecordRepository) GetTelegramRecordsForwardedFrom(
+	ctx context.Context,
+	origin domain.TelegramForwardOrigin,
+	limit int,
+) (*[]domain.TelegramRecord, error) {
+	repo.logger.DebugContext(ctx, "Started GetTelegramRecordsForwardedFrom request")
+	// Only the set parts of the origin are matched, so that the query can use the partial indexes
+	conditions := make([]string, 0, 3)
+	args := make([]any, 0, 4)
+	if origin.FromUserTelegramID != nil {
+		args = append(args, *origin.FromUserTelegramID)
+		conditions = append(conditions, fmt.Sprintf("forward_from_user_telegram_id = $%d", len(args)))
+	}
+	if origin.FromChatTelegramID != nil {
+		args = append(args, *origin.FromChatTelegramID)
+		conditions = append(conditions, fmt.Sprintf("forward_from_chat_telegram_id = $%d", len(args)))
+	}
+	if origin.MessageTelegramID != nil {
+		args = append(args, *origin.MessageTelegramID)
+		conditions = append(conditions, fmt.Sprintf("forward_from_message_telegram_id = $%d", len(args)))
+	}
+	if len(conditions) == 0 {
+		return &[]domain.TelegramRecord{}, nil
+	}
+	args = append(args, limit)
+	query := fmt.Sprintf(`SELECT id, message_telegram_id, from_telegram_user_id, in_telegram_chat_id,
+	COALESCE(message_text, '') AS message_text, entities, COALESCE(caption, '') AS caption, caption_entities,
+	posted_at, edited_at, deleted_at, reply_to_message_telegram_id,
+	thread_telegram_id, forward_from_user_telegram_id, forward_from_chat_telegram_id, forward_from_message_telegram_id,
+	forward_posted_at, added_at, added_by_user
+	FROM "records"."telegram_records" WHERE %s AND "records".is_record_visible(id)
+	ORDER BY posted_at DESC LIMIT $%d`,
+		strings.Join(conditions, " AND "), len(args))
+	var records []models.SQLXTelegramRecordModel
+	if err := repo.session.SelectContext(ctx, &records, query, args...); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get forwarded telegram records", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	domainRecords := make([]domain.TelegramRecord, len(records))
+	for i, record := range records {
+		domainRecords[i] = repo.sqlxMapper.ToDomain(record)
+	}
+	return &domainRecords, nil
+}
+
+func (repo *SQLXTelegramRecordRepository) GetTelegramRecordsByIndicator(
+	ctx context.Context,
+	indicatorType domain.TelegramIndicatorType,
+	value string,
+	tag string,
+	limit int,
+) (*[]domain.TelegramRecord, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramRecordsByIndicator request",
+		slog.String("indicator_type", string(indicatorType)),
+	)
+	query := `SELECT r.id, r.message_telegram_id, r.from_telegram_user_id, r.in_telegram_chat_id,
+	COALESCE(r.message_text, '') AS message_text, r.entities, COALESCE(r.caption, '') AS caption,
+	r.caption_entities, r.posted_at, r.edited_at, r.deleted_at, r.reply_to_message_telegram_id, r.thread_telegram_id,
+	r.forward_from_user_telegram_id, r.forward_from_chat_telegram_id, r.forward_from_message_telegram_id,
+	r.forward_posted_at, r.added_at, r.added_by_user
+	FROM "records"."telegram_records" r
+	JOIN "records"."telegram_indicators" i ON i.record_id = r.id
+	WHERE i.indicator_type = $1 AND i.value = $2 AND ` + recordTagFilter("$4") + `
+	AND "records".is_record_visible(r.id)
+	ORDER BY r.posted_at DESC LIMIT $3`
+	args := []any{indicatorType, value, limit, tag}
+	var records []models.SQLXTelegramRecordModel
+	if err := repo.session.SelectContext(ctx, &records, query, args...); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram records by indicator", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	domainRecords := make([]domain.TelegramRecord, len(records))
+	for i, record := range records {
+		domainRecords[i] = repo.sqlxMapper.ToDomain(record)
+	}
+	return &domainRecords, nil
+}
+
+func (repo *SQLXTelegramRecordRepository) GetTelegramRecordsAfterID(
+	ctx context.Context,
+	afterID uuid.UUID,
+	limit int,
+) (*[]domain.TelegramRecord, error) {
+	repo.logger.DebugContext(ctx, "Started GetTelegramRecordsAfterID request", slog.String("after_id", afterID.String()))
+	query := `SELECT id, message_telegram_id, from_telegram_user_id, in_telegram_chat_id,
+	COALESCE(message_text, '') AS message_text, entities, COALESCE(caption, '') AS caption, caption_entities,
+	posted_at, edited_at, deleted_at, reply_to_message_telegram_id,
+	thread_telegram_id, forward_from_user_telegram_id, forward_from_chat_telegram_id, forward_from_message_telegram_id,
+	forward_posted_at, added_at, added_by_user
+	FROM "records"."telegram_records" WHERE id > $1 ORDER BY id LIMIT $2`
+	var records []models.SQLXTelegramRecordModel
+	if err := repo.session.SelectContext(ctx, &records, query, afterID, limit); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to page telegram records", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	domainRecords := make([]domain.TelegramRecord, len(records))
+	for i, record := range records {
+		domainRecords[i] = repo.sqlxMapper.ToDomain(record)
+	}
+	return &domainRecords, nil
+}
+
+type chatKey struct {
+	chatTelegramID int64
+	addedByUser    uuid.UUID
+}
+
+func (repo *SQLXTelegramRecordRepository) GetTelegramRecordsByIDs(
+	ctx context.Context,
+	recordIDs []uuid.UUID,
+) (*[]domain.TelegramRecord, error) {
+	repo.logger.DebugContext(ctx, "Started GetTelegramRecordsByIDs request", slog.Int("record_count", len(recordIDs)))
+	if len(recordIDs) == 0 {
+		return &[]domain.TelegramRecord{}, nil
+	}
+	query, args, err := sqlx.In(`SELECT id, message_telegram_id, from_telegram_user_id, in_telegram_chat_id,
+	COALESCE(message_text, '') AS message_text, entities, COALESCE(caption, '') AS caption, caption_entities,
+	posted_at, edited_at, deleted_at, reply_to_message_telegram_id,
+	thread_telegram_id, forward_from_user_telegram_id, forward_from_chat_telegram_id, forward_from_message_telegram_id,
+	forward_posted_at, added_at, added_by_user
+	FROM "records"."telegram_records" WHERE id IN (?) ORDER BY id`, recordIDs)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to build telegram records query", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	var records []models.SQLXTelegramRecordModel
+	if err = repo.session.SelectContext(ctx, &records, repo.session.Rebind(query), args...); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram records", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	domainRecords := make([]domain.TelegramRecord, len(records))
+	for i, record := range records {
+		domainRecords[i] = repo.sqlxMapper.ToDomain(record)
+	}
+	return &domainRecords, nil
+}
+
+// selectionCondition matches the visible records of the selection, which must not be empty.
+func selectionCondition(selection domain.TelegramExportSelection) (string, []any) {
+	var conditions []string
+	var args []any
+	if len(selection.UserTelegramIDs) > 0 {
+		conditions = append(conditions, `from_telegram_user_id IN (
+			SELECT id FROM "records"."telegram_users" WHERE telegram_id IN (?)
+		)`)
+		args = append(args, selection.UserTelegramIDs)
+	}
+	if len(selection.ChatTelegramIDs) > 0 {
+		conditions = append(conditions, `in_telegram_chat_id IN (?)`)
+		args = append(args, selection.ChatTelegramIDs)
+	}
+	return "(" + strings.Join(conditions, " OR ") + `) AND "records".is_record_visible(id)`, args
+}
+
+func (repo *SQLXTelegramRecordRepository) CountTelegramRecordsBySelection(
+	ctx context.Context,
+	selection domain.TelegramExportSelection,
+) (int64, error) {
+	repo.logger.DebugContext(ctx, "Started CountTelegramRecordsBySelection request")
+	if selection.IsEmpty() {
+		return 0, nil
+	}
+	condition, conditionArgs := selectionCondition(selection)
+	query, args, err := sqlx.In(`SELECT COUNT(*) FROM "records"."telegram_records" WHERE `+condition, conditionArgs...)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to build telegram records query", slog.Any("err", err))
+		return 0, repository.ErrDatabaseFailed
+	}
+	var count int64
+	if err = repo.session.GetContext(ctx, &count, repo.session.Rebind(query), args...); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to count telegram records", slog.Any("err", err))
+		return 0, repository.ErrDatabaseFailed
+	}
+	return count, nil
+}
+
+func (repo *SQLXTelegramRecordRepository) GetTelegramRecordsBySelection(
+	ctx context.Context,
+	selection domain.TelegramExportSelection,
+	afterID uuid.UUID,
+	limit int,
+) (*[]domain.TelegramRecord, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramRecordsBySelection request",
+		slog.String("after_id", afterID.String()),
+	)
+	if selection.IsEmpty() {
+		return &[]domain.TelegramRecord{}, nil
+	}
+	condition, conditionArgs := selectionCondition(selection)
+	query, args, err := sqlx.In(`SELECT id, message_telegram_id, from_telegram_user_id, in_telegram_chat_id,
+	COALESCE(message_text, '') AS message_text, entities, COALESCE(caption, '') AS caption, caption_entities,
+	posted_at, edited_at, deleted_at, reply_to_message_telegram_id,
+	thread_telegram_id, forward_from_user_telegram_id, forward_from_chat_telegram_id, forward_from_message_telegram_id,
+	forward_posted_at, added_at, added_by_user
+	FROM "records"."telegram_records" WHERE id > ? AND `+condition+` ORDER BY id LIMIT ?`,
+		append(append([]any{afterID}, conditionArgs...), limit)...)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to build telegram records query", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	var records []models.SQLXTelegramRecordModel
+	if err = repo.session.SelectContext(ctx, &records, repo.session.Rebind(query), args...); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to page telegram records", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	domainRecords := make([]domain.TelegramRecord, len(records))
+	for i, record := range records {
+		domainRecords[i] = repo.sqlxMapper.ToDomain(record)
+	}
+	return &domainRecords, nil
+}
+
+func (repo *SQLXTelegramRecordRepository) getExistingUserIDs(
+	ctx context.Context,
+	telegramRecords []domain.TelegramRecord,
+) (map[uuid.UUID]bool, error) {
+	userIDs := make([]uuid.UUID, len(telegramRecords))
+	for i, record := range telegramRecords {
+		userIDs[i] = record.FromTelegramUserID
+	}
+	query, args, err := sqlx.In(`SELECT id FROM "records"."telegram_users" WHERE id IN (?)`, userIDs)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to build telegram users query", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	var existingIDs []uuid.UUID
+	if err = repo.session.SelectContext(ctx, &existingIDs, repo.session.Rebind(query), args...); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get referenced telegram users", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	existing := make(map[uuid.UUID]bool, len(existingIDs))
+	for _, id := range existingIDs {
+		existing[id] = true
+	}
+	return existing, nil
+}
+
+func (repo *SQLXTelegramRecordRepository) getExistingChatIDs(
+	ctx context.Context,
+	telegramRecords []domain.TelegramRecord,
+) (map[chatKey]bool, error) {
+	chatIDs := make([]int64, len(telegramRecords))
+	for i, record := range telegramRecords {
+		chatIDs[i] = record.InTelegramChatID
+	}
+	query, args, err := sqlx.In(
+		`SELECT chat_telegram_id, added_by_user FROM "records"."telegram_chats" WHERE chat_telegram_id IN (?)`,
+		chatIDs,
+	)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to build telegram chats query", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	var existingChats []struct {
+		ChatTelegramID int64     `db:"chat_telegram_id"`
+		AddedByUser    uuid.UUID `db:"added_by_user"`
+	}
+	if err = repo.session.SelectContext(ctx, &existingChats, repo.session.Rebind(query), args...); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get referenced telegram chats", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	existing := make(map[chatKey]bool, len(existingChats))
+	for _, chat := range existingChats {
+		existing[chatKey{chat.ChatTelegramID, chat.AddedByUser}] = true
+	}
+	return existing, nil
+}
+
+func (repo *SQLXTelegramRecordRepository) StreamTelegramRecordsByUserTelegramID(
+	ctx context.Context,
+	userTelegramID uint64,
+	tag string,
+	yield func(*domain.TelegramRecord) error,
+) error {
+	repo.logger.DebugContext(
+		ctx,
+		"Started StreamTelegramRecordsByUserTelegramID request",
+		slog.Uint64("user_telegram_id", userTelegramID),
+	)
+	query := `SELECT r.id, r.message_telegram_id, r.from_telegram_user_id, r.in_telegram_chat_id,
+	COALESCE(r.message_text, '') AS message_text, r.entities, COALESCE(r.caption, '') AS caption,
+	r.caption_entities, r.posted_at, r.edited_at, r.deleted_at, r.reply_to_message_telegram_id, r.thread_telegram_id,
+	r.forward_from_user_telegram_id, r.forward_from_chat_telegram_id, r.forward_from_message_telegram_id,
+	r.forward_posted_at, r.added_at, r.added_by_user
+	FROM "records"."telegram_records" r
+	JOIN "records"."telegram_users" u ON u.id = r.from_telegram_user_id
+	WHERE u.telegram_id = $1 AND ` + recordTagFilter("$2") + ` AND "records".is_record_visible(r.id)
+	ORDER BY r.posted_at DESC, r.id`
+	args := []any{userTelegramID, tag}
+	return repo.stream(ctx, query, args, yield)
+}
+
+func (repo *SQLXTelegramRecordRepository) StreamTelegramRecordsByIndicator(
+	ctx context.Context,
+	indicatorType domain.TelegramIndicatorType,
+	value string,
+	tag string,
+	yield func(*domain.TelegramRecord) error,
+) error {
+	repo.logger.DebugContext(
+		ctx,
+		"Started StreamTelegramRecordsByIndicator request",
+		slog.String("indicator_type", string(indicatorType)),
+	)
+	query := `SELECT r.id, r.message_telegram_id, r.from_telegram_user_id, r.in_telegram_chat_id,
+	COALESCE(r.message_text, '') AS message_text, r.entities, COALESCE(r.caption, '') AS caption,
+	r.caption_entities, r.posted_at, r.edited_at, r.deleted_at, r.reply_to_message_telegram_id, r.thread_telegram_id,
+	r.forward_from_user_telegram_id, r.forward_from_chat_telegram_id, r.forward_from_message_telegram_id,
+	r.forward_posted_at, r.added_at, r.added_by_user
+	FROM "records"."telegram_records" r
+	JOIN "records"."telegram_indicators" i ON i.record_id = r.id
+	WHERE i.indicator_type = $1 AND i.value = $2 AND ` + recordTagFilter("$3") + `
+	AND "records".is_record_visible(r.id)
+	ORDER BY r.posted_at DESC, r.id`
+	args := []any{indicatorType, value, tag}
+	return repo.stream(ctx, query, args, yield)
+}
+
+func (repo *SQLXTelegramRecordRepository) stream(
+	ctx context.Context,
+	query string,
+	args []any,
+	yield func(*domain.TelegramRecord) error,
+) error {
+	err := cursor.Stream(ctx, repo.session, query, args, func(record *models.SQLXTelegramRecordModel) error {
+		domainRecord := repo.sqlxMapper.ToDomain(*record)
+		return yield(&domainRecord)
+	})
+	if errors.Is(err, repository.ErrDatabaseFailed) {
+		repo.logger.ErrorContext(ctx, "Failed to stream telegram records", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return err
+}
+
+// recordTagFilter keeps the records r holding the tag in the parameter, every record when the tag is empty.
+func recordTagFilter(parameter string) string {
+	return `(` + parameter + ` = '' OR EXISTS (
+		SELECT 1 FROM "records"."telegram_tags" t WHERE t.telegram_record_id = r.id AND t.name = ` + parameter + `
+	))`
+}
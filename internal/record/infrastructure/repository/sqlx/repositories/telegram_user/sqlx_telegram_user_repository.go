@@ -10,6 +10,7 @@ import (
 	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
 	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
 	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jmoiron/sqlx"
 )
@@ -43,7 +44,7 @@ func (repo *SQLXTelegramUserRepository) GetByTelegramID(
 	repo.logger.DebugContext(ctx, "Started GetByTelegramID request", slog.Uint64("telegram_id", telegramID))
 	var userModel models.TelegramUserModel
 	query := `SELECT id, telegram_id, added_at, added_by_user
-	FROM "records"."telegram_users" WHERE telegram_id = $1`
+	FROM "records"."telegram_users" WHERE telegram_id = $1 AND canonical_id IS NULL`
 	err := repo.session.GetContext(ctx, &userModel, query, telegramID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -73,10 +74,10 @@ func (repo *SQLXTelegramUserRepository) AddUser(ctx context.Context, user *domai
 		if errors.As(err, &pqErr) {
 			if pqErr.Code == "23505" {
 				switch pqErr.ConstraintName {
-				case "unique_telegram_id_per_user":
+				case "unique_telegram_id":
 					repo.logger.InfoContext(
 						ctx,
-						"Telegram user already added by this user",
+						"Telegram user already added",
 						slog.Uint64("telegram_id", user.TelegramID),
 						slog.String("constraint", pqErr.ConstraintName),
 					)
@@ -117,3 +118,61 @@ func (repo *SQLXTelegramUserRepository) DeleteUserByTelegramID(ctx context.Conte
 	}
 	return nil
 }
+
+func (repo *SQLXTelegramUserRepository) GetTelegramUsersByIDs(
+	ctx context.Context,
+	userIDs []uuid.UUID,
+) (*[]domain.TelegramUser, error) {
+	repo.logger.DebugContext(ctx, "Started GetTelegramUsersByIDs request", slog.Int("user_count", len(userIDs)))
+	if len(userIDs) == 0 {
+		return &[]domain.TelegramUser{}, nil
+	}
+	query, args, err := sqlx.In(`SELECT id, telegram_id, added_at, added_by_user
+	FROM "records"."telegram_users" WHERE id IN (?) AND "records".is_user_visible(id)
+	ORDER BY id`, userIDs)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to build telegram users query", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	var userModels []models.TelegramUserModel
+	if err = repo.session.SelectContext(ctx, &userModels, repo.session.Rebind(query), args...); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram users", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	users := make([]domain.TelegramUser, len(userModels))
+	for i, userModel := range userModels {
+		users[i] = repo.sqlxMapper.ToDomain(userModel)
+	}
+	return &users, nil
+}
+
+func (repo *SQLXTelegramUserRepository) GetTelegramUsersByTelegramIDs(
+	ctx context.Context,
+	telegramIDs []uint64,
+) (*[]domain.TelegramUser, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramUsersByTelegramIDs request",
+		slog.Int("user_count", len(telegramIDs)),
+	)
+	if len(telegramIDs) == 0 {
+		return &[]domain.TelegramUser{}, nil
+	}
+	query, args, err := sqlx.In(`SELECT id, telegram_id, added_at, added_by_user
+	FROM "records"."telegram_users" WHERE telegram_id IN (?) AND "records".is_user_visible(id)
+	ORDER BY id`, telegramIDs)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to build telegram users query", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	var userModels []models.TelegramUserModel
+	if err = repo.session.SelectContext(ctx, &userModels, repo.session.Rebind(query), args...); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram users", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	users := make([]domain.TelegramUser, len(userModels))
+	for i, userModel := range userModels {
+		users[i] = repo.sqlxMapper.ToDomain(userModel)
+	}
+	return &users, nil
+}
@@ -0,0 +1,207 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXTelegramWatchlistRepository struct {
+	session    *sqlx.Tx
+	sqlxMapper *mappers.SqlxTelegramWatchlistMapper
+	logger     *slog.Logger
+}
+
+func NewSQLXTelegramWatchlistRepository(
+	session *sqlx.Tx,
+	sqlxMapper *mappers.SqlxTelegramWatchlistMapper,
+	logger *slog.Logger,
+) repository.TelegramWatchlistRepository {
+	twrLogger := logger.With(
+		slog.String("component", "repository"),
+		slog.String("name", "sqlx_telegram_watchlist_repository"),
+	)
+	return &SQLXTelegramWatchlistRepository{
+		session:    session,
+		sqlxMapper: sqlxMapper,
+		logger:     twrLogger,
+	}
+}
+
+func (repo *SQLXTelegramWatchlistRepository) AddTelegramWatchlist(
+	ctx context.Context,
+	watchlist *domain.TelegramWatchlist,
+) error {
+	repo.logger.DebugContext(ctx, "Started AddTelegramWatchlist request", slog.String("watchlist_id", watchlist.ID.String()))
+	query := `INSERT INTO "records"."telegram_watchlists" (id, owner_user_id, name, created_at)
+	VALUES (:id, :owner_user_id, :name, :created_at)`
+	if _, err := repo.session.NamedExecContext(ctx, query, repo.sqlxMapper.ToModel(*watchlist)); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to add telegram watchlist", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramWatchlistRepository) GetTelegramWatchlistByID(
+	ctx context.Context,
+	watchlistID uuid.UUID,
+) (*domain.TelegramWatchlist, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramWatchlistByID request",
+		slog.String("watchlist_id", watchlistID.String()),
+	)
+	var watchlistModel models.TelegramWatchlistModel
+	query := `SELECT id, owner_user_id, name, created_at FROM "records"."telegram_watchlists" WHERE id = $1`
+	if err := repo.session.GetContext(ctx, &watchlistModel, query, watchlistID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrWatchlistNotFound
+		}
+		repo.logger.ErrorContext(ctx, "Failed to get telegram watchlist", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	watchlist := repo.sqlxMapper.ToDomain(watchlistModel)
+	return &watchlist, nil
+}
+
+func (repo *SQLXTelegramWatchlistRepository) GetTelegramWatchlistsByOwner(
+	ctx context.Context,
+	ownerUserID uuid.UUID,
+) (*[]domain.TelegramWatchlist, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetTelegramWatchlistsByOwner request",
+		slog.String("owner_user_id", ownerUserID.String()),
+	)
+	var watchlistModels []models.TelegramWatchlistModel
+	query := `SELECT id, owner_user_id, name, created_at FROM "records"."telegram_watchlists"
+	WHERE owner_user_id = $1
+	ORDER BY created_at, id`
+	if err := repo.session.SelectContext(ctx, &watchlistModels, query, ownerUserID); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram watchlists", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	var entryModels []models.TelegramWatchlistEntryModel
+	entriesQuery := `SELECT e.id, e.watchlist_id, e.entry_type, e.value, e.added_at
+	FROM "records"."telegram_watchlist_entries" e
+	JOIN "records"."telegram_watchlists" w ON w.id = e.watchlist_id
+	WHERE w.owner_user_id = $1
+	ORDER BY e.added_at, e.id`
+	if err := repo.session.SelectContext(ctx, &entryModels, entriesQuery, ownerUserID); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get telegram watchlist entries", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+
+	watchlists := make([]domain.TelegramWatchlist, len(watchlistModels))
+	watchlistIndexes := make(map[uuid.UUID]int, len(watchlistModels))
+	for i, watchlistModel := range watchlistModels {
+		watchlists[i] = repo.sqlxMapper.ToDomain(watchlistModel)
+		watchlists[i].Entries = make([]domain.TelegramWatchlistEntry, 0)
+		watchlistIndexes[watchlistModel.ID] = i
+	}
+	for _, entryModel := range entryModels {
+		i := watchlistIndexes[entryModel.WatchlistID]
+		watchlists[i].Entries = append(watchlists[i].Entries, repo.sqlxMapper.EntryToDomain(entryModel))
+	}
+	return &watchlists, nil
+}
+
+func (repo *SQLXTelegramWatchlistRepository) RemoveTelegramWatchlist(ctx context.Context, watchlistID uuid.UUID) error {
+	repo.logger.DebugContext(
+		ctx,
+		"Started RemoveTelegramWatchlist request",
+		slog.String("watchlist_id", watchlistID.String()),
+	)
+	query := `DELETE FROM "records"."telegram_watchlists" WHERE id = $1`
+	result, err := repo.session.ExecContext(ctx, query, watchlistID)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to remove telegram watchlist", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get rows affected", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	if rowsAffected == 0 {
+		return domain.ErrWatchlistNotFound
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramWatchlistRepository) RemoveTelegramWatchlistsByOwner(
+	ctx context.Context,
+	ownerUserID uuid.UUID,
+) (int64, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started RemoveTelegramWatchlistsByOwner request",
+		slog.String("owner_user_id", ownerUserID.String()),
+	)
+	query := `DELETE FROM "records"."telegram_watchlists" WHERE owner_user_id = $1`
+	result, err := repo.session.ExecContext(ctx, query, ownerUserID)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to remove telegram watchlists", slog.Any("err", err))
+		return 0, repository.ErrDatabaseFailed
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get rows affected", slog.Any("err", err))
+		return 0, repository.ErrDatabaseFailed
+	}
+	return rowsAffected, nil
+}
+
+func (repo *SQLXTelegramWatchlistRepository) AddTelegramWatchlistEntry(
+	ctx context.Context,
+	entry *domain.TelegramWatchlistEntry,
+) error {
+	repo.logger.DebugContext(ctx, "Started AddTelegramWatchlistEntry request", slog.String("entry_id", entry.ID.String()))
+	query := `INSERT INTO "records"."telegram_watchlist_entries" (id, watchlist_id, entry_type, value, added_at)
+	VALUES (:id, :watchlist_id, :entry_type, :value, :added_at)`
+	if _, err := repo.session.NamedExecContext(ctx, query, repo.sqlxMapper.EntryToModel(*entry)); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.ConstraintName {
+			case "unique_telegram_watchlist_entry":
+				repo.logger.InfoContext(ctx, "Telegram watchlist entry already exists")
+				return domain.ErrWatchlistEntryAlreadyExists
+			case "fk_telegram_watchlist_entries_watchlist":
+				repo.logger.InfoContext(ctx, "Telegram watchlist entry references a watchlist that doesn't exist")
+				return domain.ErrWatchlistNotFound
+			}
+		}
+		repo.logger.ErrorContext(ctx, "Failed to add telegram watchlist entry", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return nil
+}
+
+func (repo *SQLXTelegramWatchlistRepository) RemoveTelegramWatchlistEntry(
+	ctx context.Context,
+	watchlistID uuid.UUID,
+	entryID uuid.UUID,
+) (*domain.TelegramWatchlistEntry, error) {
+	repo.logger.DebugContext(ctx, "Started RemoveTelegramWatchlistEntry request", slog.String("entry_id", entryID.String()))
+	var entryModel models.TelegramWatchlistEntryModel
+	query := `DELETE FROM "records"."telegram_watchlist_entries" WHERE id = $1 AND watchlist_id = $2
+	RETURNING id, watchlist_id, entry_type, value, added_at`
+	if err := repo.session.GetContext(ctx, &entryModel, query, entryID, watchlistID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrWatchlistEntryNotFound
+		}
+		repo.logger.ErrorContext(ctx, "Failed to remove telegram watchlist entry", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	entry := repo.sqlxMapper.EntryToDomain(entryModel)
+	return &entry, nil
+}
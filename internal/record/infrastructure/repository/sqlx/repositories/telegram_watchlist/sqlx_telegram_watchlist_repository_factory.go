@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXTelegramWatchlistRepositoryFactory struct {
+	logger     *slog.Logger
+	sqlxMapper *mappers.SqlxTelegramWatchlistMapper
+}
+
+func NewSQLXTelegramWatchlistRepositoryFactory(
+	logger *slog.Logger,
+	mapper *mappers.SqlxTelegramWatchlistMapper,
+) repository.TelegramWatchlistRepositoryFactory {
+	return &SQLXTelegramWatchlistRepositoryFactory{
+		logger:     logger,
+		sqlxMapper: mapper,
+	}
+}
+
+func (factory *SQLXTelegramWatchlistRepositoryFactory) CreateTelegramWatchlistRepositoryWithTransaction(
+	tm interfaces.TransactionManager,
+) repository.TelegramWatchlistRepository {
+	tx, ok := tm.GetTransaction().(*sqlx.Tx)
+	if !ok {
+		factory.logger.Error("invalid transaction type, expected *sqlx.Tx")
+		panic("invalid transaction type for sqlx repository")
+	}
+	return &SQLXTelegramWatchlistRepository{
+		session:    tx,
+		sqlxMapper: factory.sqlxMapper,
+		logger:     factory.logger,
+	}
+}
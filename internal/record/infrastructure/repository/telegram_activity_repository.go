@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+)
+
+type TelegramActivityRepository interface {
+	// GetTelegramActivityStatistics aggregates the records of the user or the chat identified by telegramID.
+	// The days and hours are counted in the timezone, which is an IANA name. Up to maxBreakdown of the most active
+	// chats or senders and up to maxGaps gaps are returned.
+	GetTelegramActivityStatistics(
+		ctx context.Context,
+		scope domain.TelegramActivityScope,
+		telegramID int64,
+		timezone string,
+		maxBreakdown int,
+		maxGaps int,
+	) (*domain.TelegramActivityStatistics, error)
+}
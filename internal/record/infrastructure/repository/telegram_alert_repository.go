@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/google/uuid"
+)
+
+type TelegramAlertRepository interface {
+	// AddTelegramAlertsForRecords raises an alert for every watchlist entry the records match,
+	// the alerts already raised are skipped.
+	AddTelegramAlertsForRecords(ctx context.Context, recordIDs []uuid.UUID) error
+	// AddTelegramAlertsForIdentities raises an alert for every watchlist entry the identities match,
+	// the alerts already raised are skipped.
+	AddTelegramAlertsForIdentities(ctx context.Context, identityIDs []uuid.UUID) error
+	// GetTelegramAlertsByOwner returns the alerts of the user, the latest first.
+	GetTelegramAlertsByOwner(
+		ctx context.Context,
+		ownerUserID uuid.UUID,
+		unreadOnly bool,
+		limit int,
+		offset int,
+	) (*[]domain.TelegramAlert, error)
+	CountUnreadTelegramAlerts(ctx context.Context, ownerUserID uuid.UUID) (int, error)
+	// SetTelegramAlertReadAt marks the alert of the user read at readAt, or unread when readAt is nil.
+	// It returns the time the alert had been read at before, nil when it was unread.
+	SetTelegramAlertReadAt(
+		ctx context.Context,
+		alertID uuid.UUID,
+		ownerUserID uuid.UUID,
+		readAt *time.Time,
+	) (*time.Time, error)
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/google/uuid"
+)
+
+// TelegramAnnotationRepository lists the tags and the notes of the targets visible to the caller of the transaction,
+// as the provenance of the users, identities and records tells.
+type TelegramAnnotationRepository interface {
+	AddTelegramTag(ctx context.Context, tag *domain.TelegramTag) error
+	GetTelegramTag(
+		ctx context.Context,
+		targetType domain.TelegramAnnotationTargetType,
+		targetID uuid.UUID,
+		name string,
+	) (*domain.TelegramTag, error)
+	// GetTelegramTags returns the tags of the target, the oldest first.
+	GetTelegramTags(
+		ctx context.Context,
+		targetType domain.TelegramAnnotationTargetType,
+		targetID uuid.UUID,
+	) (*[]domain.TelegramTag, error)
+	// GetTelegramTagCatalogue returns the tags starting with the prefix along with the amount of targets
+	// holding them, the most used first.
+	GetTelegramTagCatalogue(ctx context.Context, prefix string, limit int) (*[]domain.TelegramTagCount, error)
+	// GetTelegramTagsByName returns the tags with the name, hence the targets holding it, the latest first.
+	// An empty target type returns the tags of every type.
+	GetTelegramTagsByName(
+		ctx context.Context,
+		name string,
+		targetType domain.TelegramAnnotationTargetType,
+		limit int,
+		offset int,
+	) (*[]domain.TelegramTag, error)
+	RemoveTelegramTag(ctx context.Context, tagID uuid.UUID) error
+	AddTelegramNote(ctx context.Context, note *domain.TelegramNote) error
+	GetTelegramNote(
+		ctx context.Context,
+		targetType domain.TelegramAnnotationTargetType,
+		targetID uuid.UUID,
+		noteID uuid.UUID,
+	) (*domain.TelegramNote, error)
+	// GetTelegramNotes returns the notes of the target, the oldest first.
+	GetTelegramNotes(
+		ctx context.Context,
+		targetType domain.TelegramAnnotationTargetType,
+		targetID uuid.UUID,
+	) (*[]domain.TelegramNote, error)
+	RemoveTelegramNote(ctx context.Context, noteID uuid.UUID) error
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/google/uuid"
+)
+
+type TelegramAttachmentRepository interface {
+	AddAttachment(ctx context.Context, attachment *domain.TelegramAttachment) error
+	GetAttachmentByID(ctx context.Context, attachmentID uuid.UUID) (*domain.TelegramAttachment, error)
+	GetAttachmentsByRecordID(ctx context.Context, recordID uuid.UUID) (*[]domain.TelegramAttachment, error)
+	// GetAttachmentsByIDs returns the existing attachments among the given ones, ordered by ID.
+	GetAttachmentsByIDs(ctx context.Context, attachmentIDs []uuid.UUID) (*[]domain.TelegramAttachment, error)
+	// GetAttachmentsByRecordIDs returns the attachments of the given records, ordered by ID.
+	GetAttachmentsByRecordIDs(ctx context.Context, recordIDs []uuid.UUID) (*[]domain.TelegramAttachment, error)
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/google/uuid"
+)
+
+type TelegramChainRepository interface {
+	// LockTelegramChainHead creates the head of the chain of the collector in the team of the transaction unless
+	// it exists and locks it until the end of the transaction. Heads are to be locked in the order of their
+	// collectors.
+	LockTelegramChainHead(ctx context.Context, collectorUserID uuid.UUID) (*domain.TelegramChainHead, error)
+	// AppendTelegramChainEntries adds the entries and moves the head of their chain to the last one,
+	// the head has to be locked beforehand.
+	AppendTelegramChainEntries(ctx context.Context, entries []domain.TelegramChainEntry) error
+	// GetLatestTelegramChainContentHashes returns the content hash of the latest entry of each given subject,
+	// the subjects no entry covers are left out.
+	GetLatestTelegramChainContentHashes(
+		ctx context.Context,
+		subjectType domain.TelegramChainSubjectType,
+		subjectIDs []uuid.UUID,
+	) (map[uuid.UUID]string, error)
+	// GetTelegramChainHeads returns the heads of all chains along with their latest checkpoint,
+	// ordered by collector and team.
+	GetTelegramChainHeads(ctx context.Context) (*[]domain.TelegramChainHead, error)
+	// GetTelegramChainEntries returns up to limit entries of the chain of the collector in the team following
+	// afterSequence, so that a chain can be walked page by page starting from 0.
+	GetTelegramChainEntries(
+		ctx context.Context,
+		teamID uuid.NullUUID,
+		collectorUserID uuid.UUID,
+		afterSequence int64,
+		limit int,
+	) (*[]domain.TelegramChainEntry, error)
+	// AddTelegramChainCheckpoint keeps the checkpoint in the team of the chain it has signed.
+	AddTelegramChainCheckpoint(ctx context.Context, checkpoint *domain.TelegramChainCheckpoint) error
+	// GetTelegramChainCheckpoints returns the checkpoints of the chain of the collector in the team,
+	// the oldest first.
+	GetTelegramChainCheckpoints(
+		ctx context.Context,
+		teamID uuid.NullUUID,
+		collectorUserID uuid.UUID,
+	) (*[]domain.TelegramChainCheckpoint, error)
+	// CountUnchainedTelegramSubjects counts the records, identities and attachments no entry covers,
+	// e.g. the ones archived before the chains.
+	CountUnchainedTelegramSubjects(ctx context.Context) (map[domain.TelegramChainSubjectType]int64, error)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/google/uuid"
+)
+
+type TelegramChatRepository interface {
+	AddChat(ctx context.Context, chat *domain.TelegramChat) error
+	GetChatByTelegramID(
+		ctx context.Context,
+		chatTelegramID int64,
+		addedByUser uuid.UUID,
+	) (*domain.TelegramChat, error)
+	// UpdateChatType sets the type of a stub chat once its metadata is added
+	UpdateChatType(ctx context.Context, chatID uuid.UUID, chatType domain.TelegramChatType) error
+	// AddChatSnapshot returns ErrChatSnapshotAlreadyExists when the metadata hasn't changed since the latest snapshot
+	AddChatSnapshot(ctx context.Context, snapshot *domain.TelegramChatSnapshot) error
+	GetChatSnapshotsByChatID(ctx context.Context, chatID uuid.UUID) (*[]domain.TelegramChatSnapshot, error)
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/google/uuid"
+)
+
+type TelegramExportRepository interface {
+	AddTelegramExport(ctx context.Context, export *domain.TelegramExport) error
+	GetTelegramExportByID(ctx context.Context, exportID uuid.UUID) (*domain.TelegramExport, error)
+	// GetTelegramExportsByRequester returns the exports requested by the user, the latest first.
+	GetTelegramExportsByRequester(
+		ctx context.Context,
+		requestedBy uuid.UUID,
+		limit int,
+		offset int,
+	) (*[]domain.TelegramExport, error)
+	// ClaimTelegramExport marks the oldest pending export running, or a running one whose lease has expired,
+	// and keeps it from the other workers until leaseUntil. ErrTelegramExportNotFound means none is waiting.
+	ClaimTelegramExport(ctx context.Context, now, leaseUntil time.Time) (*domain.TelegramExport, error)
+	// UpdateTelegramExportProgress stores the progress of a running export and extends its lease.
+	UpdateTelegramExportProgress(
+		ctx context.Context,
+		exportID uuid.UUID,
+		progress domain.TelegramExportProgress,
+		leaseUntil time.Time,
+	) error
+	// FinishTelegramExport stores the outcome of a completed or failed export and releases its lease.
+	FinishTelegramExport(ctx context.Context, export *domain.TelegramExport) error
+}
@@ -0,0 +1,7 @@
+package repository
+
+import "github.com/InWamos/trinity-proto/internal/shared/interfaces"
+
+type TelegramExportRepositoryFactory interface {
+	CreateTelegramExportRepositoryWithTransaction(tm interfaces.TransactionManager) TelegramExportRepository
+}
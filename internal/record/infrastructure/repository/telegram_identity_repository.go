@@ -13,8 +13,32 @@ var (
 	ErrFailedToAddIdentity = errors.New("failed to add identity to a database")
 )
 
+// TelegramIdentityRepository lists the identities visible to the caller of the transaction, as the provenance
+// of their users tells: GetIdentitiesByUserIDs, StreamIdentitiesByUserTelegramID and GetTelegramCorrelationClusters.
 type TelegramIdentityRepository interface {
 	AddIdentity(ctx context.Context, identity *domain.TelegramIdentity) error
 	RemoveIdentityByID(ctx context.Context, identityID uuid.UUID) error
 	GetIdentityByID(ctx context.Context, identityID uuid.UUID) (*domain.TelegramIdentity, error)
+	// GetIdentitiesByIDs returns the existing identities among the given ones, ordered by ID.
+	GetIdentitiesByIDs(ctx context.Context, identityIDs []uuid.UUID) (*[]domain.TelegramIdentity, error)
+	// GetIdentitiesByUserIDs returns the identities of the given users, ordered by ID.
+	GetIdentitiesByUserIDs(ctx context.Context, userIDs []uuid.UUID) (*[]domain.TelegramIdentity, error)
+	// StreamIdentitiesByUserTelegramID passes the identities of the user, as added by every collector, to yield
+	// in the order they have been added. The errors of yield stop the stream and are returned as they are.
+	// A non-empty tag keeps the identities holding it.
+	StreamIdentitiesByUserTelegramID(
+		ctx context.Context,
+		userTelegramID uint64,
+		tag string,
+		yield func(*domain.TelegramIdentity) error,
+	) error
+	// GetTelegramCorrelationClusters lists the values of the given types shared by the identities of different users,
+	// the clusters linking the most users first. A non-zero userTelegramID keeps the clusters the user belongs to.
+	GetTelegramCorrelationClusters(
+		ctx context.Context,
+		correlationTypes []domain.TelegramCorrelationType,
+		userTelegramID uint64,
+		limit int,
+		offset int,
+	) (*[]domain.TelegramCorrelationCluster, error)
 }
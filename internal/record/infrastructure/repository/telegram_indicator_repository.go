@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+)
+
+type TelegramIndicatorRepository interface {
+	// AddTelegramIndicators stores the indicators, the ones already stored for their records are skipped.
+	AddTelegramIndicators(ctx context.Context, indicators []domain.TelegramIndicator) error
+	// GetTelegramIndicatorsByUserTelegramID aggregates the indicators of the records sent by the user,
+	// the most mentioned first.
+	GetTelegramIndicatorsByUserTelegramID(
+		ctx context.Context,
+		userTelegramID uint64,
+	) (*[]domain.TelegramIndicatorSummary, error)
+}
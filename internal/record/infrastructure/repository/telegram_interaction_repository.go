@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/google/uuid"
+)
+
+type TelegramInteractionRepository interface {
+	// DeriveTelegramInteractions stores the replies, the mentions and the shared chat of the record as interactions
+	// of its sender. A record is only derived once, later calls are no-ops, so the indicators of the record have
+	// to be stored beforehand.
+	DeriveTelegramInteractions(ctx context.Context, recordID uuid.UUID) error
+	// GetTelegramInteractionGraph returns the users up to depth interactions away from the user, at most maxNodes
+	// of them with the closest first, and the interactions between them summed up per user pair and type.
+	// Only the interactions derived from records visible to the caller are walked and weighed.
+	GetTelegramInteractionGraph(
+		ctx context.Context,
+		userTelegramID uint64,
+		depth int,
+		maxNodes int,
+	) (*domain.TelegramInteractionGraph, error)
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/google/uuid"
+)
+
+type TelegramInvestigationRepository interface {
+	AddTelegramInvestigation(ctx context.Context, investigation *domain.TelegramInvestigation) error
+	// GetTelegramInvestigationByID returns the investigation without its members and items.
+	GetTelegramInvestigationByID(ctx context.Context, investigationID uuid.UUID) (*domain.TelegramInvestigation, error)
+	// LockTelegramInvestigation returns the investigation like GetTelegramInvestigationByID and locks it until
+	// the end of the transaction, so that its members are changed one transaction at a time.
+	LockTelegramInvestigation(ctx context.Context, investigationID uuid.UUID) (*domain.TelegramInvestigation, error)
+	// GetTelegramInvestigationsByMember returns the investigations the user is a member of with their members,
+	// the newest first.
+	GetTelegramInvestigationsByMember(ctx context.Context, userID uuid.UUID) (*[]domain.TelegramInvestigation, error)
+	// RemoveTelegramInvestigation removes the investigation along with its members and items.
+	RemoveTelegramInvestigation(ctx context.Context, investigationID uuid.UUID) error
+	GetTelegramInvestigationMember(
+		ctx context.Context,
+		investigationID uuid.UUID,
+		userID uuid.UUID,
+	) (*domain.TelegramInvestigationMember, error)
+	// GetTelegramInvestigationMembers returns the members of the investigation, the oldest first.
+	GetTelegramInvestigationMembers(
+		ctx context.Context,
+		investigationID uuid.UUID,
+	) (*[]domain.TelegramInvestigationMember, error)
+	// SaveTelegramInvestigationMember adds the member or changes the role of the user already taking part.
+	SaveTelegramInvestigationMember(ctx context.Context, member *domain.TelegramInvestigationMember) error
+	// RemoveTelegramInvestigationMember removes the user from the investigation and returns the membership.
+	RemoveTelegramInvestigationMember(
+		ctx context.Context,
+		investigationID uuid.UUID,
+		userID uuid.UUID,
+	) (*domain.TelegramInvestigationMember, error)
+	// RemoveTelegramInvestigationMembershipsByUser removes the user from every investigation, removes
+	// the investigations left without members and returns how many memberships there were.
+	RemoveTelegramInvestigationMembershipsByUser(ctx context.Context, userID uuid.UUID) (int64, error)
+	AddTelegramInvestigationItem(ctx context.Context, item *domain.TelegramInvestigationItem) error
+	// GetTelegramInvestigationItems returns the items of the investigation, the oldest first,
+	// leaving out the users and the records the caller of the transaction doesn't see.
+	GetTelegramInvestigationItems(
+		ctx context.Context,
+		investigationID uuid.UUID,
+	) (*[]domain.TelegramInvestigationItem, error)
+	// RemoveTelegramInvestigationItem removes the item of the investigation and returns it.
+	RemoveTelegramInvestigationItem(
+		ctx context.Context,
+		investigationID uuid.UUID,
+		itemID uuid.UUID,
+	) (*domain.TelegramInvestigationItem, error)
+}
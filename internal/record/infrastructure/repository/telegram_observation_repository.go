@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/google/uuid"
+)
+
+type TelegramObservationRepository interface {
+	// AddTelegramObservations stores the observations their collectors haven't made yet, the other ones are skipped.
+	// It returns the targets observed for the first time by the collectors.
+	AddTelegramObservations(ctx context.Context, observations []domain.TelegramObservation) ([]uuid.UUID, error)
+	// GetTelegramObservations returns the observations of the target, the earliest first.
+	GetTelegramObservations(
+		ctx context.Context,
+		targetType domain.TelegramObservationTargetType,
+		targetID uuid.UUID,
+	) (*[]domain.TelegramObservation, error)
+	// UpdateTelegramObservationVisibility changes the visibility of the observation the collector has made
+	// of the target, domain.ErrObservationNotFound is returned when it hasn't observed it.
+	UpdateTelegramObservationVisibility(
+		ctx context.Context,
+		targetType domain.TelegramObservationTargetType,
+		targetID uuid.UUID,
+		collectorUserID uuid.UUID,
+		visibility domain.TelegramVisibility,
+	) error
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/google/uuid"
+)
+
+type TelegramProfilePictureRepository interface {
+	AddProfilePicture(ctx context.Context, picture *domain.TelegramProfilePicture) error
+	GetProfilePictureByID(ctx context.Context, pictureID uuid.UUID) (*domain.TelegramProfilePicture, error)
+	GetProfilePicturesByUserTelegramID(
+		ctx context.Context,
+		userTelegramID uint64,
+	) (*[]domain.TelegramProfilePicture, error)
+}
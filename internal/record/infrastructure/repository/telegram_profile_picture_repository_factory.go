@@ -0,0 +1,9 @@
+package repository
+
+import "github.com/InWamos/trinity-proto/internal/shared/interfaces"
+
+type TelegramProfilePictureRepositoryFactory interface {
+	CreateTelegramProfilePictureRepositoryWithTransaction(
+		tm interfaces.TransactionManager,
+	) TelegramProfilePictureRepository
+}
@@ -3,8 +3,10 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/google/uuid"
 )
 
 var (
@@ -12,11 +14,90 @@ var (
 	ErrDatabaseFailed               = errors.New("database request has failed")
 )
 
+// TelegramRecordRepository reads the records visible to the caller of the transaction, as the provenance
+// of each one tells, except for GetTelegramRecordByMessageTelegramID, GetTelegramRecordsAfterID
+// and GetTelegramRecordsByIDs: the records are stored and analyzed once for all collectors.
 type TelegramRecordRepository interface {
+	// GetLatestTelegramRecordsByUserTelegramID returns the latest records of the user.
+	// A non-empty tag only keeps the records holding it, in the other queries of records as well.
 	GetLatestTelegramRecordsByUserTelegramID(
 		ctx context.Context,
 		userTelegramID uint64,
+		tag string,
 	) (*[]domain.TelegramRecord, error)
+	// CreateTelegramRecord returns domain.ErrRecordAlreadyExists when the message is already stored,
+	// whichever collector has added it.
 	CreateTelegramRecord(ctx context.Context, telegramRecord domain.TelegramRecord) error
-	CreateTelegramRecords(ctx context.Context, telegramRecords []domain.TelegramRecord) error
+	// CreateTelegramRecords inserts all records it can and reports the outcome per record:
+	// the returned slice is aligned with the input and holds nil for inserted records
+	// or a domain error for skipped ones. The error is only returned when the whole batch has failed.
+	CreateTelegramRecords(ctx context.Context, telegramRecords []domain.TelegramRecord) ([]error, error)
+	GetTelegramRecordByID(ctx context.Context, recordID uuid.UUID) (*domain.TelegramRecord, error)
+	// GetTelegramRecordByMessageTelegramID returns the canonical record of the message of the chat,
+	// domain.ErrRecordNotFound when it isn't stored or the caller of the transaction doesn't see it.
+	GetTelegramRecordByMessageTelegramID(
+		ctx context.Context,
+		messageTelegramID uint64,
+		chatTelegramID int64,
+	) (*domain.TelegramRecord, error)
+	// UpdateTelegramRecordContent replaces the text, the caption and their entities of the record with the ones
+	// of content. The superseded version has to be kept with AddTelegramRecordRevision beforehand.
+	UpdateTelegramRecordContent(
+		ctx context.Context,
+		recordID uuid.UUID,
+		content domain.TelegramRecord,
+		editedAt time.Time,
+	) error
+	MarkTelegramRecordDeleted(ctx context.Context, recordID uuid.UUID, deletedAt time.Time) error
+	AddTelegramRecordRevision(ctx context.Context, revision *domain.TelegramRecordRevision) error
+	// GetTelegramRecordRevisions returns the superseded versions of the record, the oldest first.
+	GetTelegramRecordRevisions(ctx context.Context, recordID uuid.UUID) (*[]domain.TelegramRecordRevision, error)
+	// GetTelegramRecordThread returns up to limit records of the reply thread the record belongs to,
+	// from its root to the latest reply, the record itself included.
+	GetTelegramRecordThread(ctx context.Context, recordID uuid.UUID, limit int) (*[]domain.TelegramRecord, error)
+	// GetTelegramRecordsForwardedFrom returns up to limit records, the latest first, forwarded from
+	// the origin. Only the set fields of the origin are matched, PostedAt is ignored.
+	GetTelegramRecordsForwardedFrom(
+		ctx context.Context,
+		origin domain.TelegramForwardOrigin,
+		limit int,
+	) (*[]domain.TelegramRecord, error)
+	// GetTelegramRecordsByIndicator returns up to limit records, the latest first, mentioning the indicator.
+	GetTelegramRecordsByIndicator(
+		ctx context.Context,
+		indicatorType domain.TelegramIndicatorType,
+		value string,
+		tag string,
+		limit int,
+	) (*[]domain.TelegramRecord, error)
+	// GetTelegramRecordsAfterID returns up to limit records ordered by ID, following afterID,
+	// so that all records can be walked page by page starting from uuid.Nil.
+	GetTelegramRecordsAfterID(ctx context.Context, afterID uuid.UUID, limit int) (*[]domain.TelegramRecord, error)
+	// GetTelegramRecordsByIDs returns the existing records among the given ones, ordered by ID.
+	GetTelegramRecordsByIDs(ctx context.Context, recordIDs []uuid.UUID) (*[]domain.TelegramRecord, error)
+	// CountTelegramRecordsBySelection counts the records posted by the users or in the chats of the selection.
+	CountTelegramRecordsBySelection(ctx context.Context, selection domain.TelegramExportSelection) (int64, error)
+	// GetTelegramRecordsBySelection pages the records of the selection like GetTelegramRecordsAfterID.
+	GetTelegramRecordsBySelection(
+		ctx context.Context,
+		selection domain.TelegramExportSelection,
+		afterID uuid.UUID,
+		limit int,
+	) (*[]domain.TelegramRecord, error)
+	// StreamTelegramRecordsByUserTelegramID passes every record of the user to yield, the latest first.
+	// The errors of yield stop the stream and are returned as they are.
+	StreamTelegramRecordsByUserTelegramID(
+		ctx context.Context,
+		userTelegramID uint64,
+		tag string,
+		yield func(*domain.TelegramRecord) error,
+	) error
+	// StreamTelegramRecordsByIndicator passes every record mentioning the indicator to yield, the latest first.
+	StreamTelegramRecordsByIndicator(
+		ctx context.Context,
+		indicatorType domain.TelegramIndicatorType,
+		value string,
+		tag string,
+		yield func(*domain.TelegramRecord) error,
+	) error
 }
@@ -4,10 +4,18 @@ import (
 	"context"
 
 	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/google/uuid"
 )
 
 type TelegramUserRepository interface {
+	// GetByTelegramID returns the canonical user of the telegram ID, whichever collector has added it.
 	GetByTelegramID(ctx context.Context, telegramID uint64) (*domain.TelegramUser, error)
 	AddUser(ctx context.Context, user *domain.TelegramUser) error
 	DeleteUserByTelegramID(ctx context.Context, telegramID uint64) error
+	// GetTelegramUsersByIDs returns the existing users among the given ones, ordered by ID.
+	// It and GetTelegramUsersByTelegramIDs only return the users visible to the caller of the transaction.
+	GetTelegramUsersByIDs(ctx context.Context, userIDs []uuid.UUID) (*[]domain.TelegramUser, error)
+	// GetTelegramUsersByTelegramIDs returns the users of the given telegram IDs added by any user, ordered by ID.
+	// The duplicates stored per collector before the users were deduplicated are returned as well.
+	GetTelegramUsersByTelegramIDs(ctx context.Context, telegramIDs []uint64) (*[]domain.TelegramUser, error)
 }
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/google/uuid"
+)
+
+type TelegramWatchlistRepository interface {
+	AddTelegramWatchlist(ctx context.Context, watchlist *domain.TelegramWatchlist) error
+	// GetTelegramWatchlistByID returns the watchlist without its entries.
+	GetTelegramWatchlistByID(ctx context.Context, watchlistID uuid.UUID) (*domain.TelegramWatchlist, error)
+	// GetTelegramWatchlistsByOwner returns the watchlists of the user with their entries, the oldest first.
+	GetTelegramWatchlistsByOwner(ctx context.Context, ownerUserID uuid.UUID) (*[]domain.TelegramWatchlist, error)
+	// RemoveTelegramWatchlist removes the watchlist along with its entries and their alerts.
+	RemoveTelegramWatchlist(ctx context.Context, watchlistID uuid.UUID) error
+	// RemoveTelegramWatchlistsByOwner removes every watchlist of the user and returns how many there were.
+	RemoveTelegramWatchlistsByOwner(ctx context.Context, ownerUserID uuid.UUID) (int64, error)
+	AddTelegramWatchlistEntry(ctx context.Context, entry *domain.TelegramWatchlistEntry) error
+	// RemoveTelegramWatchlistEntry removes the entry of the watchlist along with its alerts and returns it.
+	RemoveTelegramWatchlistEntry(
+		ctx context.Context,
+		watchlistID uuid.UUID,
+		entryID uuid.UUID,
+	) (*domain.TelegramWatchlistEntry, error)
+}
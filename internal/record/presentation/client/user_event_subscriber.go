@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/investigation"
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/watchlist"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/event/client"
+	"github.com/google/uuid"
+)
+
+// UserEventSubscriber cleans up the data the record module keeps on behalf of the removed users.
+type UserEventSubscriber struct {
+	removeUserTelegramWatchlistsInteractor               *watchlist.RemoveUserTelegramWatchlists
+	removeUserTelegramInvestigationMembershipsInteractor *investigation.RemoveUserTelegramInvestigationMemberships
+	logger                                               *slog.Logger
+}
+
+func NewUserEventSubscriber(
+	removeUserTelegramWatchlistsInteractor *watchlist.RemoveUserTelegramWatchlists,
+	removeUserTelegramInvestigationMembershipsInteractor *investigation.RemoveUserTelegramInvestigationMemberships,
+	logger *slog.Logger,
+) client.Subscriber {
+	uesLogger := logger.With(slog.String("component", "user_event_subscriber"))
+	return &UserEventSubscriber{
+		removeUserTelegramWatchlistsInteractor:               removeUserTelegramWatchlistsInteractor,
+		removeUserTelegramInvestigationMembershipsInteractor: removeUserTelegramInvestigationMembershipsInteractor,
+		logger: uesLogger,
+	}
+}
+
+func (subscriber *UserEventSubscriber) EventTypes() []client.EventType {
+	return []client.EventType{client.UserRemoved}
+}
+
+func (subscriber *UserEventSubscriber) Handle(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	event client.Event,
+) error {
+	var data struct {
+		UserID uuid.UUID `json:"user_id"`
+	}
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		subscriber.logger.ErrorContext(
+			ctx,
+			"failed to unmarshal event data",
+			slog.String("event_id", event.ID.String()),
+			slog.Any("err", err),
+		)
+		return client.ErrUnexpectedError
+	}
+	watchlistsRequest := watchlist.RemoveUserTelegramWatchlistsRequest{UserID: data.UserID}
+	err := subscriber.removeUserTelegramWatchlistsInteractor.Execute(ctx, transactionManager, watchlistsRequest)
+	if err != nil {
+		return client.ErrUnexpectedError
+	}
+	membershipsRequest := investigation.RemoveUserTelegramInvestigationMembershipsRequest{UserID: data.UserID}
+	err = subscriber.removeUserTelegramInvestigationMembershipsInteractor.Execute(
+		ctx,
+		transactionManager,
+		membershipsRequest,
+	)
+	if err != nil {
+		return client.ErrUnexpectedError
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"github.com/InWamos/trinity-proto/internal/record/presentation/v1/handlers"
+	"github.com/go-chi/chi/v5"
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+)
+
+// AlertMuxV1 serves the alerts raised by the watchlists of the current user.
+type AlertMuxV1 struct {
+	mux *chi.Mux
+}
+
+func NewAlertMuxV1(
+	getTelegramAlerts *handlers.GetTelegramAlertsHandler,
+	markTelegramAlertRead *handlers.MarkTelegramAlertReadHandler,
+) *AlertMuxV1 {
+	mux := chi.NewRouter()
+	mux.Use(chiMiddleware.AllowContentType("application/json"))
+	mux.Get("/", getTelegramAlerts.ServeHTTP)
+	mux.Patch("/{alert_id}", markTelegramAlertRead.ServeHTTP)
+	return &AlertMuxV1{
+		mux: mux,
+	}
+}
+
+func (am *AlertMuxV1) GetMux() *chi.Mux {
+	return am.mux
+}
@@ -0,0 +1,39 @@
+package v1
+
+import (
+	"github.com/InWamos/trinity-proto/internal/record/presentation/v1/handlers"
+	"github.com/go-chi/chi/v5"
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+)
+
+// AnnotationMuxV1 serves the tags and the notes of the telegram users, identities and records.
+type AnnotationMuxV1 struct {
+	mux *chi.Mux
+}
+
+func NewAnnotationMuxV1(
+	getTelegramTagCatalogue *handlers.GetTelegramTagCatalogueHandler,
+	getTelegramTaggedTargets *handlers.GetTelegramTaggedTargetsHandler,
+	getTelegramAnnotations *handlers.GetTelegramAnnotationsHandler,
+	addTelegramTag *handlers.AddTelegramTagHandler,
+	removeTelegramTag *handlers.RemoveTelegramTagHandler,
+	addTelegramNote *handlers.AddTelegramNoteHandler,
+	removeTelegramNote *handlers.RemoveTelegramNoteHandler,
+) *AnnotationMuxV1 {
+	mux := chi.NewRouter()
+	mux.Use(chiMiddleware.AllowContentType("application/json"))
+	mux.Get("/tags", getTelegramTagCatalogue.ServeHTTP)
+	mux.Get("/tags/{tag}", getTelegramTaggedTargets.ServeHTTP)
+	mux.Get("/{target_type}/{target_id}", getTelegramAnnotations.ServeHTTP)
+	mux.Post("/{target_type}/{target_id}/tags", addTelegramTag.ServeHTTP)
+	mux.Delete("/{target_type}/{target_id}/tags/{tag}", removeTelegramTag.ServeHTTP)
+	mux.Post("/{target_type}/{target_id}/notes", addTelegramNote.ServeHTTP)
+	mux.Delete("/{target_type}/{target_id}/notes/{note_id}", removeTelegramNote.ServeHTTP)
+	return &AnnotationMuxV1{
+		mux: mux,
+	}
+}
+
+func (am *AnnotationMuxV1) GetMux() *chi.Mux {
+	return am.mux
+}
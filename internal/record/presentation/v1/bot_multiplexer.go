@@ -0,0 +1,26 @@
+package v1
+
+import (
+	"github.com/InWamos/trinity-proto/internal/record/presentation/v1/handlers"
+	"github.com/go-chi/chi/v5"
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+)
+
+// BotMuxV1 serves the Telegram Bot API webhooks. It's mounted apart from RecordMuxV1,
+// as Telegram authenticates with a secret token instead of a session.
+type BotMuxV1 struct {
+	mux *chi.Mux
+}
+
+func NewBotMuxV1(receiveTelegramBotUpdate *handlers.ReceiveTelegramBotUpdateHandler) *BotMuxV1 {
+	mux := chi.NewRouter()
+	mux.Use(chiMiddleware.AllowContentType("application/json"))
+	mux.Post("/telegram/webhook", receiveTelegramBotUpdate.ServeHTTP)
+	return &BotMuxV1{
+		mux: mux,
+	}
+}
+
+func (bm *BotMuxV1) GetMux() *chi.Mux {
+	return bm.mux
+}
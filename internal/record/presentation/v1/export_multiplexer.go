@@ -0,0 +1,33 @@
+package v1
+
+import (
+	"github.com/InWamos/trinity-proto/internal/record/presentation/v1/handlers"
+	"github.com/go-chi/chi/v5"
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+)
+
+// ExportMuxV1 serves the evidence exports of the current user.
+type ExportMuxV1 struct {
+	mux *chi.Mux
+}
+
+func NewExportMuxV1(
+	requestTelegramExport *handlers.RequestTelegramExportHandler,
+	getTelegramExports *handlers.GetTelegramExportsHandler,
+	getTelegramExport *handlers.GetTelegramExportHandler,
+	downloadTelegramExport *handlers.DownloadTelegramExportHandler,
+) *ExportMuxV1 {
+	mux := chi.NewRouter()
+	mux.Use(chiMiddleware.AllowContentType("application/json"))
+	mux.Post("/", requestTelegramExport.ServeHTTP)
+	mux.Get("/", getTelegramExports.ServeHTTP)
+	mux.Get("/{export_id}", getTelegramExport.ServeHTTP)
+	mux.Get("/{export_id}/download", downloadTelegramExport.ServeHTTP)
+	return &ExportMuxV1{
+		mux: mux,
+	}
+}
+
+func (em *ExportMuxV1) GetMux() *chi.Mux {
+	return em.mux
+}
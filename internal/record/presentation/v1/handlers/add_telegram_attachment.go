@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/InWamos/trinity-proto/config"
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/attachment"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	blobstorage "github.com/InWamos/trinity-proto/internal/shared/infrastructure/blob_storage"
+	"github.com/google/uuid"
+)
+
+// AddTelegramAttachmentResponse represents the response payload after successfully uploading an attachment.
+type AddTelegramAttachmentResponse struct {
+	AttachmentID string `json:"attachment_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	SHA256       string `json:"sha256"        example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	MimeType     string `json:"mime_type"     example:"image/jpeg"`
+	FileSize     uint64 `json:"file_size"     example:"102400"`
+}
+
+type AddTelegramAttachmentHandler struct {
+	interactor    *application.AddTelegramAttachment
+	maxUploadSize int64
+	logger        *slog.Logger
+}
+
+func NewAddTelegramAttachmentHandler(
+	interactor *application.AddTelegramAttachment,
+	storageConfig *config.StorageConfig,
+	logger *slog.Logger,
+) *AddTelegramAttachmentHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "add_telegram_attachment_handler"),
+	)
+
+	return &AddTelegramAttachmentHandler{
+		interactor:    interactor,
+		maxUploadSize: storageConfig.MaxUploadSize,
+		logger:        handlerLogger,
+	}
+}
+
+// ServeHTTP handles multipart uploads of a record attachment.
+// The file is streamed part by part, so the optional fields must precede the file part.
+//
+//	@Summary		Upload a record attachment
+//	@Description	Uploads a file and links it to the record. The MIME type is sniffed from the content.
+//	@Tags			record
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			record_id				path		string	true	"Record ID"
+//	@Param			telegram_attachment_id	formData	int		false	"Telegram ID of the file"
+//	@Param			file					formData	file	true	"Attachment content"
+//	@Success		201						{object}	AddTelegramAttachmentResponse
+//	@Failure		400						{string}	string	"Invalid request format"
+//	@Failure		403						{string}	string	"Insufficient privileges"
+//	@Failure		409						{string}	string	"Attachment already exists or record not found"
+//	@Failure		413						{string}	string	"File is too large"
+//	@Failure		422						{string}	string	"Attachment contains unprocessable fields"
+//	@Failure		500						{string}	string	"Internal server error"
+//	@Router			/v1/record/telegram/record/{record_id}/attachment [post]
+func (handler *AddTelegramAttachmentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	recordID, err := uuid.Parse(r.PathValue("record_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid record ID format", slog.Any("err", err))
+		http.Error(w, "Invalid record ID format", http.StatusBadRequest)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, handler.maxUploadSize+multipartOverhead)
+	reader, err := r.MultipartReader()
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid multipart request", slog.Any("err", err))
+		http.Error(w, "Invalid request format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.AddTelegramAttachmentRequest{RecordID: recordID}
+	filePart, err := nextMultipartFile(reader, func(name string, value string) error {
+		if name != "telegram_attachment_id" {
+			return fmt.Errorf("%w %q", errUnexpectedFormField, name)
+		}
+		telegramAttachmentID, parseErr := strconv.ParseUint(value, 10, 64)
+		requestDTO.TelegramAttachmentID = telegramAttachmentID
+		return parseErr
+	})
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid multipart request", slog.Any("err", err))
+		http.Error(w, "Invalid request format", http.StatusBadRequest)
+		return
+	}
+	defer filePart.Close()
+	requestDTO.FileName = filePart.FileName()
+	requestDTO.Content = filePart
+
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, blobstorage.ErrBlobTooLarge):
+			handler.logger.DebugContext(r.Context(), "Attachment is too large", slog.Any("err", err))
+			http.Error(w, "File is too large", http.StatusRequestEntityTooLarge)
+			return
+		case errors.Is(err, blobstorage.ErrBlobEmpty), errors.Is(err, blobstorage.ErrBlobReadFailed):
+			handler.logger.DebugContext(r.Context(), "Attachment could not be read", slog.Any("err", err))
+			http.Error(w, "Invalid request format", http.StatusBadRequest)
+			return
+		case errors.Is(err, domain.ErrValidationFailed):
+			handler.logger.DebugContext(r.Context(), "Validation has failed", slog.Any("err", err))
+			http.Error(w, "Attachment contains unprocessable fields", http.StatusUnprocessableEntity)
+			return
+		case errors.Is(err, domain.ErrUnexistentTelegramRecordReferenced):
+			handler.logger.DebugContext(r.Context(), "Unexistent record referenced", slog.Any("err", err))
+			http.Error(w, "This attachment references record that hasn't been added yet", http.StatusConflict)
+			return
+		case errors.Is(err, domain.ErrAttachmentAlreadyExists):
+			handler.logger.DebugContext(r.Context(), "This attachment already exists", slog.Any("err", err))
+			http.Error(w, "This file is already attached to the record", http.StatusConflict)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Storage error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := AddTelegramAttachmentResponse{
+		AttachmentID: resp.AttachmentID.String(),
+		SHA256:       resp.SHA256,
+		MimeType:     resp.MimeType,
+		FileSize:     resp.FileSize,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/chat"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+)
+
+// AddTelegramChatRequest represents the request payload for adding a telegram chat or its new snapshot.
+type AddTelegramChatRequest struct {
+	ChatTelegramID int64  `json:"chat_telegram_id" example:"-1001234567890"`
+	ChatType       string `json:"chat_type"        example:"supergroup"         enums:"private,group,supergroup,channel"`
+	Title          string `json:"title"            example:"Trinity discussion"`
+	Username       string `json:"username"         example:"trinity_chat"`
+	MemberCount    uint64 `json:"member_count"     example:"1337"`
+	Description    string `json:"description"      example:"Public Trinity chat"`
+}
+
+// AddTelegramChatResponse represents the response payload after successfully adding a telegram chat.
+type AddTelegramChatResponse struct {
+	ChatID     string `json:"chat_id"     example:"550e8400-e29b-41d4-a716-446655440000"`
+	SnapshotID string `json:"snapshot_id" example:"9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"`
+}
+
+// AddTelegramChatHandler handles HTTP requests for adding telegram chats.
+type AddTelegramChatHandler struct {
+	interactor *application.AddTelegramChat
+	logger     *slog.Logger
+}
+
+// NewAddTelegramChatHandler creates a new instance of AddTelegramChatHandler.
+func NewAddTelegramChatHandler(
+	interactor *application.AddTelegramChat,
+	logger *slog.Logger,
+) *AddTelegramChatHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "add_telegram_chat_handler"),
+	)
+
+	return &AddTelegramChatHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles POST requests to add a telegram chat.
+//
+//	@Summary		Add a telegram chat
+//	@Description	Adds a telegram chat on the first submission and a new snapshot of its metadata on the following ones
+//	@Tags			record
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		AddTelegramChatRequest	true	"Chat details"
+//	@Success		201		{object}	AddTelegramChatResponse
+//	@Failure		400		{string}	string	"Invalid request format"
+//	@Failure		403		{string}	string	"Insufficient privileges"
+//	@Failure		409		{string}	string	"You have already added this chat snapshot"
+//	@Failure		422		{string}	string	"Chat contains unprocessable fields"
+//	@Failure		500		{string}	string	"Internal server error"
+//	@Router			/v1/record/telegram/chat [post]
+//	@Security		Bearer
+func (handler *AddTelegramChatHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req AddTelegramChatRequest
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid request format", slog.Any("err", err))
+		http.Error(w, "Invalid request format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.AddTelegramChatRequest{
+		ChatTelegramID: req.ChatTelegramID,
+		Type:           domain.TelegramChatType(req.ChatType),
+		Title:          req.Title,
+		Username:       req.Username,
+		MemberCount:    req.MemberCount,
+		Description:    req.Description,
+	}
+
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrValidationFailed):
+			handler.logger.DebugContext(r.Context(), "Validation has failed", slog.Any("err", err))
+			http.Error(w, "Chat contains unprocessable fields", http.StatusUnprocessableEntity)
+			return
+		case errors.Is(err, domain.ErrChatAlreadyExists), errors.Is(err, domain.ErrChatSnapshotAlreadyExists):
+			handler.logger.DebugContext(r.Context(), "This chat snapshot is already added", slog.Any("err", err))
+			http.Error(w, "You have already added this chat snapshot", http.StatusConflict)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := AddTelegramChatResponse{ChatID: resp.ChatID.String(), SnapshotID: resp.SnapshotID.String()}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/investigation"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+)
+
+// AddTelegramInvestigationRequest represents the request payload for opening an investigation.
+type AddTelegramInvestigationRequest struct {
+	Name        string `json:"name"        example:"Crypto giveaway scam"`
+	Description string `json:"description" example:"Fake giveaway channels"`
+}
+
+// AddTelegramInvestigationResponse represents the response payload after successfully opening an investigation.
+type AddTelegramInvestigationResponse struct {
+	InvestigationID string `json:"investigation_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+type AddTelegramInvestigationHandler struct {
+	interactor *application.AddTelegramInvestigation
+	logger     *slog.Logger
+}
+
+func NewAddTelegramInvestigationHandler(
+	interactor *application.AddTelegramInvestigation,
+	logger *slog.Logger,
+) *AddTelegramInvestigationHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "add_telegram_investigation_handler"),
+	)
+
+	return &AddTelegramInvestigationHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles POST requests to open an investigation.
+//
+//	@Summary		Open an investigation
+//	@Description	Opens an investigation grouping telegram users, chats and records, the current user becomes
+//	@Description	its owner. An investigation is only visible to its members.
+//	@Tags			investigation
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		AddTelegramInvestigationRequest	true	"Investigation details"
+//	@Success		201		{object}	AddTelegramInvestigationResponse
+//	@Failure		400		{string}	string	"Invalid request format"
+//	@Failure		403		{string}	string	"Insufficient privileges"
+//	@Failure		422		{string}	string	"Investigation contains unprocessable fields"
+//	@Failure		500		{string}	string	"Internal server error"
+//	@Router			/v1/investigations [post]
+func (handler *AddTelegramInvestigationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req AddTelegramInvestigationRequest
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid request format", slog.Any("err", err))
+		http.Error(w, "Invalid request format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.AddTelegramInvestigationRequest{Name: req.Name, Description: req.Description}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrValidationFailed):
+			handler.logger.DebugContext(r.Context(), "Validation has failed", slog.Any("err", err))
+			http.Error(w, "Investigation contains unprocessable fields", http.StatusUnprocessableEntity)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(AddTelegramInvestigationResponse{InvestigationID: resp.ID.String()})
+}
@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/investigation"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+// AddTelegramInvestigationItemRequest represents the request payload for attaching an item to an investigation.
+// The target is the ID of the telegram user, chat or record.
+type AddTelegramInvestigationItemRequest struct {
+	Type     string    `json:"type"      example:"telegram_record" enums:"telegram_user,telegram_chat,telegram_record"`
+	TargetID uuid.UUID `json:"target_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Note     string    `json:"note"      example:"Posts the wallet address of the giveaway"`
+}
+
+// AddTelegramInvestigationItemResponse represents the response payload after successfully attaching an item.
+type AddTelegramInvestigationItemResponse struct {
+	ItemID string `json:"item_id" example:"9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"`
+}
+
+type AddTelegramInvestigationItemHandler struct {
+	interactor *application.AddTelegramInvestigationItem
+	logger     *slog.Logger
+}
+
+func NewAddTelegramInvestigationItemHandler(
+	interactor *application.AddTelegramInvestigationItem,
+	logger *slog.Logger,
+) *AddTelegramInvestigationItemHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "add_telegram_investigation_item_handler"),
+	)
+
+	return &AddTelegramInvestigationItemHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles POST requests to attach an item to an investigation.
+//
+//	@Summary		Attach an investigation item
+//	@Description	Attaches a telegram user, chat or record with a note to an investigation.
+//	@Description	Only the owners and the editors of the investigation attach items.
+//	@Tags			investigation
+//	@Accept			json
+//	@Produce		json
+//	@Param			investigation_id	path		string								true	"Investigation ID"	format(uuid)
+//	@Param			request				body		AddTelegramInvestigationItemRequest	true	"Item details"
+//	@Success		201					{object}	AddTelegramInvestigationItemResponse
+//	@Failure		400					{string}	string	"Invalid request format"
+//	@Failure		403					{string}	string	"Insufficient privileges"
+//	@Failure		404					{string}	string	"Investigation or target not found"
+//	@Failure		409					{string}	string	"The investigation already has this item"
+//	@Failure		422					{string}	string	"Item contains unprocessable fields"
+//	@Failure		500					{string}	string	"Internal server error"
+//	@Router			/v1/investigations/{investigation_id}/items [post]
+func (handler *AddTelegramInvestigationItemHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	investigationID, err := uuid.Parse(r.PathValue("investigation_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid investigation ID format", slog.Any("err", err))
+		http.Error(w, "Invalid investigation ID format", http.StatusBadRequest)
+		return
+	}
+	var req AddTelegramInvestigationItemRequest
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err = dec.Decode(&req); err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid request format", slog.Any("err", err))
+		http.Error(w, "Invalid request format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.AddTelegramInvestigationItemRequest{
+		InvestigationID: investigationID,
+		Type:            domain.TelegramInvestigationItemType(req.Type),
+		TargetID:        req.TargetID,
+		Note:            req.Note,
+	}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrValidationFailed):
+			handler.logger.DebugContext(r.Context(), "Validation has failed", slog.Any("err", err))
+			http.Error(w, "Item contains unprocessable fields", http.StatusUnprocessableEntity)
+			return
+		case errors.Is(err, domain.ErrInvestigationNotFound):
+			http.Error(w, "Investigation not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrUserNotFound),
+			errors.Is(err, domain.ErrChatNotFound),
+			errors.Is(err, domain.ErrRecordNotFound):
+			http.Error(w, "Target not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrInvestigationItemAlreadyExists):
+			http.Error(w, "The investigation already has this item", http.StatusConflict)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(AddTelegramInvestigationItemResponse{ItemID: resp.ID.String()})
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/annotation"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+)
+
+// AddTelegramNoteRequest represents the request payload for writing a note, the body is markdown.
+type AddTelegramNoteRequest struct {
+	Body string `json:"body" example:"Runs the **fake giveaway** channels"`
+}
+
+type AddTelegramNoteHandler struct {
+	interactor *application.AddTelegramNote
+	logger     *slog.Logger
+}
+
+func NewAddTelegramNoteHandler(
+	interactor *application.AddTelegramNote,
+	logger *slog.Logger,
+) *AddTelegramNoteHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "add_telegram_note_handler"),
+	)
+
+	return &AddTelegramNoteHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles POST requests to write a note about a telegram user, identity or record.
+//
+//	@Summary		Add a note
+//	@Description	Writes a markdown note about a telegram user, identity or record on behalf of the current user.
+//	@Tags			annotation
+//	@Accept			json
+//	@Produce		json
+//	@Param			target_type	path		string					true	"Target type"	Enums(telegram_user, telegram_identity, telegram_record)
+//	@Param			target_id	path		string					true	"Target ID"		format(uuid)
+//	@Param			request		body		AddTelegramNoteRequest	true	"Note details"
+//	@Success		201			{object}	TelegramNoteResponse
+//	@Failure		400			{string}	string	"Invalid request format"
+//	@Failure		403			{string}	string	"Insufficient privileges"
+//	@Failure		404			{string}	string	"Target not found"
+//	@Failure		422			{string}	string	"Note contains unprocessable fields"
+//	@Failure		500			{string}	string	"Internal server error"
+//	@Router			/v1/annotations/{target_type}/{target_id}/notes [post]
+func (handler *AddTelegramNoteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	targetType, targetID, err := parseAnnotationTarget(r)
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid target ID format", slog.Any("err", err))
+		http.Error(w, "Invalid target ID format", http.StatusBadRequest)
+		return
+	}
+	var req AddTelegramNoteRequest
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err = dec.Decode(&req); err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid request format", slog.Any("err", err))
+		http.Error(w, "Invalid request format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.AddTelegramNoteRequest{TargetType: targetType, TargetID: targetID, Body: req.Body}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrValidationFailed):
+			handler.logger.DebugContext(r.Context(), "Validation has failed", slog.Any("err", err))
+			http.Error(w, "Note contains unprocessable fields", http.StatusUnprocessableEntity)
+			return
+		case errors.Is(err, domain.ErrUserNotFound),
+			errors.Is(err, domain.ErrIdentityNotFound),
+			errors.Is(err, domain.ErrRecordNotFound):
+			http.Error(w, "Target not found", http.StatusNotFound)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(toTelegramNoteResponse(resp.Note))
+}
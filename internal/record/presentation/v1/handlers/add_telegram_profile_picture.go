@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/InWamos/trinity-proto/config"
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/picture"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	blobstorage "github.com/InWamos/trinity-proto/internal/shared/infrastructure/blob_storage"
+)
+
+// AddTelegramProfilePictureResponse represents the response payload after successfully uploading a profile picture.
+type AddTelegramProfilePictureResponse struct {
+	ProfilePictureID string `json:"profile_picture_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	SHA256           string `json:"sha256"             example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b"`
+	MimeType         string `json:"mime_type"          example:"image/jpeg"`
+}
+
+type AddTelegramProfilePictureHandler struct {
+	interactor    *application.AddTelegramProfilePicture
+	maxUploadSize int64
+	logger        *slog.Logger
+}
+
+func NewAddTelegramProfilePictureHandler(
+	interactor *application.AddTelegramProfilePicture,
+	storageConfig *config.StorageConfig,
+	logger *slog.Logger,
+) *AddTelegramProfilePictureHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "add_telegram_profile_picture_handler"),
+	)
+
+	return &AddTelegramProfilePictureHandler{
+		interactor:    interactor,
+		maxUploadSize: storageConfig.MaxUploadSize,
+		logger:        handlerLogger,
+	}
+}
+
+// ServeHTTP handles multipart uploads of a profile picture. The posted_at field must precede the file part.
+//
+//	@Summary		Upload a profile picture
+//	@Description	Adds an avatar to the history of the telegram user. Only images are accepted.
+//	@Tags			record
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			telegram_id	path		int		true	"User Telegram ID"
+//	@Param			posted_at	formData	string	true	"When the avatar has been set, RFC 3339"
+//	@Param			file		formData	file	true	"Image content"
+//	@Success		201			{object}	AddTelegramProfilePictureResponse
+//	@Failure		400			{string}	string	"Invalid request format"
+//	@Failure		403			{string}	string	"Insufficient privileges"
+//	@Failure		409			{string}	string	"Profile picture already exists or user not found"
+//	@Failure		413			{string}	string	"File is too large"
+//	@Failure		422			{string}	string	"Profile picture contains unprocessable fields"
+//	@Failure		500			{string}	string	"Internal server error"
+//	@Router			/v1/record/telegram/user/{telegram_id}/profile_picture [post]
+func (handler *AddTelegramProfilePictureHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userTelegramID, err := strconv.ParseUint(r.PathValue("telegram_id"), 10, 64)
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid telegram ID format", slog.Any("err", err))
+		http.Error(w, "Invalid telegram ID format", http.StatusBadRequest)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, handler.maxUploadSize+multipartOverhead)
+	reader, err := r.MultipartReader()
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid multipart request", slog.Any("err", err))
+		http.Error(w, "Invalid request format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.AddTelegramProfilePictureRequest{UserTelegramID: userTelegramID}
+	filePart, err := nextMultipartFile(reader, func(name string, value string) error {
+		if name != "posted_at" {
+			return fmt.Errorf("%w %q", errUnexpectedFormField, name)
+		}
+		postedAt, parseErr := time.Parse(time.RFC3339, value)
+		requestDTO.PostedAt = postedAt
+		return parseErr
+	})
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid multipart request", slog.Any("err", err))
+		http.Error(w, "Invalid request format", http.StatusBadRequest)
+		return
+	}
+	defer filePart.Close()
+	requestDTO.Content = filePart
+
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, blobstorage.ErrBlobTooLarge):
+			handler.logger.DebugContext(r.Context(), "Profile picture is too large", slog.Any("err", err))
+			http.Error(w, "File is too large", http.StatusRequestEntityTooLarge)
+			return
+		case errors.Is(err, blobstorage.ErrBlobEmpty), errors.Is(err, blobstorage.ErrBlobReadFailed):
+			handler.logger.DebugContext(r.Context(), "Profile picture could not be read", slog.Any("err", err))
+			http.Error(w, "Invalid request format", http.StatusBadRequest)
+			return
+		case errors.Is(err, domain.ErrValidationFailed):
+			handler.logger.DebugContext(r.Context(), "Validation has failed", slog.Any("err", err))
+			http.Error(w, "Profile picture contains unprocessable fields", http.StatusUnprocessableEntity)
+			return
+		case errors.Is(err, domain.ErrUnexistentTelegramUserReferenced):
+			handler.logger.DebugContext(r.Context(), "Unexistent user referenced", slog.Any("err", err))
+			http.Error(w, "This profile picture references user that hasn't been added yet", http.StatusConflict)
+			return
+		case errors.Is(err, domain.ErrProfilePictureAlreadyExists):
+			handler.logger.DebugContext(r.Context(), "This profile picture already exists", slog.Any("err", err))
+			http.Error(w, "This profile picture has already been added", http.StatusConflict)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Storage error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := AddTelegramProfilePictureResponse{
+		ProfilePictureID: resp.ProfilePictureID.String(),
+		SHA256:           resp.SHA256,
+		MimeType:         resp.MimeType,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(response)
+}
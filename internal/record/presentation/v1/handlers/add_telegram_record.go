@@ -15,16 +15,95 @@ import (
 
 // AddTelegramRecordRequest represents the request payload for adding a new telegram record.
 type AddTelegramRecordRequest struct {
-	MessageTelegramID  uint64    `json:"message_telegram_id"   example:"28736582143"`
-	FromUserTelegramID uuid.UUID `json:"from_user_telegram_id" example:"cf6e273b-ac6e-43f1-abba-d8009ffc1b3f"`
-	InTelegramChatID   int64     `json:"in_telegram_chat_id"   example:"123456789"`
-	MessageText        string    `json:"message_text"          example:"Hello world!"`
-	PostedAt           time.Time `json:"posted_at"             example:"2024-01-15T10:30:00Z"`
+	MessageTelegramID        uint64                  `json:"message_telegram_id"                    example:"28736582143"`
+	FromUserTelegramID       uuid.UUID               `json:"from_user_telegram_id"                  example:"cf6e273b-ac6e-43f1-abba-d8009ffc1b3f"`
+	InTelegramChatID         int64                   `json:"in_telegram_chat_id"                    example:"123456789"`
+	MessageText              string                  `json:"message_text"                           example:"Hello world!"`
+	Entities                 []TelegramMessageEntity `json:"entities,omitempty"`
+	Caption                  string                  `json:"caption,omitempty"                      example:"Look at this"`
+	CaptionEntities          []TelegramMessageEntity `json:"caption_entities,omitempty"`
+	PostedAt                 time.Time               `json:"posted_at"                              example:"2024-01-15T10:30:00Z"`
+	EditedAt                 *time.Time              `json:"edited_at,omitempty"                    example:"2024-01-15T10:35:00Z"`
+	ReplyToMessageTelegramID *uint64                 `json:"reply_to_message_telegram_id,omitempty" example:"28736582140"`
+	ThreadTelegramID         *uint64                 `json:"thread_telegram_id,omitempty"           example:"28736582100"`
+	ForwardOrigin            *TelegramForwardOrigin  `json:"forward_origin,omitempty"`
+}
+
+// TelegramForwardOrigin is where a forwarded message has been posted originally.
+// The author is omitted for accounts hidden from forwards, the chat and the message for messages of users.
+type TelegramForwardOrigin struct {
+	FromUserTelegramID *uint64    `json:"from_user_telegram_id,omitempty" example:"28736582"`
+	FromChatTelegramID *int64     `json:"from_chat_telegram_id,omitempty" example:"-1001234567890"`
+	MessageTelegramID  *uint64    `json:"message_telegram_id,omitempty"   example:"42"`
+	PostedAt           *time.Time `json:"posted_at,omitempty"             example:"2024-01-14T08:00:00Z"`
+}
+
+// TelegramMessageEntity mirrors the Bot API MessageEntity, offset and length are counted in UTF-16 code units.
+// url is set for text_link, user_telegram_id for text_mention, language for pre and custom_emoji_id for custom_emoji.
+type TelegramMessageEntity struct {
+	Type           string `json:"type"                       example:"text_link"`
+	Offset         int    `json:"offset"                     example:"6"`
+	Length         int    `json:"length"                     example:"5"`
+	URL            string `json:"url,omitempty"              example:"https://example.com"`
+	UserTelegramID uint64 `json:"user_telegram_id,omitempty" example:"28736582"`
+	Language       string `json:"language,omitempty"         example:"go"`
+	CustomEmojiID  string `json:"custom_emoji_id,omitempty"  example:"5368324170671202286"`
+}
+
+func entitiesToDomain(entities []TelegramMessageEntity) []domain.TelegramMessageEntity {
+	if len(entities) == 0 {
+		return nil
+	}
+	domainEntities := make([]domain.TelegramMessageEntity, len(entities))
+	for i, entity := range entities {
+		domainEntities[i] = domain.TelegramMessageEntity{
+			Type:           domain.TelegramMessageEntityType(entity.Type),
+			Offset:         entity.Offset,
+			Length:         entity.Length,
+			URL:            entity.URL,
+			UserTelegramID: entity.UserTelegramID,
+			Language:       entity.Language,
+			CustomEmojiID:  entity.CustomEmojiID,
+		}
+	}
+	return domainEntities
+}
+
+func entitiesFromDomain(domainEntities []domain.TelegramMessageEntity) []TelegramMessageEntity {
+	if len(domainEntities) == 0 {
+		return nil
+	}
+	entities := make([]TelegramMessageEntity, len(domainEntities))
+	for i, entity := range domainEntities {
+		entities[i] = TelegramMessageEntity{
+			Type:           string(entity.Type),
+			Offset:         entity.Offset,
+			Length:         entity.Length,
+			URL:            entity.URL,
+			UserTelegramID: entity.UserTelegramID,
+			Language:       entity.Language,
+			CustomEmojiID:  entity.CustomEmojiID,
+		}
+	}
+	return entities
+}
+
+func forwardOriginToDomain(origin *TelegramForwardOrigin) *domain.TelegramForwardOrigin {
+	if origin == nil {
+		return nil
+	}
+	return &domain.TelegramForwardOrigin{
+		FromUserTelegramID: origin.FromUserTelegramID,
+		FromChatTelegramID: origin.FromChatTelegramID,
+		MessageTelegramID:  origin.MessageTelegramID,
+		PostedAt:           origin.PostedAt,
+	}
 }
 
 // AddTelegramRecordResponse represents the response payload after successfully adding a telegram record.
 type AddTelegramRecordResponse struct {
 	RecordID string `json:"record_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Revised  bool   `json:"revised"   example:"false"`
 }
 
 // AddTelegramRecordHandler handles HTTP requests for adding telegram records.
@@ -52,15 +131,19 @@ func NewAddTelegramRecordHandler(
 // ServeHTTP handles POST requests to add a new telegram record.
 //
 //	@Summary		Add a new telegram record
-//	@Description	Creates a new telegram record with the provided message details
+//	@Description	Creates a new telegram record with the provided message details.
+//	@Description	A resubmission of a stored message with a different text adds a new version to its edit history
+//	@Description	and responds with 200 and "revised": true.
+//	@Description	An unchanged message stored by another collector is only observed by the caller, with its ID.
 //	@Tags			record
 //	@Accept			json
 //	@Produce		json
 //	@Param			request	body		AddTelegramRecordRequest	true	"Record details"
 //	@Success		201		{object}	AddTelegramRecordResponse
+//	@Success		200		{object}	AddTelegramRecordResponse	"New version of a stored record"
 //	@Failure		400		{string}	string	"Invalid request format"
 //	@Failure		403		{string}	string	"Insufficient privileges"
-//	@Failure		409		{string}	string	"Record already exists or user not found"
+//	@Failure		409		{string}	string	"Record already exists unchanged or user not found"
 //	@Failure		422		{string}	string	"Record contains unprocessable fields"
 //	@Failure		500		{string}	string	"Internal server error"
 //	@Router			/v1/record/telegram [post]
@@ -76,11 +159,18 @@ func (handler *AddTelegramRecordHandler) ServeHTTP(w http.ResponseWriter, r *htt
 	}
 
 	requestDTO := application.AddTelegramRecordRequest{
-		MessageTelegramID:  req.MessageTelegramID,
-		FromUserTelegramID: req.FromUserTelegramID,
-		InTelegramChatID:   req.InTelegramChatID,
-		MessageText:        req.MessageText,
-		PostedAt:           req.PostedAt,
+		MessageTelegramID:        req.MessageTelegramID,
+		FromUserTelegramID:       req.FromUserTelegramID,
+		InTelegramChatID:         req.InTelegramChatID,
+		MessageText:              req.MessageText,
+		Entities:                 entitiesToDomain(req.Entities),
+		Caption:                  req.Caption,
+		CaptionEntities:          entitiesToDomain(req.CaptionEntities),
+		PostedAt:                 req.PostedAt,
+		EditedAt:                 req.EditedAt,
+		ReplyToMessageTelegramID: req.ReplyToMessageTelegramID,
+		ThreadTelegramID:         req.ThreadTelegramID,
+		ForwardOrigin:            forwardOriginToDomain(req.ForwardOrigin),
 	}
 
 	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
@@ -121,8 +211,12 @@ func (handler *AddTelegramRecordHandler) ServeHTTP(w http.ResponseWriter, r *htt
 		}
 	}
 
-	response := AddTelegramRecordResponse{RecordID: resp.RecordID}
+	response := AddTelegramRecordResponse{RecordID: resp.RecordID, Revised: resp.Revised}
+	status := http.StatusCreated
+	if resp.Revised {
+		status = http.StatusOK
+	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusCreated)
+	w.WriteHeader(status)
 	_ = json.NewEncoder(w).Encode(response)
 }
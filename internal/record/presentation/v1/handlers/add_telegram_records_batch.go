@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/InWamos/trinity-proto/config"
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/record"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+)
+
+// Error codes reported for the records of a batch that haven't been added.
+const (
+	batchErrorValidationFailed = "validation_failed"
+	batchErrorRecordExists     = "record_already_exists"
+	batchErrorUserNotFound     = "user_not_found"
+	batchErrorChatNotFound     = "chat_not_found"
+	batchErrorInternal         = "internal_error"
+)
+
+// AddTelegramRecordsBatchRequest represents the request payload for adding many telegram records at once.
+type AddTelegramRecordsBatchRequest struct {
+	Records []AddTelegramRecordRequest `json:"records"`
+}
+
+// AddTelegramRecordsBatchResult is the outcome of a single record, in the order of the request.
+type AddTelegramRecordsBatchResult struct {
+	Index    int    `json:"index"               example:"0"`
+	RecordID string `json:"record_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Revised  bool   `json:"revised,omitempty"   example:"false"`
+	Error    string `json:"error,omitempty"     example:"record_already_exists"`
+}
+
+// AddTelegramRecordsBatchResponse represents the response payload of a batch.
+type AddTelegramRecordsBatchResponse struct {
+	Created int                             `json:"created" example:"1"`
+	Failed  int                             `json:"failed"  example:"1"`
+	Results []AddTelegramRecordsBatchResult `json:"results"`
+}
+
+type AddTelegramRecordsBatchHandler struct {
+	interactor       *application.AddTelegramRecordsBatch
+	maxBatchBodySize int64
+	logger           *slog.Logger
+}
+
+func NewAddTelegramRecordsBatchHandler(
+	interactor *application.AddTelegramRecordsBatch,
+	ingestConfig *config.IngestConfig,
+	logger *slog.Logger,
+) *AddTelegramRecordsBatchHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "add_telegram_records_batch_handler"),
+	)
+
+	return &AddTelegramRecordsBatchHandler{
+		interactor:       interactor,
+		maxBatchBodySize: ingestConfig.MaxBatchBodySize,
+		logger:           handlerLogger,
+	}
+}
+
+// ServeHTTP handles POST requests to add many telegram records at once.
+//
+//	@Summary		Add telegram records in bulk
+//	@Description	Adds up to INGEST_BATCH_SIZE records (500 by default) in one transaction.
+//	@Description	Records that can't be added are reported per item
+//	@Description	with one of the error codes: validation_failed, record_already_exists, user_not_found, chat_not_found.
+//	@Description	Stored messages resubmitted with a different text are added as new versions and reported as revised.
+//	@Tags			record
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		AddTelegramRecordsBatchRequest	true	"Records"
+//	@Success		200		{object}	AddTelegramRecordsBatchResponse
+//	@Failure		400		{string}	string	"Invalid request format"
+//	@Failure		403		{string}	string	"Insufficient privileges"
+//	@Failure		413		{string}	string	"Too many records in the batch or the batch is too large"
+//	@Failure		500		{string}	string	"Internal server error"
+//	@Router			/v1/record/telegram/records:batch [post]
+//	@Security		Bearer
+func (handler *AddTelegramRecordsBatchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, handler.maxBatchBodySize)
+	var req AddTelegramRecordsBatchRequest
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			handler.logger.DebugContext(r.Context(), "Batch is too large", slog.Any("err", err))
+			http.Error(w, "Batch is too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		handler.logger.DebugContext(r.Context(), "invalid request format", slog.Any("err", err))
+		http.Error(w, "Invalid request format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.AddTelegramRecordsBatchRequest{
+		Records: make([]application.AddTelegramRecordRequest, len(req.Records)),
+	}
+	for i, record := range req.Records {
+		requestDTO.Records[i] = application.AddTelegramRecordRequest{
+			MessageTelegramID:        record.MessageTelegramID,
+			FromUserTelegramID:       record.FromUserTelegramID,
+			InTelegramChatID:         record.InTelegramChatID,
+			MessageText:              record.MessageText,
+			Entities:                 entitiesToDomain(record.Entities),
+			Caption:                  record.Caption,
+			CaptionEntities:          entitiesToDomain(record.CaptionEntities),
+			PostedAt:                 record.PostedAt,
+			EditedAt:                 record.EditedAt,
+			ReplyToMessageTelegramID: record.ReplyToMessageTelegramID,
+			ThreadTelegramID:         record.ThreadTelegramID,
+			ForwardOrigin:            forwardOriginToDomain(record.ForwardOrigin),
+		}
+	}
+
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, application.ErrBatchEmpty):
+			handler.logger.DebugContext(r.Context(), "Empty batch", slog.Any("err", err))
+			http.Error(w, "Batch contains no records", http.StatusBadRequest)
+			return
+		case errors.Is(err, application.ErrBatchTooLarge):
+			handler.logger.DebugContext(r.Context(), "Batch is too large", slog.Any("err", err))
+			http.Error(w, "Too many records in the batch", http.StatusRequestEntityTooLarge)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := AddTelegramRecordsBatchResponse{
+		Results: make([]AddTelegramRecordsBatchResult, len(resp.Results)),
+	}
+	for i, result := range resp.Results {
+		response.Results[i] = AddTelegramRecordsBatchResult{Index: i, RecordID: result.RecordID, Revised: result.Revised}
+		if result.Err == nil {
+			response.Created++
+			continue
+		}
+		response.Failed++
+		response.Results[i].Error = batchErrorCode(result.Err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func batchErrorCode(err error) string {
+	switch {
+	case errors.Is(err, domain.ErrValidationFailed):
+		return batchErrorValidationFailed
+	case errors.Is(err, domain.ErrRecordAlreadyExists):
+		return batchErrorRecordExists
+	case errors.Is(err, domain.ErrUnexistentTelegramUserReferenced):
+		return batchErrorUserNotFound
+	case errors.Is(err, domain.ErrUnexistentTelegramChatReferenced):
+		return batchErrorChatNotFound
+	default:
+		return batchErrorInternal
+	}
+}
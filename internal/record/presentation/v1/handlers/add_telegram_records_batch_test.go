@@ -0,0 +1,25 @@
+package handlers //nolint:testpackage // the error codes are unexported
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+)
+
+func TestBatchErrorCode(t *testing.T) {
+	cases := map[error]string{
+		domain.ErrValidationFailed:                               batchErrorValidationFailed,
+		domain.ErrRecordAlreadyExists:                            batchErrorRecordExists,
+		domain.ErrUnexistentTelegramUserReferenced:               batchErrorUserNotFound,
+		domain.ErrUnexistentTelegramChatReferenced:               batchErrorChatNotFound,
+		fmt.Errorf("wrapped: %w", domain.ErrRecordAlreadyExists): batchErrorRecordExists,
+		errors.New("connection reset"):                           batchErrorInternal,
+	}
+	for err, expected := range cases {
+		if code := batchErrorCode(err); code != expected {
+			t.Errorf("expected %v to be reported as %q, got %q", err, expected, code)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/annotation"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+)
+
+// AddTelegramTagRequest represents the request payload for tagging a telegram user, identity or record.
+// The name is stored in lower case with its spaces turned into dashes.
+type AddTelegramTagRequest struct {
+	Name string `json:"name" example:"scammer"`
+}
+
+type AddTelegramTagHandler struct {
+	interactor *application.AddTelegramTag
+	logger     *slog.Logger
+}
+
+func NewAddTelegramTagHandler(
+	interactor *application.AddTelegramTag,
+	logger *slog.Logger,
+) *AddTelegramTagHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "add_telegram_tag_handler"),
+	)
+
+	return &AddTelegramTagHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles POST requests to tag a telegram user, identity or record.
+//
+//	@Summary		Add a tag
+//	@Description	Tags a telegram user, identity or record on behalf of the current user.
+//	@Description	A tag is made of at most 64 letters, digits, dashes and underscores.
+//	@Tags			annotation
+//	@Accept			json
+//	@Produce		json
+//	@Param			target_type	path		string					true	"Target type"	Enums(telegram_user, telegram_identity, telegram_record)
+//	@Param			target_id	path		string					true	"Target ID"		format(uuid)
+//	@Param			request		body		AddTelegramTagRequest	true	"Tag details"
+//	@Success		201			{object}	TelegramTagResponse
+//	@Failure		400			{string}	string	"Invalid request format or tag"
+//	@Failure		403			{string}	string	"Insufficient privileges"
+//	@Failure		404			{string}	string	"Target not found"
+//	@Failure		409			{string}	string	"The target already has this tag"
+//	@Failure		422			{string}	string	"Tag contains unprocessable fields"
+//	@Failure		500			{string}	string	"Internal server error"
+//	@Router			/v1/annotations/{target_type}/{target_id}/tags [post]
+func (handler *AddTelegramTagHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	targetType, targetID, err := parseAnnotationTarget(r)
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid target ID format", slog.Any("err", err))
+		http.Error(w, "Invalid target ID format", http.StatusBadRequest)
+		return
+	}
+	var req AddTelegramTagRequest
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err = dec.Decode(&req); err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid request format", slog.Any("err", err))
+		http.Error(w, "Invalid request format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.AddTelegramTagRequest{TargetType: targetType, TargetID: targetID, Name: req.Name}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrInvalidTag):
+			http.Error(w, "Tag must be made of at most 64 letters, digits, dashes and underscores", http.StatusBadRequest)
+			return
+		case errors.Is(err, domain.ErrValidationFailed):
+			handler.logger.DebugContext(r.Context(), "Validation has failed", slog.Any("err", err))
+			http.Error(w, "Tag contains unprocessable fields", http.StatusUnprocessableEntity)
+			return
+		case errors.Is(err, domain.ErrUserNotFound),
+			errors.Is(err, domain.ErrIdentityNotFound),
+			errors.Is(err, domain.ErrRecordNotFound):
+			http.Error(w, "Target not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrTagAlreadyExists):
+			http.Error(w, "The target already has this tag", http.StatusConflict)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(toTelegramTagResponse(resp.Tag))
+}
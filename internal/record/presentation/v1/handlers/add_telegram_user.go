@@ -43,6 +43,7 @@ func NewAddTelegramUserHandler(
 //
 //	@Summary		Add a new Telegram user
 //	@Description	Add a new Telegram user by Telegram ID. This creates a record linking a Telegram user to the system.
+//	@Description	A user already added by another collector is stored once, the caller observes it and gets its ID.
 //	@Tags			record
 //	@Accept			json
 //	@Produce		json
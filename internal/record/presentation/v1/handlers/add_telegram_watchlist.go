@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/watchlist"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+)
+
+// AddTelegramWatchlistRequest represents the request payload for creating a watchlist.
+type AddTelegramWatchlistRequest struct {
+	Name string `json:"name" example:"Fraud suspects"`
+}
+
+// AddTelegramWatchlistResponse represents the response payload after successfully creating a watchlist.
+type AddTelegramWatchlistResponse struct {
+	WatchlistID string `json:"watchlist_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+type AddTelegramWatchlistHandler struct {
+	interactor *application.AddTelegramWatchlist
+	logger     *slog.Logger
+}
+
+func NewAddTelegramWatchlistHandler(
+	interactor *application.AddTelegramWatchlist,
+	logger *slog.Logger,
+) *AddTelegramWatchlistHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "add_telegram_watchlist_handler"),
+	)
+
+	return &AddTelegramWatchlistHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles POST requests to create a watchlist.
+//
+//	@Summary		Create a watchlist
+//	@Description	Creates a watchlist of the current user. Its entries are matched against the records
+//	@Description	and identities added afterwards, raising alerts for the user.
+//	@Tags			watchlist
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		AddTelegramWatchlistRequest	true	"Watchlist details"
+//	@Success		201		{object}	AddTelegramWatchlistResponse
+//	@Failure		400		{string}	string	"Invalid request format"
+//	@Failure		403		{string}	string	"Insufficient privileges"
+//	@Failure		422		{string}	string	"Watchlist contains unprocessable fields"
+//	@Failure		500		{string}	string	"Internal server error"
+//	@Router			/v1/watchlists [post]
+func (handler *AddTelegramWatchlistHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req AddTelegramWatchlistRequest
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid request format", slog.Any("err", err))
+		http.Error(w, "Invalid request format", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := handler.interactor.Execute(r.Context(), application.AddTelegramWatchlistRequest{Name: req.Name})
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrValidationFailed):
+			handler.logger.DebugContext(r.Context(), "Validation has failed", slog.Any("err", err))
+			http.Error(w, "Watchlist contains unprocessable fields", http.StatusUnprocessableEntity)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(AddTelegramWatchlistResponse{WatchlistID: resp.ID.String()})
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/watchlist"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+// AddTelegramWatchlistEntryRequest represents the request payload for adding an entry to a watchlist.
+type AddTelegramWatchlistEntryRequest struct {
+	Type  string `json:"type"  example:"phone" enums:"telegram_id,username,phone,keyword"`
+	Value string `json:"value" example:"+1 (555) 123-4567"`
+}
+
+// AddTelegramWatchlistEntryResponse holds the ID of the entry and the value as it's matched.
+type AddTelegramWatchlistEntryResponse struct {
+	EntryID string `json:"entry_id" example:"9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"`
+	Value   string `json:"value"    example:"+15551234567"`
+}
+
+type AddTelegramWatchlistEntryHandler struct {
+	interactor *application.AddTelegramWatchlistEntry
+	logger     *slog.Logger
+}
+
+func NewAddTelegramWatchlistEntryHandler(
+	interactor *application.AddTelegramWatchlistEntry,
+	logger *slog.Logger,
+) *AddTelegramWatchlistEntryHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "add_telegram_watchlist_entry_handler"),
+	)
+
+	return &AddTelegramWatchlistEntryHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles POST requests to add an entry to a watchlist.
+//
+//	@Summary		Add a watchlist entry
+//	@Description	Adds a Telegram ID, a username, a phone number or a keyword to a watchlist of the current user.
+//	@Description	The value may be written in any form, e.g. "@Username" or "+1 (555) 123-4567", it's normalised
+//	@Description	the way records and identities are matched. Keywords are matched ignoring case and repeated whitespace.
+//	@Tags			watchlist
+//	@Accept			json
+//	@Produce		json
+//	@Param			watchlist_id	path		string								true	"Watchlist ID"	format(uuid)
+//	@Param			request			body		AddTelegramWatchlistEntryRequest	true	"Entry details"
+//	@Success		201				{object}	AddTelegramWatchlistEntryResponse
+//	@Failure		400				{string}	string	"Invalid request format or entry value"
+//	@Failure		403				{string}	string	"Insufficient privileges"
+//	@Failure		404				{string}	string	"Watchlist not found"
+//	@Failure		409				{string}	string	"The watchlist already has this entry"
+//	@Failure		500				{string}	string	"Internal server error"
+//	@Router			/v1/watchlists/{watchlist_id}/entries [post]
+func (handler *AddTelegramWatchlistEntryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	watchlistID, err := uuid.Parse(r.PathValue("watchlist_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid watchlist ID format", slog.Any("err", err))
+		http.Error(w, "Invalid watchlist ID format", http.StatusBadRequest)
+		return
+	}
+	var req AddTelegramWatchlistEntryRequest
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err = dec.Decode(&req); err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid request format", slog.Any("err", err))
+		http.Error(w, "Invalid request format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.AddTelegramWatchlistEntryRequest{
+		WatchlistID: watchlistID,
+		Type:        domain.TelegramWatchlistEntryType(req.Type),
+		Value:       req.Value,
+	}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, application.ErrInvalidWatchlistEntry), errors.Is(err, domain.ErrValidationFailed):
+			handler.logger.DebugContext(r.Context(), "Invalid watchlist entry", slog.Any("err", err))
+			http.Error(w, "Invalid watchlist entry", http.StatusBadRequest)
+			return
+		case errors.Is(err, domain.ErrWatchlistNotFound):
+			http.Error(w, "Watchlist not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrWatchlistEntryAlreadyExists):
+			http.Error(w, "The watchlist already has this entry", http.StatusConflict)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := AddTelegramWatchlistEntryResponse{EntryID: resp.ID.String(), Value: resp.Value}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(response)
+}
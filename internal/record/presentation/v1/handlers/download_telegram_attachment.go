@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/attachment"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+type DownloadTelegramAttachmentHandler struct {
+	interactor *application.DownloadTelegramAttachment
+	logger     *slog.Logger
+}
+
+func NewDownloadTelegramAttachmentHandler(
+	interactor *application.DownloadTelegramAttachment,
+	logger *slog.Logger,
+) *DownloadTelegramAttachmentHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "download_telegram_attachment_handler"),
+	)
+
+	return &DownloadTelegramAttachmentHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP streams the attachment content from the blob storage.
+//
+//	@Summary		Download an attachment
+//	@Description	Streams the content of the attachment as a file download.
+//	@Tags			record
+//	@Produce		octet-stream
+//	@Param			attachment_id	path	string	true	"Attachment ID"
+//	@Success		200				{file}	file	"Attachment content"
+//	@Failure		400				"Invalid attachment ID format"
+//	@Failure		403				"Insufficient privileges"
+//	@Failure		404				"Attachment not found"
+//	@Failure		500				"Internal server error"
+//	@Router			/v1/record/telegram/attachment/{attachment_id} [get]
+func (handler *DownloadTelegramAttachmentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	attachmentID, err := uuid.Parse(r.PathValue("attachment_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid attachment ID format", slog.Any("err", err))
+		http.Error(w, "Invalid attachment ID format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.DownloadTelegramAttachmentRequest{AttachmentID: attachmentID}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrAttachmentNotFound):
+			handler.logger.DebugContext(r.Context(), "telegram attachment not found by ID", slog.Any("err", err))
+			http.Error(w, "Attachment not found", http.StatusNotFound)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Storage error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+	defer resp.Content.Close()
+
+	fileName := resp.Attachment.FileName
+	if fileName == "" {
+		fileName = resp.Attachment.ID.String()
+	}
+	streamBlob(w, resp.Content, resp.Attachment.MimeType, resp.Attachment.FileSize, fileName, handler.logger, r)
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/export"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+type DownloadTelegramExportHandler struct {
+	interactor *application.DownloadTelegramExport
+	logger     *slog.Logger
+}
+
+func NewDownloadTelegramExportHandler(
+	interactor *application.DownloadTelegramExport,
+	logger *slog.Logger,
+) *DownloadTelegramExportHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "download_telegram_export_handler"),
+	)
+
+	return &DownloadTelegramExportHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP streams the bundle of a completed export from the blob storage.
+//
+//	@Summary		Download an export
+//	@Description	Streams the ZIP of a completed export. The SHA-256 of the archive and the signature of its
+//	@Description	manifest are also sent as the X-Export-SHA256 and X-Export-Signature headers.
+//	@Tags			export
+//	@Produce		application/zip
+//	@Param			export_id	path	string	true	"Export ID"
+//	@Success		200			{file}	file	"Export bundle"
+//	@Failure		400			"Invalid export ID format"
+//	@Failure		403			"Insufficient privileges"
+//	@Failure		404			"Export not found"
+//	@Failure		409			"Export has not been completed"
+//	@Failure		500			"Internal server error"
+//	@Router			/v1/exports/{export_id}/download [get]
+func (handler *DownloadTelegramExportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	exportID, err := uuid.Parse(r.PathValue("export_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid export ID format", slog.Any("err", err))
+		http.Error(w, "Invalid export ID format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.DownloadTelegramExportRequest{ExportID: exportID}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrTelegramExportNotFound):
+			handler.logger.DebugContext(r.Context(), "telegram export not found by ID", slog.Any("err", err))
+			http.Error(w, "Export not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrTelegramExportNotCompleted):
+			handler.logger.DebugContext(r.Context(), "telegram export is not completed", slog.Any("err", err))
+			http.Error(w, "Export has not been completed", http.StatusConflict)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Storage error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+	defer resp.Content.Close()
+
+	w.Header().Set("X-Export-SHA256", resp.Export.SHA256)
+	w.Header().Set("X-Export-Signature", resp.Export.Signature)
+	fileName := "export-" + resp.Export.ID.String() + ".zip"
+	streamBlob(w, resp.Content, "application/zip", uint64(resp.Export.FileSize), fileName, handler.logger, r)
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/picture"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+type DownloadTelegramProfilePictureHandler struct {
+	interactor *application.DownloadTelegramProfilePicture
+	logger     *slog.Logger
+}
+
+func NewDownloadTelegramProfilePictureHandler(
+	interactor *application.DownloadTelegramProfilePicture,
+	logger *slog.Logger,
+) *DownloadTelegramProfilePictureHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "download_telegram_profile_picture_handler"),
+	)
+
+	return &DownloadTelegramProfilePictureHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP streams the profile picture from the blob storage.
+//
+//	@Summary		Download a profile picture
+//	@Description	Streams the image of the profile picture.
+//	@Tags			record
+//	@Produce		image/jpeg,image/png,image/webp
+//	@Param			profile_picture_id	path	string	true	"Profile picture ID"
+//	@Success		200					{file}	file	"Image content"
+//	@Failure		400					"Invalid profile picture ID format"
+//	@Failure		403					"Insufficient privileges"
+//	@Failure		404					"Profile picture not found"
+//	@Failure		500					"Internal server error"
+//	@Router			/v1/record/telegram/profile_picture/{profile_picture_id} [get]
+func (handler *DownloadTelegramProfilePictureHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	pictureID, err := uuid.Parse(r.PathValue("profile_picture_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid profile picture ID format", slog.Any("err", err))
+		http.Error(w, "Invalid profile picture ID format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.DownloadTelegramProfilePictureRequest{ProfilePictureID: pictureID}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrProfilePictureNotFound):
+			handler.logger.DebugContext(r.Context(), "telegram profile picture not found by ID", slog.Any("err", err))
+			http.Error(w, "Profile picture not found", http.StatusNotFound)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Storage error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+	defer resp.Content.Close()
+
+	picture := resp.ProfilePicture
+	streamBlob(w, resp.Content, picture.MimeType, picture.FileSize, picture.ID.String(), handler.logger, r)
+}
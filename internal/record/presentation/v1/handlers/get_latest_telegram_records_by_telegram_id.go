@@ -5,6 +5,7 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"strconv"
 
 	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
 	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
@@ -23,12 +24,14 @@ type GetLatestTelegramRecordsByTelegramIDRequest struct {
 }
 
 type GetLatestTelegramRecordsByTelegramIDHandler struct {
-	interactor *application.GetLatestTelegramRecordsByUserTelegramID
-	logger     *slog.Logger
+	interactor       *application.GetLatestTelegramRecordsByUserTelegramID
+	streamInteractor *application.StreamTelegramRecordsByUserTelegramID
+	logger           *slog.Logger
 }
 
 func NewGetLatestTelegramRecordsByTelegramID(
 	interactor *application.GetLatestTelegramRecordsByUserTelegramID,
+	streamInteractor *application.StreamTelegramRecordsByUserTelegramID,
 	logger *slog.Logger,
 ) *GetLatestTelegramRecordsByTelegramIDHandler {
 	handlerLogger := logger.With(
@@ -37,8 +40,9 @@ func NewGetLatestTelegramRecordsByTelegramID(
 	)
 
 	return &GetLatestTelegramRecordsByTelegramIDHandler{
-		interactor: interactor,
-		logger:     handlerLogger,
+		interactor:       interactor,
+		streamInteractor: streamInteractor,
+		logger:           handlerLogger,
 	}
 }
 
@@ -46,17 +50,34 @@ func NewGetLatestTelegramRecordsByTelegramID(
 //
 //	@Summary		Get latest Telegram records
 //	@Description	Get the latest Telegram records for a specific Telegram user ID.
+//	@Description	Every record of the user, the latest first, is streamed as CSV or NDJSON when format is csv
+//	@Description	or ndjson, or when text/csv or application/x-ndjson is accepted. The user is then read from the path.
 //	@Tags			record
 //	@Accept			json
 //	@Produce		json
-//	@Param			request	body		GetLatestTelegramRecordsByTelegramIDRequest		true	"Telegram ID request"
-//	@Success		200		{object}	GetLatestTelegramRecordsByTelegramIDResponse	"Latest records retrieved successfully"
-//	@Failure		400		"Invalid telegram ID format"
-//	@Failure		403		"Insufficient privileges"
-//	@Failure		404		"Telegram ID not found"
-//	@Failure		500		"Internal server error"
+//	@Produce		text/csv
+//	@Produce		application/x-ndjson
+//	@Param			telegram_id	path		int												true	"Telegram user ID"
+//	@Param			format		query		string											false	"Response format"	Enums(json, csv, ndjson)
+//	@Param			tag			query		string											false	"Only list records holding the tag"
+//	@Param			request		body		GetLatestTelegramRecordsByTelegramIDRequest		false	"Telegram ID request"
+//	@Success		200			{object}	GetLatestTelegramRecordsByTelegramIDResponse	"Latest records retrieved successfully"
+//	@Failure		400			"Invalid telegram ID, format or tag"
+//	@Failure		403			"Insufficient privileges"
+//	@Failure		404			"Telegram ID not found"
+//	@Failure		500			"Internal server error"
 //	@Router			/v1/record/telegram/{telegram_id}/records [get]
 func (handler *GetLatestTelegramRecordsByTelegramIDHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	format, ok := negotiateRowFormat(r)
+	if !ok {
+		http.Error(w, "Unsupported format, use json, csv or ndjson", http.StatusBadRequest)
+		return
+	}
+	if format != rowFormatJSON {
+		handler.stream(w, r, format)
+		return
+	}
+
 	var req GetLatestTelegramRecordsByTelegramIDRequest
 	dec := json.NewDecoder(r.Body)
 	dec.DisallowUnknownFields()
@@ -65,7 +86,10 @@ func (handler *GetLatestTelegramRecordsByTelegramIDHandler) ServeHTTP(w http.Res
 		http.Error(w, "Invalid telegram ID format", http.StatusBadRequest)
 		return
 	}
-	requestDTO := application.GetLatestTelegramRecordsByUserTelegramIDRequest{UserTelegramID: req.TelegramID}
+	requestDTO := application.GetLatestTelegramRecordsByUserTelegramIDRequest{
+		UserTelegramID: req.TelegramID,
+		Tag:            r.URL.Query().Get("tag"),
+	}
 	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
 	if err != nil {
 		switch {
@@ -73,6 +97,9 @@ func (handler *GetLatestTelegramRecordsByTelegramIDHandler) ServeHTTP(w http.Res
 			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
 			http.Error(w, "Insufficient privileges", http.StatusForbidden)
 			return
+		case errors.Is(err, domain.ErrInvalidTag):
+			http.Error(w, "Invalid tag", http.StatusBadRequest)
+			return
 		case errors.Is(err, domain.ErrNoRecordsForThisTelegramID):
 			handler.logger.DebugContext(r.Context(), "telegram user not found by ID", slog.Any("err", err))
 			http.Error(w, "Telegram ID not found", http.StatusNotFound)
@@ -87,3 +114,53 @@ func (handler *GetLatestTelegramRecordsByTelegramIDHandler) ServeHTTP(w http.Res
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(responce) //nolint:musttag // Linter error, struct contains json tags
 }
+
+// stream writes every record of the user in the format as it's read.
+func (handler *GetLatestTelegramRecordsByTelegramIDHandler) stream(
+	w http.ResponseWriter,
+	r *http.Request,
+	format string,
+) {
+	userTelegramID, err := strconv.ParseUint(r.PathValue("telegram_id"), 10, 64)
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid telegram ID format", slog.Any("err", err))
+		http.Error(w, "Invalid telegram ID format", http.StatusBadRequest)
+		return
+	}
+	stream := newRowStream(
+		w,
+		format,
+		"telegram_"+strconv.FormatUint(userTelegramID, 10)+"_records",
+		telegramRecordCSVHeader,
+		"records",
+	)
+	_, err = handler.streamInteractor.Execute(r.Context(), application.StreamTelegramRecordsByUserTelegramIDRequest{
+		UserTelegramID: userTelegramID,
+		Tag:            r.URL.Query().Get("tag"),
+		Yield: func(record *domain.TelegramRecord) error {
+			return stream.write(toTelegramRecordResponse(record))
+		},
+	})
+	if err != nil {
+		if stream.started() {
+			handler.logger.WarnContext(r.Context(), "telegram records stream was interrupted", slog.Any("err", err))
+			abortRowStream()
+		}
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrInvalidTag):
+			http.Error(w, "Invalid tag", http.StatusBadRequest)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+	if err = stream.finish(); err != nil {
+		handler.logger.DebugContext(r.Context(), "failed to finish telegram records stream", slog.Any("err", err))
+	}
+}
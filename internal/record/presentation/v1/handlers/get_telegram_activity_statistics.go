@@ -0,0 +1,211 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/activity"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+)
+
+// GetTelegramActivityStatisticsResponse represents the response from the activity statistics endpoints.
+type GetTelegramActivityStatisticsResponse struct {
+	Timezone             string                          `json:"timezone"                example:"Europe/Berlin"`
+	MessageCount         int                             `json:"message_count"           example:"1250"`
+	AverageMessageLength float64                         `json:"average_message_length"  example:"42.5"`
+	FirstSeenAt          *time.Time                      `json:"first_seen_at,omitempty" example:"2024-01-15T10:30:00Z"`
+	LastSeenAt           *time.Time                      `json:"last_seen_at,omitempty"  example:"2024-02-01T08:00:00Z"`
+	Daily                []TelegramDailyActivityResponse `json:"daily"`
+	// HourOfWeek holds the amount of messages by weekday, Monday first, and hour of the day
+	HourOfWeek [7][24]int `json:"hour_of_week"`
+	// Breakdown is by chat for a user and by sender for a chat, the most active first
+	Breakdown []TelegramActivityBreakdownResponse `json:"breakdown"`
+	// Gaps are the longest periods without messages, the longest first
+	Gaps []TelegramActivityGapResponse `json:"gaps"`
+}
+
+type TelegramDailyActivityResponse struct {
+	Day          string `json:"day"           example:"2024-01-15"`
+	MessageCount int    `json:"message_count" example:"17"`
+}
+
+type TelegramActivityBreakdownResponse struct {
+	TelegramID   int64     `json:"telegram_id"   example:"-1001234567890"`
+	MessageCount int       `json:"message_count" example:"320"`
+	FirstSeenAt  time.Time `json:"first_seen_at" example:"2024-01-15T10:30:00Z"`
+	LastSeenAt   time.Time `json:"last_seen_at"  example:"2024-02-01T08:00:00Z"`
+}
+
+type TelegramActivityGapResponse struct {
+	StartedAt       time.Time `json:"started_at"       example:"2024-01-20T22:10:00Z"`
+	EndedAt         time.Time `json:"ended_at"         example:"2024-01-27T09:45:00Z"`
+	DurationSeconds int64     `json:"duration_seconds" example:"560100"`
+}
+
+type GetTelegramUserActivityHandler struct {
+	interactor *application.GetTelegramActivityStatistics
+	logger     *slog.Logger
+}
+
+func NewGetTelegramUserActivityHandler(
+	interactor *application.GetTelegramActivityStatistics,
+	logger *slog.Logger,
+) *GetTelegramUserActivityHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_user_activity_handler"),
+	)
+
+	return &GetTelegramUserActivityHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to get the activity statistics of a user.
+//
+//	@Summary		Get the activity of a user
+//	@Description	Get the activity heatmap and statistics of a Telegram user: message counts by day and by hour
+//	@Description	of the week, the most active chats, the average message length, first and last seen and the
+//	@Description	longest gaps in activity. Days and hours are counted in the given IANA timezone.
+//	@Tags			record
+//	@Produce		json
+//	@Param			telegram_id	path		int										true	"User Telegram ID"
+//	@Param			timezone	query		string									false	"IANA timezone"	default(UTC)
+//	@Success		200			{object}	GetTelegramActivityStatisticsResponse	"Statistics retrieved successfully"
+//	@Failure		400			"Invalid user ID or timezone"
+//	@Failure		403			"Insufficient privileges"
+//	@Failure		500			"Internal server error"
+//	@Router			/v1/record/telegram/user/{telegram_id}/activity [get]
+func (handler *GetTelegramUserActivityHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userTelegramID, err := strconv.ParseInt(r.PathValue("telegram_id"), 10, 64)
+	if err != nil || userTelegramID <= 0 {
+		handler.logger.DebugContext(r.Context(), "invalid user ID format", slog.Any("err", err))
+		http.Error(w, "Invalid user ID format", http.StatusBadRequest)
+		return
+	}
+	requestDTO := application.GetTelegramActivityStatisticsRequest{
+		Scope:      domain.TelegramActivityScopeUser,
+		TelegramID: userTelegramID,
+		Timezone:   r.URL.Query().Get("timezone"),
+	}
+	serveTelegramActivityStatistics(w, r, handler.interactor, handler.logger, requestDTO)
+}
+
+type GetTelegramChatActivityHandler struct {
+	interactor *application.GetTelegramActivityStatistics
+	logger     *slog.Logger
+}
+
+func NewGetTelegramChatActivityHandler(
+	interactor *application.GetTelegramActivityStatistics,
+	logger *slog.Logger,
+) *GetTelegramChatActivityHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_chat_activity_handler"),
+	)
+
+	return &GetTelegramChatActivityHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to get the activity statistics of a chat.
+//
+//	@Summary		Get the activity of a chat
+//	@Description	Get the activity heatmap and statistics of a Telegram chat: message counts by day and by hour
+//	@Description	of the week, the most active senders, the average message length, first and last seen and the
+//	@Description	longest gaps in activity. Days and hours are counted in the given IANA timezone.
+//	@Tags			record
+//	@Produce		json
+//	@Param			chat_telegram_id	path		int										true	"Chat Telegram ID"
+//	@Param			timezone			query		string									false	"IANA timezone"	default(UTC)
+//	@Success		200					{object}	GetTelegramActivityStatisticsResponse	"Statistics retrieved successfully"
+//	@Failure		400					"Invalid chat ID or timezone"
+//	@Failure		403					"Insufficient privileges"
+//	@Failure		500					"Internal server error"
+//	@Router			/v1/record/telegram/chat/{chat_telegram_id}/activity [get]
+func (handler *GetTelegramChatActivityHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	chatTelegramID, err := strconv.ParseInt(r.PathValue("chat_telegram_id"), 10, 64)
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid chat ID format", slog.Any("err", err))
+		http.Error(w, "Invalid chat ID format", http.StatusBadRequest)
+		return
+	}
+	requestDTO := application.GetTelegramActivityStatisticsRequest{
+		Scope:      domain.TelegramActivityScopeChat,
+		TelegramID: chatTelegramID,
+		Timezone:   r.URL.Query().Get("timezone"),
+	}
+	serveTelegramActivityStatistics(w, r, handler.interactor, handler.logger, requestDTO)
+}
+
+func serveTelegramActivityStatistics(
+	w http.ResponseWriter,
+	r *http.Request,
+	interactor *application.GetTelegramActivityStatistics,
+	logger *slog.Logger,
+	requestDTO application.GetTelegramActivityStatisticsRequest,
+) {
+	resp, err := interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, application.ErrInvalidTimezone):
+			logger.DebugContext(r.Context(), "Invalid timezone", slog.Any("err", err))
+			http.Error(w, "Unknown timezone, use an IANA name such as Europe/Berlin", http.StatusBadRequest)
+			return
+		default:
+			logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	statistics := resp.Statistics
+	response := GetTelegramActivityStatisticsResponse{
+		Timezone:             resp.Timezone,
+		MessageCount:         statistics.MessageCount,
+		AverageMessageLength: statistics.AverageMessageLength,
+		FirstSeenAt:          statistics.FirstSeenAt,
+		LastSeenAt:           statistics.LastSeenAt,
+		Daily:                make([]TelegramDailyActivityResponse, len(statistics.Daily)),
+		HourOfWeek:           statistics.HourOfWeek,
+		Breakdown:            make([]TelegramActivityBreakdownResponse, len(statistics.Breakdown)),
+		Gaps:                 make([]TelegramActivityGapResponse, len(statistics.Gaps)),
+	}
+	for i, daily := range statistics.Daily {
+		response.Daily[i] = TelegramDailyActivityResponse{
+			Day:          daily.Day.Format(time.DateOnly),
+			MessageCount: daily.MessageCount,
+		}
+	}
+	for i, breakdown := range statistics.Breakdown {
+		response.Breakdown[i] = TelegramActivityBreakdownResponse{
+			TelegramID:   breakdown.TelegramID,
+			MessageCount: breakdown.MessageCount,
+			FirstSeenAt:  breakdown.FirstSeenAt,
+			LastSeenAt:   breakdown.LastSeenAt,
+		}
+	}
+	for i, gap := range statistics.Gaps {
+		response.Gaps[i] = TelegramActivityGapResponse{
+			StartedAt:       gap.StartedAt,
+			EndedAt:         gap.EndedAt,
+			DurationSeconds: int64(gap.EndedAt.Sub(gap.StartedAt).Seconds()),
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
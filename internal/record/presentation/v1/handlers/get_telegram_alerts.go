@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/alert"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+// GetTelegramAlertsResponse represents the response from the GetTelegramAlerts endpoint.
+type GetTelegramAlertsResponse struct {
+	Alerts      []TelegramAlertResponse `json:"alerts"`
+	UnreadCount int                     `json:"unread_count" example:"3"`
+}
+
+// TelegramAlertResponse is a match of a watchlist entry, either in a record or in an identity.
+type TelegramAlertResponse struct {
+	ID          uuid.UUID  `json:"id"                example:"550e8400-e29b-41d4-a716-446655440000"`
+	WatchlistID uuid.UUID  `json:"watchlist_id"      example:"9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"`
+	EntryID     uuid.UUID  `json:"entry_id"          example:"4c7e8a21-3f5b-4d6e-9a1c-0b2d3e4f5a6b"`
+	EntryType   string     `json:"entry_type"        example:"username" enums:"telegram_id,username,phone,keyword"`
+	EntryValue  string     `json:"entry_value"       example:"john_doe"`
+	RecordID    *uuid.UUID `json:"record_id,omitempty"`
+	IdentityID  *uuid.UUID `json:"identity_id,omitempty"`
+	TriggeredAt time.Time  `json:"triggered_at"      example:"2024-01-15T10:30:00Z"`
+	ReadAt      *time.Time `json:"read_at,omitempty" example:"2024-01-15T11:00:00Z"`
+}
+
+type GetTelegramAlertsHandler struct {
+	interactor *application.GetTelegramAlerts
+	logger     *slog.Logger
+}
+
+func NewGetTelegramAlertsHandler(
+	interactor *application.GetTelegramAlerts,
+	logger *slog.Logger,
+) *GetTelegramAlertsHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_alerts_handler"),
+	)
+
+	return &GetTelegramAlertsHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to list the alerts of the current user.
+//
+//	@Summary		Get alerts
+//	@Description	List the alerts raised by the watchlists of the current user, newest first,
+//	@Description	along with the amount of unread alerts
+//	@Tags			alert
+//	@Produce		json
+//	@Param			unread	query		bool						false	"Only list unread alerts"	default(false)
+//	@Param			limit	query		int							false	"Amount of alerts"	minimum(1)	maximum(200)	default(50)
+//	@Param			offset	query		int							false	"Amount of alerts to skip"	minimum(0)	default(0)
+//	@Success		200		{object}	GetTelegramAlertsResponse	"Alerts retrieved successfully"
+//	@Failure		400		"Invalid filter or pagination"
+//	@Failure		403		"Insufficient privileges"
+//	@Failure		500		"Internal server error"
+//	@Router			/v1/alerts [get]
+func (handler *GetTelegramAlertsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	requestDTO := application.GetTelegramAlertsRequest{}
+	var err error
+	if rawUnread := query.Get("unread"); rawUnread != "" {
+		if requestDTO.UnreadOnly, err = strconv.ParseBool(rawUnread); err != nil {
+			http.Error(w, "Invalid unread format", http.StatusBadRequest)
+			return
+		}
+	}
+	if rawLimit := query.Get("limit"); rawLimit != "" {
+		if requestDTO.Limit, err = strconv.Atoi(rawLimit); err != nil {
+			http.Error(w, "Invalid limit format", http.StatusBadRequest)
+			return
+		}
+	}
+	if rawOffset := query.Get("offset"); rawOffset != "" {
+		if requestDTO.Offset, err = strconv.Atoi(rawOffset); err != nil {
+			http.Error(w, "Invalid offset format", http.StatusBadRequest)
+			return
+		}
+	}
+
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, application.ErrInvalidPagination):
+			http.Error(w, "Limit must be between 1 and 200 and offset must not be negative", http.StatusBadRequest)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := GetTelegramAlertsResponse{
+		Alerts:      make([]TelegramAlertResponse, len(resp.Alerts)),
+		UnreadCount: resp.UnreadCount,
+	}
+	for i, alert := range resp.Alerts {
+		response.Alerts[i] = TelegramAlertResponse{
+			ID:          alert.ID,
+			WatchlistID: alert.WatchlistID,
+			EntryID:     alert.EntryID,
+			EntryType:   string(alert.EntryType),
+			EntryValue:  alert.EntryValue,
+			RecordID:    alert.RecordID,
+			IdentityID:  alert.IdentityID,
+			TriggeredAt: alert.TriggeredAt,
+			ReadAt:      alert.ReadAt,
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
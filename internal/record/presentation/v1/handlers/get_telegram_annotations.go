@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/annotation"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+// GetTelegramAnnotationsResponse holds the tags and the notes of a telegram user, identity or record.
+type GetTelegramAnnotationsResponse struct {
+	Tags  []TelegramTagResponse  `json:"tags"`
+	Notes []TelegramNoteResponse `json:"notes"`
+}
+
+// TelegramTagResponse labels the telegram user, identity or record with the target ID.
+type TelegramTagResponse struct {
+	ID         uuid.UUID `json:"id"          example:"9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"`
+	TargetType string    `json:"target_type" enums:"telegram_user,telegram_identity,telegram_record"`
+	TargetID   uuid.UUID `json:"target_id"   example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name       string    `json:"name"        example:"scammer"`
+	AddedBy    uuid.UUID `json:"added_by"    example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	AddedAt    time.Time `json:"added_at"    example:"2024-01-15T10:30:00Z"`
+}
+
+// TelegramNoteResponse is a markdown note about the telegram user, identity or record with the target ID.
+type TelegramNoteResponse struct {
+	ID         uuid.UUID `json:"id"          example:"4c7e8a21-3f5b-4d6e-9a1c-0b2d3e4f5a6b"`
+	TargetType string    `json:"target_type" enums:"telegram_user,telegram_identity,telegram_record"`
+	TargetID   uuid.UUID `json:"target_id"   example:"550e8400-e29b-41d4-a716-446655440000"`
+	Body       string    `json:"body"        example:"Runs the **fake giveaway** channels"`
+	AddedBy    uuid.UUID `json:"added_by"    example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	AddedAt    time.Time `json:"added_at"    example:"2024-01-15T10:30:00Z"`
+}
+
+func toTelegramTagResponse(tag domain.TelegramTag) TelegramTagResponse {
+	return TelegramTagResponse{
+		ID:         tag.ID,
+		TargetType: string(tag.TargetType),
+		TargetID:   tag.TargetID,
+		Name:       tag.Name,
+		AddedBy:    tag.AddedBy,
+		AddedAt:    tag.AddedAt,
+	}
+}
+
+func toTelegramNoteResponse(note domain.TelegramNote) TelegramNoteResponse {
+	return TelegramNoteResponse{
+		ID:         note.ID,
+		TargetType: string(note.TargetType),
+		TargetID:   note.TargetID,
+		Body:       note.Body,
+		AddedBy:    note.AddedBy,
+		AddedAt:    note.AddedAt,
+	}
+}
+
+// parseAnnotationTarget reads the annotated telegram user, identity or record from the path,
+// the type is checked by the interactors.
+func parseAnnotationTarget(r *http.Request) (domain.TelegramAnnotationTargetType, uuid.UUID, error) {
+	targetID, err := uuid.Parse(r.PathValue("target_id"))
+	return domain.TelegramAnnotationTargetType(r.PathValue("target_type")), targetID, err
+}
+
+type GetTelegramAnnotationsHandler struct {
+	interactor *application.GetTelegramAnnotations
+	logger     *slog.Logger
+}
+
+func NewGetTelegramAnnotationsHandler(
+	interactor *application.GetTelegramAnnotations,
+	logger *slog.Logger,
+) *GetTelegramAnnotationsHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_annotations_handler"),
+	)
+
+	return &GetTelegramAnnotationsHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to get the tags and the notes of a telegram user, identity or record.
+//
+//	@Summary		Get annotations
+//	@Description	Get the tags and the notes of a telegram user, identity or record, the oldest first.
+//	@Tags			annotation
+//	@Produce		json
+//	@Param			target_type	path		string	true	"Target type"	Enums(telegram_user, telegram_identity, telegram_record)
+//	@Param			target_id	path		string	true	"Target ID"		format(uuid)
+//	@Success		200			{object}	GetTelegramAnnotationsResponse	"Annotations retrieved successfully"
+//	@Failure		400			{string}	string	"Invalid target"
+//	@Failure		403			{string}	string	"Insufficient privileges"
+//	@Failure		500			{string}	string	"Internal server error"
+//	@Router			/v1/annotations/{target_type}/{target_id} [get]
+func (handler *GetTelegramAnnotationsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	targetType, targetID, err := parseAnnotationTarget(r)
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid target ID format", slog.Any("err", err))
+		http.Error(w, "Invalid target ID format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.GetTelegramAnnotationsRequest{TargetType: targetType, TargetID: targetID}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, application.ErrInvalidAnnotationTarget):
+			http.Error(w, "Invalid target type", http.StatusBadRequest)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := GetTelegramAnnotationsResponse{
+		Tags:  make([]TelegramTagResponse, len(resp.Tags)),
+		Notes: make([]TelegramNoteResponse, len(resp.Notes)),
+	}
+	for i, tag := range resp.Tags {
+		response.Tags[i] = toTelegramTagResponse(tag)
+	}
+	for i, note := range resp.Notes {
+		response.Notes[i] = toTelegramNoteResponse(note)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
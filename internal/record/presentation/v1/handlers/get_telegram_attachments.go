@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/attachment"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+// TelegramAttachmentResponse is a file attached to a record. The content is served by its own endpoint.
+type TelegramAttachmentResponse struct {
+	ID                   string    `json:"id"                     example:"550e8400-e29b-41d4-a716-446655440000"`
+	TelegramAttachmentID uint64    `json:"telegram_attachment_id" example:"5368371429374829"`
+	FileName             string    `json:"file_name"              example:"photo.jpg"`
+	SHA256               string    `json:"sha256"                 example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b"`
+	FileSize             uint64    `json:"file_size"              example:"102400"`
+	MimeType             string    `json:"mime_type"              example:"image/jpeg"`
+	AddedAt              time.Time `json:"added_at"               example:"2024-01-15T10:30:00Z"`
+}
+
+// GetTelegramAttachmentsResponse represents the response from the GetTelegramAttachments endpoint.
+type GetTelegramAttachmentsResponse struct {
+	Attachments []TelegramAttachmentResponse `json:"attachments"`
+}
+
+type GetTelegramAttachmentsHandler struct {
+	interactor *application.GetTelegramAttachments
+	logger     *slog.Logger
+}
+
+func NewGetTelegramAttachmentsHandler(
+	interactor *application.GetTelegramAttachments,
+	logger *slog.Logger,
+) *GetTelegramAttachmentsHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_attachments_handler"),
+	)
+
+	return &GetTelegramAttachmentsHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to list the attachments of a record.
+//
+//	@Summary		List record attachments
+//	@Description	Get metadata of all files attached to the record.
+//	@Tags			record
+//	@Produce		json
+//	@Param			record_id	path		string							true	"Record ID"
+//	@Success		200			{object}	GetTelegramAttachmentsResponse	"Attachments retrieved successfully"
+//	@Failure		400			"Invalid record ID format"
+//	@Failure		403			"Insufficient privileges"
+//	@Failure		500			"Internal server error"
+//	@Router			/v1/record/telegram/record/{record_id}/attachments [get]
+func (handler *GetTelegramAttachmentsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	recordID, err := uuid.Parse(r.PathValue("record_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid record ID format", slog.Any("err", err))
+		http.Error(w, "Invalid record ID format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.GetTelegramAttachmentsRequest{RecordID: recordID}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	attachments := make([]TelegramAttachmentResponse, len(resp.Attachments))
+	for i, attachment := range resp.Attachments {
+		attachments[i] = TelegramAttachmentResponse{
+			ID:                   attachment.ID.String(),
+			TelegramAttachmentID: attachment.TelegramAttachmentID,
+			FileName:             attachment.FileName,
+			SHA256:               attachment.SHA256,
+			FileSize:             attachment.FileSize,
+			MimeType:             attachment.MimeType,
+			AddedAt:              attachment.AddedAt,
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(GetTelegramAttachmentsResponse{Attachments: attachments})
+}
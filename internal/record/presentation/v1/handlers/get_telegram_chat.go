@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/chat"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+)
+
+// TelegramChatSnapshotResponse is a state of chat metadata at the moment it has been added.
+type TelegramChatSnapshotResponse struct {
+	ID          string    `json:"id"           example:"9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"`
+	Title       string    `json:"title"        example:"Trinity discussion"`
+	Username    string    `json:"username"     example:"trinity_chat"`
+	MemberCount uint64    `json:"member_count" example:"1337"`
+	Description string    `json:"description"  example:"Public chat of the Trinity project"`
+	AddedAt     time.Time `json:"added_at"     example:"2024-01-15T10:30:00Z"`
+}
+
+// GetTelegramChatResponse represents the response from the GetTelegramChat endpoint
+//
+//	@Description	Telegram chat with its metadata history, a chat only known from the records posted in it
+//	@Description	is of the unknown type until its metadata is added
+type GetTelegramChatResponse struct {
+	ID             string                         `json:"id"               example:"550e8400-e29b-41d4-a716-446655440000"`
+	ChatTelegramID int64                          `json:"chat_telegram_id" example:"-1001234567890"`
+	ChatType       string                         `json:"chat_type"        example:"supergroup"                           enums:"private,group,supergroup,channel,unknown"`
+	AddedAt        time.Time                      `json:"added_at"         example:"2024-01-15T10:30:00Z"`
+	Snapshots      []TelegramChatSnapshotResponse `json:"snapshots"`
+}
+
+type GetTelegramChatHandler struct {
+	interactor *application.GetTelegramChat
+	logger     *slog.Logger
+}
+
+func NewGetTelegramChatHandler(
+	interactor *application.GetTelegramChat,
+	logger *slog.Logger,
+) *GetTelegramChatHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_chat_handler"),
+	)
+
+	return &GetTelegramChatHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to get a Telegram chat by its Telegram ID.
+//
+//	@Summary		Get Telegram chat
+//	@Description	Get a Telegram chat with all snapshots of its metadata.
+//	@Tags			record
+//	@Produce		json
+//	@Param			chat_telegram_id	path		int						true	"Chat Telegram ID"
+//	@Success		200					{object}	GetTelegramChatResponse	"Chat retrieved successfully"
+//	@Failure		400					"Invalid chat ID format"
+//	@Failure		403					"Insufficient privileges"
+//	@Failure		404					"Chat not found"
+//	@Failure		500					"Internal server error"
+//	@Router			/v1/record/telegram/chat/{chat_telegram_id} [get]
+//	@Security		Bearer
+func (handler *GetTelegramChatHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	chatTelegramID, err := strconv.ParseInt(r.PathValue("chat_telegram_id"), 10, 64)
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid chat ID format", slog.Any("err", err))
+		http.Error(w, "Invalid chat ID format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.GetTelegramChatRequest{ChatTelegramID: chatTelegramID}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrChatNotFound):
+			handler.logger.DebugContext(r.Context(), "telegram chat not found by ID", slog.Any("err", err))
+			http.Error(w, "Chat not found", http.StatusNotFound)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	snapshots := make([]TelegramChatSnapshotResponse, len(resp.Snapshots))
+	for i, snapshot := range resp.Snapshots {
+		snapshots[i] = TelegramChatSnapshotResponse{
+			ID:          snapshot.ID.String(),
+			Title:       snapshot.Title,
+			Username:    snapshot.Username,
+			MemberCount: snapshot.MemberCount,
+			Description: snapshot.Description,
+			AddedAt:     snapshot.AddedAt,
+		}
+	}
+	response := GetTelegramChatResponse{
+		ID:             resp.Chat.ID.String(),
+		ChatTelegramID: resp.Chat.ChatTelegramID,
+		ChatType:       string(resp.Chat.Type),
+		AddedAt:        resp.Chat.AddedAt,
+		Snapshots:      snapshots,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/correlation"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+// GetTelegramCorrelationClustersResponse represents the response from the GetTelegramCorrelationClusters endpoint.
+type GetTelegramCorrelationClustersResponse struct {
+	Clusters []TelegramCorrelationClusterResponse `json:"clusters"`
+}
+
+type TelegramCorrelationClusterResponse struct {
+	Type    string                              `json:"type"  example:"phone"`
+	Value   string                              `json:"value" example:"+15551234567"`
+	Members []TelegramCorrelationMemberResponse `json:"members"`
+}
+
+// TelegramCorrelationMemberResponse is a user of a cluster, the identities are the evidence of the link.
+type TelegramCorrelationMemberResponse struct {
+	UserTelegramID uint64      `json:"user_telegram_id" example:"123456789"`
+	IdentityIDs    []uuid.UUID `json:"identity_ids"`
+	FirstSeenAt    time.Time   `json:"first_seen_at"    example:"2024-01-15T10:30:00Z"`
+	LastSeenAt     time.Time   `json:"last_seen_at"     example:"2024-02-01T08:00:00Z"`
+}
+
+type GetTelegramCorrelationClustersHandler struct {
+	interactor *application.GetTelegramCorrelationClusters
+	logger     *slog.Logger
+}
+
+func NewGetTelegramCorrelationClustersHandler(
+	interactor *application.GetTelegramCorrelationClusters,
+	logger *slog.Logger,
+) *GetTelegramCorrelationClustersHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_correlation_clusters_handler"),
+	)
+
+	return &GetTelegramCorrelationClustersHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to list the clusters of correlated users.
+//
+//	@Summary		Get correlated users
+//	@Description	List the clusters of different Telegram users whose identities share a phone number, a username
+//	@Description	or a bio, with the IDs of the identities the value was seen in as evidence. Phone numbers are
+//	@Description	compared by their digits, usernames and bios ignoring case and, for bios, whitespace.
+//	@Tags			record
+//	@Produce		json
+//	@Param			type		query		[]string								false	"Correlation types, all by default"	collectionFormat(multi)	Enums(phone, username, bio)
+//	@Param			telegram_id	query		int										false	"Keep the clusters of the user"
+//	@Param			limit		query		int										false	"Amount of clusters"	minimum(1)	maximum(100)	default(50)
+//	@Param			offset		query		int										false	"Amount of clusters to skip"	minimum(0)	default(0)
+//	@Success		200			{object}	GetTelegramCorrelationClustersResponse	"Clusters retrieved successfully"
+//	@Failure		400			"Invalid type, user ID or pagination"
+//	@Failure		403			"Insufficient privileges"
+//	@Failure		500			"Internal server error"
+//	@Router			/v1/record/telegram/correlations [get]
+func (handler *GetTelegramCorrelationClustersHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	requestDTO := application.GetTelegramCorrelationClustersRequest{}
+	for _, correlationType := range query["type"] {
+		requestDTO.Types = append(requestDTO.Types, domain.TelegramCorrelationType(correlationType))
+	}
+	var err error
+	if rawTelegramID := query.Get("telegram_id"); rawTelegramID != "" {
+		if requestDTO.UserTelegramID, err = strconv.ParseUint(rawTelegramID, 10, 64); err != nil {
+			handler.logger.DebugContext(r.Context(), "invalid user ID format", slog.Any("err", err))
+			http.Error(w, "Invalid user ID format", http.StatusBadRequest)
+			return
+		}
+	}
+	if rawLimit := query.Get("limit"); rawLimit != "" {
+		if requestDTO.Limit, err = strconv.Atoi(rawLimit); err != nil {
+			http.Error(w, "Invalid limit format", http.StatusBadRequest)
+			return
+		}
+	}
+	if rawOffset := query.Get("offset"); rawOffset != "" {
+		if requestDTO.Offset, err = strconv.Atoi(rawOffset); err != nil {
+			http.Error(w, "Invalid offset format", http.StatusBadRequest)
+			return
+		}
+	}
+
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, application.ErrInvalidCorrelationType):
+			http.Error(w, "Unknown correlation type, use phone, username or bio", http.StatusBadRequest)
+			return
+		case errors.Is(err, application.ErrInvalidPagination):
+			http.Error(w, "Limit must be between 1 and 100 and offset must not be negative", http.StatusBadRequest)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := GetTelegramCorrelationClustersResponse{
+		Clusters: make([]TelegramCorrelationClusterResponse, len(resp.Clusters)),
+	}
+	for i, cluster := range resp.Clusters {
+		members := make([]TelegramCorrelationMemberResponse, len(cluster.Members))
+		for j, member := range cluster.Members {
+			members[j] = TelegramCorrelationMemberResponse{
+				UserTelegramID: member.UserTelegramID,
+				IdentityIDs:    member.IdentityIDs,
+				FirstSeenAt:    member.FirstSeenAt,
+				LastSeenAt:     member.LastSeenAt,
+			}
+		}
+		response.Clusters[i] = TelegramCorrelationClusterResponse{
+			Type:    string(cluster.Type),
+			Value:   cluster.Value,
+			Members: members,
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/export"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+type GetTelegramExportHandler struct {
+	interactor *application.GetTelegramExport
+	logger     *slog.Logger
+}
+
+func NewGetTelegramExportHandler(
+	interactor *application.GetTelegramExport,
+	logger *slog.Logger,
+) *GetTelegramExportHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_export_handler"),
+	)
+
+	return &GetTelegramExportHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to poll an export.
+//
+//	@Summary		Get an export
+//	@Description	Get the status and the progress of an export. Admins may read the exports of any user.
+//	@Tags			export
+//	@Produce		json
+//	@Param			export_id	path		string					true	"Export ID"
+//	@Success		200			{object}	TelegramExportResponse	"Export retrieved successfully"
+//	@Failure		400			"Invalid export ID format"
+//	@Failure		403			"Insufficient privileges"
+//	@Failure		404			"Export not found"
+//	@Failure		500			"Internal server error"
+//	@Router			/v1/exports/{export_id} [get]
+func (handler *GetTelegramExportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	exportID, err := uuid.Parse(r.PathValue("export_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid export ID format", slog.Any("err", err))
+		http.Error(w, "Invalid export ID format", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := handler.interactor.Execute(r.Context(), application.GetTelegramExportRequest{ExportID: exportID})
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrTelegramExportNotFound):
+			handler.logger.DebugContext(r.Context(), "telegram export not found by ID", slog.Any("err", err))
+			http.Error(w, "Export not found", http.StatusNotFound)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(newTelegramExportResponse(&resp.Export))
+}
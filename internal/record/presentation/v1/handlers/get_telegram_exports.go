@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/export"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+)
+
+// GetTelegramExportsResponse represents the response from the GetTelegramExports endpoint.
+type GetTelegramExportsResponse struct {
+	Exports []TelegramExportResponse `json:"exports"`
+}
+
+type GetTelegramExportsHandler struct {
+	interactor *application.GetTelegramExports
+	logger     *slog.Logger
+}
+
+func NewGetTelegramExportsHandler(
+	interactor *application.GetTelegramExports,
+	logger *slog.Logger,
+) *GetTelegramExportsHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_exports_handler"),
+	)
+
+	return &GetTelegramExportsHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to list the exports of the current user.
+//
+//	@Summary		Get exports
+//	@Description	List the exports requested by the current user with their progress, newest first
+//	@Tags			export
+//	@Produce		json
+//	@Param			limit	query		int							false	"Amount of exports"	minimum(1)	maximum(200)	default(50)
+//	@Param			offset	query		int							false	"Amount of exports to skip"	minimum(0)	default(0)
+//	@Success		200		{object}	GetTelegramExportsResponse	"Exports retrieved successfully"
+//	@Failure		400		"Invalid pagination"
+//	@Failure		403		"Insufficient privileges"
+//	@Failure		500		"Internal server error"
+//	@Router			/v1/exports [get]
+func (handler *GetTelegramExportsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	requestDTO := application.GetTelegramExportsRequest{}
+	var err error
+	if rawLimit := query.Get("limit"); rawLimit != "" {
+		if requestDTO.Limit, err = strconv.Atoi(rawLimit); err != nil {
+			http.Error(w, "Invalid limit format", http.StatusBadRequest)
+			return
+		}
+	}
+	if rawOffset := query.Get("offset"); rawOffset != "" {
+		if requestDTO.Offset, err = strconv.Atoi(rawOffset); err != nil {
+			http.Error(w, "Invalid offset format", http.StatusBadRequest)
+			return
+		}
+	}
+
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, application.ErrInvalidPagination):
+			http.Error(w, "Limit must be between 1 and 200 and offset must not be negative", http.StatusBadRequest)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := GetTelegramExportsResponse{Exports: make([]TelegramExportResponse, len(resp.Exports))}
+	for i := range resp.Exports {
+		response.Exports[i] = newTelegramExportResponse(&resp.Exports[i])
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
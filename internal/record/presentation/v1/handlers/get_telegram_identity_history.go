@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/identity"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+// GetTelegramIdentityHistoryResponse represents the JSON response from the GetTelegramIdentityHistory endpoint.
+type GetTelegramIdentityHistoryResponse struct {
+	Identities []TelegramIdentityResponse `json:"identities"`
+}
+
+// TelegramIdentityResponse is an identity of a user as added by a collector.
+type TelegramIdentityResponse struct {
+	ID          uuid.UUID `json:"id"            example:"550e8400-e29b-41d4-a716-446655440000"`
+	UserID      uuid.UUID `json:"user_id"       example:"cf6e273b-ac6e-43f1-abba-d8009ffc1b3f"`
+	Username    string    `json:"username"      example:"john_doe"`
+	FirstName   string    `json:"first_name"    example:"John"`
+	LastName    string    `json:"last_name"     example:"Doe"`
+	Bio         string    `json:"bio"           example:"Software developer"`
+	PhoneNumber string    `json:"phone_number"  example:"+15551234567"`
+	AddedAt     time.Time `json:"added_at"      example:"2024-01-15T10:30:00Z"`
+	AddedByUser uuid.UUID `json:"added_by_user" example:"9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"`
+}
+
+// telegramIdentityCSVHeader names the columns of the identities exported as CSV.
+var telegramIdentityCSVHeader = []string{
+	"id", "user_id", "username", "first_name", "last_name", "bio", "phone_number", "added_at", "added_by_user",
+}
+
+func (identity TelegramIdentityResponse) csvRecord() []string {
+	return []string{
+		identity.ID.String(),
+		identity.UserID.String(),
+		identity.Username,
+		identity.FirstName,
+		identity.LastName,
+		identity.Bio,
+		identity.PhoneNumber,
+		formatCSVTime(&identity.AddedAt),
+		identity.AddedByUser.String(),
+	}
+}
+
+type GetTelegramIdentityHistoryHandler struct {
+	interactor *application.StreamTelegramIdentityHistory
+	logger     *slog.Logger
+}
+
+func NewGetTelegramIdentityHistoryHandler(
+	interactor *application.StreamTelegramIdentityHistory,
+	logger *slog.Logger,
+) *GetTelegramIdentityHistoryHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_identity_history_handler"),
+	)
+
+	return &GetTelegramIdentityHistoryHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to get the identities a user has had over time.
+//
+//	@Summary		Get identity history
+//	@Description	Get every identity of the user as added by any collector, the oldest first. The identities are
+//	@Description	streamed as they're read, as CSV or NDJSON when format is csv or ndjson, or when text/csv
+//	@Description	or application/x-ndjson is accepted.
+//	@Tags			record
+//	@Produce		json
+//	@Produce		text/csv
+//	@Produce		application/x-ndjson
+//	@Param			telegram_id	path		int									true	"Telegram user ID"
+//	@Param			format		query		string								false	"Response format"	Enums(json, csv, ndjson)
+//	@Param			tag			query		string								false	"Only list identities holding the tag"
+//	@Success		200			{object}	GetTelegramIdentityHistoryResponse	"Identities retrieved successfully"
+//	@Failure		400			"Invalid telegram ID, format or tag"
+//	@Failure		403			"Insufficient privileges"
+//	@Failure		500			"Internal server error"
+//	@Router			/v1/record/telegram/user/{telegram_id}/identities [get]
+func (handler *GetTelegramIdentityHistoryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userTelegramID, err := strconv.ParseUint(r.PathValue("telegram_id"), 10, 64)
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid telegram ID format", slog.Any("err", err))
+		http.Error(w, "Invalid telegram ID format", http.StatusBadRequest)
+		return
+	}
+	format, ok := negotiateRowFormat(r)
+	if !ok {
+		http.Error(w, "Unsupported format, use json, csv or ndjson", http.StatusBadRequest)
+		return
+	}
+
+	stream := newRowStream(
+		w,
+		format,
+		"telegram_"+strconv.FormatUint(userTelegramID, 10)+"_identities",
+		telegramIdentityCSVHeader,
+		"identities",
+	)
+	_, err = handler.interactor.Execute(r.Context(), application.StreamTelegramIdentityHistoryRequest{
+		UserTelegramID: userTelegramID,
+		Tag:            r.URL.Query().Get("tag"),
+		Yield: func(identity *domain.TelegramIdentity) error {
+			return stream.write(TelegramIdentityResponse{
+				ID:          identity.ID,
+				UserID:      identity.UserID,
+				Username:    identity.Username,
+				FirstName:   identity.FirstName,
+				LastName:    identity.LastName,
+				Bio:         identity.Bio,
+				PhoneNumber: identity.PhoneNumber,
+				AddedAt:     identity.AddedAt,
+				AddedByUser: identity.AddedByUser,
+			})
+		},
+	})
+	if err != nil {
+		if stream.started() {
+			handler.logger.WarnContext(r.Context(), "telegram identities stream was interrupted", slog.Any("err", err))
+			abortRowStream()
+		}
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrInvalidTag):
+			http.Error(w, "Invalid tag", http.StatusBadRequest)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+	if err = stream.finish(); err != nil {
+		handler.logger.DebugContext(r.Context(), "failed to finish telegram identities stream", slog.Any("err", err))
+	}
+}
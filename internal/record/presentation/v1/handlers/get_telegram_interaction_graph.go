@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/interaction"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+)
+
+// defaultTelegramInteractionGraphDepth reaches the contacts of the contacts of the user.
+const defaultTelegramInteractionGraphDepth = 2
+
+// GetTelegramInteractionGraphResponse is the graph in the JSON Graph Format (https://jsongraphformat.info).
+type GetTelegramInteractionGraphResponse struct {
+	Graph TelegramInteractionGraphResponse `json:"graph"`
+}
+
+type TelegramInteractionGraphResponse struct {
+	Directed bool `json:"directed" example:"true"`
+	// Nodes are keyed by the IDs the edges refer to
+	Nodes map[string]TelegramInteractionGraphNodeResponse `json:"nodes"`
+	Edges []TelegramInteractionGraphEdgeResponse          `json:"edges"`
+}
+
+type TelegramInteractionGraphNodeResponse struct {
+	Label    string                                       `json:"label" example:"@john_doe"`
+	Metadata TelegramInteractionGraphNodeMetadataResponse `json:"metadata"`
+}
+
+type TelegramInteractionGraphNodeMetadataResponse struct {
+	TelegramID uint64 `json:"telegram_id"          example:"123456789"`
+	Username   string `json:"username,omitempty"   example:"john_doe"`
+	FirstName  string `json:"first_name,omitempty" example:"John"`
+	LastName   string `json:"last_name,omitempty"  example:"Doe"`
+	Depth      int    `json:"depth"                example:"1"`
+}
+
+type TelegramInteractionGraphEdgeResponse struct {
+	Source   string                                       `json:"source"   example:"u123456789"`
+	Target   string                                       `json:"target"   example:"u987654321"`
+	Relation string                                       `json:"relation" example:"reply"`
+	Directed bool                                         `json:"directed" example:"true"`
+	Metadata TelegramInteractionGraphEdgeMetadataResponse `json:"metadata"`
+}
+
+type TelegramInteractionGraphEdgeMetadataResponse struct {
+	Weight             int       `json:"weight"               example:"12"`
+	FirstInteractionAt time.Time `json:"first_interaction_at" example:"2024-01-15T10:30:00Z"`
+	LastInteractionAt  time.Time `json:"last_interaction_at"  example:"2024-02-01T08:00:00Z"`
+}
+
+type GetTelegramInteractionGraphHandler struct {
+	interactor *application.GetTelegramInteractionGraph
+	logger     *slog.Logger
+}
+
+func NewGetTelegramInteractionGraphHandler(
+	interactor *application.GetTelegramInteractionGraph,
+	logger *slog.Logger,
+) *GetTelegramInteractionGraphHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_interaction_graph_handler"),
+	)
+
+	return &GetTelegramInteractionGraphHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to get the interaction graph of a user.
+//
+//	@Summary		Get the interaction graph of a user
+//	@Description	Get the users a Telegram user has replied to, mentioned or shared a chat with, up to depth
+//	@Description	interactions away, and the interactions between them. Edges are weighted by the amount
+//	@Description	of interactions. The graph is returned in the JSON Graph Format by default, GraphML and GEXF
+//	@Description	for Gephi are selected with the format parameter or the Accept header.
+//	@Tags			record
+//	@Produce		json
+//	@Produce		application/graphml+xml
+//	@Produce		application/gexf+xml
+//	@Param			telegram_id	path		int									true	"User Telegram ID"
+//	@Param			depth		query		int									false	"Depth of the graph"	minimum(1)	maximum(3)	default(2)
+//	@Param			format		query		string								false	"Export format"			Enums(json, graphml, gexf)
+//	@Success		200			{object}	GetTelegramInteractionGraphResponse	"Graph retrieved successfully"
+//	@Failure		400			"Invalid user ID, depth or format"
+//	@Failure		403			"Insufficient privileges"
+//	@Failure		500			"Internal server error"
+//	@Router			/v1/record/telegram/{telegram_id}/graph [get]
+func (handler *GetTelegramInteractionGraphHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userTelegramID, err := strconv.ParseUint(r.PathValue("telegram_id"), 10, 64)
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid user ID format", slog.Any("err", err))
+		http.Error(w, "Invalid user ID format", http.StatusBadRequest)
+		return
+	}
+	depth := defaultTelegramInteractionGraphDepth
+	if rawDepth := r.URL.Query().Get("depth"); rawDepth != "" {
+		if depth, err = strconv.Atoi(rawDepth); err != nil {
+			handler.logger.DebugContext(r.Context(), "invalid depth format", slog.Any("err", err))
+			http.Error(w, "Invalid depth format", http.StatusBadRequest)
+			return
+		}
+	}
+	format, ok := negotiateGraphFormat(r)
+	if !ok {
+		http.Error(w, "Unsupported format, use json, graphml or gexf", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.GetTelegramInteractionGraphRequest{UserTelegramID: userTelegramID, Depth: depth}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, application.ErrInvalidGraphDepth):
+			handler.logger.DebugContext(r.Context(), "Invalid depth", slog.Any("err", err))
+			http.Error(w, "Depth must be between 1 and 3", http.StatusBadRequest)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", graphFormatContentTypes[format])
+	if format != graphFormatJSON {
+		w.Header().Set(
+			"Content-Disposition",
+			`attachment; filename="telegram_`+strconv.FormatUint(userTelegramID, 10)+`_graph.`+format+`"`,
+		)
+	}
+	w.WriteHeader(http.StatusOK)
+	switch format {
+	case graphFormatGraphML:
+		err = encodeGraphML(w, resp.Graph)
+	case graphFormatGEXF:
+		err = encodeGEXF(w, resp.Graph)
+	default:
+		err = json.NewEncoder(w).Encode(toGetTelegramInteractionGraphResponse(resp.Graph))
+	}
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "Failed to write the graph", slog.Any("err", err))
+	}
+}
+
+// negotiateGraphFormat prefers the format parameter over the Accept header and falls back to JSON.
+func negotiateGraphFormat(r *http.Request) (string, bool) {
+	if format := r.URL.Query().Get("format"); format != "" {
+		_, known := graphFormatContentTypes[format]
+		return format, known
+	}
+	accept := r.Header.Get("Accept")
+	for _, format := range []string{graphFormatGraphML, graphFormatGEXF} {
+		if strings.Contains(accept, graphFormatContentTypes[format]) {
+			return format, true
+		}
+	}
+	return graphFormatJSON, true
+}
+
+func toGetTelegramInteractionGraphResponse(graph domain.TelegramInteractionGraph) GetTelegramInteractionGraphResponse {
+	response := GetTelegramInteractionGraphResponse{Graph: TelegramInteractionGraphResponse{
+		Directed: true,
+		Nodes:    make(map[string]TelegramInteractionGraphNodeResponse, len(graph.Nodes)),
+		Edges:    make([]TelegramInteractionGraphEdgeResponse, len(graph.Edges)),
+	}}
+	for _, node := range graph.Nodes {
+		response.Graph.Nodes[graphNodeID(node.UserTelegramID)] = TelegramInteractionGraphNodeResponse{
+			Label: graphNodeLabel(node),
+			Metadata: TelegramInteractionGraphNodeMetadataResponse{
+				TelegramID: node.UserTelegramID,
+				Username:   node.Username,
+				FirstName:  node.FirstName,
+				LastName:   node.LastName,
+				Depth:      node.Depth,
+			},
+		}
+	}
+	for i, edge := range graph.Edges {
+		response.Graph.Edges[i] = TelegramInteractionGraphEdgeResponse{
+			Source:   graphNodeID(edge.FromUserTelegramID),
+			Target:   graphNodeID(edge.ToUserTelegramID),
+			Relation: string(edge.Type),
+			Directed: true,
+			Metadata: TelegramInteractionGraphEdgeMetadataResponse{
+				Weight:             edge.Weight,
+				FirstInteractionAt: edge.FirstInteractionAt,
+				LastInteractionAt:  edge.LastInteractionAt,
+			},
+		}
+	}
+	return response
+}
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/investigation"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+// GetTelegramInvestigationResponse holds everything in an investigation, role is the one of the current user.
+type GetTelegramInvestigationResponse struct {
+	Investigation TelegramInvestigationResponse       `json:"investigation"`
+	Role          string                              `json:"role" example:"owner" enums:"owner,editor,viewer"`
+	Items         []TelegramInvestigationItemResponse `json:"items"`
+}
+
+// TelegramInvestigationItemResponse refers to the telegram user, chat or record with the target ID.
+type TelegramInvestigationItemResponse struct {
+	ID       uuid.UUID `json:"id"        example:"9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"`
+	Type     string    `json:"type"      example:"telegram_record" enums:"telegram_user,telegram_chat,telegram_record"`
+	TargetID uuid.UUID `json:"target_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Note     string    `json:"note"      example:"Posts the wallet address of the giveaway"`
+	AddedBy  uuid.UUID `json:"added_by"  example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	AddedAt  time.Time `json:"added_at"  example:"2024-01-15T10:30:00Z"`
+}
+
+type GetTelegramInvestigationHandler struct {
+	interactor *application.GetTelegramInvestigation
+	logger     *slog.Logger
+}
+
+func NewGetTelegramInvestigationHandler(
+	interactor *application.GetTelegramInvestigation,
+	logger *slog.Logger,
+) *GetTelegramInvestigationHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_investigation_handler"),
+	)
+
+	return &GetTelegramInvestigationHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to get an investigation with its members and items.
+//
+//	@Summary		Get an investigation
+//	@Description	Get an investigation with its members and the annotated items attached to it.
+//	@Description	Investigations the current user isn't a member of are not found.
+//	@Tags			investigation
+//	@Produce		json
+//	@Param			investigation_id	path		string	true	"Investigation ID"	format(uuid)
+//	@Success		200					{object}	GetTelegramInvestigationResponse	"Investigation retrieved successfully"
+//	@Failure		400					{string}	string	"Invalid investigation ID format"
+//	@Failure		403					{string}	string	"Insufficient privileges"
+//	@Failure		404					{string}	string	"Investigation not found"
+//	@Failure		500					{string}	string	"Internal server error"
+//	@Router			/v1/investigations/{investigation_id} [get]
+func (handler *GetTelegramInvestigationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	investigationID, err := uuid.Parse(r.PathValue("investigation_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid investigation ID format", slog.Any("err", err))
+		http.Error(w, "Invalid investigation ID format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.GetTelegramInvestigationRequest{InvestigationID: investigationID}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrInvestigationNotFound):
+			http.Error(w, "Investigation not found", http.StatusNotFound)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	items := make([]TelegramInvestigationItemResponse, len(resp.Investigation.Items))
+	for i, item := range resp.Investigation.Items {
+		items[i] = TelegramInvestigationItemResponse{
+			ID:       item.ID,
+			Type:     string(item.Type),
+			TargetID: item.TargetID,
+			Note:     item.Note,
+			AddedBy:  item.AddedBy,
+			AddedAt:  item.AddedAt,
+		}
+	}
+	response := GetTelegramInvestigationResponse{
+		Investigation: newTelegramInvestigationResponse(resp.Investigation),
+		Role:          string(resp.Role),
+		Items:         items,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/investigation"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+// GetTelegramInvestigationsResponse represents the response from the GetTelegramInvestigations endpoint.
+type GetTelegramInvestigationsResponse struct {
+	Investigations []TelegramInvestigationResponse `json:"investigations"`
+}
+
+type TelegramInvestigationResponse struct {
+	ID          uuid.UUID                             `json:"id"          example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name        string                                `json:"name"        example:"Crypto giveaway scam"`
+	Description string                                `json:"description" example:"Fake giveaway channels"`
+	CreatedBy   uuid.UUID                             `json:"created_by"  example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	CreatedAt   time.Time                             `json:"created_at"  example:"2024-01-15T10:30:00Z"`
+	Members     []TelegramInvestigationMemberResponse `json:"members"`
+}
+
+type TelegramInvestigationMemberResponse struct {
+	UserID  uuid.UUID `json:"user_id"  example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	Role    string    `json:"role"     example:"editor" enums:"owner,editor,viewer"`
+	AddedBy uuid.UUID `json:"added_by" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	AddedAt time.Time `json:"added_at" example:"2024-01-15T10:30:00Z"`
+}
+
+func newTelegramInvestigationResponse(investigation domain.TelegramInvestigation) TelegramInvestigationResponse {
+	members := make([]TelegramInvestigationMemberResponse, len(investigation.Members))
+	for i, member := range investigation.Members {
+		members[i] = TelegramInvestigationMemberResponse{
+			UserID:  member.UserID,
+			Role:    string(member.Role),
+			AddedBy: member.AddedBy,
+			AddedAt: member.AddedAt,
+		}
+	}
+	return TelegramInvestigationResponse{
+		ID:          investigation.ID,
+		Name:        investigation.Name,
+		Description: investigation.Description,
+		CreatedBy:   investigation.CreatedBy,
+		CreatedAt:   investigation.CreatedAt,
+		Members:     members,
+	}
+}
+
+type GetTelegramInvestigationsHandler struct {
+	interactor *application.GetTelegramInvestigations
+	logger     *slog.Logger
+}
+
+func NewGetTelegramInvestigationsHandler(
+	interactor *application.GetTelegramInvestigations,
+	logger *slog.Logger,
+) *GetTelegramInvestigationsHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_investigations_handler"),
+	)
+
+	return &GetTelegramInvestigationsHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to list the investigations of the current user.
+//
+//	@Summary		Get investigations
+//	@Description	List the investigations the current user is a member of with their members, the newest first
+//	@Tags			investigation
+//	@Produce		json
+//	@Success		200	{object}	GetTelegramInvestigationsResponse	"Investigations retrieved successfully"
+//	@Failure		403	"Insufficient privileges"
+//	@Failure		500	"Internal server error"
+//	@Router			/v1/investigations [get]
+func (handler *GetTelegramInvestigationsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	resp, err := handler.interactor.Execute(r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := GetTelegramInvestigationsResponse{
+		Investigations: make([]TelegramInvestigationResponse, len(resp.Investigations)),
+	}
+	for i, investigation := range resp.Investigations {
+		response.Investigations[i] = newTelegramInvestigationResponse(investigation)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/picture"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+)
+
+// TelegramProfilePictureResponse is one avatar in the history of a user.
+type TelegramProfilePictureResponse struct {
+	ID       string    `json:"id"        example:"550e8400-e29b-41d4-a716-446655440000"`
+	SHA256   string    `json:"sha256"    example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b"`
+	FileSize uint64    `json:"file_size" example:"102400"`
+	MimeType string    `json:"mime_type" example:"image/jpeg"`
+	PostedAt time.Time `json:"posted_at" example:"2024-01-15T10:30:00Z"`
+	AddedAt  time.Time `json:"added_at"  example:"2024-01-16T10:30:00Z"`
+}
+
+// GetTelegramProfilePicturesResponse represents the avatar history of a user, the newest first.
+type GetTelegramProfilePicturesResponse struct {
+	ProfilePictures []TelegramProfilePictureResponse `json:"profile_pictures"`
+}
+
+type GetTelegramProfilePicturesHandler struct {
+	interactor *application.GetTelegramProfilePictures
+	logger     *slog.Logger
+}
+
+func NewGetTelegramProfilePicturesHandler(
+	interactor *application.GetTelegramProfilePictures,
+	logger *slog.Logger,
+) *GetTelegramProfilePicturesHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_profile_pictures_handler"),
+	)
+
+	return &GetTelegramProfilePicturesHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to list the avatar history of a user.
+//
+//	@Summary		List profile pictures
+//	@Description	Get the avatar history of the telegram user, the newest first.
+//	@Tags			record
+//	@Produce		json
+//	@Param			telegram_id	path		int									true	"User Telegram ID"
+//	@Success		200			{object}	GetTelegramProfilePicturesResponse	"Profile pictures retrieved successfully"
+//	@Failure		400			"Invalid telegram ID format"
+//	@Failure		403			"Insufficient privileges"
+//	@Failure		500			"Internal server error"
+//	@Router			/v1/record/telegram/user/{telegram_id}/profile_pictures [get]
+func (handler *GetTelegramProfilePicturesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userTelegramID, err := strconv.ParseUint(r.PathValue("telegram_id"), 10, 64)
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid telegram ID format", slog.Any("err", err))
+		http.Error(w, "Invalid telegram ID format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.GetTelegramProfilePicturesRequest{UserTelegramID: userTelegramID}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	pictures := make([]TelegramProfilePictureResponse, len(resp.ProfilePictures))
+	for i, picture := range resp.ProfilePictures {
+		pictures[i] = TelegramProfilePictureResponse{
+			ID:       picture.ID.String(),
+			SHA256:   picture.SHA256,
+			FileSize: picture.FileSize,
+			MimeType: picture.MimeType,
+			PostedAt: picture.PostedAt,
+			AddedAt:  picture.AddedAt,
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(GetTelegramProfilePicturesResponse{ProfilePictures: pictures})
+}
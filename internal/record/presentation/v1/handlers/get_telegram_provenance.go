@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/provenance"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+// GetTelegramProvenanceResponse holds the collectors who have archived a telegram user or record.
+type GetTelegramProvenanceResponse struct {
+	Observations []TelegramObservationResponse `json:"observations"`
+}
+
+// TelegramObservationResponse tells that the collector has archived the telegram user or record with the target ID.
+type TelegramObservationResponse struct {
+	ID              uuid.UUID `json:"id"                example:"3d8f2b6a-1c4e-4f7a-9b0d-5e6f7a8b9c0d"`
+	TargetType      string    `json:"target_type"       enums:"telegram_user,telegram_record"`
+	TargetID        uuid.UUID `json:"target_id"         example:"550e8400-e29b-41d4-a716-446655440000"`
+	CollectorUserID uuid.UUID `json:"collector_user_id" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	Visibility      string    `json:"visibility"        enums:"private,team,global"`
+	ObservedAt      time.Time `json:"observed_at"       example:"2024-01-15T10:30:00Z"`
+}
+
+func toTelegramObservationResponse(observation domain.TelegramObservation) TelegramObservationResponse {
+	return TelegramObservationResponse{
+		ID:              observation.ID,
+		TargetType:      string(observation.TargetType),
+		TargetID:        observation.TargetID,
+		CollectorUserID: observation.CollectorUserID,
+		Visibility:      string(observation.Visibility),
+		ObservedAt:      observation.ObservedAt,
+	}
+}
+
+// parseProvenanceTarget reads the observed telegram user or record from the path,
+// the type is checked by the interactors.
+func parseProvenanceTarget(r *http.Request) (domain.TelegramObservationTargetType, uuid.UUID, error) {
+	targetID, err := uuid.Parse(r.PathValue("target_id"))
+	return domain.TelegramObservationTargetType(r.PathValue("target_type")), targetID, err
+}
+
+type GetTelegramProvenanceHandler struct {
+	interactor *application.GetTelegramProvenance
+	logger     *slog.Logger
+}
+
+func NewGetTelegramProvenanceHandler(
+	interactor *application.GetTelegramProvenance,
+	logger *slog.Logger,
+) *GetTelegramProvenanceHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_provenance_handler"),
+	)
+
+	return &GetTelegramProvenanceHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to get the collectors who have archived a telegram user or record.
+//
+//	@Summary		Get provenance
+//	@Description	Get the collectors who have archived a telegram user or record, the earliest first.
+//	@Description	Only the observations visible to the caller are returned: the global ones, its own ones
+//	@Description	and the team ones of its teammates. Admins see all of them.
+//	@Tags			provenance
+//	@Produce		json
+//	@Param			target_type	path		string	true	"Target type"	Enums(telegram_user, telegram_record)
+//	@Param			target_id	path		string	true	"Target ID"		format(uuid)
+//	@Success		200			{object}	GetTelegramProvenanceResponse	"Provenance retrieved successfully"
+//	@Failure		400			{string}	string	"Invalid target"
+//	@Failure		403			{string}	string	"Insufficient privileges"
+//	@Failure		404			{string}	string	"Target not found"
+//	@Failure		500			{string}	string	"Internal server error"
+//	@Router			/v1/provenance/{target_type}/{target_id} [get]
+func (handler *GetTelegramProvenanceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	targetType, targetID, err := parseProvenanceTarget(r)
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid target ID format", slog.Any("err", err))
+		http.Error(w, "Invalid target ID format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.GetTelegramProvenanceRequest{TargetType: targetType, TargetID: targetID}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, application.ErrInvalidProvenanceTarget):
+			http.Error(w, "Invalid target type", http.StatusBadRequest)
+			return
+		case errors.Is(err, domain.ErrObservationNotFound):
+			http.Error(w, "Target not found", http.StatusNotFound)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := GetTelegramProvenanceResponse{
+		Observations: make([]TelegramObservationResponse, len(resp.Observations)),
+	}
+	for i, observation := range resp.Observations {
+		response.Observations[i] = toTelegramObservationResponse(observation)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
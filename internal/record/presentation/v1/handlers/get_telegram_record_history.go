@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/record"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+// TelegramRecordVersionResponse is a version of the message text. EditedAt is omitted for the original version.
+type TelegramRecordVersionResponse struct {
+	MessageText     string                  `json:"message_text"        example:"Hello world!"`
+	Entities        []TelegramMessageEntity `json:"entities,omitempty"`
+	Caption         string                  `json:"caption,omitempty"   example:"Look at this"`
+	CaptionEntities []TelegramMessageEntity `json:"caption_entities,omitempty"`
+	EditedAt        *time.Time              `json:"edited_at,omitempty" example:"2024-01-15T10:35:00Z"`
+	Current         bool                    `json:"current"             example:"true"`
+}
+
+// GetTelegramRecordHistoryResponse represents the response from the GetTelegramRecordHistory endpoint.
+type GetTelegramRecordHistoryResponse struct {
+	RecordID          string                          `json:"record_id"            example:"550e8400-e29b-41d4-a716-446655440000"`
+	MessageTelegramID uint64                          `json:"message_telegram_id"  example:"28736582143"`
+	PostedAt          time.Time                       `json:"posted_at"            example:"2024-01-15T10:30:00Z"`
+	DeletedAt         *time.Time                      `json:"deleted_at,omitempty" example:"2024-01-16T08:00:00Z"`
+	Versions          []TelegramRecordVersionResponse `json:"versions"`
+}
+
+type GetTelegramRecordHistoryHandler struct {
+	interactor *application.GetTelegramRecordHistory
+	logger     *slog.Logger
+}
+
+func NewGetTelegramRecordHistoryHandler(
+	interactor *application.GetTelegramRecordHistory,
+	logger *slog.Logger,
+) *GetTelegramRecordHistoryHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_record_history_handler"),
+	)
+
+	return &GetTelegramRecordHistoryHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to get the edit history of a record.
+//
+//	@Summary		Get record edit history
+//	@Description	Get all known versions of the message, the oldest first and the current one last.
+//	@Tags			record
+//	@Produce		json
+//	@Param			record_id	path		string								true	"Record ID"
+//	@Success		200			{object}	GetTelegramRecordHistoryResponse	"History retrieved successfully"
+//	@Failure		400			"Invalid record ID format"
+//	@Failure		403			"Insufficient privileges"
+//	@Failure		404			"Record not found"
+//	@Failure		500			"Internal server error"
+//	@Router			/v1/record/telegram/record/{record_id}/history [get]
+func (handler *GetTelegramRecordHistoryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	recordID, err := uuid.Parse(r.PathValue("record_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid record ID format", slog.Any("err", err))
+		http.Error(w, "Invalid record ID format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.GetTelegramRecordHistoryRequest{RecordID: recordID}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrRecordNotFound):
+			handler.logger.DebugContext(r.Context(), "telegram record not found by ID", slog.Any("err", err))
+			http.Error(w, "Record not found", http.StatusNotFound)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	versions := make([]TelegramRecordVersionResponse, 0, len(resp.Revisions)+1)
+	for _, revision := range resp.Revisions {
+		versions = append(versions, TelegramRecordVersionResponse{
+			MessageText:     revision.MessageText,
+			Entities:        entitiesFromDomain(revision.Entities),
+			Caption:         revision.Caption,
+			CaptionEntities: entitiesFromDomain(revision.CaptionEntities),
+			EditedAt:        revision.EditedAt,
+		})
+	}
+	versions = append(versions, TelegramRecordVersionResponse{
+		MessageText:     resp.Record.MessageText,
+		Entities:        entitiesFromDomain(resp.Record.Entities),
+		Caption:         resp.Record.Caption,
+		CaptionEntities: entitiesFromDomain(resp.Record.CaptionEntities),
+		EditedAt:        resp.Record.EditedAt,
+		Current:         true,
+	})
+	response := GetTelegramRecordHistoryResponse{
+		RecordID:          resp.Record.ID.String(),
+		MessageTelegramID: resp.Record.MessageTelegramID,
+		PostedAt:          resp.Record.PostedAt,
+		DeletedAt:         resp.Record.DeletedAt,
+		Versions:          versions,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
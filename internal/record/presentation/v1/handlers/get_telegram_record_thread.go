@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/record"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+// TelegramRecordResponse is a record along with its reply, thread and forward linkage.
+type TelegramRecordResponse struct {
+	RecordID                 string                  `json:"record_id"                              example:"550e8400-e29b-41d4-a716-446655440000"`
+	MessageTelegramID        uint64                  `json:"message_telegram_id"                    example:"28736582143"`
+	FromUserID               string                  `json:"from_user_id"                           example:"cf6e273b-ac6e-43f1-abba-d8009ffc1b3f"`
+	InTelegramChatID         int64                   `json:"in_telegram_chat_id"                    example:"123456789"`
+	MessageText              string                  `json:"message_text"                           example:"Hello world!"`
+	Entities                 []TelegramMessageEntity `json:"entities,omitempty"`
+	Caption                  string                  `json:"caption,omitempty"                      example:"Look at this"`
+	CaptionEntities          []TelegramMessageEntity `json:"caption_entities,omitempty"`
+	PostedAt                 time.Time               `json:"posted_at"                              example:"2024-01-15T10:30:00Z"`
+	EditedAt                 *time.Time              `json:"edited_at,omitempty"                    example:"2024-01-15T10:35:00Z"`
+	DeletedAt                *time.Time              `json:"deleted_at,omitempty"                   example:"2024-01-16T08:00:00Z"`
+	ReplyToMessageTelegramID *uint64                 `json:"reply_to_message_telegram_id,omitempty" example:"28736582140"`
+	ThreadTelegramID         *uint64                 `json:"thread_telegram_id,omitempty"           example:"28736582100"`
+	ForwardOrigin            *TelegramForwardOrigin  `json:"forward_origin,omitempty"`
+}
+
+func toTelegramRecordResponses(records []domain.TelegramRecord) []TelegramRecordResponse {
+	responses := make([]TelegramRecordResponse, len(records))
+	for i := range records {
+		responses[i] = toTelegramRecordResponse(&records[i])
+	}
+	return responses
+}
+
+func toTelegramRecordResponse(record *domain.TelegramRecord) TelegramRecordResponse {
+	response := TelegramRecordResponse{
+		RecordID:                 record.ID.String(),
+		MessageTelegramID:        record.MessageTelegramID,
+		FromUserID:               record.FromTelegramUserID.String(),
+		InTelegramChatID:         record.InTelegramChatID,
+		MessageText:              record.MessageText,
+		Entities:                 entitiesFromDomain(record.Entities),
+		Caption:                  record.Caption,
+		CaptionEntities:          entitiesFromDomain(record.CaptionEntities),
+		PostedAt:                 record.PostedAt,
+		EditedAt:                 record.EditedAt,
+		DeletedAt:                record.DeletedAt,
+		ReplyToMessageTelegramID: record.ReplyToMessageTelegramID,
+		ThreadTelegramID:         record.ThreadTelegramID,
+	}
+	if origin := record.ForwardOrigin; origin != nil {
+		response.ForwardOrigin = &TelegramForwardOrigin{
+			FromUserTelegramID: origin.FromUserTelegramID,
+			FromChatTelegramID: origin.FromChatTelegramID,
+			MessageTelegramID:  origin.MessageTelegramID,
+			PostedAt:           origin.PostedAt,
+		}
+	}
+	return response
+}
+
+// GetTelegramRecordThreadResponse represents the response from the GetTelegramRecordThread endpoint.
+type GetTelegramRecordThreadResponse struct {
+	RecordID string                   `json:"record_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Records  []TelegramRecordResponse `json:"records"`
+}
+
+type GetTelegramRecordThreadHandler struct {
+	interactor *application.GetTelegramRecordThread
+	logger     *slog.Logger
+}
+
+func NewGetTelegramRecordThreadHandler(
+	interactor *application.GetTelegramRecordThread,
+	logger *slog.Logger,
+) *GetTelegramRecordThreadHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_record_thread_handler"),
+	)
+
+	return &GetTelegramRecordThreadHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to get the reply thread around a record.
+//
+//	@Summary		Get record reply thread
+//	@Description	Get the reply thread the record belongs to: the message the chain of replies starts with
+//	@Description	and all the replies to it, in the order they have been posted. Up to 1000 records are returned.
+//	@Tags			record
+//	@Produce		json
+//	@Param			record_id	path		string							true	"Record ID"
+//	@Success		200			{object}	GetTelegramRecordThreadResponse	"Thread retrieved successfully"
+//	@Failure		400			"Invalid record ID format"
+//	@Failure		403			"Insufficient privileges"
+//	@Failure		404			"Record not found"
+//	@Failure		500			"Internal server error"
+//	@Router			/v1/record/telegram/record/{record_id}/thread [get]
+func (handler *GetTelegramRecordThreadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	recordID, err := uuid.Parse(r.PathValue("record_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid record ID format", slog.Any("err", err))
+		http.Error(w, "Invalid record ID format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.GetTelegramRecordThreadRequest{RecordID: recordID}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrRecordNotFound):
+			handler.logger.DebugContext(r.Context(), "telegram record not found by ID", slog.Any("err", err))
+			http.Error(w, "Record not found", http.StatusNotFound)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := GetTelegramRecordThreadResponse{
+		RecordID: recordID.String(),
+		Records:  toTelegramRecordResponses(resp.Records),
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
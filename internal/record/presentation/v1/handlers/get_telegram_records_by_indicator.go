@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/indicator"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+)
+
+// GetTelegramRecordsByIndicatorResponse represents the response from the GetTelegramRecordsByIndicator endpoint.
+type GetTelegramRecordsByIndicatorResponse struct {
+	Records []TelegramRecordResponse `json:"records"`
+}
+
+type GetTelegramRecordsByIndicatorHandler struct {
+	interactor       *application.GetTelegramRecordsByIndicator
+	streamInteractor *application.StreamTelegramRecordsByIndicator
+	logger           *slog.Logger
+}
+
+func NewGetTelegramRecordsByIndicatorHandler(
+	interactor *application.GetTelegramRecordsByIndicator,
+	streamInteractor *application.StreamTelegramRecordsByIndicator,
+	logger *slog.Logger,
+) *GetTelegramRecordsByIndicatorHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_records_by_indicator_handler"),
+	)
+
+	return &GetTelegramRecordsByIndicatorHandler{
+		interactor:       interactor,
+		streamInteractor: streamInteractor,
+		logger:           handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to find the records mentioning an indicator.
+//
+//	@Summary		Find records by indicator
+//	@Description	List the latest 100 records mentioning an indicator. The value may be written in any form,
+//	@Description	e.g. "+1 (555) 123-4567" or "@Username", it's normalised before the lookup.
+//	@Description	Every matching record is streamed as CSV or NDJSON when format is csv or ndjson,
+//	@Description	or when text/csv or application/x-ndjson is accepted.
+//	@Tags			record
+//	@Produce		json
+//	@Produce		text/csv
+//	@Produce		application/x-ndjson
+//	@Param			type	query		string									true	"Indicator type"	Enums(phone, email, url, mention, hashtag, iban, crypto_wallet)
+//	@Param			value	query		string									true	"Indicator value"
+//	@Param			format	query		string									false	"Response format"	Enums(json, csv, ndjson)
+//	@Param			tag		query		string									false	"Only list records holding the tag"
+//	@Success		200		{object}	GetTelegramRecordsByIndicatorResponse	"Records retrieved successfully"
+//	@Failure		400		"Invalid indicator, format or tag"
+//	@Failure		403		"Insufficient privileges"
+//	@Failure		500		"Internal server error"
+//	@Router			/v1/record/telegram/indicators/records [get]
+func (handler *GetTelegramRecordsByIndicatorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	format, ok := negotiateRowFormat(r)
+	if !ok {
+		http.Error(w, "Unsupported format, use json, csv or ndjson", http.StatusBadRequest)
+		return
+	}
+	if format != rowFormatJSON {
+		handler.stream(w, r, format)
+		return
+	}
+
+	requestDTO := application.GetTelegramRecordsByIndicatorRequest{
+		Type:  domain.TelegramIndicatorType(r.URL.Query().Get("type")),
+		Value: r.URL.Query().Get("value"),
+		Tag:   r.URL.Query().Get("tag"),
+	}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, application.ErrInvalidIndicator):
+			handler.logger.DebugContext(r.Context(), "Invalid indicator", slog.Any("err", err))
+			http.Error(w, "Invalid indicator", http.StatusBadRequest)
+			return
+		case errors.Is(err, domain.ErrInvalidTag):
+			http.Error(w, "Invalid tag", http.StatusBadRequest)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := GetTelegramRecordsByIndicatorResponse{Records: toTelegramRecordResponses(resp.Records)}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// stream writes every record mentioning the indicator in the format as it's read.
+func (handler *GetTelegramRecordsByIndicatorHandler) stream(w http.ResponseWriter, r *http.Request, format string) {
+	indicatorType := domain.TelegramIndicatorType(r.URL.Query().Get("type"))
+	stream := newRowStream(w, format, "telegram_"+string(indicatorType)+"_records", telegramRecordCSVHeader, "records")
+	_, err := handler.streamInteractor.Execute(r.Context(), application.StreamTelegramRecordsByIndicatorRequest{
+		Type:  indicatorType,
+		Value: r.URL.Query().Get("value"),
+		Tag:   r.URL.Query().Get("tag"),
+		Yield: func(record *domain.TelegramRecord) error {
+			return stream.write(toTelegramRecordResponse(record))
+		},
+	})
+	if err != nil {
+		if stream.started() {
+			handler.logger.WarnContext(r.Context(), "telegram records stream was interrupted", slog.Any("err", err))
+			abortRowStream()
+		}
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, application.ErrInvalidIndicator):
+			handler.logger.DebugContext(r.Context(), "Invalid indicator", slog.Any("err", err))
+			http.Error(w, "Invalid indicator", http.StatusBadRequest)
+			return
+		case errors.Is(err, domain.ErrInvalidTag):
+			http.Error(w, "Invalid tag", http.StatusBadRequest)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+	if err = stream.finish(); err != nil {
+		handler.logger.DebugContext(r.Context(), "failed to finish telegram records stream", slog.Any("err", err))
+	}
+}
@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/record"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+)
+
+// GetTelegramRecordsForwardedFromResponse represents the response from the GetTelegramRecordsForwardedFrom endpoint.
+type GetTelegramRecordsForwardedFromResponse struct {
+	Records []TelegramRecordResponse `json:"records"`
+}
+
+// GetTelegramRecordsForwardedFromUserHandler lists the records forwarded from a Telegram user.
+type GetTelegramRecordsForwardedFromUserHandler struct {
+	forwardedRecordsHandler
+}
+
+func NewGetTelegramRecordsForwardedFromUserHandler(
+	interactor *application.GetTelegramRecordsForwardedFrom,
+	logger *slog.Logger,
+) *GetTelegramRecordsForwardedFromUserHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_records_forwarded_from_user_handler"),
+	)
+
+	return &GetTelegramRecordsForwardedFromUserHandler{
+		forwardedRecordsHandler{interactor: interactor, logger: handlerLogger},
+	}
+}
+
+// ServeHTTP handles an HTTP request to list the records forwarded from a user.
+//
+//	@Summary		List records forwarded from a user
+//	@Description	List the latest 100 records forwarded from a Telegram user.
+//	@Tags			record
+//	@Produce		json
+//	@Param			telegram_id	path		int										true	"User Telegram ID"
+//	@Success		200			{object}	GetTelegramRecordsForwardedFromResponse	"Records retrieved successfully"
+//	@Failure		400			"Invalid user ID format"
+//	@Failure		403			"Insufficient privileges"
+//	@Failure		500			"Internal server error"
+//	@Router			/v1/record/telegram/user/{telegram_id}/forwards [get]
+func (handler *GetTelegramRecordsForwardedFromUserHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userTelegramID, err := strconv.ParseUint(r.PathValue("telegram_id"), 10, 64)
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid user ID format", slog.Any("err", err))
+		http.Error(w, "Invalid user ID format", http.StatusBadRequest)
+		return
+	}
+	handler.serve(w, r, domain.TelegramForwardOrigin{FromUserTelegramID: &userTelegramID})
+}
+
+// GetTelegramRecordsForwardedFromChatHandler lists the records forwarded from a chat or from a message of it.
+type GetTelegramRecordsForwardedFromChatHandler struct {
+	forwardedRecordsHandler
+}
+
+func NewGetTelegramRecordsForwardedFromChatHandler(
+	interactor *application.GetTelegramRecordsForwardedFrom,
+	logger *slog.Logger,
+) *GetTelegramRecordsForwardedFromChatHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_records_forwarded_from_chat_handler"),
+	)
+
+	return &GetTelegramRecordsForwardedFromChatHandler{
+		forwardedRecordsHandler{interactor: interactor, logger: handlerLogger},
+	}
+}
+
+// ServeHTTP handles an HTTP request to list the records forwarded from a chat.
+//
+//	@Summary		List records forwarded from a chat
+//	@Description	List the latest 100 records forwarded from a group or a channel,
+//	@Description	optionally narrowed down to a single message of it.
+//	@Tags			record
+//	@Produce		json
+//	@Param			chat_telegram_id	path		int										true	"Chat Telegram ID"
+//	@Param			message_telegram_id	query		int										false	"Original message Telegram ID"
+//	@Success		200					{object}	GetTelegramRecordsForwardedFromResponse	"Records retrieved successfully"
+//	@Failure		400					"Invalid chat or message ID format"
+//	@Failure		403					"Insufficient privileges"
+//	@Failure		500					"Internal server error"
+//	@Router			/v1/record/telegram/chat/{chat_telegram_id}/forwards [get]
+func (handler *GetTelegramRecordsForwardedFromChatHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	chatTelegramID, err := strconv.ParseInt(r.PathValue("chat_telegram_id"), 10, 64)
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid chat ID format", slog.Any("err", err))
+		http.Error(w, "Invalid chat ID format", http.StatusBadRequest)
+		return
+	}
+	origin := domain.TelegramForwardOrigin{FromChatTelegramID: &chatTelegramID}
+	if rawMessageID := r.URL.Query().Get("message_telegram_id"); rawMessageID != "" {
+		messageTelegramID, parseErr := strconv.ParseUint(rawMessageID, 10, 64)
+		if parseErr != nil {
+			handler.logger.DebugContext(r.Context(), "invalid message ID format", slog.Any("err", parseErr))
+			http.Error(w, "Invalid message ID format", http.StatusBadRequest)
+			return
+		}
+		origin.MessageTelegramID = &messageTelegramID
+	}
+	handler.serve(w, r, origin)
+}
+
+type forwardedRecordsHandler struct {
+	interactor *application.GetTelegramRecordsForwardedFrom
+	logger     *slog.Logger
+}
+
+func (handler *forwardedRecordsHandler) serve(
+	w http.ResponseWriter,
+	r *http.Request,
+	origin domain.TelegramForwardOrigin,
+) {
+	requestDTO := application.GetTelegramRecordsForwardedFromRequest{Origin: origin}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, application.ErrForwardOriginRequired):
+			handler.logger.DebugContext(r.Context(), "Forward origin is missing", slog.Any("err", err))
+			http.Error(w, "Either a user or a chat is required", http.StatusBadRequest)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := GetTelegramRecordsForwardedFromResponse{Records: toTelegramRecordResponses(resp.Records)}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/annotation"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+)
+
+// GetTelegramTagCatalogueResponse represents the response from the GetTelegramTagCatalogue endpoint.
+type GetTelegramTagCatalogueResponse struct {
+	Tags []TelegramTagCountResponse `json:"tags"`
+}
+
+// TelegramTagCountResponse is a tag in use along with the amount of targets holding it.
+type TelegramTagCountResponse struct {
+	Name  string `json:"name"  example:"scammer"`
+	Count int64  `json:"count" example:"42"`
+}
+
+type GetTelegramTagCatalogueHandler struct {
+	interactor *application.GetTelegramTagCatalogue
+	logger     *slog.Logger
+}
+
+func NewGetTelegramTagCatalogueHandler(
+	interactor *application.GetTelegramTagCatalogue,
+	logger *slog.Logger,
+) *GetTelegramTagCatalogueHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_tag_catalogue_handler"),
+	)
+
+	return &GetTelegramTagCatalogueHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to suggest the tags in use.
+//
+//	@Summary		Get the tag catalogue
+//	@Description	Suggest the tags in use starting with the prefix, the most used first, to autocomplete them.
+//	@Tags			annotation
+//	@Produce		json
+//	@Param			prefix	query		string							false	"Start of the tags"
+//	@Param			limit	query		int								false	"Amount of tags"	minimum(1)	maximum(100)	default(20)
+//	@Success		200		{object}	GetTelegramTagCatalogueResponse	"Tags retrieved successfully"
+//	@Failure		400		"Invalid prefix or limit"
+//	@Failure		403		"Insufficient privileges"
+//	@Failure		500		"Internal server error"
+//	@Router			/v1/annotations/tags [get]
+func (handler *GetTelegramTagCatalogueHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	requestDTO := application.GetTelegramTagCatalogueRequest{Prefix: query.Get("prefix")}
+	var err error
+	if rawLimit := query.Get("limit"); rawLimit != "" {
+		if requestDTO.Limit, err = strconv.Atoi(rawLimit); err != nil {
+			http.Error(w, "Invalid limit format", http.StatusBadRequest)
+			return
+		}
+	}
+
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrInvalidTag):
+			http.Error(w, "Prefix must be made of letters, digits, dashes and underscores", http.StatusBadRequest)
+			return
+		case errors.Is(err, application.ErrInvalidCatalogueLimit):
+			http.Error(w, "Limit must be between 1 and 100", http.StatusBadRequest)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := GetTelegramTagCatalogueResponse{Tags: make([]TelegramTagCountResponse, len(resp.Tags))}
+	for i, tag := range resp.Tags {
+		response.Tags[i] = TelegramTagCountResponse{Name: tag.Name, Count: tag.Count}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/annotation"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+)
+
+// GetTelegramTaggedTargetsResponse lists the tags with a name, each refers to a target holding it.
+type GetTelegramTaggedTargetsResponse struct {
+	Tags []TelegramTagResponse `json:"tags"`
+}
+
+type GetTelegramTaggedTargetsHandler struct {
+	interactor *application.GetTelegramTaggedTargets
+	logger     *slog.Logger
+}
+
+func NewGetTelegramTaggedTargetsHandler(
+	interactor *application.GetTelegramTaggedTargets,
+	logger *slog.Logger,
+) *GetTelegramTaggedTargetsHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_tagged_targets_handler"),
+	)
+
+	return &GetTelegramTaggedTargetsHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to list the telegram users, identities and records holding a tag.
+//
+//	@Summary		Get tagged targets
+//	@Description	List the telegram users, identities and records holding the tag, the latest tagged first
+//	@Tags			annotation
+//	@Produce		json
+//	@Param			tag			path		string								true	"Tag"
+//	@Param			target_type	query		string								false	"Only list targets of the type"	Enums(telegram_user, telegram_identity, telegram_record)
+//	@Param			limit		query		int									false	"Amount of targets"	minimum(1)	maximum(200)	default(50)
+//	@Param			offset		query		int									false	"Amount of targets to skip"	minimum(0)	default(0)
+//	@Success		200			{object}	GetTelegramTaggedTargetsResponse	"Tagged targets retrieved successfully"
+//	@Failure		400			"Invalid tag, target type or pagination"
+//	@Failure		403			"Insufficient privileges"
+//	@Failure		500			"Internal server error"
+//	@Router			/v1/annotations/tags/{tag} [get]
+func (handler *GetTelegramTaggedTargetsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	requestDTO := application.GetTelegramTaggedTargetsRequest{
+		Name:       r.PathValue("tag"),
+		TargetType: domain.TelegramAnnotationTargetType(query.Get("target_type")),
+	}
+	var err error
+	if rawLimit := query.Get("limit"); rawLimit != "" {
+		if requestDTO.Limit, err = strconv.Atoi(rawLimit); err != nil {
+			http.Error(w, "Invalid limit format", http.StatusBadRequest)
+			return
+		}
+	}
+	if rawOffset := query.Get("offset"); rawOffset != "" {
+		if requestDTO.Offset, err = strconv.Atoi(rawOffset); err != nil {
+			http.Error(w, "Invalid offset format", http.StatusBadRequest)
+			return
+		}
+	}
+
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrInvalidTag):
+			http.Error(w, "Tag must be made of at most 64 letters, digits, dashes and underscores", http.StatusBadRequest)
+			return
+		case errors.Is(err, application.ErrInvalidAnnotationTarget):
+			http.Error(w, "Invalid target type", http.StatusBadRequest)
+			return
+		case errors.Is(err, application.ErrInvalidPagination):
+			http.Error(w, "Limit must be between 1 and 200 and offset must not be negative", http.StatusBadRequest)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := GetTelegramTaggedTargetsResponse{Tags: make([]TelegramTagResponse, len(resp.Tags))}
+	for i, tag := range resp.Tags {
+		response.Tags[i] = toTelegramTagResponse(tag)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/indicator"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+)
+
+// TelegramIndicatorResponse is an indicator aggregated over the records of a user mentioning it.
+type TelegramIndicatorResponse struct {
+	Type        string    `json:"type"          example:"phone"`
+	Value       string    `json:"value"         example:"+15551234567"`
+	RecordCount int       `json:"record_count"  example:"3"`
+	FirstSeenAt time.Time `json:"first_seen_at" example:"2024-01-15T10:30:00Z"`
+	LastSeenAt  time.Time `json:"last_seen_at"  example:"2024-02-01T08:00:00Z"`
+}
+
+// GetTelegramUserIndicatorsResponse represents the response from the GetTelegramUserIndicators endpoint.
+type GetTelegramUserIndicatorsResponse struct {
+	Indicators []TelegramIndicatorResponse `json:"indicators"`
+}
+
+type GetTelegramUserIndicatorsHandler struct {
+	interactor *application.GetTelegramUserIndicators
+	logger     *slog.Logger
+}
+
+func NewGetTelegramUserIndicatorsHandler(
+	interactor *application.GetTelegramUserIndicators,
+	logger *slog.Logger,
+) *GetTelegramUserIndicatorsHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_user_indicators_handler"),
+	)
+
+	return &GetTelegramUserIndicatorsHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to list the indicators of a user.
+//
+//	@Summary		List indicators of a user
+//	@Description	List the phone numbers, emails, URLs, mentions, hashtags, IBANs and crypto wallets
+//	@Description	mentioned in the records sent by a Telegram user, the most mentioned first.
+//	@Tags			record
+//	@Produce		json
+//	@Param			telegram_id	path		int									true	"User Telegram ID"
+//	@Success		200			{object}	GetTelegramUserIndicatorsResponse	"Indicators retrieved successfully"
+//	@Failure		400			"Invalid user ID format"
+//	@Failure		403			"Insufficient privileges"
+//	@Failure		500			"Internal server error"
+//	@Router			/v1/record/telegram/user/{telegram_id}/indicators [get]
+func (handler *GetTelegramUserIndicatorsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userTelegramID, err := strconv.ParseUint(r.PathValue("telegram_id"), 10, 64)
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid user ID format", slog.Any("err", err))
+		http.Error(w, "Invalid user ID format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.GetTelegramUserIndicatorsRequest{UserTelegramID: userTelegramID}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := GetTelegramUserIndicatorsResponse{Indicators: toTelegramIndicatorResponses(resp.Indicators)}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func toTelegramIndicatorResponses(indicators []domain.TelegramIndicatorSummary) []TelegramIndicatorResponse {
+	responses := make([]TelegramIndicatorResponse, len(indicators))
+	for i, indicator := range indicators {
+		responses[i] = TelegramIndicatorResponse{
+			Type:        string(indicator.Type),
+			Value:       indicator.Value,
+			RecordCount: indicator.RecordCount,
+			FirstSeenAt: indicator.FirstSeenAt,
+			LastSeenAt:  indicator.LastSeenAt,
+		}
+	}
+	return responses
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/watchlist"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+// GetTelegramWatchlistsResponse represents the response from the GetTelegramWatchlists endpoint.
+type GetTelegramWatchlistsResponse struct {
+	Watchlists []TelegramWatchlistResponse `json:"watchlists"`
+}
+
+type TelegramWatchlistResponse struct {
+	ID        uuid.UUID                        `json:"id"         example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name      string                           `json:"name"       example:"Fraud suspects"`
+	CreatedAt time.Time                        `json:"created_at" example:"2024-01-15T10:30:00Z"`
+	Entries   []TelegramWatchlistEntryResponse `json:"entries"`
+}
+
+type TelegramWatchlistEntryResponse struct {
+	ID      uuid.UUID `json:"id"       example:"9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"`
+	Type    string    `json:"type"     example:"username" enums:"telegram_id,username,phone,keyword"`
+	Value   string    `json:"value"    example:"john_doe"`
+	AddedAt time.Time `json:"added_at" example:"2024-01-15T10:30:00Z"`
+}
+
+type GetTelegramWatchlistsHandler struct {
+	interactor *application.GetTelegramWatchlists
+	logger     *slog.Logger
+}
+
+func NewGetTelegramWatchlistsHandler(
+	interactor *application.GetTelegramWatchlists,
+	logger *slog.Logger,
+) *GetTelegramWatchlistsHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_telegram_watchlists_handler"),
+	)
+
+	return &GetTelegramWatchlistsHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to list the watchlists of the current user.
+//
+//	@Summary		Get watchlists
+//	@Description	List the watchlists of the current user with their entries
+//	@Tags			watchlist
+//	@Produce		json
+//	@Success		200	{object}	GetTelegramWatchlistsResponse	"Watchlists retrieved successfully"
+//	@Failure		403	"Insufficient privileges"
+//	@Failure		500	"Internal server error"
+//	@Router			/v1/watchlists [get]
+func (handler *GetTelegramWatchlistsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	resp, err := handler.interactor.Execute(r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := GetTelegramWatchlistsResponse{Watchlists: make([]TelegramWatchlistResponse, len(resp.Watchlists))}
+	for i, watchlist := range resp.Watchlists {
+		entries := make([]TelegramWatchlistEntryResponse, len(watchlist.Entries))
+		for j, entry := range watchlist.Entries {
+			entries[j] = TelegramWatchlistEntryResponse{
+				ID:      entry.ID,
+				Type:    string(entry.Type),
+				Value:   entry.Value,
+				AddedAt: entry.AddedAt,
+			}
+		}
+		response.Watchlists[i] = TelegramWatchlistResponse{
+			ID:        watchlist.ID,
+			Name:      watchlist.Name,
+			CreatedAt: watchlist.CreatedAt,
+			Entries:   entries,
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,227 @@
+package handlers
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"log/slog"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/InWamos/trinity-proto/config"
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/importer"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+)
+
+const exportFileName = "result.json"
+
+var errExportNotFound = errors.New("result.json not found in the archive")
+
+// ImportTelegramExportProgress is a snapshot of the import, streamed after every chunk of messages.
+type ImportTelegramExportProgress struct {
+	MessagesProcessed   int `json:"messages_processed"   example:"1200"`
+	RecordsImported     int `json:"records_imported"     example:"1100"`
+	DuplicatesSkipped   int `json:"duplicates_skipped"   example:"80"`
+	MessagesSkipped     int `json:"messages_skipped"     example:"20"`
+	UsersCreated        int `json:"users_created"        example:"35"`
+	AttachmentsImported int `json:"attachments_imported" example:"140"`
+	AttachmentsMissing  int `json:"attachments_missing"  example:"3"`
+}
+
+// ImportTelegramExportResponse represents the summary of a finished import.
+type ImportTelegramExportResponse struct {
+	ChatID         string                       `json:"chat_id"          example:"550e8400-e29b-41d4-a716-446655440000"`
+	ChatTelegramID int64                        `json:"chat_telegram_id" example:"-1001234567890"`
+	Progress       ImportTelegramExportProgress `json:"progress"`
+}
+
+type ImportTelegramExportHandler struct {
+	interactor    *application.ImportTelegramExport
+	maxImportSize int64
+	logger        *slog.Logger
+}
+
+func NewImportTelegramExportHandler(
+	interactor *application.ImportTelegramExport,
+	storageConfig *config.StorageConfig,
+	logger *slog.Logger,
+) *ImportTelegramExportHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "import_telegram_export_handler"),
+	)
+
+	return &ImportTelegramExportHandler{
+		interactor:    interactor,
+		maxImportSize: storageConfig.MaxImportSize,
+		logger:        handlerLogger,
+	}
+}
+
+// ServeHTTP imports a Telegram Desktop chat export.
+//
+//	@Summary		Import a Telegram Desktop chat export
+//	@Description	Accepts either a bare result.json or a ZIP of the whole export folder, in which case
+//	@Description	the media are imported as attachments. With "Accept: application/x-ndjson" the progress
+//	@Description	is streamed line by line and the last line is the summary.
+//	@Tags			record
+//	@Accept			json,application/zip
+//	@Produce		json,application/x-ndjson
+//	@Success		200	{object}	ImportTelegramExportResponse
+//	@Failure		400	{string}	string	"Invalid export"
+//	@Failure		403	{string}	string	"Insufficient privileges"
+//	@Failure		413	{string}	string	"Export is too large"
+//	@Failure		500	{string}	string	"Internal server error"
+//	@Router			/v1/record/telegram/chat/import [post]
+func (handler *ImportTelegramExportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, handler.maxImportSize)
+	requestDTO := application.ImportTelegramExportRequest{Export: r.Body}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "application/zip" {
+		archive, cleanup, err := handler.openArchive(r.Body)
+		if err != nil {
+			handler.logger.DebugContext(r.Context(), "invalid export archive", slog.Any("err", err))
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				http.Error(w, "Export is too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, "Invalid export", http.StatusBadRequest)
+			return
+		}
+		defer cleanup()
+		if requestDTO.Export, requestDTO.Files, err = openExport(archive); err != nil {
+			handler.logger.DebugContext(r.Context(), "invalid export archive", slog.Any("err", err))
+			http.Error(w, "Invalid export", http.StatusBadRequest)
+			return
+		}
+	}
+
+	streamProgress := strings.Contains(r.Header.Get("Accept"), "application/x-ndjson")
+	encoder := json.NewEncoder(w)
+	if streamProgress {
+		flusher, _ := w.(http.Flusher)
+		requestDTO.OnProgress = func(progress application.ImportTelegramExportProgress) {
+			if !handler.headerWritten(w) {
+				w.Header().Set("Content-Type", "application/x-ndjson")
+				w.WriteHeader(http.StatusOK)
+			}
+			_ = encoder.Encode(toImportProgress(progress))
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+	}
+
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		if handler.headerWritten(w) {
+			// The status is already sent, the error is the last line of the stream
+			handler.logger.WarnContext(r.Context(), "import has failed midway", slog.Any("err", err))
+			_ = encoder.Encode(map[string]string{"error": "import has failed, it can be safely repeated"})
+			return
+		}
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, application.ErrInvalidExport):
+			handler.logger.DebugContext(r.Context(), "Invalid export", slog.Any("err", err))
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				http.Error(w, "Export is too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, "Invalid export", http.StatusBadRequest)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Import error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := ImportTelegramExportResponse{
+		ChatID:         resp.ChatID.String(),
+		ChatTelegramID: resp.ChatTelegramID,
+		Progress:       toImportProgress(resp.Progress),
+	}
+	if !handler.headerWritten(w) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+	}
+	_ = encoder.Encode(response)
+}
+
+// headerWritten tells whether the progress stream has already started.
+func (handler *ImportTelegramExportHandler) headerWritten(w http.ResponseWriter) bool {
+	return w.Header().Get("Content-Type") == "application/x-ndjson"
+}
+
+// openArchive spools the ZIP to a temporary file, as the archive index is at its end.
+func (handler *ImportTelegramExportHandler) openArchive(body io.Reader) (*zip.Reader, func(), error) {
+	file, err := os.CreateTemp("", "trinity-import-*.zip")
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() {
+		file.Close()
+		os.Remove(file.Name())
+	}
+	size, err := io.Copy(file, body)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	archive, err := zip.NewReader(file, size)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return archive, cleanup, nil
+}
+
+// openExport finds result.json, either at the root of the archive or in the export folder inside of it.
+// Media paths are relative to the folder of result.json.
+func openExport(archive *zip.Reader) (io.Reader, fs.FS, error) {
+	exportPath := ""
+	for _, file := range archive.File {
+		if path.Base(file.Name) != exportFileName {
+			continue
+		}
+		if exportPath == "" || len(file.Name) < len(exportPath) {
+			exportPath = file.Name
+		}
+	}
+	if exportPath == "" {
+		return nil, nil, errExportNotFound
+	}
+	files, err := fs.Sub(archive, path.Dir(exportPath))
+	if err != nil {
+		return nil, nil, err
+	}
+	export, err := files.Open(exportFileName)
+	if err != nil {
+		return nil, nil, err
+	}
+	return export, files, nil
+}
+
+func toImportProgress(progress application.ImportTelegramExportProgress) ImportTelegramExportProgress {
+	return ImportTelegramExportProgress{
+		MessagesProcessed:   progress.MessagesProcessed,
+		RecordsImported:     progress.RecordsImported,
+		DuplicatesSkipped:   progress.DuplicatesSkipped,
+		MessagesSkipped:     progress.MessagesSkipped,
+		UsersCreated:        progress.UsersCreated,
+		AttachmentsImported: progress.AttachmentsImported,
+		AttachmentsMissing:  progress.AttachmentsMissing,
+	}
+}
@@ -0,0 +1,318 @@
+package handlers
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/InWamos/trinity-proto/config"
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/ingest"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+)
+
+// Types of the stream lines.
+const (
+	ingestTypeUser     = "user"
+	ingestTypeIdentity = "identity"
+	ingestTypeRecord   = "record"
+)
+
+// Error codes reported for the lines of a stream that haven't been added, on top of the batch ones.
+const (
+	ingestErrorInvalidLine    = "invalid_line"
+	ingestErrorLineTooLong    = "line_too_long"
+	ingestErrorUserExists     = "user_already_exists"
+	ingestErrorIdentityExists = "identity_already_exists"
+)
+
+// IngestTelegramStreamLine is a single line of the stream, the shape of Data depends on the Type.
+type IngestTelegramStreamLine struct {
+	Type string          `json:"type" example:"record" enums:"user,identity,record"`
+	Data json.RawMessage `json:"data" swaggertype:"object"`
+}
+
+// IngestTelegramIdentity is the data of an identity line. The user is referenced by the Telegram ID.
+type IngestTelegramIdentity struct {
+	UserTelegramID uint64 `json:"user_telegram_id"      example:"28736582143"`
+	Username       string `json:"telegram_username"     example:"user1235"`
+	FirstName      string `json:"telegram_first_name"   example:"John"`
+	LastName       string `json:"telegram_last_name"    example:"Doe"`
+	Bio            string `json:"telegram_bio"          example:"Hi! I am using Whatsapp"`
+	PhoneNumber    string `json:"telegram_phone_number" example:"+11234567890"`
+}
+
+// IngestTelegramRecord is the data of a record line. The author is referenced by the Telegram ID.
+type IngestTelegramRecord struct {
+	MessageTelegramID        uint64                  `json:"message_telegram_id"                    example:"28736582143"`
+	FromUserTelegramID       uint64                  `json:"from_telegram_id"                       example:"28736582143"`
+	InTelegramChatID         int64                   `json:"in_telegram_chat_id"                    example:"123456789"`
+	MessageText              string                  `json:"message_text"                           example:"Hello world!"`
+	Entities                 []TelegramMessageEntity `json:"entities,omitempty"`
+	Caption                  string                  `json:"caption,omitempty"                      example:"Look at this"`
+	CaptionEntities          []TelegramMessageEntity `json:"caption_entities,omitempty"`
+	PostedAt                 time.Time               `json:"posted_at"                              example:"2024-01-15T10:30:00Z"`
+	EditedAt                 *time.Time              `json:"edited_at,omitempty"                    example:"2024-01-15T10:35:00Z"`
+	ReplyToMessageTelegramID *uint64                 `json:"reply_to_message_telegram_id,omitempty" example:"28736582140"`
+	ThreadTelegramID         *uint64                 `json:"thread_telegram_id,omitempty"           example:"28736582100"`
+	ForwardOrigin            *TelegramForwardOrigin  `json:"forward_origin,omitempty"`
+}
+
+// IngestTelegramStreamAck acknowledges a line once its chunk has been committed.
+// An ack without a line number is the last one and tells why the stream has been aborted.
+type IngestTelegramStreamAck struct {
+	Line  int    `json:"line,omitempty"  example:"1"`
+	ID    string `json:"id,omitempty"    example:"550e8400-e29b-41d4-a716-446655440000"`
+	Error string `json:"error,omitempty" example:"record_already_exists"`
+}
+
+type IngestTelegramStreamHandler struct {
+	interactor   *application.IngestTelegramChunk
+	ingestConfig *config.IngestConfig
+	logger       *slog.Logger
+}
+
+func NewIngestTelegramStreamHandler(
+	interactor *application.IngestTelegramChunk,
+	ingestConfig *config.IngestConfig,
+	logger *slog.Logger,
+) *IngestTelegramStreamHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "ingest_telegram_stream_handler"),
+	)
+
+	return &IngestTelegramStreamHandler{
+		interactor:   interactor,
+		ingestConfig: ingestConfig,
+		logger:       handlerLogger,
+	}
+}
+
+// pendingLine is a line of the current chunk, either an item sent to the interactor or an already failed one.
+type pendingLine struct {
+	line      int
+	itemIndex int
+	errorCode string
+}
+
+// ingestStream is the state of a single request.
+type ingestStream struct {
+	w       http.ResponseWriter
+	rc      *http.ResponseController
+	encoder *json.Encoder
+	started bool
+	pending []pendingLine
+	items   []application.IngestItem
+}
+
+// ServeHTTP handles a stream of newline delimited users, identities and records.
+//
+//	@Summary		Ingest a stream of telegram entities
+//	@Description	Accepts newline delimited JSON, each line is {"type": "user"|"identity"|"record", "data": {...}}.
+//	@Description	User lines carry AddTelegramUserRequest, identity lines IngestTelegramIdentity
+//	@Description	and record lines IngestTelegramRecord.
+//	@Description	Lines are committed in chunks and acknowledged with IngestTelegramStreamAck as soon as their chunk
+//	@Description	is committed, so the client may send the next lines while reading the acks.
+//	@Description	Failed lines are acknowledged with one of the error codes: invalid_line, validation_failed,
+//	@Description	user_already_exists, identity_already_exists, record_already_exists, user_not_found, chat_not_found.
+//	@Tags			record
+//	@Accept			application/x-ndjson
+//	@Produce		application/x-ndjson
+//	@Param			request	body		IngestTelegramStreamLine	true	"Stream of lines"
+//	@Success		200		{object}	IngestTelegramStreamAck
+//	@Failure		403		{string}	string	"Insufficient privileges"
+//	@Failure		500		{string}	string	"Internal server error"
+//	@Router			/v1/record/telegram/ingest [post]
+//	@Security		Bearer
+func (handler *IngestTelegramStreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	stream := &ingestStream{
+		w:       w,
+		rc:      http.NewResponseController(w),
+		encoder: json.NewEncoder(w),
+		pending: make([]pendingLine, 0, handler.ingestConfig.ChunkSize),
+		items:   make([]application.IngestItem, 0, handler.ingestConfig.ChunkSize),
+	}
+	// Acks are written while the rest of the body is still being read
+	if err := stream.rc.EnableFullDuplex(); err != nil {
+		handler.logger.DebugContext(r.Context(), "full duplex is not supported", slog.Any("err", err))
+	}
+
+	scanner := bufio.NewScanner(r.Body)
+	// The scanner takes the larger of the capacity and the limit, so the buffer may not start larger than a line
+	scanner.Buffer(
+		make([]byte, 0, min(bufio.MaxScanTokenSize, handler.ingestConfig.MaxLineSize)),
+		handler.ingestConfig.MaxLineSize,
+	)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		item, err := decodeIngestLine(scanner.Bytes())
+		if err != nil {
+			stream.pending = append(stream.pending, pendingLine{line: line, errorCode: ingestErrorInvalidLine})
+		} else {
+			stream.pending = append(stream.pending, pendingLine{line: line, itemIndex: len(stream.items)})
+			stream.items = append(stream.items, item)
+		}
+		if len(stream.pending) >= handler.ingestConfig.ChunkSize {
+			if !handler.commitChunk(r, stream) {
+				return
+			}
+		}
+	}
+	if !handler.commitChunk(r, stream) {
+		return
+	}
+	if err := scanner.Err(); err != nil {
+		handler.logger.DebugContext(r.Context(), "failed to read the stream", slog.Any("err", err))
+		code := ingestErrorInvalidLine
+		if errors.Is(err, bufio.ErrTooLong) {
+			code = ingestErrorLineTooLong
+		}
+		stream.write(IngestTelegramStreamAck{Error: code})
+		return
+	}
+	if !stream.started {
+		stream.start()
+	}
+}
+
+// commitChunk sends the pending items to the interactor and acknowledges the pending lines.
+// It reports whether the stream may go on.
+func (handler *IngestTelegramStreamHandler) commitChunk(r *http.Request, stream *ingestStream) bool {
+	if len(stream.pending) == 0 {
+		return true
+	}
+	var results []application.IngestResult
+	if len(stream.items) > 0 {
+		resp, err := handler.interactor.Execute(r.Context(), application.IngestTelegramChunkRequest{Items: stream.items})
+		if err != nil {
+			handler.failStream(r, stream, err)
+			return false
+		}
+		results = resp.Results
+	}
+
+	for _, pending := range stream.pending {
+		ack := IngestTelegramStreamAck{Line: pending.line, Error: pending.errorCode}
+		if ack.Error == "" {
+			result := results[pending.itemIndex]
+			if result.Err != nil {
+				ack.Error = ingestErrorCode(result.Err)
+			} else {
+				ack.ID = result.ID.String()
+			}
+		}
+		stream.write(ack)
+	}
+	if err := stream.rc.Flush(); err != nil {
+		handler.logger.DebugContext(r.Context(), "failed to flush acks", slog.Any("err", err))
+	}
+	stream.pending = stream.pending[:0]
+	stream.items = stream.items[:0]
+	return true
+}
+
+func (handler *IngestTelegramStreamHandler) failStream(r *http.Request, stream *ingestStream, err error) {
+	if errors.Is(err, rbac.ErrInsufficientPrivileges) {
+		handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+		if !stream.started {
+			http.Error(stream.w, "Insufficient privileges", http.StatusForbidden)
+			return
+		}
+	} else {
+		handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+		if !stream.started {
+			http.Error(stream.w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+	// The committed chunks stay, the client resumes after the last acknowledged line
+	stream.write(IngestTelegramStreamAck{Error: batchErrorInternal})
+}
+
+func (stream *ingestStream) start() {
+	stream.w.Header().Set("Content-Type", "application/x-ndjson")
+	stream.w.WriteHeader(http.StatusOK)
+	stream.started = true
+}
+
+func (stream *ingestStream) write(ack IngestTelegramStreamAck) {
+	if !stream.started {
+		stream.start()
+	}
+	_ = stream.encoder.Encode(ack)
+}
+
+func decodeIngestLine(data []byte) (application.IngestItem, error) {
+	var line IngestTelegramStreamLine
+	if err := decodeStrict(data, &line); err != nil {
+		return application.IngestItem{}, err
+	}
+	switch line.Type {
+	case ingestTypeUser:
+		var user AddTelegramUserRequest
+		if err := decodeStrict(line.Data, &user); err != nil {
+			return application.IngestItem{}, err
+		}
+		return application.IngestItem{User: &application.IngestUserInput{TelegramID: user.TelegramID}}, nil
+	case ingestTypeIdentity:
+		var identity IngestTelegramIdentity
+		if err := decodeStrict(line.Data, &identity); err != nil {
+			return application.IngestItem{}, err
+		}
+		return application.IngestItem{Identity: &application.IngestIdentityInput{
+			UserTelegramID: identity.UserTelegramID,
+			Username:       identity.Username,
+			FirstName:      identity.FirstName,
+			LastName:       identity.LastName,
+			Bio:            identity.Bio,
+			PhoneNumber:    identity.PhoneNumber,
+		}}, nil
+	case ingestTypeRecord:
+		var record IngestTelegramRecord
+		if err := decodeStrict(line.Data, &record); err != nil {
+			return application.IngestItem{}, err
+		}
+		return application.IngestItem{Record: &application.IngestRecordInput{
+			MessageTelegramID:        record.MessageTelegramID,
+			FromUserTelegramID:       record.FromUserTelegramID,
+			InTelegramChatID:         record.InTelegramChatID,
+			MessageText:              record.MessageText,
+			Entities:                 entitiesToDomain(record.Entities),
+			Caption:                  record.Caption,
+			CaptionEntities:          entitiesToDomain(record.CaptionEntities),
+			PostedAt:                 record.PostedAt,
+			EditedAt:                 record.EditedAt,
+			ReplyToMessageTelegramID: record.ReplyToMessageTelegramID,
+			ThreadTelegramID:         record.ThreadTelegramID,
+			ForwardOrigin:            forwardOriginToDomain(record.ForwardOrigin),
+		}}, nil
+	default:
+		return application.IngestItem{}, application.ErrEmptyItem
+	}
+}
+
+func decodeStrict(data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
+
+func ingestErrorCode(err error) string {
+	switch {
+	case errors.Is(err, domain.ErrUserAlreadyExists):
+		return ingestErrorUserExists
+	case errors.Is(err, domain.ErrIdentityAlreadyExists):
+		return ingestErrorIdentityExists
+	default:
+		return batchErrorCode(err)
+	}
+}
@@ -0,0 +1,505 @@
+package handlers //nolint:testpackage // the line protocol is unexported
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/InWamos/trinity-proto/config"
+	telegram "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/ingest"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/domain/telegram/service"
+	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	eventclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/event/client"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+// memoryStore is an in-memory stand-in for the record repositories, shared by all transactions.
+type memoryStore struct {
+	commits    int
+	users      map[uint64]*domain.TelegramUser
+	identities map[string]domain.TelegramIdentity
+	messages   map[uint64]domain.TelegramRecord
+	indicators []domain.TelegramIndicator
+	chain      []domain.TelegramChainEntry
+	// observations are keyed by the collector and the target observed.
+	observations map[[2]uuid.UUID]bool
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		users:        make(map[uint64]*domain.TelegramUser),
+		identities:   make(map[string]domain.TelegramIdentity),
+		messages:     make(map[uint64]domain.TelegramRecord),
+		observations: make(map[[2]uuid.UUID]bool),
+	}
+}
+
+type memoryTransaction struct {
+	interfaces.TransactionManager
+	store *memoryStore
+}
+
+func (tm memoryTransaction) Commit(context.Context) error {
+	tm.store.commits++
+	return nil
+}
+
+func (memoryTransaction) Rollback(context.Context) error { return nil }
+
+func (memoryTransaction) InSavepoint(_ context.Context, fn func() error) error { return fn() }
+
+func (store *memoryStore) NewTransaction(context.Context) (interfaces.TransactionManager, error) {
+	return memoryTransaction{store: store}, nil
+}
+
+type memoryUserRepository struct {
+	repository.TelegramUserRepository
+	store *memoryStore
+}
+
+func (repo memoryUserRepository) GetByTelegramID(_ context.Context, telegramID uint64) (*domain.TelegramUser, error) {
+	if user, ok := repo.store.users[telegramID]; ok {
+		return user, nil
+	}
+	return nil, domain.ErrUserNotFound
+}
+
+func (repo memoryUserRepository) AddUser(_ context.Context, user *domain.TelegramUser) error {
+	if _, ok := repo.store.users[user.TelegramID]; ok {
+		return domain.ErrUserAlreadyExists
+	}
+	repo.store.users[user.TelegramID] = user
+	return nil
+}
+
+func (store *memoryStore) CreateTelegramUserRepositoryWithTransaction(
+	interfaces.TransactionManager,
+) repository.TelegramUserRepository {
+	return memoryUserRepository{store: store}
+}
+
+type memoryIdentityRepository struct {
+	repository.TelegramIdentityRepository
+	store *memoryStore
+}
+
+func (repo memoryIdentityRepository) AddIdentity(_ context.Context, identity *domain.TelegramIdentity) error {
+	key := identity.UserID.String() + identity.Username
+	if _, ok := repo.store.identities[key]; ok {
+		return domain.ErrIdentityAlreadyExists
+	}
+	repo.store.identities[key] = *identity
+	return nil
+}
+
+func (repo memoryIdentityRepository) GetIdentitiesByIDs(
+	_ context.Context,
+	identityIDs []uuid.UUID,
+) (*[]domain.TelegramIdentity, error) {
+	var identities []domain.TelegramIdentity
+	for _, identity := range repo.store.identities {
+		if slices.Contains(identityIDs, identity.ID) {
+			identities = append(identities, identity)
+		}
+	}
+	return &identities, nil
+}
+
+func (store *memoryStore) CreateTelegramIdentityRepositoryWithTransaction(
+	interfaces.TransactionManager,
+) repository.TelegramIdentityRepository {
+	return memoryIdentityRepository{store: store}
+}
+
+type memoryRecordRepository struct {
+	repository.TelegramRecordRepository
+	store *memoryStore
+}
+
+func (repo memoryRecordRepository) CreateTelegramRecords(
+	_ context.Context,
+	telegramRecords []domain.TelegramRecord,
+) ([]error, error) {
+	recordErrors := make([]error, len(telegramRecords))
+	for i, record := range telegramRecords {
+		if _, ok := repo.store.messages[record.MessageTelegramID]; ok {
+			recordErrors[i] = domain.ErrRecordAlreadyExists
+			continue
+		}
+		repo.store.messages[record.MessageTelegramID] = record
+	}
+	return recordErrors, nil
+}
+
+func (repo memoryRecordRepository) GetTelegramRecordByMessageTelegramID(
+	_ context.Context,
+	messageTelegramID uint64,
+	_ int64,
+) (*domain.TelegramRecord, error) {
+	if record, ok := repo.store.messages[messageTelegramID]; ok {
+		return &record, nil
+	}
+	return nil, domain.ErrRecordNotFound
+}
+
+func (repo memoryRecordRepository) GetTelegramRecordsByIDs(
+	_ context.Context,
+	recordIDs []uuid.UUID,
+) (*[]domain.TelegramRecord, error) {
+	var records []domain.TelegramRecord
+	for _, record := range repo.store.messages {
+		if slices.Contains(recordIDs, record.ID) {
+			records = append(records, record)
+		}
+	}
+	return &records, nil
+}
+
+func (store *memoryStore) CreateTelegramRecordRepositoryWithTransaction(
+	interfaces.TransactionManager,
+) repository.TelegramRecordRepository {
+	return memoryRecordRepository{store: store}
+}
+
+// memoryChainRepository appends the entries to a single chain, whatever their collector.
+type memoryChainRepository struct {
+	repository.TelegramChainRepository
+	store *memoryStore
+}
+
+func (repo memoryChainRepository) LockTelegramChainHead(
+	_ context.Context,
+	collectorUserID uuid.UUID,
+) (*domain.TelegramChainHead, error) {
+	head := &domain.TelegramChainHead{CollectorUserID: collectorUserID}
+	if len(repo.store.chain) > 0 {
+		head.Sequence = repo.store.chain[len(repo.store.chain)-1].Sequence
+		head.EntryHash = repo.store.chain[len(repo.store.chain)-1].EntryHash
+	}
+	return head, nil
+}
+
+func (repo memoryChainRepository) AppendTelegramChainEntries(
+	_ context.Context,
+	entries []domain.TelegramChainEntry,
+) error {
+	repo.store.chain = append(repo.store.chain, entries...)
+	return nil
+}
+
+func (repo memoryChainRepository) GetLatestTelegramChainContentHashes(
+	context.Context,
+	domain.TelegramChainSubjectType,
+	[]uuid.UUID,
+) (map[uuid.UUID]string, error) {
+	contentHashes := make(map[uuid.UUID]string)
+	for _, entry := range repo.store.chain {
+		contentHashes[entry.SubjectID] = entry.ContentHash
+	}
+	return contentHashes, nil
+}
+
+func (store *memoryStore) CreateTelegramChainRepositoryWithTransaction(
+	interfaces.TransactionManager,
+) repository.TelegramChainRepository {
+	return memoryChainRepository{store: store}
+}
+
+type memoryObservationRepository struct {
+	repository.TelegramObservationRepository
+	store *memoryStore
+}
+
+func (repo memoryObservationRepository) AddTelegramObservations(
+	_ context.Context,
+	observations []domain.TelegramObservation,
+) ([]uuid.UUID, error) {
+	var observedIDs []uuid.UUID
+	for _, observation := range observations {
+		key := [2]uuid.UUID{observation.CollectorUserID, observation.TargetID}
+		if !repo.store.observations[key] {
+			repo.store.observations[key] = true
+			observedIDs = append(observedIDs, observation.TargetID)
+		}
+	}
+	return observedIDs, nil
+}
+
+func (store *memoryStore) CreateTelegramObservationRepositoryWithTransaction(
+	interfaces.TransactionManager,
+) repository.TelegramObservationRepository {
+	return memoryObservationRepository{store: store}
+}
+
+// memoryIndicatorRepository keeps the indicators, the interactions aren't derived.
+type memoryIndicatorRepository struct {
+	repository.TelegramIndicatorRepository
+	repository.TelegramInteractionRepository
+	store *memoryStore
+}
+
+func (repo memoryIndicatorRepository) AddTelegramIndicators(
+	_ context.Context,
+	indicators []domain.TelegramIndicator,
+) error {
+	repo.store.indicators = append(repo.store.indicators, indicators...)
+	return nil
+}
+
+func (memoryIndicatorRepository) DeriveTelegramInteractions(context.Context, uuid.UUID) error {
+	return nil
+}
+
+func (store *memoryStore) CreateTelegramInteractionRepositoryWithTransaction(
+	interfaces.TransactionManager,
+) repository.TelegramInteractionRepository {
+	return memoryIndicatorRepository{store: store}
+}
+
+func (store *memoryStore) CreateTelegramIndicatorRepositoryWithTransaction(
+	interfaces.TransactionManager,
+) repository.TelegramIndicatorRepository {
+	return memoryIndicatorRepository{store: store}
+}
+
+// memoryAlertRepository raises no alerts, no watchlists are kept.
+type memoryAlertRepository struct {
+	repository.TelegramAlertRepository
+}
+
+func (memoryAlertRepository) AddTelegramAlertsForRecords(context.Context, []uuid.UUID) error {
+	return nil
+}
+
+func (memoryAlertRepository) AddTelegramAlertsForIdentities(context.Context, []uuid.UUID) error {
+	return nil
+}
+
+func (store *memoryStore) CreateTelegramAlertRepositoryWithTransaction(
+	interfaces.TransactionManager,
+) repository.TelegramAlertRepository {
+	return memoryAlertRepository{}
+}
+
+// memoryEventClient records no events, the outbox isn't kept.
+type memoryEventClient struct{}
+
+func (memoryEventClient) Record(context.Context, interfaces.TransactionManager, eventclient.EventType, any) error {
+	return nil
+}
+
+func (memoryEventClient) RecordObserved(
+	context.Context,
+	interfaces.TransactionManager,
+	eventclient.EventType,
+	any,
+	eventclient.Observation,
+) error {
+	return nil
+}
+
+// memoryAuditClient appends nothing, the audit log isn't kept.
+type memoryAuditClient struct {
+	auditclient.AuditClient
+}
+
+func (memoryAuditClient) Record(context.Context, interfaces.TransactionManager, ...auditclient.Entry) error {
+	return nil
+}
+
+func newIngestHandler(store *memoryStore, ingestConfig *config.IngestConfig) *IngestTelegramStreamHandler {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	watchlistEvaluator := telegram.NewTelegramWatchlistEvaluator(store)
+	visibilityPolicy := telegram.NewTelegramVisibilityPolicy(&config.VisibilityConfig{Visibility: "global"})
+	interactor := application.NewIngestTelegramChunk(
+		store,
+		service.NewTelegramModelValidator(validator.New()),
+		store,
+		store,
+		store,
+		telegram.NewTelegramRecordAnalyzer(store, store, service.NewTelegramIndicatorExtractor(), watchlistEvaluator),
+		telegram.NewTelegramChainAppender(store, store, store, nil),
+		watchlistEvaluator,
+		telegram.NewTelegramEventPublisher(memoryEventClient{}, visibilityPolicy),
+		telegram.NewTelegramProvenanceRecorder(store, visibilityPolicy),
+		memoryAuditClient{},
+		logger,
+	)
+	return NewIngestTelegramStreamHandler(interactor, ingestConfig, logger)
+}
+
+func ingest(t *testing.T, handler *IngestTelegramStreamHandler, body string) (int, []IngestTelegramStreamAck) {
+	t.Helper()
+	identity := &client.UserIdentity{UserID: uuid.New(), UserRole: client.User}
+	request := httptest.NewRequest(http.MethodPost, "/telegram/ingest", strings.NewReader(body))
+	request = request.WithContext(context.WithValue(request.Context(), client.IdentityProviderKey, identity))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	var acks []IngestTelegramStreamAck
+	scanner := bufio.NewScanner(recorder.Body)
+	for scanner.Scan() {
+		var ack IngestTelegramStreamAck
+		if err := json.Unmarshal(scanner.Bytes(), &ack); err != nil {
+			t.Fatalf("failed to decode ack %q: %v", scanner.Text(), err)
+		}
+		acks = append(acks, ack)
+	}
+	return recorder.Code, acks
+}
+
+func TestDecodeIngestLine(t *testing.T) {
+	cases := map[string]struct {
+		line  string
+		check func(item application.IngestItem) bool
+	}{
+		"user": {
+			line:  `{"type": "user", "data": {"telegram_id": 42}}`,
+			check: func(item application.IngestItem) bool { return item.User != nil && item.User.TelegramID == 42 },
+		},
+		"identity": {
+			line: `{"type": "identity", "data": {"user_telegram_id": 42, "telegram_username": "alice"}}`,
+			check: func(item application.IngestItem) bool {
+				return item.Identity != nil && item.Identity.UserTelegramID == 42 && item.Identity.Username == "alice"
+			},
+		},
+		"record": {
+			line: `{"type": "record", "data": {"message_telegram_id": 7, "from_telegram_id": 42, ` +
+				`"in_telegram_chat_id": -100, "message_text": "hi", "posted_at": "2024-01-15T10:30:00Z"}}`,
+			check: func(item application.IngestItem) bool {
+				return item.Record != nil && item.Record.MessageTelegramID == 7 && item.Record.InTelegramChatID == -100
+			},
+		},
+		"unknown type":         {line: `{"type": "chat", "data": {}}`},
+		"unknown field":        {line: `{"type": "user", "data": {"telegram_id": 42, "name": "alice"}}`},
+		"unknown line field":   {line: `{"type": "user", "data": {"telegram_id": 42}, "extra": true}`},
+		"malformed json":       {line: `{"type": "user", "data": `},
+		"mismatched data type": {line: `{"type": "user", "data": {"telegram_id": "42"}}`},
+	}
+	for name, tc := range cases {
+		item, err := decodeIngestLine([]byte(tc.line))
+		if tc.check == nil {
+			if err == nil {
+				t.Errorf("%s: expected the line to be rejected, got %+v", name, item)
+			}
+			continue
+		}
+		if err != nil || !tc.check(item) {
+			t.Errorf("%s: unexpected item %+v, err %v", name, item, err)
+		}
+	}
+}
+
+func TestIngestTelegramStream_Acks(t *testing.T) {
+	store := newMemoryStore()
+	handler := newIngestHandler(store, &config.IngestConfig{ChunkSize: 2, MaxLineSize: 1024})
+	body := strings.Join([]string{
+		`{"type": "user", "data": {"telegram_id": 42}}`,
+		`{"type": "identity", "data": {"user_telegram_id": 42, "telegram_username": "alice", ` +
+			`"telegram_first_name": "Alice", "telegram_phone_number": "+11234567890"}}`,
+		``,
+		`not json`,
+		`{"type": "record", "data": {"message_telegram_id": 7, "from_telegram_id": 42, ` +
+			`"in_telegram_chat_id": -100, "message_text": "hi", "posted_at": "2024-01-15T10:30:00Z"}}`,
+		`{"type": "record", "data": {"message_telegram_id": 7, "from_telegram_id": 42, ` +
+			`"in_telegram_chat_id": -100, "message_text": "hi", "posted_at": "2024-01-15T10:30:00Z"}}`,
+		`{"type": "record", "data": {"message_telegram_id": 8, "from_telegram_id": 43, ` +
+			`"in_telegram_chat_id": -100, "message_text": "hi", "posted_at": "2024-01-15T10:30:00Z"}}`,
+		`{"type": "user", "data": {"telegram_id": 42}}`,
+	}, "\n")
+
+	code, acks := ingest(t, handler, body)
+	if code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, code)
+	}
+	// The blank third line is neither acknowledged nor counted in a chunk
+	expected := []struct {
+		line  int
+		error string
+	}{
+		{line: 1},
+		{line: 2},
+		{line: 4, error: ingestErrorInvalidLine},
+		{line: 5},
+		{line: 6, error: batchErrorRecordExists},
+		{line: 7, error: batchErrorUserNotFound},
+		{line: 8, error: ingestErrorUserExists},
+	}
+	if len(acks) != len(expected) {
+		t.Fatalf("expected %d acks, got %+v", len(expected), acks)
+	}
+	for i, tc := range expected {
+		ack := acks[i]
+		if ack.Line != tc.line || ack.Error != tc.error || (tc.error == "") == (ack.ID == "") {
+			t.Errorf("ack %d: expected line %d with error %q, got %+v", i, tc.line, tc.error, ack)
+		}
+	}
+	if store.commits != 4 {
+		t.Errorf("expected the 7 lines to be committed in 4 chunks, got %d", store.commits)
+	}
+	// The identity and the record that has been stored are chained, the rejected lines aren't
+	if len(store.chain) != 2 || store.chain[0].SubjectType != domain.TelegramChainSubjectIdentity ||
+		store.chain[1].SubjectType != domain.TelegramChainSubjectRecord {
+		t.Errorf("expected the identity and the record to be chained, got %+v", store.chain)
+	}
+}
+
+func TestIngestTelegramStream_LineTooLong(t *testing.T) {
+	store := newMemoryStore()
+	handler := newIngestHandler(store, &config.IngestConfig{ChunkSize: 10, MaxLineSize: 64})
+	body := `{"type": "user", "data": {"telegram_id": 42}}` + "\n" + strings.Repeat("x", 128) + "\n"
+
+	_, acks := ingest(t, handler, body)
+	if len(acks) != 2 {
+		t.Fatalf("expected an ack and the abort, got %+v", acks)
+	}
+	if acks[0].Line != 1 || acks[0].ID == "" {
+		t.Errorf("expected the line before the long one to be committed, got %+v", acks[0])
+	}
+	if acks[1].Line != 0 || acks[1].Error != ingestErrorLineTooLong {
+		t.Errorf("expected the stream to be aborted with %q, got %+v", ingestErrorLineTooLong, acks[1])
+	}
+}
+
+func TestIngestTelegramStream_Unauthorized(t *testing.T) {
+	handler := newIngestHandler(newMemoryStore(), &config.IngestConfig{ChunkSize: 10, MaxLineSize: 1024})
+	request := httptest.NewRequest(
+		http.MethodPost,
+		"/telegram/ingest",
+		strings.NewReader(`{"type": "user", "data": {"telegram_id": 42}}`),
+	)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	if recorder.Code != http.StatusForbidden {
+		t.Errorf("expected status %d, got %d", http.StatusForbidden, recorder.Code)
+	}
+}
+
+func TestIngestErrorCode(t *testing.T) {
+	cases := map[error]string{
+		domain.ErrUserAlreadyExists:                ingestErrorUserExists,
+		domain.ErrIdentityAlreadyExists:            ingestErrorIdentityExists,
+		domain.ErrRecordAlreadyExists:              batchErrorRecordExists,
+		domain.ErrUnexistentTelegramUserReferenced: batchErrorUserNotFound,
+		errors.New("connection reset"):             batchErrorInternal,
+	}
+	for err, expected := range cases {
+		if code := ingestErrorCode(err); code != expected {
+			t.Errorf("expected %v to be reported as %q, got %q", err, expected, code)
+		}
+	}
+}
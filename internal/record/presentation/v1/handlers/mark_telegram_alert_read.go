@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/alert"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+// MarkTelegramAlertReadRequest represents the request payload for changing the read state of an alert.
+type MarkTelegramAlertReadRequest struct {
+	Read bool `json:"read" example:"true"`
+}
+
+type MarkTelegramAlertReadHandler struct {
+	interactor *application.MarkTelegramAlertRead
+	logger     *slog.Logger
+}
+
+func NewMarkTelegramAlertReadHandler(
+	interactor *application.MarkTelegramAlertRead,
+	logger *slog.Logger,
+) *MarkTelegramAlertReadHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "mark_telegram_alert_read_handler"),
+	)
+
+	return &MarkTelegramAlertReadHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles PATCH requests to mark an alert as read or unread.
+//
+//	@Summary		Mark an alert as read or unread
+//	@Description	Changes the read state of an alert of the current user
+//	@Tags			alert
+//	@Accept			json
+//	@Param			alert_id	path	string							true	"Alert ID"	format(uuid)
+//	@Param			request		body	MarkTelegramAlertReadRequest	true	"Read state"
+//	@Success		204			"Read state changed"
+//	@Failure		400			{string}	string	"Invalid alert ID or request format"
+//	@Failure		403			{string}	string	"Insufficient privileges"
+//	@Failure		404			{string}	string	"Alert not found"
+//	@Failure		500			{string}	string	"Internal server error"
+//	@Router			/v1/alerts/{alert_id} [patch]
+func (handler *MarkTelegramAlertReadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	alertID, err := uuid.Parse(r.PathValue("alert_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid alert ID format", slog.Any("err", err))
+		http.Error(w, "Invalid alert ID format", http.StatusBadRequest)
+		return
+	}
+	var req MarkTelegramAlertReadRequest
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err = dec.Decode(&req); err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid request format", slog.Any("err", err))
+		http.Error(w, "Invalid request format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.MarkTelegramAlertReadRequest{AlertID: alertID, Read: req.Read}
+	if err = handler.interactor.Execute(r.Context(), requestDTO); err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrAlertNotFound):
+			http.Error(w, "Alert not found", http.StatusNotFound)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/record"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+// MarkTelegramRecordDeletedRequest represents the request payload for marking a record deleted in Telegram.
+type MarkTelegramRecordDeletedRequest struct {
+	DeletedAt *time.Time `json:"deleted_at,omitempty" example:"2024-01-16T08:00:00Z"`
+}
+
+// MarkTelegramRecordDeletedResponse represents the response payload of a deletion mark.
+type MarkTelegramRecordDeletedResponse struct {
+	RecordID  string    `json:"record_id"  example:"550e8400-e29b-41d4-a716-446655440000"`
+	DeletedAt time.Time `json:"deleted_at" example:"2024-01-16T08:00:00Z"`
+}
+
+type MarkTelegramRecordDeletedHandler struct {
+	interactor *application.MarkTelegramRecordDeleted
+	logger     *slog.Logger
+}
+
+func NewMarkTelegramRecordDeletedHandler(
+	interactor *application.MarkTelegramRecordDeleted,
+	logger *slog.Logger,
+) *MarkTelegramRecordDeletedHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "mark_telegram_record_deleted_handler"),
+	)
+
+	return &MarkTelegramRecordDeletedHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to mark a record deleted in Telegram.
+//
+//	@Summary		Mark record deleted in Telegram
+//	@Description	Marks the message as deleted in Telegram, the archived content is kept.
+//	@Description	deleted_at defaults to now, a record that is already marked keeps the first deletion time.
+//	@Tags			record
+//	@Accept			json
+//	@Produce		json
+//	@Param			record_id	path		string								true	"Record ID"
+//	@Param			request		body		MarkTelegramRecordDeletedRequest	false	"Deletion details"
+//	@Success		200			{object}	MarkTelegramRecordDeletedResponse
+//	@Failure		400			{string}	string	"Invalid request format"
+//	@Failure		403			{string}	string	"Insufficient privileges"
+//	@Failure		404			{string}	string	"Record not found"
+//	@Failure		500			{string}	string	"Internal server error"
+//	@Router			/v1/record/telegram/record/{record_id}/deletion [post]
+//	@Security		Bearer
+func (handler *MarkTelegramRecordDeletedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	recordID, err := uuid.Parse(r.PathValue("record_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid record ID format", slog.Any("err", err))
+		http.Error(w, "Invalid record ID format", http.StatusBadRequest)
+		return
+	}
+
+	var req MarkTelegramRecordDeletedRequest
+	if r.ContentLength != 0 {
+		dec := json.NewDecoder(r.Body)
+		dec.DisallowUnknownFields()
+		if err = dec.Decode(&req); err != nil {
+			handler.logger.DebugContext(r.Context(), "invalid request format", slog.Any("err", err))
+			http.Error(w, "Invalid request format", http.StatusBadRequest)
+			return
+		}
+	}
+
+	requestDTO := application.MarkTelegramRecordDeletedRequest{RecordID: recordID, DeletedAt: req.DeletedAt}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrRecordNotFound):
+			handler.logger.DebugContext(r.Context(), "telegram record not found by ID", slog.Any("err", err))
+			http.Error(w, "Record not found", http.StatusNotFound)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := MarkTelegramRecordDeletedResponse{RecordID: recordID.String(), DeletedAt: resp.DeletedAt}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+)
+
+// multipartOverhead leaves room for the multipart boundaries and form fields around the file.
+const multipartOverhead = 1 << 20
+
+// maxFormFieldSize bounds the plain form fields sent along with a file.
+const maxFormFieldSize = 64
+
+var errUnexpectedFormField = errors.New("unexpected form field")
+
+// nextMultipartFile reads the plain fields preceding the "file" part and returns the file part
+// without buffering it, so uploads are streamed straight to the blob storage.
+func nextMultipartFile(
+	reader *multipart.Reader,
+	setField func(name string, value string) error,
+) (*multipart.Part, error) {
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			return nil, err
+		}
+		if part.FormName() == "file" {
+			return part, nil
+		}
+		value, err := io.ReadAll(io.LimitReader(part, maxFormFieldSize))
+		part.Close()
+		if err != nil {
+			return nil, err
+		}
+		if err = setField(part.FormName(), string(value)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// streamBlob writes the stored content as a file download.
+func streamBlob(
+	w http.ResponseWriter,
+	content io.Reader,
+	mimeType string,
+	size uint64,
+	fileName string,
+	logger *slog.Logger,
+	r *http.Request,
+) {
+	w.Header().Set("Content-Type", mimeType)
+	w.Header().Set("Content-Length", strconv.FormatUint(size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	// The type is sniffed on upload, browsers must not guess another one
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, content); err != nil {
+		logger.DebugContext(r.Context(), "blob streaming was interrupted", slog.Any("err", err))
+	}
+}
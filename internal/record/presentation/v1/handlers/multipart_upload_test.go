@@ -0,0 +1,166 @@
+package handlers //nolint:testpackage // the multipart helpers are unexported
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log/slog"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type formPart struct {
+	name     string
+	fileName string
+	content  string
+}
+
+func multipartBody(t *testing.T, parts []formPart) (*multipart.Reader, error) {
+	t.Helper()
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for _, part := range parts {
+		var (
+			partWriter io.Writer
+			err        error
+		)
+		if part.fileName != "" {
+			partWriter, err = writer.CreateFormFile(part.name, part.fileName)
+		} else {
+			partWriter, err = writer.CreateFormField(part.name)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if _, err = io.WriteString(partWriter, part.content); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return multipart.NewReader(&body, writer.Boundary()), nil
+}
+
+func TestNextMultipartFile(t *testing.T) {
+	cases := map[string]struct {
+		parts   []formPart
+		fields  map[string]string
+		content string
+		err     error
+	}{
+		"fields before the file": {
+			parts: []formPart{
+				{name: "user_telegram_id", content: "42"},
+				{name: "posted_at", content: "2024-01-15T10:30:00Z"},
+				{name: "file", fileName: "avatar.jpg", content: "image"},
+			},
+			fields:  map[string]string{"user_telegram_id": "42", "posted_at": "2024-01-15T10:30:00Z"},
+			content: "image",
+		},
+		"file only": {
+			parts:   []formPart{{name: "file", fileName: "avatar.jpg", content: "image"}},
+			fields:  map[string]string{},
+			content: "image",
+		},
+		"fields after the file are not read": {
+			parts: []formPart{
+				{name: "file", fileName: "avatar.jpg", content: "image"},
+				{name: "user_telegram_id", content: "42"},
+			},
+			fields:  map[string]string{},
+			content: "image",
+		},
+		"unexpected field": {
+			parts: []formPart{
+				{name: "unknown", content: "value"},
+				{name: "file", fileName: "avatar.jpg", content: "image"},
+			},
+			err: errUnexpectedFormField,
+		},
+		"missing file": {
+			parts: []formPart{{name: "user_telegram_id", content: "42"}},
+			err:   io.EOF,
+		},
+	}
+	for name, tc := range cases {
+		reader, err := multipartBody(t, tc.parts)
+		if err != nil {
+			t.Fatalf("%s: failed to build the body: %v", name, err)
+		}
+		fields := map[string]string{}
+		part, err := nextMultipartFile(reader, func(field string, value string) error {
+			if field != "user_telegram_id" && field != "posted_at" {
+				return errUnexpectedFormField
+			}
+			fields[field] = value
+			return nil
+		})
+		if tc.err != nil {
+			if !errors.Is(err, tc.err) {
+				t.Errorf("%s: expected %v, got %v", name, tc.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", name, err)
+			continue
+		}
+		content, _ := io.ReadAll(part)
+		if string(content) != tc.content {
+			t.Errorf("%s: expected file content %q, got %q", name, tc.content, content)
+		}
+		if len(fields) != len(tc.fields) {
+			t.Errorf("%s: expected fields %v, got %v", name, tc.fields, fields)
+		}
+		for field, value := range tc.fields {
+			if fields[field] != value {
+				t.Errorf("%s: expected %s to be %q, got %q", name, field, value, fields[field])
+			}
+		}
+	}
+}
+
+func TestNextMultipartFile_FieldSizeBound(t *testing.T) {
+	reader, err := multipartBody(t, []formPart{
+		{name: "user_telegram_id", content: strings.Repeat("9", maxFormFieldSize*2)},
+		{name: "file", fileName: "avatar.jpg", content: "image"},
+	})
+	if err != nil {
+		t.Fatalf("failed to build the body: %v", err)
+	}
+	var value string
+	if _, err = nextMultipartFile(reader, func(_ string, fieldValue string) error {
+		value = fieldValue
+		return nil
+	}); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(value) != maxFormFieldSize {
+		t.Errorf("expected the field to be cut at %d bytes, got %d", maxFormFieldSize, len(value))
+	}
+}
+
+func TestStreamBlob(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	streamBlob(recorder, strings.NewReader("image"), "image/png", 5, "avatar 1.png", slog.Default(), request)
+
+	headers := map[string]string{
+		"Content-Type":           "image/png",
+		"Content-Length":         "5",
+		"Content-Disposition":    `attachment; filename="avatar 1.png"`,
+		"X-Content-Type-Options": "nosniff",
+	}
+	for header, expected := range headers {
+		if value := recorder.Header().Get(header); value != expected {
+			t.Errorf("expected %s to be %q, got %q", header, expected, value)
+		}
+	}
+	if recorder.Body.String() != "image" {
+		t.Errorf("expected the blob to be streamed, got %q", recorder.Body.String())
+	}
+}
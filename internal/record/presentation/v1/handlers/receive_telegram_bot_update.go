@@ -0,0 +1,293 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/bot"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+)
+
+// botAPIChannelIDOffset is subtracted by the Bot API from the IDs of channels and supergroups, after negation.
+const botAPIChannelIDOffset = 1_000_000_000_000
+
+// TelegramBotUser is the part of the Bot API User object the webhook relies on.
+type TelegramBotUser struct {
+	ID        uint64 `json:"id"         example:"28736582"`
+	FirstName string `json:"first_name" example:"John"`
+	LastName  string `json:"last_name"  example:"Doe"`
+	Username  string `json:"username"   example:"john_doe"`
+}
+
+// TelegramBotChat is the part of the Bot API Chat object the webhook relies on.
+type TelegramBotChat struct {
+	ID       int64  `json:"id"       example:"-1001234567890"`
+	Type     string `json:"type"     example:"supergroup"`
+	Title    string `json:"title"    example:"Trinity discussion"`
+	Username string `json:"username" example:"trinity_chat"`
+}
+
+// TelegramBotReplyMessage is the part of the replied message the webhook relies on.
+type TelegramBotReplyMessage struct {
+	MessageID uint64 `json:"message_id" example:"1336"`
+}
+
+// TelegramBotMessageOrigin is the Bot API MessageOrigin object of a forwarded message.
+// Depending on the type, the origin is either the sender_user, the sender_chat or the chat with the message_id.
+type TelegramBotMessageOrigin struct {
+	Type       string           `json:"type"       example:"channel"`
+	Date       int64            `json:"date"       example:"1705314000"`
+	SenderUser *TelegramBotUser `json:"sender_user"`
+	SenderChat *TelegramBotChat `json:"sender_chat"`
+	Chat       *TelegramBotChat `json:"chat"`
+	MessageID  uint64           `json:"message_id" example:"42"`
+}
+
+// TelegramBotMessageEntity is the Bot API MessageEntity object.
+type TelegramBotMessageEntity struct {
+	Type          string           `json:"type"            example:"text_link"`
+	Offset        int              `json:"offset"          example:"6"`
+	Length        int              `json:"length"          example:"5"`
+	URL           string           `json:"url"             example:"https://example.com"`
+	User          *TelegramBotUser `json:"user"`
+	Language      string           `json:"language"        example:"go"`
+	CustomEmojiID string           `json:"custom_emoji_id" example:"5368324170671202286"`
+}
+
+// TelegramBotMessage is the part of the Bot API Message object the webhook relies on.
+type TelegramBotMessage struct {
+	MessageID       uint64                     `json:"message_id"        example:"1337"`
+	From            *TelegramBotUser           `json:"from"`
+	SenderChat      *TelegramBotChat           `json:"sender_chat"`
+	Chat            TelegramBotChat            `json:"chat"`
+	Date            int64                      `json:"date"              example:"1705314600"`
+	EditDate        int64                      `json:"edit_date"         example:"1705314900"`
+	Text            string                     `json:"text"              example:"Hello world!"`
+	Caption         string                     `json:"caption"           example:"Look at this"`
+	Entities        []TelegramBotMessageEntity `json:"entities"`
+	CaptionEntities []TelegramBotMessageEntity `json:"caption_entities"`
+	// MessageThreadID is the forum topic of supergroups or the comment thread of channel posts
+	MessageThreadID uint64                    `json:"message_thread_id" example:"1300"`
+	ReplyToMessage  *TelegramBotReplyMessage  `json:"reply_to_message"`
+	ForwardOrigin   *TelegramBotMessageOrigin `json:"forward_origin"`
+}
+
+// TelegramBotUpdate is the Bot API Update object. Updates of other kinds are acknowledged and ignored.
+type TelegramBotUpdate struct {
+	UpdateID      int64               `json:"update_id" example:"10000"`
+	Message       *TelegramBotMessage `json:"message"`
+	EditedMessage *TelegramBotMessage `json:"edited_message"`
+	ChannelPost   *TelegramBotMessage `json:"channel_post"`
+}
+
+type ReceiveTelegramBotUpdateHandler struct {
+	interactor *application.ReceiveTelegramBotUpdate
+	logger     *slog.Logger
+}
+
+func NewReceiveTelegramBotUpdateHandler(
+	interactor *application.ReceiveTelegramBotUpdate,
+	logger *slog.Logger,
+) *ReceiveTelegramBotUpdateHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "receive_telegram_bot_update_handler"),
+	)
+
+	return &ReceiveTelegramBotUpdateHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an update pushed by the Telegram Bot API.
+//
+//	@Summary		Receive a Telegram Bot API update
+//	@Description	Webhook for the Telegram Bot API, register it with setWebhook and the same secret_token
+//	@Description	as BOT_WEBHOOK_SECRET. Stores message, edited_message and channel_post updates as records of the
+//	@Description	configured service user, along with their chats, senders and sender identities.
+//	@Description	Updates that can't be stored are acknowledged anyway, so that Telegram doesn't redeliver them.
+//	@Tags			bot
+//	@Accept			json
+//	@Param			X-Telegram-Bot-Api-Secret-Token	header	string				true	"Webhook secret token"
+//	@Param			request							body	TelegramBotUpdate	true	"Update"
+//	@Success		200								"Update acknowledged"
+//	@Failure		400								"Invalid request format"
+//	@Failure		401								"Invalid secret token"
+//	@Failure		404								"Webhook is not configured"
+//	@Failure		500								"Internal server error"
+//	@Router			/v1/bot/telegram/webhook [post]
+func (handler *ReceiveTelegramBotUpdateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var update TelegramBotUpdate
+	// Telegram keeps adding fields, so the unknown ones are tolerated
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid request format", slog.Any("err", err))
+		http.Error(w, "Invalid request format", http.StatusBadRequest)
+		return
+	}
+
+	message := update.Message
+	switch {
+	case update.EditedMessage != nil:
+		message = update.EditedMessage
+	case update.ChannelPost != nil:
+		message = update.ChannelPost
+	}
+	if message == nil {
+		handler.logger.DebugContext(r.Context(), "unsupported update", slog.Int64("update_id", update.UpdateID))
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	requestDTO, ok := toBotUpdateRequest(message)
+	if !ok {
+		handler.logger.DebugContext(r.Context(), "update without a sender", slog.Int64("update_id", update.UpdateID))
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.ErrorContext(r.Context(), "Bot service user is not allowed to add records", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrValidationFailed):
+			handler.logger.InfoContext(
+				r.Context(),
+				"update has been skipped",
+				slog.Int64("update_id", update.UpdateID),
+				slog.Any("err", err),
+			)
+			w.WriteHeader(http.StatusOK)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	handler.logger.DebugContext(
+		r.Context(),
+		"update has been stored",
+		slog.Int64("update_id", update.UpdateID),
+		slog.String("record_id", resp.RecordID.String()),
+		slog.Bool("revised", resp.Revised),
+		slog.Bool("duplicate", resp.Duplicate),
+	)
+	w.WriteHeader(http.StatusOK)
+}
+
+// toBotUpdateRequest maps a message to the interactor request.
+// Messages on behalf of a chat, e.g. channel posts, are attributed to that chat.
+func toBotUpdateRequest(message *TelegramBotMessage) (application.ReceiveTelegramBotUpdateRequest, bool) {
+	var sender application.TelegramBotSender
+	switch {
+	case message.SenderChat != nil:
+		sender = application.TelegramBotSender{
+			TelegramID: bareChatID(message.SenderChat.ID),
+			Username:   message.SenderChat.Username,
+			FirstName:  message.SenderChat.Title,
+		}
+	case message.From != nil:
+		sender = application.TelegramBotSender{
+			TelegramID: message.From.ID,
+			Username:   message.From.Username,
+			FirstName:  message.From.FirstName,
+			LastName:   message.From.LastName,
+		}
+	default:
+		return application.ReceiveTelegramBotUpdateRequest{}, false
+	}
+
+	var editedAt *time.Time
+	if message.EditDate != 0 {
+		editDate := time.Unix(message.EditDate, 0).UTC()
+		editedAt = &editDate
+	}
+	request := application.ReceiveTelegramBotUpdateRequest{
+		MessageTelegramID: message.MessageID,
+		Sender:            sender,
+		Chat: application.TelegramBotChat{
+			TelegramID: message.Chat.ID,
+			Type:       domain.TelegramChatType(message.Chat.Type),
+			Title:      message.Chat.Title,
+			Username:   message.Chat.Username,
+		},
+		Text:            message.Text,
+		Entities:        toEntities(message.Entities),
+		Caption:         message.Caption,
+		CaptionEntities: toEntities(message.CaptionEntities),
+		PostedAt:        time.Unix(message.Date, 0).UTC(),
+		EditedAt:        editedAt,
+		ForwardOrigin:   toForwardOrigin(message.ForwardOrigin),
+	}
+	if message.ReplyToMessage != nil {
+		request.ReplyToMessageTelegramID = &message.ReplyToMessage.MessageID
+	}
+	if message.MessageThreadID != 0 {
+		request.ThreadTelegramID = &message.MessageThreadID
+	}
+	return request, true
+}
+
+// toEntities drops the entities of types newer than the ones known, rather than the whole message.
+func toEntities(botEntities []TelegramBotMessageEntity) []domain.TelegramMessageEntity {
+	var entities []domain.TelegramMessageEntity
+	for _, botEntity := range botEntities {
+		entity := domain.TelegramMessageEntity{
+			Type:          domain.TelegramMessageEntityType(botEntity.Type),
+			Offset:        botEntity.Offset,
+			Length:        botEntity.Length,
+			URL:           botEntity.URL,
+			Language:      botEntity.Language,
+			CustomEmojiID: botEntity.CustomEmojiID,
+		}
+		if !entity.Type.IsKnown() {
+			continue
+		}
+		if botEntity.User != nil {
+			entity.UserTelegramID = botEntity.User.ID
+		}
+		entities = append(entities, entity)
+	}
+	return entities
+}
+
+// toForwardOrigin maps the origin of a forwarded message, the author of a hidden_user origin is unknown.
+func toForwardOrigin(origin *TelegramBotMessageOrigin) *domain.TelegramForwardOrigin {
+	if origin == nil {
+		return nil
+	}
+	postedAt := time.Unix(origin.Date, 0).UTC()
+	forwardOrigin := &domain.TelegramForwardOrigin{PostedAt: &postedAt}
+	switch {
+	case origin.SenderUser != nil:
+		forwardOrigin.FromUserTelegramID = &origin.SenderUser.ID
+	case origin.SenderChat != nil:
+		forwardOrigin.FromChatTelegramID = &origin.SenderChat.ID
+	case origin.Chat != nil:
+		forwardOrigin.FromChatTelegramID = &origin.Chat.ID
+	}
+	if origin.MessageID != 0 {
+		forwardOrigin.MessageTelegramID = &origin.MessageID
+	}
+	return forwardOrigin
+}
+
+// bareChatID strips the Bot API prefix of a chat ID, e.g. -1001234567890 becomes 1234567890.
+func bareChatID(chatID int64) uint64 {
+	switch {
+	case chatID <= -botAPIChannelIDOffset:
+		return uint64(-(chatID + botAPIChannelIDOffset))
+	case chatID < 0:
+		return uint64(-chatID)
+	default:
+		return uint64(chatID)
+	}
+}
@@ -0,0 +1,224 @@
+package handlers //nolint:testpackage // the update mapping is unexported
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+)
+
+func TestBareChatID(t *testing.T) {
+	cases := map[int64]uint64{
+		28736582:       28736582,
+		-123456789:     123456789,
+		-1001234567890: 1234567890,
+		-999999999999:  999999999999,
+	}
+	for chatID, expected := range cases {
+		if bare := bareChatID(chatID); bare != expected {
+			t.Errorf("expected %d to be stripped to %d, got %d", chatID, expected, bare)
+		}
+	}
+}
+
+func TestToBotUpdateRequest(t *testing.T) {
+	chat := TelegramBotChat{ID: -1001234567890, Type: "supergroup", Title: "Trinity", Username: "trinity_chat"}
+	cases := map[string]struct {
+		message    TelegramBotMessage
+		ok         bool
+		senderID   uint64
+		senderName string
+		text       string
+		caption    string
+	}{
+		"user message": {
+			message: TelegramBotMessage{
+				MessageID: 1,
+				From:      &TelegramBotUser{ID: 42, FirstName: "Alice", Username: "alice"},
+				Chat:      chat,
+				Date:      1705314600,
+				Text:      "hello",
+			},
+			ok:         true,
+			senderID:   42,
+			senderName: "Alice",
+			text:       "hello",
+		},
+		"caption without text": {
+			message: TelegramBotMessage{
+				MessageID: 2,
+				From:      &TelegramBotUser{ID: 42, FirstName: "Alice"},
+				Chat:      chat,
+				Date:      1705314600,
+				Caption:   "look at this",
+			},
+			ok:         true,
+			senderID:   42,
+			senderName: "Alice",
+			caption:    "look at this",
+		},
+		"on behalf of a channel": {
+			message: TelegramBotMessage{
+				MessageID:  3,
+				From:       &TelegramBotUser{ID: 136817688, FirstName: "Channel"},
+				SenderChat: &TelegramBotChat{ID: -1007777777777, Type: "channel", Title: "News", Username: "news"},
+				Chat:       chat,
+				Date:       1705314600,
+				Text:       "post",
+			},
+			ok:         true,
+			senderID:   7777777777,
+			senderName: "News",
+			text:       "post",
+		},
+		"without a sender": {
+			message: TelegramBotMessage{MessageID: 4, Chat: chat, Date: 1705314600, Text: "anonymous"},
+		},
+	}
+	for name, tc := range cases {
+		request, ok := toBotUpdateRequest(&tc.message)
+		if ok != tc.ok {
+			t.Errorf("%s: expected ok to be %t", name, tc.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if request.Sender.TelegramID != tc.senderID || request.Sender.FirstName != tc.senderName {
+			t.Errorf("%s: unexpected sender %+v", name, request.Sender)
+		}
+		if request.Text != tc.text || request.Caption != tc.caption {
+			t.Errorf("%s: expected text %q and caption %q, got %+v", name, tc.text, tc.caption, request)
+		}
+		if request.Chat.TelegramID != chat.ID || request.Chat.Type != domain.TelegramChatTypeSupergroup {
+			t.Errorf("%s: unexpected chat %+v", name, request.Chat)
+		}
+		if !request.PostedAt.Equal(time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)) {
+			t.Errorf("%s: unexpected posted at %v", name, request.PostedAt)
+		}
+	}
+}
+
+func TestReceiveTelegramBotUpdate_Acknowledged(t *testing.T) {
+	// None of the updates reaches the interactor, which is nil here
+	handler := NewReceiveTelegramBotUpdateHandler(nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	cases := map[string]struct {
+		body   string
+		status int
+	}{
+		"unsupported update": {
+			body:   `{"update_id": 1, "callback_query": {"id": "1"}}`,
+			status: http.StatusOK,
+		},
+		"message without a sender": {
+			body:   `{"update_id": 2, "message": {"message_id": 1, "chat": {"id": -100, "type": "group"}, "date": 1}}`,
+			status: http.StatusOK,
+		},
+		"malformed update": {
+			body:   `{"update_id": `,
+			status: http.StatusBadRequest,
+		},
+	}
+	for name, tc := range cases {
+		request := httptest.NewRequest(http.MethodPost, "/telegram/webhook", strings.NewReader(tc.body))
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		if recorder.Code != tc.status {
+			t.Errorf("%s: expected status %d, got %d", name, tc.status, recorder.Code)
+		}
+	}
+}
+
+func TestToBotUpdateRequest_Linkage(t *testing.T) {
+	message := TelegramBotMessage{
+		MessageID:       1337,
+		From:            &TelegramBotUser{ID: 42, FirstName: "Alice"},
+		Chat:            TelegramBotChat{ID: -1001234567890, Type: "supergroup"},
+		Date:            1705314600,
+		Text:            "reply",
+		MessageThreadID: 1300,
+		ReplyToMessage:  &TelegramBotReplyMessage{MessageID: 1336},
+	}
+	request, ok := toBotUpdateRequest(&message)
+	if !ok {
+		t.Fatal("expected the message to be mapped")
+	}
+	if request.ReplyToMessageTelegramID == nil || *request.ReplyToMessageTelegramID != 1336 {
+		t.Errorf("expected a reply to 1336, got %v", request.ReplyToMessageTelegramID)
+	}
+	if request.ThreadTelegramID == nil || *request.ThreadTelegramID != 1300 {
+		t.Errorf("expected the thread 1300, got %v", request.ThreadTelegramID)
+	}
+	if request.ForwardOrigin != nil {
+		t.Errorf("expected no forward origin, got %+v", request.ForwardOrigin)
+	}
+
+	message.MessageThreadID = 0
+	message.ReplyToMessage = nil
+	if request, _ = toBotUpdateRequest(&message); request.ReplyToMessageTelegramID != nil ||
+		request.ThreadTelegramID != nil {
+		t.Errorf("expected a message outside of threads, got %+v", request)
+	}
+}
+
+func TestToForwardOrigin(t *testing.T) {
+	cases := map[string]struct {
+		origin    TelegramBotMessageOrigin
+		userID    uint64
+		chatID    int64
+		messageID uint64
+	}{
+		"user": {
+			origin: TelegramBotMessageOrigin{Type: "user", Date: 1705314000, SenderUser: &TelegramBotUser{ID: 42}},
+			userID: 42,
+		},
+		"hidden user": {
+			origin: TelegramBotMessageOrigin{Type: "hidden_user", Date: 1705314000},
+		},
+		"anonymous admin": {
+			origin: TelegramBotMessageOrigin{
+				Type:       "chat",
+				Date:       1705314000,
+				SenderChat: &TelegramBotChat{ID: -1001234567890},
+			},
+			chatID: -1001234567890,
+		},
+		"channel": {
+			origin: TelegramBotMessageOrigin{
+				Type:      "channel",
+				Date:      1705314000,
+				Chat:      &TelegramBotChat{ID: -1007777777777},
+				MessageID: 42,
+			},
+			chatID:    -1007777777777,
+			messageID: 42,
+		},
+	}
+	for name, tc := range cases {
+		origin := toForwardOrigin(&tc.origin)
+		if origin == nil || origin.PostedAt == nil || origin.PostedAt.Unix() != tc.origin.Date {
+			t.Errorf("%s: expected an origin posted at %d, got %+v", name, tc.origin.Date, origin)
+			continue
+		}
+		if (origin.FromUserTelegramID == nil) != (tc.userID == 0) ||
+			(origin.FromUserTelegramID != nil && *origin.FromUserTelegramID != tc.userID) {
+			t.Errorf("%s: expected the author %d, got %v", name, tc.userID, origin.FromUserTelegramID)
+		}
+		if (origin.FromChatTelegramID == nil) != (tc.chatID == 0) ||
+			(origin.FromChatTelegramID != nil && *origin.FromChatTelegramID != tc.chatID) {
+			t.Errorf("%s: expected the chat %d, got %v", name, tc.chatID, origin.FromChatTelegramID)
+		}
+		if (origin.MessageTelegramID == nil) != (tc.messageID == 0) ||
+			(origin.MessageTelegramID != nil && *origin.MessageTelegramID != tc.messageID) {
+			t.Errorf("%s: expected the message %d, got %v", name, tc.messageID, origin.MessageTelegramID)
+		}
+	}
+	if toForwardOrigin(nil) != nil {
+		t.Error("expected messages that haven't been forwarded to have no origin")
+	}
+}
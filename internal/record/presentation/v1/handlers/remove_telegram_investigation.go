@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/investigation"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+type RemoveTelegramInvestigationHandler struct {
+	interactor *application.RemoveTelegramInvestigation
+	logger     *slog.Logger
+}
+
+func NewRemoveTelegramInvestigationHandler(
+	interactor *application.RemoveTelegramInvestigation,
+	logger *slog.Logger,
+) *RemoveTelegramInvestigationHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "remove_telegram_investigation_handler"),
+	)
+
+	return &RemoveTelegramInvestigationHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles DELETE requests to remove an investigation.
+//
+//	@Summary		Remove an investigation
+//	@Description	Removes an investigation owned by the current user along with its members and items,
+//	@Description	the telegram data it refers to is kept
+//	@Tags			investigation
+//	@Param			investigation_id	path	string	true	"Investigation ID"	format(uuid)
+//	@Success		204					"Investigation removed"
+//	@Failure		400					{string}	string	"Invalid investigation ID format"
+//	@Failure		403					{string}	string	"Insufficient privileges"
+//	@Failure		404					{string}	string	"Investigation not found"
+//	@Failure		500					{string}	string	"Internal server error"
+//	@Router			/v1/investigations/{investigation_id} [delete]
+func (handler *RemoveTelegramInvestigationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	investigationID, err := uuid.Parse(r.PathValue("investigation_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid investigation ID format", slog.Any("err", err))
+		http.Error(w, "Invalid investigation ID format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.RemoveTelegramInvestigationRequest{InvestigationID: investigationID}
+	if err = handler.interactor.Execute(r.Context(), requestDTO); err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrInvestigationNotFound):
+			http.Error(w, "Investigation not found", http.StatusNotFound)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
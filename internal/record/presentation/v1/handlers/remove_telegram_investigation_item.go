@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/investigation"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+type RemoveTelegramInvestigationItemHandler struct {
+	interactor *application.RemoveTelegramInvestigationItem
+	logger     *slog.Logger
+}
+
+func NewRemoveTelegramInvestigationItemHandler(
+	interactor *application.RemoveTelegramInvestigationItem,
+	logger *slog.Logger,
+) *RemoveTelegramInvestigationItemHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "remove_telegram_investigation_item_handler"),
+	)
+
+	return &RemoveTelegramInvestigationItemHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles DELETE requests to detach an item from an investigation.
+//
+//	@Summary		Detach an investigation item
+//	@Description	Detaches an item from an investigation along with its note.
+//	@Description	Only the owners and the editors of the investigation detach items.
+//	@Tags			investigation
+//	@Param			investigation_id	path	string	true	"Investigation ID"	format(uuid)
+//	@Param			item_id				path	string	true	"Item ID"			format(uuid)
+//	@Success		204					"Item detached"
+//	@Failure		400					{string}	string	"Invalid investigation or item ID format"
+//	@Failure		403					{string}	string	"Insufficient privileges"
+//	@Failure		404					{string}	string	"Investigation or item not found"
+//	@Failure		500					{string}	string	"Internal server error"
+//	@Router			/v1/investigations/{investigation_id}/items/{item_id} [delete]
+func (handler *RemoveTelegramInvestigationItemHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	investigationID, err := uuid.Parse(r.PathValue("investigation_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid investigation ID format", slog.Any("err", err))
+		http.Error(w, "Invalid investigation ID format", http.StatusBadRequest)
+		return
+	}
+	itemID, err := uuid.Parse(r.PathValue("item_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid item ID format", slog.Any("err", err))
+		http.Error(w, "Invalid item ID format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.RemoveTelegramInvestigationItemRequest{InvestigationID: investigationID, ItemID: itemID}
+	if err = handler.interactor.Execute(r.Context(), requestDTO); err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrInvestigationNotFound):
+			http.Error(w, "Investigation not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrInvestigationItemNotFound):
+			http.Error(w, "Item not found", http.StatusNotFound)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
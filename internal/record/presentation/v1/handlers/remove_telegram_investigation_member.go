@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/investigation"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+type RemoveTelegramInvestigationMemberHandler struct {
+	interactor *application.RemoveTelegramInvestigationMember
+	logger     *slog.Logger
+}
+
+func NewRemoveTelegramInvestigationMemberHandler(
+	interactor *application.RemoveTelegramInvestigationMember,
+	logger *slog.Logger,
+) *RemoveTelegramInvestigationMemberHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "remove_telegram_investigation_member_handler"),
+	)
+
+	return &RemoveTelegramInvestigationMemberHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles DELETE requests to remove a member of an investigation.
+//
+//	@Summary		Remove an investigation member
+//	@Description	Removes a user from an investigation. The owners and the admins remove any member,
+//	@Description	every member may leave, but the last owner can't.
+//	@Tags			investigation
+//	@Param			investigation_id	path	string	true	"Investigation ID"	format(uuid)
+//	@Param			user_id				path	string	true	"User ID"			format(uuid)
+//	@Success		204					"Member removed"
+//	@Failure		400					{string}	string	"Invalid investigation or user ID format"
+//	@Failure		403					{string}	string	"Insufficient privileges"
+//	@Failure		404					{string}	string	"Investigation or member not found"
+//	@Failure		409					{string}	string	"The investigation must keep an owner"
+//	@Failure		500					{string}	string	"Internal server error"
+//	@Router			/v1/investigations/{investigation_id}/members/{user_id} [delete]
+func (handler *RemoveTelegramInvestigationMemberHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	investigationID, err := uuid.Parse(r.PathValue("investigation_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid investigation ID format", slog.Any("err", err))
+		http.Error(w, "Invalid investigation ID format", http.StatusBadRequest)
+		return
+	}
+	userID, err := uuid.Parse(r.PathValue("user_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid user ID format", slog.Any("err", err))
+		http.Error(w, "Invalid user ID format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.RemoveTelegramInvestigationMemberRequest{
+		InvestigationID: investigationID,
+		UserID:          userID,
+	}
+	if err = handler.interactor.Execute(r.Context(), requestDTO); err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrInvestigationNotFound):
+			http.Error(w, "Investigation not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrInvestigationMemberNotFound):
+			http.Error(w, "Member not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrInvestigationLastOwner):
+			http.Error(w, "The investigation must keep an owner", http.StatusConflict)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
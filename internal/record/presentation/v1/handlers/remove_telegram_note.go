@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/annotation"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+type RemoveTelegramNoteHandler struct {
+	interactor *application.RemoveTelegramNote
+	logger     *slog.Logger
+}
+
+func NewRemoveTelegramNoteHandler(
+	interactor *application.RemoveTelegramNote,
+	logger *slog.Logger,
+) *RemoveTelegramNoteHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "remove_telegram_note_handler"),
+	)
+
+	return &RemoveTelegramNoteHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles DELETE requests to remove a note about a telegram user, identity or record.
+//
+//	@Summary		Remove a note
+//	@Description	Removes a note about a telegram user, identity or record.
+//	@Description	Only the user who has written the note and the admins remove it.
+//	@Tags			annotation
+//	@Param			target_type	path	string	true	"Target type"	Enums(telegram_user, telegram_identity, telegram_record)
+//	@Param			target_id	path	string	true	"Target ID"		format(uuid)
+//	@Param			note_id		path	string	true	"Note ID"		format(uuid)
+//	@Success		204			"Note removed"
+//	@Failure		400			{string}	string	"Invalid target or note ID"
+//	@Failure		403			{string}	string	"Insufficient privileges"
+//	@Failure		404			{string}	string	"Note not found"
+//	@Failure		500			{string}	string	"Internal server error"
+//	@Router			/v1/annotations/{target_type}/{target_id}/notes/{note_id} [delete]
+func (handler *RemoveTelegramNoteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	targetType, targetID, err := parseAnnotationTarget(r)
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid target ID format", slog.Any("err", err))
+		http.Error(w, "Invalid target ID format", http.StatusBadRequest)
+		return
+	}
+	noteID, err := uuid.Parse(r.PathValue("note_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid note ID format", slog.Any("err", err))
+		http.Error(w, "Invalid note ID format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.RemoveTelegramNoteRequest{TargetType: targetType, TargetID: targetID, NoteID: noteID}
+	if err = handler.interactor.Execute(r.Context(), requestDTO); err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, application.ErrInvalidAnnotationTarget):
+			http.Error(w, "Invalid target type", http.StatusBadRequest)
+			return
+		case errors.Is(err, domain.ErrNoteNotFound):
+			http.Error(w, "Note not found", http.StatusNotFound)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
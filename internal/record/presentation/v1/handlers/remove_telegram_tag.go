@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/annotation"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+)
+
+type RemoveTelegramTagHandler struct {
+	interactor *application.RemoveTelegramTag
+	logger     *slog.Logger
+}
+
+func NewRemoveTelegramTagHandler(
+	interactor *application.RemoveTelegramTag,
+	logger *slog.Logger,
+) *RemoveTelegramTagHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "remove_telegram_tag_handler"),
+	)
+
+	return &RemoveTelegramTagHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles DELETE requests to remove a tag from a telegram user, identity or record.
+//
+//	@Summary		Remove a tag
+//	@Description	Removes a tag from a telegram user, identity or record.
+//	@Description	Only the user who has added the tag and the admins remove it.
+//	@Tags			annotation
+//	@Param			target_type	path	string	true	"Target type"	Enums(telegram_user, telegram_identity, telegram_record)
+//	@Param			target_id	path	string	true	"Target ID"		format(uuid)
+//	@Param			tag			path	string	true	"Tag"
+//	@Success		204			"Tag removed"
+//	@Failure		400			{string}	string	"Invalid target"
+//	@Failure		403			{string}	string	"Insufficient privileges"
+//	@Failure		404			{string}	string	"Tag not found"
+//	@Failure		500			{string}	string	"Internal server error"
+//	@Router			/v1/annotations/{target_type}/{target_id}/tags/{tag} [delete]
+func (handler *RemoveTelegramTagHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	targetType, targetID, err := parseAnnotationTarget(r)
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid target ID format", slog.Any("err", err))
+		http.Error(w, "Invalid target ID format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.RemoveTelegramTagRequest{
+		TargetType: targetType,
+		TargetID:   targetID,
+		Name:       r.PathValue("tag"),
+	}
+	if err = handler.interactor.Execute(r.Context(), requestDTO); err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, application.ErrInvalidAnnotationTarget):
+			http.Error(w, "Invalid target type", http.StatusBadRequest)
+			return
+		case errors.Is(err, domain.ErrTagNotFound):
+			http.Error(w, "Tag not found", http.StatusNotFound)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/watchlist"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+type RemoveTelegramWatchlistHandler struct {
+	interactor *application.RemoveTelegramWatchlist
+	logger     *slog.Logger
+}
+
+func NewRemoveTelegramWatchlistHandler(
+	interactor *application.RemoveTelegramWatchlist,
+	logger *slog.Logger,
+) *RemoveTelegramWatchlistHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "remove_telegram_watchlist_handler"),
+	)
+
+	return &RemoveTelegramWatchlistHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles DELETE requests to remove a watchlist.
+//
+//	@Summary		Remove a watchlist
+//	@Description	Removes a watchlist of the current user along with its entries and their alerts
+//	@Tags			watchlist
+//	@Param			watchlist_id	path	string	true	"Watchlist ID"	format(uuid)
+//	@Success		204				"Watchlist removed"
+//	@Failure		400				{string}	string	"Invalid watchlist ID format"
+//	@Failure		403				{string}	string	"Insufficient privileges"
+//	@Failure		404				{string}	string	"Watchlist not found"
+//	@Failure		500				{string}	string	"Internal server error"
+//	@Router			/v1/watchlists/{watchlist_id} [delete]
+func (handler *RemoveTelegramWatchlistHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	watchlistID, err := uuid.Parse(r.PathValue("watchlist_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid watchlist ID format", slog.Any("err", err))
+		http.Error(w, "Invalid watchlist ID format", http.StatusBadRequest)
+		return
+	}
+
+	err = handler.interactor.Execute(r.Context(), application.RemoveTelegramWatchlistRequest{WatchlistID: watchlistID})
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrWatchlistNotFound):
+			http.Error(w, "Watchlist not found", http.StatusNotFound)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/watchlist"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+type RemoveTelegramWatchlistEntryHandler struct {
+	interactor *application.RemoveTelegramWatchlistEntry
+	logger     *slog.Logger
+}
+
+func NewRemoveTelegramWatchlistEntryHandler(
+	interactor *application.RemoveTelegramWatchlistEntry,
+	logger *slog.Logger,
+) *RemoveTelegramWatchlistEntryHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "remove_telegram_watchlist_entry_handler"),
+	)
+
+	return &RemoveTelegramWatchlistEntryHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles DELETE requests to remove an entry of a watchlist.
+//
+//	@Summary		Remove a watchlist entry
+//	@Description	Removes an entry of a watchlist of the current user along with its alerts
+//	@Tags			watchlist
+//	@Param			watchlist_id	path	string	true	"Watchlist ID"	format(uuid)
+//	@Param			entry_id		path	string	true	"Entry ID"		format(uuid)
+//	@Success		204				"Entry removed"
+//	@Failure		400				{string}	string	"Invalid watchlist or entry ID format"
+//	@Failure		403				{string}	string	"Insufficient privileges"
+//	@Failure		404				{string}	string	"Watchlist or entry not found"
+//	@Failure		500				{string}	string	"Internal server error"
+//	@Router			/v1/watchlists/{watchlist_id}/entries/{entry_id} [delete]
+func (handler *RemoveTelegramWatchlistEntryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	watchlistID, err := uuid.Parse(r.PathValue("watchlist_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid watchlist ID format", slog.Any("err", err))
+		http.Error(w, "Invalid watchlist ID format", http.StatusBadRequest)
+		return
+	}
+	entryID, err := uuid.Parse(r.PathValue("entry_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid entry ID format", slog.Any("err", err))
+		http.Error(w, "Invalid entry ID format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.RemoveTelegramWatchlistEntryRequest{WatchlistID: watchlistID, EntryID: entryID}
+	if err = handler.interactor.Execute(r.Context(), requestDTO); err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrWatchlistNotFound):
+			http.Error(w, "Watchlist not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrWatchlistEntryNotFound):
+			http.Error(w, "Entry not found", http.StatusNotFound)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
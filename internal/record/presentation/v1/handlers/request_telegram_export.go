@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/export"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+// RequestTelegramExportRequest represents the request payload for exporting the records of users or chats.
+type RequestTelegramExportRequest struct {
+	UserTelegramIDs []uint64 `json:"user_telegram_ids" example:"123456789"`
+	ChatTelegramIDs []int64  `json:"chat_telegram_ids" example:"-1001234567890"`
+}
+
+// TelegramExportResponse is an export along with its progress. The digest and the signature are set
+// once it has been completed.
+type TelegramExportResponse struct {
+	ID              uuid.UUID                      `json:"id"                     example:"550e8400-e29b-41d4-a716-446655440000"`
+	RequestedBy     uuid.UUID                      `json:"requested_by"           example:"9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"`
+	UserTelegramIDs []uint64                       `json:"user_telegram_ids"`
+	ChatTelegramIDs []int64                        `json:"chat_telegram_ids"`
+	Status          string                         `json:"status"                 example:"running" enums:"pending,running,completed,failed"`
+	Progress        TelegramExportProgressResponse `json:"progress"`
+	SHA256          string                         `json:"sha256,omitempty"       example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	FileSize        int64                          `json:"file_size,omitempty"    example:"1048576"`
+	PublicKey       string                         `json:"public_key,omitempty"   example:"MCowBQYDK2VwAyEA"`
+	Signature       string                         `json:"signature,omitempty"    example:"dGhpcyBpcyBub3QgYSBzaWduYXR1cmU="`
+	Error           string                         `json:"error,omitempty"        example:"export selects too many records"`
+	CreatedAt       time.Time                      `json:"created_at"             example:"2024-01-15T10:30:00Z"`
+	StartedAt       *time.Time                     `json:"started_at,omitempty"   example:"2024-01-15T10:30:05Z"`
+	CompletedAt     *time.Time                     `json:"completed_at,omitempty" example:"2024-01-15T10:32:40Z"`
+}
+
+type TelegramExportProgressResponse struct {
+	RecordsTotal        int64 `json:"records_total"        example:"12500"`
+	RecordsExported     int64 `json:"records_exported"     example:"6000"`
+	IdentitiesExported  int64 `json:"identities_exported"  example:"0"`
+	AttachmentsExported int64 `json:"attachments_exported" example:"320"`
+}
+
+func newTelegramExportResponse(export *domain.TelegramExport) TelegramExportResponse {
+	response := TelegramExportResponse{
+		ID:              export.ID,
+		RequestedBy:     export.RequestedBy,
+		UserTelegramIDs: export.Selection.UserTelegramIDs,
+		ChatTelegramIDs: export.Selection.ChatTelegramIDs,
+		Status:          string(export.Status),
+		Progress: TelegramExportProgressResponse{
+			RecordsTotal:        export.Progress.RecordsTotal,
+			RecordsExported:     export.Progress.RecordsExported,
+			IdentitiesExported:  export.Progress.IdentitiesExported,
+			AttachmentsExported: export.Progress.AttachmentsExported,
+		},
+		SHA256:      export.SHA256,
+		FileSize:    export.FileSize,
+		PublicKey:   export.PublicKey,
+		Signature:   export.Signature,
+		Error:       export.Error,
+		CreatedAt:   export.CreatedAt,
+		StartedAt:   export.StartedAt,
+		CompletedAt: export.CompletedAt,
+	}
+	if response.UserTelegramIDs == nil {
+		response.UserTelegramIDs = []uint64{}
+	}
+	if response.ChatTelegramIDs == nil {
+		response.ChatTelegramIDs = []int64{}
+	}
+	return response
+}
+
+type RequestTelegramExportHandler struct {
+	interactor *application.RequestTelegramExport
+	logger     *slog.Logger
+}
+
+func NewRequestTelegramExportHandler(
+	interactor *application.RequestTelegramExport,
+	logger *slog.Logger,
+) *RequestTelegramExportHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "request_telegram_export_handler"),
+	)
+
+	return &RequestTelegramExportHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles POST requests to export the records of users or chats.
+//
+//	@Summary		Request an export
+//	@Description	Queues an export of the records posted by any of the users or in any of the chats, along with
+//	@Description	the identities of their authors and their attachments. The export is built in the background:
+//	@Description	poll it until it's completed, then download a ZIP holding the data as JSON and CSV,
+//	@Description	the attachments, a SHA-256 manifest and its detached Ed25519 signature.
+//	@Tags			export
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		RequestTelegramExportRequest	true	"Selection"
+//	@Success		202		{object}	TelegramExportResponse
+//	@Failure		400		{string}	string	"Invalid request format"
+//	@Failure		403		{string}	string	"Insufficient privileges"
+//	@Failure		422		{string}	string	"Selection contains unprocessable fields"
+//	@Failure		500		{string}	string	"Internal server error"
+//	@Failure		503		{string}	string	"Exports are disabled"
+//	@Router			/v1/exports [post]
+func (handler *RequestTelegramExportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req RequestTelegramExportRequest
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid request format", slog.Any("err", err))
+		http.Error(w, "Invalid request format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.RequestTelegramExportRequest{
+		Selection: domain.TelegramExportSelection{
+			UserTelegramIDs: req.UserTelegramIDs,
+			ChatTelegramIDs: req.ChatTelegramIDs,
+		},
+	}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrValidationFailed):
+			handler.logger.DebugContext(r.Context(), "Validation has failed", slog.Any("err", err))
+			http.Error(w, "Selection contains unprocessable fields", http.StatusUnprocessableEntity)
+			return
+		case errors.Is(err, domain.ErrTelegramExportUnsigned):
+			handler.logger.WarnContext(r.Context(), "Export requested without a signing key", slog.Any("err", err))
+			http.Error(w, "Exports are disabled", http.StatusServiceUnavailable)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(w).Encode(newTelegramExportResponse(&resp.Export))
+}
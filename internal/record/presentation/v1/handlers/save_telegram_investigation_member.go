@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/investigation"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+// SaveTelegramInvestigationMemberRequest represents the request payload for adding a member or changing its role.
+type SaveTelegramInvestigationMemberRequest struct {
+	Role string `json:"role" example:"editor" enums:"owner,editor,viewer"`
+}
+
+type SaveTelegramInvestigationMemberHandler struct {
+	interactor *application.SaveTelegramInvestigationMember
+	logger     *slog.Logger
+}
+
+func NewSaveTelegramInvestigationMemberHandler(
+	interactor *application.SaveTelegramInvestigationMember,
+	logger *slog.Logger,
+) *SaveTelegramInvestigationMemberHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "save_telegram_investigation_member_handler"),
+	)
+
+	return &SaveTelegramInvestigationMemberHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles PUT requests to add a member to an investigation or to change its role.
+//
+//	@Summary		Add or change an investigation member
+//	@Description	Adds a platform user to an investigation or changes the role of a member. Owners manage
+//	@Description	the members and remove the investigation, editors attach and detach items, viewers only read.
+//	@Description	Only the owners of the investigation and the admins manage its members, the last owner
+//	@Description	can't be demoted.
+//	@Tags			investigation
+//	@Accept			json
+//	@Produce		json
+//	@Param			investigation_id	path		string									true	"Investigation ID"	format(uuid)
+//	@Param			user_id				path		string									true	"User ID"			format(uuid)
+//	@Param			request				body		SaveTelegramInvestigationMemberRequest	true	"Member role"
+//	@Success		200					{object}	TelegramInvestigationMemberResponse		"Role of the member changed"
+//	@Success		201					{object}	TelegramInvestigationMemberResponse		"Member added"
+//	@Failure		400					{string}	string	"Invalid request format"
+//	@Failure		403					{string}	string	"Insufficient privileges"
+//	@Failure		404					{string}	string	"Investigation not found"
+//	@Failure		409					{string}	string	"The investigation must keep an owner"
+//	@Failure		422					{string}	string	"Member contains unprocessable fields"
+//	@Failure		500					{string}	string	"Internal server error"
+//	@Router			/v1/investigations/{investigation_id}/members/{user_id} [put]
+func (handler *SaveTelegramInvestigationMemberHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	investigationID, err := uuid.Parse(r.PathValue("investigation_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid investigation ID format", slog.Any("err", err))
+		http.Error(w, "Invalid investigation ID format", http.StatusBadRequest)
+		return
+	}
+	userID, err := uuid.Parse(r.PathValue("user_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid user ID format", slog.Any("err", err))
+		http.Error(w, "Invalid user ID format", http.StatusBadRequest)
+		return
+	}
+	var req SaveTelegramInvestigationMemberRequest
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err = dec.Decode(&req); err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid request format", slog.Any("err", err))
+		http.Error(w, "Invalid request format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.SaveTelegramInvestigationMemberRequest{
+		InvestigationID: investigationID,
+		UserID:          userID,
+		Role:            domain.TelegramInvestigationRole(req.Role),
+	}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrValidationFailed):
+			handler.logger.DebugContext(r.Context(), "Validation has failed", slog.Any("err", err))
+			http.Error(w, "Member contains unprocessable fields", http.StatusUnprocessableEntity)
+			return
+		case errors.Is(err, domain.ErrInvestigationNotFound):
+			http.Error(w, "Investigation not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrInvestigationLastOwner):
+			http.Error(w, "The investigation must keep an owner", http.StatusConflict)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	status := http.StatusOK
+	if resp.Created {
+		status = http.StatusCreated
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(TelegramInvestigationMemberResponse{
+		UserID:  resp.Member.UserID,
+		Role:    string(resp.Member.Role),
+		AddedBy: resp.Member.AddedBy,
+		AddedAt: resp.Member.AddedAt,
+	})
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/provenance"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+)
+
+// SetTelegramObservationVisibilityRequest represents the request payload for changing who sees an observation.
+type SetTelegramObservationVisibilityRequest struct {
+	Visibility string `json:"visibility" example:"team" enums:"private,team,global"`
+}
+
+type SetTelegramObservationVisibilityHandler struct {
+	interactor *application.SetTelegramObservationVisibility
+	logger     *slog.Logger
+}
+
+func NewSetTelegramObservationVisibilityHandler(
+	interactor *application.SetTelegramObservationVisibility,
+	logger *slog.Logger,
+) *SetTelegramObservationVisibilityHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "set_telegram_observation_visibility_handler"),
+	)
+
+	return &SetTelegramObservationVisibilityHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles PUT requests to change who sees that the caller has archived a telegram user or record.
+//
+//	@Summary		Set observation visibility
+//	@Description	Changes the visibility of the caller's observation of a telegram user or record: private
+//	@Description	is only seen by the caller, team by its teammates and global by everyone.
+//	@Tags			provenance
+//	@Accept			json
+//	@Produce		json
+//	@Param			target_type	path		string									true	"Target type"	Enums(telegram_user, telegram_record)
+//	@Param			target_id	path		string									true	"Target ID"		format(uuid)
+//	@Param			request		body		SetTelegramObservationVisibilityRequest	true	"Visibility"
+//	@Success		200			{object}	TelegramObservationResponse				"Visibility changed"
+//	@Failure		400			{string}	string	"Invalid request format"
+//	@Failure		403			{string}	string	"Insufficient privileges"
+//	@Failure		404			{string}	string	"The caller hasn't observed the target"
+//	@Failure		500			{string}	string	"Internal server error"
+//	@Router			/v1/provenance/{target_type}/{target_id}/visibility [put]
+func (handler *SetTelegramObservationVisibilityHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	targetType, targetID, err := parseProvenanceTarget(r)
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid target ID format", slog.Any("err", err))
+		http.Error(w, "Invalid target ID format", http.StatusBadRequest)
+		return
+	}
+	var req SetTelegramObservationVisibilityRequest
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err = dec.Decode(&req); err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid request format", slog.Any("err", err))
+		http.Error(w, "Invalid request format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.SetTelegramObservationVisibilityRequest{
+		TargetType: targetType,
+		TargetID:   targetID,
+		Visibility: domain.TelegramVisibility(req.Visibility),
+	}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, application.ErrInvalidProvenanceTarget):
+			http.Error(w, "Invalid target type", http.StatusBadRequest)
+			return
+		case errors.Is(err, domain.ErrInvalidVisibility):
+			http.Error(w, "Invalid visibility", http.StatusBadRequest)
+			return
+		case errors.Is(err, domain.ErrObservationNotFound):
+			http.Error(w, "The caller hasn't observed the target", http.StatusNotFound)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(toTelegramObservationResponse(resp.Observation))
+}
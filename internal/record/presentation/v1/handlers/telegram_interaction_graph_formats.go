@@ -0,0 +1,218 @@
+package handlers
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+)
+
+// Formats an interaction graph is exported in.
+const (
+	graphFormatJSON    = "json"
+	graphFormatGraphML = "graphml"
+	graphFormatGEXF    = "gexf"
+)
+
+// graphFormatContentTypes are the content types of the formats, also accepted in the Accept header.
+var graphFormatContentTypes = map[string]string{
+	graphFormatJSON:    "application/json",
+	graphFormatGraphML: "application/graphml+xml",
+	graphFormatGEXF:    "application/gexf+xml",
+}
+
+// graphNodeID identifies a node by the Telegram ID of the user, XML IDs may not start with a digit.
+func graphNodeID(userTelegramID uint64) string {
+	return "u" + strconv.FormatUint(userTelegramID, 10)
+}
+
+// graphNodeLabel is the username of the user, their name when they have none or their Telegram ID
+// when no identity of them is known.
+func graphNodeLabel(node domain.TelegramInteractionGraphNode) string {
+	if node.Username != "" {
+		return "@" + node.Username
+	}
+	if name := strings.TrimSpace(node.FirstName + " " + node.LastName); name != "" {
+		return name
+	}
+	return strconv.FormatUint(node.UserTelegramID, 10)
+}
+
+type graphMLDocument struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string           `xml:"id,attr"`
+	EdgeDefault string           `xml:"edgedefault,attr"`
+	Nodes       []graphMLElement `xml:"node"`
+	Edges       []graphMLElement `xml:"edge"`
+}
+
+type graphMLElement struct {
+	ID     string        `xml:"id,attr"`
+	Source string        `xml:"source,attr,omitempty"`
+	Target string        `xml:"target,attr,omitempty"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// encodeGraphML writes the graph in GraphML, the attributes are declared as keys of the nodes and the edges.
+func encodeGraphML(w io.Writer, graph domain.TelegramInteractionGraph) error {
+	document := graphMLDocument{
+		XMLNS: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphMLKey{
+			{ID: "label", For: "node", AttrName: "label", AttrType: "string"},
+			{ID: "telegram_id", For: "node", AttrName: "telegram_id", AttrType: "long"},
+			{ID: "username", For: "node", AttrName: "username", AttrType: "string"},
+			{ID: "depth", For: "node", AttrName: "depth", AttrType: "int"},
+			{ID: "type", For: "edge", AttrName: "type", AttrType: "string"},
+			{ID: "weight", For: "edge", AttrName: "weight", AttrType: "double"},
+			{ID: "first_interaction_at", For: "edge", AttrName: "first_interaction_at", AttrType: "string"},
+			{ID: "last_interaction_at", For: "edge", AttrName: "last_interaction_at", AttrType: "string"},
+		},
+		Graph: graphMLGraph{ID: "interactions", EdgeDefault: "directed"},
+	}
+	for _, node := range graph.Nodes {
+		document.Graph.Nodes = append(document.Graph.Nodes, graphMLElement{
+			ID: graphNodeID(node.UserTelegramID),
+			Data: []graphMLData{
+				{Key: "label", Value: graphNodeLabel(node)},
+				{Key: "telegram_id", Value: strconv.FormatUint(node.UserTelegramID, 10)},
+				{Key: "username", Value: node.Username},
+				{Key: "depth", Value: strconv.Itoa(node.Depth)},
+			},
+		})
+	}
+	for i, edge := range graph.Edges {
+		document.Graph.Edges = append(document.Graph.Edges, graphMLElement{
+			ID:     "e" + strconv.Itoa(i),
+			Source: graphNodeID(edge.FromUserTelegramID),
+			Target: graphNodeID(edge.ToUserTelegramID),
+			Data: []graphMLData{
+				{Key: "type", Value: string(edge.Type)},
+				{Key: "weight", Value: strconv.Itoa(edge.Weight)},
+				{Key: "first_interaction_at", Value: edge.FirstInteractionAt.UTC().Format(time.RFC3339)},
+				{Key: "last_interaction_at", Value: edge.LastInteractionAt.UTC().Format(time.RFC3339)},
+			},
+		})
+	}
+	return encodeXML(w, document)
+}
+
+type gexfDocument struct {
+	XMLName xml.Name  `xml:"gexf"`
+	XMLNS   string    `xml:"xmlns,attr"`
+	Version string    `xml:"version,attr"`
+	Graph   gexfGraph `xml:"graph"`
+}
+
+type gexfGraph struct {
+	DefaultEdgeType string           `xml:"defaultedgetype,attr"`
+	Mode            string           `xml:"mode,attr"`
+	Attributes      []gexfAttributes `xml:"attributes"`
+	Nodes           []gexfElement    `xml:"nodes>node"`
+	Edges           []gexfElement    `xml:"edges>edge"`
+}
+
+type gexfAttributes struct {
+	Class      string          `xml:"class,attr"`
+	Attributes []gexfAttribute `xml:"attribute"`
+}
+
+type gexfAttribute struct {
+	ID    string `xml:"id,attr"`
+	Title string `xml:"title,attr"`
+	Type  string `xml:"type,attr"`
+}
+
+type gexfElement struct {
+	ID     string `xml:"id,attr"`
+	Label  string `xml:"label,attr,omitempty"`
+	Source string `xml:"source,attr,omitempty"`
+	Target string `xml:"target,attr,omitempty"`
+	// Kind tells apart the parallel edges of the interaction types
+	Kind      string         `xml:"kind,attr,omitempty"`
+	Weight    string         `xml:"weight,attr,omitempty"`
+	AttValues []gexfAttValue `xml:"attvalues>attvalue"`
+}
+
+type gexfAttValue struct {
+	For   string `xml:"for,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// encodeGEXF writes the graph in GEXF 1.3, the native format of Gephi.
+func encodeGEXF(w io.Writer, graph domain.TelegramInteractionGraph) error {
+	document := gexfDocument{
+		XMLNS:   "http://gexf.net/1.3",
+		Version: "1.3",
+		Graph: gexfGraph{
+			DefaultEdgeType: "directed",
+			Mode:            "static",
+			Attributes: []gexfAttributes{
+				{Class: "node", Attributes: []gexfAttribute{
+					{ID: "telegram_id", Title: "telegram_id", Type: "long"},
+					{ID: "username", Title: "username", Type: "string"},
+					{ID: "depth", Title: "depth", Type: "integer"},
+				}},
+				{Class: "edge", Attributes: []gexfAttribute{
+					{ID: "first_interaction_at", Title: "first_interaction_at", Type: "string"},
+					{ID: "last_interaction_at", Title: "last_interaction_at", Type: "string"},
+				}},
+			},
+		},
+	}
+	for _, node := range graph.Nodes {
+		document.Graph.Nodes = append(document.Graph.Nodes, gexfElement{
+			ID:    graphNodeID(node.UserTelegramID),
+			Label: graphNodeLabel(node),
+			AttValues: []gexfAttValue{
+				{For: "telegram_id", Value: strconv.FormatUint(node.UserTelegramID, 10)},
+				{For: "username", Value: node.Username},
+				{For: "depth", Value: strconv.Itoa(node.Depth)},
+			},
+		})
+	}
+	for i, edge := range graph.Edges {
+		document.Graph.Edges = append(document.Graph.Edges, gexfElement{
+			ID:     "e" + strconv.Itoa(i),
+			Source: graphNodeID(edge.FromUserTelegramID),
+			Target: graphNodeID(edge.ToUserTelegramID),
+			Label:  string(edge.Type),
+			Kind:   string(edge.Type),
+			Weight: strconv.Itoa(edge.Weight),
+			AttValues: []gexfAttValue{
+				{For: "first_interaction_at", Value: edge.FirstInteractionAt.UTC().Format(time.RFC3339)},
+				{For: "last_interaction_at", Value: edge.LastInteractionAt.UTC().Format(time.RFC3339)},
+			},
+		})
+	}
+	return encodeXML(w, document)
+}
+
+func encodeXML(w io.Writer, document any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	return encoder.Encode(document)
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+)
+
+func TestGraphNodeLabel(t *testing.T) {
+	cases := map[string]struct {
+		node  domain.TelegramInteractionGraphNode
+		label string
+	}{
+		"username": {
+			node:  domain.TelegramInteractionGraphNode{UserTelegramID: 42, Username: "alice", FirstName: "Alice"},
+			label: "@alice",
+		},
+		"full name": {
+			node:  domain.TelegramInteractionGraphNode{UserTelegramID: 42, FirstName: "Alice", LastName: "Doe"},
+			label: "Alice Doe",
+		},
+		"first name": {node: domain.TelegramInteractionGraphNode{UserTelegramID: 42, FirstName: "Alice"}, label: "Alice"},
+		"unknown":    {node: domain.TelegramInteractionGraphNode{UserTelegramID: 42}, label: "42"},
+	}
+	for name, tc := range cases {
+		if label := graphNodeLabel(tc.node); label != tc.label {
+			t.Errorf("%s: expected %q, got %q", name, tc.label, label)
+		}
+	}
+}
+
+func testInteractionGraph() domain.TelegramInteractionGraph {
+	at := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	return domain.TelegramInteractionGraph{
+		Nodes: []domain.TelegramInteractionGraphNode{
+			{UserTelegramID: 42, Username: "alice"},
+			{UserTelegramID: 43, FirstName: "Bob", Depth: 1},
+		},
+		Edges: []domain.TelegramInteraction{
+			{
+				FromUserTelegramID: 43,
+				ToUserTelegramID:   42,
+				Type:               domain.TelegramInteractionTypeReply,
+				Weight:             2,
+				FirstInteractionAt: at,
+				LastInteractionAt:  at.Add(time.Hour),
+			},
+			{
+				FromUserTelegramID: 42,
+				ToUserTelegramID:   43,
+				Type:               domain.TelegramInteractionTypeSharedChat,
+				Weight:             1,
+				FirstInteractionAt: at,
+				LastInteractionAt:  at,
+			},
+		},
+	}
+}
+
+func TestEncodeGraphML(t *testing.T) {
+	var buf bytes.Buffer
+	if err := encodeGraphML(&buf, testInteractionGraph()); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	var document graphMLDocument
+	if err := xml.Unmarshal(buf.Bytes(), &document); err != nil {
+		t.Fatalf("expected valid XML, got %v", err)
+	}
+	graph := document.Graph
+	if len(graph.Nodes) != 2 || graph.Nodes[0].ID != "u42" || graph.Nodes[1].Data[0].Value != "Bob" {
+		t.Errorf("unexpected nodes %+v", graph.Nodes)
+	}
+	if len(graph.Edges) != 2 {
+		t.Fatalf("expected 2 edges, got %+v", graph.Edges)
+	}
+	reply := graph.Edges[0]
+	if reply.Source != "u43" || reply.Target != "u42" || reply.Data[0].Value != "reply" || reply.Data[1].Value != "2" {
+		t.Errorf("unexpected reply edge %+v", reply)
+	}
+	if reply.Data[3].Value != "2024-01-15T11:30:00Z" {
+		t.Errorf("expected the last interaction in RFC 3339, got %q", reply.Data[3].Value)
+	}
+}
+
+func TestEncodeGEXF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := encodeGEXF(&buf, testInteractionGraph()); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	var document gexfDocument
+	if err := xml.Unmarshal(buf.Bytes(), &document); err != nil {
+		t.Fatalf("expected valid XML, got %v", err)
+	}
+	graph := document.Graph
+	if len(graph.Nodes) != 2 || graph.Nodes[0].Label != "@alice" || graph.Nodes[1].AttValues[2].Value != "1" {
+		t.Errorf("unexpected nodes %+v", graph.Nodes)
+	}
+	// Parallel edges of a user pair are told apart by their kind
+	if len(graph.Edges) != 2 || graph.Edges[0].Kind != "reply" || graph.Edges[1].Kind != "shared_chat" {
+		t.Fatalf("unexpected edges %+v", graph.Edges)
+	}
+	if graph.Edges[0].Weight != "2" || graph.Edges[0].Source != "u43" || graph.Edges[0].Target != "u42" {
+		t.Errorf("unexpected reply edge %+v", graph.Edges[0])
+	}
+}
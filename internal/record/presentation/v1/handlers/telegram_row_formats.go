@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	csvexport "github.com/InWamos/trinity-proto/internal/shared/infrastructure/csv_export"
+)
+
+// Formats the rows of a result are exported in. CSV and NDJSON are streamed as the rows are read.
+const (
+	rowFormatJSON   = "json"
+	rowFormatCSV    = "csv"
+	rowFormatNDJSON = "ndjson"
+)
+
+// rowFormatContentTypes are the content types of the formats, also accepted in the Accept header.
+var rowFormatContentTypes = map[string]string{
+	rowFormatJSON:   "application/json",
+	rowFormatCSV:    "text/csv",
+	rowFormatNDJSON: "application/x-ndjson",
+}
+
+// rowStreamFlushRows is the amount of rows written between two flushes of the response.
+const rowStreamFlushRows = 1000
+
+// negotiateRowFormat prefers the format parameter over the Accept header and falls back to JSON.
+func negotiateRowFormat(r *http.Request) (string, bool) {
+	if format := r.URL.Query().Get("format"); format != "" {
+		_, known := rowFormatContentTypes[format]
+		return format, known
+	}
+	accept := r.Header.Get("Accept")
+	for _, format := range []string{rowFormatCSV, rowFormatNDJSON} {
+		if strings.Contains(accept, rowFormatContentTypes[format]) {
+			return format, true
+		}
+	}
+	return rowFormatJSON, true
+}
+
+// csvRow is a row of a streamed result, it's written as JSON in the other formats.
+type csvRow interface {
+	csvRecord() []string
+}
+
+// rowStream writes the rows of a result while they're read from the database. The status is only sent with
+// the first row or by finish, so that a failure before any row is still answered with an error status.
+// JSON is written as an object holding the rows under jsonKey. The CSV cells starting like a formula are
+// neutralised, the other formats keep the archived text as it is.
+type rowStream struct {
+	w         http.ResponseWriter
+	format    string
+	fileName  string
+	csvHeader []string
+	jsonKey   string
+	csvWriter *csvexport.Writer
+	sent      bool
+	rows      int64
+}
+
+func newRowStream(w http.ResponseWriter, format, fileName string, csvHeader []string, jsonKey string) *rowStream {
+	return &rowStream{w: w, format: format, fileName: fileName, csvHeader: csvHeader, jsonKey: jsonKey}
+}
+
+// started tells whether the status has been sent, an error can't be answered anymore then.
+func (stream *rowStream) started() bool {
+	return stream.sent
+}
+
+func (stream *rowStream) write(row csvRow) error {
+	if !stream.started() {
+		if err := stream.start(); err != nil {
+			return err
+		}
+	}
+	var err error
+	switch stream.format {
+	case rowFormatCSV:
+		err = stream.csvWriter.Write(row.csvRecord())
+	case rowFormatJSON:
+		if stream.rows > 0 {
+			if _, err = io.WriteString(stream.w, ","); err != nil {
+				return err
+			}
+		}
+		err = json.NewEncoder(stream.w).Encode(row)
+	default:
+		err = json.NewEncoder(stream.w).Encode(row)
+	}
+	if err != nil {
+		return err
+	}
+	stream.rows++
+	if stream.rows%rowStreamFlushRows == 0 {
+		return stream.flush()
+	}
+	return nil
+}
+
+func (stream *rowStream) start() error {
+	contentType := rowFormatContentTypes[stream.format]
+	if stream.format == rowFormatCSV {
+		contentType += "; charset=utf-8"
+	}
+	stream.w.Header().Set("Content-Type", contentType)
+	if stream.format != rowFormatJSON {
+		stream.w.Header().Set(
+			"Content-Disposition",
+			mime.FormatMediaType("attachment", map[string]string{"filename": stream.fileName + "." + stream.format}),
+		)
+	}
+	stream.w.WriteHeader(http.StatusOK)
+	stream.sent = true
+	switch stream.format {
+	case rowFormatCSV:
+		stream.csvWriter = csvexport.NewWriter(stream.w)
+		return stream.csvWriter.Write(stream.csvHeader)
+	case rowFormatJSON:
+		key, _ := json.Marshal(stream.jsonKey)
+		_, err := io.WriteString(stream.w, "{"+string(key)+":[")
+		return err
+	}
+	return nil
+}
+
+// finish sends the status of an empty result and the rows left.
+func (stream *rowStream) finish() error {
+	if !stream.started() {
+		if err := stream.start(); err != nil {
+			return err
+		}
+	}
+	if stream.format == rowFormatJSON {
+		if _, err := io.WriteString(stream.w, "]}\n"); err != nil {
+			return err
+		}
+	}
+	return stream.flush()
+}
+
+func (stream *rowStream) flush() error {
+	if stream.csvWriter != nil {
+		stream.csvWriter.Flush()
+		if err := stream.csvWriter.Error(); err != nil {
+			return err
+		}
+	}
+	return http.NewResponseController(stream.w).Flush()
+}
+
+// abortRowStream drops the connection of a stream failing after its status has been sent, so that the client
+// sees the result as truncated rather than complete.
+func abortRowStream() {
+	panic(http.ErrAbortHandler)
+}
+
+// telegramRecordCSVHeader names the columns of the records exported as CSV, the entities are kept as JSON.
+var telegramRecordCSVHeader = []string{
+	"record_id", "message_telegram_id", "from_user_id", "in_telegram_chat_id", "message_text", "entities",
+	"caption", "caption_entities", "posted_at", "edited_at", "deleted_at", "reply_to_message_telegram_id",
+	"thread_telegram_id", "forward_from_user_telegram_id", "forward_from_chat_telegram_id",
+	"forward_from_message_telegram_id", "forward_posted_at",
+}
+
+func (record TelegramRecordResponse) csvRecord() []string {
+	csvRecord := []string{
+		record.RecordID,
+		strconv.FormatUint(record.MessageTelegramID, 10),
+		record.FromUserID,
+		strconv.FormatInt(record.InTelegramChatID, 10),
+		record.MessageText,
+		formatCSVEntities(record.Entities),
+		record.Caption,
+		formatCSVEntities(record.CaptionEntities),
+		formatCSVTime(&record.PostedAt),
+		formatCSVTime(record.EditedAt),
+		formatCSVTime(record.DeletedAt),
+		formatCSVUint(record.ReplyToMessageTelegramID),
+		formatCSVUint(record.ThreadTelegramID),
+		"", "", "", "",
+	}
+	if origin := record.ForwardOrigin; origin != nil {
+		csvRecord[13] = formatCSVUint(origin.FromUserTelegramID)
+		if origin.FromChatTelegramID != nil {
+			csvRecord[14] = strconv.FormatInt(*origin.FromChatTelegramID, 10)
+		}
+		csvRecord[15] = formatCSVUint(origin.MessageTelegramID)
+		csvRecord[16] = formatCSVTime(origin.PostedAt)
+	}
+	return csvRecord
+}
+
+func formatCSVEntities(entities []TelegramMessageEntity) string {
+	if len(entities) == 0 {
+		return ""
+	}
+	data, _ := json.Marshal(entities)
+	return string(data)
+}
+
+func formatCSVTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func formatCSVUint(value *uint64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatUint(*value, 10)
+}
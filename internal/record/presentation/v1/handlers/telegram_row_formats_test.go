@@ -0,0 +1,102 @@
+package handlers //nolint:testpackage // the row formats are unexported
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNegotiateRowFormat(t *testing.T) {
+	for _, test := range []struct {
+		query    string
+		accept   string
+		expected string
+		known    bool
+	}{
+		{"", "", rowFormatJSON, true},
+		{"", "text/csv", rowFormatCSV, true},
+		{"", "application/x-ndjson, application/json;q=0.5", rowFormatNDJSON, true},
+		{"?format=json", "text/csv", rowFormatJSON, true},
+		{"?format=xlsx", "", "xlsx", false},
+	} {
+		r := httptest.NewRequest(http.MethodGet, "/records"+test.query, nil)
+		r.Header.Set("Accept", test.accept)
+		format, known := negotiateRowFormat(r)
+		if format != test.expected || known != test.known {
+			t.Errorf("%q %q: expected %s %t, got %s %t", test.query, test.accept, test.expected, test.known, format, known)
+		}
+	}
+}
+
+func TestRowStream(t *testing.T) {
+	rows := []TelegramRecordResponse{
+		{RecordID: "a", MessageTelegramID: 1, MessageText: "Hello, world", PostedAt: time.Unix(0, 0)},
+		{
+			RecordID:          "b",
+			MessageTelegramID: 2,
+			MessageText:       "=1+1",
+			Entities:          []TelegramMessageEntity{{Type: "bold", Length: 1}},
+		},
+	}
+	for _, format := range []string{rowFormatJSON, rowFormatCSV, rowFormatNDJSON} {
+		recorder := httptest.NewRecorder()
+		stream := newRowStream(recorder, format, "records", telegramRecordCSVHeader, "records")
+		for _, row := range rows {
+			if err := stream.write(row); err != nil {
+				t.Fatalf("%s: failed to write the row: %v", format, err)
+			}
+		}
+		if err := stream.finish(); err != nil {
+			t.Fatalf("%s: failed to finish the stream: %v", format, err)
+		}
+		if !strings.HasPrefix(recorder.Header().Get("Content-Type"), rowFormatContentTypes[format]) {
+			t.Errorf("%s: unexpected content type %q", format, recorder.Header().Get("Content-Type"))
+		}
+
+		body := recorder.Body.String()
+		switch format {
+		case rowFormatJSON:
+			var response GetTelegramRecordsByIndicatorResponse
+			if err := json.Unmarshal([]byte(body), &response); err != nil || len(response.Records) != 2 {
+				t.Errorf("json: expected 2 records, got %q (%v)", body, err)
+			}
+		case rowFormatCSV:
+			records, err := csv.NewReader(strings.NewReader(body)).ReadAll()
+			if err != nil || len(records) != 3 || records[1][4] != "Hello, world" || records[2][5] == "" {
+				t.Fatalf("csv: unexpected rows %q (%v)", records, err)
+			}
+			// The spreadsheet the export is opened in doesn't evaluate the text
+			if records[2][4] != "'=1+1" {
+				t.Errorf("csv: expected the formula to be neutralised, got %q", records[2][4])
+			}
+			if !strings.Contains(recorder.Header().Get("Content-Disposition"), `filename=records.csv`) {
+				t.Errorf("csv: unexpected disposition %q", recorder.Header().Get("Content-Disposition"))
+			}
+		case rowFormatNDJSON:
+			lines := strings.Split(strings.TrimSpace(body), "\n")
+			var row TelegramRecordResponse
+			if len(lines) != 2 || json.Unmarshal([]byte(lines[1]), &row) != nil || row.RecordID != "b" ||
+				row.MessageText != "=1+1" {
+				t.Errorf("ndjson: unexpected lines %q", lines)
+			}
+		}
+	}
+}
+
+func TestRowStreamEmpty(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	stream := newRowStream(recorder, rowFormatJSON, "identities", telegramIdentityCSVHeader, "identities")
+	if stream.started() {
+		t.Error("expected the stream not to be started before any row")
+	}
+	if err := stream.finish(); err != nil {
+		t.Fatalf("failed to finish the stream: %v", err)
+	}
+	if body := recorder.Body.String(); body != "{\"identities\":[]}\n" || recorder.Code != http.StatusOK {
+		t.Errorf("expected an empty list, got %d %q", recorder.Code, body)
+	}
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	application "github.com/InWamos/trinity-proto/internal/record/application/telegram/chain"
+	domain "github.com/InWamos/trinity-proto/internal/record/domain/telegram"
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/google/uuid"
+)
+
+// VerifyTelegramChainResponse represents the response from the VerifyTelegramChain endpoint.
+// Intact is set when no break has been found.
+type VerifyTelegramChainResponse struct {
+	Intact              bool                         `json:"intact"               example:"false"`
+	ChainsVerified      int                          `json:"chains_verified"      example:"3"`
+	EntriesVerified     int                          `json:"entries_verified"     example:"12500"`
+	CheckpointsVerified int                          `json:"checkpoints_verified" example:"48"`
+	SignaturesVerified  bool                         `json:"signatures_verified"  example:"true"`
+	Breaks              []TelegramChainBreakResponse `json:"breaks"`
+	BreaksTruncated     bool                         `json:"breaks_truncated"     example:"false"`
+	Unchained           map[string]int64             `json:"unchained"`
+}
+
+// TelegramChainBreakResponse is a single finding, the subject is only set for the breaks about a subject.
+type TelegramChainBreakResponse struct {
+	CollectorUserID uuid.UUID  `json:"collector_user_id"`
+	Sequence        int64      `json:"sequence"               example:"42"`
+	SubjectType     string     `json:"subject_type,omitempty" example:"record"`
+	SubjectID       *uuid.UUID `json:"subject_id,omitempty"`
+	Reason          string     `json:"reason"                 example:"content_mismatch"`
+}
+
+type VerifyTelegramChainHandler struct {
+	interactor *application.VerifyTelegramChain
+	logger     *slog.Logger
+}
+
+func NewVerifyTelegramChainHandler(
+	interactor *application.VerifyTelegramChain,
+	logger *slog.Logger,
+) *VerifyTelegramChainHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "verify_telegram_chain_handler"),
+	)
+
+	return &VerifyTelegramChainHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to verify the hash chains over the archived data.
+//
+//	@Summary		Verify the telegram chains
+//	@Description	Walk the hash chains linking the archived records, identities and attachments of every collector
+//	@Description	and report where they break: a removed, inserted or altered entry, a subject altered or removed
+//	@Description	after its latest entry, or a chain differing from its signed checkpoints. The subjects archived
+//	@Description	before the chains were introduced are counted as unchained. Admin only.
+//	@Tags			record
+//	@Produce		json
+//	@Param			collector	query		string						false	"Verify the chain of this user only"	format(uuid)
+//	@Success		200			{object}	VerifyTelegramChainResponse	"Chains verified, see intact"
+//	@Failure		400			"Invalid collector ID"
+//	@Failure		403			"Insufficient privileges"
+//	@Failure		404			"No chain for this collector"
+//	@Failure		500			"Internal server error"
+//	@Router			/v1/record/telegram/chain/verification [get]
+func (handler *VerifyTelegramChainHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	requestDTO := application.VerifyTelegramChainRequest{}
+	if rawCollector := r.URL.Query().Get("collector"); rawCollector != "" {
+		collectorUserID, err := uuid.Parse(rawCollector)
+		if err != nil {
+			handler.logger.DebugContext(r.Context(), "invalid collector ID format", slog.Any("err", err))
+			http.Error(w, "Invalid collector ID format", http.StatusBadRequest)
+			return
+		}
+		requestDTO.CollectorUserID = collectorUserID
+	}
+
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrTelegramChainNotFound):
+			http.Error(w, "No chain for this collector", http.StatusNotFound)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := VerifyTelegramChainResponse{
+		Intact:              len(resp.Breaks) == 0,
+		ChainsVerified:      resp.Progress.ChainsVerified,
+		EntriesVerified:     resp.Progress.EntriesVerified,
+		CheckpointsVerified: resp.CheckpointsVerified,
+		SignaturesVerified:  resp.SignaturesVerified,
+		Breaks:              make([]TelegramChainBreakResponse, len(resp.Breaks)),
+		BreaksTruncated:     resp.BreaksTruncated,
+		Unchained:           make(map[string]int64, len(resp.Unchained)),
+	}
+	for i, chainBreak := range resp.Breaks {
+		response.Breaks[i] = TelegramChainBreakResponse{
+			CollectorUserID: chainBreak.CollectorUserID,
+			Sequence:        chainBreak.Sequence,
+			SubjectType:     string(chainBreak.SubjectType),
+			Reason:          string(chainBreak.Reason),
+		}
+		if chainBreak.SubjectID != uuid.Nil {
+			response.Breaks[i].SubjectID = &chainBreak.SubjectID
+		}
+	}
+	for subjectType, count := range resp.Unchained {
+		response.Unchained[string(subjectType)] = count
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"github.com/InWamos/trinity-proto/internal/record/presentation/v1/handlers"
+	"github.com/go-chi/chi/v5"
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+)
+
+// InvestigationMuxV1 serves the investigations of the current user.
+type InvestigationMuxV1 struct {
+	mux *chi.Mux
+}
+
+func NewInvestigationMuxV1(
+	addTelegramInvestigation *handlers.AddTelegramInvestigationHandler,
+	getTelegramInvestigations *handlers.GetTelegramInvestigationsHandler,
+	getTelegramInvestigation *handlers.GetTelegramInvestigationHandler,
+	removeTelegramInvestigation *handlers.RemoveTelegramInvestigationHandler,
+	saveTelegramInvestigationMember *handlers.SaveTelegramInvestigationMemberHandler,
+	removeTelegramInvestigationMember *handlers.RemoveTelegramInvestigationMemberHandler,
+	addTelegramInvestigationItem *handlers.AddTelegramInvestigationItemHandler,
+	removeTelegramInvestigationItem *handlers.RemoveTelegramInvestigationItemHandler,
+) *InvestigationMuxV1 {
+	mux := chi.NewRouter()
+	mux.Use(chiMiddleware.AllowContentType("application/json"))
+	mux.Post("/", addTelegramInvestigation.ServeHTTP)
+	mux.Get("/", getTelegramInvestigations.ServeHTTP)
+	mux.Get("/{investigation_id}", getTelegramInvestigation.ServeHTTP)
+	mux.Delete("/{investigation_id}", removeTelegramInvestigation.ServeHTTP)
+	mux.Put("/{investigation_id}/members/{user_id}", saveTelegramInvestigationMember.ServeHTTP)
+	mux.Delete("/{investigation_id}/members/{user_id}", removeTelegramInvestigationMember.ServeHTTP)
+	mux.Post("/{investigation_id}/items", addTelegramInvestigationItem.ServeHTTP)
+	mux.Delete("/{investigation_id}/items/{item_id}", removeTelegramInvestigationItem.ServeHTTP)
+	return &InvestigationMuxV1{
+		mux: mux,
+	}
+}
+
+func (im *InvestigationMuxV1) GetMux() *chi.Mux {
+	return im.mux
+}
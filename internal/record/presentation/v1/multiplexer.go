@@ -3,6 +3,7 @@ package v1
 import (
 	"github.com/InWamos/trinity-proto/internal/record/presentation/v1/handlers"
 	"github.com/go-chi/chi/v5"
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
 )
 
 type RecordMuxV1 struct {
@@ -14,12 +15,76 @@ func NewRecordMuxV1(
 	addTelegramUser *handlers.AddTelegramUserHandler,
 	addTelegramIdentity *handlers.AddTelegramIdentityHandler,
 	addTelegramRecord *handlers.AddTelegramRecordHandler,
+	addTelegramRecordsBatch *handlers.AddTelegramRecordsBatchHandler,
+	getTelegramRecordHistory *handlers.GetTelegramRecordHistoryHandler,
+	getTelegramRecordThread *handlers.GetTelegramRecordThreadHandler,
+	getTelegramRecordsForwardedFromUser *handlers.GetTelegramRecordsForwardedFromUserHandler,
+	getTelegramRecordsForwardedFromChat *handlers.GetTelegramRecordsForwardedFromChatHandler,
+	markTelegramRecordDeleted *handlers.MarkTelegramRecordDeletedHandler,
+	addTelegramChat *handlers.AddTelegramChatHandler,
+	getTelegramChat *handlers.GetTelegramChatHandler,
+	addTelegramAttachment *handlers.AddTelegramAttachmentHandler,
+	getTelegramAttachments *handlers.GetTelegramAttachmentsHandler,
+	downloadTelegramAttachment *handlers.DownloadTelegramAttachmentHandler,
+	addTelegramProfilePicture *handlers.AddTelegramProfilePictureHandler,
+	getTelegramProfilePictures *handlers.GetTelegramProfilePicturesHandler,
+	downloadTelegramProfilePicture *handlers.DownloadTelegramProfilePictureHandler,
+	importTelegramExport *handlers.ImportTelegramExportHandler,
+	ingestTelegramStream *handlers.IngestTelegramStreamHandler,
+	getTelegramUserIndicators *handlers.GetTelegramUserIndicatorsHandler,
+	getTelegramRecordsByIndicator *handlers.GetTelegramRecordsByIndicatorHandler,
+	getTelegramInteractionGraph *handlers.GetTelegramInteractionGraphHandler,
+	getTelegramCorrelationClusters *handlers.GetTelegramCorrelationClustersHandler,
+	getTelegramUserActivity *handlers.GetTelegramUserActivityHandler,
+	getTelegramChatActivity *handlers.GetTelegramChatActivityHandler,
+	verifyTelegramChain *handlers.VerifyTelegramChainHandler,
+	getTelegramIdentityHistory *handlers.GetTelegramIdentityHistoryHandler,
 ) *RecordMuxV1 {
 	mux := chi.NewRouter()
-	mux.Get("/telegram/{telegram_id}/records", getLatestTelegramRecordsByTelegramID.ServeHTTP)
-	mux.Post("/telegram/identity", addTelegramIdentity.ServeHTTP)
-	mux.Post("/telegram/user", addTelegramUser.ServeHTTP)
-	mux.Post("/telegram/record", addTelegramRecord.ServeHTTP)
+	mux.Group(func(r chi.Router) {
+		// Only allow json content type
+		r.Use(chiMiddleware.AllowContentType("application/json"))
+		r.Get("/telegram/{telegram_id}/records", getLatestTelegramRecordsByTelegramID.ServeHTTP)
+		r.Post("/telegram/identity", addTelegramIdentity.ServeHTTP)
+		r.Post("/telegram/user", addTelegramUser.ServeHTTP)
+		r.Post("/telegram/record", addTelegramRecord.ServeHTTP)
+		r.Post("/telegram/records:batch", addTelegramRecordsBatch.ServeHTTP)
+		r.Get("/telegram/record/{record_id}/history", getTelegramRecordHistory.ServeHTTP)
+		r.Get("/telegram/record/{record_id}/thread", getTelegramRecordThread.ServeHTTP)
+		r.Get("/telegram/user/{telegram_id}/forwards", getTelegramRecordsForwardedFromUser.ServeHTTP)
+		r.Get("/telegram/chat/{chat_telegram_id}/forwards", getTelegramRecordsForwardedFromChat.ServeHTTP)
+		r.Post("/telegram/record/{record_id}/deletion", markTelegramRecordDeleted.ServeHTTP)
+		r.Post("/telegram/chat", addTelegramChat.ServeHTTP)
+		r.Get("/telegram/chat/{chat_telegram_id}", getTelegramChat.ServeHTTP)
+		r.Get("/telegram/record/{record_id}/attachments", getTelegramAttachments.ServeHTTP)
+		r.Get("/telegram/attachment/{attachment_id}", downloadTelegramAttachment.ServeHTTP)
+		r.Get("/telegram/user/{telegram_id}/profile_pictures", getTelegramProfilePictures.ServeHTTP)
+		r.Get("/telegram/user/{telegram_id}/identities", getTelegramIdentityHistory.ServeHTTP)
+		r.Get("/telegram/profile_picture/{profile_picture_id}", downloadTelegramProfilePicture.ServeHTTP)
+		r.Get("/telegram/user/{telegram_id}/indicators", getTelegramUserIndicators.ServeHTTP)
+		r.Get("/telegram/indicators/records", getTelegramRecordsByIndicator.ServeHTTP)
+		r.Get("/telegram/{telegram_id}/graph", getTelegramInteractionGraph.ServeHTTP)
+		r.Get("/telegram/correlations", getTelegramCorrelationClusters.ServeHTTP)
+		r.Get("/telegram/user/{telegram_id}/activity", getTelegramUserActivity.ServeHTTP)
+		r.Get("/telegram/chat/{chat_telegram_id}/activity", getTelegramChatActivity.ServeHTTP)
+		r.Get("/telegram/chain/verification", verifyTelegramChain.ServeHTTP)
+	})
+	mux.Group(func(r chi.Router) {
+		// File uploads are streamed as multipart forms
+		r.Use(chiMiddleware.AllowContentType("multipart/form-data"))
+		r.Post("/telegram/record/{record_id}/attachment", addTelegramAttachment.ServeHTTP)
+		r.Post("/telegram/user/{telegram_id}/profile_picture", addTelegramProfilePicture.ServeHTTP)
+	})
+	mux.Group(func(r chi.Router) {
+		// Exports come either as a bare result.json or as a zipped export folder
+		r.Use(chiMiddleware.AllowContentType("application/json", "application/zip"))
+		r.Post("/telegram/chat/import", importTelegramExport.ServeHTTP)
+	})
+	mux.Group(func(r chi.Router) {
+		// High-volume collectors stream newline delimited JSON
+		r.Use(chiMiddleware.AllowContentType("application/x-ndjson"))
+		r.Post("/telegram/ingest", ingestTelegramStream.ServeHTTP)
+	})
 	return &RecordMuxV1{
 		mux: mux,
 	}
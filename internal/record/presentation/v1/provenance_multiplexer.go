@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"github.com/InWamos/trinity-proto/internal/record/presentation/v1/handlers"
+	"github.com/go-chi/chi/v5"
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+)
+
+// ProvenanceMuxV1 serves the collectors who have archived the telegram users and records.
+type ProvenanceMuxV1 struct {
+	mux *chi.Mux
+}
+
+func NewProvenanceMuxV1(
+	getTelegramProvenance *handlers.GetTelegramProvenanceHandler,
+	setTelegramObservationVisibility *handlers.SetTelegramObservationVisibilityHandler,
+) *ProvenanceMuxV1 {
+	mux := chi.NewRouter()
+	mux.Use(chiMiddleware.AllowContentType("application/json"))
+	mux.Get("/{target_type}/{target_id}", getTelegramProvenance.ServeHTTP)
+	mux.Put("/{target_type}/{target_id}/visibility", setTelegramObservationVisibility.ServeHTTP)
+	return &ProvenanceMuxV1{
+		mux: mux,
+	}
+}
+
+func (pm *ProvenanceMuxV1) GetMux() *chi.Mux {
+	return pm.mux
+}
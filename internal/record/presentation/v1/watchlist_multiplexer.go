@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"github.com/InWamos/trinity-proto/internal/record/presentation/v1/handlers"
+	"github.com/go-chi/chi/v5"
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+)
+
+// WatchlistMuxV1 serves the watchlists of the current user.
+type WatchlistMuxV1 struct {
+	mux *chi.Mux
+}
+
+func NewWatchlistMuxV1(
+	addTelegramWatchlist *handlers.AddTelegramWatchlistHandler,
+	getTelegramWatchlists *handlers.GetTelegramWatchlistsHandler,
+	removeTelegramWatchlist *handlers.RemoveTelegramWatchlistHandler,
+	addTelegramWatchlistEntry *handlers.AddTelegramWatchlistEntryHandler,
+	removeTelegramWatchlistEntry *handlers.RemoveTelegramWatchlistEntryHandler,
+) *WatchlistMuxV1 {
+	mux := chi.NewRouter()
+	mux.Use(chiMiddleware.AllowContentType("application/json"))
+	mux.Post("/", addTelegramWatchlist.ServeHTTP)
+	mux.Get("/", getTelegramWatchlists.ServeHTTP)
+	mux.Delete("/{watchlist_id}", removeTelegramWatchlist.ServeHTTP)
+	mux.Post("/{watchlist_id}/entries", addTelegramWatchlistEntry.ServeHTTP)
+	mux.Delete("/{watchlist_id}/entries/{entry_id}", removeTelegramWatchlistEntry.ServeHTTP)
+	return &WatchlistMuxV1{
+		mux: mux,
+	}
+}
+
+func (wm *WatchlistMuxV1) GetMux() *chi.Mux {
+	return wm.mux
+}
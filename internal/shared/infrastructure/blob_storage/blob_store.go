@@ -0,0 +1,35 @@
+package blobstorage
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/config"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+)
+
+var (
+	ErrUnknownBackend = errors.New("unknown blob storage backend")
+	ErrInvalidKey     = errors.New("invalid blob key")
+)
+
+// NewBlobStore picks the blob storage backend configured by STORAGE_BACKEND.
+func NewBlobStore(storageConfig *config.StorageConfig, logger *slog.Logger) (interfaces.BlobStore, error) {
+	switch storageConfig.Backend {
+	case "local", "":
+		return NewLocalBlobStore(storageConfig.LocalPath, logger)
+	case "s3":
+		return NewS3BlobStore(
+			storageConfig.S3Endpoint,
+			storageConfig.S3Region,
+			storageConfig.S3Bucket,
+			storageConfig.S3AccessKey,
+			storageConfig.S3SecretKey,
+			storageConfig.S3RequestTimeout,
+			logger,
+		)
+	default:
+		logger.Error("unknown blob storage backend", slog.String("backend", storageConfig.Backend))
+		return nil, ErrUnknownBackend
+	}
+}
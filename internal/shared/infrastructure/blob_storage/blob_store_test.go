@@ -0,0 +1,215 @@
+package blobstorage_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	blobstorage "github.com/InWamos/trinity-proto/internal/shared/infrastructure/blob_storage"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+)
+
+// fakeS3 is a minimal in-memory stand-in for MinIO serving path-style object requests.
+type fakeS3 struct {
+	mu      sync.Mutex
+	bucket  string
+	objects map[string][]byte
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=minio/") ||
+		!strings.Contains(auth, "SignedHeaders=host;x-amz-content-sha256;x-amz-date") ||
+		r.Header.Get("X-Amz-Date") == "" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	prefix := "/" + s.bucket + "/"
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, prefix)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		s.objects[key] = body
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet, http.MethodHead:
+		body, ok := s.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(body)
+		}
+	case http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newStores(t *testing.T) map[string]interfaces.BlobStore {
+	t.Helper()
+	logger := slog.New(slog.DiscardHandler)
+
+	local, err := blobstorage.NewLocalBlobStore(t.TempDir(), logger)
+	if err != nil {
+		t.Fatalf("failed to create local store: %v", err)
+	}
+
+	server := httptest.NewServer(&fakeS3{bucket: "trinity", objects: map[string][]byte{}})
+	t.Cleanup(server.Close)
+	s3, err := blobstorage.NewS3BlobStore(server.URL, "", "trinity", "minio", "minio123", time.Minute, logger)
+	if err != nil {
+		t.Fatalf("failed to create s3 store: %v", err)
+	}
+
+	return map[string]interfaces.BlobStore{"local": local, "s3": s3}
+}
+
+func TestBlobStoreRoundTrip(t *testing.T) {
+	for name, store := range newStores(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			key := "sha256/ab/cd/abcdef"
+			content := []byte("attachment content")
+
+			exists, err := store.Exists(ctx, key)
+			if err != nil || exists {
+				t.Fatalf("expected missing blob, got exists=%v err=%v", exists, err)
+			}
+
+			err = store.Put(ctx, key, bytes.NewReader(content), int64(len(content)), "text/plain")
+			if err != nil {
+				t.Fatalf("put failed: %v", err)
+			}
+
+			exists, err = store.Exists(ctx, key)
+			if err != nil || !exists {
+				t.Fatalf("expected existing blob, got exists=%v err=%v", exists, err)
+			}
+
+			reader, err := store.Get(ctx, key)
+			if err != nil {
+				t.Fatalf("get failed: %v", err)
+			}
+			got, err := io.ReadAll(reader)
+			reader.Close()
+			if err != nil {
+				t.Fatalf("read failed: %v", err)
+			}
+			if !bytes.Equal(got, content) {
+				t.Errorf("expected %q, got %q", content, got)
+			}
+
+			if err = store.Delete(ctx, key); err != nil {
+				t.Fatalf("delete failed: %v", err)
+			}
+			if _, err = store.Get(ctx, key); !errors.Is(err, interfaces.ErrBlobNotFound) {
+				t.Errorf("expected ErrBlobNotFound after delete, got %v", err)
+			}
+		})
+	}
+}
+
+func TestBlobStoreRejectsInvalidKeys(t *testing.T) {
+	for name, store := range newStores(t) {
+		t.Run(name, func(t *testing.T) {
+			for _, key := range []string{"", "/etc/passwd"} {
+				err := store.Put(context.Background(), key, strings.NewReader("x"), 1, "")
+				if !errors.Is(err, blobstorage.ErrInvalidKey) {
+					t.Errorf("key %q: expected ErrInvalidKey, got %v", key, err)
+				}
+			}
+		})
+	}
+
+	local, _ := blobstorage.NewLocalBlobStore(t.TempDir(), slog.New(slog.DiscardHandler))
+	if _, err := local.Get(context.Background(), "../outside"); !errors.Is(err, blobstorage.ErrInvalidKey) {
+		t.Errorf("expected ErrInvalidKey for escaping key, got %v", err)
+	}
+}
+
+func TestSpoolBlob(t *testing.T) {
+	png := append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 64)...)
+	tests := []struct {
+		name         string
+		content      []byte
+		maxSize      int64
+		expectedErr  error
+		expectedMime string
+	}{
+		{name: "Sniffs PNG", content: png, maxSize: 1024, expectedMime: "image/png"},
+		{name: "Sniffs text", content: []byte("hello"), maxSize: 5, expectedMime: "text/plain; charset=utf-8"},
+		{name: "Too large", content: []byte("hello"), maxSize: 4, expectedErr: blobstorage.ErrBlobTooLarge},
+		{name: "Empty", content: []byte{}, maxSize: 4, expectedErr: blobstorage.ErrBlobEmpty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blob, err := blobstorage.SpoolBlob(bytes.NewReader(tt.content), tt.maxSize)
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Fatalf("expected %v, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer blob.Close()
+
+			if blob.MimeType != tt.expectedMime {
+				t.Errorf("expected mime %q, got %q", tt.expectedMime, blob.MimeType)
+			}
+			if blob.Size != int64(len(tt.content)) {
+				t.Errorf("expected size %d, got %d", len(tt.content), blob.Size)
+			}
+			if len(blob.SHA256) != 64 || !strings.HasSuffix(blob.StorageKey(), blob.SHA256) {
+				t.Errorf("unexpected hash %q or key %q", blob.SHA256, blob.StorageKey())
+			}
+		})
+	}
+}
+
+func TestSpooledBlobStoreDeduplicates(t *testing.T) {
+	store, err := blobstorage.NewLocalBlobStore(t.TempDir(), slog.New(slog.DiscardHandler))
+	if err != nil {
+		t.Fatalf("failed to create local store: %v", err)
+	}
+	ctx := context.Background()
+
+	for range 2 {
+		blob, spoolErr := blobstorage.SpoolBlob(strings.NewReader("same content"), 1024)
+		if spoolErr != nil {
+			t.Fatalf("spool failed: %v", spoolErr)
+		}
+		if err = blob.Store(ctx, store); err != nil {
+			t.Fatalf("store failed: %v", err)
+		}
+		blob.Close()
+	}
+
+	first, _ := blobstorage.SpoolBlob(strings.NewReader("same content"), 1024)
+	defer first.Close()
+	reader, err := store.Get(ctx, first.StorageKey())
+	if err != nil {
+		t.Fatalf("expected deduplicated blob to exist: %v", err)
+	}
+	reader.Close()
+}
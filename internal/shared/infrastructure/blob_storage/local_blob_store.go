@@ -0,0 +1,123 @@
+package blobstorage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+)
+
+// LocalBlobStore keeps blobs as plain files under the root directory.
+type LocalBlobStore struct {
+	root   string
+	logger *slog.Logger
+}
+
+func NewLocalBlobStore(root string, logger *slog.Logger) (*LocalBlobStore, error) {
+	lbsLogger := logger.With(slog.String("component", "local_blob_store"))
+	if root == "" {
+		root = "data/blobs"
+	}
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		lbsLogger.Error("failed to create blob storage directory", slog.String("root", root), slog.Any("err", err))
+		return nil, err
+	}
+	return &LocalBlobStore{root: root, logger: lbsLogger}, nil
+}
+
+func (store *LocalBlobStore) Put(
+	ctx context.Context,
+	key string,
+	content io.Reader,
+	_ int64,
+	_ string,
+) error {
+	path, err := store.path(key)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		store.logger.ErrorContext(ctx, "failed to create blob directory", slog.Any("err", err))
+		return err
+	}
+	// Write to a temporary file first so readers never see a partially written blob
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		store.logger.ErrorContext(ctx, "failed to create temporary blob file", slog.Any("err", err))
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = io.Copy(tmp, content); err != nil {
+		_ = tmp.Close()
+		store.logger.ErrorContext(ctx, "failed to write blob", slog.String("key", key), slog.Any("err", err))
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		store.logger.ErrorContext(ctx, "failed to move blob in place", slog.String("key", key), slog.Any("err", err))
+		return err
+	}
+	return nil
+}
+
+func (store *LocalBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := store.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, interfaces.ErrBlobNotFound
+		}
+		store.logger.ErrorContext(ctx, "failed to open blob", slog.String("key", key), slog.Any("err", err))
+		return nil, err
+	}
+	return file, nil
+}
+
+func (store *LocalBlobStore) Exists(ctx context.Context, key string) (bool, error) {
+	path, err := store.path(key)
+	if err != nil {
+		return false, err
+	}
+	if _, err = os.Stat(path); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		store.logger.ErrorContext(ctx, "failed to stat blob", slog.String("key", key), slog.Any("err", err))
+		return false, err
+	}
+	return true, nil
+}
+
+func (store *LocalBlobStore) Delete(ctx context.Context, key string) error {
+	path, err := store.path(key)
+	if err != nil {
+		return err
+	}
+	if err = os.Remove(path); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return interfaces.ErrBlobNotFound
+		}
+		store.logger.ErrorContext(ctx, "failed to delete blob", slog.String("key", key), slog.Any("err", err))
+		return err
+	}
+	return nil
+}
+
+// path resolves the key inside of the root, refusing keys escaping it.
+func (store *LocalBlobStore) path(key string) (string, error) {
+	localKey := filepath.FromSlash(key)
+	if key == "" || !filepath.IsLocal(localKey) {
+		return "", ErrInvalidKey
+	}
+	return filepath.Join(store.root, localKey), nil
+}
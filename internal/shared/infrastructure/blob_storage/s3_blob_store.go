@@ -0,0 +1,265 @@
+package blobstorage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+)
+
+const (
+	s3Service          = "s3"
+	s3SigningAlgorithm = "AWS4-HMAC-SHA256"
+	s3UnsignedPayload  = "UNSIGNED-PAYLOAD"
+	s3DateFormat       = "20060102"
+	s3DateTimeFormat   = "20060102T150405Z"
+)
+
+var ErrUnexpectedS3Response = errors.New("unexpected response from S3 storage")
+
+// S3BlobStore talks to any S3-compatible storage (AWS, MinIO, Ceph) using path-style requests
+// signed with AWS Signature Version 4.
+type S3BlobStore struct {
+	endpoint  *url.URL
+	region    string
+	bucket    string
+	accessKey string
+	secretKey string
+	client    *http.Client
+	now       func() time.Time
+	logger    *slog.Logger
+}
+
+func NewS3BlobStore(
+	endpoint string,
+	region string,
+	bucket string,
+	accessKey string,
+	secretKey string,
+	requestTimeout time.Duration,
+	logger *slog.Logger,
+) (*S3BlobStore, error) {
+	sbsLogger := logger.With(slog.String("component", "s3_blob_store"))
+	parsedEndpoint, err := url.Parse(endpoint)
+	if err != nil || parsedEndpoint.Scheme == "" || parsedEndpoint.Host == "" {
+		sbsLogger.Error("invalid S3 endpoint", slog.String("endpoint", endpoint))
+		return nil, fmt.Errorf("invalid S3 endpoint %q", endpoint)
+	}
+	if bucket == "" {
+		sbsLogger.Error("S3 bucket is not configured")
+		return nil, errors.New("S3 bucket is not configured")
+	}
+	if region == "" {
+		region = "us-east-1"
+	}
+	return &S3BlobStore{
+		endpoint:  parsedEndpoint,
+		region:    region,
+		bucket:    bucket,
+		accessKey: accessKey,
+		secretKey: secretKey,
+		client:    &http.Client{Timeout: requestTimeout},
+		now:       time.Now,
+		logger:    sbsLogger,
+	}, nil
+}
+
+func (store *S3BlobStore) Put(
+	ctx context.Context,
+	key string,
+	content io.Reader,
+	size int64,
+	contentType string,
+) error {
+	req, err := store.newRequest(ctx, http.MethodPut, key, content)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := store.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return store.unexpected(ctx, req, resp)
+	}
+	return nil
+}
+
+func (store *S3BlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := store.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := store.do(req)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, interfaces.ErrBlobNotFound
+	default:
+		defer resp.Body.Close()
+		return nil, store.unexpected(ctx, req, resp)
+	}
+}
+
+func (store *S3BlobStore) Exists(ctx context.Context, key string) (bool, error) {
+	req, err := store.newRequest(ctx, http.MethodHead, key, nil)
+	if err != nil {
+		return false, err
+	}
+	resp, err := store.do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, store.unexpected(ctx, req, resp)
+	}
+}
+
+func (store *S3BlobStore) Delete(ctx context.Context, key string) error {
+	req, err := store.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := store.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// S3 answers 204 for missing keys too, so deletion is idempotent here
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return store.unexpected(ctx, req, resp)
+	}
+	return nil
+}
+
+func (store *S3BlobStore) newRequest(
+	ctx context.Context,
+	method string,
+	key string,
+	body io.Reader,
+) (*http.Request, error) {
+	if key == "" || strings.HasPrefix(key, "/") {
+		return nil, ErrInvalidKey
+	}
+	objectURL := *store.endpoint
+	objectURL.Path = strings.TrimSuffix(objectURL.Path, "/") + "/" + store.bucket + "/" + key
+	objectURL.RawPath = encodeS3Path(objectURL.Path)
+	req, err := http.NewRequestWithContext(ctx, method, objectURL.String(), body)
+	if err != nil {
+		store.logger.ErrorContext(ctx, "failed to build S3 request", slog.Any("err", err))
+		return nil, err
+	}
+	return req, nil
+}
+
+func (store *S3BlobStore) do(req *http.Request) (*http.Response, error) {
+	store.sign(req, store.now().UTC())
+	resp, err := store.client.Do(req)
+	if err != nil {
+		store.logger.ErrorContext(
+			req.Context(),
+			"S3 request failed",
+			slog.String("method", req.Method),
+			slog.Any("err", err),
+		)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (store *S3BlobStore) unexpected(ctx context.Context, req *http.Request, resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	store.logger.ErrorContext(
+		ctx,
+		"unexpected S3 response",
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Int("status", resp.StatusCode),
+		slog.String("body", string(body)),
+	)
+	return fmt.Errorf("%w: %s %s returned %d", ErrUnexpectedS3Response, req.Method, req.URL.Path, resp.StatusCode)
+}
+
+// sign adds the AWS Signature Version 4 Authorization header to the request.
+// The payload is left unsigned so that uploads can be streamed.
+func (store *S3BlobStore) sign(req *http.Request, now time.Time) {
+	amzDate := now.Format(s3DateTimeFormat)
+	shortDate := now.Format(s3DateFormat)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", s3UnsignedPayload)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "host:" + req.URL.Host + "\n" +
+		"x-amz-content-sha256:" + s3UnsignedPayload + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.Query().Encode(),
+		canonicalHeaders,
+		signedHeaders,
+		s3UnsignedPayload,
+	}, "\n")
+
+	scope := shortDate + "/" + store.region + "/" + s3Service + "/aws4_request"
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := s3SigningAlgorithm + "\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonicalHash[:])
+
+	signingKey := hmacSHA256([]byte("AWS4"+store.secretKey), shortDate)
+	signingKey = hmacSHA256(signingKey, store.region)
+	signingKey = hmacSHA256(signingKey, s3Service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SigningAlgorithm, store.accessKey, scope, signedHeaders, signature,
+	))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// encodeS3Path percent-encodes everything but the unreserved characters and slashes, as SigV4 expects.
+func encodeS3Path(path string) string {
+	var builder strings.Builder
+	for _, b := range []byte(path) {
+		switch {
+		case 'A' <= b && b <= 'Z', 'a' <= b && b <= 'z', '0' <= b && b <= '9',
+			b == '-', b == '_', b == '.', b == '~', b == '/':
+			builder.WriteByte(b)
+		default:
+			fmt.Fprintf(&builder, "%%%02X", b)
+		}
+	}
+	return builder.String()
+}
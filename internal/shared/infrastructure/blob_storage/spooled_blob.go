@@ -0,0 +1,106 @@
+package blobstorage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+)
+
+var (
+	ErrBlobTooLarge = errors.New("blob exceeds the maximum allowed size")
+	ErrBlobEmpty    = errors.New("blob is empty")
+	// ErrBlobReadFailed means the content could not be read, e.g. the client has aborted the upload.
+	ErrBlobReadFailed = errors.New("failed to read the blob content")
+)
+
+// sniffLength is the amount of bytes http.DetectContentType looks at.
+const sniffLength = 512
+
+// SpooledBlob is an upload buffered to a temporary file, so that its hash, size
+// and type are known before it is written to the blob storage.
+type SpooledBlob struct {
+	file     *os.File
+	SHA256   string
+	Size     int64
+	MimeType string
+}
+
+// SpoolBlob copies the content to a temporary file while hashing it.
+// The MIME type is sniffed from the content, the one declared by the client is never trusted.
+// The caller must Close the returned blob.
+func SpoolBlob(content io.Reader, maxSize int64) (*SpooledBlob, error) {
+	file, err := os.CreateTemp("", "trinity-blob-*")
+	if err != nil {
+		return nil, err
+	}
+	blob := &SpooledBlob{file: file}
+
+	hash := sha256.New()
+	// Read one byte past the limit to tell a full-sized blob from an oversized one
+	written, err := io.Copy(io.MultiWriter(file, hash), io.LimitReader(content, maxSize+1))
+	if err != nil {
+		blob.Close()
+		var pathErr *os.PathError
+		if errors.As(err, &pathErr) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %w", ErrBlobReadFailed, err)
+	}
+	if written > maxSize {
+		blob.Close()
+		return nil, ErrBlobTooLarge
+	}
+	if written == 0 {
+		blob.Close()
+		return nil, ErrBlobEmpty
+	}
+
+	header := make([]byte, sniffLength)
+	n, err := file.ReadAt(header, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		blob.Close()
+		return nil, err
+	}
+
+	blob.SHA256 = hex.EncodeToString(hash.Sum(nil))
+	blob.Size = written
+	blob.MimeType = http.DetectContentType(header[:n])
+	return blob, nil
+}
+
+// StorageKey is the content-addressed key of the blob, e.g. sha256/ab/cd/abcd....
+func (blob *SpooledBlob) StorageKey() string {
+	return "sha256/" + blob.SHA256[0:2] + "/" + blob.SHA256[2:4] + "/" + blob.SHA256
+}
+
+// Store writes the blob to the storage unless identical content is already there.
+func (blob *SpooledBlob) Store(ctx context.Context, store interfaces.BlobStore) error {
+	key := blob.StorageKey()
+	exists, err := store.Exists(ctx, key)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+	if _, err = blob.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	return store.Put(ctx, key, blob.file, blob.Size, blob.MimeType)
+}
+
+// Close removes the temporary file.
+func (blob *SpooledBlob) Close() error {
+	closeErr := blob.file.Close()
+	if err := os.Remove(blob.file.Name()); err != nil {
+		return err
+	}
+	return closeErr
+}
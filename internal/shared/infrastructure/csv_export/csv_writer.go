@@ -0,0 +1,41 @@
+package csvexport
+
+import (
+	"encoding/csv"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// formulaPrefixes start the cells a spreadsheet evaluates as a formula, or as a command with DDE.
+const formulaPrefixes = "=+-@\t\r"
+
+// numberPattern matches the plain numbers, e.g. the IDs of Telegram chats and the phone numbers, which evaluate
+// to themselves.
+var numberPattern = regexp.MustCompile(`^[-+]?\d+(\.\d+)?$`)
+
+// Writer writes CSV records the way csv.Writer does, with the cells that would be evaluated
+// when the file is opened in a spreadsheet neutralised.
+type Writer struct {
+	*csv.Writer
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{Writer: csv.NewWriter(w)}
+}
+
+func (w *Writer) Write(record []string) error {
+	neutralized := make([]string, len(record))
+	for i, cell := range record {
+		neutralized[i] = NeutralizeCell(cell)
+	}
+	return w.Writer.Write(neutralized)
+}
+
+// NeutralizeCell prefixes the cell with a quote when it starts like a formula, so it's shown as text.
+func NeutralizeCell(cell string) string {
+	if cell == "" || !strings.ContainsRune(formulaPrefixes, rune(cell[0])) || numberPattern.MatchString(cell) {
+		return cell
+	}
+	return "'" + cell
+}
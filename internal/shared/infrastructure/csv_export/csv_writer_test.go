@@ -0,0 +1,49 @@
+package csvexport_test
+
+import (
+	"bytes"
+	"testing"
+
+	csvexport "github.com/InWamos/trinity-proto/internal/shared/infrastructure/csv_export"
+)
+
+func TestNeutralizeCell(t *testing.T) {
+	cases := map[string]struct {
+		cell     string
+		expected string
+	}{
+		"empty":              {cell: "", expected: ""},
+		"text":               {cell: "hello", expected: "hello"},
+		"formula":            {cell: "=HYPERLINK(\"http://evil\")", expected: "'=HYPERLINK(\"http://evil\")"},
+		"plus":               {cell: "+1+1", expected: "'+1+1"},
+		"minus formula":      {cell: "-2+3", expected: "'-2+3"},
+		"at":                 {cell: "@SUM(A1)", expected: "'@SUM(A1)"},
+		"tab":                {cell: "\t=1", expected: "'\t=1"},
+		"carriage return":    {cell: "\r=1", expected: "'\r=1"},
+		"negative chat id":   {cell: "-100123", expected: "-100123"},
+		"negative decimal":   {cell: "-1.5", expected: "-1.5"},
+		"formula later on":   {cell: "a=1", expected: "a=1"},
+		"username mention":   {cell: "@john_doe", expected: "'@john_doe"},
+		"phone number":       {cell: "+15551234567", expected: "+15551234567"},
+		"json state":         {cell: `{"role":"admin"}`, expected: `{"role":"admin"}`},
+		"leading whitespace": {cell: " =1", expected: " =1"},
+	}
+	for name, tc := range cases {
+		if got := csvexport.NeutralizeCell(tc.cell); got != tc.expected {
+			t.Errorf("%s: expected %q, got %q", name, tc.expected, got)
+		}
+	}
+}
+
+func TestWriter_Write(t *testing.T) {
+	var buffer bytes.Buffer
+	writer := csvexport.NewWriter(&buffer)
+	if err := writer.Write([]string{"id", "=1+1", "-100123"}); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	writer.Flush()
+
+	if expected := "id,'=1+1,-100123\n"; buffer.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buffer.String())
+	}
+}
@@ -50,6 +50,15 @@ func NewSQLXDatabase(config *config.DatabaseConfig, logger *slog.Logger) (*SQLXD
 
 	dbLogger.Info("database connection established")
 
+	// Superusers and the roles with BYPASSRLS aren't subject to the row-level security isolating the teams
+	var bypassesRowSecurity bool
+	query := `SELECT rolsuper OR rolbypassrls FROM pg_roles WHERE rolname = current_user`
+	if err = engine.Get(&bypassesRowSecurity, query); err != nil {
+		dbLogger.Warn("failed to check the attributes of the database role", slog.Any("error", err))
+	} else if bypassesRowSecurity {
+		dbLogger.Warn("the database role bypasses row-level security, the teams aren't isolated from each other")
+	}
+
 	return &SQLXDatabase{
 		engine: engine,
 		logger: dbLogger,
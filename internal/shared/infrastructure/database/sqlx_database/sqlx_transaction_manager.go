@@ -2,12 +2,32 @@ package sqlxdatabase
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 
 	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
 	"github.com/jmoiron/sqlx"
 )
 
+// savepointName is reused by every savepoint, the innermost one shadows the outer ones.
+const savepointName = "trinity_savepoint"
+
+// The row-level security policies and the visibility predicates of the record module read these settings,
+// they last until the transaction ends.
+const (
+	tenantIDSetting    = "app.tenant_id"
+	tenantScopeSetting = "app.tenant_scope"
+	userIDSetting      = "app.user_id"
+	userRoleSetting    = "app.user_role"
+)
+
+// The values of app.tenant_scope, a transaction without one sees no tenant.
+const (
+	tenantScopeTeam = "team"
+	tenantScopeAll  = "all"
+)
+
 type SQLXTransactionManager struct {
 	transaction *sqlx.Tx
 	logger      *slog.Logger
@@ -40,6 +60,55 @@ func (tm *SQLXTransactionManager) Rollback(ctx context.Context) error {
 	return nil
 }
 
+func (tm *SQLXTransactionManager) InSavepoint(ctx context.Context, fn func() error) error {
+	if _, err := tm.transaction.ExecContext(ctx, "SAVEPOINT "+savepointName); err != nil {
+		tm.logger.ErrorContext(ctx, "failed to create savepoint", slog.Any("error", err))
+		return err
+	}
+	if fnErr := fn(); fnErr != nil {
+		if _, err := tm.transaction.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+savepointName); err != nil {
+			tm.logger.ErrorContext(ctx, "failed to rollback to savepoint", slog.Any("error", err))
+			return errors.Join(fnErr, err)
+		}
+		return fnErr
+	}
+	if _, err := tm.transaction.ExecContext(ctx, "RELEASE SAVEPOINT "+savepointName); err != nil {
+		tm.logger.ErrorContext(ctx, "failed to release savepoint", slog.Any("error", err))
+		return err
+	}
+	return nil
+}
+
 func (tm *SQLXTransactionManager) GetTransaction() any {
 	return tm.transaction
 }
+
+// scopeToCaller scopes the transaction to the user it runs on behalf of and to their team, no team is a tenant too.
+// Only a transaction marked by interfaces.WithTenantBypass, as the background jobs open, sees every tenant,
+// any other one sees none.
+func (tm *SQLXTransactionManager) scopeToCaller(ctx context.Context) error {
+	tenantID, scope, userID, userRole := "", "", "", ""
+	if idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity); ok && idp != nil {
+		scope, userID, userRole = tenantScopeTeam, idp.UserID.String(), string(idp.UserRole)
+		if idp.TeamID.Valid {
+			tenantID = idp.TeamID.UUID.String()
+		}
+	} else if interfaces.HasTenantBypass(ctx) {
+		scope = tenantScopeAll
+	}
+	query := `SELECT set_config($1, $2, true), set_config($3, $4, true), set_config($5, $6, true),
+	set_config($7, $8, true)`
+	_, err := tm.transaction.ExecContext(
+		ctx,
+		query,
+		tenantIDSetting, tenantID,
+		tenantScopeSetting, scope,
+		userIDSetting, userID,
+		userRoleSetting, userRole,
+	)
+	if err != nil {
+		tm.logger.ErrorContext(ctx, "failed to scope transaction to caller", slog.Any("error", err))
+		return err
+	}
+	return nil
+}
@@ -21,7 +21,7 @@ func NewSQLXTransactionFactory(db *SQLXDatabase, logger *slog.Logger) interfaces
 	}
 }
 
-// NewTransaction creates a NEW transaction for each request.
+// NewTransaction creates a NEW transaction for each request, scoped to the tenant of its caller.
 func (f *SQLXTransactionFactory) NewTransaction(ctx context.Context) (interfaces.TransactionManager, error) {
 	tx, err := f.db.engine.BeginTxx(ctx, nil)
 	if err != nil {
@@ -29,8 +29,15 @@ func (f *SQLXTransactionFactory) NewTransaction(ctx context.Context) (interfaces
 		return nil, err
 	}
 
-	return &SQLXTransactionManager{
+	transactionManager := &SQLXTransactionManager{
 		transaction: tx,
 		logger:      f.logger,
-	}, nil
+	}
+	if err = transactionManager.scopeToCaller(ctx); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			f.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("error", rollbackErr))
+		}
+		return nil, err
+	}
+	return transactionManager, nil
 }
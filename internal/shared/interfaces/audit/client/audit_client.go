@@ -0,0 +1,19 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+)
+
+var ErrUnexpectedError = errors.New("unexpected error occured")
+
+type AuditClient interface {
+	// Record appends the entries to the audit log in the transaction of the change they're about,
+	// so they're only kept if the change has been committed.
+	Record(ctx context.Context, transactionManager interfaces.TransactionManager, entries ...Entry) error
+	// RecordDetached appends the entries in a transaction of their own, for the changes stored outside of
+	// the database, e.g. the sessions, and for the reads, whose transactions are never committed.
+	RecordDetached(ctx context.Context, entries ...Entry) error
+}
@@ -0,0 +1,63 @@
+package client
+
+import "github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+
+type Action string
+
+const (
+	UserCreated  Action = "user.created"
+	UserPromoted Action = "user.promoted"
+	UserDemoted  Action = "user.demoted"
+	UserRemoved  Action = "user.removed"
+
+	TeamCreated Action = "team.created"
+	TeamRemoved Action = "team.removed"
+
+	SessionCreated Action = "auth.session_created"
+	LoginFailed    Action = "auth.login_failed"
+
+	TelegramUserAdded             Action = "telegram_user.added"
+	TelegramChatAdded             Action = "telegram_chat.added"
+	TelegramChatSnapshotAdded     Action = "telegram_chat_snapshot.added"
+	TelegramIdentityAdded         Action = "telegram_identity.added"
+	TelegramRecordAdded           Action = "telegram_record.added"
+	TelegramRecordRevised         Action = "telegram_record.revised"
+	TelegramRecordDeleted         Action = "telegram_record.deleted"
+	TelegramRecordViewed          Action = "telegram_record.viewed"
+	TelegramAttachmentAdded       Action = "telegram_attachment.added"
+	TelegramProfilePictureAdded   Action = "telegram_profile_picture.added"
+	TelegramWatchlistAdded        Action = "telegram_watchlist.added"
+	TelegramWatchlistRemoved      Action = "telegram_watchlist.removed"
+	TelegramWatchlistEntryAdded   Action = "telegram_watchlist_entry.added"
+	TelegramWatchlistEntryRemoved Action = "telegram_watchlist_entry.removed"
+	TelegramAlertRead             Action = "telegram_alert.read"
+	TelegramExportRequested       Action = "telegram_export.requested"
+	TelegramExportDownloaded      Action = "telegram_export.downloaded"
+
+	TelegramInvestigationAdded             Action = "telegram_investigation.added"
+	TelegramInvestigationRemoved           Action = "telegram_investigation.removed"
+	TelegramInvestigationMemberAdded       Action = "telegram_investigation_member.added"
+	TelegramInvestigationMemberRoleChanged Action = "telegram_investigation_member.role_changed"
+	TelegramInvestigationMemberRemoved     Action = "telegram_investigation_member.removed"
+	TelegramInvestigationItemAdded         Action = "telegram_investigation_item.added"
+	TelegramInvestigationItemRemoved       Action = "telegram_investigation_item.removed"
+
+	TelegramTagAdded    Action = "telegram_tag.added"
+	TelegramTagRemoved  Action = "telegram_tag.removed"
+	TelegramNoteAdded   Action = "telegram_note.added"
+	TelegramNoteRemoved Action = "telegram_note.removed"
+
+	TelegramObservationVisibilityChanged Action = "telegram_observation.visibility_changed"
+)
+
+// Entry is a change made by an interactor. Before and After are the state of the target around the change,
+// marshalled to JSON, nil when there's none: Before of a creation, After of a removal.
+type Entry struct {
+	Action     Action
+	TargetType string
+	TargetID   string
+	Before     any
+	After      any
+	// Actor is taken from the context of the request when it's nil, an entry without either is made by the system
+	Actor *client.UserIdentity
+}
@@ -21,9 +21,17 @@ const (
 	User  UserRole = "user"
 )
 
+type contextKey string
+
+// IdentityProviderKey is the context key the *UserIdentity of the authenticated user is stored under.
+const IdentityProviderKey contextKey = "IdentityProvider"
+
 type UserIdentity struct {
 	UserID   uuid.UUID
 	UserRole UserRole
+	// TeamID is the tenant the transactions of the user are scoped to, none is the tenant of the users
+	// without a team
+	TeamID uuid.NullUUID
 }
 
 type AuthClient interface {
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EventType string
+
+const (
+	TelegramRecordCreated   EventType = "telegram_record.created"
+	TelegramIdentityCreated EventType = "telegram_identity.created"
+	UserCreated             EventType = "user.created"
+	UserPromoted            EventType = "user.promoted"
+	UserDemoted             EventType = "user.demoted"
+	UserRemoved             EventType = "user.removed"
+)
+
+// Observation is how a collector has observed the data an event is about, only the users seeing it
+// should receive the event. Visibility is one of private, team or global.
+type Observation struct {
+	ObservedByUser uuid.UUID
+	Visibility     string
+}
+
+// Event is a change a module has committed, as the subscribers receive it.
+// The ID stays the same across redeliveries, so it may be used to deduplicate them.
+type Event struct {
+	ID         uuid.UUID
+	Type       EventType
+	OccurredAt time.Time
+	Data       json.RawMessage
+	// TenantID is the team the change has been made within, no team is a tenant too.
+	TenantID uuid.NullUUID
+	// Observation is set for the changes of the observed telegram data.
+	Observation *Observation
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+)
+
+var ErrUnexpectedError = errors.New("unexpected error occured")
+
+type EventClient interface {
+	// Record adds the event to the outbox. It runs in the transaction of the change the event is about,
+	// so the event is only published if the change has been committed. The data is marshalled to JSON.
+	Record(
+		ctx context.Context,
+		transactionManager interfaces.TransactionManager,
+		eventType EventType,
+		data any,
+	) error
+	// RecordObserved adds the event the way Record does, about data the collector has observed.
+	RecordObserved(
+		ctx context.Context,
+		transactionManager interfaces.TransactionManager,
+		eventType EventType,
+		data any,
+		observation Observation,
+	) error
+}
+
+// Subscriber is the in-process handler of the events of its types.
+// Handle runs in the transaction the event is marked as published in, so the changes it makes are committed
+// exactly once. A failed Handle rolls the transaction back and the event is published again later.
+type Subscriber interface {
+	EventTypes() []EventType
+	Handle(ctx context.Context, transactionManager interfaces.TransactionManager, event Event) error
+}
@@ -0,0 +1,12 @@
+package interfaces
+
+type contextKey string
+
+// RequestMetadataKey is the context key the *RequestMetadata of the request is stored under.
+const RequestMetadataKey contextKey = "RequestMetadata"
+
+// RequestMetadata describes where a request comes from, e.g. for the audit log.
+type RequestMetadata struct {
+	RequestID string
+	IPAddress string
+}
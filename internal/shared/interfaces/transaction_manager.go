@@ -5,5 +5,8 @@ import "context"
 type TransactionManager interface {
 	Commit(ctx context.Context) error
 	Rollback(ctx context.Context) error
+	// InSavepoint runs fn inside a savepoint and rolls back to it if fn fails,
+	// so a failed statement doesn't abort the rest of the transaction.
+	InSavepoint(ctx context.Context, fn func() error) error
 	GetTransaction() any
 }
@@ -5,3 +5,18 @@ import "context"
 type TransactionManagerFactory interface {
 	NewTransaction(ctx context.Context) (TransactionManager, error)
 }
+
+type tenantBypassKey struct{}
+
+// WithTenantBypass marks ctx for the transactions of a background job working on behalf of nobody,
+// they see the data of every tenant. The identity of a user in ctx takes precedence over the mark.
+// A transaction with neither sees no tenant at all.
+func WithTenantBypass(ctx context.Context) context.Context {
+	return context.WithValue(ctx, tenantBypassKey{}, true)
+}
+
+// HasTenantBypass reports whether ctx has been marked by WithTenantBypass.
+func HasTenantBypass(ctx context.Context) bool {
+	bypass, _ := ctx.Value(tenantBypassKey{}).(bool)
+	return bypass
+}
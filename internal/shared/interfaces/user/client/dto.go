@@ -13,3 +13,9 @@ type VerifyCredentialsResponse struct {
 	UserID   uuid.UUID
 	UserRole UserRole
 }
+
+type ResolveUserResponse struct {
+	UserID   uuid.UUID
+	UserRole UserRole
+	TeamID   uuid.NullUUID
+}
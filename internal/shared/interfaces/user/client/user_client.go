@@ -3,14 +3,21 @@ package client
 import (
 	"context"
 	"errors"
+
+	"github.com/google/uuid"
 )
 
 var (
 	ErrUsernameAbsent    = errors.New("username record absent")
 	ErrPasswordMissmatch = errors.New("password missmatch")
+	ErrUserAbsent        = errors.New("user record absent")
 	ErrUnexpectedError   = errors.New("unexpected error occured")
 )
 
 type UserClient interface {
 	VerifyCredentials(ctx context.Context, username, password string) (VerifyCredentialsResponse, error)
+	// ResolveUser returns the current role and team of the user, ErrUserAbsent once they've been removed.
+	ResolveUser(ctx context.Context, userID uuid.UUID) (ResolveUserResponse, error)
+	// GetUserTeam resolves the team of a user, ErrUserAbsent is returned for a removed one.
+	GetUserTeam(ctx context.Context, userID uuid.UUID) (uuid.NullUUID, error)
 }
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 
 	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
 	"github.com/InWamos/trinity-proto/internal/user/application/service"
 	"github.com/InWamos/trinity-proto/internal/user/domain"
 	"github.com/InWamos/trinity-proto/internal/user/infrastructure/repository"
@@ -17,6 +18,7 @@ type CreateRandomAdminUser struct {
 	uuidGenerator             *service.UUIDGenerator
 	transactionManagerFactory interfaces.TransactionManagerFactory
 	userRepositoryFactory     repository.UserRepositoryFactory
+	auditClient               auditclient.AuditClient
 	logger                    *slog.Logger
 }
 
@@ -25,6 +27,7 @@ func NewCreateRandomAdminUser(
 	uuidGenerator *service.UUIDGenerator,
 	transactionManagerFactory interfaces.TransactionManagerFactory,
 	userRepositoryFactory repository.UserRepositoryFactory,
+	auditClient auditclient.AuditClient,
 	logger *slog.Logger,
 ) *CreateRandomAdminUser {
 	culogger := logger.With(
@@ -36,6 +39,7 @@ func NewCreateRandomAdminUser(
 		uuidGenerator:             uuidGenerator,
 		transactionManagerFactory: transactionManagerFactory,
 		userRepositoryFactory:     userRepositoryFactory,
+		auditClient:               auditClient,
 		logger:                    culogger,
 	}
 }
@@ -62,7 +66,7 @@ func (interactor *CreateRandomAdminUser) Execute(
 		return ErrUUIDGeneration
 	}
 
-	newUser := domain.NewUser(randomUUID, "admin", "admin", passwordHashed, domain.RoleAdmin)
+	newUser := domain.NewUser(randomUUID, "admin", "admin", passwordHashed, domain.RoleAdmin, uuid.NullUUID{})
 
 	// Execute within a transaction managed by the factory
 	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
@@ -92,6 +96,20 @@ func (interactor *CreateRandomAdminUser) Execute(
 		return ErrDatabaseFailed
 	}
 
+	// There's no actor in the context, the entry is made by the system
+	entry := auditclient.Entry{
+		Action:     auditclient.UserCreated,
+		TargetType: auditTargetUser,
+		TargetID:   newUser.ID.String(),
+		After:      newUserEvent(*newUser),
+	}
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		return ErrDatabaseFailed
+	}
+
 	if err = transactionManager.Commit(ctx); err != nil {
 		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
 		return ErrDatabaseFailed
@@ -0,0 +1,113 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	"github.com/InWamos/trinity-proto/internal/user/application/service"
+	"github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/InWamos/trinity-proto/internal/user/infrastructure/repository"
+	"github.com/google/uuid"
+)
+
+type CreateTeamRequest struct {
+	Name string
+}
+
+type CreateTeamResponse struct {
+	TeamID uuid.UUID
+}
+
+type CreateTeam struct {
+	uuidGenerator             *service.UUIDGenerator
+	transactionManagerFactory interfaces.TransactionManagerFactory
+	teamRepositoryFactory     repository.TeamRepositoryFactory
+	auditClient               auditclient.AuditClient
+	logger                    *slog.Logger
+}
+
+func NewCreateTeam(
+	uuidGenerator *service.UUIDGenerator,
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	teamRepositoryFactory repository.TeamRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *CreateTeam {
+	ctlogger := logger.With(
+		slog.String("component", "interactor"),
+		slog.String("name", "create_team"),
+	)
+	return &CreateTeam{
+		uuidGenerator:             uuidGenerator,
+		transactionManagerFactory: transactionManagerFactory,
+		teamRepositoryFactory:     teamRepositoryFactory,
+		auditClient:               auditClient,
+		logger:                    ctlogger,
+	}
+}
+
+func (interactor *CreateTeam) Execute(ctx context.Context, input CreateTeamRequest) (*CreateTeamResponse, error) {
+	interactor.logger.DebugContext(ctx, "Started CreateTeam execution")
+
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, domain.RoleAdmin); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	randomUUID, err := interactor.uuidGenerator.GetUUIDv7()
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "The uuid generator has failed")
+		return nil, ErrUUIDGeneration
+	}
+
+	newTeam := domain.NewTeam(randomUUID, input.Name)
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, ErrDatabaseFailed
+	}
+
+	teamRepository := interactor.teamRepositoryFactory.CreateTeamRepositoryWithTransaction(transactionManager)
+
+	if err = teamRepository.CreateTeam(ctx, *newTeam); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create team", slog.Any("err", err))
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		if errors.Is(err, repository.ErrTeamAlreadyExists) {
+			return nil, ErrTeamAlreadyExists
+		}
+		return nil, ErrDatabaseFailed
+	}
+
+	entry := auditclient.Entry{
+		Action:     auditclient.TeamCreated,
+		TargetType: auditTargetTeam,
+		TargetID:   newTeam.ID.String(),
+		After:      teamAuditState{TeamID: newTeam.ID, Name: newTeam.Name},
+	}
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		return nil, ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return nil, ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished CreateTeam execution")
+	return &CreateTeamResponse{TeamID: newTeam.ID}, nil
+}
@@ -2,15 +2,17 @@ package application
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 
 	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
 	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
 	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	eventclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/event/client"
 	"github.com/InWamos/trinity-proto/internal/user/application/service"
 	"github.com/InWamos/trinity-proto/internal/user/domain"
 	"github.com/InWamos/trinity-proto/internal/user/infrastructure/repository"
-	"github.com/InWamos/trinity-proto/middleware"
 	"github.com/google/uuid"
 )
 
@@ -20,6 +22,7 @@ type CreateUserRequest struct {
 	DisplayName string
 	Password    string
 	Role        domain.Role
+	TeamID      uuid.NullUUID
 }
 
 // CreateUserResponse Output DTO for interactor.
@@ -32,6 +35,8 @@ type CreateUser struct {
 	uuidGenerator             *service.UUIDGenerator
 	transactionManagerFactory interfaces.TransactionManagerFactory
 	userRepositoryFactory     repository.UserRepositoryFactory
+	eventClient               eventclient.EventClient
+	auditClient               auditclient.AuditClient
 	logger                    *slog.Logger
 }
 
@@ -40,6 +45,8 @@ func NewCreateUser(
 	uuidGenerator *service.UUIDGenerator,
 	transactionManagerFactory interfaces.TransactionManagerFactory,
 	userRepositoryFactory repository.UserRepositoryFactory,
+	eventClient eventclient.EventClient,
+	auditClient auditclient.AuditClient,
 	logger *slog.Logger,
 ) *CreateUser {
 	culogger := logger.With(
@@ -51,6 +58,8 @@ func NewCreateUser(
 		uuidGenerator:             uuidGenerator,
 		transactionManagerFactory: transactionManagerFactory,
 		userRepositoryFactory:     userRepositoryFactory,
+		eventClient:               eventClient,
+		auditClient:               auditClient,
 		logger:                    culogger,
 	}
 }
@@ -58,7 +67,7 @@ func NewCreateUser(
 func (interactor *CreateUser) Execute(ctx context.Context, input CreateUserRequest) (*CreateUserResponse, error) {
 	interactor.logger.DebugContext(ctx, "Started Create User execution")
 
-	idp, ok := ctx.Value(middleware.IdentityProviderKey).(*client.UserIdentity)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
 	if !ok || idp == nil {
 		return nil, rbac.ErrInsufficientPrivileges
 	}
@@ -79,7 +88,14 @@ func (interactor *CreateUser) Execute(ctx context.Context, input CreateUserReque
 		return nil, ErrUUIDGeneration
 	}
 
-	newUser := domain.NewUser(randomUUID, input.Username, input.DisplayName, passwordHashed, input.Role)
+	newUser := domain.NewUser(
+		randomUUID,
+		input.Username,
+		input.DisplayName,
+		passwordHashed,
+		input.Role,
+		input.TeamID,
+	)
 
 	// Execute within a transaction managed by the factory
 	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
@@ -94,6 +110,30 @@ func (interactor *CreateUser) Execute(ctx context.Context, input CreateUserReque
 	err = userRepository.CreateUser(ctx, *newUser)
 	if err != nil {
 		interactor.logger.ErrorContext(ctx, "failed to create user", slog.Any("err", err))
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			return nil, ErrTeamNotFound
+		}
+		return nil, ErrDatabaseFailed
+	}
+
+	// The event is only published if the change is committed
+	event := newUserEvent(*newUser)
+	if err = interactor.eventClient.Record(ctx, transactionManager, eventclient.UserCreated, event); err != nil {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		return nil, ErrDatabaseFailed
+	}
+	entry := auditclient.Entry{
+		Action:     auditclient.UserCreated,
+		TargetType: auditTargetUser,
+		TargetID:   newUser.ID.String(),
+		After:      event,
+	}
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
 		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
 			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
 		}
@@ -8,10 +8,11 @@ import (
 
 	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
 	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
 	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	eventclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/event/client"
 	"github.com/InWamos/trinity-proto/internal/user/domain"
 	"github.com/InWamos/trinity-proto/internal/user/infrastructure/repository"
-	"github.com/InWamos/trinity-proto/middleware"
 	"github.com/google/uuid"
 )
 
@@ -22,12 +23,16 @@ type DemoteUserRequest struct {
 type DemoteUser struct {
 	transactionManagerFactory interfaces.TransactionManagerFactory
 	userRepositoryFactory     repository.UserRepositoryFactory
+	eventClient               eventclient.EventClient
+	auditClient               auditclient.AuditClient
 	logger                    *slog.Logger
 }
 
 func NewDemoteUser(
 	transactionManagerFactory interfaces.TransactionManagerFactory,
 	userRepositoryFactory repository.UserRepositoryFactory,
+	eventClient eventclient.EventClient,
+	auditClient auditclient.AuditClient,
 	logger *slog.Logger,
 ) *DemoteUser {
 	dulogger := logger.With(
@@ -37,6 +42,8 @@ func NewDemoteUser(
 	return &DemoteUser{
 		transactionManagerFactory: transactionManagerFactory,
 		userRepositoryFactory:     userRepositoryFactory,
+		eventClient:               eventClient,
+		auditClient:               auditClient,
 		logger:                    dulogger,
 	}
 }
@@ -44,7 +51,7 @@ func NewDemoteUser(
 func (interactor *DemoteUser) Execute(ctx context.Context, input DemoteUserRequest) error {
 	interactor.logger.DebugContext(ctx, "Started DemoteUser execution", slog.String("user_id", input.ID.String()))
 
-	idp, ok := ctx.Value(middleware.IdentityProviderKey).(*client.UserIdentity)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
 	if !ok || idp == nil {
 		return rbac.ErrInsufficientPrivileges
 	}
@@ -60,6 +67,19 @@ func (interactor *DemoteUser) Execute(ctx context.Context, input DemoteUserReque
 
 	userRepository := interactor.userRepositoryFactory.CreateUserRepositoryWithTransaction(transactionManager)
 
+	// The state before the change is kept in the audit log
+	user, err := userRepository.GetUserByID(ctx, input.ID)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get user", slog.Any("err", err))
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+		return ErrDatabaseFailed
+	}
+
 	err = userRepository.ChangeUserRoleByID(ctx, input.ID, domain.RoleUser)
 	if err != nil {
 		interactor.logger.ErrorContext(ctx, "failed to demote user", slog.Any("err", err))
@@ -74,6 +94,28 @@ func (interactor *DemoteUser) Execute(ctx context.Context, input DemoteUserReque
 		return ErrDatabaseFailed
 	}
 
+	// The event is only published if the change is committed
+	event := UserEvent{UserID: input.ID, Role: domain.RoleUser}
+	if err = interactor.eventClient.Record(ctx, transactionManager, eventclient.UserDemoted, event); err != nil {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		return ErrDatabaseFailed
+	}
+	entry := auditclient.Entry{
+		Action:     auditclient.UserDemoted,
+		TargetType: auditTargetUser,
+		TargetID:   input.ID.String(),
+		Before:     UserEvent{UserID: user.ID, Role: user.Role},
+		After:      event,
+	}
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		return ErrDatabaseFailed
+	}
+
 	if err = transactionManager.Commit(ctx); err != nil {
 		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
 		return ErrDatabaseFailed
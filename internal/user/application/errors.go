@@ -10,4 +10,7 @@ var (
 	ErrUsernameAbsent          = errors.New("this username is absent")
 	ErrPasswordMismatch        = errors.New("password didn't match")
 	ErrNoUserIdentityProvided  = errors.New("no user identity provided")
+	ErrTeamNotFound            = errors.New("team not found")
+	ErrTeamAlreadyExists       = errors.New("team with this name already exists")
+	ErrTeamNotEmpty            = errors.New("team still has users")
 )
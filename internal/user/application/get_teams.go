@@ -0,0 +1,76 @@
+package application
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	"github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/InWamos/trinity-proto/internal/user/infrastructure/repository"
+)
+
+type GetTeamsResponse struct {
+	Teams []domain.Team
+}
+
+type GetTeams struct {
+	transactionManagerFactory interfaces.TransactionManagerFactory
+	teamRepositoryFactory     repository.TeamRepositoryFactory
+	logger                    *slog.Logger
+}
+
+func NewGetTeams(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	teamRepositoryFactory repository.TeamRepositoryFactory,
+	logger *slog.Logger,
+) *GetTeams {
+	gtlogger := logger.With(
+		slog.String("component", "interactor"),
+		slog.String("name", "get_teams"),
+	)
+	return &GetTeams{
+		transactionManagerFactory: transactionManagerFactory,
+		teamRepositoryFactory:     teamRepositoryFactory,
+		logger:                    gtlogger,
+	}
+}
+
+func (interactor *GetTeams) Execute(ctx context.Context) (*GetTeamsResponse, error) {
+	interactor.logger.DebugContext(ctx, "Started GetTeams execution")
+
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, domain.RoleAdmin); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, ErrDatabaseFailed
+	}
+
+	teamRepository := interactor.teamRepositoryFactory.CreateTeamRepositoryWithTransaction(transactionManager)
+
+	teams, err := teamRepository.GetTeams(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get teams", slog.Any("err", err))
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		return nil, ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return nil, ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetTeams execution")
+	return &GetTeamsResponse{Teams: teams}, nil
+}
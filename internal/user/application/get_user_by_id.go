@@ -10,7 +10,6 @@ import (
 	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
 	"github.com/InWamos/trinity-proto/internal/user/domain"
 	"github.com/InWamos/trinity-proto/internal/user/infrastructure/repository"
-	"github.com/InWamos/trinity-proto/middleware"
 	"github.com/google/uuid"
 )
 
@@ -51,7 +50,7 @@ func NewGetUserByID(
 func (interactor *GetUserByID) Execute(ctx context.Context, input GetUserByIDRequest) (*GetUserByIDResponse, error) {
 	interactor.logger.DebugContext(ctx, "Started GetUserByID execution", slog.String("user_id", input.ID.String()))
 
-	idp, ok := ctx.Value(middleware.IdentityProviderKey).(*client.UserIdentity)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
 	if !ok || idp == nil {
 		return nil, rbac.ErrInsufficientPrivileges
 	}
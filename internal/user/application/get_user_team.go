@@ -0,0 +1,74 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/user/infrastructure/repository"
+	"github.com/google/uuid"
+)
+
+type GetUserTeamRequest struct {
+	UserID uuid.UUID
+}
+
+type GetUserTeamResponse struct {
+	TeamID uuid.NullUUID
+}
+
+// GetUserTeam resolves the team of an authenticated user, before their identity is known to the request.
+// A removed user is not found.
+type GetUserTeam struct {
+	transactionManagerFactory interfaces.TransactionManagerFactory
+	userRepositoryFactory     repository.UserRepositoryFactory
+	logger                    *slog.Logger
+}
+
+func NewGetUserTeam(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	userRepositoryFactory repository.UserRepositoryFactory,
+	logger *slog.Logger,
+) *GetUserTeam {
+	gutLogger := logger.With(
+		slog.String("component", "interactor"),
+		slog.String("name", "get_user_team"),
+	)
+	return &GetUserTeam{
+		transactionManagerFactory: transactionManagerFactory,
+		userRepositoryFactory:     userRepositoryFactory,
+		logger:                    gutLogger,
+	}
+}
+
+func (interactor *GetUserTeam) Execute(ctx context.Context, input GetUserTeamRequest) (GetUserTeamResponse, error) {
+	interactor.logger.DebugContext(ctx, "Started GetUserTeam execution", slog.String("user_id", input.UserID.String()))
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return GetUserTeamResponse{}, ErrDatabaseFailed
+	}
+
+	userRepository := interactor.userRepositoryFactory.CreateUserRepositoryWithTransaction(transactionManager)
+
+	user, err := userRepository.GetUserByID(ctx, input.UserID)
+	if err != nil {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return GetUserTeamResponse{}, ErrUserNotFound
+		}
+		return GetUserTeamResponse{}, ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return GetUserTeamResponse{}, ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished GetUserTeam execution")
+	return GetUserTeamResponse{TeamID: user.TeamID}, nil
+}
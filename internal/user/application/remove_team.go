@@ -0,0 +1,107 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	"github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/InWamos/trinity-proto/internal/user/infrastructure/repository"
+	"github.com/google/uuid"
+)
+
+type RemoveTeamRequest struct {
+	ID uuid.UUID
+}
+
+// RemoveTeam removes a team no user has ever belonged to.
+type RemoveTeam struct {
+	transactionManagerFactory interfaces.TransactionManagerFactory
+	teamRepositoryFactory     repository.TeamRepositoryFactory
+	auditClient               auditclient.AuditClient
+	logger                    *slog.Logger
+}
+
+func NewRemoveTeam(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	teamRepositoryFactory repository.TeamRepositoryFactory,
+	auditClient auditclient.AuditClient,
+	logger *slog.Logger,
+) *RemoveTeam {
+	rtlogger := logger.With(
+		slog.String("component", "interactor"),
+		slog.String("name", "remove_team"),
+	)
+	return &RemoveTeam{
+		transactionManagerFactory: transactionManagerFactory,
+		teamRepositoryFactory:     teamRepositoryFactory,
+		auditClient:               auditClient,
+		logger:                    rtlogger,
+	}
+}
+
+func (interactor *RemoveTeam) Execute(ctx context.Context, input RemoveTeamRequest) error {
+	interactor.logger.DebugContext(ctx, "Started RemoveTeam execution", slog.String("team_id", input.ID.String()))
+
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return rbac.ErrInsufficientPrivileges
+	}
+
+	if err := rbac.AuthorizeByRole(idp, domain.RoleAdmin); err != nil {
+		return rbac.ErrInsufficientPrivileges
+	}
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return ErrDatabaseFailed
+	}
+
+	teamRepository := interactor.teamRepositoryFactory.CreateTeamRepositoryWithTransaction(transactionManager)
+
+	// The state before the change is kept in the audit log
+	team, err := teamRepository.GetTeamByID(ctx, input.ID)
+	if err == nil {
+		err = teamRepository.RemoveTeamByID(ctx, input.ID)
+	}
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to remove team", slog.Any("err", err))
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		switch {
+		case errors.Is(err, repository.ErrTeamNotFound):
+			return ErrTeamNotFound
+		case errors.Is(err, repository.ErrTeamNotEmpty):
+			return ErrTeamNotEmpty
+		default:
+			return ErrDatabaseFailed
+		}
+	}
+
+	entry := auditclient.Entry{
+		Action:     auditclient.TeamRemoved,
+		TargetType: auditTargetTeam,
+		TargetID:   team.ID.String(),
+		Before:     teamAuditState{TeamID: team.ID, Name: team.Name},
+	}
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		return ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished RemoveTeam execution")
+	return nil
+}
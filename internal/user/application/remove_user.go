@@ -8,10 +8,11 @@ import (
 
 	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
 	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	auditclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/audit/client"
 	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	eventclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/event/client"
 	"github.com/InWamos/trinity-proto/internal/user/domain"
 	"github.com/InWamos/trinity-proto/internal/user/infrastructure/repository"
-	"github.com/InWamos/trinity-proto/middleware"
 	"github.com/google/uuid"
 )
 
@@ -22,12 +23,16 @@ type RemoveUserRequest struct {
 type RemoveUser struct {
 	transactionManagerFactory interfaces.TransactionManagerFactory
 	userRepositoryFactory     repository.UserRepositoryFactory
+	eventClient               eventclient.EventClient
+	auditClient               auditclient.AuditClient
 	logger                    *slog.Logger
 }
 
 func NewRemoveUser(
 	transactionManagerFactory interfaces.TransactionManagerFactory,
 	userRepositoryFactory repository.UserRepositoryFactory,
+	eventClient eventclient.EventClient,
+	auditClient auditclient.AuditClient,
 	logger *slog.Logger,
 ) *RemoveUser {
 	rulogger := logger.With(
@@ -37,6 +42,8 @@ func NewRemoveUser(
 	return &RemoveUser{
 		transactionManagerFactory: transactionManagerFactory,
 		userRepositoryFactory:     userRepositoryFactory,
+		eventClient:               eventClient,
+		auditClient:               auditClient,
 		logger:                    rulogger,
 	}
 }
@@ -44,7 +51,7 @@ func NewRemoveUser(
 func (interactor *RemoveUser) Execute(ctx context.Context, input RemoveUserRequest) error {
 	interactor.logger.DebugContext(ctx, "Started RemoveUser execution", slog.String("user_id", input.ID.String()))
 
-	idp, ok := ctx.Value(middleware.IdentityProviderKey).(*client.UserIdentity)
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
 	if !ok || idp == nil {
 		return rbac.ErrInsufficientPrivileges
 	}
@@ -61,6 +68,19 @@ func (interactor *RemoveUser) Execute(ctx context.Context, input RemoveUserReque
 
 	userRepository := interactor.userRepositoryFactory.CreateUserRepositoryWithTransaction(transactionManager)
 
+	// The state before the change is kept in the audit log
+	user, err := userRepository.GetUserByID(ctx, input.ID)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to get user", slog.Any("err", err))
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+		return ErrDatabaseFailed
+	}
+
 	err = userRepository.RemoveUserByID(ctx, input.ID)
 	if err != nil {
 		interactor.logger.ErrorContext(ctx, "failed to remove user", slog.Any("err", err))
@@ -75,6 +95,27 @@ func (interactor *RemoveUser) Execute(ctx context.Context, input RemoveUserReque
 		return ErrDatabaseFailed
 	}
 
+	// The event is only published if the change is committed
+	event := UserEvent{UserID: input.ID}
+	if err = interactor.eventClient.Record(ctx, transactionManager, eventclient.UserRemoved, event); err != nil {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		return ErrDatabaseFailed
+	}
+	entry := auditclient.Entry{
+		Action:     auditclient.UserRemoved,
+		TargetType: auditTargetUser,
+		TargetID:   input.ID.String(),
+		Before:     newUserEvent(user),
+	}
+	if err = interactor.auditClient.Record(ctx, transactionManager, entry); err != nil {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		return ErrDatabaseFailed
+	}
+
 	if err = transactionManager.Commit(ctx); err != nil {
 		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
 		return ErrDatabaseFailed
@@ -0,0 +1,77 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/InWamos/trinity-proto/internal/user/infrastructure/repository"
+	"github.com/google/uuid"
+)
+
+type ResolveUserRequest struct {
+	UserID uuid.UUID
+}
+
+type ResolveUserResponse struct {
+	UserID   uuid.UUID
+	UserRole domain.Role
+	TeamID   uuid.NullUUID
+}
+
+// ResolveUser returns the current role and team of a user, for the background jobs acting on behalf of them
+// long after they've been authenticated. A removed user is not found.
+type ResolveUser struct {
+	transactionManagerFactory interfaces.TransactionManagerFactory
+	userRepositoryFactory     repository.UserRepositoryFactory
+	logger                    *slog.Logger
+}
+
+func NewResolveUser(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	userRepositoryFactory repository.UserRepositoryFactory,
+	logger *slog.Logger,
+) *ResolveUser {
+	ruLogger := logger.With(
+		slog.String("component", "interactor"),
+		slog.String("name", "resolve_user"),
+	)
+	return &ResolveUser{
+		transactionManagerFactory: transactionManagerFactory,
+		userRepositoryFactory:     userRepositoryFactory,
+		logger:                    ruLogger,
+	}
+}
+
+func (interactor *ResolveUser) Execute(ctx context.Context, input ResolveUserRequest) (ResolveUserResponse, error) {
+	interactor.logger.DebugContext(ctx, "Started ResolveUser execution", slog.String("user_id", input.UserID.String()))
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return ResolveUserResponse{}, ErrDatabaseFailed
+	}
+
+	userRepository := interactor.userRepositoryFactory.CreateUserRepositoryWithTransaction(transactionManager)
+
+	user, err := userRepository.GetUserByID(ctx, input.UserID)
+	if err != nil {
+		if rollbackErr := transactionManager.Rollback(ctx); rollbackErr != nil {
+			interactor.logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", rollbackErr))
+		}
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return ResolveUserResponse{}, ErrUserNotFound
+		}
+		return ResolveUserResponse{}, ErrDatabaseFailed
+	}
+
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return ResolveUserResponse{}, ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished ResolveUser execution")
+	return ResolveUserResponse{UserID: user.ID, UserRole: user.Role, TeamID: user.TeamID}, nil
+}
@@ -0,0 +1,40 @@
+package application
+
+import (
+	"github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+// UserEvent is the data of the user events delivered to the webhook subscriptions.
+// Only the fields the change is about are set.
+type UserEvent struct {
+	UserID      uuid.UUID     `json:"user_id"`
+	Username    string        `json:"username,omitempty"`
+	DisplayName string        `json:"display_name,omitempty"`
+	Role        domain.Role   `json:"role,omitempty"`
+	TeamID      uuid.NullUUID `json:"team_id,omitzero"`
+}
+
+// auditTargetUser is the target type of the audit entries about the users.
+const auditTargetUser = "user"
+
+// auditTargetTeam is the target type of the audit entries about the teams.
+const auditTargetTeam = "team"
+
+// teamAuditState is the state of a team kept in the audit log.
+type teamAuditState struct {
+	TeamID uuid.UUID `json:"team_id"`
+	Name   string    `json:"name"`
+}
+
+// newUserEvent returns the full state of the user, it's also the state kept in the audit log
+// so the password hash is never part of it.
+func newUserEvent(user domain.User) UserEvent {
+	return UserEvent{
+		UserID:      user.ID,
+		Username:    user.Username,
+		DisplayName: user.DisplayName,
+		Role:        user.Role,
+		TeamID:      user.TeamID,
+	}
+}
@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Team is a department sharing the deployment. The record module keeps the data collected by the users
+// of a team from the other teams.
+type Team struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedAt time.Time
+}
+
+func NewTeam(uuid7 uuid.UUID, name string) *Team {
+	return &Team{
+		ID:        uuid7,
+		Name:      name,
+		CreatedAt: time.Now(),
+	}
+}
@@ -21,11 +21,21 @@ type User struct {
 	DisplayName  string
 	PasswordHash string
 	Role         Role
-	CreatedAt    time.Time
-	DeletedAt    sql.NullTime
+	// TeamID is chosen when the user is created, the data they collect stays with the team.
+	// A user without a team shares the data of the users without a team.
+	TeamID    uuid.NullUUID
+	CreatedAt time.Time
+	DeletedAt sql.NullTime
 }
 
-func NewUser(uuid7 uuid.UUID, username string, displayName string, passwordHash string, role Role) *User {
+func NewUser(
+	uuid7 uuid.UUID,
+	username string,
+	displayName string,
+	passwordHash string,
+	role Role,
+	teamID uuid.NullUUID,
+) *User {
 	// Consider using uuidv7 for a better indexing
 	return &User{
 		ID:           uuid7,
@@ -33,6 +43,7 @@ func NewUser(uuid7 uuid.UUID, username string, displayName string, passwordHash
 		DisplayName:  displayName,
 		PasswordHash: passwordHash,
 		Role:         role,
+		TeamID:       teamID,
 		CreatedAt:    time.Now(),
 		DeletedAt:    sql.NullTime{Valid: false},
 	}
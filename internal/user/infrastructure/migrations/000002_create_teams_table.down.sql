@@ -0,0 +1,9 @@
+-- squawk-ignore-file ban-drop-table,ban-drop-column
+-- Drop the teams, every user shares the data again
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+-- squawk-ignore require-concurrent-index-deletion
+DROP INDEX IF EXISTS "user".idx_users_team_id;
+ALTER TABLE "user"."users"
+DROP COLUMN IF EXISTS team_id;
+DROP TABLE IF EXISTS "user".teams;
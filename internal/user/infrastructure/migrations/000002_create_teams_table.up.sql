@@ -0,0 +1,21 @@
+-- Create the teams sharing the deployment, the record module keeps their data apart
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+CREATE TABLE IF NOT EXISTS "user"."teams" (
+    id UUID PRIMARY KEY NOT NULL,
+    name VARCHAR NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT "unique_team_name" UNIQUE (name),
+    CONSTRAINT "team_name_length" CHECK (LENGTH(name) > 0)
+);
+
+-- The users that belong to no team share the data stored before the teams
+-- A team isn't removed while a user, even a removed one, belongs to it
+ALTER TABLE "user"."users"
+ADD COLUMN IF NOT EXISTS team_id UUID CONSTRAINT "fk_users_team"
+REFERENCES "user".teams (id);
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_users_team_id ON "user".users (team_id);
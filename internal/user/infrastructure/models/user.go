@@ -16,11 +16,12 @@ const (
 
 // UserModelSqlx is for sqlx repositories.
 type UserModelSqlx struct {
-	ID           uuid.UUID    `db:"id"`
-	Username     string       `db:"username"`
-	DisplayName  string       `db:"display_name"`
-	PasswordHash string       `db:"password_hash"`
-	UserRole     UserRole     `db:"user_role"`
-	CreatedAt    time.Time    `db:"created_at"`
-	DeletedAt    sql.NullTime `db:"deleted_at"`
+	ID           uuid.UUID     `db:"id"`
+	Username     string        `db:"username"`
+	DisplayName  string        `db:"display_name"`
+	PasswordHash string        `db:"password_hash"`
+	UserRole     UserRole      `db:"user_role"`
+	TeamID       uuid.NullUUID `db:"team_id"`
+	CreatedAt    time.Time     `db:"created_at"`
+	DeletedAt    sql.NullTime  `db:"deleted_at"`
 }
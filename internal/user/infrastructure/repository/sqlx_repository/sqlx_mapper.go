@@ -20,6 +20,7 @@ func (sm *SqlxUserMapper) ToDomain(inputModel *models.UserModelSqlx) domain.User
 		inputModel.DisplayName,
 		inputModel.PasswordHash,
 		domain.Role(inputModel.UserRole),
+		inputModel.TeamID,
 	)
 }
 
@@ -36,7 +37,30 @@ func (sm *SqlxUserMapper) ToModel(inputEntity *domain.User) models.UserModelSqlx
 		DisplayName:  inputEntity.DisplayName,
 		PasswordHash: inputEntity.PasswordHash,
 		UserRole:     models.UserRole(inputEntity.Role),
+		TeamID:       inputEntity.TeamID,
 		CreatedAt:    inputEntity.CreatedAt,
 		DeletedAt:    deletedAt,
 	}
 }
+
+type SqlxTeamMapper struct{}
+
+func NewSqlxTeamMapper() *SqlxTeamMapper {
+	return &SqlxTeamMapper{}
+}
+
+func (sm *SqlxTeamMapper) ToDomain(inputModel *models.TeamModelSqlx) domain.Team {
+	return domain.Team{
+		ID:        inputModel.ID,
+		Name:      inputModel.Name,
+		CreatedAt: inputModel.CreatedAt,
+	}
+}
+
+func (sm *SqlxTeamMapper) ToModel(inputEntity *domain.Team) models.TeamModelSqlx {
+	return models.TeamModelSqlx{
+		ID:        inputEntity.ID,
+		Name:      inputEntity.Name,
+		CreatedAt: inputEntity.CreatedAt,
+	}
+}
@@ -0,0 +1,143 @@
+package sqlxrepository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/InWamos/trinity-proto/internal/user/infrastructure/models"
+	"github.com/InWamos/trinity-proto/internal/user/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
+)
+
+type SqlxTeamRepository struct {
+	session    *sqlx.Tx
+	sqlxMapper *SqlxTeamMapper
+	logger     *slog.Logger
+}
+
+func NewSqlxTeamRepository(session *sqlx.Tx, logger *slog.Logger) repository.TeamRepository {
+	trlogger := logger.With(
+		slog.String("component", "repository"),
+		slog.String("name", "sqlx_team_repository"),
+	)
+	return &SqlxTeamRepository{
+		session:    session,
+		sqlxMapper: NewSqlxTeamMapper(),
+		logger:     trlogger,
+	}
+}
+
+func (tr *SqlxTeamRepository) CreateTeam(ctx context.Context, team domain.Team) error {
+	tr.logger.DebugContext(ctx, "Started CreateTeam request")
+
+	teamModel := tr.sqlxMapper.ToModel(&team)
+	query := `INSERT INTO "user".teams (id, name, created_at) VALUES ($1, $2, $3)`
+
+	_, err := tr.session.ExecContext(ctx, query, teamModel.ID, teamModel.Name, teamModel.CreatedAt)
+
+	tr.logger.DebugContext(ctx, "Finished CreateTeam request")
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "unique_team_name" {
+			tr.logger.InfoContext(ctx, "Team already exists", slog.String("team_name", teamModel.Name))
+			return repository.ErrTeamAlreadyExists
+		}
+		tr.logger.ErrorContext(ctx, "Failed to save team record", slog.Any("err", err))
+		return err
+	}
+
+	tr.logger.DebugContext(ctx, "Team has been created", slog.String("team_id", teamModel.ID.String()))
+	return nil
+}
+
+func (tr *SqlxTeamRepository) GetTeams(ctx context.Context) ([]domain.Team, error) {
+	tr.logger.DebugContext(ctx, "Started GetTeams request")
+
+	var teamModels []models.TeamModelSqlx
+	query := `SELECT id, name, created_at FROM "user".teams ORDER BY name`
+
+	err := tr.session.SelectContext(ctx, &teamModels, query)
+	tr.logger.DebugContext(ctx, "Finished GetTeams request")
+
+	if err != nil {
+		tr.logger.ErrorContext(ctx, "Failed to get teams", slog.Any("err", err))
+		return nil, err
+	}
+
+	teams := make([]domain.Team, len(teamModels))
+	for i := range teamModels {
+		teams[i] = tr.sqlxMapper.ToDomain(&teamModels[i])
+	}
+	return teams, nil
+}
+
+func (tr *SqlxTeamRepository) GetTeamByID(ctx context.Context, id uuid.UUID) (domain.Team, error) {
+	tr.logger.DebugContext(ctx, "Started GetTeamByID request")
+
+	var team models.TeamModelSqlx
+	query := `SELECT id, name, created_at FROM "user".teams WHERE id = $1`
+
+	err := tr.session.GetContext(ctx, &team, query, id)
+	tr.logger.DebugContext(ctx, "Finished GetTeamByID request")
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			tr.logger.InfoContext(ctx, "Team not found by id", slog.String("team_id", id.String()))
+			return domain.Team{}, repository.ErrTeamNotFound
+		}
+		tr.logger.ErrorContext(
+			ctx,
+			"Failed to find team by id",
+			slog.String("team_id", id.String()),
+			slog.Any("err", err),
+		)
+		return domain.Team{}, err
+	}
+
+	return tr.sqlxMapper.ToDomain(&team), nil
+}
+
+func (tr *SqlxTeamRepository) RemoveTeamByID(ctx context.Context, id uuid.UUID) error {
+	tr.logger.DebugContext(ctx, "Started RemoveTeamByID request")
+
+	// The data collected by the users of the team is tagged with its id, so the users keep it from being removed
+	query := `DELETE FROM "user".teams WHERE id = $1`
+	result, err := tr.session.ExecContext(ctx, query, id)
+
+	tr.logger.DebugContext(ctx, "Finished RemoveTeamByID request")
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "fk_users_team" {
+			tr.logger.InfoContext(ctx, "Team still has users", slog.String("team_id", id.String()))
+			return repository.ErrTeamNotEmpty
+		}
+		tr.logger.ErrorContext(
+			ctx,
+			"Failed to remove team by id",
+			slog.String("team_id", id.String()),
+			slog.Any("err", err),
+		)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		tr.logger.ErrorContext(ctx, "Failed to get rows affected", slog.Any("err", err))
+		return err
+	}
+
+	if rowsAffected == 0 {
+		tr.logger.InfoContext(ctx, "Team not found by id", slog.String("team_id", id.String()))
+		return repository.ErrTeamNotFound
+	}
+
+	tr.logger.DebugContext(ctx, "Team has been removed", slog.String("team_id", id.String()))
+	return nil
+}
@@ -0,0 +1,38 @@
+package sqlxrepository
+
+import (
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/user/infrastructure/repository"
+	"github.com/jmoiron/sqlx"
+)
+
+type SqlxTeamRepositoryFactory struct {
+	logger     *slog.Logger
+	sqlxMapper *SqlxTeamMapper
+}
+
+func NewSqlxTeamRepositoryFactory(logger *slog.Logger, mapper *SqlxTeamMapper) repository.TeamRepositoryFactory {
+	return &SqlxTeamRepositoryFactory{
+		logger:     logger,
+		sqlxMapper: mapper,
+	}
+}
+
+func (strf *SqlxTeamRepositoryFactory) CreateTeamRepositoryWithTransaction(
+	tm interfaces.TransactionManager,
+) repository.TeamRepository {
+	// Extract the underlying sqlx transaction
+	tx, ok := tm.GetTransaction().(*sqlx.Tx)
+	if !ok {
+		strf.logger.Error("invalid transaction type, expected *sqlx.Tx")
+		panic("invalid transaction type for sqlx repository")
+	}
+
+	return &SqlxTeamRepository{
+		session:    tx,
+		sqlxMapper: strf.sqlxMapper,
+		logger:     strf.logger,
+	}
+}
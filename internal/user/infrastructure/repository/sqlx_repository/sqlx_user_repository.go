@@ -10,6 +10,7 @@ import (
 	"github.com/InWamos/trinity-proto/internal/user/infrastructure/models"
 	"github.com/InWamos/trinity-proto/internal/user/infrastructure/repository"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -35,7 +36,7 @@ func (ur *SqlxUserRepository) GetUserByID(ctx context.Context, id uuid.UUID) (do
 	ur.logger.DebugContext(ctx, "Started GetUserByID request")
 
 	var user models.UserModelSqlx
-	query := `SELECT id, username, display_name, password_hash, user_role, created_at, deleted_at 
+	query := `SELECT id, username, display_name, password_hash, user_role, team_id, created_at, deleted_at 
 			  FROM "user".users WHERE id = $1 AND deleted_at IS NULL`
 
 	err := ur.session.GetContext(ctx, &user, query, id)
@@ -62,7 +63,7 @@ func (ur *SqlxUserRepository) GetUserByUsername(ctx context.Context, username st
 	ur.logger.DebugContext(ctx, "Started GetUserByUsername request")
 
 	var user models.UserModelSqlx
-	query := `SELECT id, username, display_name, password_hash, user_role, created_at, deleted_at 
+	query := `SELECT id, username, display_name, password_hash, user_role, team_id, created_at, deleted_at 
 			  FROM "user".users WHERE username = $1 AND deleted_at IS NULL`
 
 	err := ur.session.GetContext(ctx, &user, query, username)
@@ -166,8 +167,8 @@ func (ur *SqlxUserRepository) CreateUser(ctx context.Context, user domain.User)
 	ur.logger.DebugContext(ctx, "Started CreateUser request")
 
 	userModel := ur.sqlxMapper.ToModel(&user)
-	query := `INSERT INTO "user".users (id, username, display_name, password_hash, user_role, created_at) 
-			  VALUES ($1, $2, $3, $4, $5, $6)`
+	query := `INSERT INTO "user".users (id, username, display_name, password_hash, user_role, team_id, created_at) 
+			  VALUES ($1, $2, $3, $4, $5, $6, $7)`
 
 	_, err := ur.session.ExecContext(
 		ctx,
@@ -177,12 +178,18 @@ func (ur *SqlxUserRepository) CreateUser(ctx context.Context, user domain.User)
 		userModel.DisplayName,
 		userModel.PasswordHash,
 		userModel.UserRole,
+		userModel.TeamID,
 		userModel.CreatedAt,
 	)
 
 	ur.logger.DebugContext(ctx, "Finished CreateUser request")
 
 	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "fk_users_team" {
+			ur.logger.InfoContext(ctx, "User references a team that doesn't exist")
+			return repository.ErrTeamNotFound
+		}
 		ur.logger.ErrorContext(ctx, "Failed to save user record", slog.Any("err", err))
 		return repository.ErrUserCreationFailed
 	}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/user/domain"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTeamNotFound      = errors.New("team was not found")
+	ErrTeamAlreadyExists = errors.New("team with this name already exists")
+	// ErrTeamNotEmpty means a user, even a removed one, belongs to the team.
+	ErrTeamNotEmpty = errors.New("team still has users")
+)
+
+type TeamRepository interface {
+	CreateTeam(ctx context.Context, team domain.Team) error
+	GetTeams(ctx context.Context) ([]domain.Team, error)
+	GetTeamByID(ctx context.Context, id uuid.UUID) (domain.Team, error)
+	RemoveTeamByID(ctx context.Context, id uuid.UUID) error
+}
+
+type TeamRepositoryFactory interface {
+	CreateTeamRepositoryWithTransaction(tm interfaces.TransactionManager) TeamRepository
+}
@@ -7,19 +7,29 @@ import (
 
 	"github.com/InWamos/trinity-proto/internal/shared/interfaces/user/client"
 	"github.com/InWamos/trinity-proto/internal/user/application"
+	"github.com/google/uuid"
 )
 
 type UserClient struct {
 	validateUserCredentialsInteractor *application.ValidateUserCredentials
+	resolveUserInteractor             *application.ResolveUser
+	getUserTeamInteractor             *application.GetUserTeam
 	logger                            *slog.Logger
 }
 
 func NewUserClient(
 	validateUserCredentialsInteractor *application.ValidateUserCredentials,
+	resolveUserInteractor *application.ResolveUser,
+	getUserTeamInteractor *application.GetUserTeam,
 	logger *slog.Logger,
 ) client.UserClient {
 	ucLogger := logger.With(slog.String("component", "user_client"))
-	return &UserClient{validateUserCredentialsInteractor: validateUserCredentialsInteractor, logger: ucLogger}
+	return &UserClient{
+		validateUserCredentialsInteractor: validateUserCredentialsInteractor,
+		resolveUserInteractor:             resolveUserInteractor,
+		getUserTeamInteractor:             getUserTeamInteractor,
+		logger:                            ucLogger,
+	}
 }
 
 func (uClient *UserClient) VerifyCredentials(
@@ -59,3 +69,43 @@ func (uClient *UserClient) VerifyCredentials(
 	)
 	return client.VerifyCredentialsResponse{UserID: responce.UserID, UserRole: client.UserRole(responce.UserRole)}, nil
 }
+
+func (uClient *UserClient) ResolveUser(ctx context.Context, userID uuid.UUID) (client.ResolveUserResponse, error) {
+	response, err := uClient.resolveUserInteractor.Execute(ctx, application.ResolveUserRequest{UserID: userID})
+	if err != nil {
+		switch {
+		case errors.Is(err, application.ErrUserNotFound):
+			uClient.logger.InfoContext(ctx, "resolution of non-existent user",
+				slog.String("user_id", userID.String()))
+			return client.ResolveUserResponse{}, client.ErrUserAbsent
+
+		default:
+			uClient.logger.ErrorContext(ctx, "unexpected error during user resolution",
+				slog.Any("err", err))
+			return client.ResolveUserResponse{}, client.ErrUnexpectedError
+		}
+	}
+	return client.ResolveUserResponse{
+		UserID:   response.UserID,
+		UserRole: client.UserRole(response.UserRole),
+		TeamID:   response.TeamID,
+	}, nil
+}
+
+func (uClient *UserClient) GetUserTeam(ctx context.Context, userID uuid.UUID) (uuid.NullUUID, error) {
+	response, err := uClient.getUserTeamInteractor.Execute(ctx, application.GetUserTeamRequest{UserID: userID})
+	if err != nil {
+		switch {
+		case errors.Is(err, application.ErrUserNotFound):
+			uClient.logger.InfoContext(ctx, "team requested for non-existent user",
+				slog.String("user_id", userID.String()))
+			return uuid.NullUUID{}, client.ErrUserAbsent
+
+		default:
+			uClient.logger.ErrorContext(ctx, "unexpected error during team resolution",
+				slog.Any("err", err))
+			return uuid.NullUUID{}, client.ErrUnexpectedError
+		}
+	}
+	return response.TeamID, nil
+}
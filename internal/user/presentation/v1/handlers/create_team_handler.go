@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/user/application"
+	"github.com/InWamos/trinity-proto/internal/user/presentation/service"
+)
+
+// CreateTeamResponse represents the response from the CreateTeam endpoint
+//
+//	@Description	Team creation response with ID
+type CreateTeamResponse struct {
+	Message string `json:"message" example:"The team has been created"`
+	ID      string `json:"id"      example:"019b1a49-dbf6-74d6-97bf-2d7e57d30c76"`
+}
+
+type createTeamForm struct {
+	Name string `json:"name" validate:"required,min=1,max=64"`
+}
+
+type CreateTeamHandler struct {
+	interactor *application.CreateTeam
+	validator  service.PostFormValidator
+	logger     *slog.Logger
+}
+
+// NewCreateTeamHandler builds a new CreateTeamHandler.
+func NewCreateTeamHandler(
+	interactor *application.CreateTeam,
+	validator service.PostFormValidator,
+	logger *slog.Logger,
+) *CreateTeamHandler {
+	cthLogger := logger.With(slog.String("component", "handler"), slog.String("name", "create_team"))
+	return &CreateTeamHandler{interactor: interactor, validator: validator, logger: cthLogger}
+}
+
+// ServeHTTP handles an HTTP request to create a team.
+//
+//	@Summary		Create a new team
+//	@Description	Create a team sharing the deployment. The users that join it only see the data collected
+//	@Description	within the team.
+//	@Tags			teams
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		createTeamForm		true	"Team creation request"
+//	@Success		201		{object}	CreateTeamResponse	"Team created successfully"
+//	@Failure		400		{object}	ErrorResponse		"Invalid request body"
+//	@Failure		403		{object}	ErrorResponse		"Insufficient privileges"
+//	@Failure		409		{object}	ErrorResponse		"Team already exists"
+//	@Failure		500		{object}	ErrorResponse		"Internal server error"
+//	@Router			/v1/teams/ [post]
+func (handler *CreateTeamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	var teamForm createTeamForm
+	if err := handler.validator.ValidateBody(r.Body, &teamForm); err != nil {
+		handler.logger.DebugContext(r.Context(), "failed to validate the form", slog.Any("err", err))
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "Invalid request body"})
+		return
+	}
+
+	requestDTO := application.CreateTeamRequest{Name: teamForm.Name}
+	response, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "failed to call the interactor", slog.Any("err", err))
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			w.WriteHeader(http.StatusForbidden)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "Insufficient privileges"})
+			return
+		case errors.Is(err, application.ErrTeamAlreadyExists):
+			w.WriteHeader(http.StatusConflict)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "Team already exists"})
+			return
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "Internal server error"})
+			return
+		}
+	}
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"message": "The team has been created",
+		"id":      response.TeamID.String(),
+	})
+}
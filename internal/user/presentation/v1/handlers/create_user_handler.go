@@ -10,6 +10,7 @@ import (
 	"github.com/InWamos/trinity-proto/internal/user/application"
 	"github.com/InWamos/trinity-proto/internal/user/domain"
 	"github.com/InWamos/trinity-proto/internal/user/presentation/service"
+	"github.com/google/uuid"
 )
 
 // CreateUserResponse represents the response from the CreateUser endpoint
@@ -32,6 +33,7 @@ type createUserForm struct {
 	DisplayName string `json:"display_name" validate:"required,min=1,max=64"`
 	Password    string `json:"password"     validate:"required,alphanumunicode,min=8,max=64"`
 	UserRole    string `json:"user_role"    validate:"required,oneof=user admin"`
+	TeamID      string `json:"team_id"      validate:"omitempty,uuid"`
 }
 
 type CreateUserHandler struct {
@@ -53,13 +55,16 @@ func NewCreateUserHandler(
 // ServeHTTP handles an HTTP request to create a user.
 //
 //	@Summary		Create a new user
-//	@Description	Create a new user with username, display name, password and role
+//	@Description	Create a new user with username, display name, password and role.
+//	@Description	The user may join a team, which can't be changed later: the users only see the data
+//	@Description	collected within their team, the users without a team share the data collected before the teams.
 //	@Tags			users
 //	@Accept			json
 //	@Produce		json
 //	@Param			request	body		createUserForm		true	"User creation request"
 //	@Success		201		{object}	CreateUserResponse	"User created successfully"
 //	@Failure		400		{object}	ErrorResponse		"Invalid request body"
+//	@Failure		404		{object}	ErrorResponse		"Team not found"
 //	@Failure		500		{object}	ErrorResponse		"Internal server error"
 //	@Router			/v1/users/ [post]
 func (handler *CreateUserHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -72,11 +77,24 @@ func (handler *CreateUserHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	var teamID uuid.NullUUID
+	if userForm.TeamID != "" {
+		parsedTeamID, err := uuid.Parse(userForm.TeamID)
+		if err != nil {
+			handler.logger.DebugContext(r.Context(), "invalid team ID format", slog.Any("err", err))
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "Invalid request body"})
+			return
+		}
+		teamID = uuid.NullUUID{UUID: parsedTeamID, Valid: true}
+	}
+
 	requestDTO := application.CreateUserRequest{
 		Username:    userForm.Username,
 		DisplayName: userForm.DisplayName,
 		Password:    userForm.Password,
 		Role:        domain.Role(userForm.UserRole),
+		TeamID:      teamID,
 	}
 	response, err := handler.interactor.Execute(r.Context(), requestDTO)
 	if err != nil {
@@ -86,6 +104,10 @@ func (handler *CreateUserHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 			w.WriteHeader(http.StatusForbidden)
 			_ = json.NewEncoder(w).Encode(map[string]string{"error": "Insufficient privileges"})
 			return
+		case errors.Is(err, application.ErrTeamNotFound):
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "Team not found"})
+			return
 		default:
 			w.WriteHeader(http.StatusInternalServerError)
 			_ = json.NewEncoder(w).Encode(map[string]string{"error": "Internal server error"})
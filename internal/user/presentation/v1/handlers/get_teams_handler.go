@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/user/application"
+)
+
+// GetTeamResponse represents a team
+//
+//	@Description	Team information
+type GetTeamResponse struct {
+	ID        string    `json:"id"         example:"019b1a49-dbf6-74d6-97bf-2d7e57d30c76"`
+	Name      string    `json:"name"       example:"Fraud department"`
+	CreatedAt time.Time `json:"created_at" example:"2025-12-14T00:36:46.545Z"`
+}
+
+type GetTeamsHandler struct {
+	interactor *application.GetTeams
+	logger     *slog.Logger
+}
+
+func NewGetTeamsHandler(
+	interactor *application.GetTeams,
+	logger *slog.Logger,
+) *GetTeamsHandler {
+	gthLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_teams"),
+	)
+	return &GetTeamsHandler{
+		interactor: interactor,
+		logger:     gthLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP GET request to list the teams.
+//
+//	@Summary		List teams
+//	@Description	Retrieve every team sharing the deployment, ordered by name
+//	@Tags			teams
+//	@Produce		json
+//	@Success		200	{array}		GetTeamResponse	"Teams"
+//	@Failure		403	{object}	ErrorResponse	"Insufficient privileges"
+//	@Failure		500	{object}	ErrorResponse	"Server error"
+//	@Router			/v1/teams/ [get]
+func (handler *GetTeamsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	response, err := handler.interactor.Execute(r.Context())
+	if err != nil {
+		handler.logger.ErrorContext(r.Context(), "failed to get teams", slog.Any("err", err))
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			w.WriteHeader(http.StatusForbidden)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "Insufficient privileges"})
+			return
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "Internal server error"})
+			return
+		}
+	}
+
+	teams := make([]GetTeamResponse, len(response.Teams))
+	for i, team := range response.Teams {
+		teams[i] = GetTeamResponse{ID: team.ID.String(), Name: team.Name, CreatedAt: team.CreatedAt}
+	}
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(teams)
+}
@@ -20,6 +20,7 @@ type GetUserResponse struct {
 	Username    string    `json:"username"     example:"johndoe"`
 	DisplayName string    `json:"display_name" example:"John Doe"`
 	UserRole    string    `json:"user_role"    example:"user"                                 enums:"user,admin"`
+	TeamID      *string   `json:"team_id"      example:"019b1a49-dbf6-74d6-97bf-2d7e57d30c76"`
 	CreatedAt   time.Time `json:"created_at"   example:"2025-12-14T00:36:46.545Z"`
 }
 
@@ -102,6 +103,7 @@ func (handler *GetUserHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		"username":     response.User.Username,
 		"display_name": response.User.DisplayName,
 		"user_role":    response.User.Role,
+		"team_id":      response.User.TeamID,
 		"created_at":   response.User.CreatedAt,
 	})
 }
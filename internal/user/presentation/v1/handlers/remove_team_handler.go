@@ -0,0 +1,86 @@
+//nolint:dupl // Intended to be similar to other handlers
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/user/application"
+	"github.com/google/uuid"
+)
+
+type RemoveTeamHandler struct {
+	interactor *application.RemoveTeam
+	logger     *slog.Logger
+}
+
+func NewRemoveTeamHandler(
+	interactor *application.RemoveTeam,
+	logger *slog.Logger,
+) *RemoveTeamHandler {
+	rthLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "remove_team"),
+	)
+	return &RemoveTeamHandler{
+		interactor: interactor,
+		logger:     rthLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP DELETE request to remove a team.
+//
+//	@Summary		Delete a team
+//	@Description	Remove a team no user has ever joined, the removed users still belong to their team
+//	@Tags			teams
+//	@Produce		json
+//	@Param			id	path		string			true	"Team ID (UUID)"	format(uuid)
+//	@Success		200	{object}	SuccessResponse	"Team deleted successfully"
+//	@Failure		400	{object}	ErrorResponse	"Invalid team ID format"
+//	@Failure		403	{object}	ErrorResponse	"Insufficient privileges"
+//	@Failure		404	{object}	ErrorResponse	"Team not found"
+//	@Failure		409	{object}	ErrorResponse	"Team still has users"
+//	@Failure		500	{object}	ErrorResponse	"Server error"
+//	@Router			/v1/teams/{id} [delete]
+func (handler *RemoveTeamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	teamID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid team ID format", slog.Any("err", err))
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "Invalid team ID format"})
+		return
+	}
+
+	err = handler.interactor.Execute(r.Context(), application.RemoveTeamRequest{ID: teamID})
+	if err != nil {
+		handler.logger.ErrorContext(r.Context(), "failed to remove team", slog.Any("err", err))
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			w.WriteHeader(http.StatusForbidden)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "Insufficient privileges"})
+			return
+		case errors.Is(err, application.ErrTeamNotFound):
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "Team not found"})
+			return
+		case errors.Is(err, application.ErrTeamNotEmpty):
+			w.WriteHeader(http.StatusConflict)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "Team still has users"})
+			return
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "Internal server error"})
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"message": "Team removed successfully",
+	})
+}
@@ -3,6 +3,7 @@ package v1
 import (
 	"github.com/InWamos/trinity-proto/internal/user/presentation/v1/handlers"
 	"github.com/go-chi/chi/v5"
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
 )
 
 type UserMuxV1 struct {
@@ -17,6 +18,8 @@ func NewUserMuxV1(
 	removeUserHandler *handlers.RemoveUserHandler,
 ) *UserMuxV1 {
 	mux := chi.NewRouter()
+	// Only allow json content type
+	mux.Use(chiMiddleware.AllowContentType("application/json"))
 	mux.Post("/", createUserHandler.ServeHTTP)
 	mux.Get("/{id}", getUserHandler.ServeHTTP)
 	mux.Delete("/{id}", removeUserHandler.ServeHTTP)
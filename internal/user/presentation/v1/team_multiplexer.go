@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"github.com/InWamos/trinity-proto/internal/user/presentation/v1/handlers"
+	"github.com/go-chi/chi/v5"
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+)
+
+type TeamMuxV1 struct {
+	mux *chi.Mux
+}
+
+func NewTeamMuxV1(
+	createTeamHandler *handlers.CreateTeamHandler,
+	getTeamsHandler *handlers.GetTeamsHandler,
+	removeTeamHandler *handlers.RemoveTeamHandler,
+) *TeamMuxV1 {
+	mux := chi.NewRouter()
+	// Only allow json content type
+	mux.Use(chiMiddleware.AllowContentType("application/json"))
+	mux.Post("/", createTeamHandler.ServeHTTP)
+	mux.Get("/", getTeamsHandler.ServeHTTP)
+	mux.Delete("/{id}", removeTeamHandler.ServeHTTP)
+	return &TeamMuxV1{mux: mux}
+}
+
+func (tm *TeamMuxV1) GetMux() *chi.Mux {
+	return tm.mux
+}
@@ -0,0 +1,113 @@
+package application
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/webhook/domain"
+	"github.com/InWamos/trinity-proto/internal/webhook/infrastructure/repository"
+	"github.com/google/uuid"
+)
+
+const (
+	minSecretLength = 16
+	maxSecretLength = 256
+)
+
+type AddWebhookSubscriptionRequest struct {
+	URL        string
+	EventTypes []domain.EventType
+	Secret     string
+}
+
+type AddWebhookSubscriptionResponse struct {
+	SubscriptionID uuid.UUID
+}
+
+type AddWebhookSubscription struct {
+	transactionManagerFactory            interfaces.TransactionManagerFactory
+	webhookSubscriptionRepositoryFactory repository.WebhookSubscriptionRepositoryFactory
+	logger                               *slog.Logger
+}
+
+func NewAddWebhookSubscription(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	webhookSubscriptionRepositoryFactory repository.WebhookSubscriptionRepositoryFactory,
+	logger *slog.Logger,
+) *AddWebhookSubscription {
+	awsLogger := logger.With(
+		slog.String("component", "interactor"),
+		slog.String("name", "add_webhook_subscription"),
+	)
+	return &AddWebhookSubscription{
+		transactionManagerFactory:            transactionManagerFactory,
+		webhookSubscriptionRepositoryFactory: webhookSubscriptionRepositoryFactory,
+		logger:                               awsLogger,
+	}
+}
+
+func (interactor *AddWebhookSubscription) Execute(
+	ctx context.Context,
+	input AddWebhookSubscriptionRequest,
+) (*AddWebhookSubscriptionResponse, error) {
+	idp, err := authorizeAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, err := url.Parse(input.URL)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return nil, ErrInvalidURL
+	}
+	if len(input.EventTypes) == 0 {
+		return nil, ErrInvalidEvents
+	}
+	for _, eventType := range input.EventTypes {
+		if !eventType.IsValid() {
+			return nil, ErrInvalidEvents
+		}
+	}
+	if len(input.Secret) < minSecretLength || len(input.Secret) > maxSecretLength {
+		return nil, ErrInvalidSecret
+	}
+	eventTypes := slices.Clone(input.EventTypes)
+	slices.Sort(eventTypes)
+
+	subscription := &domain.WebhookSubscription{
+		ID:            uuid.New(),
+		URL:           endpoint.String(),
+		EventTypes:    slices.Compact(eventTypes),
+		Secret:        input.Secret,
+		CreatedAt:     time.Now(),
+		CreatedByUser: idp.UserID,
+		TenantID:      idp.TeamID,
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started AddWebhookSubscription execution",
+		slog.String("subscription_id", subscription.ID.String()),
+	)
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, ErrDatabaseFailed
+	}
+	subscriptionRepository := interactor.webhookSubscriptionRepositoryFactory.
+		CreateWebhookSubscriptionRepositoryWithTransaction(transactionManager)
+	if err = subscriptionRepository.AddWebhookSubscription(ctx, subscription); err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		return nil, ErrDatabaseFailed
+	}
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return nil, ErrDatabaseFailed
+	}
+
+	interactor.logger.DebugContext(ctx, "Finished AddWebhookSubscription execution")
+	return &AddWebhookSubscriptionResponse{SubscriptionID: subscription.ID}, nil
+}
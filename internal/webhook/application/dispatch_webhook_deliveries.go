@@ -0,0 +1,139 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/InWamos/trinity-proto/config"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/webhook/domain"
+	"github.com/InWamos/trinity-proto/internal/webhook/domain/service"
+	"github.com/InWamos/trinity-proto/internal/webhook/infrastructure/repository"
+	"github.com/InWamos/trinity-proto/internal/webhook/infrastructure/sender"
+)
+
+// maxLastErrorLength truncates the errors kept in the delivery log.
+const maxLastErrorLength = 1024
+
+// DispatchWebhookDeliveries sends a batch of the due deliveries. The batch is claimed in a transaction
+// of its own, so that the database isn't kept waiting for the endpoints, and each outcome is stored apart.
+type DispatchWebhookDeliveries struct {
+	transactionManagerFactory        interfaces.TransactionManagerFactory
+	webhookDeliveryRepositoryFactory repository.WebhookDeliveryRepositoryFactory
+	webhookSender                    sender.WebhookSender
+	retryPolicy                      *service.WebhookRetryPolicy
+	batchSize                        int
+	// lease is how long a claimed delivery is kept from the other workers, it outlasts the attempt
+	lease  time.Duration
+	logger *slog.Logger
+}
+
+func NewDispatchWebhookDeliveries(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	webhookDeliveryRepositoryFactory repository.WebhookDeliveryRepositoryFactory,
+	webhookSender sender.WebhookSender,
+	webhookConfig *config.WebhookConfig,
+	logger *slog.Logger,
+) *DispatchWebhookDeliveries {
+	dwdLogger := logger.With(
+		slog.String("component", "interactor"),
+		slog.String("name", "dispatch_webhook_deliveries"),
+	)
+	return &DispatchWebhookDeliveries{
+		transactionManagerFactory:        transactionManagerFactory,
+		webhookDeliveryRepositoryFactory: webhookDeliveryRepositoryFactory,
+		webhookSender:                    webhookSender,
+		retryPolicy:                      service.NewWebhookRetryPolicy(webhookConfig.MaxAttempts),
+		batchSize:                        webhookConfig.BatchSize,
+		lease:                            time.Duration(webhookConfig.BatchSize+1) * webhookConfig.RequestTimeout,
+		logger:                           dwdLogger,
+	}
+}
+
+// Execute returns how many deliveries have been attempted, a full batch means more may be due already.
+func (interactor *DispatchWebhookDeliveries) Execute(ctx context.Context) (int, error) {
+	now := time.Now()
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return 0, ErrDatabaseFailed
+	}
+	deliveryRepository := interactor.webhookDeliveryRepositoryFactory.
+		CreateWebhookDeliveryRepositoryWithTransaction(transactionManager)
+	dueDeliveries, err := deliveryRepository.ClaimDueWebhookDeliveries(
+		ctx,
+		now,
+		now.Add(interactor.lease),
+		interactor.batchSize,
+	)
+	if err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		return 0, ErrDatabaseFailed
+	}
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return 0, ErrDatabaseFailed
+	}
+
+	var errs []error
+	for i := range *dueDeliveries {
+		if err = interactor.attempt(ctx, &(*dueDeliveries)[i]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return len(*dueDeliveries), errors.Join(errs...)
+}
+
+func (interactor *DispatchWebhookDeliveries) attempt(ctx context.Context, due *domain.DueWebhookDelivery) error {
+	delivery := &due.Delivery
+	responseStatus, sendErr := interactor.webhookSender.Send(ctx, due)
+	if responseStatus != 0 {
+		delivery.LastResponseStatus = &responseStatus
+	}
+	switch {
+	case sendErr == nil:
+		delivery.Status = domain.DeliverySucceeded
+		delivery.LastError = nil
+	default:
+		lastError := sendErr.Error()
+		if len(lastError) > maxLastErrorLength {
+			lastError = strings.ToValidUTF8(lastError[:maxLastErrorLength], "")
+		}
+		delivery.LastError = &lastError
+		if delay, ok := interactor.retryPolicy.NextAttemptDelay(delivery.Attempts); ok {
+			delivery.Status = domain.DeliveryPending
+			delivery.NextAttemptAt = time.Now().Add(delay)
+		} else {
+			delivery.Status = domain.DeliveryFailed
+		}
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Webhook delivery has been attempted",
+		slog.String("delivery_id", delivery.ID.String()),
+		slog.Int("attempts", delivery.Attempts),
+		slog.String("status", string(delivery.Status)),
+	)
+
+	// The outcome is stored even if the worker is being stopped, otherwise the attempt would be made again
+	ctx = context.WithoutCancel(ctx)
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return ErrDatabaseFailed
+	}
+	deliveryRepository := interactor.webhookDeliveryRepositoryFactory.
+		CreateWebhookDeliveryRepositoryWithTransaction(transactionManager)
+	if err = deliveryRepository.UpdateWebhookDeliveryOutcome(ctx, delivery); err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		return ErrDatabaseFailed
+	}
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return ErrDatabaseFailed
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package application
+
+import "errors"
+
+var (
+	ErrDatabaseFailed = errors.New("the database operation has failed")
+	ErrInvalidURL     = errors.New("the URL must be an absolute http or https URL")
+	ErrInvalidEvents  = errors.New("at least one known event type must be subscribed to")
+	ErrInvalidSecret  = errors.New(
+		"the secret must be between 16 and 256 characters long",
+	)
+	ErrInvalidDeliveryStatus = errors.New("unknown delivery status")
+	ErrInvalidPagination     = errors.New("limit must be between 1 and 100 and offset must not be negative")
+)
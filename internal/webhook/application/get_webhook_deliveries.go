@@ -0,0 +1,110 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/webhook/domain"
+	"github.com/InWamos/trinity-proto/internal/webhook/infrastructure/repository"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultWebhookDeliveries is used when the limit is not set.
+	DefaultWebhookDeliveries = 50
+	// MaxWebhookDeliveries caps the limit.
+	MaxWebhookDeliveries = 100
+)
+
+type GetWebhookDeliveriesRequest struct {
+	SubscriptionID uuid.UUID
+	// Status filters the deliveries, all of them are returned when it's empty
+	Status domain.DeliveryStatus
+	Limit  int
+	Offset int
+}
+
+type GetWebhookDeliveriesResponse struct {
+	Deliveries []domain.WebhookDelivery
+}
+
+// GetWebhookDeliveries is the delivery log of a subscription, newest first.
+type GetWebhookDeliveries struct {
+	transactionManagerFactory            interfaces.TransactionManagerFactory
+	webhookSubscriptionRepositoryFactory repository.WebhookSubscriptionRepositoryFactory
+	webhookDeliveryRepositoryFactory     repository.WebhookDeliveryRepositoryFactory
+	logger                               *slog.Logger
+}
+
+func NewGetWebhookDeliveries(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	webhookSubscriptionRepositoryFactory repository.WebhookSubscriptionRepositoryFactory,
+	webhookDeliveryRepositoryFactory repository.WebhookDeliveryRepositoryFactory,
+	logger *slog.Logger,
+) *GetWebhookDeliveries {
+	gwdLogger := logger.With(
+		slog.String("component", "interactor"),
+		slog.String("name", "get_webhook_deliveries"),
+	)
+	return &GetWebhookDeliveries{
+		transactionManagerFactory:            transactionManagerFactory,
+		webhookSubscriptionRepositoryFactory: webhookSubscriptionRepositoryFactory,
+		webhookDeliveryRepositoryFactory:     webhookDeliveryRepositoryFactory,
+		logger:                               gwdLogger,
+	}
+}
+
+func (interactor *GetWebhookDeliveries) Execute(
+	ctx context.Context,
+	input GetWebhookDeliveriesRequest,
+) (*GetWebhookDeliveriesResponse, error) {
+	idp, err := authorizeAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.Status != "" && !input.Status.IsValid() {
+		return nil, ErrInvalidDeliveryStatus
+	}
+	if input.Limit == 0 {
+		input.Limit = DefaultWebhookDeliveries
+	}
+	if input.Limit < 1 || input.Limit > MaxWebhookDeliveries || input.Offset < 0 {
+		return nil, ErrInvalidPagination
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started GetWebhookDeliveries execution",
+		slog.String("subscription_id", input.SubscriptionID.String()),
+	)
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, ErrDatabaseFailed
+	}
+	defer rollback(ctx, interactor.logger, transactionManager)
+
+	subscriptionRepository := interactor.webhookSubscriptionRepositoryFactory.
+		CreateWebhookSubscriptionRepositoryWithTransaction(transactionManager)
+	if _, err = subscriptionRepository.GetWebhookSubscriptionByID(ctx, idp.TeamID, input.SubscriptionID); err != nil {
+		if errors.Is(err, domain.ErrSubscriptionNotFound) {
+			return nil, err
+		}
+		return nil, ErrDatabaseFailed
+	}
+	deliveryRepository := interactor.webhookDeliveryRepositoryFactory.
+		CreateWebhookDeliveryRepositoryWithTransaction(transactionManager)
+	deliveries, err := deliveryRepository.GetWebhookDeliveriesBySubscription(
+		ctx,
+		input.SubscriptionID,
+		input.Status,
+		input.Limit,
+		input.Offset,
+	)
+	if err != nil {
+		return nil, ErrDatabaseFailed
+	}
+	return &GetWebhookDeliveriesResponse{Deliveries: *deliveries}, nil
+}
@@ -0,0 +1,59 @@
+package application
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/webhook/domain"
+	"github.com/InWamos/trinity-proto/internal/webhook/infrastructure/repository"
+)
+
+type GetWebhookSubscriptionsResponse struct {
+	Subscriptions []domain.WebhookSubscription
+}
+
+type GetWebhookSubscriptions struct {
+	transactionManagerFactory            interfaces.TransactionManagerFactory
+	webhookSubscriptionRepositoryFactory repository.WebhookSubscriptionRepositoryFactory
+	logger                               *slog.Logger
+}
+
+func NewGetWebhookSubscriptions(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	webhookSubscriptionRepositoryFactory repository.WebhookSubscriptionRepositoryFactory,
+	logger *slog.Logger,
+) *GetWebhookSubscriptions {
+	gwsLogger := logger.With(
+		slog.String("component", "interactor"),
+		slog.String("name", "get_webhook_subscriptions"),
+	)
+	return &GetWebhookSubscriptions{
+		transactionManagerFactory:            transactionManagerFactory,
+		webhookSubscriptionRepositoryFactory: webhookSubscriptionRepositoryFactory,
+		logger:                               gwsLogger,
+	}
+}
+
+func (interactor *GetWebhookSubscriptions) Execute(ctx context.Context) (*GetWebhookSubscriptionsResponse, error) {
+	idp, err := authorizeAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	interactor.logger.DebugContext(ctx, "Started GetWebhookSubscriptions execution")
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, ErrDatabaseFailed
+	}
+	defer rollback(ctx, interactor.logger, transactionManager)
+
+	subscriptionRepository := interactor.webhookSubscriptionRepositoryFactory.
+		CreateWebhookSubscriptionRepositoryWithTransaction(transactionManager)
+	subscriptions, err := subscriptionRepository.GetWebhookSubscriptions(ctx, idp.TeamID)
+	if err != nil {
+		return nil, ErrDatabaseFailed
+	}
+	return &GetWebhookSubscriptionsResponse{Subscriptions: *subscriptions}, nil
+}
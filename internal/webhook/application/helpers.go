@@ -0,0 +1,29 @@
+package application
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userDomain "github.com/InWamos/trinity-proto/internal/user/domain"
+)
+
+// authorizeAdmin lets only admins manage the subscriptions of their team, as they receive the data of every user.
+func authorizeAdmin(ctx context.Context) (*client.UserIdentity, error) {
+	idp, ok := ctx.Value(client.IdentityProviderKey).(*client.UserIdentity)
+	if !ok || idp == nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+	if err := rbac.AuthorizeByRole(idp, userDomain.RoleAdmin); err != nil {
+		return nil, rbac.ErrInsufficientPrivileges
+	}
+	return idp, nil
+}
+
+func rollback(ctx context.Context, logger *slog.Logger, transactionManager interfaces.TransactionManager) {
+	if err := transactionManager.Rollback(ctx); err != nil {
+		logger.ErrorContext(ctx, "failed to rollback transaction", slog.Any("err", err))
+	}
+}
@@ -0,0 +1,79 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/webhook/domain"
+	"github.com/InWamos/trinity-proto/internal/webhook/infrastructure/repository"
+	"github.com/google/uuid"
+)
+
+type PublishWebhookEventRequest struct {
+	EventID    uuid.UUID
+	EventType  domain.EventType
+	OccurredAt time.Time
+	Data       json.RawMessage
+	// TenantID is the team the event has been recorded within, only its subscriptions receive the event
+	TenantID    uuid.NullUUID
+	Observation *domain.WebhookObservation
+}
+
+// PublishWebhookEvent queues the deliveries of an event in the transaction it's published from the outbox in.
+// The deliveries of an event keep its ID, so the endpoints may deduplicate the redeliveries by it.
+type PublishWebhookEvent struct {
+	webhookDeliveryRepositoryFactory repository.WebhookDeliveryRepositoryFactory
+	logger                           *slog.Logger
+}
+
+func NewPublishWebhookEvent(
+	webhookDeliveryRepositoryFactory repository.WebhookDeliveryRepositoryFactory,
+	logger *slog.Logger,
+) *PublishWebhookEvent {
+	pweLogger := logger.With(
+		slog.String("component", "interactor"),
+		slog.String("name", "publish_webhook_event"),
+	)
+	return &PublishWebhookEvent{
+		webhookDeliveryRepositoryFactory: webhookDeliveryRepositoryFactory,
+		logger:                           pweLogger,
+	}
+}
+
+func (interactor *PublishWebhookEvent) Execute(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	input PublishWebhookEventRequest,
+) error {
+	event := &domain.WebhookEvent{
+		ID:          input.EventID,
+		Type:        input.EventType,
+		CreatedAt:   input.OccurredAt.UTC(),
+		Data:        input.Data,
+		TenantID:    input.TenantID,
+		Observation: input.Observation,
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to marshal event", slog.Any("err", err))
+		return err
+	}
+
+	deliveryRepository := interactor.webhookDeliveryRepositoryFactory.
+		CreateWebhookDeliveryRepositoryWithTransaction(transactionManager)
+	queued, err := deliveryRepository.AddWebhookDeliveries(ctx, event, payload)
+	if err != nil {
+		return ErrDatabaseFailed
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Webhook event has been published",
+		slog.String("event_id", event.ID.String()),
+		slog.String("event_type", string(event.Type)),
+		slog.Int64("deliveries", queued),
+	)
+	return nil
+}
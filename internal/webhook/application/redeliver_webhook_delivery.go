@@ -0,0 +1,81 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/webhook/domain"
+	"github.com/InWamos/trinity-proto/internal/webhook/infrastructure/repository"
+	"github.com/google/uuid"
+)
+
+type RedeliverWebhookDeliveryRequest struct {
+	DeliveryID uuid.UUID
+}
+
+type RedeliverWebhookDeliveryResponse struct {
+	DeliveryID uuid.UUID
+}
+
+// RedeliverWebhookDelivery queues the payload of a delivery again, whatever its status is.
+// The redelivery gets an ID and attempts of its own, the original delivery is kept as it is.
+type RedeliverWebhookDelivery struct {
+	transactionManagerFactory        interfaces.TransactionManagerFactory
+	webhookDeliveryRepositoryFactory repository.WebhookDeliveryRepositoryFactory
+	logger                           *slog.Logger
+}
+
+func NewRedeliverWebhookDelivery(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	webhookDeliveryRepositoryFactory repository.WebhookDeliveryRepositoryFactory,
+	logger *slog.Logger,
+) *RedeliverWebhookDelivery {
+	rwdLogger := logger.With(
+		slog.String("component", "interactor"),
+		slog.String("name", "redeliver_webhook_delivery"),
+	)
+	return &RedeliverWebhookDelivery{
+		transactionManagerFactory:        transactionManagerFactory,
+		webhookDeliveryRepositoryFactory: webhookDeliveryRepositoryFactory,
+		logger:                           rwdLogger,
+	}
+}
+
+func (interactor *RedeliverWebhookDelivery) Execute(
+	ctx context.Context,
+	input RedeliverWebhookDeliveryRequest,
+) (*RedeliverWebhookDeliveryResponse, error) {
+	idp, err := authorizeAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	redeliveryID := uuid.New()
+	interactor.logger.DebugContext(
+		ctx,
+		"Started RedeliverWebhookDelivery execution",
+		slog.String("delivery_id", input.DeliveryID.String()),
+		slog.String("redelivery_id", redeliveryID.String()),
+	)
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return nil, ErrDatabaseFailed
+	}
+	deliveryRepository := interactor.webhookDeliveryRepositoryFactory.
+		CreateWebhookDeliveryRepositoryWithTransaction(transactionManager)
+	if err = deliveryRepository.RedeliverWebhookDelivery(ctx, idp.TeamID, input.DeliveryID, redeliveryID); err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		if errors.Is(err, domain.ErrDeliveryNotFound) {
+			return nil, err
+		}
+		return nil, ErrDatabaseFailed
+	}
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return nil, ErrDatabaseFailed
+	}
+	return &RedeliverWebhookDeliveryResponse{DeliveryID: redeliveryID}, nil
+}
@@ -0,0 +1,70 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/webhook/domain"
+	"github.com/InWamos/trinity-proto/internal/webhook/infrastructure/repository"
+	"github.com/google/uuid"
+)
+
+type RemoveWebhookSubscriptionRequest struct {
+	SubscriptionID uuid.UUID
+}
+
+type RemoveWebhookSubscription struct {
+	transactionManagerFactory            interfaces.TransactionManagerFactory
+	webhookSubscriptionRepositoryFactory repository.WebhookSubscriptionRepositoryFactory
+	logger                               *slog.Logger
+}
+
+func NewRemoveWebhookSubscription(
+	transactionManagerFactory interfaces.TransactionManagerFactory,
+	webhookSubscriptionRepositoryFactory repository.WebhookSubscriptionRepositoryFactory,
+	logger *slog.Logger,
+) *RemoveWebhookSubscription {
+	rwsLogger := logger.With(
+		slog.String("component", "interactor"),
+		slog.String("name", "remove_webhook_subscription"),
+	)
+	return &RemoveWebhookSubscription{
+		transactionManagerFactory:            transactionManagerFactory,
+		webhookSubscriptionRepositoryFactory: webhookSubscriptionRepositoryFactory,
+		logger:                               rwsLogger,
+	}
+}
+
+func (interactor *RemoveWebhookSubscription) Execute(ctx context.Context, input RemoveWebhookSubscriptionRequest) error {
+	idp, err := authorizeAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	interactor.logger.DebugContext(
+		ctx,
+		"Started RemoveWebhookSubscription execution",
+		slog.String("subscription_id", input.SubscriptionID.String()),
+	)
+
+	transactionManager, err := interactor.transactionManagerFactory.NewTransaction(ctx)
+	if err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to create transaction", slog.Any("err", err))
+		return ErrDatabaseFailed
+	}
+	subscriptionRepository := interactor.webhookSubscriptionRepositoryFactory.
+		CreateWebhookSubscriptionRepositoryWithTransaction(transactionManager)
+	if err = subscriptionRepository.RemoveWebhookSubscription(ctx, idp.TeamID, input.SubscriptionID); err != nil {
+		rollback(ctx, interactor.logger, transactionManager)
+		if errors.Is(err, domain.ErrSubscriptionNotFound) {
+			return err
+		}
+		return ErrDatabaseFailed
+	}
+	if err = transactionManager.Commit(ctx); err != nil {
+		interactor.logger.ErrorContext(ctx, "failed to commit", slog.Any("err", err))
+		return ErrDatabaseFailed
+	}
+	return nil
+}
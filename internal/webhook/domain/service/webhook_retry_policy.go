@@ -0,0 +1,41 @@
+package service
+
+import "time"
+
+const (
+	// DefaultWebhookRetryBaseDelay is waited for after the first failed attempt, it doubles with every next one.
+	DefaultWebhookRetryBaseDelay = time.Minute
+	// DefaultWebhookRetryMaxDelay caps the delay between two attempts.
+	DefaultWebhookRetryMaxDelay = 6 * time.Hour
+)
+
+// WebhookRetryPolicy backs off exponentially between the attempts of a delivery until MaxAttempts is reached.
+type WebhookRetryPolicy struct {
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	MaxAttempts int
+}
+
+func NewWebhookRetryPolicy(maxAttempts int) *WebhookRetryPolicy {
+	return &WebhookRetryPolicy{
+		BaseDelay:   DefaultWebhookRetryBaseDelay,
+		MaxDelay:    DefaultWebhookRetryMaxDelay,
+		MaxAttempts: maxAttempts,
+	}
+}
+
+// NextAttemptDelay returns the delay before the attempt following the given amount of failed ones,
+// it's false once the delivery has run out of attempts.
+func (policy *WebhookRetryPolicy) NextAttemptDelay(failedAttempts int) (time.Duration, bool) {
+	if failedAttempts >= policy.MaxAttempts {
+		return 0, false
+	}
+	delay := policy.BaseDelay
+	for i := 1; i < failedAttempts; i++ {
+		delay *= 2
+		if delay >= policy.MaxDelay {
+			return policy.MaxDelay, true
+		}
+	}
+	return min(delay, policy.MaxDelay), true
+}
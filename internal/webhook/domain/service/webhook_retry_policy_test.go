@@ -0,0 +1,25 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/InWamos/trinity-proto/internal/webhook/domain/service"
+)
+
+func TestWebhookRetryPolicy(t *testing.T) {
+	policy := service.NewWebhookRetryPolicy(12)
+	expected := []time.Duration{
+		time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute, 16 * time.Minute, 32 * time.Minute,
+		64 * time.Minute, 128 * time.Minute, 256 * time.Minute, 6 * time.Hour, 6 * time.Hour,
+	}
+	for i, want := range expected {
+		delay, ok := policy.NextAttemptDelay(i + 1)
+		if !ok || delay != want {
+			t.Errorf("after %d failed attempts expected a retry in %s, got %s (%t)", i+1, want, delay, ok)
+		}
+	}
+	if _, ok := policy.NextAttemptDelay(12); ok {
+		t.Error("expected no retry once the attempts have run out")
+	}
+}
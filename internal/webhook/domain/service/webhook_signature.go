@@ -0,0 +1,40 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureHeader holds the HMAC-SHA256 of the timestamp and the body, prefixed with SignaturePrefix.
+	SignatureHeader = "X-Trinity-Signature"
+	// TimestampHeader holds the Unix time the delivery has been signed at,
+	// so that receivers can reject the replays of old deliveries.
+	TimestampHeader = "X-Trinity-Timestamp"
+	// EventHeader holds the type of the delivered event.
+	EventHeader = "X-Trinity-Event"
+	// DeliveryHeader holds the ID of the delivery, it's the same for all the attempts of a delivery.
+	DeliveryHeader  = "X-Trinity-Delivery"
+	SignaturePrefix = "sha256="
+)
+
+// SignWebhookPayload signs "<unix timestamp>.<body>" with the secret of the subscription.
+func SignWebhookPayload(secret string, signedAt time.Time, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(signedAt.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return SignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookSignature is what receivers do to authenticate a delivery, the comparison is constant-time.
+func VerifyWebhookSignature(secret string, signedAt time.Time, payload []byte, signature string) bool {
+	if !strings.HasPrefix(signature, SignaturePrefix) {
+		return false
+	}
+	return hmac.Equal([]byte(SignWebhookPayload(secret, signedAt, payload)), []byte(signature))
+}
@@ -0,0 +1,35 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/InWamos/trinity-proto/internal/webhook/domain/service"
+)
+
+func TestWebhookSignature(t *testing.T) {
+	signedAt := time.Unix(1700000000, 0)
+	payload := []byte(`{"type":"user.demoted"}`)
+	signature := service.SignWebhookPayload("secret", signedAt, payload)
+
+	if !service.VerifyWebhookSignature("secret", signedAt, payload, signature) {
+		t.Errorf("expected signature %s to be verified", signature)
+	}
+	tampered := []struct {
+		name      string
+		secret    string
+		signedAt  time.Time
+		payload   []byte
+		signature string
+	}{
+		{name: "secret", secret: "other", signedAt: signedAt, payload: payload, signature: signature},
+		{name: "timestamp", secret: "secret", signedAt: signedAt.Add(time.Second), payload: payload, signature: signature},
+		{name: "payload", secret: "secret", signedAt: signedAt, payload: []byte(`{}`), signature: signature},
+		{name: "prefix", secret: "secret", signedAt: signedAt, payload: payload, signature: signature[7:]},
+	}
+	for _, tc := range tampered {
+		if service.VerifyWebhookSignature(tc.secret, tc.signedAt, tc.payload, tc.signature) {
+			t.Errorf("expected signature with another %s to be rejected", tc.name)
+		}
+	}
+}
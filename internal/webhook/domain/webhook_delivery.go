@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrDeliveryNotFound = errors.New("webhook delivery was not found")
+
+type DeliveryStatus string
+
+const (
+	// DeliveryPending deliveries are sent once their NextAttemptAt has passed.
+	DeliveryPending DeliveryStatus = "pending"
+	// DeliverySucceeded deliveries have been answered with a 2xx status.
+	DeliverySucceeded DeliveryStatus = "succeeded"
+	// DeliveryFailed deliveries have run out of attempts, they're only sent again when redelivered.
+	DeliveryFailed DeliveryStatus = "failed"
+)
+
+func (status DeliveryStatus) IsValid() bool {
+	switch status {
+	case DeliveryPending, DeliverySucceeded, DeliveryFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// WebhookEvent is the body of the deliveries, every subscription to its type gets the same one.
+// The audience of the event isn't a part of the body, it only chooses the subscriptions it's delivered to.
+type WebhookEvent struct {
+	ID        uuid.UUID       `json:"id"`
+	Type      EventType       `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+	// TenantID is the team the event has been recorded within
+	TenantID uuid.NullUUID `json:"-"`
+	// Observation is the way the data of the event has been collected, nil when it isn't about the observed data
+	Observation *WebhookObservation `json:"-"`
+}
+
+// WebhookObservation is the collector of the data an event is about and the visibility they've shared it with.
+type WebhookObservation struct {
+	ObservedByUser uuid.UUID
+	Visibility     string
+}
+
+// WebhookDelivery is an event queued for a subscription along with the outcome of its last attempt.
+type WebhookDelivery struct {
+	ID                 uuid.UUID
+	SubscriptionID     uuid.UUID
+	EventID            uuid.UUID
+	EventType          EventType
+	Payload            []byte
+	Status             DeliveryStatus
+	Attempts           int
+	NextAttemptAt      time.Time
+	LastAttemptAt      *time.Time
+	LastResponseStatus *int
+	LastError          *string
+	// RedeliveryOf is the delivery this one has been manually redelivered from
+	RedeliveryOf *uuid.UUID
+	CreatedAt    time.Time
+}
+
+// DueWebhookDelivery is a delivery claimed for an attempt, with the endpoint and the secret of its subscription.
+type DueWebhookDelivery struct {
+	Delivery WebhookDelivery
+	URL      string
+	Secret   string
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrSubscriptionNotFound = errors.New("webhook subscription was not found")
+
+type EventType string
+
+const (
+	EventTelegramRecordCreated   EventType = "telegram_record.created"
+	EventTelegramIdentityCreated EventType = "telegram_identity.created"
+	EventUserCreated             EventType = "user.created"
+	EventUserPromoted            EventType = "user.promoted"
+	EventUserDemoted             EventType = "user.demoted"
+	EventUserRemoved             EventType = "user.removed"
+)
+
+// EventTypes lists every event a subscription may be made to.
+var EventTypes = []EventType{
+	EventTelegramRecordCreated,
+	EventTelegramIdentityCreated,
+	EventUserCreated,
+	EventUserPromoted,
+	EventUserDemoted,
+	EventUserRemoved,
+}
+
+func (eventType EventType) IsValid() bool {
+	return slices.Contains(EventTypes, eventType)
+}
+
+// WebhookSubscription is an endpoint the events of its types are delivered to.
+// The Secret signs the deliveries, it's never returned once the subscription has been added.
+type WebhookSubscription struct {
+	ID            uuid.UUID
+	URL           string
+	EventTypes    []EventType
+	Secret        string
+	CreatedAt     time.Time
+	CreatedByUser uuid.UUID
+	// TenantID is the team the subscription has been added within, it only receives the events of the team
+	TenantID uuid.NullUUID
+}
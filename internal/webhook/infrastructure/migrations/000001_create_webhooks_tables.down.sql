@@ -0,0 +1,8 @@
+-- squawk-ignore-file ban-drop-table
+-- Drop webhook subscriptions and their deliveries
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+DROP TABLE IF EXISTS "webhooks".deliveries;
+DROP TABLE IF EXISTS "webhooks".subscription_event_types;
+DROP TABLE IF EXISTS "webhooks".subscriptions;
+DROP SCHEMA IF EXISTS "webhooks";
@@ -0,0 +1,53 @@
+-- Create schema for webhook module
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+CREATE SCHEMA IF NOT EXISTS "webhooks";
+
+CREATE TABLE IF NOT EXISTS "webhooks"."subscriptions" (
+    id UUID PRIMARY KEY NOT NULL,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by_user UUID NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS "webhooks"."subscription_event_types" (
+    subscription_id UUID NOT NULL CONSTRAINT "fk_subscription_event_types_subscription"
+    REFERENCES "webhooks".subscriptions (id) ON DELETE CASCADE,
+    event_type TEXT NOT NULL,
+    PRIMARY KEY (subscription_id, event_type)
+);
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_subscription_event_types_event_type ON "webhooks"."subscription_event_types" (event_type);
+
+CREATE TABLE IF NOT EXISTS "webhooks"."deliveries" (
+    id UUID PRIMARY KEY NOT NULL,
+    subscription_id UUID NOT NULL CONSTRAINT "fk_deliveries_subscription"
+    REFERENCES "webhooks".subscriptions (id) ON DELETE CASCADE,
+    event_id UUID NOT NULL,
+    event_type TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_attempt_at TIMESTAMP WITH TIME ZONE,
+    last_response_status INTEGER,
+    last_error TEXT,
+    redelivery_of UUID CONSTRAINT "fk_deliveries_redelivery_of"
+    REFERENCES "webhooks".deliveries (id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT "check_delivery_status" CHECK (
+        status IN ('pending', 'succeeded', 'failed')
+    )
+);
+
+-- The queue only ever looks at the pending deliveries
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_deliveries_pending_next_attempt_at ON "webhooks"."deliveries" (next_attempt_at) WHERE status = 'pending';
+
+-- squawk-ignore require-concurrent-index-creation
+CREATE INDEX IF NOT EXISTS
+idx_deliveries_subscription_created_at ON "webhooks"."deliveries" (subscription_id, created_at DESC);
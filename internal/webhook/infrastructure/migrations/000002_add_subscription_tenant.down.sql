@@ -0,0 +1,5 @@
+-- squawk-ignore-file ban-drop-column
+-- Drop the team of the subscriptions, every subscription receives the events of all the teams again
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+ALTER TABLE "webhooks"."subscriptions" DROP COLUMN IF EXISTS tenant_id;
@@ -0,0 +1,6 @@
+-- Keep the team a subscription has been added within, it only receives the events of its team
+SET statement_timeout = '5s';
+SET lock_timeout = '1s';
+
+ALTER TABLE "webhooks"."subscriptions"
+ADD COLUMN IF NOT EXISTS tenant_id UUID;
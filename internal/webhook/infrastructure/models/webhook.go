@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookSubscriptionModelSqlx is a row of the subscriptions table, its event types are stored apart.
+type WebhookSubscriptionModelSqlx struct {
+	ID            uuid.UUID     `db:"id"`
+	URL           string        `db:"url"`
+	Secret        string        `db:"secret"`
+	CreatedAt     time.Time     `db:"created_at"`
+	CreatedByUser uuid.UUID     `db:"created_by_user"`
+	TenantID      uuid.NullUUID `db:"tenant_id"`
+}
+
+type WebhookSubscriptionEventTypeModelSqlx struct {
+	SubscriptionID uuid.UUID `db:"subscription_id"`
+	EventType      string    `db:"event_type"`
+}
+
+// WebhookDeliveryModelSqlx keeps the payload as text, it's sent byte for byte the way it's read.
+type WebhookDeliveryModelSqlx struct {
+	ID                 uuid.UUID  `db:"id"`
+	SubscriptionID     uuid.UUID  `db:"subscription_id"`
+	EventID            uuid.UUID  `db:"event_id"`
+	EventType          string     `db:"event_type"`
+	Payload            string     `db:"payload"`
+	Status             string     `db:"status"`
+	Attempts           int        `db:"attempts"`
+	NextAttemptAt      time.Time  `db:"next_attempt_at"`
+	LastAttemptAt      *time.Time `db:"last_attempt_at"`
+	LastResponseStatus *int       `db:"last_response_status"`
+	LastError          *string    `db:"last_error"`
+	RedeliveryOf       *uuid.UUID `db:"redelivery_of"`
+	CreatedAt          time.Time  `db:"created_at"`
+}
+
+type DueWebhookDeliveryModelSqlx struct {
+	WebhookDeliveryModelSqlx
+
+	URL    string `db:"url"`
+	Secret string `db:"secret"`
+}
@@ -0,0 +1,75 @@
+package sqlxrepository
+
+import (
+	"github.com/InWamos/trinity-proto/internal/webhook/domain"
+	"github.com/InWamos/trinity-proto/internal/webhook/infrastructure/models"
+)
+
+type SqlxWebhookMapper struct{}
+
+func NewSqlxWebhookMapper() *SqlxWebhookMapper {
+	return &SqlxWebhookMapper{}
+}
+
+func (sm *SqlxWebhookMapper) SubscriptionToDomain(
+	inputModel *models.WebhookSubscriptionModelSqlx,
+	eventTypes []domain.EventType,
+) domain.WebhookSubscription {
+	return domain.WebhookSubscription{
+		ID:            inputModel.ID,
+		URL:           inputModel.URL,
+		EventTypes:    eventTypes,
+		Secret:        inputModel.Secret,
+		CreatedAt:     inputModel.CreatedAt,
+		CreatedByUser: inputModel.CreatedByUser,
+		TenantID:      inputModel.TenantID,
+	}
+}
+
+func (sm *SqlxWebhookMapper) SubscriptionToModel(
+	inputEntity *domain.WebhookSubscription,
+) (models.WebhookSubscriptionModelSqlx, []models.WebhookSubscriptionEventTypeModelSqlx) {
+	eventTypes := make([]models.WebhookSubscriptionEventTypeModelSqlx, len(inputEntity.EventTypes))
+	for i, eventType := range inputEntity.EventTypes {
+		eventTypes[i] = models.WebhookSubscriptionEventTypeModelSqlx{
+			SubscriptionID: inputEntity.ID,
+			EventType:      string(eventType),
+		}
+	}
+	return models.WebhookSubscriptionModelSqlx{
+		ID:            inputEntity.ID,
+		URL:           inputEntity.URL,
+		Secret:        inputEntity.Secret,
+		CreatedAt:     inputEntity.CreatedAt,
+		CreatedByUser: inputEntity.CreatedByUser,
+		TenantID:      inputEntity.TenantID,
+	}, eventTypes
+}
+
+func (sm *SqlxWebhookMapper) DeliveryToDomain(inputModel *models.WebhookDeliveryModelSqlx) domain.WebhookDelivery {
+	return domain.WebhookDelivery{
+		ID:                 inputModel.ID,
+		SubscriptionID:     inputModel.SubscriptionID,
+		EventID:            inputModel.EventID,
+		EventType:          domain.EventType(inputModel.EventType),
+		Payload:            []byte(inputModel.Payload),
+		Status:             domain.DeliveryStatus(inputModel.Status),
+		Attempts:           inputModel.Attempts,
+		NextAttemptAt:      inputModel.NextAttemptAt,
+		LastAttemptAt:      inputModel.LastAttemptAt,
+		LastResponseStatus: inputModel.LastResponseStatus,
+		LastError:          inputModel.LastError,
+		RedeliveryOf:       inputModel.RedeliveryOf,
+		CreatedAt:          inputModel.CreatedAt,
+	}
+}
+
+func (sm *SqlxWebhookMapper) DueDeliveryToDomain(
+	inputModel *models.DueWebhookDeliveryModelSqlx,
+) domain.DueWebhookDelivery {
+	return domain.DueWebhookDelivery{
+		Delivery: sm.DeliveryToDomain(&inputModel.WebhookDeliveryModelSqlx),
+		URL:      inputModel.URL,
+		Secret:   inputModel.Secret,
+	}
+}
@@ -0,0 +1,211 @@
+package sqlxrepository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/InWamos/trinity-proto/internal/webhook/domain"
+	"github.com/InWamos/trinity-proto/internal/webhook/infrastructure/models"
+	"github.com/InWamos/trinity-proto/internal/webhook/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+const deliveryColumns = `d.id, d.subscription_id, d.event_id, d.event_type, d.payload, d.status, d.attempts,
+	d.next_attempt_at, d.last_attempt_at, d.last_response_status, d.last_error, d.redelivery_of, d.created_at`
+
+type SqlxWebhookDeliveryRepository struct {
+	session    *sqlx.Tx
+	sqlxMapper *SqlxWebhookMapper
+	logger     *slog.Logger
+}
+
+func NewSqlxWebhookDeliveryRepository(
+	session *sqlx.Tx,
+	sqlxMapper *SqlxWebhookMapper,
+	logger *slog.Logger,
+) repository.WebhookDeliveryRepository {
+	wdrLogger := logger.With(
+		slog.String("component", "repository"),
+		slog.String("name", "sqlx_webhook_delivery_repository"),
+	)
+	return &SqlxWebhookDeliveryRepository{
+		session:    session,
+		sqlxMapper: sqlxMapper,
+		logger:     wdrLogger,
+	}
+}
+
+func (repo *SqlxWebhookDeliveryRepository) AddWebhookDeliveries(
+	ctx context.Context,
+	event *domain.WebhookEvent,
+	payload []byte,
+) (int64, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started AddWebhookDeliveries request",
+		slog.String("event_id", event.ID.String()),
+		slog.String("event_type", string(event.Type)),
+	)
+	var observedByUser uuid.NullUUID
+	var visibility *string
+	if event.Observation != nil {
+		observedByUser = uuid.NullUUID{UUID: event.Observation.ObservedByUser, Valid: true}
+		visibility = &event.Observation.Visibility
+	}
+	// The way the records see the observations, the users without a team share no team observations
+	query := `INSERT INTO "webhooks"."deliveries"
+	(id, subscription_id, event_id, event_type, payload, next_attempt_at, created_at)
+	SELECT gen_random_uuid(), t.subscription_id, $1::UUID, t.event_type, $3::JSONB, $4::TIMESTAMPTZ, $4::TIMESTAMPTZ
+	FROM "webhooks"."subscription_event_types" t
+	JOIN "webhooks"."subscriptions" s ON s.id = t.subscription_id
+	WHERE t.event_type = $2
+	AND s.tenant_id IS NOT DISTINCT FROM $5::UUID
+	AND (
+		$7::TEXT IS NULL
+		OR $7::TEXT = 'global'
+		OR ($7::TEXT = 'team' AND $5::UUID IS NOT NULL)
+		OR s.created_by_user = $6::UUID
+	)`
+	result, err := repo.session.ExecContext(
+		ctx,
+		query,
+		event.ID,
+		event.Type,
+		string(payload),
+		event.CreatedAt,
+		event.TenantID,
+		observedByUser,
+		visibility,
+	)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to add webhook deliveries", slog.Any("err", err))
+		return 0, repository.ErrDatabaseFailed
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get rows affected", slog.Any("err", err))
+		return 0, repository.ErrDatabaseFailed
+	}
+	return rowsAffected, nil
+}
+
+func (repo *SqlxWebhookDeliveryRepository) GetWebhookDeliveriesBySubscription(
+	ctx context.Context,
+	subscriptionID uuid.UUID,
+	status domain.DeliveryStatus,
+	limit, offset int,
+) (*[]domain.WebhookDelivery, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetWebhookDeliveriesBySubscription request",
+		slog.String("subscription_id", subscriptionID.String()),
+	)
+	var deliveryModels []models.WebhookDeliveryModelSqlx
+	query := `SELECT ` + deliveryColumns + ` FROM "webhooks"."deliveries" d
+	WHERE d.subscription_id = $1 AND ($2 = '' OR d.status = $2)
+	ORDER BY d.created_at DESC, d.id
+	LIMIT $3 OFFSET $4`
+	err := repo.session.SelectContext(ctx, &deliveryModels, query, subscriptionID, string(status), limit, offset)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get webhook deliveries", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	deliveries := make([]domain.WebhookDelivery, len(deliveryModels))
+	for i, deliveryModel := range deliveryModels {
+		deliveries[i] = repo.sqlxMapper.DeliveryToDomain(&deliveryModel)
+	}
+	return &deliveries, nil
+}
+
+func (repo *SqlxWebhookDeliveryRepository) RedeliverWebhookDelivery(
+	ctx context.Context,
+	tenantID uuid.NullUUID,
+	deliveryID, redeliveryID uuid.UUID,
+) error {
+	repo.logger.DebugContext(
+		ctx,
+		"Started RedeliverWebhookDelivery request",
+		slog.String("delivery_id", deliveryID.String()),
+	)
+	query := `INSERT INTO "webhooks"."deliveries"
+	(id, subscription_id, event_id, event_type, payload, redelivery_of)
+	SELECT $2::UUID, d.subscription_id, d.event_id, d.event_type, d.payload, d.id
+	FROM "webhooks"."deliveries" d
+	JOIN "webhooks"."subscriptions" s ON s.id = d.subscription_id
+	WHERE d.id = $1 AND s.tenant_id IS NOT DISTINCT FROM $3`
+	result, err := repo.session.ExecContext(ctx, query, deliveryID, redeliveryID, tenantID)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to redeliver webhook delivery", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get rows affected", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	if rowsAffected == 0 {
+		return domain.ErrDeliveryNotFound
+	}
+	return nil
+}
+
+func (repo *SqlxWebhookDeliveryRepository) ClaimDueWebhookDeliveries(
+	ctx context.Context,
+	now, leaseUntil time.Time,
+	limit int,
+) (*[]domain.DueWebhookDelivery, error) {
+	repo.logger.DebugContext(ctx, "Started ClaimDueWebhookDeliveries request")
+	var dueModels []models.DueWebhookDeliveryModelSqlx
+	// SKIP LOCKED lets several workers claim distinct deliveries at once
+	query := `WITH due AS (
+		SELECT id FROM "webhooks"."deliveries"
+		WHERE status = 'pending' AND next_attempt_at <= $1
+		ORDER BY next_attempt_at
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED
+	)
+	UPDATE "webhooks"."deliveries" d
+	SET attempts = d.attempts + 1, last_attempt_at = $1, next_attempt_at = $2
+	FROM due, "webhooks"."subscriptions" s
+	WHERE d.id = due.id AND s.id = d.subscription_id
+	RETURNING ` + deliveryColumns + `, s.url, s.secret`
+	if err := repo.session.SelectContext(ctx, &dueModels, query, now, leaseUntil, limit); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to claim due webhook deliveries", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	dueDeliveries := make([]domain.DueWebhookDelivery, len(dueModels))
+	for i, dueModel := range dueModels {
+		dueDeliveries[i] = repo.sqlxMapper.DueDeliveryToDomain(&dueModel)
+	}
+	return &dueDeliveries, nil
+}
+
+func (repo *SqlxWebhookDeliveryRepository) UpdateWebhookDeliveryOutcome(
+	ctx context.Context,
+	delivery *domain.WebhookDelivery,
+) error {
+	repo.logger.DebugContext(
+		ctx,
+		"Started UpdateWebhookDeliveryOutcome request",
+		slog.String("delivery_id", delivery.ID.String()),
+	)
+	query := `UPDATE "webhooks"."deliveries"
+	SET status = $2, next_attempt_at = $3, last_response_status = $4, last_error = $5
+	WHERE id = $1`
+	_, err := repo.session.ExecContext(
+		ctx,
+		query,
+		delivery.ID,
+		string(delivery.Status),
+		delivery.NextAttemptAt,
+		delivery.LastResponseStatus,
+		delivery.LastError,
+	)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to update webhook delivery outcome", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package sqlxrepository
+
+import (
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/webhook/infrastructure/repository"
+	"github.com/jmoiron/sqlx"
+)
+
+type SqlxWebhookDeliveryRepositoryFactory struct {
+	logger     *slog.Logger
+	sqlxMapper *SqlxWebhookMapper
+}
+
+func NewSqlxWebhookDeliveryRepositoryFactory(
+	logger *slog.Logger,
+	mapper *SqlxWebhookMapper,
+) repository.WebhookDeliveryRepositoryFactory {
+	return &SqlxWebhookDeliveryRepositoryFactory{
+		logger:     logger,
+		sqlxMapper: mapper,
+	}
+}
+
+func (factory *SqlxWebhookDeliveryRepositoryFactory) CreateWebhookDeliveryRepositoryWithTransaction(
+	tm interfaces.TransactionManager,
+) repository.WebhookDeliveryRepository {
+	tx, ok := tm.GetTransaction().(*sqlx.Tx)
+	if !ok {
+		factory.logger.Error("invalid transaction type, expected *sqlx.Tx")
+		panic("invalid transaction type for sqlx repository")
+	}
+	return NewSqlxWebhookDeliveryRepository(tx, factory.sqlxMapper, factory.logger)
+}
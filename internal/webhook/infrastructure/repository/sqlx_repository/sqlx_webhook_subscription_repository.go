@@ -0,0 +1,157 @@
+package sqlxrepository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/webhook/domain"
+	"github.com/InWamos/trinity-proto/internal/webhook/infrastructure/models"
+	"github.com/InWamos/trinity-proto/internal/webhook/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type SqlxWebhookSubscriptionRepository struct {
+	session    *sqlx.Tx
+	sqlxMapper *SqlxWebhookMapper
+	logger     *slog.Logger
+}
+
+func NewSqlxWebhookSubscriptionRepository(
+	session *sqlx.Tx,
+	sqlxMapper *SqlxWebhookMapper,
+	logger *slog.Logger,
+) repository.WebhookSubscriptionRepository {
+	wsrLogger := logger.With(
+		slog.String("component", "repository"),
+		slog.String("name", "sqlx_webhook_subscription_repository"),
+	)
+	return &SqlxWebhookSubscriptionRepository{
+		session:    session,
+		sqlxMapper: sqlxMapper,
+		logger:     wsrLogger,
+	}
+}
+
+func (repo *SqlxWebhookSubscriptionRepository) AddWebhookSubscription(
+	ctx context.Context,
+	subscription *domain.WebhookSubscription,
+) error {
+	repo.logger.DebugContext(
+		ctx,
+		"Started AddWebhookSubscription request",
+		slog.String("subscription_id", subscription.ID.String()),
+	)
+	subscriptionModel, eventTypeModels := repo.sqlxMapper.SubscriptionToModel(subscription)
+	query := `INSERT INTO "webhooks"."subscriptions" (id, url, secret, created_at, created_by_user, tenant_id)
+	VALUES (:id, :url, :secret, :created_at, :created_by_user, :tenant_id)`
+	if _, err := repo.session.NamedExecContext(ctx, query, subscriptionModel); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to add webhook subscription", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	eventTypesQuery := `INSERT INTO "webhooks"."subscription_event_types" (subscription_id, event_type)
+	VALUES (:subscription_id, :event_type)`
+	if _, err := repo.session.NamedExecContext(ctx, eventTypesQuery, eventTypeModels); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to add webhook subscription event types", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	return nil
+}
+
+func (repo *SqlxWebhookSubscriptionRepository) GetWebhookSubscriptionByID(
+	ctx context.Context,
+	tenantID uuid.NullUUID,
+	subscriptionID uuid.UUID,
+) (*domain.WebhookSubscription, error) {
+	repo.logger.DebugContext(
+		ctx,
+		"Started GetWebhookSubscriptionByID request",
+		slog.String("subscription_id", subscriptionID.String()),
+	)
+	var subscriptionModel models.WebhookSubscriptionModelSqlx
+	query := `SELECT id, url, secret, created_at, created_by_user, tenant_id FROM "webhooks"."subscriptions"
+	WHERE id = $1 AND tenant_id IS NOT DISTINCT FROM $2`
+	if err := repo.session.GetContext(ctx, &subscriptionModel, query, subscriptionID, tenantID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrSubscriptionNotFound
+		}
+		repo.logger.ErrorContext(ctx, "Failed to get webhook subscription", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	var eventTypes []domain.EventType
+	eventTypesQuery := `SELECT event_type FROM "webhooks"."subscription_event_types"
+	WHERE subscription_id = $1
+	ORDER BY event_type`
+	if err := repo.session.SelectContext(ctx, &eventTypes, eventTypesQuery, subscriptionID); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get webhook subscription event types", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	subscription := repo.sqlxMapper.SubscriptionToDomain(&subscriptionModel, eventTypes)
+	return &subscription, nil
+}
+
+func (repo *SqlxWebhookSubscriptionRepository) GetWebhookSubscriptions(
+	ctx context.Context,
+	tenantID uuid.NullUUID,
+) (*[]domain.WebhookSubscription, error) {
+	repo.logger.DebugContext(ctx, "Started GetWebhookSubscriptions request")
+	var subscriptionModels []models.WebhookSubscriptionModelSqlx
+	query := `SELECT id, url, secret, created_at, created_by_user, tenant_id FROM "webhooks"."subscriptions"
+	WHERE tenant_id IS NOT DISTINCT FROM $1
+	ORDER BY created_at, id`
+	if err := repo.session.SelectContext(ctx, &subscriptionModels, query, tenantID); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get webhook subscriptions", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+	var eventTypeModels []models.WebhookSubscriptionEventTypeModelSqlx
+	eventTypesQuery := `SELECT t.subscription_id, t.event_type FROM "webhooks"."subscription_event_types" t
+	JOIN "webhooks"."subscriptions" s ON s.id = t.subscription_id
+	WHERE s.tenant_id IS NOT DISTINCT FROM $1
+	ORDER BY t.event_type`
+	if err := repo.session.SelectContext(ctx, &eventTypeModels, eventTypesQuery, tenantID); err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get webhook subscription event types", slog.Any("err", err))
+		return nil, repository.ErrDatabaseFailed
+	}
+
+	eventTypes := make(map[uuid.UUID][]domain.EventType, len(subscriptionModels))
+	for _, eventTypeModel := range eventTypeModels {
+		eventTypes[eventTypeModel.SubscriptionID] = append(
+			eventTypes[eventTypeModel.SubscriptionID],
+			domain.EventType(eventTypeModel.EventType),
+		)
+	}
+	subscriptions := make([]domain.WebhookSubscription, len(subscriptionModels))
+	for i, subscriptionModel := range subscriptionModels {
+		subscriptions[i] = repo.sqlxMapper.SubscriptionToDomain(&subscriptionModel, eventTypes[subscriptionModel.ID])
+	}
+	return &subscriptions, nil
+}
+
+func (repo *SqlxWebhookSubscriptionRepository) RemoveWebhookSubscription(
+	ctx context.Context,
+	tenantID uuid.NullUUID,
+	subscriptionID uuid.UUID,
+) error {
+	repo.logger.DebugContext(
+		ctx,
+		"Started RemoveWebhookSubscription request",
+		slog.String("subscription_id", subscriptionID.String()),
+	)
+	query := `DELETE FROM "webhooks"."subscriptions" WHERE id = $1 AND tenant_id IS NOT DISTINCT FROM $2`
+	result, err := repo.session.ExecContext(ctx, query, subscriptionID, tenantID)
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to remove webhook subscription", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.logger.ErrorContext(ctx, "Failed to get rows affected", slog.Any("err", err))
+		return repository.ErrDatabaseFailed
+	}
+	if rowsAffected == 0 {
+		return domain.ErrSubscriptionNotFound
+	}
+	return nil
+}
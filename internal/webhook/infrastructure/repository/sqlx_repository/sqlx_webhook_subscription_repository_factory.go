@@ -0,0 +1,35 @@
+package sqlxrepository
+
+import (
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/webhook/infrastructure/repository"
+	"github.com/jmoiron/sqlx"
+)
+
+type SqlxWebhookSubscriptionRepositoryFactory struct {
+	logger     *slog.Logger
+	sqlxMapper *SqlxWebhookMapper
+}
+
+func NewSqlxWebhookSubscriptionRepositoryFactory(
+	logger *slog.Logger,
+	mapper *SqlxWebhookMapper,
+) repository.WebhookSubscriptionRepositoryFactory {
+	return &SqlxWebhookSubscriptionRepositoryFactory{
+		logger:     logger,
+		sqlxMapper: mapper,
+	}
+}
+
+func (factory *SqlxWebhookSubscriptionRepositoryFactory) CreateWebhookSubscriptionRepositoryWithTransaction(
+	tm interfaces.TransactionManager,
+) repository.WebhookSubscriptionRepository {
+	tx, ok := tm.GetTransaction().(*sqlx.Tx)
+	if !ok {
+		factory.logger.Error("invalid transaction type, expected *sqlx.Tx")
+		panic("invalid transaction type for sqlx repository")
+	}
+	return NewSqlxWebhookSubscriptionRepository(tx, factory.sqlxMapper, factory.logger)
+}
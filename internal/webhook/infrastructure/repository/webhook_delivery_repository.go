@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/webhook/domain"
+	"github.com/google/uuid"
+)
+
+type WebhookDeliveryRepository interface {
+	// AddWebhookDeliveries queues a delivery of the payload for every subscription to the type of the event
+	// added within its team and returns how many have been queued. The events about the observed data only
+	// reach the subscriptions their visibility shares it with, the private ones only those of their collector.
+	AddWebhookDeliveries(ctx context.Context, event *domain.WebhookEvent, payload []byte) (int64, error)
+	// GetWebhookDeliveriesBySubscription returns the newest deliveries first, of any status when it's empty.
+	GetWebhookDeliveriesBySubscription(
+		ctx context.Context,
+		subscriptionID uuid.UUID,
+		status domain.DeliveryStatus,
+		limit, offset int,
+	) (*[]domain.WebhookDelivery, error)
+	// RedeliverWebhookDelivery queues the payload of the delivery again, as a new pending delivery.
+	// Only the deliveries of the subscriptions added within the team are redelivered.
+	RedeliverWebhookDelivery(ctx context.Context, tenantID uuid.NullUUID, deliveryID, redeliveryID uuid.UUID) error
+	// ClaimDueWebhookDeliveries counts an attempt for the pending deliveries due at the time and postpones them
+	// until leaseUntil, so that no other worker claims them while they're being sent. A delivery whose worker
+	// has stopped before storing the outcome is attempted again once the lease has expired.
+	ClaimDueWebhookDeliveries(
+		ctx context.Context,
+		now, leaseUntil time.Time,
+		limit int,
+	) (*[]domain.DueWebhookDelivery, error)
+	// UpdateWebhookDeliveryOutcome stores the status, the next attempt time and the outcome of the last attempt.
+	UpdateWebhookDeliveryOutcome(ctx context.Context, delivery *domain.WebhookDelivery) error
+}
+
+type WebhookDeliveryRepositoryFactory interface {
+	CreateWebhookDeliveryRepositoryWithTransaction(tm interfaces.TransactionManager) WebhookDeliveryRepository
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/webhook/domain"
+	"github.com/google/uuid"
+)
+
+var ErrDatabaseFailed = errors.New("database request has failed")
+
+// WebhookSubscriptionRepository only ever finds the subscriptions added within the team it's given,
+// none is the team of the users without one.
+type WebhookSubscriptionRepository interface {
+	AddWebhookSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error
+	GetWebhookSubscriptionByID(
+		ctx context.Context,
+		tenantID uuid.NullUUID,
+		subscriptionID uuid.UUID,
+	) (*domain.WebhookSubscription, error)
+	GetWebhookSubscriptions(ctx context.Context, tenantID uuid.NullUUID) (*[]domain.WebhookSubscription, error)
+	// RemoveWebhookSubscription removes the subscription along with its deliveries
+	RemoveWebhookSubscription(ctx context.Context, tenantID uuid.NullUUID, subscriptionID uuid.UUID) error
+}
+
+type WebhookSubscriptionRepositoryFactory interface {
+	CreateWebhookSubscriptionRepositoryWithTransaction(tm interfaces.TransactionManager) WebhookSubscriptionRepository
+}
@@ -0,0 +1,77 @@
+package sender
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/InWamos/trinity-proto/config"
+	"github.com/InWamos/trinity-proto/internal/webhook/domain"
+	"github.com/InWamos/trinity-proto/internal/webhook/domain/service"
+)
+
+// maxDrainedResponseSize is read from the responses so that their connections can be reused.
+const maxDrainedResponseSize = 64 << 10
+
+type HTTPWebhookSender struct {
+	client *http.Client
+	now    func() time.Time
+	logger *slog.Logger
+}
+
+func NewHTTPWebhookSender(webhookConfig *config.WebhookConfig, logger *slog.Logger) WebhookSender {
+	return &HTTPWebhookSender{
+		client: &http.Client{
+			Timeout: webhookConfig.RequestTimeout,
+			// A redirect is answered like any other non-2xx status, the signed body is never sent elsewhere
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		now: time.Now,
+		logger: logger.With(
+			slog.String("component", "webhook_sender"),
+			slog.String("name", "http_webhook_sender"),
+		),
+	}
+}
+
+func (sender *HTTPWebhookSender) Send(ctx context.Context, due *domain.DueWebhookDelivery) (int, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, due.URL, bytes.NewReader(due.Delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	signedAt := sender.now()
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", "Trinity-Webhook/1.0")
+	request.Header.Set(service.EventHeader, string(due.Delivery.EventType))
+	request.Header.Set(service.DeliveryHeader, due.Delivery.ID.String())
+	request.Header.Set(service.TimestampHeader, strconv.FormatInt(signedAt.Unix(), 10))
+	request.Header.Set(
+		service.SignatureHeader,
+		service.SignWebhookPayload(due.Secret, signedAt, due.Delivery.Payload),
+	)
+
+	response, err := sender.client.Do(request)
+	if err != nil {
+		sender.logger.DebugContext(
+			ctx,
+			"webhook endpoint hasn't answered",
+			slog.String("delivery_id", due.Delivery.ID.String()),
+			slog.Any("err", err),
+		)
+		return 0, err
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, maxDrainedResponseSize))
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return response.StatusCode, fmt.Errorf("%w: %s", ErrUnsuccessfulStatus, response.Status)
+	}
+	return response.StatusCode, nil
+}
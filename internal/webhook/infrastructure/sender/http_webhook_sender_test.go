@@ -0,0 +1,96 @@
+package sender_test
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/InWamos/trinity-proto/config"
+	"github.com/InWamos/trinity-proto/internal/webhook/domain"
+	"github.com/InWamos/trinity-proto/internal/webhook/domain/service"
+	"github.com/InWamos/trinity-proto/internal/webhook/infrastructure/sender"
+	"github.com/google/uuid"
+)
+
+func newDueDelivery(url string) *domain.DueWebhookDelivery {
+	return &domain.DueWebhookDelivery{
+		Delivery: domain.WebhookDelivery{
+			ID:        uuid.New(),
+			EventType: domain.EventUserDemoted,
+			Payload:   []byte(`{"type":"user.demoted","data":{"user_id":"42"}}`),
+		},
+		URL:    url,
+		Secret: "receiver-secret",
+	}
+}
+
+func newSender() sender.WebhookSender {
+	return sender.NewHTTPWebhookSender(
+		&config.WebhookConfig{RequestTimeout: time.Second},
+		slog.New(slog.DiscardHandler),
+	)
+}
+
+func TestSendSignsTheDelivery(t *testing.T) {
+	received := make(chan error, 1)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			received <- err
+			return
+		}
+		timestamp, err := strconv.ParseInt(r.Header.Get(service.TimestampHeader), 10, 64)
+		if err != nil {
+			received <- err
+			return
+		}
+		signature := r.Header.Get(service.SignatureHeader)
+		switch {
+		case !service.VerifyWebhookSignature("receiver-secret", time.Unix(timestamp, 0), body, signature):
+			received <- errors.New("signature " + signature + " hasn't been verified")
+		case r.Header.Get(service.EventHeader) != string(domain.EventUserDemoted):
+			received <- errors.New("unexpected event " + r.Header.Get(service.EventHeader))
+		default:
+			received <- nil
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	status, err := newSender().Send(t.Context(), newDueDelivery(receiver.URL))
+	if err != nil || status != http.StatusNoContent {
+		t.Fatalf("expected the delivery to succeed, got %d: %v", status, err)
+	}
+	if err = <-received; err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSendReportsUnsuccessfulStatuses(t *testing.T) {
+	for _, receiverStatus := range []int{http.StatusFound, http.StatusBadRequest, http.StatusServiceUnavailable} {
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "/elsewhere", receiverStatus)
+		}))
+
+		status, err := newSender().Send(t.Context(), newDueDelivery(receiver.URL))
+		if status != receiverStatus || !errors.Is(err, sender.ErrUnsuccessfulStatus) {
+			t.Errorf("expected %d to be reported as unsuccessful, got %d: %v", receiverStatus, status, err)
+		}
+		receiver.Close()
+	}
+}
+
+func TestSendReportsUnreachableEndpoints(t *testing.T) {
+	receiver := httptest.NewServer(http.NotFoundHandler())
+	receiver.Close()
+
+	status, err := newSender().Send(t.Context(), newDueDelivery(receiver.URL))
+	if status != 0 || err == nil || errors.Is(err, sender.ErrUnsuccessfulStatus) {
+		t.Errorf("expected the unreachable endpoint to be reported, got %d: %v", status, err)
+	}
+}
@@ -0,0 +1,16 @@
+package sender
+
+import (
+	"context"
+	"errors"
+
+	"github.com/InWamos/trinity-proto/internal/webhook/domain"
+)
+
+var ErrUnsuccessfulStatus = errors.New("the endpoint has answered with a non-2xx status")
+
+type WebhookSender interface {
+	// Send posts the signed payload of the delivery to the endpoint of its subscription. It returns the status
+	// the endpoint has answered with, zero when it hasn't answered, and ErrUnsuccessfulStatus unless it's 2xx.
+	Send(ctx context.Context, due *domain.DueWebhookDelivery) (int, error)
+}
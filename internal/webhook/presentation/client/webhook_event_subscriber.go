@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/event/client"
+	"github.com/InWamos/trinity-proto/internal/webhook/application"
+	"github.com/InWamos/trinity-proto/internal/webhook/domain"
+)
+
+// WebhookEventSubscriber queues the deliveries of every event a subscription may be made to.
+type WebhookEventSubscriber struct {
+	publishWebhookEventInteractor *application.PublishWebhookEvent
+	logger                        *slog.Logger
+}
+
+func NewWebhookEventSubscriber(
+	publishWebhookEventInteractor *application.PublishWebhookEvent,
+	logger *slog.Logger,
+) client.Subscriber {
+	wesLogger := logger.With(slog.String("component", "webhook_event_subscriber"))
+	return &WebhookEventSubscriber{publishWebhookEventInteractor: publishWebhookEventInteractor, logger: wesLogger}
+}
+
+func (subscriber *WebhookEventSubscriber) EventTypes() []client.EventType {
+	eventTypes := make([]client.EventType, len(domain.EventTypes))
+	for i, eventType := range domain.EventTypes {
+		eventTypes[i] = client.EventType(eventType)
+	}
+	return eventTypes
+}
+
+func (subscriber *WebhookEventSubscriber) Handle(
+	ctx context.Context,
+	transactionManager interfaces.TransactionManager,
+	event client.Event,
+) error {
+	interactorRequest := application.PublishWebhookEventRequest{
+		EventID:    event.ID,
+		EventType:  domain.EventType(event.Type),
+		OccurredAt: event.OccurredAt,
+		Data:       event.Data,
+		TenantID:   event.TenantID,
+	}
+	if event.Observation != nil {
+		interactorRequest.Observation = &domain.WebhookObservation{
+			ObservedByUser: event.Observation.ObservedByUser,
+			Visibility:     event.Observation.Visibility,
+		}
+	}
+	if err := subscriber.publishWebhookEventInteractor.Execute(ctx, transactionManager, interactorRequest); err != nil {
+		subscriber.logger.ErrorContext(
+			ctx,
+			"failed to publish webhook event",
+			slog.String("event_id", event.ID.String()),
+			slog.String("event_type", string(event.Type)),
+			slog.Any("err", err),
+		)
+		return client.ErrUnexpectedError
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/webhook/application"
+	"github.com/InWamos/trinity-proto/internal/webhook/domain"
+)
+
+// AddWebhookSubscriptionRequest represents the request payload for subscribing an endpoint to events.
+type AddWebhookSubscriptionRequest struct {
+	URL        string   `json:"url"         example:"https://enrichment.example.com/hooks/trinity"`
+	EventTypes []string `json:"event_types" example:"telegram_record.created,user.demoted"`
+	Secret     string   `json:"secret"      example:"7d1f0e4c9a2b4f6e8c3d5a7b9e1f2c4d"`
+}
+
+// AddWebhookSubscriptionResponse represents the response payload after successfully subscribing an endpoint.
+type AddWebhookSubscriptionResponse struct {
+	SubscriptionID string `json:"subscription_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+type AddWebhookSubscriptionHandler struct {
+	interactor *application.AddWebhookSubscription
+	logger     *slog.Logger
+}
+
+func NewAddWebhookSubscriptionHandler(
+	interactor *application.AddWebhookSubscription,
+	logger *slog.Logger,
+) *AddWebhookSubscriptionHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "add_webhook_subscription_handler"),
+	)
+
+	return &AddWebhookSubscriptionHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles POST requests to subscribe an endpoint to events.
+//
+//	@Summary		Add a webhook subscription
+//	@Description	Subscribes an endpoint to events. Each event is POSTed to it as JSON with the X-Trinity-Event,
+//	@Description	X-Trinity-Delivery and X-Trinity-Timestamp headers, and X-Trinity-Signature set to
+//	@Description	"sha256=" followed by the hex HMAC-SHA256 of "<timestamp>.<body>" keyed with the secret.
+//	@Description	Deliveries not answered with 2xx are retried with an exponential backoff.
+//	@Description	Event types: telegram_record.created, telegram_identity.created, user.created, user.promoted,
+//	@Description	user.demoted, user.removed.
+//	@Tags			webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		AddWebhookSubscriptionRequest	true	"Subscription details"
+//	@Success		201		{object}	AddWebhookSubscriptionResponse
+//	@Failure		400		{string}	string	"Invalid request format, URL, event types or secret"
+//	@Failure		403		{string}	string	"Insufficient privileges"
+//	@Failure		500		{string}	string	"Internal server error"
+//	@Router			/v1/webhooks [post]
+func (handler *AddWebhookSubscriptionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req AddWebhookSubscriptionRequest
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid request format", slog.Any("err", err))
+		http.Error(w, "Invalid request format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.AddWebhookSubscriptionRequest{URL: req.URL, Secret: req.Secret}
+	for _, eventType := range req.EventTypes {
+		requestDTO.EventTypes = append(requestDTO.EventTypes, domain.EventType(eventType))
+	}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, application.ErrInvalidURL),
+			errors.Is(err, application.ErrInvalidEvents),
+			errors.Is(err, application.ErrInvalidSecret):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(AddWebhookSubscriptionResponse{SubscriptionID: resp.SubscriptionID.String()})
+}
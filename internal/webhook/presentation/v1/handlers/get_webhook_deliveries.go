@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/webhook/application"
+	"github.com/InWamos/trinity-proto/internal/webhook/domain"
+	"github.com/google/uuid"
+)
+
+// GetWebhookDeliveriesResponse represents the response from the GetWebhookDeliveries endpoint.
+type GetWebhookDeliveriesResponse struct {
+	Deliveries []WebhookDeliveryResponse `json:"deliveries"`
+}
+
+// WebhookDeliveryResponse is a delivery along with the outcome of its last attempt.
+type WebhookDeliveryResponse struct {
+	ID                 uuid.UUID       `json:"id"                             example:"550e8400-e29b-41d4-a716-446655440000"`
+	EventID            uuid.UUID       `json:"event_id"                       example:"4c7e8a21-3f5b-4d6e-9a1c-0b2d3e4f5a6b"`
+	EventType          string          `json:"event_type"                     example:"user.demoted"`
+	Payload            json.RawMessage `json:"payload"                        swaggertype:"object"`
+	Status             string          `json:"status"                         example:"pending" enums:"pending,succeeded,failed"`
+	Attempts           int             `json:"attempts"                       example:"2"`
+	NextAttemptAt      *time.Time      `json:"next_attempt_at,omitempty"      example:"2024-01-15T10:32:00Z"`
+	LastAttemptAt      *time.Time      `json:"last_attempt_at,omitempty"      example:"2024-01-15T10:31:00Z"`
+	LastResponseStatus *int            `json:"last_response_status,omitempty" example:"503"`
+	LastError          *string         `json:"last_error,omitempty"`
+	RedeliveryOf       *uuid.UUID      `json:"redelivery_of,omitempty"`
+	CreatedAt          time.Time       `json:"created_at"                     example:"2024-01-15T10:30:00Z"`
+}
+
+type GetWebhookDeliveriesHandler struct {
+	interactor *application.GetWebhookDeliveries
+	logger     *slog.Logger
+}
+
+func NewGetWebhookDeliveriesHandler(
+	interactor *application.GetWebhookDeliveries,
+	logger *slog.Logger,
+) *GetWebhookDeliveriesHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_webhook_deliveries_handler"),
+	)
+
+	return &GetWebhookDeliveriesHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to list the deliveries of a webhook subscription.
+//
+//	@Summary		Get webhook deliveries
+//	@Description	The delivery log of a webhook subscription, newest first, with the outcome of the last attempt
+//	@Description	of every delivery. The next attempt time is only set for the pending deliveries.
+//	@Tags			webhooks
+//	@Produce		json
+//	@Param			subscription_id	path		string							true	"Subscription ID"	format(uuid)
+//	@Param			status			query		string							false	"Delivery status"	Enums(pending, succeeded, failed)
+//	@Param			limit			query		int								false	"Amount of deliveries"	minimum(1)	maximum(100)	default(50)
+//	@Param			offset			query		int								false	"Amount of deliveries to skip"	minimum(0)	default(0)
+//	@Success		200				{object}	GetWebhookDeliveriesResponse	"Deliveries retrieved successfully"
+//	@Failure		400				"Invalid subscription ID, status or pagination"
+//	@Failure		403				"Insufficient privileges"
+//	@Failure		404				"Subscription not found"
+//	@Failure		500				"Internal server error"
+//	@Router			/v1/webhooks/{subscription_id}/deliveries [get]
+func (handler *GetWebhookDeliveriesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	subscriptionID, err := uuid.Parse(r.PathValue("subscription_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid subscription ID format", slog.Any("err", err))
+		http.Error(w, "Invalid subscription ID format", http.StatusBadRequest)
+		return
+	}
+	query := r.URL.Query()
+	requestDTO := application.GetWebhookDeliveriesRequest{
+		SubscriptionID: subscriptionID,
+		Status:         domain.DeliveryStatus(query.Get("status")),
+	}
+	if rawLimit := query.Get("limit"); rawLimit != "" {
+		if requestDTO.Limit, err = strconv.Atoi(rawLimit); err != nil {
+			http.Error(w, "Invalid limit format", http.StatusBadRequest)
+			return
+		}
+	}
+	if rawOffset := query.Get("offset"); rawOffset != "" {
+		if requestDTO.Offset, err = strconv.Atoi(rawOffset); err != nil {
+			http.Error(w, "Invalid offset format", http.StatusBadRequest)
+			return
+		}
+	}
+
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, application.ErrInvalidDeliveryStatus):
+			http.Error(w, "Unknown delivery status, use pending, succeeded or failed", http.StatusBadRequest)
+			return
+		case errors.Is(err, application.ErrInvalidPagination):
+			http.Error(w, "Limit must be between 1 and 100 and offset must not be negative", http.StatusBadRequest)
+			return
+		case errors.Is(err, domain.ErrSubscriptionNotFound):
+			http.Error(w, "Subscription not found", http.StatusNotFound)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := GetWebhookDeliveriesResponse{Deliveries: make([]WebhookDeliveryResponse, len(resp.Deliveries))}
+	for i, delivery := range resp.Deliveries {
+		response.Deliveries[i] = WebhookDeliveryResponse{
+			ID:                 delivery.ID,
+			EventID:            delivery.EventID,
+			EventType:          string(delivery.EventType),
+			Payload:            delivery.Payload,
+			Status:             string(delivery.Status),
+			Attempts:           delivery.Attempts,
+			LastAttemptAt:      delivery.LastAttemptAt,
+			LastResponseStatus: delivery.LastResponseStatus,
+			LastError:          delivery.LastError,
+			RedeliveryOf:       delivery.RedeliveryOf,
+			CreatedAt:          delivery.CreatedAt,
+		}
+		if delivery.Status == domain.DeliveryPending {
+			response.Deliveries[i].NextAttemptAt = &delivery.NextAttemptAt
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/webhook/application"
+	"github.com/google/uuid"
+)
+
+// GetWebhookSubscriptionsResponse represents the response from the GetWebhookSubscriptions endpoint.
+type GetWebhookSubscriptionsResponse struct {
+	Subscriptions []WebhookSubscriptionResponse `json:"subscriptions"`
+}
+
+// WebhookSubscriptionResponse never holds the secret of the subscription.
+type WebhookSubscriptionResponse struct {
+	ID            uuid.UUID `json:"id"              example:"550e8400-e29b-41d4-a716-446655440000"`
+	URL           string    `json:"url"             example:"https://enrichment.example.com/hooks/trinity"`
+	EventTypes    []string  `json:"event_types"     example:"telegram_record.created,user.demoted"`
+	CreatedAt     time.Time `json:"created_at"      example:"2024-01-15T10:30:00Z"`
+	CreatedByUser uuid.UUID `json:"created_by_user" example:"9b2f0c1e-7d4a-4c55-a0f3-2a3c1f9d8e11"`
+}
+
+type GetWebhookSubscriptionsHandler struct {
+	interactor *application.GetWebhookSubscriptions
+	logger     *slog.Logger
+}
+
+func NewGetWebhookSubscriptionsHandler(
+	interactor *application.GetWebhookSubscriptions,
+	logger *slog.Logger,
+) *GetWebhookSubscriptionsHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "get_webhook_subscriptions_handler"),
+	)
+
+	return &GetWebhookSubscriptionsHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles an HTTP request to list the webhook subscriptions.
+//
+//	@Summary		Get webhook subscriptions
+//	@Description	List the webhook subscriptions, without their secrets
+//	@Tags			webhooks
+//	@Produce		json
+//	@Success		200	{object}	GetWebhookSubscriptionsResponse	"Subscriptions retrieved successfully"
+//	@Failure		403	"Insufficient privileges"
+//	@Failure		500	"Internal server error"
+//	@Router			/v1/webhooks [get]
+func (handler *GetWebhookSubscriptionsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	resp, err := handler.interactor.Execute(r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response := GetWebhookSubscriptionsResponse{
+		Subscriptions: make([]WebhookSubscriptionResponse, len(resp.Subscriptions)),
+	}
+	for i, subscription := range resp.Subscriptions {
+		eventTypes := make([]string, len(subscription.EventTypes))
+		for j, eventType := range subscription.EventTypes {
+			eventTypes[j] = string(eventType)
+		}
+		response.Subscriptions[i] = WebhookSubscriptionResponse{
+			ID:            subscription.ID,
+			URL:           subscription.URL,
+			EventTypes:    eventTypes,
+			CreatedAt:     subscription.CreatedAt,
+			CreatedByUser: subscription.CreatedByUser,
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/webhook/application"
+	"github.com/InWamos/trinity-proto/internal/webhook/domain"
+	"github.com/google/uuid"
+)
+
+// RedeliverWebhookDeliveryResponse holds the ID of the new delivery.
+type RedeliverWebhookDeliveryResponse struct {
+	DeliveryID string `json:"delivery_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+type RedeliverWebhookDeliveryHandler struct {
+	interactor *application.RedeliverWebhookDelivery
+	logger     *slog.Logger
+}
+
+func NewRedeliverWebhookDeliveryHandler(
+	interactor *application.RedeliverWebhookDelivery,
+	logger *slog.Logger,
+) *RedeliverWebhookDeliveryHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "redeliver_webhook_delivery_handler"),
+	)
+
+	return &RedeliverWebhookDeliveryHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles POST requests to redeliver a webhook delivery.
+//
+//	@Summary		Redeliver a webhook delivery
+//	@Description	Queues the payload of a delivery again as a new delivery, which is sent right away.
+//	@Description	The original delivery is kept in the log as it is.
+//	@Tags			webhooks
+//	@Produce		json
+//	@Param			delivery_id	path		string	true	"Delivery ID"	format(uuid)
+//	@Success		202			{object}	RedeliverWebhookDeliveryResponse
+//	@Failure		400			{string}	string	"Invalid delivery ID format"
+//	@Failure		403			{string}	string	"Insufficient privileges"
+//	@Failure		404			{string}	string	"Delivery not found"
+//	@Failure		500			{string}	string	"Internal server error"
+//	@Router			/v1/webhooks/deliveries/{delivery_id}/redeliver [post]
+func (handler *RedeliverWebhookDeliveryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	deliveryID, err := uuid.Parse(r.PathValue("delivery_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid delivery ID format", slog.Any("err", err))
+		http.Error(w, "Invalid delivery ID format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.RedeliverWebhookDeliveryRequest{DeliveryID: deliveryID}
+	resp, err := handler.interactor.Execute(r.Context(), requestDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrDeliveryNotFound):
+			http.Error(w, "Delivery not found", http.StatusNotFound)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(w).Encode(RedeliverWebhookDeliveryResponse{DeliveryID: resp.DeliveryID.String()})
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/InWamos/trinity-proto/internal/shared/authorization/rbac"
+	"github.com/InWamos/trinity-proto/internal/webhook/application"
+	"github.com/InWamos/trinity-proto/internal/webhook/domain"
+	"github.com/google/uuid"
+)
+
+type RemoveWebhookSubscriptionHandler struct {
+	interactor *application.RemoveWebhookSubscription
+	logger     *slog.Logger
+}
+
+func NewRemoveWebhookSubscriptionHandler(
+	interactor *application.RemoveWebhookSubscription,
+	logger *slog.Logger,
+) *RemoveWebhookSubscriptionHandler {
+	handlerLogger := logger.With(
+		slog.String("component", "handler"),
+		slog.String("name", "remove_webhook_subscription_handler"),
+	)
+
+	return &RemoveWebhookSubscriptionHandler{
+		interactor: interactor,
+		logger:     handlerLogger,
+	}
+}
+
+// ServeHTTP handles DELETE requests to remove a webhook subscription.
+//
+//	@Summary		Remove a webhook subscription
+//	@Description	Removes a webhook subscription along with its deliveries, the pending ones are never sent
+//	@Tags			webhooks
+//	@Param			subscription_id	path	string	true	"Subscription ID"	format(uuid)
+//	@Success		204				"Subscription removed"
+//	@Failure		400				{string}	string	"Invalid subscription ID format"
+//	@Failure		403				{string}	string	"Insufficient privileges"
+//	@Failure		404				{string}	string	"Subscription not found"
+//	@Failure		500				{string}	string	"Internal server error"
+//	@Router			/v1/webhooks/{subscription_id} [delete]
+func (handler *RemoveWebhookSubscriptionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	subscriptionID, err := uuid.Parse(r.PathValue("subscription_id"))
+	if err != nil {
+		handler.logger.DebugContext(r.Context(), "invalid subscription ID format", slog.Any("err", err))
+		http.Error(w, "Invalid subscription ID format", http.StatusBadRequest)
+		return
+	}
+
+	requestDTO := application.RemoveWebhookSubscriptionRequest{SubscriptionID: subscriptionID}
+	if err = handler.interactor.Execute(r.Context(), requestDTO); err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrInsufficientPrivileges):
+			handler.logger.DebugContext(r.Context(), "Auth error", slog.Any("err", err))
+			http.Error(w, "Insufficient privileges", http.StatusForbidden)
+			return
+		case errors.Is(err, domain.ErrSubscriptionNotFound):
+			http.Error(w, "Subscription not found", http.StatusNotFound)
+			return
+		default:
+			handler.logger.ErrorContext(r.Context(), "Database error", slog.Any("err", err))
+			http.Error(w, "Internal Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,33 @@
+package v1
+
+import (
+	"github.com/InWamos/trinity-proto/internal/webhook/presentation/v1/handlers"
+	"github.com/go-chi/chi/v5"
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+)
+
+type WebhookMuxV1 struct {
+	mux *chi.Mux
+}
+
+func NewWebhookMuxV1(
+	addWebhookSubscription *handlers.AddWebhookSubscriptionHandler,
+	getWebhookSubscriptions *handlers.GetWebhookSubscriptionsHandler,
+	removeWebhookSubscription *handlers.RemoveWebhookSubscriptionHandler,
+	getWebhookDeliveries *handlers.GetWebhookDeliveriesHandler,
+	redeliverWebhookDelivery *handlers.RedeliverWebhookDeliveryHandler,
+) *WebhookMuxV1 {
+	mux := chi.NewRouter()
+	// Only allow json content type
+	mux.Use(chiMiddleware.AllowContentType("application/json"))
+	mux.Post("/", addWebhookSubscription.ServeHTTP)
+	mux.Get("/", getWebhookSubscriptions.ServeHTTP)
+	mux.Delete("/{subscription_id}", removeWebhookSubscription.ServeHTTP)
+	mux.Get("/{subscription_id}/deliveries", getWebhookDeliveries.ServeHTTP)
+	mux.Post("/deliveries/{delivery_id}/redeliver", redeliverWebhookDelivery.ServeHTTP)
+	return &WebhookMuxV1{mux: mux}
+}
+
+func (wm *WebhookMuxV1) GetMux() *chi.Mux {
+	return wm.mux
+}
@@ -9,20 +9,23 @@ import (
 	"strings"
 
 	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/user/client"
+	"github.com/google/uuid"
 )
 
-type contextKey string
-
-const IdentityProviderKey contextKey = "IdentityProvider"
-
 type AuthenticationMiddleware struct {
 	logger     *slog.Logger
 	authClient client.AuthClient
+	userClient userclient.UserClient
 }
 
-func NewAuthenticationMiddleware(logger *slog.Logger, authClient client.AuthClient) *AuthenticationMiddleware {
+func NewAuthenticationMiddleware(
+	logger *slog.Logger,
+	authClient client.AuthClient,
+	userClient userclient.UserClient,
+) *AuthenticationMiddleware {
 	middlewareLogger := logger.With(slog.String("component", "authentication_middleware"))
-	return &AuthenticationMiddleware{logger: middlewareLogger, authClient: authClient}
+	return &AuthenticationMiddleware{logger: middlewareLogger, authClient: authClient, userClient: userClient}
 }
 
 func (middleware *AuthenticationMiddleware) Handler(next http.Handler) http.Handler {
@@ -63,20 +66,43 @@ func (middleware *AuthenticationMiddleware) Handler(next http.Handler) http.Hand
 			return
 		}
 
+		// The team is resolved on every request, so the sessions of a removed user stop working too
+		teamID, err := middleware.userClient.GetUserTeam(r.Context(), userIdentity.UserID)
+		if err != nil {
+			if errors.Is(err, userclient.ErrUserAbsent) {
+				middleware.logger.WarnContext(r.Context(), "session of a removed user", slog.String("token", token))
+				respondWithError(w, http.StatusUnauthorized, "invalid session", "invalid_token")
+				return
+			}
+			middleware.logger.ErrorContext(r.Context(), "unexpected error during team resolution", slog.Any("err", err))
+			respondWithError(w, http.StatusInternalServerError, "authentication failed", "")
+			return
+		}
+		userIdentity.TeamID = teamID
+
 		middleware.logger.DebugContext(r.Context(), "session validated successfully",
 			slog.String("method", r.Method),
 			slog.String("user_id", userIdentity.UserID.String()),
 			slog.String("user_role", string(userIdentity.UserRole)),
+			slog.String("team_id", teamIDString(userIdentity.TeamID)),
 			slog.String("uri", r.RequestURI))
 
 		// add idp to the context
-		ctx := context.WithValue(r.Context(), IdentityProviderKey, &userIdentity)
+		ctx := context.WithValue(r.Context(), client.IdentityProviderKey, &userIdentity)
 
 		// Call the next handler with updated context
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+// teamIDString logs the absence of a team as an empty string.
+func teamIDString(teamID uuid.NullUUID) string {
+	if !teamID.Valid {
+		return ""
+	}
+	return teamID.UUID.String()
+}
+
 // extractToken extracts the session token from the Authorization header
 // Expected format: Authorization: Bearer {token}.
 func extractToken(r *http.Request) (string, error) {
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"context"
+	"crypto/subtle"
+	"log/slog"
+	"net/http"
+
+	"github.com/InWamos/trinity-proto/config"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/user/client"
+)
+
+// botSecretTokenHeader is sent by Telegram with every update, see setWebhook secret_token.
+const botSecretTokenHeader = "X-Telegram-Bot-Api-Secret-Token"
+
+// BotAuthenticationMiddleware authenticates Telegram Bot API webhook calls by the secret token
+// and acts on behalf of the configured service user.
+type BotAuthenticationMiddleware struct {
+	logger     *slog.Logger
+	botConfig  *config.BotConfig
+	userClient userclient.UserClient
+}
+
+func NewBotAuthenticationMiddleware(
+	logger *slog.Logger,
+	botConfig *config.BotConfig,
+	userClient userclient.UserClient,
+) *BotAuthenticationMiddleware {
+	middlewareLogger := logger.With(slog.String("component", "bot_authentication_middleware"))
+	return &BotAuthenticationMiddleware{logger: middlewareLogger, botConfig: botConfig, userClient: userClient}
+}
+
+func (middleware *BotAuthenticationMiddleware) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The webhook doesn't exist until it is configured
+		if middleware.botConfig.WebhookSecret == "" {
+			http.NotFound(w, r)
+			return
+		}
+
+		token := r.Header.Get(botSecretTokenHeader)
+		if subtle.ConstantTimeCompare([]byte(token), []byte(middleware.botConfig.WebhookSecret)) != 1 {
+			middleware.logger.WarnContext(r.Context(), "invalid bot secret token", slog.String("uri", r.RequestURI))
+			http.Error(w, "Invalid secret token", http.StatusUnauthorized)
+			return
+		}
+
+		// The updates are stored within the team of the service user
+		teamID, err := middleware.userClient.GetUserTeam(r.Context(), middleware.botConfig.ServiceUserID)
+		if err != nil {
+			middleware.logger.ErrorContext(r.Context(), "failed to resolve the team of the service user",
+				slog.Any("err", err))
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+			return
+		}
+
+		userIdentity := client.UserIdentity{
+			UserID:   middleware.botConfig.ServiceUserID,
+			UserRole: client.User,
+			TeamID:   teamID,
+		}
+		ctx := context.WithValue(r.Context(), client.IdentityProviderKey, &userIdentity)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
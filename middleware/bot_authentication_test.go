@@ -0,0 +1,69 @@
+package middleware_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/InWamos/trinity-proto/config"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	userclient "github.com/InWamos/trinity-proto/internal/shared/interfaces/user/client"
+	"github.com/InWamos/trinity-proto/middleware"
+	"github.com/google/uuid"
+)
+
+// fakeUserClient places every user in the same team.
+type fakeUserClient struct {
+	userclient.UserClient
+	teamID uuid.NullUUID
+}
+
+func (c *fakeUserClient) GetUserTeam(context.Context, uuid.UUID) (uuid.NullUUID, error) {
+	return c.teamID, nil
+}
+
+func TestBotAuthenticationMiddleware(t *testing.T) {
+	serviceUserID := uuid.New()
+	userClient := &fakeUserClient{teamID: uuid.NullUUID{UUID: uuid.New(), Valid: true}}
+	cases := map[string]struct {
+		secret string
+		token  string
+		status int
+	}{
+		"matching token":     {secret: "s3cret", token: "s3cret", status: http.StatusOK},
+		"wrong token":        {secret: "s3cret", token: "guess", status: http.StatusUnauthorized},
+		"missing token":      {secret: "s3cret", status: http.StatusUnauthorized},
+		"token prefix":       {secret: "s3cret", token: "s3c", status: http.StatusUnauthorized},
+		"webhook disabled":   {token: "s3cret", status: http.StatusNotFound},
+		"disabled and empty": {status: http.StatusNotFound},
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	for name, tc := range cases {
+		botConfig := &config.BotConfig{WebhookSecret: tc.secret, ServiceUserID: serviceUserID}
+		var identity *client.UserIdentity
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			identity, _ = r.Context().Value(client.IdentityProviderKey).(*client.UserIdentity)
+			w.WriteHeader(http.StatusOK)
+		})
+		request := httptest.NewRequest(http.MethodPost, "/v1/bot/telegram/webhook", nil)
+		if tc.token != "" {
+			request.Header.Set("X-Telegram-Bot-Api-Secret-Token", tc.token)
+		}
+		recorder := httptest.NewRecorder()
+		middleware.NewBotAuthenticationMiddleware(logger, botConfig, userClient).Handler(next).ServeHTTP(recorder, request)
+
+		if recorder.Code != tc.status {
+			t.Errorf("%s: expected status %d, got %d", name, tc.status, recorder.Code)
+		}
+		if tc.status != http.StatusOK {
+			continue
+		}
+		if identity == nil || identity.UserID != serviceUserID || identity.UserRole != client.User ||
+			identity.TeamID != userClient.teamID {
+			t.Errorf("%s: expected the request to act as the service user within their team, got %+v", name, identity)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader carries the ID of the request back to the client, to be quoted when reporting an issue.
+const RequestIDHeader = "X-Request-ID"
+
+// RequestMetadataMiddleware assigns an ID to every request and keeps its client IP. The ID is always generated
+// by the server, so it can't be spoofed. It has to run after TrustedProxyMiddleware to see the real client IP.
+type RequestMetadataMiddleware struct{}
+
+func NewRequestMetadataMiddleware() *RequestMetadataMiddleware {
+	return &RequestMetadataMiddleware{}
+}
+
+func (middleware *RequestMetadataMiddleware) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ipAddress, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			ipAddress = r.RemoteAddr
+		}
+		metadata := &interfaces.RequestMetadata{RequestID: uuid.NewString(), IPAddress: ipAddress}
+		w.Header().Set(RequestIDHeader, metadata.RequestID)
+		ctx := context.WithValue(r.Context(), interfaces.RequestMetadataKey, metadata)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -9,10 +9,12 @@ import (
 	"time"
 
 	"github.com/InWamos/trinity-proto/config"
+	auditV1Mux "github.com/InWamos/trinity-proto/internal/audit/presentation/v1"
 	authV1Mux "github.com/InWamos/trinity-proto/internal/auth/presentation/v1"
 	recordV1Mux "github.com/InWamos/trinity-proto/internal/record/presentation/v1"
 	"github.com/InWamos/trinity-proto/internal/user/application"
 	userV1Mux "github.com/InWamos/trinity-proto/internal/user/presentation/v1"
+	webhookV1Mux "github.com/InWamos/trinity-proto/internal/webhook/presentation/v1"
 	"github.com/InWamos/trinity-proto/middleware"
 	"github.com/go-chi/chi/v5"
 	chiMiddleware "github.com/go-chi/chi/v5/middleware"
@@ -57,22 +59,34 @@ func NewMainHTTPServer(
 	loggingMiddleware *middleware.LoggingMiddleware,
 	corsMiddleware *middleware.GlobalCORSMiddleware,
 	trustedProxyMiddleware *middleware.TrustedProxyMiddleware,
+	requestMetadataMiddleware *middleware.RequestMetadataMiddleware,
 	authMiddleware *middleware.AuthenticationMiddleware,
+	botAuthMiddleware *middleware.BotAuthenticationMiddleware,
 	userMuxV1 *userV1Mux.UserMuxV1,
+	teamMuxV1 *userV1Mux.TeamMuxV1,
 	authMuxV1 *authV1Mux.AuthMuxV1,
 	recordMuxV1 *recordV1Mux.RecordMuxV1,
+	botMuxV1 *recordV1Mux.BotMuxV1,
+	watchlistMuxV1 *recordV1Mux.WatchlistMuxV1,
+	alertMuxV1 *recordV1Mux.AlertMuxV1,
+	exportMuxV1 *recordV1Mux.ExportMuxV1,
+	investigationMuxV1 *recordV1Mux.InvestigationMuxV1,
+	annotationMuxV1 *recordV1Mux.AnnotationMuxV1,
+	provenanceMuxV1 *recordV1Mux.ProvenanceMuxV1,
+	webhookMuxV1 *webhookV1Mux.WebhookMuxV1,
+	auditMuxV1 *auditV1Mux.AuditMuxV1,
 	logger *slog.Logger,
 ) MainHTTPServer {
 	listenAddress := fmt.Sprintf("%s:%d", serverConfig.BindAddress, serverConfig.Port)
 	chiRouter := chi.NewRouter()
 	// Logging
 	chiRouter.Use(loggingMiddleware.Handler)
-	// Only allow json content type
-	chiRouter.Use(chiMiddleware.AllowContentType("application/json"))
 	// Send nocache header to reverse proxy
 	chiRouter.Use(chiMiddleware.NoCache)
 	// Real IP spoofing protection
 	chiRouter.Use(trustedProxyMiddleware.Handler)
+	// Request ID and client IP for the audit log
+	chiRouter.Use(requestMetadataMiddleware.Handler)
 	// Fixes typos in /
 	chiRouter.Use(chiMiddleware.CleanPath)
 	// Recover from panic, log it and return 500
@@ -82,8 +96,18 @@ func NewMainHTTPServer(
 	// CORS
 	chiRouter.Use(corsMiddleware.Handler)
 	chiRouter.Mount("/api/v1/users", authMiddleware.Handler(userMuxV1.GetMux()))
+	chiRouter.Mount("/api/v1/teams", authMiddleware.Handler(teamMuxV1.GetMux()))
 	chiRouter.Mount("/api/v1/record", authMiddleware.Handler(recordMuxV1.GetMux()))
+	chiRouter.Mount("/api/v1/watchlists", authMiddleware.Handler(watchlistMuxV1.GetMux()))
+	chiRouter.Mount("/api/v1/alerts", authMiddleware.Handler(alertMuxV1.GetMux()))
+	chiRouter.Mount("/api/v1/exports", authMiddleware.Handler(exportMuxV1.GetMux()))
+	chiRouter.Mount("/api/v1/investigations", authMiddleware.Handler(investigationMuxV1.GetMux()))
+	chiRouter.Mount("/api/v1/annotations", authMiddleware.Handler(annotationMuxV1.GetMux()))
+	chiRouter.Mount("/api/v1/provenance", authMiddleware.Handler(provenanceMuxV1.GetMux()))
+	chiRouter.Mount("/api/v1/webhooks", authMiddleware.Handler(webhookMuxV1.GetMux()))
+	chiRouter.Mount("/api/v1/audit", authMiddleware.Handler(auditMuxV1.GetMux()))
 	chiRouter.Mount("/api/v1/auth", authMuxV1.GetMux())
+	chiRouter.Mount("/api/v1/bot", botAuthMiddleware.Handler(botMuxV1.GetMux()))
 	chiRouter.Mount("/swagger", httpSwagger.WrapHandler)
 
 	srv, hook := getMainServerAndHook(listenAddress, chiRouter, logger)
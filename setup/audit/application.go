@@ -0,0 +1,18 @@
+package audit
+
+import (
+	"github.com/InWamos/trinity-proto/internal/audit/application"
+	"go.uber.org/fx"
+)
+
+func NewAuditApplicationContainer() fx.Option {
+	return fx.Module(
+		"audit_application",
+		fx.Provide(
+			// Provides the interactors the other modules record their changes with
+			application.NewRecordAuditEntries,
+			application.NewRecordDetachedAuditEntries,
+			application.NewGetAuditEntries,
+		),
+	)
+}
@@ -0,0 +1,17 @@
+package audit
+
+import (
+	sqlxrepository "github.com/InWamos/trinity-proto/internal/audit/infrastructure/repository/sqlx_repository"
+	"go.uber.org/fx"
+)
+
+func NewAuditInfrastructureContainer() fx.Option {
+	return fx.Module(
+		"audit_infrastructure",
+		fx.Provide(
+			// Provides Sqlx mapper for repositories
+			sqlxrepository.NewSqlxAuditMapper,
+			sqlxrepository.NewSqlxAuditRepositoryFactory,
+		),
+	)
+}
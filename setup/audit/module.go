@@ -0,0 +1,13 @@
+package audit
+
+import "go.uber.org/fx"
+
+func NewAuditModuleContainer() fx.Option {
+	// Module with all module's dependencies
+	return fx.Module(
+		"audit_module",
+		NewAuditApplicationContainer(),
+		NewAuditInfrastructureContainer(),
+		NewAuditPresentationContainer(),
+	)
+}
@@ -0,0 +1,21 @@
+package audit
+
+import (
+	"github.com/InWamos/trinity-proto/internal/audit/presentation/client"
+	v1 "github.com/InWamos/trinity-proto/internal/audit/presentation/v1"
+	"github.com/InWamos/trinity-proto/internal/audit/presentation/v1/handlers"
+	"go.uber.org/fx"
+)
+
+func NewAuditPresentationContainer() fx.Option {
+	return fx.Module(
+		"audit_presentation",
+		fx.Provide(
+			// Provides audit client the other modules record their changes with
+			client.NewAuditClient,
+			handlers.NewGetAuditEntriesHandler,
+			// Provides Audit v1 api mux
+			v1.NewAuditMuxV1,
+		),
+	)
+}
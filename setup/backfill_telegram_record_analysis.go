@@ -0,0 +1,98 @@
+package setup
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/record"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/user/client"
+	"go.uber.org/fx"
+)
+
+// BackfillTelegramRecordAnalysisCommandName is the CLI subcommand analyzing all stored records again.
+const BackfillTelegramRecordAnalysisCommandName = "backfill-telegram-records"
+
+// BackfillTelegramIndicatorsCommandName is the former name of the backfill, kept as an alias
+// since the indicators are extracted along with the rest of the analysis.
+const BackfillTelegramIndicatorsCommandName = "backfill-telegram-indicators"
+
+var errMissingBackfillFlags = errors.New("-username of an admin is required")
+
+func parseBackfillTelegramRecordAnalysisFlags(args []string) (string, error) {
+	var username string
+	flagSet := flag.NewFlagSet(BackfillTelegramRecordAnalysisCommandName, flag.ContinueOnError)
+	flagSet.StringVar(&username, "username", "", "admin running the backfill")
+	if err := flagSet.Parse(args); err != nil {
+		return "", err
+	}
+	if username == "" {
+		return "", errMissingBackfillFlags
+	}
+	return username, nil
+}
+
+// NewBackfillTelegramRecordAnalysisCommand returns an Fx invoke function that runs the backfill once the application
+// has started and shuts it down with a non-zero exit code on failure.
+// The password is taken from TRINITY_PASSWORD or read from the first line of stdin.
+func NewBackfillTelegramRecordAnalysisCommand(args []string) any {
+	return func(
+		lc fx.Lifecycle,
+		shutdowner fx.Shutdowner,
+		interactor *record.BackfillTelegramRecordAnalysis,
+		userClient client.UserClient,
+		logger *slog.Logger,
+	) {
+		cmdLogger := logger.With(
+			slog.String("component", "cli"),
+			slog.String("name", BackfillTelegramRecordAnalysisCommandName),
+		)
+		runCtx, cancel := context.WithCancel(context.Background())
+		lc.Append(fx.Hook{
+			OnStart: func(_ context.Context) error {
+				go func() {
+					exitCode := 0
+					if err := runBackfillTelegramRecordAnalysis(runCtx, args, interactor, userClient, cmdLogger); err != nil {
+						cmdLogger.Error("Backfill has failed", slog.Any("err", err))
+						exitCode = 1
+					}
+					_ = shutdowner.Shutdown(fx.ExitCode(exitCode))
+				}()
+				return nil
+			},
+			OnStop: func(_ context.Context) error {
+				cancel()
+				return nil
+			},
+		})
+	}
+}
+
+func runBackfillTelegramRecordAnalysis(
+	ctx context.Context,
+	args []string,
+	interactor *record.BackfillTelegramRecordAnalysis,
+	userClient client.UserClient,
+	logger *slog.Logger,
+) error {
+	username, err := parseBackfillTelegramRecordAnalysisFlags(args)
+	if err != nil {
+		return err
+	}
+	ctx, err = authenticateCommandUser(ctx, userClient, username)
+	if err != nil {
+		return err
+	}
+
+	resp, err := interactor.Execute(ctx, record.BackfillTelegramRecordAnalysisRequest{
+		OnProgress: func(progress record.BackfillTelegramRecordAnalysisProgress) {
+			logger.Info("Backfill progress", slog.Int("records_processed", progress.RecordsProcessed))
+		},
+	})
+	if err != nil {
+		return err
+	}
+	logger.Info("Backfill has finished", slog.Int("records_processed", resp.Progress.RecordsProcessed))
+	return nil
+}
@@ -0,0 +1,49 @@
+package setup
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	authClient "github.com/InWamos/trinity-proto/internal/shared/interfaces/auth/client"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/user/client"
+)
+
+// commandPasswordEnv allows to pass the password of CLI subcommands non-interactively.
+const commandPasswordEnv = "TRINITY_PASSWORD" //nolint:gosec //Name of the variable, not a credential
+
+// authenticateCommandUser verifies the credentials of the user a CLI subcommand runs on behalf of
+// and returns a context carrying their identity, like the authentication middleware does for HTTP requests.
+// The subcommand only sees the data of the user's team then.
+// The password is taken from TRINITY_PASSWORD or read from the first line of stdin.
+func authenticateCommandUser(
+	ctx context.Context,
+	userClient client.UserClient,
+	username string,
+) (context.Context, error) {
+	password, ok := os.LookupEnv(commandPasswordEnv)
+	if !ok {
+		var err error
+		password, err = bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && password == "" {
+			return nil, fmt.Errorf("failed to read the password from stdin: %w", err)
+		}
+		password = strings.TrimRight(password, "\r\n")
+	}
+	credentials, err := userClient.VerifyCredentials(ctx, username, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate %q: %w", username, err)
+	}
+	teamID, err := userClient.GetUserTeam(ctx, credentials.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve the team of %q: %w", username, err)
+	}
+	identity := &authClient.UserIdentity{
+		UserID:   credentials.UserID,
+		UserRole: authClient.UserRole(credentials.UserRole),
+		TeamID:   teamID,
+	}
+	return context.WithValue(ctx, authClient.IdentityProviderKey, identity), nil
+}
@@ -0,0 +1,22 @@
+package event
+
+import (
+	"github.com/InWamos/trinity-proto/internal/event/application"
+	"go.uber.org/fx"
+)
+
+func NewEventApplicationContainer() fx.Option {
+	return fx.Module(
+		"event_application",
+		fx.Provide(
+			application.NewRecordEvent,
+			// Provides the interactor the dispatcher runs, with the subscribers the other modules provide
+			// to the event_subscribers group
+			fx.Annotate(
+				application.NewDispatchOutboxEvents,
+				fx.ParamTags(``, ``, ``, `group:"event_subscribers"`),
+			),
+			application.NewPruneOutboxEvents,
+		),
+	)
+}
@@ -0,0 +1,20 @@
+package event
+
+import (
+	sqlxrepository "github.com/InWamos/trinity-proto/internal/event/infrastructure/repository/sqlx_repository"
+	"github.com/InWamos/trinity-proto/internal/event/infrastructure/stream"
+	"go.uber.org/fx"
+)
+
+func NewEventInfrastructureContainer() fx.Option {
+	return fx.Module(
+		"event_infrastructure",
+		fx.Provide(
+			// Provides Sqlx mapper for repositories
+			sqlxrepository.NewSqlxOutboxMapper,
+			sqlxrepository.NewSqlxOutboxRepositoryFactory,
+			// Provides the Redis stream the events are published to
+			stream.NewRedisEventStream,
+		),
+	)
+}
@@ -0,0 +1,13 @@
+package event
+
+import "go.uber.org/fx"
+
+func NewEventModuleContainer() fx.Option {
+	// Module with all module's dependencies
+	return fx.Module(
+		"event_module",
+		NewEventApplicationContainer(),
+		NewEventInfrastructureContainer(),
+		NewEventPresentationContainer(),
+	)
+}
@@ -0,0 +1,16 @@
+package event
+
+import (
+	"github.com/InWamos/trinity-proto/internal/event/presentation/client"
+	"go.uber.org/fx"
+)
+
+func NewEventPresentationContainer() fx.Option {
+	return fx.Module(
+		"event_presentation",
+		fx.Provide(
+			// Provides event client the other modules record their events with
+			client.NewEventClient,
+		),
+	)
+}
@@ -0,0 +1,71 @@
+package setup
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/InWamos/trinity-proto/config"
+	"github.com/InWamos/trinity-proto/internal/event/application"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"go.uber.org/fx"
+)
+
+// outboxPruneInterval is how often the published events past their retention are removed.
+const outboxPruneInterval = time.Hour
+
+// StartEventDispatcher polls the outbox while the server runs. A full batch is followed by the next one
+// right away, so that a backlog is worked off without waiting for the poll interval.
+func StartEventDispatcher(
+	lc fx.Lifecycle,
+	dispatchInteractor *application.DispatchOutboxEvents,
+	pruneInteractor *application.PruneOutboxEvents,
+	eventConfig *config.EventConfig,
+	logger *slog.Logger,
+) {
+	dispatcherLogger := logger.With(slog.String("component", "event_dispatcher"))
+	// The subscribers clean up the data of every team
+	ctx, cancel := context.WithCancel(interfaces.WithTenantBypass(context.Background()))
+	done := make(chan struct{})
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				ticker := time.NewTicker(eventConfig.PollInterval)
+				defer ticker.Stop()
+				var prunedAt time.Time
+				for {
+					if time.Since(prunedAt) >= outboxPruneInterval {
+						if _, err := pruneInteractor.Execute(ctx); err != nil {
+							dispatcherLogger.ErrorContext(ctx, "failed to prune outbox events", slog.Any("err", err))
+						}
+						prunedAt = time.Now()
+					}
+					attempted, err := dispatchInteractor.Execute(ctx)
+					if err != nil {
+						dispatcherLogger.ErrorContext(ctx, "failed to dispatch outbox events", slog.Any("err", err))
+					}
+					if err == nil && attempted == eventConfig.BatchSize {
+						continue
+					}
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+}
@@ -0,0 +1,113 @@
+package setup
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/importer"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/user/client"
+	"go.uber.org/fx"
+)
+
+// ImportTelegramExportCommandName is the CLI subcommand importing a Telegram Desktop export.
+const ImportTelegramExportCommandName = "import-telegram-export"
+
+var errMissingImportFlags = errors.New("both -username and -export are required")
+
+type importTelegramExportFlags struct {
+	username   string
+	exportPath string
+}
+
+func parseImportTelegramExportFlags(args []string) (importTelegramExportFlags, error) {
+	var flags importTelegramExportFlags
+	flagSet := flag.NewFlagSet(ImportTelegramExportCommandName, flag.ContinueOnError)
+	flagSet.StringVar(&flags.username, "username", "", "user the records are attributed to")
+	flagSet.StringVar(&flags.exportPath, "export", "", "path to result.json of the export, media are looked up next to it")
+	if err := flagSet.Parse(args); err != nil {
+		return flags, err
+	}
+	if flags.username == "" || flags.exportPath == "" {
+		return flags, errMissingImportFlags
+	}
+	return flags, nil
+}
+
+// NewImportTelegramExportCommand returns an Fx invoke function that runs the import once the application has started
+// and shuts it down with a non-zero exit code on failure.
+// The password is taken from TRINITY_PASSWORD or read from the first line of stdin.
+func NewImportTelegramExportCommand(args []string) any {
+	return func(
+		lc fx.Lifecycle,
+		shutdowner fx.Shutdowner,
+		interactor *importer.ImportTelegramExport,
+		userClient client.UserClient,
+		logger *slog.Logger,
+	) {
+		cmdLogger := logger.With(slog.String("component", "cli"), slog.String("name", ImportTelegramExportCommandName))
+		runCtx, cancel := context.WithCancel(context.Background())
+		lc.Append(fx.Hook{
+			OnStart: func(_ context.Context) error {
+				go func() {
+					exitCode := 0
+					if err := runImportTelegramExport(runCtx, args, interactor, userClient, cmdLogger); err != nil {
+						cmdLogger.Error("Import has failed", slog.Any("err", err))
+						exitCode = 1
+					}
+					_ = shutdowner.Shutdown(fx.ExitCode(exitCode))
+				}()
+				return nil
+			},
+			OnStop: func(_ context.Context) error {
+				cancel()
+				return nil
+			},
+		})
+	}
+}
+
+func runImportTelegramExport(
+	ctx context.Context,
+	args []string,
+	interactor *importer.ImportTelegramExport,
+	userClient client.UserClient,
+	logger *slog.Logger,
+) error {
+	flags, err := parseImportTelegramExportFlags(args)
+	if err != nil {
+		return err
+	}
+
+	ctx, err = authenticateCommandUser(ctx, userClient, flags.username)
+	if err != nil {
+		return err
+	}
+
+	export, err := os.Open(flags.exportPath)
+	if err != nil {
+		return err
+	}
+	defer export.Close()
+
+	resp, err := interactor.Execute(ctx, importer.ImportTelegramExportRequest{
+		Export: export,
+		Files:  os.DirFS(filepath.Dir(flags.exportPath)),
+		OnProgress: func(progress importer.ImportTelegramExportProgress) {
+			logger.Info("Import progress", slog.Any("progress", progress))
+		},
+	})
+	if err != nil {
+		return err
+	}
+	logger.Info(
+		"Import has finished",
+		slog.String("chat_id", resp.ChatID.String()),
+		slog.Int64("chat_telegram_id", resp.ChatTelegramID),
+		slog.Any("progress", resp.Progress),
+	)
+	return nil
+}
@@ -2,8 +2,25 @@ package record
 
 import (
 	application "github.com/InWamos/trinity-proto/internal/record/application/telegram"
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/activity"
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/alert"
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/annotation"
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/attachment"
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/bot"
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/chain"
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/chat"
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/correlation"
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/export"
 	identityApplication "github.com/InWamos/trinity-proto/internal/record/application/telegram/identity"
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/importer"
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/indicator"
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/ingest"
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/interaction"
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/investigation"
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/picture"
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/provenance"
 	"github.com/InWamos/trinity-proto/internal/record/application/telegram/record"
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/watchlist"
 	"go.uber.org/fx"
 )
 
@@ -12,9 +29,73 @@ func NewRecordApplicationContainer() fx.Option {
 		"record_application",
 		fx.Provide(
 			application.NewGetLatestTelegramRecordsByUserTelegramID,
+			application.NewStreamTelegramRecordsByUserTelegramID,
 			application.NewAddTelegramUser,
+			application.NewTelegramWatchlistEvaluator,
+			application.NewTelegramRecordAnalyzer,
+			application.NewTelegramEventPublisher,
+			application.NewTelegramChainAppender,
+			application.NewTelegramVisibilityPolicy,
+			application.NewTelegramProvenanceRecorder,
 			record.NewAddTelegramRecord,
+			record.NewAddTelegramRecordsBatch,
+			record.NewGetTelegramRecordHistory,
+			record.NewGetTelegramRecordThread,
+			record.NewGetTelegramRecordsForwardedFrom,
+			record.NewMarkTelegramRecordDeleted,
+			record.NewBackfillTelegramRecordAnalysis,
 			identityApplication.NewAddTelegramIdentity,
+			identityApplication.NewStreamTelegramIdentityHistory,
+			chat.NewAddTelegramChat,
+			chat.NewGetTelegramChat,
+			attachment.NewAddTelegramAttachment,
+			attachment.NewGetTelegramAttachments,
+			attachment.NewDownloadTelegramAttachment,
+			picture.NewAddTelegramProfilePicture,
+			picture.NewGetTelegramProfilePictures,
+			picture.NewDownloadTelegramProfilePicture,
+			importer.NewImportTelegramExport,
+			ingest.NewIngestTelegramChunk,
+			bot.NewReceiveTelegramBotUpdate,
+			indicator.NewGetTelegramUserIndicators,
+			indicator.NewGetTelegramRecordsByIndicator,
+			indicator.NewStreamTelegramRecordsByIndicator,
+			interaction.NewGetTelegramInteractionGraph,
+			correlation.NewGetTelegramCorrelationClusters,
+			activity.NewGetTelegramActivityStatistics,
+			watchlist.NewAddTelegramWatchlist,
+			watchlist.NewGetTelegramWatchlists,
+			watchlist.NewRemoveTelegramWatchlist,
+			watchlist.NewAddTelegramWatchlistEntry,
+			watchlist.NewRemoveTelegramWatchlistEntry,
+			watchlist.NewRemoveUserTelegramWatchlists,
+			alert.NewGetTelegramAlerts,
+			alert.NewMarkTelegramAlertRead,
+			chain.NewCheckpointTelegramChains,
+			chain.NewVerifyTelegramChain,
+			export.NewRequestTelegramExport,
+			export.NewGetTelegramExports,
+			export.NewGetTelegramExport,
+			export.NewDownloadTelegramExport,
+			export.NewBuildTelegramExport,
+			investigation.NewAddTelegramInvestigation,
+			investigation.NewGetTelegramInvestigations,
+			investigation.NewGetTelegramInvestigation,
+			investigation.NewRemoveTelegramInvestigation,
+			investigation.NewSaveTelegramInvestigationMember,
+			investigation.NewRemoveTelegramInvestigationMember,
+			investigation.NewAddTelegramInvestigationItem,
+			investigation.NewRemoveTelegramInvestigationItem,
+			investigation.NewRemoveUserTelegramInvestigationMemberships,
+			annotation.NewAddTelegramTag,
+			annotation.NewRemoveTelegramTag,
+			annotation.NewAddTelegramNote,
+			annotation.NewRemoveTelegramNote,
+			annotation.NewGetTelegramAnnotations,
+			annotation.NewGetTelegramTagCatalogue,
+			annotation.NewGetTelegramTaggedTargets,
+			provenance.NewGetTelegramProvenance,
+			provenance.NewSetTelegramObservationVisibility,
 		),
 	)
 }
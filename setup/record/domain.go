@@ -8,6 +8,6 @@ import (
 func NewRecordDomainContainer() fx.Option {
 	return fx.Module(
 		"record_domain",
-		fx.Provide(service.NewTelegramModelValidator),
+		fx.Provide(service.NewTelegramModelValidator, service.NewTelegramIndicatorExtractor),
 	)
 }
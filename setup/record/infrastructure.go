@@ -2,9 +2,22 @@ package record
 
 import (
 	"github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/mappers"
+	SqlxTelegramActivityRepositories "github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/repositories/telegram_activity"
+	SqlxTelegramAlertRepositories "github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/repositories/telegram_alert"
+	SqlxTelegramAnnotationRepositories "github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/repositories/telegram_annotation"
+	SqlxTelegramAttachmentRepositories "github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/repositories/telegram_attachment"
+	SqlxTelegramChainRepositories "github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/repositories/telegram_chain"
+	SqlxTelegramChatRepositories "github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/repositories/telegram_chat"
+	SqlxTelegramExportRepositories "github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/repositories/telegram_export"
 	SqlxTelegramIdentityRepositories "github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/repositories/telegram_identity"
+	SqlxTelegramIndicatorRepositories "github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/repositories/telegram_indicator"
+	SqlxTelegramInteractionRepositories "github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/repositories/telegram_interaction"
+	SqlxTelegramInvestigationRepositories "github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/repositories/telegram_investigation"
+	SqlxTelegramObservationRepositories "github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/repositories/telegram_observation"
+	SqlxTelegramProfilePictureRepositories "github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/repositories/telegram_profile_picture"
 	SqlxTelegramRecordRepositories "github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/repositories/telegram_record"
 	SqlxTelegramUserRepositories "github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/repositories/telegram_user"
+	SqlxTelegramWatchlistRepositories "github.com/InWamos/trinity-proto/internal/record/infrastructure/repository/sqlx/repositories/telegram_watchlist"
 	"go.uber.org/fx"
 )
 
@@ -15,12 +28,51 @@ func NewRecordInfrastructureContainer() fx.Option {
 			mappers.NewSqlxTelegramRecordMapper,
 			mappers.NewSqlxTelegramUserMapper,
 			mappers.NewSqlxTelegramIdentityMapper,
+			mappers.NewSqlxTelegramChatMapper,
+			mappers.NewSqlxTelegramAttachmentMapper,
+			mappers.NewSqlxTelegramProfilePictureMapper,
+			mappers.NewSqlxTelegramIndicatorMapper,
+			mappers.NewSqlxTelegramInteractionMapper,
+			mappers.NewSqlxTelegramActivityMapper,
+			mappers.NewSqlxTelegramWatchlistMapper,
+			mappers.NewSqlxTelegramAlertMapper,
+			mappers.NewSqlxTelegramChainMapper,
+			mappers.NewSqlxTelegramExportMapper,
+			mappers.NewSqlxTelegramInvestigationMapper,
+			mappers.NewSqlxTelegramAnnotationMapper,
+			mappers.NewSqlxTelegramObservationMapper,
 			SqlxTelegramRecordRepositories.NewSQLXTelegramRecordRepository,
 			SqlxTelegramRecordRepositories.NewSQLXTelegramRecordRepositoryFactory,
 			SqlxTelegramUserRepositories.NewSQLXTelegramUserRepository,
 			SqlxTelegramUserRepositories.NewSQLXTelegramUserRepositoryFactory,
 			SqlxTelegramIdentityRepositories.NewSQLXTelegramIdentityRepository,
 			SqlxTelegramIdentityRepositories.NewSQLXTelegramIdentityRepositoryFactory,
+			SqlxTelegramChatRepositories.NewSQLXTelegramChatRepository,
+			SqlxTelegramChatRepositories.NewSQLXTelegramChatRepositoryFactory,
+			SqlxTelegramAttachmentRepositories.NewSQLXTelegramAttachmentRepository,
+			SqlxTelegramAttachmentRepositories.NewSQLXTelegramAttachmentRepositoryFactory,
+			SqlxTelegramProfilePictureRepositories.NewSQLXTelegramProfilePictureRepository,
+			SqlxTelegramProfilePictureRepositories.NewSQLXTelegramProfilePictureRepositoryFactory,
+			SqlxTelegramIndicatorRepositories.NewSQLXTelegramIndicatorRepository,
+			SqlxTelegramIndicatorRepositories.NewSQLXTelegramIndicatorRepositoryFactory,
+			SqlxTelegramInteractionRepositories.NewSQLXTelegramInteractionRepository,
+			SqlxTelegramInteractionRepositories.NewSQLXTelegramInteractionRepositoryFactory,
+			SqlxTelegramActivityRepositories.NewSQLXTelegramActivityRepository,
+			SqlxTelegramActivityRepositories.NewSQLXTelegramActivityRepositoryFactory,
+			SqlxTelegramWatchlistRepositories.NewSQLXTelegramWatchlistRepository,
+			SqlxTelegramWatchlistRepositories.NewSQLXTelegramWatchlistRepositoryFactory,
+			SqlxTelegramAlertRepositories.NewSQLXTelegramAlertRepository,
+			SqlxTelegramAlertRepositories.NewSQLXTelegramAlertRepositoryFactory,
+			SqlxTelegramChainRepositories.NewSQLXTelegramChainRepository,
+			SqlxTelegramChainRepositories.NewSQLXTelegramChainRepositoryFactory,
+			SqlxTelegramExportRepositories.NewSQLXTelegramExportRepository,
+			SqlxTelegramExportRepositories.NewSQLXTelegramExportRepositoryFactory,
+			SqlxTelegramInvestigationRepositories.NewSQLXTelegramInvestigationRepository,
+			SqlxTelegramInvestigationRepositories.NewSQLXTelegramInvestigationRepositoryFactory,
+			SqlxTelegramAnnotationRepositories.NewSQLXTelegramAnnotationRepository,
+			SqlxTelegramAnnotationRepositories.NewSQLXTelegramAnnotationRepositoryFactory,
+			SqlxTelegramObservationRepositories.NewSQLXTelegramObservationRepository,
+			SqlxTelegramObservationRepositories.NewSQLXTelegramObservationRepositoryFactory,
 		),
 	)
 }
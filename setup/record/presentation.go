@@ -1,6 +1,7 @@
 package record
 
 import (
+	"github.com/InWamos/trinity-proto/internal/record/presentation/client"
 	v1 "github.com/InWamos/trinity-proto/internal/record/presentation/v1"
 	"github.com/InWamos/trinity-proto/internal/record/presentation/v1/handlers"
 	"go.uber.org/fx"
@@ -14,7 +15,69 @@ func NewRecordPresentationContainer() fx.Option {
 			handlers.NewAddTelegramUserHandler,
 			handlers.NewAddTelegramIdentityHandler,
 			handlers.NewAddTelegramRecordHandler,
+			handlers.NewAddTelegramRecordsBatchHandler,
+			handlers.NewGetTelegramRecordHistoryHandler,
+			handlers.NewGetTelegramRecordThreadHandler,
+			handlers.NewGetTelegramRecordsForwardedFromUserHandler,
+			handlers.NewGetTelegramRecordsForwardedFromChatHandler,
+			handlers.NewMarkTelegramRecordDeletedHandler,
+			handlers.NewAddTelegramChatHandler,
+			handlers.NewGetTelegramChatHandler,
+			handlers.NewAddTelegramAttachmentHandler,
+			handlers.NewGetTelegramAttachmentsHandler,
+			handlers.NewDownloadTelegramAttachmentHandler,
+			handlers.NewAddTelegramProfilePictureHandler,
+			handlers.NewGetTelegramProfilePicturesHandler,
+			handlers.NewDownloadTelegramProfilePictureHandler,
+			handlers.NewImportTelegramExportHandler,
+			handlers.NewIngestTelegramStreamHandler,
+			handlers.NewReceiveTelegramBotUpdateHandler,
+			handlers.NewGetTelegramUserIndicatorsHandler,
+			handlers.NewGetTelegramRecordsByIndicatorHandler,
+			handlers.NewGetTelegramInteractionGraphHandler,
+			handlers.NewGetTelegramCorrelationClustersHandler,
+			handlers.NewGetTelegramUserActivityHandler,
+			handlers.NewGetTelegramChatActivityHandler,
+			handlers.NewAddTelegramWatchlistHandler,
+			handlers.NewGetTelegramWatchlistsHandler,
+			handlers.NewRemoveTelegramWatchlistHandler,
+			handlers.NewAddTelegramWatchlistEntryHandler,
+			handlers.NewRemoveTelegramWatchlistEntryHandler,
+			handlers.NewGetTelegramAlertsHandler,
+			handlers.NewMarkTelegramAlertReadHandler,
+			handlers.NewVerifyTelegramChainHandler,
+			handlers.NewGetTelegramIdentityHistoryHandler,
+			handlers.NewRequestTelegramExportHandler,
+			handlers.NewGetTelegramExportsHandler,
+			handlers.NewGetTelegramExportHandler,
+			handlers.NewDownloadTelegramExportHandler,
+			handlers.NewAddTelegramInvestigationHandler,
+			handlers.NewGetTelegramInvestigationsHandler,
+			handlers.NewGetTelegramInvestigationHandler,
+			handlers.NewRemoveTelegramInvestigationHandler,
+			handlers.NewSaveTelegramInvestigationMemberHandler,
+			handlers.NewRemoveTelegramInvestigationMemberHandler,
+			handlers.NewAddTelegramInvestigationItemHandler,
+			handlers.NewRemoveTelegramInvestigationItemHandler,
+			handlers.NewAddTelegramTagHandler,
+			handlers.NewRemoveTelegramTagHandler,
+			handlers.NewAddTelegramNoteHandler,
+			handlers.NewRemoveTelegramNoteHandler,
+			handlers.NewGetTelegramAnnotationsHandler,
+			handlers.NewGetTelegramTagCatalogueHandler,
+			handlers.NewGetTelegramTaggedTargetsHandler,
+			handlers.NewGetTelegramProvenanceHandler,
+			handlers.NewSetTelegramObservationVisibilityHandler,
 			v1.NewRecordMuxV1,
+			v1.NewBotMuxV1,
+			v1.NewWatchlistMuxV1,
+			v1.NewAlertMuxV1,
+			v1.NewExportMuxV1,
+			v1.NewInvestigationMuxV1,
+			v1.NewAnnotationMuxV1,
+			v1.NewProvenanceMuxV1,
+			// Subscribes the record module to the events of the user module
+			fx.Annotate(client.NewUserEventSubscriber, fx.ResultTags(`group:"event_subscribers"`)),
 		),
 	)
 }
@@ -0,0 +1,14 @@
+package storage
+
+import (
+	blobstorage "github.com/InWamos/trinity-proto/internal/shared/infrastructure/blob_storage"
+	"go.uber.org/fx"
+)
+
+func NewBlobStorageContainer() fx.Option {
+	return fx.Module(
+		"blob_storage",
+		fx.Provide(
+			blobstorage.NewBlobStore,
+		))
+}
@@ -2,6 +2,7 @@ package shared //nolint:revive //meaningful package name
 
 import (
 	"github.com/InWamos/trinity-proto/setup/shared/infrastructure/database"
+	"github.com/InWamos/trinity-proto/setup/shared/infrastructure/storage"
 	"go.uber.org/fx"
 )
 
@@ -9,5 +10,6 @@ func NewSharedModuleContainer() fx.Option {
 	return fx.Module(
 		"shared_module",
 		database.NewSqlxDatabaseContainer(),
+		storage.NewBlobStorageContainer(),
 	)
 }
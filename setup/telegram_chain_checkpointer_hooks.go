@@ -0,0 +1,60 @@
+package setup
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/InWamos/trinity-proto/config"
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/chain"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"go.uber.org/fx"
+)
+
+// StartTelegramChainCheckpointer signs the heads of the telegram chains every checkpoint interval while
+// the server runs. It isn't started without a signing key.
+func StartTelegramChainCheckpointer(
+	lc fx.Lifecycle,
+	interactor *chain.CheckpointTelegramChains,
+	chainConfig *config.ChainConfig,
+	logger *slog.Logger,
+) {
+	checkpointerLogger := logger.With(slog.String("component", "telegram_chain_checkpointer"))
+	if chainConfig.SigningKey == nil {
+		checkpointerLogger.Warn("CHAIN_SIGNING_KEY is not set, the telegram chains won't be checkpointed")
+		return
+	}
+	// The chains of every team are checkpointed
+	ctx, cancel := context.WithCancel(interfaces.WithTenantBypass(context.Background()))
+	done := make(chan struct{})
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				ticker := time.NewTicker(chainConfig.CheckpointInterval)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+					}
+					if _, err := interactor.Execute(ctx); err != nil {
+						checkpointerLogger.ErrorContext(ctx, "failed to checkpoint telegram chains", slog.Any("err", err))
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+}
@@ -0,0 +1,66 @@
+package setup
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/InWamos/trinity-proto/config"
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/export"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces"
+	"go.uber.org/fx"
+)
+
+// StartTelegramExportWorker builds the pending telegram exports one at a time while the server runs.
+// It isn't started without a signing key, the exports are refused then.
+func StartTelegramExportWorker(
+	lc fx.Lifecycle,
+	interactor *export.BuildTelegramExport,
+	exportConfig *config.ExportConfig,
+	chainConfig *config.ChainConfig,
+	logger *slog.Logger,
+) {
+	workerLogger := logger.With(slog.String("component", "telegram_export_worker"))
+	if chainConfig.SigningKey == nil {
+		workerLogger.Warn("CHAIN_SIGNING_KEY is not set, the telegram exports won't be built")
+		return
+	}
+	// The exports of every team are claimed, each is built within the team of its requester then
+	ctx, cancel := context.WithCancel(interfaces.WithTenantBypass(context.Background()))
+	done := make(chan struct{})
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				ticker := time.NewTicker(exportConfig.PollInterval)
+				defer ticker.Stop()
+				for {
+					claimed, err := interactor.Execute(ctx)
+					if err != nil {
+						workerLogger.ErrorContext(ctx, "failed to build telegram export", slog.Any("err", err))
+					}
+					// Another export may be pending
+					if claimed && ctx.Err() == nil {
+						continue
+					}
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+}
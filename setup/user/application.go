@@ -26,7 +26,17 @@ func NewUserApplicationContainer() fx.Option {
 			application.NewRemoveUser,
 			// Provides ValidateUserCredentialsInteractor
 			application.NewValidateUserCredentials,
+			// Provides ResolveUserInteractor
+			application.NewResolveUser,
 			application.NewCreateRandomAdminUser,
+			// Provides GetUserTeamInteractor
+			application.NewGetUserTeam,
+			// Provides CreateTeamInteractor
+			application.NewCreateTeam,
+			// Provides GetTeamsInteractor
+			application.NewGetTeams,
+			// Provides RemoveTeamInteractor
+			application.NewRemoveTeam,
 		),
 	)
 }
@@ -13,6 +13,10 @@ func NewUserInfrastructureContainer() fx.Option {
 			sqlxrepository.NewSqlxUserMapper,
 			// Provides User repository factory
 			sqlxrepository.NewSqlxUserRepositoryFactory,
+			// Provides Sqlx mapper for team repository
+			sqlxrepository.NewSqlxTeamMapper,
+			// Provides Team repository factory
+			sqlxrepository.NewSqlxTeamRepositoryFactory,
 			// Provides SQLx session
 		),
 	)
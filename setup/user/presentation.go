@@ -29,6 +29,14 @@ func NewUserPresentationContainer() fx.Option {
 			handlers.NewRemoveUserHandler,
 			// Provides User v1 api mux
 			v1.NewUserMuxV1,
+			// Provides create team handler
+			handlers.NewCreateTeamHandler,
+			// Provides get teams handler
+			handlers.NewGetTeamsHandler,
+			// Provides remove team handler
+			handlers.NewRemoveTeamHandler,
+			// Provides Team v1 api mux
+			v1.NewTeamMuxV1,
 		),
 	)
 }
@@ -0,0 +1,145 @@
+package setup
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log/slog"
+
+	"github.com/InWamos/trinity-proto/internal/record/application/telegram/chain"
+	"github.com/InWamos/trinity-proto/internal/shared/interfaces/user/client"
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+)
+
+// VerifyTelegramChainCommandName is the CLI subcommand verifying the hash chains over the archived telegram data.
+const VerifyTelegramChainCommandName = "verify-telegram-chain"
+
+var (
+	errMissingVerifyFlags  = errors.New("-username of an admin is required")
+	errTelegramChainBroken = errors.New("the telegram chain is broken")
+)
+
+type verifyTelegramChainFlags struct {
+	username        string
+	collectorUserID uuid.UUID
+}
+
+func parseVerifyTelegramChainFlags(args []string) (*verifyTelegramChainFlags, error) {
+	var flags verifyTelegramChainFlags
+	var collector string
+	flagSet := flag.NewFlagSet(VerifyTelegramChainCommandName, flag.ContinueOnError)
+	flagSet.StringVar(&flags.username, "username", "", "admin running the verification")
+	flagSet.StringVar(&collector, "collector", "", "ID of the user whose chain is verified, every chain by default")
+	if err := flagSet.Parse(args); err != nil {
+		return nil, err
+	}
+	if flags.username == "" {
+		return nil, errMissingVerifyFlags
+	}
+	if collector != "" {
+		collectorUserID, err := uuid.Parse(collector)
+		if err != nil {
+			return nil, err
+		}
+		flags.collectorUserID = collectorUserID
+	}
+	return &flags, nil
+}
+
+// NewVerifyTelegramChainCommand returns an Fx invoke function that runs the verification once the application
+// has started and shuts it down with a non-zero exit code when it has failed or found a break.
+// The password is taken from TRINITY_PASSWORD or read from the first line of stdin.
+func NewVerifyTelegramChainCommand(args []string) any {
+	return func(
+		lc fx.Lifecycle,
+		shutdowner fx.Shutdowner,
+		interactor *chain.VerifyTelegramChain,
+		userClient client.UserClient,
+		logger *slog.Logger,
+	) {
+		cmdLogger := logger.With(
+			slog.String("component", "cli"),
+			slog.String("name", VerifyTelegramChainCommandName),
+		)
+		runCtx, cancel := context.WithCancel(context.Background())
+		lc.Append(fx.Hook{
+			OnStart: func(_ context.Context) error {
+				go func() {
+					exitCode := 0
+					if err := runVerifyTelegramChain(runCtx, args, interactor, userClient, cmdLogger); err != nil {
+						cmdLogger.Error("Verification has failed", slog.Any("err", err))
+						exitCode = 1
+					}
+					_ = shutdowner.Shutdown(fx.ExitCode(exitCode))
+				}()
+				return nil
+			},
+			OnStop: func(_ context.Context) error {
+				cancel()
+				return nil
+			},
+		})
+	}
+}
+
+func runVerifyTelegramChain(
+	ctx context.Context,
+	args []string,
+	interactor *chain.VerifyTelegramChain,
+	userClient client.UserClient,
+	logger *slog.Logger,
+) error {
+	flags, err := parseVerifyTelegramChainFlags(args)
+	if err != nil {
+		return err
+	}
+	ctx, err = authenticateCommandUser(ctx, userClient, flags.username)
+	if err != nil {
+		return err
+	}
+
+	resp, err := interactor.Execute(ctx, chain.VerifyTelegramChainRequest{
+		CollectorUserID: flags.collectorUserID,
+		OnProgress: func(progress chain.VerifyTelegramChainProgress) {
+			logger.Info(
+				"Verification progress",
+				slog.Int("chains_verified", progress.ChainsVerified),
+				slog.Int("entries_verified", progress.EntriesVerified),
+			)
+		},
+	})
+	if err != nil {
+		return err
+	}
+	for _, chainBreak := range resp.Breaks {
+		logger.Warn(
+			"Telegram chain break",
+			slog.String("collector_user_id", chainBreak.CollectorUserID.String()),
+			slog.Int64("sequence", chainBreak.Sequence),
+			slog.String("subject_type", string(chainBreak.SubjectType)),
+			slog.String("subject_id", chainBreak.SubjectID.String()),
+			slog.String("reason", string(chainBreak.Reason)),
+		)
+	}
+	if !resp.SignaturesVerified {
+		logger.Warn("CHAIN_SIGNING_KEY is not set, the signatures of the checkpoints haven't been verified")
+	}
+	for subjectType, count := range resp.Unchained {
+		if count > 0 {
+			logger.Info("Unchained subjects", slog.String("subject_type", string(subjectType)), slog.Int64("count", count))
+		}
+	}
+	logger.Info(
+		"Verification has finished",
+		slog.Int("chains_verified", resp.Progress.ChainsVerified),
+		slog.Int("entries_verified", resp.Progress.EntriesVerified),
+		slog.Int("checkpoints_verified", resp.CheckpointsVerified),
+		slog.Int("breaks", len(resp.Breaks)),
+		slog.Bool("breaks_truncated", resp.BreaksTruncated),
+	)
+	if len(resp.Breaks) > 0 {
+		return errTelegramChainBroken
+	}
+	return nil
+}
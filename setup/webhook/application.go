@@ -0,0 +1,23 @@
+package webhook
+
+import (
+	"github.com/InWamos/trinity-proto/internal/webhook/application"
+	"go.uber.org/fx"
+)
+
+func NewWebhookApplicationContainer() fx.Option {
+	return fx.Module(
+		"webhook_application",
+		fx.Provide(
+			application.NewAddWebhookSubscription,
+			application.NewGetWebhookSubscriptions,
+			application.NewRemoveWebhookSubscription,
+			application.NewGetWebhookDeliveries,
+			application.NewRedeliverWebhookDelivery,
+			// Provides the interactor the event subscriber queues the deliveries with
+			application.NewPublishWebhookEvent,
+			// Provides the interactor the delivery worker runs
+			application.NewDispatchWebhookDeliveries,
+		),
+	)
+}
@@ -0,0 +1,21 @@
+package webhook
+
+import (
+	sqlxrepository "github.com/InWamos/trinity-proto/internal/webhook/infrastructure/repository/sqlx_repository"
+	"github.com/InWamos/trinity-proto/internal/webhook/infrastructure/sender"
+	"go.uber.org/fx"
+)
+
+func NewWebhookInfrastructureContainer() fx.Option {
+	return fx.Module(
+		"webhook_infrastructure",
+		fx.Provide(
+			// Provides Sqlx mapper for repositories
+			sqlxrepository.NewSqlxWebhookMapper,
+			sqlxrepository.NewSqlxWebhookSubscriptionRepositoryFactory,
+			sqlxrepository.NewSqlxWebhookDeliveryRepositoryFactory,
+			// Provides the HTTP client signing the deliveries
+			sender.NewHTTPWebhookSender,
+		),
+	)
+}
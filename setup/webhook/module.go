@@ -0,0 +1,13 @@
+package webhook
+
+import "go.uber.org/fx"
+
+func NewWebhookModuleContainer() fx.Option {
+	// Module with all module's dependencies
+	return fx.Module(
+		"webhook_module",
+		NewWebhookApplicationContainer(),
+		NewWebhookInfrastructureContainer(),
+		NewWebhookPresentationContainer(),
+	)
+}
@@ -0,0 +1,25 @@
+package webhook
+
+import (
+	"github.com/InWamos/trinity-proto/internal/webhook/presentation/client"
+	v1 "github.com/InWamos/trinity-proto/internal/webhook/presentation/v1"
+	"github.com/InWamos/trinity-proto/internal/webhook/presentation/v1/handlers"
+	"go.uber.org/fx"
+)
+
+func NewWebhookPresentationContainer() fx.Option {
+	return fx.Module(
+		"webhook_presentation",
+		fx.Provide(
+			// Subscribes the webhooks to the events the other modules record
+			fx.Annotate(client.NewWebhookEventSubscriber, fx.ResultTags(`group:"event_subscribers"`)),
+			handlers.NewAddWebhookSubscriptionHandler,
+			handlers.NewGetWebhookSubscriptionsHandler,
+			handlers.NewRemoveWebhookSubscriptionHandler,
+			handlers.NewGetWebhookDeliveriesHandler,
+			handlers.NewRedeliverWebhookDeliveryHandler,
+			// Provides Webhook v1 api mux
+			v1.NewWebhookMuxV1,
+		),
+	)
+}
@@ -0,0 +1,58 @@
+package setup
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/InWamos/trinity-proto/config"
+	"github.com/InWamos/trinity-proto/internal/webhook/application"
+	"go.uber.org/fx"
+)
+
+// StartWebhookDispatcher polls the webhook delivery queue while the server runs. A full batch is followed
+// by the next one right away, so that a backlog is worked off without waiting for the poll interval.
+func StartWebhookDispatcher(
+	lc fx.Lifecycle,
+	interactor *application.DispatchWebhookDeliveries,
+	webhookConfig *config.WebhookConfig,
+	logger *slog.Logger,
+) {
+	dispatcherLogger := logger.With(slog.String("component", "webhook_dispatcher"))
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				ticker := time.NewTicker(webhookConfig.PollInterval)
+				defer ticker.Stop()
+				for {
+					attempted, err := interactor.Execute(ctx)
+					if err != nil {
+						dispatcherLogger.ErrorContext(ctx, "failed to dispatch webhook deliveries", slog.Any("err", err))
+					}
+					if err == nil && attempted == webhookConfig.BatchSize {
+						continue
+					}
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+}
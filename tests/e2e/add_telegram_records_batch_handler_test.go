@@ -0,0 +1,101 @@
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type recordsBatchResponse struct {
+	Created int `json:"created"`
+	Failed  int `json:"failed"`
+	Results []struct {
+		Index    int    `json:"index"`
+		RecordID string `json:"record_id"`
+		Error    string `json:"error"`
+	} `json:"results"`
+}
+
+func postRecordsBatch(t *testing.T, baseURL, token string, records []map[string]interface{}) recordsBatchResponse {
+	t.Helper()
+
+	resp := MakeAuthorizedRequest(
+		t,
+		"POST",
+		fmt.Sprintf("%s/api/v1/record/telegram/records:batch", baseURL),
+		token,
+		map[string]interface{}{"records": records},
+	)
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("batch failed: status=%d, body=%s", resp.StatusCode, string(respBody))
+	}
+	var batch recordsBatchResponse
+	if err := json.Unmarshal(respBody, &batch); err != nil {
+		t.Fatalf("failed to unmarshal batch response: %v", err)
+	}
+	return batch
+}
+
+func TestAddTelegramRecordsBatch_Deduplication(t *testing.T) {
+	baseURL, cleanup := StartTestServer(t)
+	defer cleanup()
+
+	adminToken := LoginUser(t, baseURL, "admin", "admin123")
+
+	telegramID := uint64(time.Now().UnixNano())
+	userResp := MakeAuthorizedRequest(
+		t,
+		"POST",
+		fmt.Sprintf("%s/api/v1/record/telegram/user", baseURL),
+		adminToken,
+		map[string]uint64{"telegram_id": telegramID},
+	)
+	defer userResp.Body.Close()
+	var user map[string]string
+	userBody, _ := io.ReadAll(userResp.Body)
+	if userResp.StatusCode != http.StatusCreated {
+		t.Fatalf("failed to add telegram user: status=%d, body=%s", userResp.StatusCode, string(userBody))
+	}
+	if err := json.Unmarshal(userBody, &user); err != nil {
+		t.Fatalf("failed to unmarshal user response: %v", err)
+	}
+
+	record := func(messageID uint64, text string) map[string]interface{} {
+		return map[string]interface{}{
+			"message_telegram_id":   messageID,
+			"from_user_telegram_id": user["record_id"],
+			"in_telegram_chat_id":   int64(telegramID % 1_000_000_000),
+			"message_text":          text,
+			"posted_at":             "2024-01-15T10:30:00Z",
+		}
+	}
+
+	// A message repeated inside of the batch is stored once
+	batch := postRecordsBatch(t, baseURL, adminToken, []map[string]interface{}{
+		record(1, "first"), record(2, "second"), record(1, "first again"), record(3, ""),
+	})
+	if batch.Created != 2 || batch.Failed != 2 || len(batch.Results) != 4 {
+		t.Fatalf("expected 2 created and 2 failed records, got %+v", batch)
+	}
+	expectedErrors := []string{"", "", "record_already_exists", "validation_failed"}
+	for i, result := range batch.Results {
+		if result.Index != i || result.Error != expectedErrors[i] || (result.Error == "") == (result.RecordID == "") {
+			t.Errorf("unexpected result %d: %+v", i, result)
+		}
+	}
+
+	// Messages stored by an earlier batch are skipped without failing the new ones
+	batch = postRecordsBatch(t, baseURL, adminToken, []map[string]interface{}{record(2, "second"), record(4, "fourth")})
+	if batch.Created != 1 || batch.Failed != 1 {
+		t.Fatalf("expected only the new record to be created, got %+v", batch)
+	}
+	if batch.Results[0].Error != "record_already_exists" || batch.Results[1].RecordID == "" {
+		t.Errorf("expected the stored record to be reported as a duplicate, got %+v", batch.Results)
+	}
+}
@@ -0,0 +1,118 @@
+package e2e
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+type auditEntriesResponse struct {
+	Entries []struct {
+		ID          string  `json:"id"`
+		ActorUserID *string `json:"actor_user_id"`
+		ActorRole   string  `json:"actor_role"`
+		Action      string  `json:"action"`
+		TargetType  string  `json:"target_type"`
+		TargetID    string  `json:"target_id"`
+		RequestID   string  `json:"request_id"`
+		IPAddress   string  `json:"ip_address"`
+	} `json:"entries"`
+}
+
+func TestAuditLog(t *testing.T) {
+	baseURL, cleanup := StartTestServer(t)
+	defer cleanup()
+
+	adminToken := LoginUser(t, baseURL, "admin", "admin123")
+
+	username := fmt.Sprintf("audited%d", time.Now().UnixNano()%1000000)
+	resp := MakeAuthorizedRequest(t, "POST", baseURL+"/api/v1/users/", adminToken, map[string]string{
+		"username":     username,
+		"display_name": "Audited User",
+		"password":     "password123",
+		"user_role":    "user",
+	})
+	DecodeResponse(t, resp, http.StatusCreated, nil)
+
+	db, err := openTestDatabase(testContainers)
+	if err != nil {
+		t.Fatalf("failed to open the test database: %v", err)
+	}
+	defer db.Close()
+	var userID string
+	if err := db.QueryRow(`SELECT id FROM "user"."users" WHERE username = $1`, username).Scan(&userID); err != nil {
+		t.Fatalf("failed to query the created user: %v", err)
+	}
+
+	cases := map[string]struct {
+		query    string
+		expected bool
+	}{
+		"action and target": {query: "?action=user.created&target_type=user&target_id=" + userID, expected: true},
+		"other action":      {query: "?action=user.removed&target_id=" + userID, expected: false},
+		"time range":        {query: "?target_id=" + userID + "&from=2000-01-01T00:00:00Z", expected: true},
+		"before the change": {query: "?target_id=" + userID + "&to=2000-01-01T00:00:00Z", expected: false},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var entries auditEntriesResponse
+			resp := MakeAuthorizedRequest(t, "GET", baseURL+"/api/v1/audit"+tc.query, adminToken, nil)
+			DecodeResponse(t, resp, http.StatusOK, &entries)
+
+			if !tc.expected {
+				if len(entries.Entries) != 0 {
+					t.Errorf("expected no entry, got %+v", entries.Entries)
+				}
+				return
+			}
+			if len(entries.Entries) != 1 {
+				t.Fatalf("expected the creation of the user to be logged once, got %+v", entries.Entries)
+			}
+			entry := entries.Entries[0]
+			if entry.Action != "user.created" || entry.ActorUserID == nil || entry.ActorRole != "admin" {
+				t.Errorf("expected the admin to have created the user, got %+v", entry)
+			}
+			if entry.RequestID == "" || entry.IPAddress == "" {
+				t.Errorf("expected the request metadata to be logged, got %+v", entry)
+			}
+		})
+	}
+
+	resp = MakeAuthorizedRequest(t, "GET", baseURL+"/api/v1/audit?format=csv&target_id="+userID, adminToken, nil)
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/csv") {
+		t.Fatalf("expected a CSV export, got %d %q", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	rows, err := csv.NewReader(resp.Body).ReadAll()
+	if err != nil {
+		t.Fatalf("failed to read the CSV export: %v", err)
+	}
+	if len(rows) != 2 || rows[0][0] != "id" || rows[1][4] != "user.created" {
+		t.Errorf("expected the header and the creation of the user, got %v", rows)
+	}
+
+	// The entries can't be altered, not even by connecting to the database directly
+	for _, statement := range []string{
+		`UPDATE "audit"."entries" SET action = 'user.removed' WHERE target_id = $1`,
+		`DELETE FROM "audit"."entries" WHERE target_id = $1`,
+	} {
+		if _, err := db.Exec(statement, userID); err == nil || !strings.Contains(err.Error(), "append-only") {
+			t.Errorf("expected %q to be rejected, got %v", statement, err)
+		}
+	}
+	if _, err := db.Exec(`TRUNCATE "audit"."entries"`); err == nil || !strings.Contains(err.Error(), "append-only") {
+		t.Errorf("expected the entries not to be truncated, got %v", err)
+	}
+}
+
+func TestAuditLog_AdminOnly(t *testing.T) {
+	baseURL, cleanup := StartTestServer(t)
+	defer cleanup()
+
+	userToken := LoginUser(t, baseURL, "testuser", "user12345")
+	resp := MakeAuthorizedRequest(t, "GET", baseURL+"/api/v1/audit", userToken, nil)
+	DecodeResponse(t, resp, http.StatusForbidden, nil)
+}
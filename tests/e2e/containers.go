@@ -119,18 +119,31 @@ func SetupContainers(ctx context.Context) (*TestContainers, error) {
 	}, nil
 }
 
+// migratedModules lists the modules whose migrations the tested endpoints depend on.
+var migratedModules = []string{"user", "record", "webhook", "event", "audit"}
+
 // runMigrations runs database migrations using the migrate container.
 func runMigrations(ctx context.Context, net *testcontainers.DockerNetwork) error {
+	for _, module := range migratedModules {
+		if err := runModuleMigrations(ctx, net, module); err != nil {
+			return fmt.Errorf("failed to migrate %s module: %w", module, err)
+		}
+	}
+	return nil
+}
+
+// runModuleMigrations runs the migrations of a module, tracked in its own table like in docker-compose.
+func runModuleMigrations(ctx context.Context, net *testcontainers.DockerNetwork, module string) error {
 	// Get the project root directory
 	_, currentFile, _, _ := runtime.Caller(0)
 	projectRoot := filepath.Join(filepath.Dir(currentFile), "..", "..")
-	migrationsPath := filepath.Join(projectRoot, "internal", "user", "infrastructure", "migrations")
+	migrationsPath := filepath.Join(projectRoot, "internal", module, "infrastructure", "migrations")
 
 	// Use container name for internal network communication
 	// PostgreSQL container is named "postgres" on the network
 	dbURL := fmt.Sprintf(
-		"postgres://%s:%s@postgres:5432/%s?sslmode=disable",
-		TestDBUser, TestDBPassword, TestDBName,
+		"postgres://%s:%s@postgres:5432/%s?sslmode=disable&x-migrations-table=schema_migrations_%s",
+		TestDBUser, TestDBPassword, TestDBName, module,
 	)
 
 	migrateReq := testcontainers.GenericContainerRequest{
@@ -221,9 +234,10 @@ func (tc *TestContainers) GetDatabaseConfig() map[string]string {
 // GetRedisConfig returns environment variables for the test Redis.
 func (tc *TestContainers) GetRedisConfig() map[string]string {
 	return map[string]string{
-		"REDIS_ADDRESS":  tc.RedisHost,
-		"REDIS_PORT":     tc.RedisPort,
-		"REDIS_DB_AUTH":  "0",
-		"REDIS_PASSWORD": "",
+		"REDIS_ADDRESS":   tc.RedisHost,
+		"REDIS_PORT":      tc.RedisPort,
+		"REDIS_DB_AUTH":   "0",
+		"REDIS_DB_EVENTS": "1",
+		"REDIS_PASSWORD":  "",
 	}
 }
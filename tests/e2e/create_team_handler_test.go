@@ -0,0 +1,101 @@
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestCreateTeam_Success(t *testing.T) {
+	baseURL, cleanup := StartTestServer(t)
+	defer cleanup()
+
+	// Login as admin to manage teams
+	adminToken := LoginUser(t, baseURL, "admin", "admin123")
+
+	reqBody := map[string]string{
+		"name": "red-team",
+	}
+
+	createResp := MakeAuthorizedRequest(t, "POST", fmt.Sprintf("%s/api/v1/teams/", baseURL), adminToken, reqBody)
+	defer createResp.Body.Close()
+
+	createBody, err := io.ReadAll(createResp.Body)
+	if err != nil {
+		t.Fatalf("failed to read response body: %v", err)
+	}
+
+	// Assert
+	if createResp.StatusCode != http.StatusCreated {
+		t.Fatalf(
+			"expected status %d, got %d. Response: %s",
+			http.StatusCreated,
+			createResp.StatusCode,
+			string(createBody),
+		)
+	}
+
+	// The new team must be listed
+	listResp := MakeAuthorizedRequest(t, "GET", fmt.Sprintf("%s/api/v1/teams/", baseURL), adminToken, nil)
+	defer listResp.Body.Close()
+
+	listBody, err := io.ReadAll(listResp.Body)
+	if err != nil {
+		t.Fatalf("failed to read response body: %v", err)
+	}
+
+	if listResp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status %d, got %d. Response: %s", http.StatusOK, listResp.StatusCode, string(listBody))
+	}
+
+	var teams []map[string]interface{}
+	if err := json.Unmarshal(listBody, &teams); err != nil {
+		t.Fatalf("failed to unmarshal teams response: %v", err)
+	}
+
+	found := false
+	for _, team := range teams {
+		if team["name"] == "red-team" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected team %q to be listed, got %s", "red-team", string(listBody))
+	}
+}
+
+func TestCreateTeam_Duplicate(t *testing.T) {
+	baseURL, cleanup := StartTestServer(t)
+	defer cleanup()
+
+	// Login as admin
+	adminToken := LoginUser(t, baseURL, "admin", "admin123")
+
+	reqBody := map[string]string{
+		"name": "blue-team",
+	}
+
+	firstResp := MakeAuthorizedRequest(t, "POST", fmt.Sprintf("%s/api/v1/teams/", baseURL), adminToken, reqBody)
+	defer firstResp.Body.Close()
+
+	if firstResp.StatusCode != http.StatusCreated {
+		respBody, _ := io.ReadAll(firstResp.Body)
+		t.Fatalf("failed to create team: status=%d, body=%s", firstResp.StatusCode, string(respBody))
+	}
+
+	// The same name can't be used twice
+	secondResp := MakeAuthorizedRequest(t, "POST", fmt.Sprintf("%s/api/v1/teams/", baseURL), adminToken, reqBody)
+	defer secondResp.Body.Close()
+
+	respBody, err := io.ReadAll(secondResp.Body)
+	if err != nil {
+		t.Fatalf("failed to read response body: %v", err)
+	}
+
+	// Assert
+	if secondResp.StatusCode != http.StatusConflict {
+		t.Errorf("expected status %d, got %d. Response: %s", http.StatusConflict, secondResp.StatusCode, string(respBody))
+	}
+}
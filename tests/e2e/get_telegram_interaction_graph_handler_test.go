@@ -0,0 +1,116 @@
+package e2e
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type interactionGraphResponse struct {
+	Graph struct {
+		Nodes map[string]struct {
+			Metadata struct {
+				TelegramID uint64 `json:"telegram_id"`
+				Depth      int    `json:"depth"`
+			} `json:"metadata"`
+		} `json:"nodes"`
+		Edges []struct {
+			Source   string `json:"source"`
+			Target   string `json:"target"`
+			Relation string `json:"relation"`
+			Metadata struct {
+				Weight int `json:"weight"`
+			} `json:"metadata"`
+		} `json:"edges"`
+	} `json:"graph"`
+}
+
+func TestGetTelegramInteractionGraph_PerRecordInteractions(t *testing.T) {
+	baseURL, cleanup := StartTestServer(t)
+	defer cleanup()
+
+	adminToken := LoginUser(t, baseURL, "admin", "admin123")
+
+	aliceTelegramID := uint64(time.Now().UnixNano())
+	bobTelegramID := aliceTelegramID + 1
+	alice := AddTelegramUser(t, baseURL, adminToken, aliceTelegramID)
+	bob := AddTelegramUser(t, baseURL, adminToken, bobTelegramID)
+	bobUsername := fmt.Sprintf("bob%d", bobTelegramID%1_000_000_000)
+	AddTelegramIdentity(t, baseURL, adminToken, bob, bobUsername, "+15550001111")
+
+	chatID := int64(aliceTelegramID % 1_000_000_000)
+	question := AddTelegramRecord(t, baseURL, adminToken, alice, chatID, 1, "question", nil)
+	replies := []string{
+		AddTelegramRecord(t, baseURL, adminToken, bob, chatID, 2, "answer", map[string]interface{}{
+			"reply_to_message_telegram_id": 1,
+		}),
+		AddTelegramRecord(t, baseURL, adminToken, bob, chatID, 3, "another answer", map[string]interface{}{
+			"reply_to_message_telegram_id": 1,
+		}),
+	}
+	AddTelegramRecord(t, baseURL, adminToken, alice, chatID, 4, "thanks @"+bobUsername, nil)
+
+	// Every reply is kept as an interaction of its own record, related to the record replied to
+	db, err := openTestDatabase(testContainers)
+	if err != nil {
+		t.Fatalf("failed to open the test database: %v", err)
+	}
+	defer db.Close()
+	rows, err := db.Query(`SELECT record_id, related_record_id FROM "records"."telegram_user_interactions"
+		WHERE from_user_telegram_id = $1 AND to_user_telegram_id = $2 AND interaction_type = 'reply'
+		ORDER BY first_interaction_at`, bobTelegramID, aliceTelegramID)
+	if err != nil {
+		t.Fatalf("failed to query the interactions: %v", err)
+	}
+	defer rows.Close()
+	var replyInteractions [][2]string
+	for rows.Next() {
+		var interaction [2]string
+		if err := rows.Scan(&interaction[0], &interaction[1]); err != nil {
+			t.Fatalf("failed to scan an interaction: %v", err)
+		}
+		replyInteractions = append(replyInteractions, interaction)
+	}
+	if len(replyInteractions) != len(replies) {
+		t.Fatalf("expected an interaction per reply, got %v", replyInteractions)
+	}
+	for i, interaction := range replyInteractions {
+		if interaction[0] != replies[i] || interaction[1] != question {
+			t.Errorf("expected reply %s to %s, got %v", replies[i], question, interaction)
+		}
+	}
+
+	// The graph sums the interactions of the records up per user pair
+	var graph interactionGraphResponse
+	resp := MakeAuthorizedRequest(
+		t,
+		"GET",
+		fmt.Sprintf("%s/api/v1/record/telegram/%d/graph?depth=1", baseURL, aliceTelegramID),
+		adminToken,
+		nil,
+	)
+	DecodeResponse(t, resp, http.StatusOK, &graph)
+	aliceNode, bobNode := fmt.Sprintf("u%d", aliceTelegramID), fmt.Sprintf("u%d", bobTelegramID)
+	if len(graph.Graph.Nodes) != 2 || graph.Graph.Nodes[bobNode].Metadata.Depth != 1 {
+		t.Fatalf("expected alice and bob in the graph, got %+v", graph.Graph.Nodes)
+	}
+	weights := make(map[string]int)
+	for _, edge := range graph.Graph.Edges {
+		weights[edge.Source+" "+edge.Relation+" "+edge.Target] = edge.Metadata.Weight
+	}
+	expectedWeights := map[string]int{
+		bobNode + " reply " + aliceNode:       2,
+		aliceNode + " mention " + bobNode:     1,
+		aliceNode + " shared_chat " + bobNode: 1,
+		bobNode + " shared_chat " + aliceNode: 1,
+	}
+	for edge, weight := range expectedWeights {
+		if weights[edge] != weight {
+			t.Errorf("expected %s to weigh %d, got %d", edge, weight, weights[edge])
+		}
+	}
+	if len(weights) != len(expectedWeights) {
+		t.Errorf("expected %d edges, got %v", len(expectedWeights), weights)
+	}
+}
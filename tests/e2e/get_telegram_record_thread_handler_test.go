@@ -0,0 +1,118 @@
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type recordThreadResponse struct {
+	RecordID string `json:"record_id"`
+	Records  []struct {
+		RecordID                 string  `json:"record_id"`
+		MessageTelegramID        uint64  `json:"message_telegram_id"`
+		ReplyToMessageTelegramID *uint64 `json:"reply_to_message_telegram_id"`
+	} `json:"records"`
+}
+
+func getRecordThread(t *testing.T, baseURL, token, recordID string) recordThreadResponse {
+	t.Helper()
+
+	resp := MakeAuthorizedRequest(
+		t,
+		"GET",
+		fmt.Sprintf("%s/api/v1/record/telegram/record/%s/thread", baseURL, recordID),
+		token,
+		nil,
+	)
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("failed to get the thread: status=%d, body=%s", resp.StatusCode, string(respBody))
+	}
+	var thread recordThreadResponse
+	if err := json.Unmarshal(respBody, &thread); err != nil {
+		t.Fatalf("failed to unmarshal thread response: %v", err)
+	}
+	return thread
+}
+
+func TestGetTelegramRecordThread(t *testing.T) {
+	baseURL, cleanup := StartTestServer(t)
+	defer cleanup()
+
+	adminToken := LoginUser(t, baseURL, "admin", "admin123")
+
+	telegramID := uint64(time.Now().UnixNano())
+	userResp := MakeAuthorizedRequest(
+		t,
+		"POST",
+		fmt.Sprintf("%s/api/v1/record/telegram/user", baseURL),
+		adminToken,
+		map[string]uint64{"telegram_id": telegramID},
+	)
+	defer userResp.Body.Close()
+	var user map[string]string
+	userBody, _ := io.ReadAll(userResp.Body)
+	if userResp.StatusCode != http.StatusCreated {
+		t.Fatalf("failed to add telegram user: status=%d, body=%s", userResp.StatusCode, string(userBody))
+	}
+	if err := json.Unmarshal(userBody, &user); err != nil {
+		t.Fatalf("failed to unmarshal user response: %v", err)
+	}
+
+	chatID := int64(telegramID % 1_000_000_000)
+	record := func(chatID int64, messageID uint64, replyTo uint64, minute int) map[string]interface{} {
+		record := map[string]interface{}{
+			"message_telegram_id":   messageID,
+			"from_user_telegram_id": user["record_id"],
+			"in_telegram_chat_id":   chatID,
+			"message_text":          fmt.Sprintf("message %d", messageID),
+			"posted_at":             fmt.Sprintf("2024-01-15T10:%02d:00Z", minute),
+		}
+		if replyTo != 0 {
+			record["reply_to_message_telegram_id"] = replyTo
+		}
+		return record
+	}
+
+	// 1 <- 2 <- 3 and 1 <- 4, the same message IDs in another chat aren't a part of the thread
+	batch := postRecordsBatch(t, baseURL, adminToken, []map[string]interface{}{
+		record(chatID, 1, 0, 0), record(chatID, 2, 1, 1), record(chatID, 3, 2, 2), record(chatID, 4, 1, 3),
+		record(chatID+1, 5, 1, 4), record(chatID+1, 1, 0, 5),
+	})
+	if batch.Created != 6 {
+		t.Fatalf("expected 6 created records, got %+v", batch)
+	}
+
+	thread := getRecordThread(t, baseURL, adminToken, batch.Results[2].RecordID)
+	if thread.RecordID != batch.Results[2].RecordID {
+		t.Errorf("expected the thread of %s, got %s", batch.Results[2].RecordID, thread.RecordID)
+	}
+	expectedMessages := []uint64{1, 2, 3, 4}
+	if len(thread.Records) != len(expectedMessages) {
+		t.Fatalf("expected %d records in the thread, got %+v", len(expectedMessages), thread.Records)
+	}
+	for i, record := range thread.Records {
+		if record.MessageTelegramID != expectedMessages[i] || record.RecordID != batch.Results[i].RecordID {
+			t.Errorf("expected message %d at %d from the root, got %+v", expectedMessages[i], i, record)
+		}
+	}
+
+	// Messages replying to each other in a cycle don't make the walk run forever
+	cycleChatID := chatID + 2
+	batch = postRecordsBatch(t, baseURL, adminToken, []map[string]interface{}{
+		record(cycleChatID, 10, 12, 0), record(cycleChatID, 11, 10, 1), record(cycleChatID, 12, 11, 2),
+	})
+	if batch.Created != 3 {
+		t.Fatalf("expected 3 created records, got %+v", batch)
+	}
+	thread = getRecordThread(t, baseURL, adminToken, batch.Results[1].RecordID)
+	if len(thread.Records) != 3 {
+		t.Errorf("expected every message of the cycle once, got %+v", thread.Records)
+	}
+}
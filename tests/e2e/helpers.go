@@ -14,10 +14,13 @@ import (
 	"github.com/InWamos/trinity-proto/logger"
 	"github.com/InWamos/trinity-proto/middleware"
 	"github.com/InWamos/trinity-proto/setup"
+	"github.com/InWamos/trinity-proto/setup/audit"
 	"github.com/InWamos/trinity-proto/setup/auth"
+	"github.com/InWamos/trinity-proto/setup/event"
 	"github.com/InWamos/trinity-proto/setup/record"
 	"github.com/InWamos/trinity-proto/setup/shared"
 	"github.com/InWamos/trinity-proto/setup/user"
+	"github.com/InWamos/trinity-proto/setup/webhook"
 	"go.uber.org/fx"
 	"go.uber.org/fx/fxevent"
 	"go.uber.org/fx/fxtest"
@@ -78,17 +81,22 @@ func StartTestServer(t *testing.T) (baseURL string, cleanup func()) {
 	t.Helper()
 
 	app := fxtest.New(t,
-		fx.Provide(config.NewDatabaseConfig, config.NewLoggingConfig, config.NewServerConfig, config.NewRedisConfig),
+		fx.Provide(config.NewDatabaseConfig, config.NewLoggingConfig, config.NewServerConfig, config.NewRedisConfig, config.NewStorageConfig, config.NewIngestConfig, config.NewBotConfig, config.NewWebhookConfig, config.NewEventConfig, config.NewChainConfig, config.NewExportConfig, config.NewVisibilityConfig),
 		fx.Provide(logger.GetLogger),
 		fx.Provide(
 			middleware.NewGlobalCORSMiddleware,
 			middleware.NewTrustedProxyMiddleware,
+			middleware.NewRequestMetadataMiddleware,
 			middleware.NewLoggingMiddleware,
 			middleware.NewAuthenticationMiddleware,
+			middleware.NewBotAuthenticationMiddleware,
 		),
 		user.NewUserModuleContainer(),
 		auth.NewAuthModuleContainer(),
 		record.NewRecordModuleContainer(),
+		webhook.NewWebhookModuleContainer(),
+		event.NewEventModuleContainer(),
+		audit.NewAuditModuleContainer(),
 		shared.NewSharedModuleContainer(),
 		fx.Provide(setup.NewMainHTTPServer),
 		fx.Provide(setup.NewProfilerHTTPServer),
@@ -205,3 +213,145 @@ func MakeAuthorizedRequest(t *testing.T, method, url, token string, body interfa
 
 	return resp
 }
+
+// DecodeResponse checks the status of the response and decodes its JSON body into v, when v isn't nil
+func DecodeResponse(t *testing.T, resp *http.Response, expectedStatus int, v interface{}) {
+	t.Helper()
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("failed to read response body: %v", err)
+	}
+
+	if resp.StatusCode != expectedStatus {
+		t.Fatalf(
+			"%s %s: expected status %d, got %d. Response: %s",
+			resp.Request.Method,
+			resp.Request.URL.Path,
+			expectedStatus,
+			resp.StatusCode,
+			string(respBody),
+		)
+	}
+
+	if v == nil {
+		return
+	}
+	if err := json.Unmarshal(respBody, v); err != nil {
+		t.Fatalf("failed to unmarshal response: %v. Response: %s", err, string(respBody))
+	}
+}
+
+// CreatePlatformUser creates a user with the admin token and returns its ID, teamID may be empty
+func CreatePlatformUser(t *testing.T, baseURL, adminToken, username, password, role, teamID string) string {
+	t.Helper()
+
+	reqBody := map[string]string{
+		"username":     username,
+		"display_name": username,
+		"password":     password,
+		"user_role":    role,
+		"team_id":      teamID,
+	}
+	var created struct {
+		ID string `json:"id"`
+	}
+	resp := MakeAuthorizedRequest(t, "POST", baseURL+"/api/v1/users/", adminToken, reqBody)
+	DecodeResponse(t, resp, http.StatusCreated, &created)
+	return created.ID
+}
+
+// CreateTeam creates a team with the admin token and returns its ID
+func CreateTeam(t *testing.T, baseURL, adminToken, name string) string {
+	t.Helper()
+
+	var created struct {
+		ID string `json:"id"`
+	}
+	resp := MakeAuthorizedRequest(t, "POST", baseURL+"/api/v1/teams/", adminToken, map[string]string{"name": name})
+	DecodeResponse(t, resp, http.StatusCreated, &created)
+	return created.ID
+}
+
+// ArchivingTeam is a team archiving telegram data, along with its collector and its admin
+type ArchivingTeam struct {
+	collectorID    string
+	collectorToken string
+	adminToken     string
+}
+
+// CreateArchivingTeam creates a team with a collector and an admin, both named after the team
+func CreateArchivingTeam(t *testing.T, baseURL, adminToken, name string) ArchivingTeam {
+	t.Helper()
+
+	teamID := CreateTeam(t, baseURL, adminToken, name)
+	collectorID := CreatePlatformUser(t, baseURL, adminToken, name+"collector", "password123", "user", teamID)
+	CreatePlatformUser(t, baseURL, adminToken, name+"admin", "password123", "admin", teamID)
+	return ArchivingTeam{
+		collectorID:    collectorID,
+		collectorToken: LoginUser(t, baseURL, name+"collector", "password123"),
+		adminToken:     LoginUser(t, baseURL, name+"admin", "password123"),
+	}
+}
+
+// AddTelegramUser archives a telegram user and returns its ID
+func AddTelegramUser(t *testing.T, baseURL, token string, telegramID uint64) string {
+	t.Helper()
+
+	var added struct {
+		RecordID string `json:"record_id"`
+	}
+	resp := MakeAuthorizedRequest(
+		t, "POST", baseURL+"/api/v1/record/telegram/user", token, map[string]uint64{"telegram_id": telegramID},
+	)
+	DecodeResponse(t, resp, http.StatusCreated, &added)
+	return added.RecordID
+}
+
+// AddTelegramIdentity archives an identity of the telegram user and returns its ID
+func AddTelegramIdentity(t *testing.T, baseURL, token, userID, username, phoneNumber string) string {
+	t.Helper()
+
+	reqBody := map[string]string{
+		"telegram_id":           userID,
+		"telegram_username":     username,
+		"telegram_first_name":   username,
+		"telegram_phone_number": phoneNumber,
+	}
+	var added struct {
+		RecordID string `json:"record_id"`
+	}
+	resp := MakeAuthorizedRequest(t, "POST", baseURL+"/api/v1/record/telegram/identity", token, reqBody)
+	DecodeResponse(t, resp, http.StatusCreated, &added)
+	return added.RecordID
+}
+
+// AddTelegramRecord archives a message, with the optional fields of the request in extra, and returns its ID
+func AddTelegramRecord(
+	t *testing.T,
+	baseURL, token, fromUserID string,
+	chatID int64,
+	messageID uint64,
+	text string,
+	extra map[string]interface{},
+) string {
+	t.Helper()
+
+	reqBody := map[string]interface{}{
+		"message_telegram_id":   messageID,
+		"from_user_telegram_id": fromUserID,
+		"in_telegram_chat_id":   chatID,
+		"message_text":          text,
+		"posted_at":             time.Date(2024, 1, 15, 10, 30, int(messageID%60), 0, time.UTC),
+	}
+	for key, value := range extra {
+		reqBody[key] = value
+	}
+	var added struct {
+		RecordID string `json:"record_id"`
+	}
+	resp := MakeAuthorizedRequest(t, "POST", baseURL+"/api/v1/record/telegram/record", token, reqBody)
+	DecodeResponse(t, resp, http.StatusCreated, &added)
+	return added.RecordID
+}
@@ -76,8 +76,8 @@ func TestMain(m *testing.M) {
 	os.Exit(code)
 }
 
-// initializeTestUsers creates default test users (admin and regular user) in the database
-func initializeTestUsers(tc *TestContainers) error {
+// openTestDatabase connects to the test database directly, bypassing the API
+func openTestDatabase(tc *TestContainers) (*sql.DB, error) {
 	config := tc.GetDatabaseConfig()
 	connStr := fmt.Sprintf(
 		"postgres://%s:%s@%s:%s/%s?sslmode=disable",
@@ -90,13 +90,23 @@ func initializeTestUsers(tc *TestContainers) error {
 
 	db, err := sql.Open("postgres", connStr)
 	if err != nil {
-		return fmt.Errorf("failed to open database: %w", err)
+		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
-	defer db.Close()
 
 	if err := db.Ping(); err != nil {
-		return fmt.Errorf("failed to ping database: %w", err)
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+	return db, nil
+}
+
+// initializeTestUsers creates default test users (admin and regular user) in the database
+func initializeTestUsers(tc *TestContainers) error {
+	db, err := openTestDatabase(tc)
+	if err != nil {
+		return err
 	}
+	defer db.Close()
 
 	// Hash passwords
 	adminHash, err := bcrypt.GenerateFromPassword([]byte("admin123"), bcrypt.DefaultCost)
@@ -0,0 +1,104 @@
+package e2e
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type chainVerification struct {
+	Intact              bool                     `json:"intact"`
+	ChainsVerified      int                      `json:"chains_verified"`
+	EntriesVerified     int                      `json:"entries_verified"`
+	CheckpointsVerified int                      `json:"checkpoints_verified"`
+	SignaturesVerified  bool                     `json:"signatures_verified"`
+	Breaks              []map[string]interface{} `json:"breaks"`
+}
+
+// archiveChainedRecords has the collector of the team archive an identity and messages of the same telegram user,
+// it returns the amount of chain entries they append.
+func archiveChainedRecords(t *testing.T, baseURL string, team ArchivingTeam, telegramID uint64, messages int) int {
+	t.Helper()
+
+	userID := AddTelegramUser(t, baseURL, team.collectorToken, telegramID)
+	phoneNumber := fmt.Sprintf("+1666%07d", telegramID%10000000)
+	AddTelegramIdentity(t, baseURL, team.collectorToken, userID, fmt.Sprintf("chained%d", telegramID), phoneNumber)
+	for i := 1; i <= messages; i++ {
+		AddTelegramRecord(
+			t, baseURL, team.collectorToken, userID, -int64(telegramID), uint64(i), fmt.Sprintf("Message %d", i), nil,
+		)
+	}
+	return messages + 1
+}
+
+// awaitChainCheckpoint verifies the chains the admin sees until the checkpointer has signed them
+func awaitChainCheckpoint(t *testing.T, baseURL, adminToken string) chainVerification {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		var verification chainVerification
+		resp := MakeAuthorizedRequest(t, "GET", baseURL+"/api/v1/record/telegram/chain/verification", adminToken, nil)
+		DecodeResponse(t, resp, http.StatusOK, &verification)
+		if verification.CheckpointsVerified > 0 {
+			return verification
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the chains haven't been checkpointed")
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+}
+
+func TestTelegramChain_ChainPerTeam(t *testing.T) {
+	seed := make([]byte, 32)
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+	t.Setenv("CHAIN_SIGNING_KEY", base64.StdEncoding.EncodeToString(seed))
+	t.Setenv("CHAIN_CHECKPOINT_INTERVAL", "100ms")
+
+	baseURL, cleanup := StartTestServer(t)
+	defer cleanup()
+
+	adminToken := LoginUser(t, baseURL, "admin", "admin123")
+	suffix := time.Now().UnixNano() % 1000000
+	red := CreateArchivingTeam(t, baseURL, adminToken, fmt.Sprintf("red%d", suffix))
+	blue := CreateArchivingTeam(t, baseURL, adminToken, fmt.Sprintf("blue%d", suffix))
+
+	// Both teams archive the same telegram user, each one within its own tenant
+	telegramID := uint64(800000000 + suffix)
+	redEntries := archiveChainedRecords(t, baseURL, red, telegramID, 2)
+	blueEntries := archiveChainedRecords(t, baseURL, blue, telegramID, 3)
+
+	for _, tc := range []struct {
+		name    string
+		team    ArchivingTeam
+		entries int
+	}{
+		{"red", red, redEntries},
+		{"blue", blue, blueEntries},
+	} {
+		verification := awaitChainCheckpoint(t, baseURL, tc.team.adminToken)
+		if !verification.Intact {
+			t.Errorf("expected the chain of the %s team to be intact, got breaks %v", tc.name, verification.Breaks)
+		}
+		if !verification.SignaturesVerified {
+			t.Errorf("expected the checkpoints of the %s team to be verified", tc.name)
+		}
+		if verification.ChainsVerified != 1 {
+			t.Errorf("expected the %s team to see 1 chain, got %d", tc.name, verification.ChainsVerified)
+		}
+		if verification.EntriesVerified != tc.entries {
+			t.Errorf("expected %d entries in the %s chain, got %d", tc.entries, tc.name, verification.EntriesVerified)
+		}
+	}
+
+	// The chain of a collector of another team isn't visible
+	resp := MakeAuthorizedRequest(
+		t, "GET", baseURL+"/api/v1/record/telegram/chain/verification?collector="+blue.collectorID, red.adminToken, nil,
+	)
+	DecodeResponse(t, resp, http.StatusNotFound, nil)
+}
@@ -0,0 +1,352 @@
+package e2e
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// privateArchive is the telegram data archived by a collector whose observations are private
+type privateArchive struct {
+	collectorToken string
+	readerToken    string
+	readerID       string
+	recordID       string
+	replyID        string
+	// The servers of the tests share the database, so the archived ids are unique to the test
+	senderTelegramID uint64
+	chatTelegramID   int64
+	tag              string
+}
+
+// archivePrivateRecords has testuser archive two users sharing a phone number, a message of the first one
+// with a reply and a forward of the second one, and a reader without any observation of them.
+func archivePrivateRecords(t *testing.T, baseURL string) privateArchive {
+	t.Helper()
+
+	suffix := uint64(time.Now().UnixNano() % 1000000)
+	adminToken := LoginUser(t, baseURL, "admin", "admin123")
+	readerName := fmt.Sprintf("reader%d", suffix)
+	readerID := CreatePlatformUser(t, baseURL, adminToken, readerName, "password123", "user", "")
+
+	archive := privateArchive{
+		collectorToken:   LoginUser(t, baseURL, "testuser", "user12345"),
+		readerToken:      LoginUser(t, baseURL, readerName, "password123"),
+		readerID:         readerID,
+		senderTelegramID: 700000000 + suffix*2,
+		chatTelegramID:   -1007000000000 - int64(suffix),
+		tag:              fmt.Sprintf("private%d", suffix),
+	}
+	phoneNumber := fmt.Sprintf("+1555%07d", suffix)
+
+	senderID := AddTelegramUser(t, baseURL, archive.collectorToken, archive.senderTelegramID)
+	replierID := AddTelegramUser(t, baseURL, archive.collectorToken, archive.senderTelegramID+1)
+	AddTelegramIdentity(t, baseURL, archive.collectorToken, senderID, readerName+"_sender", phoneNumber)
+	AddTelegramIdentity(t, baseURL, archive.collectorToken, replierID, readerName+"_replier", phoneNumber)
+
+	archive.recordID = AddTelegramRecord(
+		t, baseURL, archive.collectorToken, senderID, archive.chatTelegramID, 1, "Private message", nil,
+	)
+	archive.replyID = AddTelegramRecord(
+		t, baseURL, archive.collectorToken, replierID, archive.chatTelegramID, 2, "Private reply",
+		map[string]interface{}{"reply_to_message_telegram_id": 1},
+	)
+	forwardOrigin := map[string]interface{}{"from_user_telegram_id": archive.senderTelegramID}
+	AddTelegramRecord(
+		t, baseURL, archive.collectorToken, replierID, archive.chatTelegramID, 3, "Private forward",
+		map[string]interface{}{"forward_origin": forwardOrigin},
+	)
+
+	resp := MakeAuthorizedRequest(
+		t,
+		"POST",
+		fmt.Sprintf("%s/api/v1/annotations/telegram_record/%s/tags", baseURL, archive.recordID),
+		archive.collectorToken,
+		map[string]string{"name": archive.tag},
+	)
+	DecodeResponse(t, resp, http.StatusCreated, nil)
+	return archive
+}
+
+// startPrivateTestServer starts the server with the records private to their collector
+func startPrivateTestServer(t *testing.T) (string, func()) {
+	t.Helper()
+
+	t.Setenv("RECORD_VISIBILITY", "private")
+	t.Setenv("STORAGE_LOCAL_PATH", t.TempDir())
+	t.Setenv("EXPORT_POLL_INTERVAL", "100ms")
+	return StartTestServer(t)
+}
+
+func TestTelegramVisibility_RecordThreadAndHistory(t *testing.T) {
+	baseURL, cleanup := startPrivateTestServer(t)
+	defer cleanup()
+
+	archive := archivePrivateRecords(t, baseURL)
+
+	threadURL := fmt.Sprintf("%s/api/v1/record/telegram/record/%s/thread", baseURL, archive.replyID)
+	historyURL := fmt.Sprintf("%s/api/v1/record/telegram/record/%s/history", baseURL, archive.replyID)
+
+	var thread struct {
+		Records []map[string]interface{} `json:"records"`
+	}
+	resp := MakeAuthorizedRequest(t, "GET", threadURL, archive.collectorToken, nil)
+	DecodeResponse(t, resp, http.StatusOK, &thread)
+	if len(thread.Records) != 2 {
+		t.Errorf("expected the collector to see the message and its reply, got %d records", len(thread.Records))
+	}
+
+	var history struct {
+		Versions []map[string]interface{} `json:"versions"`
+	}
+	resp = MakeAuthorizedRequest(t, "GET", historyURL, archive.collectorToken, nil)
+	DecodeResponse(t, resp, http.StatusOK, &history)
+	if len(history.Versions) == 0 {
+		t.Error("expected the collector to see the versions of the reply")
+	}
+
+	// The private record doesn't exist for the reader
+	for _, url := range []string{threadURL, historyURL} {
+		resp = MakeAuthorizedRequest(t, "GET", url, archive.readerToken, nil)
+		DecodeResponse(t, resp, http.StatusNotFound, nil)
+	}
+}
+
+func TestTelegramVisibility_ForwardedFrom(t *testing.T) {
+	baseURL, cleanup := startPrivateTestServer(t)
+	defer cleanup()
+
+	archive := archivePrivateRecords(t, baseURL)
+	url := fmt.Sprintf("%s/api/v1/record/telegram/user/%d/forwards", baseURL, archive.senderTelegramID)
+
+	var forwards struct {
+		Records []map[string]interface{} `json:"records"`
+	}
+	resp := MakeAuthorizedRequest(t, "GET", url, archive.collectorToken, nil)
+	DecodeResponse(t, resp, http.StatusOK, &forwards)
+	if len(forwards.Records) != 1 {
+		t.Errorf("expected the collector to see 1 forward, got %d", len(forwards.Records))
+	}
+
+	resp = MakeAuthorizedRequest(t, "GET", url, archive.readerToken, nil)
+	DecodeResponse(t, resp, http.StatusOK, &forwards)
+	if len(forwards.Records) != 0 {
+		t.Errorf("expected the reader to see no forward, got %d", len(forwards.Records))
+	}
+}
+
+func TestTelegramVisibility_Activity(t *testing.T) {
+	baseURL, cleanup := startPrivateTestServer(t)
+	defer cleanup()
+
+	archive := archivePrivateRecords(t, baseURL)
+
+	for _, url := range []string{
+		fmt.Sprintf("%s/api/v1/record/telegram/user/%d/activity", baseURL, archive.senderTelegramID),
+		fmt.Sprintf("%s/api/v1/record/telegram/chat/%d/activity", baseURL, archive.chatTelegramID),
+	} {
+		var activity struct {
+			MessageCount int `json:"message_count"`
+		}
+		resp := MakeAuthorizedRequest(t, "GET", url, archive.collectorToken, nil)
+		DecodeResponse(t, resp, http.StatusOK, &activity)
+		if activity.MessageCount == 0 {
+			t.Errorf("expected the collector to see the messages of %s", url)
+		}
+
+		resp = MakeAuthorizedRequest(t, "GET", url, archive.readerToken, nil)
+		DecodeResponse(t, resp, http.StatusOK, &activity)
+		if activity.MessageCount != 0 {
+			t.Errorf("expected the reader to see no message of %s, got %d", url, activity.MessageCount)
+		}
+	}
+}
+
+func TestTelegramVisibility_InteractionGraph(t *testing.T) {
+	baseURL, cleanup := startPrivateTestServer(t)
+	defer cleanup()
+
+	archive := archivePrivateRecords(t, baseURL)
+	url := fmt.Sprintf("%s/api/v1/record/telegram/%d/graph", baseURL, archive.senderTelegramID)
+
+	var graph struct {
+		Graph struct {
+			Edges []map[string]interface{} `json:"edges"`
+		} `json:"graph"`
+	}
+	resp := MakeAuthorizedRequest(t, "GET", url, archive.collectorToken, nil)
+	DecodeResponse(t, resp, http.StatusOK, &graph)
+	if len(graph.Graph.Edges) == 0 {
+		t.Error("expected the collector to see the reply between the users")
+	}
+
+	resp = MakeAuthorizedRequest(t, "GET", url, archive.readerToken, nil)
+	DecodeResponse(t, resp, http.StatusOK, &graph)
+	if len(graph.Graph.Edges) != 0 {
+		t.Errorf("expected the reader to see no interaction, got %d", len(graph.Graph.Edges))
+	}
+}
+
+func TestTelegramVisibility_CorrelationClusters(t *testing.T) {
+	baseURL, cleanup := startPrivateTestServer(t)
+	defer cleanup()
+
+	archive := archivePrivateRecords(t, baseURL)
+	url := fmt.Sprintf(
+		"%s/api/v1/record/telegram/correlations?type=phone&telegram_id=%d", baseURL, archive.senderTelegramID,
+	)
+
+	var clusters struct {
+		Clusters []map[string]interface{} `json:"clusters"`
+	}
+	resp := MakeAuthorizedRequest(t, "GET", url, archive.collectorToken, nil)
+	DecodeResponse(t, resp, http.StatusOK, &clusters)
+	if len(clusters.Clusters) != 1 {
+		t.Errorf("expected the collector to see 1 cluster, got %d", len(clusters.Clusters))
+	}
+
+	resp = MakeAuthorizedRequest(t, "GET", url, archive.readerToken, nil)
+	DecodeResponse(t, resp, http.StatusOK, &clusters)
+	if len(clusters.Clusters) != 0 {
+		t.Errorf("expected the reader to see no cluster, got %d", len(clusters.Clusters))
+	}
+}
+
+func TestTelegramVisibility_Annotations(t *testing.T) {
+	baseURL, cleanup := startPrivateTestServer(t)
+	defer cleanup()
+
+	archive := archivePrivateRecords(t, baseURL)
+	annotationsURL := fmt.Sprintf("%s/api/v1/annotations/telegram_record/%s", baseURL, archive.recordID)
+	catalogueURL := fmt.Sprintf("%s/api/v1/annotations/tags?prefix=%s", baseURL, archive.tag)
+
+	var annotations struct {
+		Tags []map[string]interface{} `json:"tags"`
+	}
+	resp := MakeAuthorizedRequest(t, "GET", annotationsURL, archive.collectorToken, nil)
+	DecodeResponse(t, resp, http.StatusOK, &annotations)
+	if len(annotations.Tags) != 1 {
+		t.Errorf("expected the collector to see 1 tag, got %d", len(annotations.Tags))
+	}
+
+	resp = MakeAuthorizedRequest(t, "GET", annotationsURL, archive.readerToken, nil)
+	DecodeResponse(t, resp, http.StatusOK, &annotations)
+	if len(annotations.Tags) != 0 {
+		t.Errorf("expected the reader to see no tag, got %d", len(annotations.Tags))
+	}
+
+	// The tag of the private record isn't counted in the catalogue of the reader either
+	var catalogue struct {
+		Tags []map[string]interface{} `json:"tags"`
+	}
+	resp = MakeAuthorizedRequest(t, "GET", catalogueURL, archive.collectorToken, nil)
+	DecodeResponse(t, resp, http.StatusOK, &catalogue)
+	if len(catalogue.Tags) != 1 {
+		t.Errorf("expected the collector to see 1 tag in the catalogue, got %d", len(catalogue.Tags))
+	}
+
+	resp = MakeAuthorizedRequest(t, "GET", catalogueURL, archive.readerToken, nil)
+	DecodeResponse(t, resp, http.StatusOK, &catalogue)
+	if len(catalogue.Tags) != 0 {
+		t.Errorf("expected the reader to see no tag in the catalogue, got %d", len(catalogue.Tags))
+	}
+}
+
+func TestTelegramVisibility_InvestigationItems(t *testing.T) {
+	baseURL, cleanup := startPrivateTestServer(t)
+	defer cleanup()
+
+	archive := archivePrivateRecords(t, baseURL)
+
+	// The collector shares an investigation holding the private record with the reader
+	var investigation struct {
+		InvestigationID string `json:"investigation_id"`
+	}
+	resp := MakeAuthorizedRequest(
+		t, "POST", baseURL+"/api/v1/investigations/", archive.collectorToken,
+		map[string]string{"name": "Private records", "description": "Holds a private record"},
+	)
+	DecodeResponse(t, resp, http.StatusCreated, &investigation)
+	investigationURL := fmt.Sprintf("%s/api/v1/investigations/%s", baseURL, investigation.InvestigationID)
+
+	resp = MakeAuthorizedRequest(
+		t, "POST", investigationURL+"/items", archive.collectorToken,
+		map[string]string{"type": "telegram_record", "target_id": archive.recordID},
+	)
+	DecodeResponse(t, resp, http.StatusCreated, nil)
+
+	resp = MakeAuthorizedRequest(
+		t, "PUT", investigationURL+"/members/"+archive.readerID, archive.collectorToken,
+		map[string]string{"role": "viewer"},
+	)
+	DecodeResponse(t, resp, http.StatusCreated, nil)
+
+	var details struct {
+		Items []map[string]interface{} `json:"items"`
+	}
+	resp = MakeAuthorizedRequest(t, "GET", investigationURL, archive.collectorToken, nil)
+	DecodeResponse(t, resp, http.StatusOK, &details)
+	if len(details.Items) != 1 {
+		t.Errorf("expected the collector to see 1 item, got %d", len(details.Items))
+	}
+
+	resp = MakeAuthorizedRequest(t, "GET", investigationURL, archive.readerToken, nil)
+	DecodeResponse(t, resp, http.StatusOK, &details)
+	if len(details.Items) != 0 {
+		t.Errorf("expected the reader to see no item, got %d", len(details.Items))
+	}
+}
+
+func TestTelegramVisibility_Exports(t *testing.T) {
+	baseURL, cleanup := startPrivateTestServer(t)
+	defer cleanup()
+
+	archive := archivePrivateRecords(t, baseURL)
+
+	collectorTotal := awaitTelegramExportTotal(t, baseURL, archive.collectorToken, archive.senderTelegramID)
+	if collectorTotal == 0 {
+		t.Error("expected the export of the collector to select the private record")
+	}
+
+	readerTotal := awaitTelegramExportTotal(t, baseURL, archive.readerToken, archive.senderTelegramID)
+	if readerTotal != 0 {
+		t.Errorf("expected the export of the reader to select no record, got %d", readerTotal)
+	}
+}
+
+// awaitTelegramExportTotal requests the export of the user's records and waits for the worker to be done with it,
+// it returns the amount of records the export selected.
+func awaitTelegramExportTotal(t *testing.T, baseURL, token string, userTelegramID uint64) int64 {
+	t.Helper()
+
+	type exportResponse struct {
+		ID       string `json:"id"`
+		Status   string `json:"status"`
+		Error    string `json:"error"`
+		Progress struct {
+			RecordsTotal int64 `json:"records_total"`
+		} `json:"progress"`
+	}
+
+	var export exportResponse
+	resp := MakeAuthorizedRequest(
+		t, "POST", baseURL+"/api/v1/exports/", token,
+		map[string][]uint64{"user_telegram_ids": {userTelegramID}},
+	)
+	DecodeResponse(t, resp, http.StatusAccepted, &export)
+
+	deadline := time.Now().Add(30 * time.Second)
+	for export.Status != "completed" && export.Status != "failed" {
+		if time.Now().After(deadline) {
+			t.Fatalf("export %s is still %s", export.ID, export.Status)
+		}
+		time.Sleep(200 * time.Millisecond)
+		resp = MakeAuthorizedRequest(t, "GET", baseURL+"/api/v1/exports/"+export.ID, token, nil)
+		DecodeResponse(t, resp, http.StatusOK, &export)
+	}
+	if export.Status == "failed" {
+		t.Fatalf("export %s failed: %s", export.ID, export.Error)
+	}
+	return export.Progress.RecordsTotal
+}
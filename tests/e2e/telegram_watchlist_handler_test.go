@@ -0,0 +1,154 @@
+package e2e
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+)
+
+type telegramAlertsResponse struct {
+	Alerts []struct {
+		ID          string  `json:"id"`
+		WatchlistID string  `json:"watchlist_id"`
+		EntryID     string  `json:"entry_id"`
+		EntryType   string  `json:"entry_type"`
+		RecordID    *string `json:"record_id"`
+		IdentityID  *string `json:"identity_id"`
+		ReadAt      *string `json:"read_at"`
+	} `json:"alerts"`
+	UnreadCount int `json:"unread_count"`
+}
+
+func addWatchlistEntry(t *testing.T, baseURL, token, watchlistID, entryType, value string) (string, string) {
+	t.Helper()
+
+	var added struct {
+		EntryID string `json:"entry_id"`
+		Value   string `json:"value"`
+	}
+	resp := MakeAuthorizedRequest(
+		t,
+		"POST",
+		fmt.Sprintf("%s/api/v1/watchlists/%s/entries", baseURL, watchlistID),
+		token,
+		map[string]string{"type": entryType, "value": value},
+	)
+	DecodeResponse(t, resp, http.StatusCreated, &added)
+	return added.EntryID, added.Value
+}
+
+// getWatchlistAlerts returns the alerts of the current user raised by the watchlist, keyed by the matched entry
+func getWatchlistAlerts(t *testing.T, baseURL, token, watchlistID string) map[string][]string {
+	t.Helper()
+
+	var alerts telegramAlertsResponse
+	resp := MakeAuthorizedRequest(t, "GET", baseURL+"/api/v1/alerts?limit=200", token, nil)
+	DecodeResponse(t, resp, http.StatusOK, &alerts)
+	matched := map[string][]string{}
+	for _, alert := range alerts.Alerts {
+		if alert.WatchlistID != watchlistID {
+			continue
+		}
+		switch {
+		case alert.RecordID != nil:
+			matched[alert.EntryID] = append(matched[alert.EntryID], *alert.RecordID)
+		case alert.IdentityID != nil:
+			matched[alert.EntryID] = append(matched[alert.EntryID], *alert.IdentityID)
+		}
+	}
+	return matched
+}
+
+func TestTelegramWatchlistAlerts(t *testing.T) {
+	baseURL, cleanup := StartTestServer(t)
+	defer cleanup()
+
+	adminToken := LoginUser(t, baseURL, "admin", "admin123")
+
+	telegramID := uint64(time.Now().UnixNano())
+	chatID := int64(telegramID % 1_000_000_000)
+	username := fmt.Sprintf("watched_%d", telegramID%1_000_000_000)
+	userID := AddTelegramUser(t, baseURL, adminToken, telegramID)
+	AddTelegramIdentity(t, baseURL, adminToken, userID, username, fmt.Sprintf("+1555%07d", telegramID%10_000_000))
+	mentionerID := AddTelegramUser(t, baseURL, adminToken, telegramID+1)
+
+	// Entries only match the activity added after them
+	pastRecordID := AddTelegramRecord(t, baseURL, adminToken, userID, chatID, 1, "a wire transfer", nil)
+
+	var watchlist struct {
+		WatchlistID string `json:"watchlist_id"`
+	}
+	resp := MakeAuthorizedRequest(
+		t, "POST", baseURL+"/api/v1/watchlists", adminToken, map[string]string{"name": "Suspects"},
+	)
+	DecodeResponse(t, resp, http.StatusCreated, &watchlist)
+
+	keywordEntryID, keyword := addWatchlistEntry(
+		t, baseURL, adminToken, watchlist.WatchlistID, "keyword", "  Wire \t TRANSFER ",
+	)
+	if keyword != "wire transfer" {
+		t.Errorf("expected the keyword to be stored normalised, got %q", keyword)
+	}
+	usernameEntryID, _ := addWatchlistEntry(t, baseURL, adminToken, watchlist.WatchlistID, "username", "@"+username)
+	telegramIDEntryID, _ := addWatchlistEntry(
+		t, baseURL, adminToken, watchlist.WatchlistID, "telegram_id", fmt.Sprint(telegramID),
+	)
+
+	resp = MakeAuthorizedRequest(
+		t,
+		"POST",
+		fmt.Sprintf("%s/api/v1/watchlists/%s/entries", baseURL, watchlist.WatchlistID),
+		adminToken,
+		map[string]string{"type": "keyword", "value": "wire transfer"},
+	)
+	DecodeResponse(t, resp, http.StatusConflict, nil)
+
+	cases := []struct {
+		name     string
+		fromID   string
+		text     string
+		expected []string
+	}{
+		{name: "keyword split over lines", fromID: mentionerID, text: "Send the WIRE\ntransfer", expected: []string{
+			keywordEntryID,
+		}},
+		{name: "mention of the username", fromID: mentionerID, text: "ask @" + username, expected: []string{
+			usernameEntryID,
+		}},
+		{name: "sent by the watched user", fromID: userID, text: "hello there", expected: []string{
+			usernameEntryID, telegramIDEntryID,
+		}},
+		{name: "unrelated", fromID: mentionerID, text: "wire the transfer", expected: nil},
+	}
+	recordIDs := make([]string, len(cases))
+	for i, tc := range cases {
+		recordIDs[i] = AddTelegramRecord(t, baseURL, adminToken, tc.fromID, chatID, uint64(i+2), tc.text, nil)
+	}
+
+	alerts := getWatchlistAlerts(t, baseURL, adminToken, watchlist.WatchlistID)
+	for _, matched := range alerts {
+		if slices.Contains(matched, pastRecordID) {
+			t.Error("expected the record added before the entries not to raise an alert")
+		}
+	}
+	for i, tc := range cases {
+		for _, entryID := range []string{keywordEntryID, usernameEntryID, telegramIDEntryID} {
+			raised := slices.Contains(alerts[entryID], recordIDs[i])
+			expected := slices.Contains(tc.expected, entryID)
+			if raised != expected {
+				t.Errorf("%s: expected an alert of the entry %s to be %v, got %v", tc.name, entryID, expected, raised)
+			}
+		}
+	}
+
+	// Removing the watchlist removes its alerts along with its entries
+	resp = MakeAuthorizedRequest(
+		t, "DELETE", fmt.Sprintf("%s/api/v1/watchlists/%s", baseURL, watchlist.WatchlistID), adminToken, nil,
+	)
+	DecodeResponse(t, resp, http.StatusNoContent, nil)
+	if alerts = getWatchlistAlerts(t, baseURL, adminToken, watchlist.WatchlistID); len(alerts) != 0 {
+		t.Errorf("expected the alerts to be removed with the watchlist, got %v", alerts)
+	}
+}
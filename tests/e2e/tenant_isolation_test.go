@@ -0,0 +1,230 @@
+package e2e
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type latestTelegramRecords struct {
+	Records []struct {
+		ID          string
+		MessageText string
+		DeletedAt   *time.Time
+	} `json:"records"`
+}
+
+type telegramRecordsBatch struct {
+	Created int `json:"created"`
+	Failed  int `json:"failed"`
+	Results []struct {
+		RecordID string `json:"record_id"`
+		Error    string `json:"error"`
+	} `json:"results"`
+}
+
+// getLatestTelegramRecords returns the records of the telegram user the token sees, none when they see no record
+func getLatestTelegramRecords(t *testing.T, baseURL, token string, telegramID uint64) latestTelegramRecords {
+	t.Helper()
+
+	var latest latestTelegramRecords
+	resp := MakeAuthorizedRequest(
+		t,
+		"GET",
+		fmt.Sprintf("%s/api/v1/record/telegram/%d/records", baseURL, telegramID),
+		token,
+		map[string]uint64{"telegram_id": telegramID},
+	)
+	if resp.StatusCode == http.StatusNotFound {
+		DecodeResponse(t, resp, http.StatusNotFound, nil)
+		return latest
+	}
+	DecodeResponse(t, resp, http.StatusOK, &latest)
+	return latest
+}
+
+// addTelegramRecordsBatch archives the messages of the user in the chat of the same id in a single batch
+func addTelegramRecordsBatch(
+	t *testing.T,
+	baseURL, token, fromUserID string,
+	telegramID uint64,
+	messageIDs ...uint64,
+) telegramRecordsBatch {
+	t.Helper()
+
+	records := make([]map[string]interface{}, len(messageIDs))
+	for i, messageID := range messageIDs {
+		records[i] = map[string]interface{}{
+			"message_telegram_id":   messageID,
+			"from_user_telegram_id": fromUserID,
+			"in_telegram_chat_id":   -int64(telegramID),
+			"message_text":          fmt.Sprintf("Message %d", messageID),
+			"posted_at":             time.Date(2024, 1, 15, 10, 30, int(messageID%60), 0, time.UTC),
+		}
+	}
+	var batch telegramRecordsBatch
+	resp := MakeAuthorizedRequest(
+		t, "POST", baseURL+"/api/v1/record/telegram/records:batch", token, map[string]interface{}{"records": records},
+	)
+	DecodeResponse(t, resp, http.StatusOK, &batch)
+	return batch
+}
+
+func TestTenantIsolation_ReadUpdateAndDeduplicate(t *testing.T) {
+	baseURL, cleanup := StartTestServer(t)
+	defer cleanup()
+
+	adminToken := LoginUser(t, baseURL, "admin", "admin123")
+	suffix := time.Now().UnixNano() % 1000000
+	red := CreateArchivingTeam(t, baseURL, adminToken, fmt.Sprintf("red%d", suffix))
+	blue := CreateArchivingTeam(t, baseURL, adminToken, fmt.Sprintf("blue%d", suffix))
+	teamlessToken := LoginUser(t, baseURL, "testuser", "user12345")
+
+	telegramID := uint64(810000000 + suffix)
+	chatID := -int64(telegramID)
+	redUserID := AddTelegramUser(t, baseURL, red.collectorToken, telegramID)
+	redRecordID := AddTelegramRecord(t, baseURL, red.collectorToken, redUserID, chatID, 1, "Red message", nil)
+
+	// The other team doesn't read the record of the red team
+	if latest := getLatestTelegramRecords(t, baseURL, blue.collectorToken, telegramID); len(latest.Records) != 0 {
+		t.Errorf("expected the blue team to see no record, got %d", len(latest.Records))
+	}
+	resp := MakeAuthorizedRequest(
+		t, "GET", fmt.Sprintf("%s/api/v1/record/telegram/record/%s/thread", baseURL, redRecordID),
+		blue.collectorToken, nil,
+	)
+	DecodeResponse(t, resp, http.StatusNotFound, nil)
+
+	// Nor does it update it
+	resp = MakeAuthorizedRequest(
+		t, "POST", fmt.Sprintf("%s/api/v1/record/telegram/record/%s/deletion", baseURL, redRecordID),
+		blue.collectorToken, map[string]string{},
+	)
+	DecodeResponse(t, resp, http.StatusNotFound, nil)
+
+	// The same message archived by the blue team is stored within it instead of revising the red one
+	blueUserID := AddTelegramUser(t, baseURL, blue.collectorToken, telegramID)
+	var added struct {
+		RecordID string `json:"record_id"`
+		Revised  bool   `json:"revised"`
+	}
+	resp = MakeAuthorizedRequest(t, "POST", baseURL+"/api/v1/record/telegram/record", blue.collectorToken,
+		map[string]interface{}{
+			"message_telegram_id":   1,
+			"from_user_telegram_id": blueUserID,
+			"in_telegram_chat_id":   chatID,
+			"message_text":          "Blue message",
+			"posted_at":             time.Date(2024, 1, 15, 10, 30, 1, 0, time.UTC),
+			"edited_at":             time.Date(2024, 1, 15, 10, 35, 0, 0, time.UTC),
+		},
+	)
+	DecodeResponse(t, resp, http.StatusCreated, &added)
+	if added.Revised || added.RecordID == redRecordID {
+		t.Errorf("expected the blue message to be stored apart from the red one, got %+v", added)
+	}
+
+	// The users without a team are a tenant of their own
+	teamlessUserID := AddTelegramUser(t, baseURL, teamlessToken, telegramID)
+	AddTelegramRecord(t, baseURL, teamlessToken, teamlessUserID, chatID, 1, "Teamless message", nil)
+
+	for _, tc := range []struct {
+		name  string
+		token string
+		text  string
+	}{
+		{"red", red.collectorToken, "Red message"},
+		{"blue", blue.collectorToken, "Blue message"},
+		{"teamless", teamlessToken, "Teamless message"},
+	} {
+		latest := getLatestTelegramRecords(t, baseURL, tc.token, telegramID)
+		if len(latest.Records) != 1 {
+			t.Errorf("expected the %s tenant to see 1 record, got %d", tc.name, len(latest.Records))
+			continue
+		}
+		if record := latest.Records[0]; record.MessageText != tc.text || record.DeletedAt != nil {
+			t.Errorf("expected the %s tenant to see %q undeleted, got %+v", tc.name, tc.text, record)
+		}
+	}
+}
+
+func TestTenantIsolation_BatchDeduplicatesWithinTheTeam(t *testing.T) {
+	baseURL, cleanup := StartTestServer(t)
+	defer cleanup()
+
+	adminToken := LoginUser(t, baseURL, "admin", "admin123")
+	suffix := time.Now().UnixNano() % 1000000
+	red := CreateArchivingTeam(t, baseURL, adminToken, fmt.Sprintf("red%d", suffix))
+	blue := CreateArchivingTeam(t, baseURL, adminToken, fmt.Sprintf("blue%d", suffix))
+
+	telegramID := uint64(820000000 + suffix)
+	redUserID := AddTelegramUser(t, baseURL, red.collectorToken, telegramID)
+	blueUserID := AddTelegramUser(t, baseURL, blue.collectorToken, telegramID)
+
+	batch := addTelegramRecordsBatch(t, baseURL, red.collectorToken, redUserID, telegramID, 1, 2)
+	if batch.Created != 2 {
+		t.Fatalf("expected the red team to store 2 records, got %+v", batch)
+	}
+
+	// A message the team has stored already conflicts, the new one is stored
+	batch = addTelegramRecordsBatch(t, baseURL, red.collectorToken, redUserID, telegramID, 1, 3)
+	if batch.Created != 1 || len(batch.Results) != 2 || batch.Results[0].Error != "record_already_exists" {
+		t.Errorf("expected the red team to store only the new record, got %+v", batch)
+	}
+
+	// The messages of the red team don't conflict with the ones of the blue team
+	batch = addTelegramRecordsBatch(t, baseURL, blue.collectorToken, blueUserID, telegramID, 1, 2)
+	if batch.Created != 2 || batch.Failed != 0 {
+		t.Errorf("expected the blue team to store 2 records, got %+v", batch)
+	}
+
+	if latest := getLatestTelegramRecords(t, baseURL, red.collectorToken, telegramID); len(latest.Records) != 3 {
+		t.Errorf("expected the red team to see 3 records, got %d", len(latest.Records))
+	}
+	if latest := getLatestTelegramRecords(t, baseURL, blue.collectorToken, telegramID); len(latest.Records) != 2 {
+		t.Errorf("expected the blue team to see 2 records, got %d", len(latest.Records))
+	}
+}
+
+func TestTenantIsolation_ExportWorkerBuildsWithinTheTeamOfTheRequester(t *testing.T) {
+	t.Setenv("STORAGE_LOCAL_PATH", t.TempDir())
+	t.Setenv("EXPORT_POLL_INTERVAL", "100ms")
+
+	baseURL, cleanup := StartTestServer(t)
+	defer cleanup()
+
+	adminToken := LoginUser(t, baseURL, "admin", "admin123")
+	suffix := time.Now().UnixNano() % 1000000
+	red := CreateArchivingTeam(t, baseURL, adminToken, fmt.Sprintf("red%d", suffix))
+	blue := CreateArchivingTeam(t, baseURL, adminToken, fmt.Sprintf("blue%d", suffix))
+	teamlessToken := LoginUser(t, baseURL, "testuser", "user12345")
+
+	// Every tenant archives a different amount of messages of the same telegram user
+	telegramID := uint64(830000000 + suffix)
+	for _, tc := range []struct {
+		token    string
+		messages []uint64
+	}{
+		{red.collectorToken, []uint64{1, 2}},
+		{blue.collectorToken, []uint64{1}},
+		{teamlessToken, []uint64{1, 2, 3}},
+	} {
+		userID := AddTelegramUser(t, baseURL, tc.token, telegramID)
+		addTelegramRecordsBatch(t, baseURL, tc.token, userID, telegramID, tc.messages...)
+	}
+
+	// The worker builds the export on behalf of its requester, so it only selects the records of their tenant
+	for _, tc := range []struct {
+		name  string
+		token string
+		total int64
+	}{
+		{"red", red.collectorToken, 2},
+		{"blue", blue.collectorToken, 1},
+		{"teamless", teamlessToken, 3},
+	} {
+		if total := awaitTelegramExportTotal(t, baseURL, tc.token, telegramID); total != tc.total {
+			t.Errorf("expected the export of the %s tenant to select %d records, got %d", tc.name, tc.total, total)
+		}
+	}
+}